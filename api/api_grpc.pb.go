@@ -30,8 +30,14 @@ type DexClient interface {
 	UpdatePassword(ctx context.Context, in *UpdatePasswordReq, opts ...grpc.CallOption) (*UpdatePasswordResp, error)
 	// DeletePassword deletes the password.
 	DeletePassword(ctx context.Context, in *DeletePasswordReq, opts ...grpc.CallOption) (*DeletePasswordResp, error)
+	// CreatePasswordPlaintext creates a password from its plain text.
+	CreatePasswordPlaintext(ctx context.Context, in *CreatePasswordPlaintextReq, opts ...grpc.CallOption) (*CreatePasswordPlaintextResp, error)
+	// UpdatePasswordPlaintext changes a password to a new plain text password.
+	UpdatePasswordPlaintext(ctx context.Context, in *UpdatePasswordPlaintextReq, opts ...grpc.CallOption) (*UpdatePasswordPlaintextResp, error)
 	// ListPassword lists all password entries.
 	ListPasswords(ctx context.Context, in *ListPasswordReq, opts ...grpc.CallOption) (*ListPasswordResp, error)
+	// ListPasswordsByGroup lists the password entries of users in a group.
+	ListPasswordsByGroup(ctx context.Context, in *ListPasswordsByGroupReq, opts ...grpc.CallOption) (*ListPasswordsByGroupResp, error)
 	// GetVersion returns version information of the server.
 	GetVersion(ctx context.Context, in *VersionReq, opts ...grpc.CallOption) (*VersionResp, error)
 	// ListRefresh lists all the refresh token entries for a particular user.
@@ -42,6 +48,31 @@ type DexClient interface {
 	RevokeRefresh(ctx context.Context, in *RevokeRefreshReq, opts ...grpc.CallOption) (*RevokeRefreshResp, error)
 	// VerifyPassword returns whether a password matches a hash for a specific email or not.
 	VerifyPassword(ctx context.Context, in *VerifyPasswordReq, opts ...grpc.CallOption) (*VerifyPasswordResp, error)
+	// ListConsents lists the scopes a user has approved for each client.
+	ListConsents(ctx context.Context, in *ListConsentReq, opts ...grpc.CallOption) (*ListConsentResp, error)
+	// RevokeConsent revokes the consent a user gave to a client, so the approval
+	// screen is shown again on the next login.
+	RevokeConsent(ctx context.Context, in *RevokeConsentReq, opts ...grpc.CallOption) (*RevokeConsentResp, error)
+	// ListLoginAttempts lists the usernames and client addresses with failed password logins.
+	ListLoginAttempts(ctx context.Context, in *ListLoginAttemptsReq, opts ...grpc.CallOption) (*ListLoginAttemptsResp, error)
+	// UnlockLogin lifts the lockout of a username or client address after failed password logins.
+	UnlockLogin(ctx context.Context, in *UnlockLoginReq, opts ...grpc.CallOption) (*UnlockLoginResp, error)
+	// ApprovePassword lets a user who signed up log in.
+	ApprovePassword(ctx context.Context, in *ApprovePasswordReq, opts ...grpc.CallOption) (*ApprovePasswordResp, error)
+	// CreateSAMLServiceProvider registers a SAML service provider.
+	CreateSAMLServiceProvider(ctx context.Context, in *CreateSAMLServiceProviderReq, opts ...grpc.CallOption) (*CreateSAMLServiceProviderResp, error)
+	// UpdateSAMLServiceProvider updates an existing SAML service provider.
+	UpdateSAMLServiceProvider(ctx context.Context, in *UpdateSAMLServiceProviderReq, opts ...grpc.CallOption) (*UpdateSAMLServiceProviderResp, error)
+	// DeleteSAMLServiceProvider deletes the SAML service provider.
+	DeleteSAMLServiceProvider(ctx context.Context, in *DeleteSAMLServiceProviderReq, opts ...grpc.CallOption) (*DeleteSAMLServiceProviderResp, error)
+	// ListSAMLServiceProviders lists all SAML service providers.
+	ListSAMLServiceProviders(ctx context.Context, in *ListSAMLServiceProvidersReq, opts ...grpc.CallOption) (*ListSAMLServiceProvidersResp, error)
+	// ListWebAuthnCredentials lists the WebAuthn credentials of a user.
+	ListWebAuthnCredentials(ctx context.Context, in *ListWebAuthnCredentialsReq, opts ...grpc.CallOption) (*ListWebAuthnCredentialsResp, error)
+	// DeleteWebAuthnCredential deletes a WebAuthn credential.
+	DeleteWebAuthnCredential(ctx context.Context, in *DeleteWebAuthnCredentialReq, opts ...grpc.CallOption) (*DeleteWebAuthnCredentialResp, error)
+	// DeleteTOTPEnrollment removes the TOTP second factor of a user.
+	DeleteTOTPEnrollment(ctx context.Context, in *DeleteTOTPEnrollmentReq, opts ...grpc.CallOption) (*DeleteTOTPEnrollmentResp, error)
 }
 
 type dexClient struct {
@@ -106,6 +137,24 @@ func (c *dexClient) DeletePassword(ctx context.Context, in *DeletePasswordReq, o
 	return out, nil
 }
 
+func (c *dexClient) CreatePasswordPlaintext(ctx context.Context, in *CreatePasswordPlaintextReq, opts ...grpc.CallOption) (*CreatePasswordPlaintextResp, error) {
+	out := new(CreatePasswordPlaintextResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/CreatePasswordPlaintext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) UpdatePasswordPlaintext(ctx context.Context, in *UpdatePasswordPlaintextReq, opts ...grpc.CallOption) (*UpdatePasswordPlaintextResp, error) {
+	out := new(UpdatePasswordPlaintextResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/UpdatePasswordPlaintext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dexClient) ListPasswords(ctx context.Context, in *ListPasswordReq, opts ...grpc.CallOption) (*ListPasswordResp, error) {
 	out := new(ListPasswordResp)
 	err := c.cc.Invoke(ctx, "/api.Dex/ListPasswords", in, out, opts...)
@@ -115,6 +164,15 @@ func (c *dexClient) ListPasswords(ctx context.Context, in *ListPasswordReq, opts
 	return out, nil
 }
 
+func (c *dexClient) ListPasswordsByGroup(ctx context.Context, in *ListPasswordsByGroupReq, opts ...grpc.CallOption) (*ListPasswordsByGroupResp, error) {
+	out := new(ListPasswordsByGroupResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/ListPasswordsByGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dexClient) GetVersion(ctx context.Context, in *VersionReq, opts ...grpc.CallOption) (*VersionResp, error) {
 	out := new(VersionResp)
 	err := c.cc.Invoke(ctx, "/api.Dex/GetVersion", in, out, opts...)
@@ -151,6 +209,114 @@ func (c *dexClient) VerifyPassword(ctx context.Context, in *VerifyPasswordReq, o
 	return out, nil
 }
 
+func (c *dexClient) ListConsents(ctx context.Context, in *ListConsentReq, opts ...grpc.CallOption) (*ListConsentResp, error) {
+	out := new(ListConsentResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/ListConsents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) RevokeConsent(ctx context.Context, in *RevokeConsentReq, opts ...grpc.CallOption) (*RevokeConsentResp, error) {
+	out := new(RevokeConsentResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/RevokeConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) ListLoginAttempts(ctx context.Context, in *ListLoginAttemptsReq, opts ...grpc.CallOption) (*ListLoginAttemptsResp, error) {
+	out := new(ListLoginAttemptsResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/ListLoginAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) UnlockLogin(ctx context.Context, in *UnlockLoginReq, opts ...grpc.CallOption) (*UnlockLoginResp, error) {
+	out := new(UnlockLoginResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/UnlockLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) ApprovePassword(ctx context.Context, in *ApprovePasswordReq, opts ...grpc.CallOption) (*ApprovePasswordResp, error) {
+	out := new(ApprovePasswordResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/ApprovePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) CreateSAMLServiceProvider(ctx context.Context, in *CreateSAMLServiceProviderReq, opts ...grpc.CallOption) (*CreateSAMLServiceProviderResp, error) {
+	out := new(CreateSAMLServiceProviderResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/CreateSAMLServiceProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) UpdateSAMLServiceProvider(ctx context.Context, in *UpdateSAMLServiceProviderReq, opts ...grpc.CallOption) (*UpdateSAMLServiceProviderResp, error) {
+	out := new(UpdateSAMLServiceProviderResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/UpdateSAMLServiceProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) DeleteSAMLServiceProvider(ctx context.Context, in *DeleteSAMLServiceProviderReq, opts ...grpc.CallOption) (*DeleteSAMLServiceProviderResp, error) {
+	out := new(DeleteSAMLServiceProviderResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/DeleteSAMLServiceProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) ListSAMLServiceProviders(ctx context.Context, in *ListSAMLServiceProvidersReq, opts ...grpc.CallOption) (*ListSAMLServiceProvidersResp, error) {
+	out := new(ListSAMLServiceProvidersResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/ListSAMLServiceProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) ListWebAuthnCredentials(ctx context.Context, in *ListWebAuthnCredentialsReq, opts ...grpc.CallOption) (*ListWebAuthnCredentialsResp, error) {
+	out := new(ListWebAuthnCredentialsResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/ListWebAuthnCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) DeleteWebAuthnCredential(ctx context.Context, in *DeleteWebAuthnCredentialReq, opts ...grpc.CallOption) (*DeleteWebAuthnCredentialResp, error) {
+	out := new(DeleteWebAuthnCredentialResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/DeleteWebAuthnCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dexClient) DeleteTOTPEnrollment(ctx context.Context, in *DeleteTOTPEnrollmentReq, opts ...grpc.CallOption) (*DeleteTOTPEnrollmentResp, error) {
+	out := new(DeleteTOTPEnrollmentResp)
+	err := c.cc.Invoke(ctx, "/api.Dex/DeleteTOTPEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DexServer is the server API for Dex service.
 // All implementations must embed UnimplementedDexServer
 // for forward compatibility
@@ -167,8 +333,14 @@ type DexServer interface {
 	UpdatePassword(context.Context, *UpdatePasswordReq) (*UpdatePasswordResp, error)
 	// DeletePassword deletes the password.
 	DeletePassword(context.Context, *DeletePasswordReq) (*DeletePasswordResp, error)
+	// CreatePasswordPlaintext creates a password from its plain text.
+	CreatePasswordPlaintext(context.Context, *CreatePasswordPlaintextReq) (*CreatePasswordPlaintextResp, error)
+	// UpdatePasswordPlaintext changes a password to a new plain text password.
+	UpdatePasswordPlaintext(context.Context, *UpdatePasswordPlaintextReq) (*UpdatePasswordPlaintextResp, error)
 	// ListPassword lists all password entries.
 	ListPasswords(context.Context, *ListPasswordReq) (*ListPasswordResp, error)
+	// ListPasswordsByGroup lists the password entries of users in a group.
+	ListPasswordsByGroup(context.Context, *ListPasswordsByGroupReq) (*ListPasswordsByGroupResp, error)
 	// GetVersion returns version information of the server.
 	GetVersion(context.Context, *VersionReq) (*VersionResp, error)
 	// ListRefresh lists all the refresh token entries for a particular user.
@@ -179,6 +351,31 @@ type DexServer interface {
 	RevokeRefresh(context.Context, *RevokeRefreshReq) (*RevokeRefreshResp, error)
 	// VerifyPassword returns whether a password matches a hash for a specific email or not.
 	VerifyPassword(context.Context, *VerifyPasswordReq) (*VerifyPasswordResp, error)
+	// ListConsents lists the scopes a user has approved for each client.
+	ListConsents(context.Context, *ListConsentReq) (*ListConsentResp, error)
+	// RevokeConsent revokes the consent a user gave to a client, so the approval
+	// screen is shown again on the next login.
+	RevokeConsent(context.Context, *RevokeConsentReq) (*RevokeConsentResp, error)
+	// ListLoginAttempts lists the usernames and client addresses with failed password logins.
+	ListLoginAttempts(context.Context, *ListLoginAttemptsReq) (*ListLoginAttemptsResp, error)
+	// UnlockLogin lifts the lockout of a username or client address after failed password logins.
+	UnlockLogin(context.Context, *UnlockLoginReq) (*UnlockLoginResp, error)
+	// ApprovePassword lets a user who signed up log in.
+	ApprovePassword(context.Context, *ApprovePasswordReq) (*ApprovePasswordResp, error)
+	// CreateSAMLServiceProvider registers a SAML service provider.
+	CreateSAMLServiceProvider(context.Context, *CreateSAMLServiceProviderReq) (*CreateSAMLServiceProviderResp, error)
+	// UpdateSAMLServiceProvider updates an existing SAML service provider.
+	UpdateSAMLServiceProvider(context.Context, *UpdateSAMLServiceProviderReq) (*UpdateSAMLServiceProviderResp, error)
+	// DeleteSAMLServiceProvider deletes the SAML service provider.
+	DeleteSAMLServiceProvider(context.Context, *DeleteSAMLServiceProviderReq) (*DeleteSAMLServiceProviderResp, error)
+	// ListSAMLServiceProviders lists all SAML service providers.
+	ListSAMLServiceProviders(context.Context, *ListSAMLServiceProvidersReq) (*ListSAMLServiceProvidersResp, error)
+	// ListWebAuthnCredentials lists the WebAuthn credentials of a user.
+	ListWebAuthnCredentials(context.Context, *ListWebAuthnCredentialsReq) (*ListWebAuthnCredentialsResp, error)
+	// DeleteWebAuthnCredential deletes a WebAuthn credential.
+	DeleteWebAuthnCredential(context.Context, *DeleteWebAuthnCredentialReq) (*DeleteWebAuthnCredentialResp, error)
+	// DeleteTOTPEnrollment removes the TOTP second factor of a user.
+	DeleteTOTPEnrollment(context.Context, *DeleteTOTPEnrollmentReq) (*DeleteTOTPEnrollmentResp, error)
 	mustEmbedUnimplementedDexServer()
 }
 
@@ -204,9 +401,18 @@ func (UnimplementedDexServer) UpdatePassword(context.Context, *UpdatePasswordReq
 func (UnimplementedDexServer) DeletePassword(context.Context, *DeletePasswordReq) (*DeletePasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePassword not implemented")
 }
+func (UnimplementedDexServer) CreatePasswordPlaintext(context.Context, *CreatePasswordPlaintextReq) (*CreatePasswordPlaintextResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePasswordPlaintext not implemented")
+}
+func (UnimplementedDexServer) UpdatePasswordPlaintext(context.Context, *UpdatePasswordPlaintextReq) (*UpdatePasswordPlaintextResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePasswordPlaintext not implemented")
+}
 func (UnimplementedDexServer) ListPasswords(context.Context, *ListPasswordReq) (*ListPasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPasswords not implemented")
 }
+func (UnimplementedDexServer) ListPasswordsByGroup(context.Context, *ListPasswordsByGroupReq) (*ListPasswordsByGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPasswordsByGroup not implemented")
+}
 func (UnimplementedDexServer) GetVersion(context.Context, *VersionReq) (*VersionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
@@ -219,6 +425,42 @@ func (UnimplementedDexServer) RevokeRefresh(context.Context, *RevokeRefreshReq)
 func (UnimplementedDexServer) VerifyPassword(context.Context, *VerifyPasswordReq) (*VerifyPasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyPassword not implemented")
 }
+func (UnimplementedDexServer) ListConsents(context.Context, *ListConsentReq) (*ListConsentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsents not implemented")
+}
+func (UnimplementedDexServer) RevokeConsent(context.Context, *RevokeConsentReq) (*RevokeConsentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeConsent not implemented")
+}
+func (UnimplementedDexServer) ListLoginAttempts(context.Context, *ListLoginAttemptsReq) (*ListLoginAttemptsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginAttempts not implemented")
+}
+func (UnimplementedDexServer) UnlockLogin(context.Context, *UnlockLoginReq) (*UnlockLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockLogin not implemented")
+}
+func (UnimplementedDexServer) ApprovePassword(context.Context, *ApprovePasswordReq) (*ApprovePasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePassword not implemented")
+}
+func (UnimplementedDexServer) CreateSAMLServiceProvider(context.Context, *CreateSAMLServiceProviderReq) (*CreateSAMLServiceProviderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSAMLServiceProvider not implemented")
+}
+func (UnimplementedDexServer) UpdateSAMLServiceProvider(context.Context, *UpdateSAMLServiceProviderReq) (*UpdateSAMLServiceProviderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSAMLServiceProvider not implemented")
+}
+func (UnimplementedDexServer) DeleteSAMLServiceProvider(context.Context, *DeleteSAMLServiceProviderReq) (*DeleteSAMLServiceProviderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSAMLServiceProvider not implemented")
+}
+func (UnimplementedDexServer) ListSAMLServiceProviders(context.Context, *ListSAMLServiceProvidersReq) (*ListSAMLServiceProvidersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSAMLServiceProviders not implemented")
+}
+func (UnimplementedDexServer) ListWebAuthnCredentials(context.Context, *ListWebAuthnCredentialsReq) (*ListWebAuthnCredentialsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebAuthnCredentials not implemented")
+}
+func (UnimplementedDexServer) DeleteWebAuthnCredential(context.Context, *DeleteWebAuthnCredentialReq) (*DeleteWebAuthnCredentialResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebAuthnCredential not implemented")
+}
+func (UnimplementedDexServer) DeleteTOTPEnrollment(context.Context, *DeleteTOTPEnrollmentReq) (*DeleteTOTPEnrollmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTOTPEnrollment not implemented")
+}
 func (UnimplementedDexServer) mustEmbedUnimplementedDexServer() {}
 
 // UnsafeDexServer may be embedded to opt out of forward compatibility for this service.
@@ -340,6 +582,42 @@ func _Dex_DeletePassword_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dex_CreatePasswordPlaintext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePasswordPlaintextReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).CreatePasswordPlaintext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/CreatePasswordPlaintext",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).CreatePasswordPlaintext(ctx, req.(*CreatePasswordPlaintextReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_UpdatePasswordPlaintext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePasswordPlaintextReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).UpdatePasswordPlaintext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/UpdatePasswordPlaintext",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).UpdatePasswordPlaintext(ctx, req.(*UpdatePasswordPlaintextReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dex_ListPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPasswordReq)
 	if err := dec(in); err != nil {
@@ -358,6 +636,24 @@ func _Dex_ListPasswords_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dex_ListPasswordsByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPasswordsByGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).ListPasswordsByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/ListPasswordsByGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).ListPasswordsByGroup(ctx, req.(*ListPasswordsByGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dex_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VersionReq)
 	if err := dec(in); err != nil {
@@ -430,6 +726,222 @@ func _Dex_VerifyPassword_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dex_ListConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).ListConsents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/ListConsents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).ListConsents(ctx, req.(*ListConsentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_RevokeConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeConsentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).RevokeConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/RevokeConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).RevokeConsent(ctx, req.(*RevokeConsentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_ListLoginAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginAttemptsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).ListLoginAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/ListLoginAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).ListLoginAttempts(ctx, req.(*ListLoginAttemptsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_UnlockLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).UnlockLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/UnlockLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).UnlockLogin(ctx, req.(*UnlockLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_ApprovePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).ApprovePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/ApprovePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).ApprovePassword(ctx, req.(*ApprovePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_CreateSAMLServiceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSAMLServiceProviderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).CreateSAMLServiceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/CreateSAMLServiceProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).CreateSAMLServiceProvider(ctx, req.(*CreateSAMLServiceProviderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_UpdateSAMLServiceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSAMLServiceProviderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).UpdateSAMLServiceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/UpdateSAMLServiceProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).UpdateSAMLServiceProvider(ctx, req.(*UpdateSAMLServiceProviderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_DeleteSAMLServiceProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSAMLServiceProviderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).DeleteSAMLServiceProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/DeleteSAMLServiceProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).DeleteSAMLServiceProvider(ctx, req.(*DeleteSAMLServiceProviderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_ListSAMLServiceProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSAMLServiceProvidersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).ListSAMLServiceProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/ListSAMLServiceProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).ListSAMLServiceProviders(ctx, req.(*ListSAMLServiceProvidersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_ListWebAuthnCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebAuthnCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).ListWebAuthnCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/ListWebAuthnCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).ListWebAuthnCredentials(ctx, req.(*ListWebAuthnCredentialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_DeleteWebAuthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebAuthnCredentialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).DeleteWebAuthnCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/DeleteWebAuthnCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).DeleteWebAuthnCredential(ctx, req.(*DeleteWebAuthnCredentialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dex_DeleteTOTPEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTOTPEnrollmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DexServer).DeleteTOTPEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dex/DeleteTOTPEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DexServer).DeleteTOTPEnrollment(ctx, req.(*DeleteTOTPEnrollmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dex_ServiceDesc is the grpc.ServiceDesc for Dex service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -461,10 +973,22 @@ var Dex_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePassword",
 			Handler:    _Dex_DeletePassword_Handler,
 		},
+		{
+			MethodName: "CreatePasswordPlaintext",
+			Handler:    _Dex_CreatePasswordPlaintext_Handler,
+		},
+		{
+			MethodName: "UpdatePasswordPlaintext",
+			Handler:    _Dex_UpdatePasswordPlaintext_Handler,
+		},
 		{
 			MethodName: "ListPasswords",
 			Handler:    _Dex_ListPasswords_Handler,
 		},
+		{
+			MethodName: "ListPasswordsByGroup",
+			Handler:    _Dex_ListPasswordsByGroup_Handler,
+		},
 		{
 			MethodName: "GetVersion",
 			Handler:    _Dex_GetVersion_Handler,
@@ -481,6 +1005,54 @@ var Dex_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyPassword",
 			Handler:    _Dex_VerifyPassword_Handler,
 		},
+		{
+			MethodName: "ListConsents",
+			Handler:    _Dex_ListConsents_Handler,
+		},
+		{
+			MethodName: "RevokeConsent",
+			Handler:    _Dex_RevokeConsent_Handler,
+		},
+		{
+			MethodName: "ListLoginAttempts",
+			Handler:    _Dex_ListLoginAttempts_Handler,
+		},
+		{
+			MethodName: "UnlockLogin",
+			Handler:    _Dex_UnlockLogin_Handler,
+		},
+		{
+			MethodName: "ApprovePassword",
+			Handler:    _Dex_ApprovePassword_Handler,
+		},
+		{
+			MethodName: "CreateSAMLServiceProvider",
+			Handler:    _Dex_CreateSAMLServiceProvider_Handler,
+		},
+		{
+			MethodName: "UpdateSAMLServiceProvider",
+			Handler:    _Dex_UpdateSAMLServiceProvider_Handler,
+		},
+		{
+			MethodName: "DeleteSAMLServiceProvider",
+			Handler:    _Dex_DeleteSAMLServiceProvider_Handler,
+		},
+		{
+			MethodName: "ListSAMLServiceProviders",
+			Handler:    _Dex_ListSAMLServiceProviders_Handler,
+		},
+		{
+			MethodName: "ListWebAuthnCredentials",
+			Handler:    _Dex_ListWebAuthnCredentials_Handler,
+		},
+		{
+			MethodName: "DeleteWebAuthnCredential",
+			Handler:    _Dex_DeleteWebAuthnCredential_Handler,
+		},
+		{
+			MethodName: "DeleteTOTPEnrollment",
+			Handler:    _Dex_DeleteTOTPEnrollment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/api.proto",
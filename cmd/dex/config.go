@@ -30,6 +30,15 @@ type Config struct {
 	GRPC      GRPC      `json:"grpc"`
 	Expiry    Expiry    `json:"expiry"`
 	Logger    Logger    `json:"logger"`
+	CIBA      CIBA      `json:"ciba"`
+	TOTP      TOTP      `json:"totp"`
+	WebAuthn  WebAuthn  `json:"webauthn"`
+	Lockout   Lockout   `json:"lockout"`
+	SAMLIdP   SAMLIdP   `json:"samlIdP"`
+	Email     Email     `json:"email"`
+
+	Registration Registration `json:"registration"`
+	Passwords    Passwords    `json:"passwords"`
 
 	Frontend server.WebConfig `json:"frontend"`
 
@@ -68,6 +77,17 @@ func (c Config) Validate() error {
 		{c.GRPC.TLSKey != "" && c.GRPC.Addr == "", "no address specified for gRPC"},
 		{(c.GRPC.TLSCert == "") != (c.GRPC.TLSKey == ""), "must specific both a gRPC TLS cert and key"},
 		{c.GRPC.TLSCert == "" && c.GRPC.TLSClientCA != "", "cannot specify gRPC TLS client CA without a gRPC TLS cert"},
+		{c.CIBA.Webhook != nil && c.CIBA.Webhook.URL == "", "no url specified for the CIBA webhook"},
+		{c.OAuth2.PairwiseSubjectSalt == "" && hasPairwiseClient(c.StaticClients), "pairwise clients require an oauth2 pairwise subject salt"},
+		{c.TOTP.EncryptionKey == "" && (len(c.TOTP.Connectors) > 0 || len(c.TOTP.Clients) > 0), "no encryption key specified for TOTP"},
+		{!c.EnablePasswordDB && (c.WebAuthn.Passwordless || c.WebAuthn.SecondFactor), "cannot use WebAuthn without enabling password db"},
+		{c.Lockout.MaxUserFailures < 0 || c.Lockout.MaxIPFailures < 0, "lockout failure limits cannot be negative"},
+		{c.Email.SMTP != nil && c.Email.SMTP.Address == "", "no address specified for SMTP"},
+		{c.Email.SMTP != nil && c.Email.SMTP.From == "", "no sender address specified for SMTP"},
+		{c.Registration.Enabled && !c.EnablePasswordDB, "cannot enable registration without enabling password db"},
+		{c.Registration.Enabled && c.Email.SMTP == nil, "registration requires email.smtp to verify email addresses"},
+		{c.Passwords.HashAlgorithm != "" && c.Passwords.HashAlgorithm != "bcrypt" && c.Passwords.HashAlgorithm != "argon2id", "password hash algorithm must be bcrypt or argon2id"},
+		{c.Passwords.Policy.MinLength < 0 || c.Passwords.Policy.History < 0, "password policy minimum length and history cannot be negative"},
 	}
 
 	var checkErrors []string
@@ -83,6 +103,15 @@ func (c Config) Validate() error {
 	return nil
 }
 
+func hasPairwiseClient(clients []storage.Client) bool {
+	for _, client := range clients {
+		if client.SubjectType == "pairwise" {
+			return true
+		}
+	}
+	return false
+}
+
 type password storage.Password
 
 func (p *password) UnmarshalJSON(b []byte) error {
@@ -92,14 +121,21 @@ func (p *password) UnmarshalJSON(b []byte) error {
 		UserID      string `json:"userID"`
 		Hash        string `json:"hash"`
 		HashFromEnv string `json:"hashFromEnv"`
+
+		Groups      []string          `json:"groups"`
+		DisplayName string            `json:"displayName"`
+		Attributes  map[string]string `json:"attributes"`
 	}
 	if err := json.Unmarshal(b, &data); err != nil {
 		return err
 	}
 	*p = password(storage.Password{
-		Email:    data.Email,
-		Username: data.Username,
-		UserID:   data.UserID,
+		Email:       data.Email,
+		Username:    data.Username,
+		UserID:      data.UserID,
+		Groups:      data.Groups,
+		DisplayName: data.DisplayName,
+		Attributes:  data.Attributes,
 	})
 	if len(data.Hash) == 0 && len(data.HashFromEnv) > 0 {
 		data.Hash = os.Getenv(data.HashFromEnv)
@@ -108,9 +144,10 @@ func (p *password) UnmarshalJSON(b []byte) error {
 		return fmt.Errorf("no password hash provided")
 	}
 
-	// If this value is a valid bcrypt, use it.
+	// If this value is a valid bcrypt, use it. Argon2id hashes are checked when
+	// the user logs in.
 	_, bcryptErr := bcrypt.Cost([]byte(data.Hash))
-	if bcryptErr == nil {
+	if bcryptErr == nil || strings.HasPrefix(data.Hash, "$argon2id$") {
 		p.Hash = []byte(data.Hash)
 		return nil
 	}
@@ -137,6 +174,21 @@ type OAuth2 struct {
 	AlwaysShowLoginScreen bool `json:"alwaysShowLoginScreen"`
 	// This is the connector that can be used for password grant
 	PasswordConnector string `json:"passwordConnector"`
+	// Secret salt used to derive subjects for clients with the "pairwise" subject type.
+	// Changing it changes the subjects of all pairwise clients.
+	PairwiseSubjectSalt string `json:"pairwiseSubjectSalt"`
+	// Authentication context classes clients can request with "acr_values", from
+	// weakest to strongest.
+	ACRLevels []ACRLevel `json:"acrLevels"`
+}
+
+// ACRLevel maps an authentication context class to the connectors which reach it.
+type ACRLevel struct {
+	Value string `json:"value"`
+	// Connectors which reach the level on their own.
+	Connectors []string `json:"connectors"`
+	// Chains of connectors the user logs in with one after the other to reach the level.
+	Chains [][]string `json:"chains"`
 }
 
 // Web is the config format for the HTTP server.
@@ -329,10 +381,133 @@ type Expiry struct {
 	// DeviceRequests defines the duration of time for which the DeviceRequests will be valid.
 	DeviceRequests string `json:"deviceRequests"`
 
+	// BackchannelRequests defines the duration of time for which CIBA requests will be valid.
+	BackchannelRequests string `json:"backchannelRequests"`
+
+	// PasswordResets defines the duration of time for which emailed password reset links will be valid.
+	PasswordResets string `json:"passwordResets"`
+
+	// EmailVerifications defines the duration of time for which links confirming the email
+	// address of a new account will be valid.
+	EmailVerifications string `json:"emailVerifications"`
+
 	// RefreshTokens defines refresh tokens expiry policy
 	RefreshTokens RefreshToken `json:"refreshTokens"`
 }
 
+// CIBA holds the configuration for client initiated backchannel authentication.
+// The flow is only enabled when a notifier is configured.
+type CIBA struct {
+	// Webhook notifies users by POSTing backchannel authentication requests to a URL.
+	Webhook *CIBAWebhook `json:"webhook"`
+}
+
+// CIBAWebhook is the config format for the webhook notifier.
+type CIBAWebhook struct {
+	URL string `json:"url"`
+	// Headers added to every notification, for example to authenticate dex.
+	Headers map[string]string `json:"headers"`
+}
+
+// TOTP is the config format for the TOTP second factor.
+type TOTP struct {
+	// Key used to encrypt the TOTP secrets in the storage.
+	EncryptionKey string `json:"encryptionKey"`
+	// Name shown in authenticator apps. Defaults to the issuer host.
+	Issuer string `json:"issuer"`
+	// Require TOTP for users logging in with these connectors.
+	Connectors []string `json:"connectors"`
+	// Require TOTP for users logging in to these clients.
+	Clients []string `json:"clients"`
+	// Number of wrong codes after which users have to log in again. Defaults to 5.
+	MaxFailures int `json:"maxFailures"`
+}
+
+// WebAuthn is the config format for passkeys of local users.
+type WebAuthn struct {
+	// Relying party ID. Defaults to the host name of the issuer.
+	RPID string `json:"rpID"`
+	// Name shown by authenticators. Defaults to "dex".
+	RPDisplayName string `json:"rpDisplayName"`
+	// Let users log in with a passkey instead of their password.
+	Passwordless bool `json:"passwordless"`
+	// Require a passkey or security key after users log in with their password.
+	SecondFactor bool `json:"secondFactor"`
+}
+
+// Lockout is the config format for locking password logins after failed attempts.
+type Lockout struct {
+	// Failed logins for a username, or from a client address, before logins are
+	// locked. Zero disables the limit.
+	MaxUserFailures int `json:"maxUserFailures"`
+	MaxIPFailures   int `json:"maxIPFailures"`
+	// Duration of the first lockout, doubled for every further failure up to maxDelay.
+	BaseDelay string `json:"baseDelay"`
+	MaxDelay  string `json:"maxDelay"`
+	// Failures are forgotten after this long without another one.
+	ResetAfter string `json:"resetAfter"`
+	// Header with the client address set by a reverse proxy, e.g. X-Forwarded-For.
+	ClientIPHeader string `json:"clientIPHeader"`
+}
+
+// SAMLIdP is the config format for serving dex as a SAML 2.0 identity provider.
+type SAMLIdP struct {
+	Enabled bool `json:"enabled"`
+	// How long assertions are valid for, 5 minutes by default.
+	AssertionsValidFor string `json:"assertionsValidFor"`
+}
+
+// Email holds the configuration for sending emails to users, which lets users of
+// the password database reset their password and verify their email address.
+type Email struct {
+	SMTP *SMTP `json:"smtp"`
+}
+
+// SMTP is the config format for sending emails through an SMTP server.
+type SMTP struct {
+	// Address of the server as host:port.
+	Address string `json:"address"`
+	// Credentials, if the server requires authentication.
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// Sender address of the emails.
+	From string `json:"from"`
+	// Send emails unencrypted. Sending fails otherwise if the server doesn't
+	// support STARTTLS.
+	DisableStartTLS bool `json:"disableStartTLS"`
+}
+
+// Registration is the config format for users signing up for the password database.
+type Registration struct {
+	Enabled bool `json:"enabled"`
+	// Only email addresses of these domains can sign up. All domains if empty.
+	AllowedDomains []string `json:"allowedDomains"`
+	// Users can't log in until an admin approved them through the gRPC API.
+	RequireApproval bool `json:"requireApproval"`
+}
+
+// Passwords is the config format for the passwords of the password database.
+type Passwords struct {
+	// Algorithm of the hashes dex creates, "bcrypt" or "argon2id". Defaults to bcrypt.
+	HashAlgorithm string `json:"hashAlgorithm"`
+	// Requirements for passwords set in plain text.
+	Policy PasswordPolicy `json:"policy"`
+}
+
+// PasswordPolicy is the config format for the requirements of new passwords.
+type PasswordPolicy struct {
+	MinLength        int  `json:"minLength"`
+	RequireUppercase bool `json:"requireUppercase"`
+	RequireLowercase bool `json:"requireLowercase"`
+	RequireDigit     bool `json:"requireDigit"`
+	RequireSymbol    bool `json:"requireSymbol"`
+	// Number of previous passwords which can't be reused.
+	History int `json:"history"`
+	// File of passwords, or their SHA-1 hashes, which are refused. It's kept in
+	// memory, at 20 bytes per password.
+	BreachedPasswordsFile string `json:"breachedPasswordsFile"`
+}
+
 // Logger holds configuration required to customize logging for dex.
 type Logger struct {
 	// Level sets logging level severity.
@@ -108,6 +108,10 @@ staticPasswords:
   hash: "$2a$10$33EMT0cVYVlPy6WAMCLsceLYjWhuHpbz5yuZxu/GAFj03J9Lytjuy"
   username: "admin"
   userID: "08a8684b-db88-4b73-90a9-3cd1661f5466"
+  groups: ["admins"]
+  displayName: "Admin User"
+  attributes:
+    department: "it"
 - email: "foo@example.com"
   # base64'd value of the same bcrypt hash above. We want to be able to parse both of these
   hash: "JDJhJDEwJDMzRU1UMGNWWVZsUHk2V0FNQ0xzY2VMWWpXaHVIcGJ6NXl1Wnh1L0dBRmowM0o5THl0anV5"
@@ -119,6 +123,13 @@ expiry:
   idTokens: "25h"
   authRequests: "25h"
   deviceRequests: "10m"
+  backchannelRequests: "2m"
+
+ciba:
+  webhook:
+    url: https://notify.example.com/dex
+    headers:
+      Authorization: "Bearer s3cr3t"
 
 logger:
   level: "debug"
@@ -184,10 +195,13 @@ logger:
 		EnablePasswordDB: true,
 		StaticPasswords: []password{
 			{
-				Email:    "admin@example.com",
-				Hash:     []byte("$2a$10$33EMT0cVYVlPy6WAMCLsceLYjWhuHpbz5yuZxu/GAFj03J9Lytjuy"),
-				Username: "admin",
-				UserID:   "08a8684b-db88-4b73-90a9-3cd1661f5466",
+				Email:       "admin@example.com",
+				Hash:        []byte("$2a$10$33EMT0cVYVlPy6WAMCLsceLYjWhuHpbz5yuZxu/GAFj03J9Lytjuy"),
+				Username:    "admin",
+				UserID:      "08a8684b-db88-4b73-90a9-3cd1661f5466",
+				Groups:      []string{"admins"},
+				DisplayName: "Admin User",
+				Attributes:  map[string]string{"department": "it"},
 			},
 			{
 				Email:    "foo@example.com",
@@ -201,6 +215,14 @@ logger:
 			IDTokens:       "25h",
 			AuthRequests:   "25h",
 			DeviceRequests: "10m",
+
+			BackchannelRequests: "2m",
+		},
+		CIBA: CIBA{
+			Webhook: &CIBAWebhook{
+				URL:     "https://notify.example.com/dex",
+				Headers: map[string]string{"Authorization": "Bearer s3cr3t"},
+			},
 		},
 		Logger: Logger{
 			Level:  "debug",
@@ -259,6 +259,18 @@ func runServe(options serveOptions) error {
 	if c.OAuth2.PasswordConnector != "" {
 		logger.Infof("config using password grant connector: %s", c.OAuth2.PasswordConnector)
 	}
+	if c.OAuth2.PairwiseSubjectSalt != "" {
+		logger.Infof("config pairwise subjects enabled")
+	}
+	var acrLevels []server.ACRLevel
+	for _, level := range c.OAuth2.ACRLevels {
+		chains := append([][]string(nil), level.Chains...)
+		for _, connID := range level.Connectors {
+			chains = append(chains, []string{connID})
+		}
+		acrLevels = append(acrLevels, server.ACRLevel{Value: level.Value, Chains: chains})
+		logger.Infof("config acr level %q reached by connectors: %q", level.Value, chains)
+	}
 	if len(c.Web.AllowedOrigins) > 0 {
 		logger.Infof("config allowed origins: %s", c.Web.AllowedOrigins)
 	}
@@ -273,6 +285,8 @@ func runServe(options serveOptions) error {
 		SkipApprovalScreen:     c.OAuth2.SkipApprovalScreen,
 		AlwaysShowLoginScreen:  c.OAuth2.AlwaysShowLoginScreen,
 		PasswordConnector:      c.OAuth2.PasswordConnector,
+		PairwiseSubjectSalt:    c.OAuth2.PairwiseSubjectSalt,
+		ACRLevels:              acrLevels,
 		AllowedOrigins:         c.Web.AllowedOrigins,
 		Issuer:                 c.Issuer,
 		Storage:                s,
@@ -314,6 +328,129 @@ func runServe(options serveOptions) error {
 		logger.Infof("config device requests valid for: %v", deviceRequests)
 		serverConfig.DeviceRequestsValidFor = deviceRequests
 	}
+	if c.Expiry.BackchannelRequests != "" {
+		backchannelRequests, err := time.ParseDuration(c.Expiry.BackchannelRequests)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for backchannel request expiry: %v", c.Expiry.BackchannelRequests, err)
+		}
+		logger.Infof("config backchannel requests valid for: %v", backchannelRequests)
+		serverConfig.BackchannelRequestsValidFor = backchannelRequests
+	}
+	if c.Expiry.PasswordResets != "" {
+		passwordResets, err := time.ParseDuration(c.Expiry.PasswordResets)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for password reset expiry: %v", c.Expiry.PasswordResets, err)
+		}
+		logger.Infof("config password resets valid for: %v", passwordResets)
+		serverConfig.PasswordResetsValidFor = passwordResets
+	}
+	if c.Expiry.EmailVerifications != "" {
+		emailVerifications, err := time.ParseDuration(c.Expiry.EmailVerifications)
+		if err != nil {
+			return fmt.Errorf("invalid config value %q for email verification expiry: %v", c.Expiry.EmailVerifications, err)
+		}
+		logger.Infof("config email verifications valid for: %v", emailVerifications)
+		serverConfig.EmailVerificationsValidFor = emailVerifications
+	}
+	if c.CIBA.Webhook != nil {
+		logger.Infof("config CIBA webhook notifier: %s", c.CIBA.Webhook.URL)
+		serverConfig.BackchannelNotifier = server.NewWebhookNotifier(c.CIBA.Webhook.URL, c.CIBA.Webhook.Headers, nil)
+	}
+	if c.Email.SMTP != nil {
+		sender, err := server.NewSMTPSender(server.SMTPConfig{
+			Address:         c.Email.SMTP.Address,
+			Username:        c.Email.SMTP.Username,
+			Password:        c.Email.SMTP.Password,
+			From:            c.Email.SMTP.From,
+			DisableStartTLS: c.Email.SMTP.DisableStartTLS,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to configure SMTP: %v", err)
+		}
+		logger.Infof("config emails sent through SMTP server: %s", c.Email.SMTP.Address)
+		serverConfig.EmailSender = sender
+	}
+	if c.Passwords.HashAlgorithm != "" {
+		logger.Infof("config password hash algorithm: %s", c.Passwords.HashAlgorithm)
+		serverConfig.Passwords.HashAlgorithm = c.Passwords.HashAlgorithm
+	}
+	if c.Passwords.Policy != (PasswordPolicy{}) {
+		policy, err := server.NewPasswordPolicy(server.PasswordPolicyConfig{
+			MinLength:             c.Passwords.Policy.MinLength,
+			RequireUppercase:      c.Passwords.Policy.RequireUppercase,
+			RequireLowercase:      c.Passwords.Policy.RequireLowercase,
+			RequireDigit:          c.Passwords.Policy.RequireDigit,
+			RequireSymbol:         c.Passwords.Policy.RequireSymbol,
+			History:               c.Passwords.Policy.History,
+			BreachedPasswordsFile: c.Passwords.Policy.BreachedPasswordsFile,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to load password policy: %v", err)
+		}
+		logger.Infof("config password policy: minimum length %d, history %d", c.Passwords.Policy.MinLength, c.Passwords.Policy.History)
+		serverConfig.Passwords.Policy = policy
+	}
+	if c.Registration.Enabled {
+		logger.Infof("config registration allowed domains: %q, require approval: %t", c.Registration.AllowedDomains, c.Registration.RequireApproval)
+		serverConfig.Registration = server.RegistrationConfig{
+			Enabled:         true,
+			AllowedDomains:  c.Registration.AllowedDomains,
+			RequireApproval: c.Registration.RequireApproval,
+		}
+	}
+	if c.TOTP.EncryptionKey != "" {
+		logger.Infof("config TOTP required for connectors: %q, clients: %q", c.TOTP.Connectors, c.TOTP.Clients)
+		serverConfig.TOTP = server.TOTPConfig{
+			EncryptionKey: c.TOTP.EncryptionKey,
+			Issuer:        c.TOTP.Issuer,
+			Connectors:    c.TOTP.Connectors,
+			Clients:       c.TOTP.Clients,
+			MaxFailures:   c.TOTP.MaxFailures,
+		}
+	}
+	if c.WebAuthn.Passwordless || c.WebAuthn.SecondFactor {
+		logger.Infof("config WebAuthn passwordless: %t, second factor: %t", c.WebAuthn.Passwordless, c.WebAuthn.SecondFactor)
+		serverConfig.WebAuthn = server.WebAuthnConfig{
+			RPID:          c.WebAuthn.RPID,
+			RPDisplayName: c.WebAuthn.RPDisplayName,
+			Passwordless:  c.WebAuthn.Passwordless,
+			SecondFactor:  c.WebAuthn.SecondFactor,
+		}
+	}
+	if c.Lockout.MaxUserFailures > 0 || c.Lockout.MaxIPFailures > 0 {
+		lockout := server.LockoutConfig{
+			MaxUserFailures: c.Lockout.MaxUserFailures,
+			MaxIPFailures:   c.Lockout.MaxIPFailures,
+			ClientIPHeader:  c.Lockout.ClientIPHeader,
+		}
+		for _, d := range []struct {
+			name  string
+			value string
+			dst   *time.Duration
+		}{
+			{"base delay", c.Lockout.BaseDelay, &lockout.BaseDelay},
+			{"max delay", c.Lockout.MaxDelay, &lockout.MaxDelay},
+			{"reset after", c.Lockout.ResetAfter, &lockout.ResetAfter},
+		} {
+			if d.value == "" {
+				continue
+			}
+			if *d.dst, err = time.ParseDuration(d.value); err != nil {
+				return fmt.Errorf("invalid config value %q for lockout %s: %v", d.value, d.name, err)
+			}
+		}
+		logger.Infof("config lockout after failed logins per user: %d, per client address: %d", lockout.MaxUserFailures, lockout.MaxIPFailures)
+		serverConfig.Lockout = lockout
+	}
+	if c.SAMLIdP.Enabled {
+		serverConfig.SAMLIdP.Enabled = true
+		if c.SAMLIdP.AssertionsValidFor != "" {
+			if serverConfig.SAMLIdP.AssertionsValidFor, err = time.ParseDuration(c.SAMLIdP.AssertionsValidFor); err != nil {
+				return fmt.Errorf("invalid config value %q for SAML assertions expiry: %v", c.SAMLIdP.AssertionsValidFor, err)
+			}
+		}
+		logger.Infof("config SAML identity provider enabled")
+	}
 	refreshTokenPolicy, err := server.NewRefreshTokenPolicy(
 		logger,
 		c.Expiry.RefreshTokens.DisableRotation,
@@ -459,7 +596,7 @@ func runServe(options serveOptions) error {
 		}
 
 		grpcSrv := grpc.NewServer(grpcOptions...)
-		api.RegisterDexServer(grpcSrv, server.NewAPI(serverConfig.Storage, logger, version))
+		api.RegisterDexServer(grpcSrv, server.NewAPI(serverConfig.Storage, logger, version, serverConfig.PairwiseSubjectSalt, serverConfig.Passwords))
 
 		grpcMetrics.InitializeMetrics(grpcSrv)
 		if c.GRPC.Reflection {
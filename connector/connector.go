@@ -3,13 +3,16 @@ package connector
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 )
 
 // Connector is a mechanism for federating login to a remote identity service.
 //
 // Implementations are expected to implement either the PasswordConnector or
-// CallbackConnector interface.
+// CallbackConnector interface. Connectors implementing io.Closer are closed
+// when the server replaces them, for example after their config changed.
 type Connector interface{}
 
 // Scopes represents additional data requested by the clients about the end user.
@@ -31,6 +34,10 @@ type Identity struct {
 
 	Groups []string
 
+	// Attributes are custom attributes of the user, such as a department, for
+	// connectors which keep them. They aren't added to tokens.
+	Attributes map[string]string
+
 	// ConnectorData holds data used by the connector for subsequent requests after initial
 	// authentication, such as access tokens for upstream provides.
 	//
@@ -47,6 +54,22 @@ type PasswordConnector interface {
 	Login(ctx context.Context, s Scopes, username, password string) (identity Identity, validPassword bool, err error)
 }
 
+// ErrPasswordExpired is returned, possibly wrapped, by the Login of password
+// connectors when the password is correct but expired.
+var ErrPasswordExpired = errors.New("password expired")
+
+// ErrPasswordRejected is returned, possibly wrapped, by ChangePassword when the
+// upstream refuses the new password, for example because it's too weak.
+var ErrPasswordRejected = errors.New("new password rejected")
+
+// PasswordChangeConnector is an optional interface for password connectors which
+// can change the passwords of their users.
+type PasswordChangeConnector interface {
+	// ChangePassword checks the current password of the user, which may have
+	// expired, and replaces it. It returns false if the current password is wrong.
+	ChangePassword(ctx context.Context, username, oldPassword, newPassword string) (validPassword bool, err error)
+}
+
 // CallbackConnector is an interface implemented by connectors which use an OAuth
 // style redirect flow to determine user information.
 type CallbackConnector interface {
@@ -69,6 +92,19 @@ type CallbackConnector interface {
 	HandleCallback(s Scopes, r *http.Request) (identity Identity, err error)
 }
 
+// CallbackDataConnector is a CallbackConnector which keeps data between sending
+// the user to the provider and the callback, for example a PKCE code verifier.
+// The server uses these methods instead of LoginURL and HandleCallback.
+type CallbackDataConnector interface {
+	// LoginURLWithData is LoginURL, which also returns the data for the callback.
+	// The server keeps the data, it isn't part of the URL.
+	LoginURLWithData(s Scopes, callbackURL, state string) (loginURL string, callbackData []byte, err error)
+
+	// HandleCallbackWithData is HandleCallback with the data LoginURLWithData
+	// returned for the login.
+	HandleCallbackWithData(s Scopes, callbackData []byte, r *http.Request) (identity Identity, err error)
+}
+
 // SAMLConnector represents SAML connectors which implement the HTTP POST binding.
 //  RelayState is handled by the server.
 //
@@ -91,6 +127,48 @@ type SAMLConnector interface {
 	HandlePOST(s Scopes, samlResponse, inResponseTo string) (identity Identity, err error)
 }
 
+// IDPInitiatedLogin is what a login a SAML IdP started, without a request of
+// the server, is for.
+type IDPInitiatedLogin struct {
+	// The client the user is logged in to, and the redirect URI of the client the
+	// user is sent to. The RelayState of the response may choose another redirect
+	// URI of the client.
+	ClientID    string
+	RedirectURI string
+
+	Scopes []string
+}
+
+// SAMLIDPInitiatedConnector is a SAML connector which accepts responses the IdP
+// sends unsolicited, when users log in from the IdP instead of a client.
+type SAMLIDPInitiatedConnector interface {
+	// IDPInitiatedLogin returns false if the connector doesn't accept
+	// unsolicited responses.
+	IDPInitiatedLogin() (login IDPInitiatedLogin, ok bool)
+
+	// HandleUnsolicitedPOST is HandlePOST for a response without InResponseTo.
+	// Nothing ties such a response to a login of the user, so the server must
+	// accept the returned assertion ID only once, until the assertion expires.
+	HandleUnsolicitedPOST(s Scopes, samlResponse string) (identity Identity, assertionID string, expiry time.Time, err error)
+}
+
+// SAMLMetadataConnector is a SAML connector which describes dex as a service
+// provider with a metadata document, for IdPs to be configured with.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-metadata-2.0-os.pdf
+type SAMLMetadataConnector interface {
+	// Metadata returns the XML metadata document of the service provider.
+	Metadata() ([]byte, error)
+}
+
+// UserLookupConnector is a connector which can look up users without their
+// credentials, for other connectors to refresh identities with.
+type UserLookupConnector interface {
+	// LookupUser returns the current identity of the user with the username. It
+	// fails if the user doesn't exist or is disabled.
+	LookupUser(ctx context.Context, s Scopes, username string) (Identity, error)
+}
+
 // RefreshConnector is a connector that can update the client claims.
 type RefreshConnector interface {
 	// Refresh is called when a client attempts to claim a refresh token. The
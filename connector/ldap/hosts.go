@@ -0,0 +1,171 @@
+package ldap
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dexidp/dex/pkg/log"
+)
+
+const (
+	// How long a server which couldn't be reached is tried only after the others.
+	hostRetryDelay = 30 * time.Second
+	// How long the servers found through DNS SRV records are used before they're
+	// looked up again.
+	srvRefreshInterval = 5 * time.Minute
+)
+
+// hostList orders the LDAP servers to connect to. Servers found through SRV
+// records go first, by priority and then chosen by weight as described in
+// RFC 2782. The servers from the config follow. Servers of equal priority and
+// weight, and those from the config, are used in turn. Servers which recently
+// failed go last.
+type hostList struct {
+	// Servers from the config, with ports.
+	static []string
+
+	// Service, for example "ldap", and domain of the SRV records to look up.
+	// No records are looked up if the domain is empty.
+	srvService string
+	srvDomain  string
+
+	logger log.Logger
+
+	// Overridden by tests.
+	lookupSRV func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	now       func() time.Time
+	intn      func(n int) int
+
+	mu sync.Mutex
+	// Sorted by priority.
+	srvRecords []*net.SRV
+	srvExpires time.Time
+	down       map[string]time.Time
+	next       int
+}
+
+func newHostList(static []string, srvService, srvDomain string, logger log.Logger) *hostList {
+	return &hostList{
+		static:     static,
+		srvService: srvService,
+		srvDomain:  srvDomain,
+		logger:     logger,
+		lookupSRV:  net.DefaultResolver.LookupSRV,
+		now:        time.Now,
+		intn:       rand.Intn,
+		down:       make(map[string]time.Time),
+	}
+}
+
+// hosts returns the servers to try, in order, for a new connection.
+func (l *hostList) hosts(ctx context.Context) []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if l.srvDomain != "" && now.After(l.srvExpires) {
+		l.refreshSRV(ctx)
+		l.srvExpires = now.Add(srvRefreshInterval)
+	}
+
+	next := l.next
+	l.next++
+
+	var all []string
+	for i := 0; i < len(l.srvRecords); {
+		j := i + 1
+		for j < len(l.srvRecords) && l.srvRecords[j].Priority == l.srvRecords[i].Priority {
+			j++
+		}
+		records := make([]*net.SRV, 0, j-i)
+		for k := i; k < j; k++ {
+			records = append(records, l.srvRecords[i+(next+k-i)%(j-i)])
+		}
+		for _, r := range l.byWeight(records) {
+			all = append(all, net.JoinHostPort(r.Target, strconv.Itoa(int(r.Port))))
+		}
+		i = j
+	}
+	for i := range l.static {
+		all = append(all, l.static[(next+i)%len(l.static)])
+	}
+
+	var up, down []string
+	for _, host := range all {
+		if until, ok := l.down[host]; ok && now.Before(until) {
+			down = append(down, host)
+		} else {
+			up = append(up, host)
+		}
+	}
+	return append(up, down...)
+}
+
+// refreshSRV looks up the servers of the SRV records. If the lookup fails the
+// previous servers are kept.
+func (l *hostList) refreshSRV(ctx context.Context) {
+	_, records, err := l.lookupSRV(ctx, l.srvService, "tcp", l.srvDomain)
+	if err != nil {
+		l.logger.Errorf("ldap: lookup of SRV records for %q failed: %v", l.srvDomain, err)
+		return
+	}
+	srvRecords := make([]*net.SRV, 0, len(records))
+	for _, r := range records {
+		// A target of "." means the service isn't available in the domain.
+		if r.Target == "." {
+			continue
+		}
+		srvRecords = append(srvRecords, &net.SRV{Target: trimDot(r.Target), Port: r.Port, Priority: r.Priority, Weight: r.Weight})
+	}
+	sort.SliceStable(srvRecords, func(i, j int) bool {
+		return srvRecords[i].Priority < srvRecords[j].Priority
+	})
+	l.srvRecords = srvRecords
+}
+
+// byWeight orders records of the same priority, choosing each next one with a
+// probability proportional to its weight. Records with a weight of zero go
+// last, in the given order.
+func (l *hostList) byWeight(records []*net.SRV) []*net.SRV {
+	sum := 0
+	for _, r := range records {
+		sum += int(r.Weight)
+	}
+	for i := 0; sum > 0 && i < len(records)-1; i++ {
+		n := l.intn(sum)
+		s := 0
+		for j := i; j < len(records); j++ {
+			s += int(records[j].Weight)
+			if s > n {
+				records[i], records[j] = records[j], records[i]
+				break
+			}
+		}
+		sum -= int(records[i].Weight)
+	}
+	return records
+}
+
+func (l *hostList) markDown(host string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.down[host] = l.now().Add(hostRetryDelay)
+}
+
+func (l *hostList) markUp(host string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.down, host)
+}
+
+func trimDot(s string) string {
+	if len(s) > 0 && s[len(s)-1] == '.' {
+		return s[:len(s)-1]
+	}
+	return s
+}
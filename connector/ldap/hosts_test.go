@@ -0,0 +1,108 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/sirupsen/logrus"
+)
+
+func TestHostList(t *testing.T) {
+	now := time.Now()
+	lookups := 0
+	var lookupErr error
+
+	l := newHostList([]string{"static.example.com:636"}, "ldap", "example.com",
+		&logrus.Logger{Out: io.Discard, Formatter: &logrus.TextFormatter{}})
+	l.now = func() time.Time { return now }
+	l.lookupSRV = func(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		if service != "ldap" || proto != "tcp" || name != "example.com" {
+			t.Errorf("unexpected lookup of %s %s %s", service, proto, name)
+		}
+		lookups++
+		if lookupErr != nil {
+			return "", nil, lookupErr
+		}
+		return "_ldap._tcp.example.com.", []*net.SRV{
+			{Target: "dc1.example.com.", Port: 389},
+			{Target: "dc2.example.com.", Port: 389},
+		}, nil
+	}
+
+	ctx := context.Background()
+	check := func(want ...string) {
+		t.Helper()
+		if diff := pretty.Compare(want, l.hosts(ctx)); diff != "" {
+			t.Error(diff)
+		}
+	}
+
+	// Servers of the same priority and weight are used in turn, servers from
+	// the config follow those of the SRV records.
+	check("dc1.example.com:389", "dc2.example.com:389", "static.example.com:636")
+	check("dc2.example.com:389", "dc1.example.com:389", "static.example.com:636")
+
+	// Servers which failed recently go last.
+	l.markDown("dc1.example.com:389")
+	check("dc2.example.com:389", "static.example.com:636", "dc1.example.com:389")
+	check("dc2.example.com:389", "static.example.com:636", "dc1.example.com:389")
+
+	now = now.Add(hostRetryDelay + time.Second)
+	check("dc1.example.com:389", "dc2.example.com:389", "static.example.com:636")
+	l.markDown("dc2.example.com:389")
+	l.markUp("dc2.example.com:389")
+	check("dc2.example.com:389", "dc1.example.com:389", "static.example.com:636")
+
+	if lookups != 1 {
+		t.Errorf("expected the SRV records to be cached, got %d lookups", lookups)
+	}
+
+	// The previous servers are kept if the records can't be looked up again.
+	lookupErr = errors.New("lookup failed")
+	now = now.Add(srvRefreshInterval + time.Second)
+	check("dc1.example.com:389", "dc2.example.com:389", "static.example.com:636")
+	if lookups != 2 {
+		t.Errorf("expected the SRV records to be looked up again, got %d lookups", lookups)
+	}
+}
+
+func TestHostListSRVPriorityAndWeight(t *testing.T) {
+	l := newHostList(nil, "ldap", "example.com",
+		&logrus.Logger{Out: io.Discard, Formatter: &logrus.TextFormatter{}})
+	l.lookupSRV = func(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		return "_ldap._tcp.example.com.", []*net.SRV{
+			{Target: "backup.example.com.", Port: 389, Priority: 20, Weight: 100},
+			{Target: "dc1.example.com.", Port: 389, Priority: 10, Weight: 10},
+			{Target: "unused.example.com.", Port: 389, Priority: 10, Weight: 0},
+			{Target: "dc2.example.com.", Port: 389, Priority: 10, Weight: 30},
+		}, nil
+	}
+
+	ctx := context.Background()
+	dc2First := 0
+	for i := 0; i < 1000; i++ {
+		hosts := l.hosts(ctx)
+		if len(hosts) != 4 {
+			t.Fatalf("expected 4 servers, got %v", hosts)
+		}
+		switch {
+		case hosts[0] == "dc2.example.com:389" && hosts[1] == "dc1.example.com:389":
+			dc2First++
+		case hosts[0] == "dc1.example.com:389" && hosts[1] == "dc2.example.com:389":
+		default:
+			t.Fatalf("expected the weighted servers of the lowest priority first, got %v", hosts)
+		}
+		if hosts[2] != "unused.example.com:389" || hosts[3] != "backup.example.com:389" {
+			t.Fatalf("expected the server without weight, then the higher priority, last, got %v", hosts)
+		}
+	}
+	// dc2 has three quarters of the weight.
+	if dc2First < 650 || dc2First > 850 {
+		t.Errorf("expected dc2 first about 750 times, got %d", dc2First)
+	}
+}
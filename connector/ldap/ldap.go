@@ -6,9 +6,14 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-ldap/ldap/v3"
 
@@ -28,6 +33,9 @@ import (
 //       host: ldap.example.com:636
 //       # The following field is required if using port 389.
 //       # insecureNoSSL: true
+//       # Further servers to fail over to.
+//       # hosts:
+//       # - ldap2.example.com:636
 //       rootCA: /etc/dex/ldap.ca
 //       bindDN: uid=serviceaccount,cn=users,dc=example,dc=com
 //       bindPW: password
@@ -68,6 +76,36 @@ type Config struct {
 	// guessed based on the TLS configuration. 389 or 636.
 	Host string `json:"host"`
 
+	// Further LDAP servers, each with an optional port like host. Connections go to
+	// the servers in turn, and to the next one if a server can't be reached.
+	Hosts []string `json:"hosts"`
+
+	// Domain whose servers are looked up through the DNS SRV records
+	// "_ldap._tcp.<domain>", or "_ldaps._tcp.<domain>" unless insecureNoSSL or
+	// startTLS are set. The servers found are used before those of host and hosts,
+	// in the order of their priority and weight.
+	DiscoveryDomain string `json:"discoveryDomain"`
+
+	// Timeout for connecting to a server, including the bind as the service
+	// account. Defaults to "5s".
+	DialTimeout string `json:"dialTimeout"`
+
+	// Timeout for every operation, such as a search or a bind. Defaults to "30s".
+	Timeout string `json:"timeout"`
+
+	// Connections bound as the service account are kept for reuse.
+	ConnectionPool struct {
+		// Maximum number of open connections. Defaults to 10.
+		MaxConnections int `json:"maxConnections"`
+
+		// How long idle connections are kept open. Defaults to "5m".
+		MaxIdleTime string `json:"maxIdleTime"`
+
+		// Connections idle for longer than this are checked with a search of the
+		// root DSE before they're reused. Defaults to "30s".
+		HealthCheckInterval string `json:"healthCheckInterval"`
+	} `json:"connectionPool"`
+
 	// Required if LDAP host does not use TLS.
 	InsecureNoSSL bool `json:"insecureNoSSL"`
 
@@ -98,6 +136,26 @@ type Config struct {
 	// "Username".
 	UsernamePrompt string `json:"usernamePrompt"`
 
+	// Set if the server is Active Directory. Logins and refreshes of accounts which
+	// are disabled or locked out are then rejected, based on the userAccountControl
+	// and msDS-User-Account-Control-Computed attributes of the user.
+	ActiveDirectory bool `json:"activeDirectory"`
+
+	// Number of entries requested per page of user and group searches, using the
+	// simple paged results control. Defaults to 500, which is below the maximum
+	// page size of Active Directory.
+	PageSize int `json:"pageSize"`
+
+	// Lets users change their password through dex, including after it expired.
+	// Can either be:
+	// * "" - password changes are disabled
+	// * "passwordModify" - the user binds and changes their password with the
+	//   password modify extended operation of RFC 3062
+	// * "unicodePwd" - the service account replaces the unicodePwd attribute of
+	//   the user in Active Directory, which checks the old password. Requires
+	//   LDAPS or startTLS.
+	PasswordChange string `json:"passwordChange"`
+
 	// User entry search configuration.
 	UserSearch struct {
 		// BaseDN to start the search from. For example "cn=users,dc=example,dc=com"
@@ -155,9 +213,41 @@ type Config struct {
 
 		// The attribute of the group that represents its name.
 		NameAttr string `json:"nameAttr"`
+
+		// Resolution of groups the user is a member of through other groups.
+		NestedGroups struct {
+			// Can either be:
+			// * "" - only return the groups the user is a direct member of
+			// * "iterative" - search for the groups of every group found, applying
+			//   the user matchers to the group entries
+			// * "inChain" - let Active Directory resolve the nested groups by
+			//   searching with the LDAP_MATCHING_RULE_IN_CHAIN rule. Only works
+			//   with user matchers of DN valued attributes such as "member".
+			Strategy string `json:"strategy"`
+
+			// Maximum number of levels of groups searched by the iterative strategy,
+			// besides the groups of the user. Defaults to 10.
+			MaxDepth int `json:"maxDepth"`
+		} `json:"nestedGroups"`
 	} `json:"groupSearch"`
 }
 
+// Strategies for resolving nested groups.
+const (
+	nestedGroupsIterative = "iterative"
+	nestedGroupsInChain   = "inChain"
+)
+
+// Flags of the userAccountControl attribute of Active Directory.
+const (
+	uacAccountDisable = 0x2
+	uacLockout        = 0x10
+)
+
+// OID of the LDAP_MATCHING_RULE_IN_CHAIN matching rule of Active Directory,
+// which follows group memberships transitively.
+const matchingRuleInChain = "1.2.840.113556.1.4.1941"
+
 func scopeString(i int) string {
 	switch i {
 	case ldap.ScopeBaseObject:
@@ -203,10 +293,13 @@ func userMatchers(c *Config, logger log.Logger) []UserMatcher {
 
 // Open returns an authentication strategy using LDAP.
 func (c *Config) Open(id string, logger log.Logger) (connector.Connector, error) {
-	conn, err := c.OpenConnector(logger)
+	conn, err := c.openConnector(id, logger)
 	if err != nil {
 		return nil, err
 	}
+	if c.PasswordChange != "" {
+		return passwordChangeConnector{conn}, nil
+	}
 	return connector.Connector(conn), nil
 }
 
@@ -221,39 +314,77 @@ func (c *Config) OpenConnector(logger log.Logger) (interface {
 	connector.PasswordConnector
 	connector.RefreshConnector
 }, error) {
-	return c.openConnector(logger)
+	return c.openConnector("", logger)
 }
 
-func (c *Config) openConnector(logger log.Logger) (*ldapConnector, error) {
+func (c *Config) openConnector(id string, logger log.Logger) (*ldapConnector, error) {
 	requiredFields := []struct {
 		name string
 		val  string
 	}{
-		{"host", c.Host},
 		{"userSearch.baseDN", c.UserSearch.BaseDN},
 		{"userSearch.username", c.UserSearch.Username},
 	}
 
+	if c.Host == "" && len(c.Hosts) == 0 && c.DiscoveryDomain == "" {
+		return nil, fmt.Errorf("ldap: missing required field %q", "host")
+	}
 	for _, field := range requiredFields {
 		if field.val == "" {
 			return nil, fmt.Errorf("ldap: missing required field %q", field.name)
 		}
 	}
 
-	var (
-		host string
-		err  error
-	)
-	if host, _, err = net.SplitHostPort(c.Host); err != nil {
-		host = c.Host
+	var hosts []string
+	if c.Host != "" {
+		c.Host = c.withDefaultPort(c.Host)
+		hosts = append(hosts, c.Host)
+	}
+	for _, host := range c.Hosts {
+		hosts = append(hosts, c.withDefaultPort(host))
+	}
+
+	dialTimeout, err := parseDuration("dialTimeout", c.DialTimeout, 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := parseDuration("timeout", c.Timeout, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	maxIdleTime, err := parseDuration("connectionPool.maxIdleTime", c.ConnectionPool.MaxIdleTime, 5*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	healthCheckInterval, err := parseDuration("connectionPool.healthCheckInterval", c.ConnectionPool.HealthCheckInterval, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if c.PageSize < 0 {
+		return nil, fmt.Errorf("ldap: pageSize can't be negative")
+	}
+	if c.PageSize == 0 {
+		c.PageSize = 500
+	}
+	switch c.PasswordChange {
+	case "", passwordChangeModify:
+	case passwordChangeUnicodePwd:
 		if c.InsecureNoSSL {
-			c.Host += ":389"
-		} else {
-			c.Host += ":636"
+			return nil, fmt.Errorf("ldap: passwordChange %q requires LDAPS or startTLS", c.PasswordChange)
 		}
+	default:
+		return nil, fmt.Errorf("ldap: passwordChange unknown value %q", c.PasswordChange)
 	}
 
-	tlsConfig := &tls.Config{ServerName: host, InsecureSkipVerify: c.InsecureSkipVerify}
+	maxConns := c.ConnectionPool.MaxConnections
+	if maxConns < 0 {
+		return nil, fmt.Errorf("ldap: connectionPool.maxConnections can't be negative")
+	}
+	if maxConns == 0 {
+		maxConns = 10
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify}
 	if c.RootCA != "" || len(c.RootCAData) != 0 {
 		data := c.RootCAData
 		if len(data) == 0 {
@@ -285,65 +416,220 @@ func (c *Config) openConnector(logger log.Logger) (*ldapConnector, error) {
 		return nil, fmt.Errorf("groupSearch.Scope unknown value %q", c.GroupSearch.Scope)
 	}
 
+	switch c.GroupSearch.NestedGroups.Strategy {
+	case "", nestedGroupsIterative, nestedGroupsInChain:
+	default:
+		return nil, fmt.Errorf("groupSearch.nestedGroups.strategy unknown value %q", c.GroupSearch.NestedGroups.Strategy)
+	}
+	if c.GroupSearch.NestedGroups.MaxDepth < 0 {
+		return nil, fmt.Errorf("ldap: groupSearch.nestedGroups.maxDepth can't be negative")
+	}
+	if c.GroupSearch.NestedGroups.MaxDepth == 0 {
+		c.GroupSearch.NestedGroups.MaxDepth = 10
+	}
+
 	// TODO(nabokihms): remove it after deleting deprecated groupSearch options
 	c.GroupSearch.UserMatchers = userMatchers(c, logger)
-	return &ldapConnector{*c, userSearchScope, groupSearchScope, tlsConfig, logger}, nil
+
+	srvService := "ldaps"
+	if c.InsecureNoSSL || c.StartTLS {
+		srvService = "ldap"
+	}
+	conn := &ldapConnector{
+		Config:           *c,
+		id:               id,
+		userSearchScope:  userSearchScope,
+		groupSearchScope: groupSearchScope,
+		tlsConfig:        tlsConfig,
+		hosts:            newHostList(hosts, srvService, c.DiscoveryDomain, logger),
+		dialTimeout:      dialTimeout,
+		timeout:          timeout,
+		logger:           logger,
+	}
+	conn.pool = newConnPool(id, maxConns, maxIdleTime, healthCheckInterval, conn.dial)
+	return conn, nil
+}
+
+func parseDuration(name, s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("ldap: invalid %s %q: %v", name, s, err)
+	}
+	return d, nil
+}
+
+// withDefaultPort adds the port to a host without one, guessing it based on the
+// TLS configuration.
+func (c *Config) withDefaultPort(host string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	if c.InsecureNoSSL {
+		return host + ":389"
+	}
+	return host + ":636"
 }
 
 type ldapConnector struct {
 	Config
 
+	id string
+
 	userSearchScope  int
 	groupSearchScope int
 
 	tlsConfig *tls.Config
 
+	hosts *hostList
+	pool  *connPool
+
+	dialTimeout time.Duration
+	timeout     time.Duration
+
 	logger log.Logger
 }
 
 var (
 	_ connector.PasswordConnector = (*ldapConnector)(nil)
 	_ connector.RefreshConnector  = (*ldapConnector)(nil)
+	_ io.Closer                   = (*ldapConnector)(nil)
 )
 
-// do initializes a connection to the LDAP directory and passes it to the
-// provided function. It then performs appropriate teardown or reuse before
-// returning.
-func (c *ldapConnector) do(_ context.Context, f func(c *ldap.Conn) error) error {
-	// TODO(ericchiang): support context here
-	var (
-		conn *ldap.Conn
-		err  error
-	)
-	switch {
-	case c.InsecureNoSSL:
-		conn, err = ldap.Dial("tcp", c.Host)
-	case c.StartTLS:
-		conn, err = ldap.Dial("tcp", c.Host)
-		if err != nil {
-			return fmt.Errorf("failed to connect: %v", err)
+// Close closes the pooled connections. The server calls it when the connector
+// is replaced.
+func (c *ldapConnector) Close() error {
+	c.pool.close()
+	return nil
+}
+
+// do passes a connection bound as the service account to the provided function.
+// Connections are taken from and returned to the pool, so f must not call do
+// itself.
+func (c *ldapConnector) do(ctx context.Context, f func(c *ldap.Conn) error) error {
+	return c.withConn(ctx, false, f)
+}
+
+// doUserBind is like do for functions which bind as another user. The
+// connection is bound as the service account again before it's reused.
+func (c *ldapConnector) doUserBind(ctx context.Context, f func(c *ldap.Conn) error) error {
+	return c.withConn(ctx, true, f)
+}
+
+func (c *ldapConnector) withConn(ctx context.Context, rebind bool, f func(c *ldap.Conn) error) error {
+	pc, err := c.pool.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = f(pc.conn)
+	// Errors are rare, and the connection might be broken after a timeout.
+	reuse := err == nil
+	if reuse && rebind {
+		if err := c.bind(pc.conn); err != nil {
+			c.logger.Errorf("ldap: failed to bind as the service account again: %v", err)
+			reuse = false
+		}
+	}
+	c.pool.put(pc, reuse)
+	return err
+}
+
+// dial connects to the first server which can be reached and binds as the
+// service account.
+func (c *ldapConnector) dial(ctx context.Context) (*ldap.Conn, error) {
+	hosts := c.hosts.hosts(ctx)
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("ldap: no servers found in domain %q", c.DiscoveryDomain)
+	}
+
+	var lastErr error
+	for i, host := range hosts {
+		conn, err := c.dialHost(ctx, host)
+		if err == nil {
+			c.hosts.markUp(host)
+			return conn, nil
 		}
-		if err := conn.StartTLS(c.tlsConfig); err != nil {
-			return fmt.Errorf("start TLS failed: %v", err)
+		// Every server rejects wrong service account credentials.
+		var ldapErr *ldap.Error
+		if errors.As(err, &ldapErr) && ldapErr.ResultCode == ldap.LDAPResultInvalidCredentials {
+			return nil, err
+		}
+		lastErr = err
+		c.hosts.markDown(host)
+		if ctx.Err() != nil {
+			break
+		}
+		if i < len(hosts)-1 {
+			c.logger.Errorf("ldap: failed to connect to %s, trying %s: %v", host, hosts[i+1], err)
+			failovers.WithLabelValues(c.id, host).Inc()
 		}
-	default:
-		conn, err = ldap.DialTLS("tcp", c.Host, c.tlsConfig)
 	}
+	return nil, lastErr
+}
+
+func (c *ldapConnector) dialHost(ctx context.Context, host string) (*ldap.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.dialTimeout)
+	defer cancel()
+
+	serverName, _, err := net.SplitHostPort(host)
 	if err != nil {
-		return fmt.Errorf("failed to connect: %v", err)
+		return nil, fmt.Errorf("ldap: invalid host %q: %v", host, err)
+	}
+	tlsConfig := c.tlsConfig.Clone()
+	tlsConfig.ServerName = serverName
+
+	var d net.Dialer
+	netConn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %v", err)
+	}
+
+	var conn *ldap.Conn
+	if c.InsecureNoSSL || c.StartTLS {
+		conn = ldap.NewConn(netConn, false)
+	} else {
+		tlsConn := tls.Client(netConn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			netConn.Close()
+			return nil, fmt.Errorf("failed to connect: %v", err)
+		}
+		conn = ldap.NewConn(tlsConn, true)
 	}
-	defer conn.Close()
+	conn.Start()
 
+	// Bound the setup by the dial timeout, and then every operation by the
+	// operation timeout.
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetTimeout(time.Until(deadline))
+	}
+	if c.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("start TLS failed: %v", err)
+		}
+	}
+	if err := c.bind(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetTimeout(c.timeout)
+	return conn, nil
+}
+
+// bind binds as the service account.
+func (c *ldapConnector) bind(conn *ldap.Conn) error {
 	// If bindDN and bindPW are empty this will default to an anonymous bind.
 	if c.BindDN == "" && c.BindPW == "" {
 		if err := conn.UnauthenticatedBind(""); err != nil {
-			return fmt.Errorf("ldap: initial anonymous bind failed: %v", err)
+			return fmt.Errorf("ldap: initial anonymous bind failed: %w", err)
 		}
 	} else if err := conn.Bind(c.BindDN, c.BindPW); err != nil {
-		return fmt.Errorf("ldap: initial bind for user %q failed: %v", c.BindDN, err)
+		return fmt.Errorf("ldap: initial bind for user %q failed: %w", c.BindDN, err)
 	}
-
-	return f(conn)
+	return nil
 }
 
 func getAttrs(e ldap.Entry, name string) []string {
@@ -436,7 +722,7 @@ func (c *ldapConnector) userEntry(conn *ldap.Conn, username string) (user ldap.E
 
 	c.logger.Infof("performing ldap search %s %s %s",
 		req.BaseDN, scopeString(req.Scope), req.Filter)
-	resp, err := conn.Search(req)
+	resp, err := conn.SearchWithPaging(req, uint32(c.PageSize))
 	if err != nil {
 		return ldap.Entry{}, false, fmt.Errorf("ldap: search with filter %q failed: %v", req.Filter, err)
 	}
@@ -454,6 +740,72 @@ func (c *ldapConnector) userEntry(conn *ldap.Conn, username string) (user ldap.E
 	}
 }
 
+// userBind binds as the user, and reports whether the password expired according
+// to the password policy control or the error of Active Directory. Some servers
+// let users bind with an expired password so they can change it.
+func (c *ldapConnector) userBind(conn *ldap.Conn, dn, password string) (expired bool, err error) {
+	req := ldap.NewSimpleBindRequest(dn, password, []ldap.Control{ldap.NewControlBeheraPasswordPolicy()})
+	result, err := conn.SimpleBind(req)
+	if result != nil {
+		if ctrl, ok := ldap.FindControl(result.Controls, ldap.ControlTypeBeheraPasswordPolicy).(*ldap.ControlBeheraPasswordPolicy); ok {
+			switch ctrl.Error {
+			case ldap.BeheraPasswordExpired, ldap.BeheraChangeAfterReset:
+				expired = true
+			}
+		}
+	}
+	// Active Directory reports expired passwords, and passwords which have to be
+	// changed at the next logon, in the diagnostic message. Both are only
+	// reported for correct passwords.
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		msg := err.Error()
+		if strings.Contains(msg, "data 532") || strings.Contains(msg, "data 773") {
+			expired = true
+		}
+	}
+	return expired, err
+}
+
+// accountStatus returns why Active Directory refuses logins of the user, or an
+// empty string if the account is enabled. Lockouts are only reliably reported
+// by the computed attribute, which is only returned when the user entry itself
+// is searched.
+func (c *ldapConnector) accountStatus(conn *ldap.Conn, user ldap.Entry) (string, error) {
+	req := &ldap.SearchRequest{
+		BaseDN:     user.DN,
+		Filter:     "(objectClass=*)",
+		Scope:      ldap.ScopeBaseObject,
+		Attributes: []string{"userAccountControl", "msDS-User-Account-Control-Computed"},
+	}
+	resp, err := conn.Search(req)
+	if err != nil {
+		return "", fmt.Errorf("ldap: search for account status of %q failed: %v", user.DN, err)
+	}
+	if len(resp.Entries) != 1 {
+		return "", fmt.Errorf("ldap: search for account status of %q returned %d results", user.DN, len(resp.Entries))
+	}
+
+	var flags uint64
+	for _, attr := range req.Attributes {
+		v := getAttr(*resp.Entries[0], attr)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("ldap: entry %q has invalid %s %q", user.DN, attr, v)
+		}
+		flags |= uint64(n)
+	}
+	switch {
+	case flags&uacAccountDisable != 0:
+		return "disabled", nil
+	case flags&uacLockout != 0:
+		return "locked out", nil
+	}
+	return "", nil
+}
+
 func (c *ldapConnector) Login(ctx context.Context, s connector.Scopes, username, password string) (ident connector.Identity, validPass bool, err error) {
 	// make this check to avoid unauthenticated bind to the LDAP server.
 	if password == "" {
@@ -467,7 +819,7 @@ func (c *ldapConnector) Login(ctx context.Context, s connector.Scopes, username,
 		user          ldap.Entry
 	)
 
-	err = c.do(ctx, func(conn *ldap.Conn) error {
+	err = c.doUserBind(ctx, func(conn *ldap.Conn) error {
 		entry, found, err := c.userEntry(conn, username)
 		if err != nil {
 			return err
@@ -478,8 +830,25 @@ func (c *ldapConnector) Login(ctx context.Context, s connector.Scopes, username,
 		}
 		user = entry
 
+		if c.ActiveDirectory {
+			status, err := c.accountStatus(conn, user)
+			if err != nil {
+				return err
+			}
+			if status != "" {
+				c.logger.Errorf("ldap: account %q is %s", user.DN, status)
+				incorrectPass = true
+				return nil
+			}
+		}
+
 		// Try to authenticate as the distinguished name.
-		if err := conn.Bind(user.DN, password); err != nil {
+		expired, err := c.userBind(conn, user.DN, password)
+		if expired {
+			c.logger.Infof("ldap: password of user %q expired", user.DN)
+			return fmt.Errorf("ldap: password of user %q expired: %w", user.DN, connector.ErrPasswordExpired)
+		}
+		if err != nil {
 			// Detect a bad password through the LDAP error code.
 			if ldapErr, ok := err.(*ldap.Error); ok {
 				switch ldapErr.ResultCode {
@@ -537,39 +906,73 @@ func (c *ldapConnector) Refresh(ctx context.Context, s connector.Scopes, ident c
 		return ident, fmt.Errorf("ldap: failed to unmarshal internal data: %v", err)
 	}
 
+	user, err := c.activeUserEntry(ctx, data.Username)
+	if err != nil {
+		return ident, err
+	}
+	if user.DN != data.Entry.DN {
+		return ident, fmt.Errorf("ldap: refresh for username %q expected DN %q got %q", data.Username, data.Entry.DN, user.DN)
+	}
+
+	newIdent, err := c.identityWithGroups(ctx, s, user)
+	if err != nil {
+		return connector.Identity{}, err
+	}
+	newIdent.ConnectorData = ident.ConnectorData
+	return newIdent, nil
+}
+
+// LookupUser returns the current identity of the user with the username, for
+// other connectors to refresh their identities with.
+func (c *ldapConnector) LookupUser(ctx context.Context, s connector.Scopes, username string) (connector.Identity, error) {
+	user, err := c.activeUserEntry(ctx, username)
+	if err != nil {
+		return connector.Identity{}, err
+	}
+	return c.identityWithGroups(ctx, s, user)
+}
+
+// activeUserEntry returns the entry of the user, and fails if the user doesn't
+// exist or the Active Directory account is disabled.
+func (c *ldapConnector) activeUserEntry(ctx context.Context, username string) (ldap.Entry, error) {
 	var user ldap.Entry
 	err := c.do(ctx, func(conn *ldap.Conn) error {
-		entry, found, err := c.userEntry(conn, data.Username)
+		entry, found, err := c.userEntry(conn, username)
 		if err != nil {
 			return err
 		}
 		if !found {
-			return fmt.Errorf("ldap: user not found %q", data.Username)
+			return fmt.Errorf("ldap: user not found %q", username)
 		}
 		user = entry
+
+		if c.ActiveDirectory {
+			status, err := c.accountStatus(conn, user)
+			if err != nil {
+				return err
+			}
+			if status != "" {
+				return fmt.Errorf("ldap: account %q is %s", user.DN, status)
+			}
+		}
 		return nil
 	})
-	if err != nil {
-		return ident, err
-	}
-	if user.DN != data.Entry.DN {
-		return ident, fmt.Errorf("ldap: refresh for username %q expected DN %q got %q", data.Username, data.Entry.DN, user.DN)
-	}
+	return user, err
+}
 
-	newIdent, err := c.identityFromEntry(user)
+func (c *ldapConnector) identityWithGroups(ctx context.Context, s connector.Scopes, user ldap.Entry) (connector.Identity, error) {
+	ident, err := c.identityFromEntry(user)
 	if err != nil {
 		return ident, err
 	}
-	newIdent.ConnectorData = ident.ConnectorData
-
 	if s.Groups {
 		groups, err := c.groups(ctx, user)
 		if err != nil {
 			return connector.Identity{}, fmt.Errorf("ldap: failed to query groups: %v", err)
 		}
-		newIdent.Groups = groups
+		ident.Groups = groups
 	}
-	return newIdent, nil
+	return ident, nil
 }
 
 func (c *ldapConnector) groups(ctx context.Context, user ldap.Entry) ([]string, error) {
@@ -578,10 +981,55 @@ func (c *ldapConnector) groups(ctx context.Context, user ldap.Entry) ([]string,
 		return nil, nil
 	}
 
+	var groups []*ldap.Entry
+	if err := c.do(ctx, func(conn *ldap.Conn) error {
+		var err error
+		if c.GroupSearch.NestedGroups.Strategy == nestedGroupsIterative {
+			groups, err = c.nestedGroupEntries(conn, user)
+		} else {
+			groups, err = c.groupEntries(conn, user, false)
+		}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	groupNames := make([]string, 0, len(groups))
+	for _, group := range groups {
+		name := getAttr(*group, c.GroupSearch.NameAttr)
+		if name == "" {
+			// Be obnoxious about missing missing attributes. If the group entry is
+			// missing its name attribute, that indicates a misconfiguration.
+			//
+			// In the future we can add configuration options to just log these errors.
+			return nil, fmt.Errorf("ldap: group entity %q missing required attribute %q",
+				group.DN, c.GroupSearch.NameAttr)
+		}
+
+		groupNames = append(groupNames, name)
+	}
+	return groupNames, nil
+}
+
+// groupEntries searches for the groups which have the entry, either the user or
+// a group, as a member according to the user matchers.
+func (c *ldapConnector) groupEntries(conn *ldap.Conn, entry ldap.Entry, isGroup bool) ([]*ldap.Entry, error) {
+	attrs := []string{c.GroupSearch.NameAttr}
+	if c.GroupSearch.NestedGroups.Strategy == nestedGroupsIterative {
+		// The groups found are searched for with the user matchers in turn.
+		for _, matcher := range c.GroupSearch.UserMatchers {
+			attrs = append(attrs, matcher.UserAttr)
+		}
+	}
+
 	var groups []*ldap.Entry
 	for _, matcher := range c.GroupSearch.UserMatchers {
-		for _, attr := range getAttrs(user, matcher.UserAttr) {
-			filter := fmt.Sprintf("(%s=%s)", matcher.GroupAttr, ldap.EscapeFilter(attr))
+		groupAttr := matcher.GroupAttr
+		if c.GroupSearch.NestedGroups.Strategy == nestedGroupsInChain {
+			groupAttr += ":" + matchingRuleInChain + ":"
+		}
+		for _, attr := range getAttrs(entry, matcher.UserAttr) {
+			filter := fmt.Sprintf("(%s=%s)", groupAttr, ldap.EscapeFilter(attr))
 			if c.GroupSearch.Filter != "" {
 				filter = fmt.Sprintf("(&%s%s)", c.GroupSearch.Filter, filter)
 			}
@@ -590,45 +1038,66 @@ func (c *ldapConnector) groups(ctx context.Context, user ldap.Entry) ([]string,
 				BaseDN:     c.GroupSearch.BaseDN,
 				Filter:     filter,
 				Scope:      c.groupSearchScope,
-				Attributes: []string{c.GroupSearch.NameAttr},
+				Attributes: attrs,
 			}
 
-			gotGroups := false
-			if err := c.do(ctx, func(conn *ldap.Conn) error {
-				c.logger.Infof("performing ldap search %s %s %s",
-					req.BaseDN, scopeString(req.Scope), req.Filter)
-				resp, err := conn.Search(req)
-				if err != nil {
-					return fmt.Errorf("ldap: search failed: %v", err)
-				}
-				gotGroups = len(resp.Entries) != 0
-				groups = append(groups, resp.Entries...)
-				return nil
-			}); err != nil {
-				return nil, err
+			c.logger.Infof("performing ldap search %s %s %s",
+				req.BaseDN, scopeString(req.Scope), req.Filter)
+			resp, err := conn.SearchWithPaging(req, uint32(c.PageSize))
+			if err != nil {
+				return nil, fmt.Errorf("ldap: search failed: %v", err)
 			}
-			if !gotGroups {
+			// Most groups aren't members of other groups.
+			if len(resp.Entries) == 0 && !isGroup {
 				// TODO(ericchiang): Is this going to spam the logs?
 				c.logger.Errorf("ldap: groups search with filter %q returned no groups", filter)
 			}
+			groups = append(groups, resp.Entries...)
 		}
 	}
+	return groups, nil
+}
 
-	groupNames := make([]string, 0, len(groups))
-	for _, group := range groups {
-		name := getAttr(*group, c.GroupSearch.NameAttr)
-		if name == "" {
-			// Be obnoxious about missing missing attributes. If the group entry is
-			// missing its name attribute, that indicates a misconfiguration.
-			//
-			// In the future we can add configuration options to just log these errors.
-			return nil, fmt.Errorf("ldap: group entity %q missing required attribute %q",
-				group.DN, c.GroupSearch.NameAttr)
+// nestedGroupEntries returns the groups of the user and the groups those groups
+// are members of, level by level up to the maximum depth. Every group is
+// returned and searched for once, even if the groups form a cycle.
+func (c *ldapConnector) nestedGroupEntries(conn *ldap.Conn, user ldap.Entry) ([]*ldap.Entry, error) {
+	seen := make(map[string]bool)
+	var groups []*ldap.Entry
+	add := func(entries []*ldap.Entry) (added []*ldap.Entry) {
+		for _, e := range entries {
+			if seen[e.DN] {
+				continue
+			}
+			seen[e.DN] = true
+			groups = append(groups, e)
+			added = append(added, e)
 		}
+		return added
+	}
 
-		groupNames = append(groupNames, name)
+	found, err := c.groupEntries(conn, user, false)
+	if err != nil {
+		return nil, err
 	}
-	return groupNames, nil
+	level := add(found)
+	for depth := 1; len(level) > 0; depth++ {
+		if depth > c.GroupSearch.NestedGroups.MaxDepth {
+			c.logger.Warnf("ldap: groups of %q are nested deeper than the maximum depth of %d, ignoring the rest",
+				user.DN, c.GroupSearch.NestedGroups.MaxDepth)
+			break
+		}
+		var next []*ldap.Entry
+		for _, group := range level {
+			found, err := c.groupEntries(conn, *group, true)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, add(found)...)
+		}
+		level = next
+	}
+	return groups, nil
 }
 
 func (c *ldapConnector) Prompt() string {
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/go-ldap/ldap/v3"
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/sirupsen/logrus"
 
@@ -221,6 +224,42 @@ func TestGroupQuery(t *testing.T) {
 	runTests(t, connectLDAP, c, tests)
 }
 
+func TestNestedGroupQuery(t *testing.T) {
+	c := &Config{}
+	c.UserSearch.BaseDN = "ou=People,ou=TestNestedGroupQuery,dc=example,dc=org"
+	c.UserSearch.NameAttr = "cn"
+	c.UserSearch.EmailAttr = "mail"
+	c.UserSearch.IDAttr = "DN"
+	c.UserSearch.Username = "cn"
+	c.GroupSearch.BaseDN = "ou=Groups,ou=TestNestedGroupQuery,dc=example,dc=org"
+	c.GroupSearch.UserMatchers = []UserMatcher{
+		{
+			UserAttr:  "DN",
+			GroupAttr: "member",
+		},
+	}
+	c.GroupSearch.NameAttr = "cn"
+	c.GroupSearch.NestedGroups.Strategy = nestedGroupsIterative
+
+	tests := []subtest{
+		{
+			name:     "validpassword",
+			username: "jane",
+			password: "foo",
+			groups:   true,
+			want: connector.Identity{
+				UserID:        "cn=jane,ou=People,ou=TestNestedGroupQuery,dc=example,dc=org",
+				Username:      "jane",
+				Email:         "janedoe@example.com",
+				EmailVerified: true,
+				Groups:        []string{"developers", "engineering", "all"},
+			},
+		},
+	}
+
+	runTests(t, connectLDAP, c, tests)
+}
+
 func TestGroupsOnUserEntity(t *testing.T) {
 	c := &Config{}
 	c.UserSearch.BaseDN = "ou=People,ou=TestGroupsOnUserEntity,dc=example,dc=org"
@@ -557,7 +596,7 @@ func runTests(t *testing.T, connMethod connectionMethod, config *Config, tests [
 
 	l := &logrus.Logger{Out: io.Discard, Formatter: &logrus.TextFormatter{}}
 
-	conn, err := c.openConnector(l)
+	conn, err := c.openConnector("ldap", l)
 	if err != nil {
 		t.Errorf("open connector: %v", err)
 	}
@@ -614,3 +653,266 @@ func runTests(t *testing.T, connMethod connectionMethod, config *Config, tests [
 		})
 	}
 }
+
+// fakeDirectory answers searches of a fakeServer from a list of entries. It
+// only supports filters of the form "(attr=value)", optionally with the in
+// chain matching rule, and base object searches of "(objectClass=*)".
+type fakeDirectory struct {
+	mu      sync.Mutex
+	entries []*ldap.Entry
+}
+
+func (d *fakeDirectory) setAttr(dn, name string, values ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range d.entries {
+		if e.DN != dn {
+			continue
+		}
+		for _, a := range e.Attributes {
+			if a.Name == name {
+				a.Values = values
+				return
+			}
+		}
+		e.Attributes = append(e.Attributes, &ldap.EntryAttribute{Name: name, Values: values})
+	}
+}
+
+func (d *fakeDirectory) search(baseDN, filter string) []*ldap.Entry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if filter == "(objectClass=*)" {
+		for _, e := range d.entries {
+			if e.DN == baseDN {
+				return []*ldap.Entry{copyEntry(e)}
+			}
+		}
+		return nil
+	}
+
+	parts := strings.SplitN(strings.TrimSuffix(strings.TrimPrefix(filter, "("), ")"), "=", 2)
+	attr, value := parts[0], parts[1]
+	inChain := strings.HasSuffix(attr, ":"+matchingRuleInChain+":")
+	attr = strings.TrimSuffix(attr, ":"+matchingRuleInChain+":")
+
+	var found []*ldap.Entry
+	seen := make(map[string]bool)
+	values := []string{value}
+	for len(values) > 0 {
+		var next []string
+		for _, e := range d.entries {
+			if seen[e.DN] || !strings.HasSuffix(e.DN, baseDN) {
+				continue
+			}
+			for _, v := range values {
+				if contains(getAttrs(*e, attr), v) {
+					seen[e.DN] = true
+					found = append(found, copyEntry(e))
+					next = append(next, e.DN)
+					break
+				}
+			}
+		}
+		if !inChain {
+			break
+		}
+		values = next
+	}
+	return found
+}
+
+// copyEntry copies entries for the server, which encodes them after the lock
+// is released.
+func copyEntry(e *ldap.Entry) *ldap.Entry {
+	c := &ldap.Entry{DN: e.DN}
+	for _, a := range e.Attributes {
+		c.Attributes = append(c.Attributes, &ldap.EntryAttribute{Name: a.Name, Values: append([]string(nil), a.Values...)})
+	}
+	return c
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func newFakeDirectory() *fakeDirectory {
+	entry := func(dn string, attrs map[string][]string) *ldap.Entry {
+		e := &ldap.Entry{DN: dn}
+		for name, values := range attrs {
+			e.Attributes = append(e.Attributes, &ldap.EntryAttribute{Name: name, Values: values})
+		}
+		return e
+	}
+	return &fakeDirectory{entries: []*ldap.Entry{
+		entry("cn=jane,ou=People,dc=example,dc=org", map[string][]string{
+			"cn": {"jane"}, "mail": {"janedoe@example.com"}, "userAccountControl": {"512"},
+		}),
+		entry("cn=developers,ou=Groups,dc=example,dc=org", map[string][]string{
+			"cn": {"developers"}, "member": {"cn=jane,ou=People,dc=example,dc=org"},
+		}),
+		entry("cn=engineering,ou=Groups,dc=example,dc=org", map[string][]string{
+			"cn": {"engineering"}, "member": {"cn=developers,ou=Groups,dc=example,dc=org", "cn=staff,ou=Groups,dc=example,dc=org"},
+		}),
+		// A cycle with engineering.
+		entry("cn=staff,ou=Groups,dc=example,dc=org", map[string][]string{
+			"cn": {"staff"}, "member": {"cn=engineering,ou=Groups,dc=example,dc=org"},
+		}),
+		entry("cn=all,ou=Groups,dc=example,dc=org", map[string][]string{
+			"cn": {"all"}, "member": {"cn=staff,ou=Groups,dc=example,dc=org"},
+		}),
+	}}
+}
+
+func nestedGroupsConfig(dir *fakeDirectory, t *testing.T) *Config {
+	server := startFakeServer(t, &fakeServer{search: dir.search})
+	c := &Config{Host: server.addr}
+	c.UserSearch.NameAttr = "cn"
+	c.UserSearch.EmailAttr = "mail"
+	c.UserSearch.IDAttr = "DN"
+	c.GroupSearch.BaseDN = "ou=Groups,dc=example,dc=org"
+	c.GroupSearch.UserMatchers = []UserMatcher{
+		{
+			UserAttr:  "DN",
+			GroupAttr: "member",
+		},
+	}
+	c.GroupSearch.NameAttr = "cn"
+	return c
+}
+
+func TestNestedGroups(t *testing.T) {
+	tests := []struct {
+		strategy string
+		maxDepth int
+		want     []string
+	}{
+		{"", 0, []string{"developers"}},
+		{nestedGroupsIterative, 0, []string{"developers", "engineering", "staff", "all"}},
+		{nestedGroupsIterative, 1, []string{"developers", "engineering"}},
+		{nestedGroupsInChain, 0, []string{"developers", "engineering", "staff", "all"}},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%q depth %d", tc.strategy, tc.maxDepth), func(t *testing.T) {
+			c := nestedGroupsConfig(newFakeDirectory(), t)
+			c.GroupSearch.NestedGroups.Strategy = tc.strategy
+			c.GroupSearch.NestedGroups.MaxDepth = tc.maxDepth
+			conn := openTestConnector(t, "nested", c)
+
+			ident, validPW, err := conn.Login(context.Background(), connector.Scopes{Groups: true}, "jane", "foo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !validPW {
+				t.Fatal("invalid password")
+			}
+			if diff := pretty.Compare(tc.want, ident.Groups); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestActiveDirectoryAccountStatus(t *testing.T) {
+	const dn = "cn=jane,ou=People,dc=example,dc=org"
+	dir := newFakeDirectory()
+	c := nestedGroupsConfig(dir, t)
+	c.ActiveDirectory = true
+	conn := openTestConnector(t, "ad", c)
+
+	s := connector.Scopes{OfflineAccess: true}
+	ident, validPW, err := conn.Login(context.Background(), s, "jane", "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !validPW {
+		t.Fatal("invalid password")
+	}
+
+	// Lockouts are reported by the computed attribute.
+	dir.setAttr(dn, "msDS-User-Account-Control-Computed", "16")
+	if _, err := conn.Refresh(context.Background(), s, ident); err == nil {
+		t.Error("expected refresh of locked out account to fail")
+	}
+	if _, validPW, err := conn.Login(context.Background(), s, "jane", "foo"); err != nil || validPW {
+		t.Errorf("expected login of locked out account to be rejected, got %v %v", validPW, err)
+	}
+
+	dir.setAttr(dn, "msDS-User-Account-Control-Computed", "0")
+	if _, err := conn.Refresh(context.Background(), s, ident); err != nil {
+		t.Errorf("refresh failed: %v", err)
+	}
+
+	dir.setAttr(dn, "userAccountControl", "514")
+	if _, err := conn.Refresh(context.Background(), s, ident); err == nil {
+		t.Error("expected refresh of disabled account to fail")
+	}
+	if _, validPW, err := conn.Login(context.Background(), s, "jane", "foo"); err != nil || validPW {
+		t.Errorf("expected login of disabled account to be rejected, got %v %v", validPW, err)
+	}
+}
+
+func TestLookupUser(t *testing.T) {
+	const dn = "cn=jane,ou=People,dc=example,dc=org"
+	dir := newFakeDirectory()
+	c := nestedGroupsConfig(dir, t)
+	c.ActiveDirectory = true
+	conn := openTestConnector(t, "lookup", c)
+	ctx := context.Background()
+
+	ident, err := conn.LookupUser(ctx, connector.Scopes{Groups: true}, "jane")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := connector.Identity{
+		UserID:        dn,
+		Username:      "jane",
+		Email:         "janedoe@example.com",
+		EmailVerified: true,
+		Groups:        []string{"developers"},
+	}
+	if diff := pretty.Compare(want, ident); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err := conn.LookupUser(ctx, connector.Scopes{}, "john"); err == nil {
+		t.Error("expected lookup of unknown user to fail")
+	}
+	dir.setAttr(dn, "userAccountControl", "514")
+	if _, err := conn.LookupUser(ctx, connector.Scopes{}, "jane"); err == nil {
+		t.Error("expected lookup of disabled account to fail")
+	}
+}
+
+func TestPagedGroupSearch(t *testing.T) {
+	dir := newFakeDirectory()
+	want := []string{"developers"}
+	for i := 0; i < 25; i++ {
+		name := fmt.Sprintf("group-%02d", i)
+		dir.entries = append(dir.entries, &ldap.Entry{
+			DN: "cn=" + name + ",ou=Groups,dc=example,dc=org",
+			Attributes: []*ldap.EntryAttribute{
+				{Name: "cn", Values: []string{name}},
+				{Name: "member", Values: []string{"cn=jane,ou=People,dc=example,dc=org"}},
+			},
+		})
+		want = append(want, name)
+	}
+	c := nestedGroupsConfig(dir, t)
+	c.PageSize = 10
+	conn := openTestConnector(t, "paged", c)
+
+	ident, _, err := conn.Login(context.Background(), connector.Scopes{Groups: true}, "jane", "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := pretty.Compare(want, ident.Groups); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,110 @@
+package ldap
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/dexidp/dex/connector"
+)
+
+// Methods of changing passwords.
+const (
+	passwordChangeModify     = "passwordModify"
+	passwordChangeUnicodePwd = "unicodePwd"
+)
+
+// passwordChangeConnector is opened instead of an ldapConnector if password
+// changes are enabled.
+type passwordChangeConnector struct {
+	*ldapConnector
+}
+
+var _ connector.PasswordChangeConnector = passwordChangeConnector{}
+
+func (c passwordChangeConnector) ChangePassword(ctx context.Context, username, oldPassword, newPassword string) (bool, error) {
+	// make this check to avoid unauthenticated bind to the LDAP server.
+	if oldPassword == "" {
+		return false, nil
+	}
+
+	incorrectPass := false
+	change := func(conn *ldap.Conn) error {
+		user, found, err := c.userEntry(conn, username)
+		if err != nil {
+			return err
+		}
+		if !found {
+			incorrectPass = true
+			return nil
+		}
+
+		if c.PasswordChange == passwordChangeUnicodePwd {
+			// Deleting the old value and adding the new one is a change rather than a
+			// reset, which any account may request if it knows the old password.
+			req := ldap.NewModifyRequest(user.DN, nil)
+			req.Delete("unicodePwd", []string{encodeUnicodePwd(oldPassword)})
+			req.Add("unicodePwd", []string{encodeUnicodePwd(newPassword)})
+			err = conn.Modify(req)
+		} else {
+			var expired bool
+			if expired, err = c.userBind(conn, user.DN, oldPassword); err != nil {
+				if expired {
+					return fmt.Errorf("ldap: password of user %q expired and can't be used to change it: %v", user.DN, err)
+				}
+				if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+					c.logger.Errorf("ldap: invalid password for user %q", user.DN)
+					incorrectPass = true
+					return nil
+				}
+				return fmt.Errorf("ldap: failed to bind as dn %q: %v", user.DN, err)
+			}
+			_, err = conn.PasswordModify(ldap.NewPasswordModifyRequest("", oldPassword, newPassword))
+		}
+
+		var ldapErr *ldap.Error
+		switch {
+		case err == nil:
+			c.logger.Infof("ldap: changed password of user %q", user.DN)
+			return nil
+		case !errors.As(err, &ldapErr):
+		case ldapErr.ResultCode == ldap.LDAPResultInvalidCredentials,
+			// ERROR_INVALID_PASSWORD of Active Directory, for a wrong old password.
+			ldapErr.ResultCode == ldap.LDAPResultConstraintViolation && strings.Contains(err.Error(), "0000056B"):
+			c.logger.Errorf("ldap: invalid password for user %q", user.DN)
+			incorrectPass = true
+			return nil
+		case ldapErr.ResultCode == ldap.LDAPResultConstraintViolation,
+			ldapErr.ResultCode == ldap.LDAPResultUnwillingToPerform:
+			return fmt.Errorf("ldap: new password of user %q rejected: %v: %w", user.DN, err, connector.ErrPasswordRejected)
+		}
+		return fmt.Errorf("ldap: failed to change password of user %q: %v", user.DN, err)
+	}
+
+	var err error
+	if c.PasswordChange == passwordChangeUnicodePwd {
+		err = c.do(ctx, change)
+	} else {
+		err = c.doUserBind(ctx, change)
+	}
+	if err != nil {
+		return false, err
+	}
+	return !incorrectPass, nil
+}
+
+// encodeUnicodePwd encodes a password for the unicodePwd attribute of Active
+// Directory, which takes it quoted and in UTF-16LE.
+func encodeUnicodePwd(password string) string {
+	u := utf16.Encode([]rune(`"` + password + `"`))
+	b := make([]byte, 2*len(u))
+	for i, r := range u {
+		binary.LittleEndian.PutUint16(b[2*i:], r)
+	}
+	return string(b)
+}
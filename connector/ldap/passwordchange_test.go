@@ -0,0 +1,193 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/dexidp/dex/connector"
+)
+
+const janeDN = "cn=jane,ou=People,dc=example,dc=org"
+
+// passwordTestConfig returns a config for the users of newFakeDirectory.
+func passwordTestConfig(server *fakeServer) *Config {
+	c := &Config{Host: server.addr}
+	c.UserSearch.IDAttr = "DN"
+	c.UserSearch.EmailAttr = "mail"
+	c.UserSearch.NameAttr = "cn"
+	return c
+}
+
+func TestPasswordExpired(t *testing.T) {
+	expired := int8(ldap.BeheraPasswordExpired)
+	changeAfterReset := int8(ldap.BeheraChangeAfterReset)
+	tests := []struct {
+		name    string
+		result  fakeResult
+		validPW bool
+		expired bool
+	}{
+		{"valid", fakeResult{}, true, false},
+		{"invalid", fakeResult{code: ldap.LDAPResultInvalidCredentials}, false, false},
+		{"password policy expired", fakeResult{code: ldap.LDAPResultInvalidCredentials, policyError: &expired}, false, true},
+		{"password policy change after reset", fakeResult{policyError: &changeAfterReset}, false, true},
+		{"active directory expired", fakeResult{
+			code: ldap.LDAPResultInvalidCredentials,
+			msg:  "80090308: LdapErr: DSID-0C09044E, comment: AcceptSecurityContext error, data 532, v4563",
+		}, false, true},
+		{"active directory must change", fakeResult{
+			code: ldap.LDAPResultInvalidCredentials,
+			msg:  "80090308: LdapErr: DSID-0C09044E, comment: AcceptSecurityContext error, data 773, v4563",
+		}, false, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := startFakeServer(t, &fakeServer{
+				search: newFakeDirectory().search,
+				bind: func(dn, password string) fakeResult {
+					if dn == janeDN {
+						return tc.result
+					}
+					return fakeResult{}
+				},
+			})
+			conn := openTestConnector(t, "expired", passwordTestConfig(server))
+
+			_, validPW, err := conn.Login(context.Background(), connector.Scopes{}, "jane", "foo")
+			if tc.expired {
+				if !errors.Is(err, connector.ErrPasswordExpired) {
+					t.Fatalf("expected an expired password, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if validPW != tc.validPW {
+				t.Errorf("expected valid password %v, got %v", tc.validPW, validPW)
+			}
+		})
+	}
+}
+
+// passwordServer is a directory with a single user, jane, whose password can
+// be changed with either method.
+type passwordServer struct {
+	mu       sync.Mutex
+	password string
+	// Result of the next change, if it's to fail.
+	changeResult *fakeResult
+}
+
+func (p *passwordServer) setChangeResult(r *fakeResult) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.changeResult = r
+}
+
+func (p *passwordServer) currentPassword() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.password
+}
+
+func (p *passwordServer) bind(dn, password string) fakeResult {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if dn == janeDN && password != p.password {
+		return fakeResult{code: ldap.LDAPResultInvalidCredentials}
+	}
+	return fakeResult{}
+}
+
+func (p *passwordServer) modify(op *ber.Packet) fakeResult {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.changeResult != nil {
+		return *p.changeResult
+	}
+
+	if op.Tag == ldap.ApplicationModifyRequest {
+		// A delete of the old unicodePwd followed by an add of the new one.
+		changes := op.Children[1].Children
+		if len(changes) != 2 {
+			return fakeResult{code: ldap.LDAPResultProtocolError}
+		}
+		value := func(change *ber.Packet) string {
+			return change.Children[1].Children[1].Children[0].Data.String()
+		}
+		if value(changes[0]) != encodeUnicodePwd(p.password) {
+			return fakeResult{code: ldap.LDAPResultConstraintViolation, msg: "00000056: AtrErr: DSID-03190F80, #1:\n\t0: 0000056B"}
+		}
+		p.password = value(changes[1])
+		return fakeResult{}
+	}
+
+	// The request value of a password modify request is a sequence of the
+	// optional user, the old and the new password.
+	value, err := ber.DecodePacketErr(op.Children[1].Data.Bytes())
+	if err != nil {
+		return fakeResult{code: ldap.LDAPResultProtocolError}
+	}
+	for _, field := range value.Children {
+		if field.Tag == 2 {
+			p.password = field.Data.String()
+		}
+	}
+	return fakeResult{}
+}
+
+func TestChangePassword(t *testing.T) {
+	for _, method := range []string{passwordChangeModify, passwordChangeUnicodePwd} {
+		t.Run(method, func(t *testing.T) {
+			ps := &passwordServer{password: "foo"}
+			server := startFakeServer(t, &fakeServer{
+				search: newFakeDirectory().search,
+				bind:   ps.bind,
+				modify: ps.modify,
+			})
+			conn := openTestConnector(t, "change", passwordTestConfig(server))
+			// unicodePwd can't be changed over unencrypted connections, which the
+			// fake server doesn't check.
+			conn.PasswordChange = method
+			c := passwordChangeConnector{conn}
+			ctx := context.Background()
+
+			if ok, err := c.ChangePassword(ctx, "jane", "wrong", "bar"); err != nil || ok {
+				t.Errorf("expected a wrong password to be rejected, got %v %v", ok, err)
+			}
+			if ok, err := c.ChangePassword(ctx, "john", "foo", "bar"); err != nil || ok {
+				t.Errorf("expected an unknown user to be rejected, got %v %v", ok, err)
+			}
+
+			ps.setChangeResult(&fakeResult{code: ldap.LDAPResultConstraintViolation, msg: "password too short"})
+			if _, err := c.ChangePassword(ctx, "jane", "foo", "b"); !errors.Is(err, connector.ErrPasswordRejected) {
+				t.Errorf("expected the new password to be rejected, got %v", err)
+			}
+			ps.setChangeResult(nil)
+
+			if ok, err := c.ChangePassword(ctx, "jane", "foo", "bar"); err != nil || !ok {
+				t.Fatalf("failed to change password: %v %v", ok, err)
+			}
+			want := "bar"
+			if method == passwordChangeUnicodePwd {
+				want = encodeUnicodePwd("bar")
+			}
+			if got := ps.currentPassword(); got != want {
+				t.Errorf("expected password %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestEncodeUnicodePwd(t *testing.T) {
+	want := "\"\x00p\x00\xe4\x00s\x00s\x00\"\x00"
+	if got := encodeUnicodePwd("päss"); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
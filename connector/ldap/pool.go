@@ -0,0 +1,199 @@
+package ldap
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	poolConnections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ldap_pool_connections",
+		Help: "Number of pooled LDAP connections, by connector and whether they're in use or idle.",
+	}, []string{"connector", "state"})
+	poolWaits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ldap_pool_waits_total",
+		Help: "Count of LDAP operations which waited for a pooled connection to be released.",
+	}, []string{"connector"})
+	poolHealthCheckFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ldap_pool_health_check_failures_total",
+		Help: "Count of idle LDAP connections which were closed because they failed a health check.",
+	}, []string{"connector"})
+	failovers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ldap_failovers_total",
+		Help: "Count of failed connections to an LDAP server after which the next server was tried, by connector and server.",
+	}, []string{"connector", "host"})
+)
+
+// Collectors returns the metrics of the connection pools and server failovers
+// of all LDAP connectors.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{poolConnections, poolWaits, poolHealthCheckFailures, failovers}
+}
+
+type pooledConn struct {
+	conn     *ldap.Conn
+	lastUsed time.Time
+}
+
+// connPool keeps connections bound as the service account for reuse. At most
+// max connections are open at a time, further callers wait for one to be
+// released.
+type connPool struct {
+	// Dials a new connection bound as the service account.
+	dial func(ctx context.Context) (*ldap.Conn, error)
+
+	// Idle connections are closed after maxIdleTime, and checked with a search of
+	// the root DSE before they're reused if they were idle for healthCheckInterval.
+	maxIdleTime         time.Duration
+	healthCheckInterval time.Duration
+
+	connectorID string
+	now         func() time.Time
+
+	// Holds a value for every connection in use.
+	slots chan struct{}
+
+	mu   sync.Mutex
+	idle []*pooledConn
+	// Set once the connector was replaced. Connections in use are closed when
+	// they're released, and the metrics are left to the new pool.
+	closed bool
+}
+
+func newConnPool(connectorID string, max int, maxIdleTime, healthCheckInterval time.Duration, dial func(ctx context.Context) (*ldap.Conn, error)) *connPool {
+	return &connPool{
+		dial:                dial,
+		maxIdleTime:         maxIdleTime,
+		healthCheckInterval: healthCheckInterval,
+		connectorID:         connectorID,
+		now:                 time.Now,
+		slots:               make(chan struct{}, max),
+	}
+}
+
+// get returns an idle connection which passes the health check, or dials a
+// new one.
+func (p *connPool) get(ctx context.Context) (*pooledConn, error) {
+	select {
+	case p.slots <- struct{}{}:
+	default:
+		poolWaits.WithLabelValues(p.connectorID).Inc()
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	for {
+		pc := p.popIdle()
+		if pc == nil {
+			break
+		}
+		if p.healthy(pc) {
+			p.updateMetrics()
+			return pc, nil
+		}
+		pc.conn.Close()
+	}
+
+	conn, err := p.dial(ctx)
+	if err != nil {
+		<-p.slots
+		p.updateMetrics()
+		return nil, err
+	}
+	p.updateMetrics()
+	return &pooledConn{conn: conn}, nil
+}
+
+// put releases a connection from get. If reuse is false the connection is
+// closed instead of being kept for later operations.
+func (p *connPool) put(pc *pooledConn, reuse bool) {
+	if reuse && !pc.conn.IsClosing() {
+		pc.lastUsed = p.now()
+		p.mu.Lock()
+		if !p.closed {
+			p.idle = append(p.idle, pc)
+			pc = nil
+		}
+		p.mu.Unlock()
+	}
+	if pc != nil {
+		pc.conn.Close()
+	}
+	<-p.slots
+	p.updateMetrics()
+}
+
+// close closes the idle connections, and those in use once they're released.
+// Operations still running on a closed pool dial new connections.
+func (p *connPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, pc := range p.idle {
+		pc.conn.Close()
+	}
+	p.idle = nil
+	p.closed = true
+}
+
+// popIdle returns the most recently used idle connection, closing those which
+// were idle for too long.
+func (p *connPool) popIdle() *pooledConn {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	n := 0
+	for _, pc := range p.idle {
+		if p.maxIdleTime > 0 && now.Sub(pc.lastUsed) > p.maxIdleTime {
+			pc.conn.Close()
+			continue
+		}
+		p.idle[n] = pc
+		n++
+	}
+	p.idle = p.idle[:n]
+
+	if n == 0 {
+		return nil
+	}
+	pc := p.idle[n-1]
+	p.idle = p.idle[:n-1]
+	return pc
+}
+
+func (p *connPool) healthy(pc *pooledConn) bool {
+	if pc.conn.IsClosing() {
+		return false
+	}
+	if p.now().Sub(pc.lastUsed) < p.healthCheckInterval {
+		return true
+	}
+	_, err := pc.conn.Search(&ldap.SearchRequest{
+		Scope:      ldap.ScopeBaseObject,
+		Filter:     "(objectClass=*)",
+		Attributes: []string{"1.1"},
+	})
+	if err != nil {
+		poolHealthCheckFailures.WithLabelValues(p.connectorID).Inc()
+		return false
+	}
+	return true
+}
+
+func (p *connPool) updateMetrics() {
+	p.mu.Lock()
+	idle, closed := len(p.idle), p.closed
+	p.mu.Unlock()
+	if closed {
+		return
+	}
+	poolConnections.WithLabelValues(p.connectorID, "in_use").Set(float64(len(p.slots)))
+	poolConnections.WithLabelValues(p.connectorID, "idle").Set(float64(idle))
+}
@@ -0,0 +1,367 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+)
+
+// fakeServer is an LDAP server for tests. By default it accepts any bind,
+// modify and password modify request and returns no entries for searches.
+// Searches with the paged results control are answered in pages.
+type fakeServer struct {
+	addr     string
+	accepted int32
+	searches int32
+
+	// Entries returned by searches.
+	search func(baseDN, filter string) []*ldap.Entry
+	// Results of binds, and of modify and password modify requests.
+	bind   func(dn, password string) fakeResult
+	modify func(req *ber.Packet) fakeResult
+}
+
+type fakeResult struct {
+	code uint16
+	msg  string
+	// Error of the password policy response control, if any.
+	policyError *int8
+}
+
+// startFakeServer starts the server, which must not be changed afterwards.
+func startFakeServer(t *testing.T, s *fakeServer) *fakeServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s.addr = l.Addr().String()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&s.accepted, 1)
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeServer) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		req, err := ber.ReadPacket(conn)
+		if err != nil || len(req.Children) < 2 {
+			return
+		}
+		id := req.Children[0].Value
+		op := req.Children[1]
+
+		var (
+			tag      ber.Tag
+			result   fakeResult
+			controls []ldap.Control
+		)
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			tag = ldap.ApplicationBindResponse
+			if s.bind != nil {
+				result = s.bind(op.Children[1].Value.(string), op.Children[2].Data.String())
+			}
+		case ldap.ApplicationModifyRequest, ldap.ApplicationExtendedRequest:
+			tag = op.Tag + 1
+			if s.modify != nil {
+				result = s.modify(op)
+			}
+		case ldap.ApplicationSearchRequest:
+			atomic.AddInt32(&s.searches, 1)
+			tag = ldap.ApplicationSearchResultDone
+			if s.search == nil {
+				break
+			}
+			filter, err := ldap.DecompileFilter(op.Children[6])
+			if err != nil {
+				return
+			}
+			entries := s.search(op.Children[0].Value.(string), filter)
+			if paging := requestPaging(req); paging != nil && paging.PagingSize > 0 {
+				start, _ := strconv.Atoi(string(paging.Cookie))
+				end := start + int(paging.PagingSize)
+				cookie := strconv.Itoa(end)
+				if end >= len(entries) {
+					end, cookie = len(entries), ""
+				}
+				entries = entries[start:end]
+				controls = append(controls, &ldap.ControlPaging{Cookie: []byte(cookie)})
+			}
+			for _, e := range entries {
+				if _, err := conn.Write(searchResultEntry(id, e).Bytes()); err != nil {
+					return
+				}
+			}
+		default:
+			return
+		}
+
+		resp := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+		resp.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+		res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+		res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(result.code), "Result Code"))
+		res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+		res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, result.msg, "Diagnostic Message"))
+		resp.AppendChild(res)
+		if result.policyError != nil || len(controls) > 0 {
+			packet := ber.Encode(ber.ClassContext, ber.TypeConstructed, 0, nil, "Controls")
+			for _, c := range controls {
+				packet.AppendChild(c.Encode())
+			}
+			if result.policyError != nil {
+				packet.AppendChild(passwordPolicyControl(*result.policyError))
+			}
+			resp.AppendChild(packet)
+		}
+		if _, err := conn.Write(resp.Bytes()); err != nil {
+			return
+		}
+	}
+}
+
+// requestPaging returns the paged results control of a request, if any.
+func requestPaging(req *ber.Packet) *ldap.ControlPaging {
+	if len(req.Children) < 3 {
+		return nil
+	}
+	for _, child := range req.Children[2].Children {
+		if c, err := ldap.DecodeControl(child); err == nil {
+			if paging, ok := c.(*ldap.ControlPaging); ok {
+				return paging
+			}
+		}
+	}
+	return nil
+}
+
+// passwordPolicyControl encodes a password policy response control, which
+// go-ldap can only decode.
+func passwordPolicyControl(policyError int8) *ber.Packet {
+	value := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Password Policy Response")
+	value.AppendChild(ber.NewInteger(ber.ClassContext, ber.TypePrimitive, 1, int64(policyError), "Error"))
+	control := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Control")
+	control.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, ldap.ControlTypeBeheraPasswordPolicy, "Control Type"))
+	control.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, string(value.Bytes()), "Control Value"))
+	return control
+}
+
+func searchResultEntry(id interface{}, e *ldap.Entry) *ber.Packet {
+	resp := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	resp.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Entry")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "DN"))
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for _, a := range e.Attributes {
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, a.Name, "Type"))
+		values := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range a.Values {
+			values.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(values)
+		attrs.AppendChild(attr)
+	}
+	entry.AppendChild(attrs)
+	resp.AppendChild(entry)
+	return resp
+}
+
+// unreachableAddr returns an address nothing listens on.
+func unreachableAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	return addr
+}
+
+func openTestConnector(t *testing.T, id string, c *Config) *ldapConnector {
+	c.InsecureNoSSL = true
+	c.BindDN = "cn=admin,dc=example,dc=org"
+	c.BindPW = "admin"
+	c.UserSearch.BaseDN = "ou=People,dc=example,dc=org"
+	c.UserSearch.Username = "cn"
+
+	l := &logrus.Logger{Out: io.Discard, Formatter: &logrus.TextFormatter{}}
+	conn, err := c.openConnector(id, l)
+	if err != nil {
+		t.Fatalf("open connector: %v", err)
+	}
+	return conn
+}
+
+func search(conn *ldap.Conn) error {
+	_, err := conn.Search(&ldap.SearchRequest{
+		BaseDN: "ou=People,dc=example,dc=org",
+		Scope:  ldap.ScopeWholeSubtree,
+		Filter: "(cn=jane)",
+	})
+	return err
+}
+
+func TestFailover(t *testing.T) {
+	down := unreachableAddr(t)
+	server := startFakeServer(t, &fakeServer{})
+
+	c := openTestConnector(t, "failover", &Config{Host: down, Hosts: []string{server.addr}})
+
+	for i := 0; i < 2; i++ {
+		if err := c.do(context.Background(), search); err != nil {
+			t.Fatalf("search %d failed: %v", i, err)
+		}
+	}
+
+	if got := testutil.ToFloat64(failovers.WithLabelValues("failover", down)); got != 1 {
+		t.Errorf("expected 1 failover, got %v", got)
+	}
+	if got := atomic.LoadInt32(&server.accepted); got != 1 {
+		t.Errorf("expected the connection to be reused, got %d connections", got)
+	}
+	// The server which couldn't be reached is tried last from now on.
+	if hosts := c.hosts.hosts(context.Background()); hosts[len(hosts)-1] != down {
+		t.Errorf("expected %s to be tried last, got %v", down, hosts)
+	}
+}
+
+func TestPoolBounded(t *testing.T) {
+	server := startFakeServer(t, &fakeServer{})
+
+	c := &Config{Host: server.addr}
+	c.ConnectionPool.MaxConnections = 1
+	conn := openTestConnector(t, "bounded", c)
+
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- conn.do(context.Background(), func(conn *ldap.Conn) error {
+			<-release
+			return nil
+		})
+	}()
+
+	waits := testutil.ToFloat64(poolWaits.WithLabelValues("bounded"))
+
+	// Wait until the only connection is in use.
+	for len(conn.pool.slots) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := conn.do(ctx, search)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the pool to be exhausted, got %v", err)
+	}
+	if got := testutil.ToFloat64(poolWaits.WithLabelValues("bounded")) - waits; got != 1 {
+		t.Errorf("expected 1 wait, got %v", got)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.do(context.Background(), search); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&server.accepted); got != 1 {
+		t.Errorf("expected the connection to be reused, got %d connections", got)
+	}
+}
+
+func TestPoolClose(t *testing.T) {
+	server := startFakeServer(t, &fakeServer{})
+	conn := openTestConnector(t, "close", &Config{Host: server.addr})
+
+	var pooled *ldap.Conn
+	if err := conn.do(context.Background(), func(c *ldap.Conn) error {
+		pooled = c
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !pooled.IsClosing() {
+		t.Error("expected idle connections to be closed")
+	}
+
+	// Operations still using the replaced connector work, their connections
+	// are closed afterwards.
+	var inUse *ldap.Conn
+	if err := conn.do(context.Background(), func(c *ldap.Conn) error {
+		inUse = c
+		return search(c)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !inUse.IsClosing() {
+		t.Error("expected the released connection to be closed")
+	}
+	if len(conn.pool.idle) != 0 {
+		t.Errorf("expected no idle connections, got %d", len(conn.pool.idle))
+	}
+}
+
+func TestPoolHealthCheck(t *testing.T) {
+	server := startFakeServer(t, &fakeServer{})
+	conn := openTestConnector(t, "healthcheck", &Config{Host: server.addr})
+
+	noop := func(*ldap.Conn) error { return nil }
+	if err := conn.do(context.Background(), noop); err != nil {
+		t.Fatal(err)
+	}
+
+	// A connection idle for longer than the health check interval is checked
+	// before it's reused.
+	now := time.Now()
+	conn.pool.now = func() time.Time { return now.Add(time.Minute) }
+	if err := conn.do(context.Background(), noop); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&server.searches); got != 1 {
+		t.Errorf("expected a health check, got %d searches", got)
+	}
+
+	// A connection idle for longer than the maximum idle time is replaced.
+	conn.pool.now = func() time.Time { return now.Add(time.Hour) }
+	if err := conn.do(context.Background(), noop); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&server.accepted); got != 2 {
+		t.Errorf("expected a new connection, got %d connections", got)
+	}
+
+	// Connections aren't reused after errors.
+	if err := conn.do(context.Background(), func(*ldap.Conn) error { return errors.New("failed") }); err == nil {
+		t.Fatal("expected an error")
+	}
+	if err := conn.do(context.Background(), noop); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&server.accepted); got != 3 {
+		t.Errorf("expected a new connection, got %d connections", got)
+	}
+}
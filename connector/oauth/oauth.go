@@ -17,6 +17,7 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/pkg/claims"
 	"github.com/dexidp/dex/pkg/log"
 )
 
@@ -28,12 +29,13 @@ type oauthConnector struct {
 	authorizationURL     string
 	userInfoURL          string
 	scopes               []string
-	userIDKey            string
-	userNameKey          string
-	preferredUsernameKey string
-	emailKey             string
-	emailVerifiedKey     string
-	groupsKey            string
+	userIDKey            claims.Path
+	userNameKey          claims.Path
+	preferredUsernameKey claims.Path
+	emailKey             claims.Path
+	emailVerifiedKey     claims.Path
+	groupsKey            claims.Path
+	groupsMapper         *claims.GroupsMapper
 	httpClient           *http.Client
 	logger               log.Logger
 }
@@ -53,12 +55,19 @@ type Config struct {
 	RootCAs            []string `json:"rootCAs"`
 	InsecureSkipVerify bool     `json:"insecureSkipVerify"`
 	UserIDKey          string   `json:"userIDKey"` // defaults to "id"
-	ClaimMapping       struct {
+
+	// The keys are paths to the claims, like "realm_access.roles" for nested claims.
+	ClaimMapping struct {
 		UserNameKey          string `json:"userNameKey"`          // defaults to "user_name"
 		PreferredUsernameKey string `json:"preferredUsernameKey"` // defaults to "preferred_username"
 		GroupsKey            string `json:"groupsKey"`            // defaults to "groups"
 		EmailKey             string `json:"emailKey"`             // defaults to "email"
 		EmailVerifiedKey     string `json:"emailVerifiedKey"`     // defaults to "email_verified"
+
+		// Prefix added to the groups, and a regular expression whole groups must
+		// match before the prefix is added.
+		GroupsPrefix string `json:"groupsPrefix"`
+		GroupsFilter string `json:"groupsFilter"`
 	} `json:"claimMapping"`
 }
 
@@ -96,20 +105,35 @@ func (c *Config) Open(id string, logger log.Logger) (connector.Connector, error)
 	}
 
 	oauthConn := &oauthConnector{
-		clientID:             c.ClientID,
-		clientSecret:         c.ClientSecret,
-		tokenURL:             c.TokenURL,
-		authorizationURL:     c.AuthorizationURL,
-		userInfoURL:          c.UserInfoURL,
-		scopes:               c.Scopes,
-		redirectURI:          c.RedirectURI,
-		logger:               logger,
-		userIDKey:            userIDKey,
-		userNameKey:          userNameKey,
-		preferredUsernameKey: preferredUsernameKey,
-		groupsKey:            groupsKey,
-		emailKey:             emailKey,
-		emailVerifiedKey:     emailVerifiedKey,
+		clientID:         c.ClientID,
+		clientSecret:     c.ClientSecret,
+		tokenURL:         c.TokenURL,
+		authorizationURL: c.AuthorizationURL,
+		userInfoURL:      c.UserInfoURL,
+		scopes:           c.Scopes,
+		redirectURI:      c.RedirectURI,
+		logger:           logger,
+	}
+
+	for _, p := range []struct {
+		path *claims.Path
+		expr string
+	}{
+		{&oauthConn.userIDKey, userIDKey},
+		{&oauthConn.userNameKey, userNameKey},
+		{&oauthConn.preferredUsernameKey, preferredUsernameKey},
+		{&oauthConn.groupsKey, groupsKey},
+		{&oauthConn.emailKey, emailKey},
+		{&oauthConn.emailVerifiedKey, emailVerifiedKey},
+	} {
+		if *p.path, err = claims.ParsePath(p.expr); err != nil {
+			return nil, fmt.Errorf("OAuth connector: invalid claim mapping: %v", err)
+		}
+	}
+
+	oauthConn.groupsMapper, err = claims.NewGroupsMapper(c.ClaimMapping.GroupsPrefix, c.ClaimMapping.GroupsFilter)
+	if err != nil {
+		return nil, fmt.Errorf("OAuth connector: invalid claim mapping: %v", err)
 	}
 
 	oauthConn.httpClient, err = newHTTPClient(c.RootCAs, c.InsecureSkipVerify)
@@ -209,16 +233,16 @@ func (c *oauthConnector) HandleCallback(s connector.Scopes, r *http.Request) (id
 		return identity, fmt.Errorf("OAuth Connector: failed to parse userinfo: %v", err)
 	}
 
-	userID, found := userInfoResult[c.userIDKey].(string)
+	userID, found := c.userIDKey.LookupString(userInfoResult)
 	if !found {
 		return identity, fmt.Errorf("OAuth Connector: not found %v claim", c.userIDKey)
 	}
 
 	identity.UserID = userID
-	identity.Username, _ = userInfoResult[c.userNameKey].(string)
-	identity.PreferredUsername, _ = userInfoResult[c.preferredUsernameKey].(string)
-	identity.Email, _ = userInfoResult[c.emailKey].(string)
-	identity.EmailVerified, _ = userInfoResult[c.emailVerifiedKey].(bool)
+	identity.Username, _ = c.userNameKey.LookupString(userInfoResult)
+	identity.PreferredUsername, _ = c.preferredUsernameKey.LookupString(userInfoResult)
+	identity.Email, _ = c.emailKey.LookupString(userInfoResult)
+	identity.EmailVerified, _ = c.emailVerifiedKey.LookupBool(userInfoResult)
 
 	if s.Groups {
 		groups := map[string]struct{}{}
@@ -226,9 +250,11 @@ func (c *oauthConnector) HandleCallback(s connector.Scopes, r *http.Request) (id
 		c.addGroupsFromMap(groups, userInfoResult)
 		c.addGroupsFromToken(groups, token.AccessToken)
 
+		var groupNames []string
 		for groupName := range groups {
-			identity.Groups = append(identity.Groups, groupName)
+			groupNames = append(groupNames, groupName)
 		}
+		identity.Groups = c.groupsMapper.Map(groupNames)
 	}
 
 	if s.OfflineAccess {
@@ -244,7 +270,8 @@ func (c *oauthConnector) HandleCallback(s connector.Scopes, r *http.Request) (id
 }
 
 func (c *oauthConnector) addGroupsFromMap(groups map[string]struct{}, result map[string]interface{}) error {
-	groupsClaim, ok := result[c.groupsKey].([]interface{})
+	v, _ := c.groupsKey.Lookup(result)
+	groupsClaim, ok := v.([]interface{})
 	if !ok {
 		return errors.New("cannot convert to slice")
 	}
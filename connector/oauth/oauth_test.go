@@ -168,6 +168,55 @@ func TestHandleCallBackForGroupsInToken(t *testing.T) {
 	assert.Equal(t, identity.EmailVerified, false)
 }
 
+func TestHandleCallBackForNestedClaims(t *testing.T) {
+	tokenClaims := map[string]interface{}{}
+
+	userInfoClaims := map[string]interface{}{
+		"user": map[string]interface{}{
+			"id":             "test-user-id",
+			"name":           "test-username",
+			"email":          "test-email",
+			"email_verified": true,
+		},
+		"realm_access": map[string]interface{}{
+			"roles": []string{"team-a", "offline_access"},
+		},
+	}
+
+	testServer := testSetup(t, tokenClaims, userInfoClaims)
+	defer testServer.Close()
+
+	testConfig := Config{
+		ClientID:         "testClient",
+		ClientSecret:     "testSecret",
+		RedirectURI:      testServer.URL + "/callback",
+		TokenURL:         testServer.URL + "/token",
+		AuthorizationURL: testServer.URL + "/authorize",
+		UserInfoURL:      testServer.URL + "/userinfo",
+		UserIDKey:        "user.id",
+	}
+	testConfig.ClaimMapping.UserNameKey = "user.name"
+	testConfig.ClaimMapping.EmailKey = `user["email"]`
+	testConfig.ClaimMapping.EmailVerifiedKey = "user.email_verified"
+	testConfig.ClaimMapping.GroupsKey = "realm_access.roles"
+	testConfig.ClaimMapping.GroupsPrefix = "oauth:"
+	testConfig.ClaimMapping.GroupsFilter = "team-.*"
+
+	conn, err := testConfig.Open("id", logrus.New())
+	assert.Equal(t, err, nil)
+
+	req := newRequestWithAuthCode(t, testServer.URL, "some-code")
+
+	identity, err := conn.(connector.CallbackConnector).HandleCallback(connector.Scopes{Groups: true}, req)
+	assert.Equal(t, err, nil)
+
+	assert.Equal(t, identity.Groups, []string{"oauth:team-a"})
+	assert.Equal(t, identity.UserID, "test-user-id")
+	assert.Equal(t, identity.Username, "test-username")
+	assert.Equal(t, identity.Email, "test-email")
+	assert.Equal(t, identity.EmailVerified, true)
+}
+
 func testSetup(t *testing.T, tokenClaims map[string]interface{}, userInfoClaims map[string]interface{}) *httptest.Server {
 	key, err := rsa.GenerateKey(rand.Reader, 1024)
 	if err != nil {
@@ -0,0 +1,216 @@
+package oidc
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+const (
+	clientAssertionTypeJWT = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// How long client assertions and generated client secrets are valid for.
+	// They're created for every request to the token endpoint.
+	clientJWTValidFor = 5 * time.Minute
+)
+
+// PrivateKeyJWT is the key dex signs client assertions with to authenticate to
+// the token endpoint.
+type PrivateKeyJWT struct {
+	// PEM encoded RSA or ECDSA private key.
+	KeyFile string `json:"keyFile"`
+	// ID the provider knows the key by, sent as "kid".
+	KeyID string `json:"keyID"`
+}
+
+// GeneratedClientSecret is a client secret dex generates as a JWT signed with an
+// ES256 key, which providers like Sign in with Apple require.
+type GeneratedClientSecret struct {
+	// PEM encoded P-256 private key, like the .p8 file Apple issues.
+	KeyFile string `json:"keyFile"`
+	// ID the provider knows the key by, sent as "kid".
+	KeyID string `json:"keyID"`
+	// Issuer of the secret, for Apple the team ID.
+	Issuer string `json:"issuer"`
+	// Audience of the secret. Defaults to the issuer of the provider.
+	Audience string `json:"audience"`
+}
+
+// clientJWTSigner signs the JWTs dex authenticates to the provider with.
+type clientJWTSigner struct {
+	key   crypto.Signer
+	alg   jose.SignatureAlgorithm
+	keyID string
+	now   func() time.Time
+}
+
+func newClientJWTSigner(keyFile, keyID string) (*clientJWTSigner, error) {
+	key, alg, err := loadSigningKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &clientJWTSigner{key: key, alg: alg, keyID: keyID, now: time.Now}, nil
+}
+
+// sign returns a JWT with the claims and the standard time claims.
+func (s *clientJWTSigner) sign(claims map[string]interface{}) (string, error) {
+	now := s.now()
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(clientJWTValidFor).Unix()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	key := jose.SigningKey{Algorithm: s.alg, Key: &jose.JSONWebKey{Key: s.key, KeyID: s.keyID}}
+	signer, err := jose.NewSigner(key, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return "", fmt.Errorf("new signer: %v", err)
+	}
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return "", fmt.Errorf("sign: %v", err)
+	}
+	return jws.CompactSerialize()
+}
+
+// clientAssertion returns the parameters of the private_key_jwt client
+// authentication.
+//
+// https://openid.net/specs/openid-connect-core-1_0.html#ClientAuthentication
+func (s *clientJWTSigner) clientAssertion(clientID, tokenURL string) (url.Values, error) {
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return nil, err
+	}
+	assertion, err := s.sign(map[string]interface{}{
+		"iss": clientID,
+		"sub": clientID,
+		"aud": tokenURL,
+		"jti": base64.RawURLEncoding.EncodeToString(jti),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("oidc: failed to create client assertion: %v", err)
+	}
+	return url.Values{
+		"client_assertion_type": {clientAssertionTypeJWT},
+		"client_assertion":      {assertion},
+	}, nil
+}
+
+// clientSecret returns a client secret generated as a JWT.
+//
+// https://developer.apple.com/documentation/accountorganizationaldatasharing/creating-a-client-secret
+func (s *clientJWTSigner) clientSecret(clientID, issuer, audience string) (url.Values, error) {
+	secret, err := s.sign(map[string]interface{}{
+		"iss": issuer,
+		"sub": clientID,
+		"aud": audience,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("oidc: failed to generate client secret: %v", err)
+	}
+	return url.Values{"client_secret": {secret}}, nil
+}
+
+// tokenAuthTransport adds the parameters dex authenticates with to the requests
+// to the token endpoint. golang.org/x/oauth2 only sends static client secrets,
+// and doesn't take extra parameters for refresh requests.
+type tokenAuthTransport struct {
+	tokenURL string
+	params   func() (url.Values, error)
+	base     http.RoundTripper
+}
+
+func (t *tokenAuthTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method != http.MethodPost || r.URL.String() != t.tokenURL || r.Body == nil {
+		return t.base.RoundTrip(r)
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	params, err := t.params()
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range params {
+		values[k] = v
+	}
+
+	// Round trippers must not modify the request.
+	encoded := []byte(values.Encode())
+	r2 := r.Clone(r.Context())
+	r2.Body = io.NopCloser(bytes.NewReader(encoded))
+	r2.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(encoded)), nil
+	}
+	r2.ContentLength = int64(len(encoded))
+	return t.base.RoundTrip(r2)
+}
+
+// loadSigningKey loads a PEM encoded private key in PKCS #8, PKCS #1 or SEC 1
+// form, and returns the JWS algorithm to sign with it.
+func loadSigningKey(file string) (crypto.Signer, jose.SignatureAlgorithm, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, "", fmt.Errorf("read key file: %v", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, "", fmt.Errorf("no PEM data in key file %q", file)
+	}
+
+	var key interface{}
+	switch {
+	case strings.HasPrefix(block.Type, "RSA "):
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case strings.HasPrefix(block.Type, "EC "):
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("parse key file %q: %v", file, err)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return key, jose.RS256, nil
+	case *ecdsa.PrivateKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return key, jose.ES256, nil
+		case elliptic.P384():
+			return key, jose.ES384, nil
+		case elliptic.P521():
+			return key, jose.ES512, nil
+		}
+		return nil, "", errors.New("unsupported elliptic curve")
+	default:
+		return nil, "", fmt.Errorf("unsupported key type %T", key)
+	}
+}
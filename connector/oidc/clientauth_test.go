@@ -0,0 +1,200 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/dexidp/dex/connector"
+)
+
+var idTokenClaims = map[string]interface{}{
+	"sub":            "subvalue",
+	"name":           "namevalue",
+	"email":          "emailvalue",
+	"email_verified": true,
+}
+
+// writeKeyFile writes the key as a PEM encoded PKCS #8 private key.
+func writeKeyFile(t *testing.T, key crypto.Signer) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// verifyClientJWT verifies a JWT dex signed with the key, and returns its header
+// and claims.
+func verifyClientJWT(token string, pub crypto.PublicKey) (jose.Header, map[string]interface{}, error) {
+	jws, err := jose.ParseSigned(token)
+	if err != nil {
+		return jose.Header{}, nil, fmt.Errorf("parse JWT: %v", err)
+	}
+	payload, err := jws.Verify(pub)
+	if err != nil {
+		return jose.Header{}, nil, fmt.Errorf("verify JWT: %v", err)
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return jose.Header{}, nil, err
+	}
+	return jws.Signatures[0].Header, claims, nil
+}
+
+func expectClaims(claims map[string]interface{}, want map[string]string) error {
+	for k, v := range want {
+		if claims[k] != v {
+			return fmt.Errorf("expected %s %q, got %v", k, v, claims[k])
+		}
+	}
+	return nil
+}
+
+func TestPrivateKeyJWT(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var grantTypes []string
+	testServer, err := setupServer(idTokenClaims, func(r *http.Request) error {
+		if r.PostFormValue("client_secret") != "" {
+			return errors.New("unexpected client secret")
+		}
+		if typ := r.PostFormValue("client_assertion_type"); typ != clientAssertionTypeJWT {
+			return fmt.Errorf("unexpected client assertion type %q", typ)
+		}
+		header, claims, err := verifyClientJWT(r.PostFormValue("client_assertion"), &key.PublicKey)
+		if err != nil {
+			return err
+		}
+		if header.KeyID != "key-1" {
+			return fmt.Errorf("unexpected key ID %q", header.KeyID)
+		}
+		if claims["jti"] == nil || claims["exp"] == nil {
+			return errors.New("client assertion without jti or exp")
+		}
+		grantTypes = append(grantTypes, r.PostFormValue("grant_type"))
+		return expectClaims(claims, map[string]string{
+			"iss": "clientID",
+			"sub": "clientID",
+			"aud": "http://" + r.Host + "/token",
+		})
+	})
+	if err != nil {
+		t.Fatal("failed to setup test server", err)
+	}
+	defer testServer.Close()
+
+	conn, err := newConnector(Config{
+		Issuer:        testServer.URL,
+		ClientID:      "clientID",
+		RedirectURI:   testServer.URL + "/callback",
+		PrivateKeyJWT: &PrivateKeyJWT{KeyFile: writeKeyFile(t, key), KeyID: "key-1"},
+	})
+	if err != nil {
+		t.Fatal("failed to create new connector", err)
+	}
+
+	req, err := newRequestWithAuthCode(testServer.URL, "someCode")
+	if err != nil {
+		t.Fatal("failed to create request", err)
+	}
+	identity, err := conn.HandleCallback(connector.Scopes{OfflineAccess: true}, req)
+	if err != nil {
+		t.Fatal("handle callback failed", err)
+	}
+	if _, err := conn.Refresh(context.Background(), connector.Scopes{OfflineAccess: true}, identity); err != nil {
+		t.Fatal("refresh failed", err)
+	}
+	expectEquals(t, grantTypes, []string{"authorization_code", "refresh_token"})
+}
+
+func TestGeneratedClientSecret(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testServer, err := setupServer(idTokenClaims, func(r *http.Request) error {
+		header, claims, err := verifyClientJWT(r.PostFormValue("client_secret"), &key.PublicKey)
+		if err != nil {
+			return err
+		}
+		if header.Algorithm != string(jose.ES256) || header.KeyID != "key-1" {
+			return fmt.Errorf("unexpected header %+v", header)
+		}
+		return expectClaims(claims, map[string]string{
+			"iss": "team-id",
+			"sub": "clientID",
+			"aud": "http://" + r.Host,
+		})
+	})
+	if err != nil {
+		t.Fatal("failed to setup test server", err)
+	}
+	defer testServer.Close()
+
+	config := Config{
+		Issuer:      testServer.URL,
+		ClientID:    "clientID",
+		RedirectURI: testServer.URL + "/callback",
+		GeneratedClientSecret: &GeneratedClientSecret{
+			KeyFile: writeKeyFile(t, key),
+			KeyID:   "key-1",
+			Issuer:  "team-id",
+		},
+	}
+	conn, err := newConnector(config)
+	if err != nil {
+		t.Fatal("failed to create new connector", err)
+	}
+
+	req, err := newRequestWithAuthCode(testServer.URL, "someCode")
+	if err != nil {
+		t.Fatal("failed to create request", err)
+	}
+	if _, err := conn.HandleCallback(connector.Scopes{}, req); err != nil {
+		t.Fatal("handle callback failed", err)
+	}
+
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, modify := range map[string]func(c *Config){
+		"with a client secret": func(c *Config) { c.ClientSecret = "clientSecret" },
+		"with private key JWT": func(c *Config) { c.PrivateKeyJWT = &PrivateKeyJWT{KeyFile: c.GeneratedClientSecret.KeyFile} },
+		"with a P-384 key":     func(c *Config) { c.GeneratedClientSecret.KeyFile = writeKeyFile(t, p384Key) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := config
+			secret := *config.GeneratedClientSecret
+			c.GeneratedClientSecret = &secret
+			modify(&c)
+			if _, err := newConnector(c); err == nil {
+				t.Error("expected the config to be rejected")
+			}
+		})
+	}
+}
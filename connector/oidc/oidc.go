@@ -3,6 +3,9 @@ package oidc
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,8 +16,10 @@ import (
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"golang.org/x/oauth2"
+	jose "gopkg.in/square/go-jose.v2"
 
 	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/pkg/claims"
 	"github.com/dexidp/dex/pkg/log"
 )
 
@@ -32,6 +37,18 @@ type Config struct {
 	// https://tools.ietf.org/html/rfc6749#section-2.3.1
 	BasicAuthUnsupported *bool `json:"basicAuthUnsupported"`
 
+	// PKCE sends a code challenge with the S256 method on authorization requests.
+	//
+	// https://www.rfc-editor.org/rfc/rfc7636
+	PKCE bool `json:"pkce"`
+
+	// Authenticates dex to the token endpoint with a JWT signed by its private key
+	// ("private_key_jwt") instead of the client secret.
+	PrivateKeyJWT *PrivateKeyJWT `json:"privateKeyJWT"`
+
+	// Generates the client secret as a signed JWT instead of using clientSecret.
+	GeneratedClientSecret *GeneratedClientSecret `json:"generatedClientSecret"`
+
 	Scopes []string `json:"scopes"` // defaults to "profile" and "email"
 
 	// Optional list of whitelisted domains when using Google
@@ -54,6 +71,8 @@ type Config struct {
 	// id tokens
 	GetUserInfo bool `json:"getUserInfo"`
 
+	// UserIDKey, UserNameKey and the keys of ClaimMapping are paths to the
+	// claims, so nested claims like "realm_access.roles" can be mapped.
 	UserIDKey string `json:"userIDKey"`
 
 	UserNameKey string `json:"userNameKey"`
@@ -75,9 +94,59 @@ type Config struct {
 
 		// Configurable key which contains the groups claims
 		GroupsKey string `json:"groups"` // defaults to "groups"
+
+		// Configurable key which contains the email_verified claim
+		EmailVerifiedKey string `json:"email_verified"` // defaults to "email_verified"
+
+		// Prefix added to the groups, to tell them apart from the groups of other connectors
+		GroupsPrefix string `json:"groupsPrefix"`
+
+		// Regular expression whole groups must match, before the prefix is added.
+		// Other groups are dropped. "admin" keeps only "admin", "team-.*" keeps
+		// the groups starting with "team-".
+		GroupsFilter string `json:"groupsFilter"`
 	} `json:"claimMapping"`
 }
 
+// claimMapping holds the paths to the claims the identity is made of.
+type claimMapping struct {
+	userID            claims.Path
+	userName          claims.Path
+	preferredUsername claims.Path
+	email             claims.Path
+	emailVerified     claims.Path
+	groups            claims.Path
+	groupsMapper      *claims.GroupsMapper
+}
+
+func (c *Config) claimMapping() (m claimMapping, err error) {
+	emailVerifiedKey := c.ClaimMapping.EmailVerifiedKey
+	if emailVerifiedKey == "" {
+		emailVerifiedKey = "email_verified"
+	}
+	userNameKey := c.UserNameKey
+	if userNameKey == "" {
+		userNameKey = "name"
+	}
+	for _, p := range []struct {
+		path *claims.Path
+		expr string
+	}{
+		{&m.userID, c.UserIDKey},
+		{&m.userName, userNameKey},
+		{&m.preferredUsername, c.ClaimMapping.PreferredUsernameKey},
+		{&m.email, c.ClaimMapping.EmailKey},
+		{&m.emailVerified, emailVerifiedKey},
+		{&m.groups, c.ClaimMapping.GroupsKey},
+	} {
+		if *p.path, err = claims.ParsePath(p.expr); err != nil {
+			return m, err
+		}
+	}
+	m.groupsMapper, err = claims.NewGroupsMapper(c.ClaimMapping.GroupsPrefix, c.ClaimMapping.GroupsFilter)
+	return m, err
+}
+
 // Domains that don't support basic auth. golang.org/x/oauth2 has an internal
 // list, but it only matches specific URLs, not top level domains.
 var brokenAuthHeaderDomains = []string{
@@ -91,6 +160,11 @@ type connectorData struct {
 	RefreshToken []byte
 }
 
+// callbackData is kept by the server between the login URL and the callback.
+type callbackData struct {
+	CodeVerifier string `json:"codeVerifier,omitempty"`
+}
+
 // Detect auth header provider issues for known providers. This lets users
 // avoid having to explicitly set "basicAuthUnsupported" in their config.
 //
@@ -109,6 +183,11 @@ func knownBrokenAuthHeaderProvider(issuerURL string) bool {
 // Open returns a connector which can be used to login users through an upstream
 // OpenID Connect provider.
 func (c *Config) Open(id string, logger log.Logger) (conn connector.Connector, err error) {
+	mapping, err := c.claimMapping()
+	if err != nil {
+		return nil, fmt.Errorf("oidc: invalid claim mapping: %v", err)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	provider, err := oidc.NewProvider(ctx, c.Issuer)
@@ -119,7 +198,16 @@ func (c *Config) Open(id string, logger log.Logger) (conn connector.Connector, e
 
 	endpoint := provider.Endpoint()
 
-	if c.BasicAuthUnsupported != nil {
+	tokenAuth, err := c.tokenAuth(endpoint.TokenURL, provider)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	if tokenAuth != nil {
+		// The client ID goes along with the parameters the transport adds.
+		endpoint.AuthStyle = oauth2.AuthStyleInParams
+	} else if c.BasicAuthUnsupported != nil {
 		// Setting "basicAuthUnsupported" always overrides our detection.
 		if *c.BasicAuthUnsupported {
 			endpoint.AuthStyle = oauth2.AuthStyleInParams
@@ -141,7 +229,7 @@ func (c *Config) Open(id string, logger log.Logger) (conn connector.Connector, e
 	}
 
 	clientID := c.ClientID
-	return &oidcConnector{
+	oidcConn := &oidcConnector{
 		provider:    provider,
 		redirectURI: c.RedirectURI,
 		oauth2Config: &oauth2.Config{
@@ -162,18 +250,76 @@ func (c *Config) Open(id string, logger log.Logger) (conn connector.Connector, e
 		acrValues:                 c.AcrValues,
 		getUserInfo:               c.GetUserInfo,
 		promptType:                c.PromptType,
-		userIDKey:                 c.UserIDKey,
-		userNameKey:               c.UserNameKey,
 		overrideClaimMapping:      c.OverrideClaimMapping,
-		preferredUsernameKey:      c.ClaimMapping.PreferredUsernameKey,
-		emailKey:                  c.ClaimMapping.EmailKey,
-		groupsKey:                 c.ClaimMapping.GroupsKey,
-	}, nil
+		claimMapping:              mapping,
+		pkce:                      c.PKCE,
+	}
+	if tokenAuth != nil {
+		oidcConn.httpClient = &http.Client{Transport: tokenAuth}
+	}
+	return oidcConn, nil
+}
+
+// tokenAuth returns the transport which authenticates dex to the token endpoint
+// with a JWT, or nil if dex authenticates with the client secret.
+func (c *Config) tokenAuth(tokenURL string, provider *oidc.Provider) (*tokenAuthTransport, error) {
+	switch {
+	case c.PrivateKeyJWT != nil && c.GeneratedClientSecret != nil:
+		return nil, errors.New("oidc: privateKeyJWT and generatedClientSecret can't both be configured")
+	case c.PrivateKeyJWT != nil:
+		if c.ClientSecret != "" {
+			return nil, errors.New("oidc: clientSecret can't be configured with privateKeyJWT")
+		}
+		signer, err := newClientJWTSigner(c.PrivateKeyJWT.KeyFile, c.PrivateKeyJWT.KeyID)
+		if err != nil {
+			return nil, fmt.Errorf("oidc: failed to load private key JWT key: %v", err)
+		}
+		return &tokenAuthTransport{
+			tokenURL: tokenURL,
+			params: func() (url.Values, error) {
+				return signer.clientAssertion(c.ClientID, tokenURL)
+			},
+			base: http.DefaultTransport,
+		}, nil
+	case c.GeneratedClientSecret != nil:
+		if c.ClientSecret != "" {
+			return nil, errors.New("oidc: clientSecret can't be configured with generatedClientSecret")
+		}
+		g := *c.GeneratedClientSecret
+		if g.Issuer == "" {
+			return nil, errors.New("oidc: generatedClientSecret requires an issuer")
+		}
+		if g.Audience == "" {
+			var claims struct {
+				Issuer string `json:"issuer"`
+			}
+			if err := provider.Claims(&claims); err != nil {
+				return nil, fmt.Errorf("oidc: failed to get the issuer of the provider: %v", err)
+			}
+			g.Audience = claims.Issuer
+		}
+		signer, err := newClientJWTSigner(g.KeyFile, g.KeyID)
+		if err != nil {
+			return nil, fmt.Errorf("oidc: failed to load generated client secret key: %v", err)
+		}
+		if signer.alg != jose.ES256 {
+			return nil, errors.New("oidc: generatedClientSecret requires a P-256 key")
+		}
+		return &tokenAuthTransport{
+			tokenURL: tokenURL,
+			params: func() (url.Values, error) {
+				return signer.clientSecret(c.ClientID, g.Issuer, g.Audience)
+			},
+			base: http.DefaultTransport,
+		}, nil
+	}
+	return nil, nil
 }
 
 var (
-	_ connector.CallbackConnector = (*oidcConnector)(nil)
-	_ connector.RefreshConnector  = (*oidcConnector)(nil)
+	_ connector.CallbackConnector     = (*oidcConnector)(nil)
+	_ connector.CallbackDataConnector = (*oidcConnector)(nil)
+	_ connector.RefreshConnector      = (*oidcConnector)(nil)
 )
 
 type oidcConnector struct {
@@ -189,12 +335,12 @@ type oidcConnector struct {
 	acrValues                 []string
 	getUserInfo               bool
 	promptType                string
-	userIDKey                 string
-	userNameKey               string
 	overrideClaimMapping      bool
-	preferredUsernameKey      string
-	emailKey                  string
-	groupsKey                 string
+	claimMapping              claimMapping
+	pkce                      bool
+
+	// Authenticates dex to the token endpoint, if it doesn't use the client secret.
+	httpClient *http.Client
 }
 
 func (c *oidcConnector) Close() error {
@@ -203,11 +349,43 @@ func (c *oidcConnector) Close() error {
 }
 
 func (c *oidcConnector) LoginURL(s connector.Scopes, callbackURL, state string) (string, error) {
+	return c.loginURL(s, callbackURL, state, nil)
+}
+
+// LoginURLWithData returns the login URL, with a PKCE code challenge if PKCE is
+// enabled. The verifier is the callback data.
+func (c *oidcConnector) LoginURLWithData(s connector.Scopes, callbackURL, state string) (string, []byte, error) {
+	if !c.pkce {
+		loginURL, err := c.LoginURL(s, callbackURL, state)
+		return loginURL, nil, err
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, fmt.Errorf("oidc: failed to generate code verifier: %v", err)
+	}
+	verifier := base64.RawURLEncoding.EncodeToString(b)
+	challenge := sha256.Sum256([]byte(verifier))
+	opts := []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:])),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+	loginURL, err := c.loginURL(s, callbackURL, state, opts)
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := json.Marshal(callbackData{CodeVerifier: verifier})
+	if err != nil {
+		return "", nil, fmt.Errorf("oidc: failed to encode callback data: %v", err)
+	}
+	return loginURL, data, nil
+}
+
+func (c *oidcConnector) loginURL(s connector.Scopes, callbackURL, state string, opts []oauth2.AuthCodeOption) (string, error) {
 	if c.redirectURI != callbackURL {
 		return "", fmt.Errorf("expected callback URL %q did not match the URL in the config %q", callbackURL, c.redirectURI)
 	}
 
-	var opts []oauth2.AuthCodeOption
 	if len(c.hostedDomains) > 0 {
 		preferredDomain := c.hostedDomains[0]
 		if len(c.hostedDomains) > 1 {
@@ -240,16 +418,49 @@ func (e *oauth2Error) Error() string {
 }
 
 func (c *oidcConnector) HandleCallback(s connector.Scopes, r *http.Request) (identity connector.Identity, err error) {
+	return c.handleCallback(r, nil)
+}
+
+// HandleCallbackWithData exchanges the code with the PKCE code verifier of the
+// login, if PKCE is enabled.
+func (c *oidcConnector) HandleCallbackWithData(s connector.Scopes, data []byte, r *http.Request) (identity connector.Identity, err error) {
+	if !c.pkce {
+		return c.handleCallback(r, nil)
+	}
+
+	var cd callbackData
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &cd); err != nil {
+			return identity, fmt.Errorf("oidc: failed to decode callback data: %v", err)
+		}
+	}
+	if cd.CodeVerifier == "" {
+		return identity, errors.New("oidc: no PKCE code verifier for the login")
+	}
+	return c.handleCallback(r, []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("code_verifier", cd.CodeVerifier)})
+}
+
+func (c *oidcConnector) handleCallback(r *http.Request, opts []oauth2.AuthCodeOption) (identity connector.Identity, err error) {
 	q := r.URL.Query()
 	if errType := q.Get("error"); errType != "" {
 		return identity, &oauth2Error{errType, q.Get("error_description")}
 	}
-	token, err := c.oauth2Config.Exchange(r.Context(), q.Get("code"))
+	ctx := c.clientContext(r.Context())
+	token, err := c.oauth2Config.Exchange(ctx, q.Get("code"), opts...)
 	if err != nil {
 		return identity, fmt.Errorf("oidc: failed to get token: %v", err)
 	}
 
-	return c.createIdentity(r.Context(), identity, token)
+	return c.createIdentity(ctx, identity, token)
+}
+
+// clientContext returns a context for requests to the provider, which
+// authenticate dex to the token endpoint as configured.
+func (c *oidcConnector) clientContext(ctx context.Context) context.Context {
+	if c.httpClient == nil {
+		return ctx
+	}
+	return oidc.ClientContext(ctx, c.httpClient)
 }
 
 // Refresh is used to refresh a session with the refresh token provided by the IdP
@@ -264,6 +475,7 @@ func (c *oidcConnector) Refresh(ctx context.Context, s connector.Scopes, identit
 		RefreshToken: string(cd.RefreshToken),
 		Expiry:       time.Now().Add(-time.Hour),
 	}
+	ctx = c.clientContext(ctx)
 	token, err := c.oauth2Config.TokenSource(ctx, t).Token()
 	if err != nil {
 		return identity, fmt.Errorf("oidc: failed to get refresh token: %v", err)
@@ -298,18 +510,15 @@ func (c *oidcConnector) createIdentity(ctx context.Context, identity connector.I
 		}
 	}
 
-	userNameKey := "name"
-	if c.userNameKey != "" {
-		userNameKey = c.userNameKey
-	}
-	name, found := claims[userNameKey].(string)
+	m := c.claimMapping
+	name, found := m.userName.LookupString(claims)
 	if !found {
-		return identity, fmt.Errorf("missing \"%s\" claim", userNameKey)
+		return identity, fmt.Errorf("missing \"%s\" claim", m.userName)
 	}
 
 	preferredUsername, found := claims["preferred_username"].(string)
-	if (!found || c.overrideClaimMapping) && c.preferredUsernameKey != "" {
-		preferredUsername, _ = claims[c.preferredUsernameKey].(string)
+	if (!found || c.overrideClaimMapping) && !m.preferredUsername.IsZero() {
+		preferredUsername, _ = m.preferredUsername.LookupString(claims)
 	}
 
 	hasEmailScope := false
@@ -323,42 +532,43 @@ func (c *oidcConnector) createIdentity(ctx context.Context, identity connector.I
 	var email string
 	emailKey := "email"
 	email, found = claims[emailKey].(string)
-	if (!found || c.overrideClaimMapping) && c.emailKey != "" {
-		emailKey = c.emailKey
-		email, found = claims[emailKey].(string)
+	if (!found || c.overrideClaimMapping) && !m.email.IsZero() {
+		emailKey = m.email.String()
+		email, found = m.email.LookupString(claims)
 	}
 
 	if !found && hasEmailScope {
 		return identity, fmt.Errorf("missing email claim, not found \"%s\" key", emailKey)
 	}
 
-	emailVerified, found := claims["email_verified"].(bool)
+	emailVerified, found := m.emailVerified.LookupBool(claims)
 	if !found {
 		if c.insecureSkipEmailVerified {
 			emailVerified = true
 		} else if hasEmailScope {
-			return identity, errors.New("missing \"email_verified\" claim")
+			return identity, fmt.Errorf("missing \"%s\" claim", m.emailVerified)
 		}
 	}
 
 	var groups []string
 	if c.insecureEnableGroups {
-		groupsKey := "groups"
-		vs, found := claims[groupsKey].([]interface{})
-		if (!found || c.overrideClaimMapping) && c.groupsKey != "" {
-			groupsKey = c.groupsKey
-			vs, found = claims[groupsKey].([]interface{})
-		}
-
-		if found {
+		vs, found := claims["groups"].([]interface{})
+		if (!found || c.overrideClaimMapping) && !m.groups.IsZero() {
+			groups, _, err = m.groups.LookupStrings(claims)
+		} else {
 			for _, v := range vs {
-				if s, ok := v.(string); ok {
-					groups = append(groups, s)
-				} else {
-					return identity, fmt.Errorf("malformed \"%v\" claim", groupsKey)
+				s, ok := v.(string)
+				if !ok {
+					err = errors.New("malformed \"groups\" claim")
+					break
 				}
+				groups = append(groups, s)
 			}
 		}
+		if err != nil {
+			return identity, err
+		}
+		groups = m.groupsMapper.Map(groups)
 	}
 
 	hostedDomain, _ := claims["hd"].(string)
@@ -395,10 +605,10 @@ func (c *oidcConnector) createIdentity(ctx context.Context, identity connector.I
 		ConnectorData:     connData,
 	}
 
-	if c.userIDKey != "" {
-		userID, found := claims[c.userIDKey].(string)
+	if !m.userID.IsZero() {
+		userID, found := m.userID.LookupString(claims)
 		if !found {
-			return identity, fmt.Errorf("oidc: not found %v claim", c.userIDKey)
+			return identity, fmt.Errorf("oidc: not found %v claim", m.userID)
 		}
 		identity.UserID = userID
 	}
@@ -2,8 +2,10 @@ package oidc
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
@@ -11,6 +13,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"strings"
 	"testing"
@@ -53,6 +56,8 @@ func TestHandleCallback(t *testing.T) {
 		preferredUsernameKey      string
 		emailKey                  string
 		groupsKey                 string
+		groupsPrefix              string
+		groupsFilter              string
 		insecureSkipEmailVerified bool
 		scopes                    []string
 		expectUserID              string
@@ -252,6 +257,31 @@ func TestHandleCallback(t *testing.T) {
 				"cognito:groups": []string{"group3", "group4"},
 			},
 		},
+		{
+			name:                      "nestedClaims",
+			userIDKey:                 "ext.id",
+			emailKey:                  `ext["mail"]`,
+			groupsKey:                 "realm_access.roles",
+			groupsPrefix:              "kc:",
+			groupsFilter:              "app-.*",
+			expectUserID:              "extid",
+			expectUserName:            "namevalue",
+			expectedEmailField:        "extmail",
+			expectGroups:              []string{"kc:app-admin", "kc:app-user"},
+			scopes:                    []string{"groups"},
+			insecureSkipEmailVerified: true,
+			token: map[string]interface{}{
+				"sub":  "subvalue",
+				"name": "namevalue",
+				"ext": map[string]interface{}{
+					"id":   "extid",
+					"mail": "extmail",
+				},
+				"realm_access": map[string]interface{}{
+					"roles": []string{"app-admin", "offline_access", "app-user"},
+				},
+			},
+		},
 		{
 			name:                      "customGroupsKeyDespiteGroupsProvidedButOverride",
 			overrideClaimMapping:      true,
@@ -275,7 +305,7 @@ func TestHandleCallback(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			testServer, err := setupServer(tc.token)
+			testServer, err := setupServer(tc.token, nil)
 			if err != nil {
 				t.Fatal("failed to setup test server", err)
 			}
@@ -305,6 +335,8 @@ func TestHandleCallback(t *testing.T) {
 			config.ClaimMapping.PreferredUsernameKey = tc.preferredUsernameKey
 			config.ClaimMapping.EmailKey = tc.emailKey
 			config.ClaimMapping.GroupsKey = tc.groupsKey
+			config.ClaimMapping.GroupsPrefix = tc.groupsPrefix
+			config.ClaimMapping.GroupsFilter = tc.groupsFilter
 
 			conn, err := newConnector(config)
 			if err != nil {
@@ -331,7 +363,123 @@ func TestHandleCallback(t *testing.T) {
 	}
 }
 
-func setupServer(tok map[string]interface{}) (*httptest.Server, error) {
+func TestRefreshClaimMapping(t *testing.T) {
+	testServer, err := setupServer(map[string]interface{}{
+		"sub":  "subvalue",
+		"name": "namevalue",
+		"realm_access": map[string]interface{}{
+			"roles": []string{"admin", "offline_access"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal("failed to setup test server", err)
+	}
+	defer testServer.Close()
+
+	config := Config{
+		Issuer:                    testServer.URL,
+		ClientID:                  "clientID",
+		ClientSecret:              "clientSecret",
+		Scopes:                    []string{"groups"},
+		RedirectURI:               testServer.URL + "/callback",
+		InsecureSkipEmailVerified: true,
+		InsecureEnableGroups:      true,
+	}
+	config.ClaimMapping.GroupsKey = "realm_access.roles"
+	config.ClaimMapping.GroupsPrefix = "kc:"
+	config.ClaimMapping.GroupsFilter = "^admin$"
+	conn, err := newConnector(config)
+	if err != nil {
+		t.Fatal("failed to create new connector", err)
+	}
+
+	connData, err := json.Marshal(connectorData{RefreshToken: []byte("refreshToken")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := conn.Refresh(context.Background(), connector.Scopes{Groups: true}, connector.Identity{ConnectorData: connData})
+	if err != nil {
+		t.Fatal("refresh failed", err)
+	}
+	expectEquals(t, identity.Groups, []string{"kc:admin"})
+}
+
+func TestPKCE(t *testing.T) {
+	var challenge string
+	testServer, err := setupServer(map[string]interface{}{
+		"sub":            "subvalue",
+		"name":           "namevalue",
+		"email":          "emailvalue",
+		"email_verified": true,
+	}, func(r *http.Request) error {
+		verifier := r.PostFormValue("code_verifier")
+		if verifier == "" {
+			return errors.New("no code verifier")
+		}
+		sum := sha256.Sum256([]byte(verifier))
+		if base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+			return errors.New("code verifier doesn't match the challenge")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("failed to setup test server", err)
+	}
+	defer testServer.Close()
+
+	redirectURI := testServer.URL + "/callback"
+	conn, err := newConnector(Config{
+		Issuer:       testServer.URL,
+		ClientID:     "clientID",
+		ClientSecret: "clientSecret",
+		RedirectURI:  redirectURI,
+		PKCE:         true,
+	})
+	if err != nil {
+		t.Fatal("failed to create new connector", err)
+	}
+
+	loginURL, data, err := conn.LoginURLWithData(connector.Scopes{}, redirectURI, "state")
+	if err != nil {
+		t.Fatal("failed to get login URL", err)
+	}
+	u, err := url.Parse(loginURL)
+	if err != nil {
+		t.Fatal("failed to parse login URL", err)
+	}
+	expectEquals(t, u.Query().Get("code_challenge_method"), "S256")
+	challenge = u.Query().Get("code_challenge")
+	if challenge == "" {
+		t.Fatal("no code challenge in the login URL")
+	}
+
+	req, err := newRequestWithAuthCode(testServer.URL, "someCode")
+	if err != nil {
+		t.Fatal("failed to create request", err)
+	}
+	if _, err := conn.HandleCallbackWithData(connector.Scopes{}, nil, req); err == nil {
+		t.Error("expected the callback without a code verifier to fail")
+	}
+	identity, err := conn.HandleCallbackWithData(connector.Scopes{}, data, req)
+	if err != nil {
+		t.Fatal("handle callback failed", err)
+	}
+	expectEquals(t, identity.UserID, "subvalue")
+
+	// Without PKCE there's no callback data and nothing is added to the URL.
+	conn.pkce = false
+	loginURL, data, err = conn.LoginURLWithData(connector.Scopes{}, redirectURI, "state")
+	if err != nil {
+		t.Fatal("failed to get login URL", err)
+	}
+	if data != nil || strings.Contains(loginURL, "code_challenge") {
+		t.Errorf("expected no PKCE parameters, got %q and data %q", loginURL, data)
+	}
+}
+
+// setupServer starts a provider issuing ID tokens with the claims. checkToken,
+// if set, validates the parameters of requests to the token endpoint.
+func setupServer(tok map[string]interface{}, checkToken func(r *http.Request) error) (*httptest.Server, error) {
 	key, err := rsa.GenerateKey(rand.Reader, 1024)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate rsa key: %v", err)
@@ -358,6 +506,17 @@ func setupServer(tok map[string]interface{}) (*httptest.Server, error) {
 	})
 
 	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if checkToken != nil {
+			if err := checkToken(r); err != nil {
+				w.Header().Add("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(&map[string]string{
+					"error":             "invalid_request",
+					"error_description": err.Error(),
+				})
+				return
+			}
+		}
 		url := fmt.Sprintf("http://%s", r.Host)
 		tok["iss"] = url
 		tok["exp"] = time.Now().Add(time.Hour).Unix()
@@ -369,9 +528,10 @@ func setupServer(tok map[string]interface{}) (*httptest.Server, error) {
 
 		w.Header().Add("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(&map[string]string{
-			"access_token": token,
-			"id_token":     token,
-			"token_type":   "Bearer",
+			"access_token":  token,
+			"id_token":      token,
+			"token_type":    "Bearer",
+			"refresh_token": "refreshToken",
 		})
 	})
 
@@ -0,0 +1,243 @@
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	"github.com/dexidp/dex/pkg/log"
+)
+
+const (
+	// How often the IdP metadata is loaded again if it isn't configured.
+	defaultIDPMetadataRefreshInterval = 24 * time.Hour
+	// How long until the IdP metadata is loaded again after that failed.
+	idpMetadataRetryDelay = time.Minute
+)
+
+// idpConfig is what dex needs to know about the IdP. It's either configured
+// directly or loaded from the IdP's metadata.
+type idpConfig struct {
+	ssoIssuer string
+	ssoURL    string
+
+	// If nil, don't do signature validation.
+	validator *dsig.ValidationContext
+}
+
+// idpMetadata loads the configuration of the IdP from its metadata, and loads
+// it again periodically to pick up new signing certificates.
+type idpMetadata struct {
+	url  string
+	file string
+
+	client          *http.Client
+	refreshInterval time.Duration
+
+	// If set, the metadata must be signed with one of these certificates.
+	signingCerts []*x509.Certificate
+
+	// Values from the config which take precedence over the metadata. The
+	// certificates are trusted in addition to those of the metadata, unless
+	// validate is false.
+	static      idpConfig
+	staticCerts []*x509.Certificate
+	validate    bool
+
+	logger log.Logger
+	now    func() time.Time
+
+	mu      sync.Mutex
+	current idpConfig
+	expires time.Time
+	// Set while the metadata is loaded again in the background.
+	refreshing bool
+}
+
+// get returns the configuration of the IdP. If it's due, the metadata is
+// loaded again in the background, so logins don't wait for a slow IdP. The
+// current configuration is used until then, and kept if that fails.
+func (m *idpMetadata) get() idpConfig {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.refreshing && !m.now().Before(m.expires) {
+		m.refreshing = true
+		go m.refresh()
+	}
+	return m.current
+}
+
+func (m *idpMetadata) refresh() {
+	idp, err := m.fetch()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.refreshing = false
+	if err != nil {
+		m.logger.Errorf("saml: failed to load IdP metadata, using the previous metadata: %v", err)
+		m.expires = m.now().Add(idpMetadataRetryDelay)
+		return
+	}
+	m.current = idp
+	m.expires = m.now().Add(m.refreshInterval)
+}
+
+// load loads the metadata, and fails if it can't be loaded.
+func (m *idpMetadata) load() error {
+	idp, err := m.fetch()
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.current = idp
+	m.expires = m.now().Add(m.refreshInterval)
+	return nil
+}
+
+// fetch reads the metadata and returns the configuration of the IdP from it.
+func (m *idpMetadata) fetch() (idpConfig, error) {
+	data, err := m.read()
+	if err != nil {
+		return idpConfig{}, err
+	}
+	if m.signingCerts != nil {
+		if data, err = m.verify(data); err != nil {
+			return idpConfig{}, err
+		}
+	}
+	return m.parse(data)
+}
+
+// verify checks the signature of the metadata's root element, and returns the
+// signed content.
+func (m *idpMetadata) verify(data []byte) ([]byte, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, fmt.Errorf("parse IdP metadata: %v", err)
+	}
+	if doc.Root() == nil {
+		return nil, errors.New("parse IdP metadata: no root element")
+	}
+	signed, err := dsig.NewDefaultValidationContext(certStore{m.signingCerts}).Validate(doc.Root())
+	if err != nil {
+		return nil, fmt.Errorf("verify IdP metadata signature: %v", err)
+	}
+	doc.SetRoot(signed)
+	return doc.WriteToBytes()
+}
+
+func (m *idpMetadata) read() ([]byte, error) {
+	if m.file != "" {
+		data, err := os.ReadFile(m.file)
+		if err != nil {
+			return nil, fmt.Errorf("read IdP metadata file: %v", err)
+		}
+		return data, nil
+	}
+
+	resp, err := m.client.Get(m.url)
+	if err != nil {
+		return nil, fmt.Errorf("get IdP metadata: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get IdP metadata: unexpected status %s", resp.Status)
+	}
+	// Metadata of federations can be large, but not this large.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 10<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read IdP metadata: %v", err)
+	}
+	return data, nil
+}
+
+// parse returns the configuration of the IdP from its metadata, which is
+// either the EntityDescriptor of the IdP or an EntitiesDescriptor including
+// it. If there are several IdPs, the one with the configured ssoIssuer is
+// used.
+func (m *idpMetadata) parse(data []byte) (idpConfig, error) {
+	var entities []entityDescriptor
+	var ed entityDescriptor
+	if err := xml.Unmarshal(data, &ed); err == nil {
+		entities = append(entities, ed)
+	} else {
+		var eds entitiesDescriptor
+		if err := xml.Unmarshal(data, &eds); err != nil {
+			return idpConfig{}, fmt.Errorf("parse IdP metadata: %v", err)
+		}
+		entities = eds.all()
+	}
+
+	var idp *entityDescriptor
+	for i, e := range entities {
+		if e.IDPSSODescriptor == nil || (m.static.ssoIssuer != "" && e.EntityID != m.static.ssoIssuer) {
+			continue
+		}
+		if idp != nil {
+			return idpConfig{}, errors.New("IdP metadata describes several IdPs, configure ssoIssuer to choose one")
+		}
+		idp = &entities[i]
+	}
+	if idp == nil {
+		if m.static.ssoIssuer != "" {
+			return idpConfig{}, fmt.Errorf("IdP metadata doesn't describe the IdP %q", m.static.ssoIssuer)
+		}
+		return idpConfig{}, errors.New("IdP metadata doesn't describe an IdP")
+	}
+
+	c := m.static
+	if c.ssoIssuer == "" {
+		c.ssoIssuer = idp.EntityID
+	}
+	if c.ssoURL == "" {
+		for _, s := range idp.IDPSSODescriptor.SingleSignOnServices {
+			if s.Binding == bindingPOST {
+				c.ssoURL = s.Location
+				break
+			}
+		}
+		if c.ssoURL == "" {
+			return idpConfig{}, errors.New("IdP metadata has no SingleSignOnService with the HTTP-POST binding")
+		}
+	}
+
+	if !m.validate {
+		return c, nil
+	}
+	certs := append([]*x509.Certificate{}, m.staticCerts...)
+	for _, k := range idp.IDPSSODescriptor.KeyDescriptors {
+		// Keys without a use are for both signing and encryption.
+		if k.Use != "" && k.Use != "signing" {
+			continue
+		}
+		for _, value := range k.KeyInfo.X509Data.X509Certificates {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+			if err != nil {
+				return idpConfig{}, fmt.Errorf("decode IdP certificate: %v", err)
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return idpConfig{}, fmt.Errorf("parse IdP certificate: %v", err)
+			}
+			certs = append(certs, cert)
+		}
+	}
+	if len(certs) == 0 {
+		return idpConfig{}, errors.New("IdP metadata has no signing certificates")
+	}
+	c.validator = dsig.NewDefaultValidationContext(certStore{certs})
+	return c, nil
+}
@@ -0,0 +1,287 @@
+package saml
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dexidp/dex/connector"
+)
+
+func idpEntityDescriptor(t *testing.T, entityID, certFile string) string {
+	cert, err := loadCert(certFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+        <ds:X509Data>
+          <ds:X509Certificate>
+            %s
+          </ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="%[1]s/sso/redirect"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="%[1]s/sso/post"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, entityID, base64.StdEncoding.EncodeToString(cert.Raw))
+}
+
+func TestIDPMetadataURL(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		metadata = idpEntityDescriptor(t, "http://www.okta.com/exk91cb99lKkKSYoy0h7", "testdata/ca.crt")
+		status   = http.StatusOK
+	)
+	s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(status)
+		w.Write([]byte(metadata))
+	}))
+	defer s.Close()
+
+	c := Config{
+		IDPMetadataURL: s.URL,
+		UsernameAttr:   "Name",
+		EmailAttr:      "email",
+		RedirectURI:    "http://127.0.0.1:5556/dex/callback",
+		metadataClient: s.Client(),
+	}
+	p, err := c.openConnector(logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	p.metadata.now = func() time.Time { return now }
+	// Starts a refresh if it's due and waits for it.
+	refresh := func() {
+		p.metadata.get()
+		for {
+			p.metadata.mu.Lock()
+			refreshing := p.metadata.refreshing
+			p.metadata.mu.Unlock()
+			if !refreshing {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	if action, _, err := p.POSTData(connector.Scopes{}, "id"); err != nil || action != "http://www.okta.com/exk91cb99lKkKSYoy0h7/sso/post" {
+		t.Errorf("expected the SSO URL of the POST binding, got %q %v", action, err)
+	}
+
+	// Responses signed with the certificate of the metadata are accepted.
+	resp, err := os.ReadFile("testdata/good-resp.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	respTime, err := time.Parse(timeFormat, "2017-04-04T04:34:59.330Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.now = func() time.Time { return respTime }
+	handle := func() error {
+		_, err := p.HandlePOST(connector.Scopes{}, base64.StdEncoding.EncodeToString(resp), "6zmm5mguyebwvajyf2sdwwcw6m")
+		return err
+	}
+	if err := handle(); err != nil {
+		t.Fatalf("handle response: %v", err)
+	}
+
+	// The IdP rolls over to a new certificate, which is picked up with the
+	// next refresh.
+	mu.Lock()
+	metadata = idpEntityDescriptor(t, "http://www.okta.com/exk91cb99lKkKSYoy0h7", "testdata/bad-ca.crt")
+	mu.Unlock()
+	if err := handle(); err != nil {
+		t.Fatalf("expected the previous certificate to be used until the refresh: %v", err)
+	}
+	now = now.Add(defaultIDPMetadataRefreshInterval + time.Second)
+	refresh()
+	if err := handle(); err == nil {
+		t.Fatal("expected the response to be rejected after the refresh")
+	}
+
+	// If the metadata can't be loaded the previous metadata is kept.
+	mu.Lock()
+	metadata = idpEntityDescriptor(t, "http://www.okta.com/exk91cb99lKkKSYoy0h7", "testdata/ca.crt")
+	status = http.StatusInternalServerError
+	mu.Unlock()
+	now = now.Add(defaultIDPMetadataRefreshInterval + time.Second)
+	refresh()
+	if err := handle(); err == nil {
+		t.Fatal("expected the previous metadata to be kept")
+	}
+
+	mu.Lock()
+	status = http.StatusOK
+	mu.Unlock()
+	now = now.Add(idpMetadataRetryDelay + time.Second)
+	refresh()
+	if err := handle(); err != nil {
+		t.Fatalf("handle response: %v", err)
+	}
+}
+
+// newSigningCert returns a self-signed certificate and its key, and the file
+// the certificate is written to.
+func newSigningCert(t *testing.T) (tls.Certificate, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "metadata signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "signer.crt")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, file
+}
+
+func signMetadata(t *testing.T, metadata string, cert tls.Certificate) string {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(metadata); err != nil {
+		t.Fatal(err)
+	}
+	doc.Root().CreateAttr("ID", "_metadata")
+	signed, err := dsig.NewDefaultSigningContext(dsig.TLSCertKeyStore(cert)).SignEnveloped(doc.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.SetRoot(signed)
+	s, err := doc.WriteToString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestIDPMetadataSignature(t *testing.T) {
+	signer, signerFile := newSigningCert(t)
+	other, _ := newSigningCert(t)
+	metadata := idpEntityDescriptor(t, "https://idp.example.com", "testdata/ca.crt")
+
+	var served string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(served))
+	}))
+	defer s.Close()
+
+	tests := []struct {
+		name        string
+		served      string
+		signingCert string
+		wantErr     bool
+	}{
+		{name: "plain HTTP without signature", served: metadata, wantErr: true},
+		{name: "unsigned", served: metadata, signingCert: signerFile, wantErr: true},
+		{name: "signed by someone else", served: signMetadata(t, metadata, other), signingCert: signerFile, wantErr: true},
+		{name: "signed", served: signMetadata(t, metadata, signer), signingCert: signerFile},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			served = tc.served
+			c := Config{
+				IDPMetadataURL:         s.URL,
+				IDPMetadataSigningCert: tc.signingCert,
+				UsernameAttr:           "Name",
+				EmailAttr:              "email",
+				RedirectURI:            "http://127.0.0.1:5556/dex/callback",
+			}
+			_, err := c.openConnector(logrus.New())
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected the metadata to be rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestIDPMetadataFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "metadata.xml")
+	metadata := `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` +
+		idpEntityDescriptor(t, "https://idp1.example.com", "testdata/ca.crt") +
+		`<md:EntitiesDescriptor>` + idpEntityDescriptor(t, "https://idp2.example.com", "testdata/bad-ca.crt") + `</md:EntitiesDescriptor>` +
+		`</md:EntitiesDescriptor>`
+	if err := os.WriteFile(file, []byte(metadata), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		ssoIssuer string
+		ssoURL    string
+		wantErr   bool
+		wantURL   string
+	}{
+		{name: "several IdPs", wantErr: true},
+		{name: "unknown IdP", ssoIssuer: "https://idp3.example.com", wantErr: true},
+		{name: "nested IdP", ssoIssuer: "https://idp2.example.com", wantURL: "https://idp2.example.com/sso/post"},
+		{name: "configured SSO URL", ssoIssuer: "https://idp1.example.com", ssoURL: "https://sso.example.com", wantURL: "https://sso.example.com"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Config{
+				IDPMetadataFile: file,
+				SSOIssuer:       tc.ssoIssuer,
+				SSOURL:          tc.ssoURL,
+				UsernameAttr:    "Name",
+				EmailAttr:       "email",
+				RedirectURI:     "http://127.0.0.1:5556/dex/callback",
+			}
+			p, err := c.openConnector(logrus.New())
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatal(err)
+				}
+				return
+			}
+			if tc.wantErr {
+				t.Fatal("wanted error")
+			}
+			idp := p.currentIDP()
+			if idp.ssoURL != tc.wantURL || idp.ssoIssuer != tc.ssoIssuer {
+				t.Errorf("expected SSO URL %q and issuer %q, got %q and %q", tc.wantURL, tc.ssoIssuer, idp.ssoURL, idp.ssoIssuer)
+			}
+			if idp.validator == nil {
+				t.Error("expected signatures to be validated")
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/pkg/groups"
+	"github.com/dexidp/dex/pkg/log"
+)
+
+// Claims of the user which can be the username to look the user up with.
+const (
+	lookupByEmail    = "email"
+	lookupByUsername = "username"
+	lookupByUserID   = "userID"
+)
+
+// Attribute queries and their responses are sent in SOAP 1.1 envelopes.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-bindings-2.0-os.pdf
+// "3.2 SAML SOAP Binding"
+const (
+	soapEnvelopeNS = "http://schemas.xmlsoap.org/soap/envelope/"
+	soapAction     = "http://www.oasis-open.org/committees/security"
+)
+
+// refreshingProvider is a provider which refreshes identities. It's only used
+// if refresh is configured, since dex issues refresh tokens for every
+// connector which implements connector.RefreshConnector.
+type refreshingProvider struct {
+	*provider
+}
+
+var (
+	_ connector.RefreshConnector = refreshingProvider{}
+	_ io.Closer                  = refreshingProvider{}
+)
+
+func (p *provider) openRefresh(id string, c *RefreshConfig, logger log.Logger) error {
+	switch {
+	case c.AttributeQueryURL != "" && c.LDAP != nil:
+		return errors.New("refresh: only one of 'attributeQueryURL' and 'ldap' can be set")
+	case c.AttributeQueryURL != "":
+		p.attributeQueryURL = c.AttributeQueryURL
+		p.client = &http.Client{Timeout: 30 * time.Second}
+		return nil
+	case c.LDAP == nil:
+		return errors.New("refresh requires 'attributeQueryURL' or 'ldap'")
+	}
+
+	switch c.LookupBy {
+	case "":
+		p.lookupBy = lookupByEmail
+	case lookupByEmail, lookupByUsername, lookupByUserID:
+		p.lookupBy = c.LookupBy
+	default:
+		return fmt.Errorf("refresh: unknown lookupBy %q", c.LookupBy)
+	}
+	conn, err := c.LDAP.Open(id, logger)
+	if err != nil {
+		return fmt.Errorf("refresh: open ldap connector: %v", err)
+	}
+	lookup, ok := conn.(connector.UserLookupConnector)
+	if !ok {
+		if closer, ok := conn.(io.Closer); ok {
+			closer.Close()
+		}
+		return errors.New("refresh: ldap connector can't look up users")
+	}
+	p.userLookup = lookup
+	return nil
+}
+
+// Close releases the LDAP connector users are looked up with, which keeps a
+// pool of connections.
+func (p refreshingProvider) Close() error {
+	if closer, ok := p.userLookup.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Refresh checks that the user still exists, with the IdP's attribute service
+// or in the LDAP directory, and updates the user's identity from there.
+func (p refreshingProvider) Refresh(ctx context.Context, s connector.Scopes, ident connector.Identity) (connector.Identity, error) {
+	if p.userLookup != nil {
+		return p.refreshWithLookup(ctx, s, ident)
+	}
+
+	assertion, err := p.queryAttributes(ctx, ident.UserID)
+	if err != nil {
+		return ident, fmt.Errorf("saml: attribute query: %v", err)
+	}
+	newIdent, err := p.identity(s, assertion)
+	if err != nil {
+		return ident, err
+	}
+	newIdent.ConnectorData = ident.ConnectorData
+	return newIdent, nil
+}
+
+// refreshWithLookup looks the user up in the directory. The user keeps the
+// claims of the IdP, only the groups are replaced with those of the directory.
+func (p *provider) refreshWithLookup(ctx context.Context, s connector.Scopes, ident connector.Identity) (connector.Identity, error) {
+	username := ident.Email
+	switch p.lookupBy {
+	case lookupByUsername:
+		username = ident.Username
+	case lookupByUserID:
+		username = ident.UserID
+	}
+	if username == "" {
+		return ident, fmt.Errorf("saml: user %q has no %s to look up", ident.UserID, p.lookupBy)
+	}
+
+	// The groups are needed for allowedGroups even if they aren't requested.
+	scopes := connector.Scopes{Groups: s.Groups || len(p.allowedGroups) > 0}
+	user, err := p.userLookup.LookupUser(ctx, scopes, username)
+	if err != nil {
+		return ident, fmt.Errorf("saml: look up user: %v", err)
+	}
+
+	newIdent := ident
+	newIdent.Groups = nil
+	if s.Groups {
+		newIdent.Groups = user.Groups
+	}
+	if len(p.allowedGroups) > 0 {
+		groupMatches := groups.Filter(user.Groups, p.allowedGroups)
+		if len(groupMatches) == 0 {
+			return ident, fmt.Errorf("user not a member of allowed groups")
+		}
+		if s.Groups && p.filterGroups {
+			newIdent.Groups = groupMatches
+		}
+	}
+	return newIdent, nil
+}
+
+// queryAttributes sends an AttributeQuery for the subject with the NameID to
+// the IdP's attribute service, and returns the assertion of the response.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-core-2.0-os.pdf
+// "3.3.2.3 Element <AttributeQuery>"
+func (p *provider) queryAttributes(ctx context.Context, nameIDValue string) (*assertion, error) {
+	id, err := newRequestID()
+	if err != nil {
+		return nil, err
+	}
+	q := &attributeQuery{
+		ID:           id,
+		IssueInstant: xmlTime(p.now()),
+		Destination:  p.attributeQueryURL,
+		// Unlike the SSO service, the attribute service needs to know who is
+		// asking.
+		Issuer: &issuer{Issuer: p.entityID()},
+		Subject: &subject{
+			NameID: &nameID{Format: p.nameIDPolicyFormat, Value: nameIDValue},
+		},
+	}
+	data, err := xml.Marshal(q)
+	if err != nil {
+		return nil, fmt.Errorf("marshal attribute query: %v", err)
+	}
+	if p.signingCert != nil {
+		if data, err = p.sign(data); err != nil {
+			return nil, fmt.Errorf("sign attribute query: %v", err)
+		}
+	}
+
+	var body bytes.Buffer
+	body.WriteString(`<soap11:Envelope xmlns:soap11="` + soapEnvelopeNS + `"><soap11:Body>`)
+	body.Write(data)
+	body.WriteString(`</soap11:Body></soap11:Envelope>`)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.attributeQueryURL, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	req.Header.Set("SOAPAction", soapAction)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// SOAP faults have the status 500, but tell nothing more useful.
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	envelope, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %v", err)
+	}
+	rawResp, err := soapBody(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	idp := p.currentIDP()
+	samlResp, rootElementSigned, err := p.parseResponse(idp, rawResp)
+	if err != nil {
+		return nil, err
+	}
+	if rootElementSigned {
+		if idp.ssoIssuer != "" && samlResp.Issuer != nil && samlResp.Issuer.Issuer != idp.ssoIssuer {
+			return nil, fmt.Errorf("expected Issuer value %s, got %s", idp.ssoIssuer, samlResp.Issuer.Issuer)
+		}
+		if samlResp.InResponseTo != id {
+			return nil, fmt.Errorf("expected InResponseTo value %s, got %s", id, samlResp.InResponseTo)
+		}
+	}
+	if samlResp.Status == nil {
+		return nil, fmt.Errorf("response did not contain a Status element")
+	}
+	if err := p.validateStatus(samlResp.Status); err != nil {
+		return nil, err
+	}
+
+	assertion := samlResp.Assertion
+	if assertion == nil {
+		return nil, fmt.Errorf("response did not contain an assertion")
+	}
+	// The assertion isn't for a login, so there's no subject confirmation to
+	// validate, but it must be about the same subject.
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Value != nameIDValue {
+		return nil, fmt.Errorf("response did not contain an assertion about %q", nameIDValue)
+	}
+	if assertion.Conditions != nil {
+		if err := p.validateConditions(assertion.Conditions); err != nil {
+			return nil, err
+		}
+	}
+	return assertion, nil
+}
+
+// soapBody returns the SAML Response in the body of a SOAP envelope, with the
+// namespaces it inherits from the envelope declared on it.
+func soapBody(data []byte) ([]byte, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, fmt.Errorf("parse SOAP envelope: %v", err)
+	}
+	envelope := doc.Root()
+	if envelope == nil || envelope.Tag != "Envelope" || envelope.NamespaceURI() != soapEnvelopeNS {
+		return nil, errors.New("response is not a SOAP envelope")
+	}
+	body, err := etreeutils.NSFindOneChild(envelope, soapEnvelopeNS, "Body")
+	if err != nil || body == nil {
+		return nil, errors.New("SOAP envelope has no body")
+	}
+	ctx, err := etreeutils.NSBuildParentContext(body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := etreeutils.NSFindOneChildCtx(ctx, body, protocolSAML2, "Response")
+	if err != nil || resp == nil {
+		return nil, errors.New("SOAP body has no SAML response")
+	}
+	if ctx, err = etreeutils.NSBuildParentContext(resp); err != nil {
+		return nil, err
+	}
+	if resp, err = etreeutils.NSDetatch(ctx, resp); err != nil {
+		return nil, err
+	}
+
+	respDoc := etree.NewDocument()
+	respDoc.SetRoot(resp)
+	return respDoc.WriteToBytes()
+}
+
+// newRequestID returns a random ID for a request. IDs of XML elements can't
+// start with a digit.
+func newRequestID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "_" + hex.EncodeToString(b), nil
+}
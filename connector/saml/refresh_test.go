@@ -0,0 +1,298 @@
+package saml
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/connector/ldap"
+)
+
+// attributeService answers attribute queries with a response built from
+// testdata/good-resp.tmpl, signed with the key of the provider.
+type attributeService struct {
+	t *testing.T
+	p *provider
+	// Overrides of the response, if set.
+	inResponseTo string
+	statusCode   string
+	fault        bool
+}
+
+func (a *attributeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t := a.t
+	if r.Header.Get("SOAPAction") == "" {
+		t.Error("expected a SOAPAction header")
+	}
+	if a.fault {
+		http.Error(w, "fault", http.StatusInternalServerError)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := etree.NewDocument()
+	if err := req.ReadFromBytes(body); err != nil {
+		t.Fatal(err)
+	}
+	query := req.FindElement("//AttributeQuery")
+	if query == nil {
+		t.Fatal("expected an attribute query")
+	}
+	if query.SelectElement("Signature") == nil {
+		t.Error("expected the attribute query to be signed")
+	}
+	nameID := query.FindElement("./Subject/NameID")
+	if nameID == nil || nameID.SelectAttrValue("Format", "") != nameIDFormatPersistent {
+		t.Error("expected the NameID of the user")
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromFile("testdata/good-resp.tmpl"); err != nil {
+		t.Fatal(err)
+	}
+	root := doc.Root()
+	root.RemoveChild(root.SelectElement("Signature"))
+	root.RemoveAttr("Destination")
+	inResponseTo := query.SelectAttrValue("ID", "")
+	if a.inResponseTo != "" {
+		inResponseTo = a.inResponseTo
+	}
+	root.CreateAttr("InResponseTo", inResponseTo)
+	if a.statusCode != "" {
+		root.FindElement("./Status/StatusCode").CreateAttr("Value", a.statusCode)
+	}
+	if nameID != nil {
+		root.FindElement("./Assertion/Subject/NameID").SetText(nameID.Text())
+	}
+	resp, err := doc.WriteToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = a.p.sign(resp); err != nil {
+		t.Fatal(err)
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	io.WriteString(w, `<soap11:Envelope xmlns:soap11="`+soapEnvelopeNS+`"><soap11:Body>`)
+	w.Write(resp)
+	io.WriteString(w, `</soap11:Body></soap11:Envelope>`)
+}
+
+func TestRefreshAttributeQuery(t *testing.T) {
+	a := &attributeService{t: t}
+	s := httptest.NewServer(a)
+	defer s.Close()
+
+	c := Config{
+		CA:           "testdata/ca.crt",
+		UsernameAttr: "Name",
+		EmailAttr:    "email",
+		GroupsAttr:   "groups",
+		RedirectURI:  "http://127.0.0.1:5556/dex/callback",
+		SSOURL:       "http://foo.bar/",
+		// Responses of the test are signed with the same key.
+		SigningCert: "testdata/ca.crt",
+		SigningKey:  "testdata/ca.key",
+		Refresh:     &RefreshConfig{AttributeQueryURL: s.URL},
+	}
+	conn, err := c.Open("saml", logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, ok := conn.(refreshingProvider)
+	if !ok {
+		t.Fatalf("expected a connector which refreshes identities, got %T", conn)
+	}
+	a.p = p.provider
+	now, err := time.Parse(timeFormat, "2017-04-04T04:34:59.330Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	scopes := connector.Scopes{OfflineAccess: true, Groups: true}
+	ident := connector.Identity{
+		UserID:   "eric.chiang+okta@coreos.com",
+		Username: "eric",
+		Email:    "eric.chiang+okta@coreos.com",
+	}
+	got, err := p.Refresh(ctx, scopes, ident)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := connector.Identity{
+		UserID:        "eric.chiang+okta@coreos.com",
+		Username:      "Eric",
+		Email:         "eric.chiang+okta@coreos.com",
+		EmailVerified: true,
+		Groups:        []string{"Everyone", "Admins"},
+	}
+	if diff := pretty.Compare(want, got); diff != "" {
+		t.Error(diff)
+	}
+
+	a.inResponseTo = "_other"
+	if _, err := p.Refresh(ctx, scopes, ident); err == nil {
+		t.Error("expected a response to another query to be rejected")
+	}
+	a.inResponseTo = ""
+
+	a.statusCode = "urn:oasis:names:tc:SAML:2.0:status:Requester"
+	if _, err := p.Refresh(ctx, scopes, ident); err == nil {
+		t.Error("expected a failed query to be rejected")
+	}
+	a.statusCode = ""
+
+	a.fault = true
+	if _, err := p.Refresh(ctx, scopes, ident); err == nil {
+		t.Error("expected a SOAP fault to be rejected")
+	}
+	a.fault = false
+
+	// The attributes are checked again.
+	p.allowedGroups = []string{"Contractors"}
+	if _, err := p.Refresh(ctx, scopes, ident); err == nil {
+		t.Error("expected a user who isn't in the allowed groups to be rejected")
+	}
+}
+
+type fakeUserLookup struct {
+	users  map[string][]string
+	closed *bool
+}
+
+func (f fakeUserLookup) Close() error {
+	*f.closed = true
+	return nil
+}
+
+func (f fakeUserLookup) LookupUser(ctx context.Context, s connector.Scopes, username string) (connector.Identity, error) {
+	groups, ok := f.users[username]
+	if !ok {
+		return connector.Identity{}, errors.New("user not found")
+	}
+	ident := connector.Identity{UserID: "cn=" + username, Username: username}
+	if s.Groups {
+		ident.Groups = groups
+	}
+	return ident, nil
+}
+
+func TestRefreshLookup(t *testing.T) {
+	ident := connector.Identity{
+		UserID:        "abc123",
+		Username:      "jane",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Groups:        []string{"old"},
+	}
+	lookup := fakeUserLookup{users: map[string][]string{
+		"jane@example.com": {"developers", "admins"},
+		"jane":             {"designers"},
+	}}
+
+	tests := []struct {
+		name          string
+		lookupBy      string
+		allowedGroups []string
+		filterGroups  bool
+		scopes        connector.Scopes
+		wantGroups    []string
+		wantErr       bool
+	}{
+		{name: "email", scopes: connector.Scopes{Groups: true}, wantGroups: []string{"developers", "admins"}},
+		{name: "username", lookupBy: lookupByUsername, scopes: connector.Scopes{Groups: true}, wantGroups: []string{"designers"}},
+		{name: "unknown user", lookupBy: lookupByUserID, wantErr: true},
+		{name: "groups not requested", scopes: connector.Scopes{}},
+		{name: "allowed groups", allowedGroups: []string{"admins"}, scopes: connector.Scopes{Groups: true}, wantGroups: []string{"developers", "admins"}},
+		{name: "filtered groups", allowedGroups: []string{"admins"}, filterGroups: true, scopes: connector.Scopes{Groups: true}, wantGroups: []string{"admins"}},
+		{name: "not in allowed groups", allowedGroups: []string{"admins"}, lookupBy: lookupByUsername, scopes: connector.Scopes{Groups: true}, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			lookupBy := tc.lookupBy
+			if lookupBy == "" {
+				lookupBy = lookupByEmail
+			}
+			p := refreshingProvider{&provider{
+				allowedGroups: tc.allowedGroups,
+				filterGroups:  tc.filterGroups,
+				userLookup:    lookup,
+				lookupBy:      lookupBy,
+			}}
+			got, err := p.Refresh(context.Background(), tc.scopes, ident)
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatal(err)
+				}
+				return
+			}
+			if tc.wantErr {
+				t.Fatal("wanted error")
+			}
+			// Only the groups are taken from the directory.
+			want := ident
+			want.Groups = tc.wantGroups
+			if diff := pretty.Compare(want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestRefreshClose(t *testing.T) {
+	// Providers refreshing with an attribute query have nothing to close.
+	if err := (refreshingProvider{&provider{}}).Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var closed bool
+	p := refreshingProvider{&provider{userLookup: fakeUserLookup{closed: &closed}}}
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !closed {
+		t.Error("expected the user lookup connector to be closed")
+	}
+}
+
+func TestRefreshConfig(t *testing.T) {
+	base := Config{
+		CA:           "testdata/ca.crt",
+		UsernameAttr: "Name",
+		EmailAttr:    "email",
+		RedirectURI:  "http://127.0.0.1:5556/dex/callback",
+		SSOURL:       "http://foo.bar/",
+	}
+	conn, err := base.Open("saml", logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := conn.(connector.RefreshConnector); ok {
+		t.Error("expected the connector not to refresh identities unless configured")
+	}
+
+	for name, refresh := range map[string]*RefreshConfig{
+		"no source":       {},
+		"several sources": {AttributeQueryURL: "https://idp.example.com/attributes", LDAP: &ldap.Config{}},
+		"unknown lookup":  {LDAP: &ldap.Config{}, LookupBy: "name"},
+	} {
+		c := base
+		c.Refresh = refresh
+		if _, err := c.Open("saml", logrus.New()); err == nil {
+			t.Errorf("%s: expected the refresh config to be rejected", name)
+		}
+	}
+}
@@ -3,11 +3,14 @@ package saml
 
 import (
 	"bytes"
+	"crypto/rsa"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
 	"encoding/xml"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"sync"
@@ -20,6 +23,7 @@ import (
 	"github.com/russellhaering/goxmldsig/etreeutils"
 
 	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/connector/ldap"
 	"github.com/dexidp/dex/pkg/groups"
 	"github.com/dexidp/dex/pkg/log"
 )
@@ -29,6 +33,8 @@ const (
 	bindingRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
 	bindingPOST     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
 
+	protocolSAML2 = "urn:oasis:names:tc:SAML:2.0:protocol"
+
 	nameIDFormatEmailAddress = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
 	nameIDFormatUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
 	nameIDFormatX509Subject  = "urn:oasis:names:tc:SAML:1.1:nameid-format:X509SubjectName"
@@ -68,15 +74,28 @@ var (
 
 // Config represents configuration options for the SAML provider.
 type Config struct {
-	// TODO(ericchiang): A bunch of these fields could be auto-filled if
-	// we supported SAML metadata discovery.
-	//
-	// https://www.oasis-open.org/committees/download.php/35391/sstc-saml-metadata-errata-2.0-wd-04-diff.pdf
-
 	EntityIssuer string `json:"entityIssuer"`
 	SSOIssuer    string `json:"ssoIssuer"`
 	SSOURL       string `json:"ssoURL"`
 
+	// URL or file of the IdP's metadata, which the SSO URL, the issuer and the
+	// certificates to verify XML signatures with are loaded from. The metadata
+	// is loaded again every IDPMetadataRefreshInterval, 24 hours by default, to
+	// pick up new certificates. ssoURL and ssoIssuer take precedence over the
+	// metadata, and ca or caData are trusted in addition to it.
+	//
+	// https://www.oasis-open.org/committees/download.php/35391/sstc-saml-metadata-errata-2.0-wd-04-diff.pdf
+	IDPMetadataURL             string `json:"idpMetadataURL"`
+	IDPMetadataFile            string `json:"idpMetadataFile"`
+	IDPMetadataRefreshInterval string `json:"idpMetadataRefreshInterval"`
+
+	// File of the certificates the metadata must be signed with. Required if
+	// idpMetadataURL doesn't use https.
+	IDPMetadataSigningCert string `json:"idpMetadataSigningCert"`
+
+	// Overridden by tests.
+	metadataClient *http.Client
+
 	// X509 CA file or raw data to verify XML signatures.
 	CA     string `json:"ca"`
 	CAData []byte `json:"caData"`
@@ -108,6 +127,64 @@ type Config struct {
 	//		urn:oasis:names:tc:SAML:2.0:nameid-format:persistent
 	//
 	NameIDPolicyFormat string `json:"nameIDPolicyFormat"`
+
+	// PEM encoded certificate and RSA private key, as files or raw data, to sign
+	// AuthnRequests with. The certificate is published in the service provider
+	// metadata. Requests aren't signed if these aren't set.
+	SigningCert     string `json:"signingCert"`
+	SigningCertData []byte `json:"signingCertData"`
+	SigningKey      string `json:"signingKey"`
+	SigningKeyData  []byte `json:"signingKeyData"`
+
+	// PEM encoded certificate and RSA private key, as files or raw data, for the
+	// IdP to encrypt assertions with, and dex to decrypt them. The certificate is
+	// published in the service provider metadata.
+	EncryptionCert     string `json:"encryptionCert"`
+	EncryptionCertData []byte `json:"encryptionCertData"`
+	EncryptionKey      string `json:"encryptionKey"`
+	EncryptionKeyData  []byte `json:"encryptionKeyData"`
+
+	// Accept responses the IdP sends without a request of dex, when users log in
+	// from the IdP's portal. Nil disables IdP-initiated logins.
+	IDPInitiated *IDPInitiatedConfig `json:"idpInitiated"`
+
+	// Re-validate users and update their groups when clients refresh their
+	// tokens. Nil disables refresh tokens for the connector.
+	Refresh *RefreshConfig `json:"refresh"`
+}
+
+// IDPInitiatedConfig is the client which users who log in from the IdP are
+// logged in to. The IdP must send its responses to the callback URL of the
+// connector, "/callback/{connector ID}", which must also be the redirectURI of
+// the connector.
+//
+// Nothing ties an unsolicited response to a browser, so the client gets an
+// authorization code without a state. Each assertion is accepted only once.
+type IDPInitiatedConfig struct {
+	ClientID string `json:"clientID"`
+
+	// The redirect URI of the client users are sent to. A RelayState of the IdP's
+	// response which is another redirect URI of the client takes precedence.
+	RedirectURI string `json:"redirectURI"`
+
+	// Scopes to log in with, "openid", "email" and "profile" by default.
+	Scopes []string `json:"scopes"`
+}
+
+// RefreshConfig is where the identities of users are refreshed from, either
+// the IdP's attribute service or an LDAP directory. Exactly one must be set.
+type RefreshConfig struct {
+	// URL of the IdP's AttributeService with the SOAP binding. Attribute queries
+	// are for the NameID of the user, and signed if signingCert is set. The
+	// attributes are mapped like those of a login.
+	AttributeQueryURL string `json:"attributeQueryURL"`
+
+	// LDAP directory to look users up in. Users must exist and not be disabled,
+	// and their groups are replaced with their groups in the directory.
+	LDAP *ldap.Config `json:"ldap"`
+	// Claim of the user which is the LDAP username: "email", the default,
+	// "username" or "userID".
+	LookupBy string `json:"lookupBy"`
 }
 
 type certStore struct {
@@ -121,18 +198,37 @@ func (c certStore) Certificates() (roots []*x509.Certificate, err error) {
 // Open validates the config and returns a connector. It does not actually
 // validate connectivity with the provider.
 func (c *Config) Open(id string, logger log.Logger) (connector.Connector, error) {
-	return c.openConnector(logger)
+	p, err := c.openConnector(logger)
+	if err != nil {
+		return nil, err
+	}
+	if c.Refresh == nil {
+		return p, nil
+	}
+	if err := p.openRefresh(id, c.Refresh, logger); err != nil {
+		return nil, err
+	}
+	return refreshingProvider{p}, nil
 }
 
 func (c *Config) openConnector(logger log.Logger) (*provider, error) {
-	requiredFields := []struct {
+	if c.IDPMetadataURL != "" && c.IDPMetadataFile != "" {
+		return nil, errors.New("must provide either 'idpMetadataURL' or 'idpMetadataFile'")
+	}
+	hasMetadata := c.IDPMetadataURL != "" || c.IDPMetadataFile != ""
+
+	type field struct {
 		name, val string
-	}{
-		{"ssoURL", c.SSOURL},
-		{"usernameAttr", c.UsernameAttr},
-		{"emailAttr", c.EmailAttr},
-		{"redirectURI", c.RedirectURI},
 	}
+	var requiredFields []field
+	if !hasMetadata {
+		requiredFields = append(requiredFields, field{"ssoURL", c.SSOURL})
+	}
+	requiredFields = append(requiredFields,
+		field{"usernameAttr", c.UsernameAttr},
+		field{"emailAttr", c.EmailAttr},
+		field{"redirectURI", c.RedirectURI},
+	)
 	var missing []string
 	for _, f := range requiredFields {
 		if f.val == "" {
@@ -148,9 +244,11 @@ func (c *Config) openConnector(logger log.Logger) (*provider, error) {
 	}
 
 	p := &provider{
-		entityIssuer:  c.EntityIssuer,
-		ssoIssuer:     c.SSOIssuer,
-		ssoURL:        c.SSOURL,
+		entityIssuer: c.EntityIssuer,
+		idp: idpConfig{
+			ssoIssuer: c.SSOIssuer,
+			ssoURL:    c.SSOURL,
+		},
 		now:           time.Now,
 		usernameAttr:  c.UsernameAttr,
 		emailAttr:     c.EmailAttr,
@@ -164,6 +262,20 @@ func (c *Config) openConnector(logger log.Logger) (*provider, error) {
 		nameIDPolicyFormat: c.NameIDPolicyFormat,
 	}
 
+	if c.IDPInitiated != nil {
+		if c.IDPInitiated.ClientID == "" || c.IDPInitiated.RedirectURI == "" {
+			return nil, errors.New("idpInitiated requires 'clientID' and 'redirectURI'")
+		}
+		p.idpInitiated = &connector.IDPInitiatedLogin{
+			ClientID:    c.IDPInitiated.ClientID,
+			RedirectURI: c.IDPInitiated.RedirectURI,
+			Scopes:      c.IDPInitiated.Scopes,
+		}
+		if len(p.idpInitiated.Scopes) == 0 {
+			p.idpInitiated.Scopes = []string{"openid", "email", "profile"}
+		}
+	}
+
 	if p.nameIDPolicyFormat == "" {
 		p.nameIDPolicyFormat = nameIDFormatPersistent
 	} else {
@@ -187,11 +299,22 @@ func (c *Config) openConnector(logger log.Logger) (*provider, error) {
 		}
 	}
 
-	if !c.InsecureSkipSignatureValidation {
-		if (c.CA == "") == (c.CAData == nil) {
-			return nil, errors.New("must provide either 'ca' or 'caData'")
-		}
+	var err error
+	p.signingCert, err = loadKeyPair("signing", c.SigningCert, c.SigningCertData, c.SigningKey, c.SigningKeyData)
+	if err != nil {
+		return nil, err
+	}
+	p.encryptionCert, err = loadKeyPair("encryption", c.EncryptionCert, c.EncryptionCertData, c.EncryptionKey, c.EncryptionKeyData)
+	if err != nil {
+		return nil, err
+	}
 
+	var certs []*x509.Certificate
+	switch {
+	case c.InsecureSkipSignatureValidation:
+	case c.CA != "" && c.CAData != nil, !hasMetadata && c.CA == "" && c.CAData == nil:
+		return nil, errors.New("must provide either 'ca' or 'caData'")
+	case c.CA != "" || c.CAData != nil:
 		var caData []byte
 		if c.CA != "" {
 			data, err := os.ReadFile(c.CA)
@@ -203,42 +326,139 @@ func (c *Config) openConnector(logger log.Logger) (*provider, error) {
 			caData = c.CAData
 		}
 
-		var (
-			certs []*x509.Certificate
-			block *pem.Block
-		)
-		for {
-			block, caData = pem.Decode(caData)
-			if block == nil {
-				caData = bytes.TrimSpace(caData)
-				if len(caData) > 0 { // if there's some left, we've been given bad caData
-					return nil, fmt.Errorf("parse cert: trailing data: %q", string(caData))
-				}
-				break
-			}
-			cert, err := x509.ParseCertificate(block.Bytes)
-			if err != nil {
-				return nil, fmt.Errorf("parse cert: %v", err)
-			}
-			certs = append(certs, cert)
+		if certs, err = parseCerts(caData); err != nil {
+			return nil, err
 		}
 		if len(certs) == 0 {
 			return nil, errors.New("no certificates found in ca data")
 		}
-		p.validator = dsig.NewDefaultValidationContext(certStore{certs})
+		p.idp.validator = dsig.NewDefaultValidationContext(certStore{certs})
+	}
+
+	if hasMetadata {
+		// Metadata served over plain HTTP could be changed on its way, to trust
+		// the attacker's certificates. It has to be signed then.
+		if c.IDPMetadataURL != "" && !strings.HasPrefix(c.IDPMetadataURL, "https://") && c.IDPMetadataSigningCert == "" {
+			return nil, errors.New("'idpMetadataURL' must use https, or 'idpMetadataSigningCert' must be provided")
+		}
+		var signingCerts []*x509.Certificate
+		if c.IDPMetadataSigningCert != "" {
+			data, err := os.ReadFile(c.IDPMetadataSigningCert)
+			if err != nil {
+				return nil, fmt.Errorf("read idpMetadataSigningCert file: %v", err)
+			}
+			if signingCerts, err = parseCerts(data); err != nil {
+				return nil, err
+			}
+			if len(signingCerts) == 0 {
+				return nil, errors.New("no certificates found in idpMetadataSigningCert")
+			}
+		}
+
+		client := c.metadataClient
+		if client == nil {
+			client = &http.Client{Timeout: 30 * time.Second}
+		}
+		refreshInterval := defaultIDPMetadataRefreshInterval
+		if c.IDPMetadataRefreshInterval != "" {
+			if refreshInterval, err = time.ParseDuration(c.IDPMetadataRefreshInterval); err != nil {
+				return nil, fmt.Errorf("invalid idpMetadataRefreshInterval %q: %v", c.IDPMetadataRefreshInterval, err)
+			}
+		}
+		p.metadata = &idpMetadata{
+			url:             c.IDPMetadataURL,
+			file:            c.IDPMetadataFile,
+			client:          client,
+			refreshInterval: refreshInterval,
+			signingCerts:    signingCerts,
+			static:          p.idp,
+			staticCerts:     certs,
+			validate:        !c.InsecureSkipSignatureValidation,
+			logger:          logger,
+			now:             time.Now,
+		}
+		if err := p.metadata.load(); err != nil {
+			return nil, err
+		}
 	}
 	return p, nil
 }
 
+// parseCerts parses the PEM encoded certificates.
+func parseCerts(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	var block *pem.Block
+	for {
+		block, data = pem.Decode(data)
+		if block == nil {
+			data = bytes.TrimSpace(data)
+			if len(data) > 0 { // if there's some left, we've been given bad data
+				return nil, fmt.Errorf("parse cert: trailing data: %q", string(data))
+			}
+			return certs, nil
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse cert: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+}
+
+// loadKeyPair loads a certificate and its RSA private key, each from either a
+// file or raw data. It returns nil if none of them is set.
+func loadKeyPair(use, certFile string, certData []byte, keyFile string, keyData []byte) (*tls.Certificate, error) {
+	load := func(kind, file string, data []byte) ([]byte, error) {
+		if file != "" && data != nil {
+			return nil, fmt.Errorf("must provide either '%s%s' or '%s%sData'", use, kind, use, kind)
+		}
+		if file == "" {
+			return data, nil
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s %s file: %v", use, strings.ToLower(kind), err)
+		}
+		return data, nil
+	}
+	certPEM, err := load("Cert", certFile, certData)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := load("Key", keyFile, keyData)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case certPEM == nil && keyPEM == nil:
+		return nil, nil
+	case certPEM == nil:
+		return nil, fmt.Errorf("%s key provided without a certificate", use)
+	case keyPEM == nil:
+		return nil, fmt.Errorf("%s certificate provided without a key", use)
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s key pair: %v", use, err)
+	}
+	if _, ok := cert.PrivateKey.(*rsa.PrivateKey); !ok {
+		return nil, fmt.Errorf("%s key must be an RSA key", use)
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return nil, fmt.Errorf("parse %s certificate: %v", use, err)
+	}
+	return &cert, nil
+}
+
 type provider struct {
 	entityIssuer string
-	ssoIssuer    string
-	ssoURL       string
 
-	now func() time.Time
+	// The IdP as configured, or its metadata if that's configured instead.
+	idp      idpConfig
+	metadata *idpMetadata
 
-	// If nil, don't do signature validation.
-	validator *dsig.ValidationContext
+	now func() time.Time
 
 	// Attribute mappings
 	usernameAttr  string
@@ -252,15 +472,48 @@ type provider struct {
 
 	nameIDPolicyFormat string
 
+	// Key pairs of dex as a service provider. Either may be nil.
+	signingCert    *tls.Certificate
+	encryptionCert *tls.Certificate
+
+	// Where unsolicited responses log users in to, nil if they're rejected.
+	idpInitiated *connector.IDPInitiatedLogin
+
+	// Where identities are refreshed from, if refresh is enabled.
+	attributeQueryURL string
+	userLookup        connector.UserLookupConnector
+	lookupBy          string
+	client            *http.Client
+
 	logger log.Logger
 }
 
+// currentIDP returns the configuration of the IdP.
+func (p *provider) currentIDP() idpConfig {
+	if p.metadata != nil {
+		return p.metadata.get()
+	}
+	return p.idp
+}
+
+// entityID returns the entity ID of dex as a service provider, which is also
+// the audience it expects in assertions.
+func (p *provider) entityID() string {
+	// Sometimes, dex's issuer string can be different than the redirect URI,
+	// but if dex's issuer isn't explicitly provided assume the redirect URI.
+	if p.entityIssuer != "" {
+		return p.entityIssuer
+	}
+	return p.redirectURI
+}
+
 func (p *provider) POSTData(s connector.Scopes, id string) (action, value string, err error) {
+	idp := p.currentIDP()
 	r := &authnRequest{
 		ProtocolBinding: bindingPOST,
 		ID:              id,
 		IssueInstant:    xmlTime(p.now()),
-		Destination:     p.ssoURL,
+		Destination:     idp.ssoURL,
 		NameIDPolicy: &nameIDPolicy{
 			AllowCreate: true,
 			Format:      p.nameIDPolicyFormat,
@@ -277,10 +530,85 @@ func (p *provider) POSTData(s connector.Scopes, id string) (action, value string
 	if err != nil {
 		return "", "", fmt.Errorf("marshal authn request: %v", err)
 	}
+	if p.signingCert != nil {
+		if data, err = p.sign(data); err != nil {
+			return "", "", fmt.Errorf("sign authn request: %v", err)
+		}
+	}
 
 	// See: https://docs.oasis-open.org/security/saml/v2.0/saml-bindings-2.0-os.pdf
 	// "3.5.4 Message Encoding"
-	return p.ssoURL, base64.StdEncoding.EncodeToString(data), nil
+	return idp.ssoURL, base64.StdEncoding.EncodeToString(data), nil
+}
+
+// sign adds an enveloped signature to a request. The POST binding carries the
+// signature in the request itself.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-bindings-2.0-os.pdf
+// "3.5.4 Message Encoding"
+func (p *provider) sign(data []byte) ([]byte, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, fmt.Errorf("parse document: %v", err)
+	}
+
+	ctx := dsig.NewDefaultSigningContext(dsig.TLSCertKeyStore(*p.signingCert))
+	ctx.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	root := doc.Root()
+	sig, err := ctx.ConstructSignature(root, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// The schema requires the signature to follow the issuer. The enveloped
+	// signature transform removes it wherever it is, so it doesn't have to be
+	// the last element like SignEnveloped adds it.
+	index := 0
+	if issuer := root.SelectElement("Issuer"); issuer != nil {
+		index = issuer.Index() + 1
+	}
+	root.InsertChildAt(index, sig)
+	return doc.WriteToBytes()
+}
+
+// Metadata returns the metadata of dex as a service provider, which IdPs can
+// be configured with.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-metadata-2.0-os.pdf
+// "2.4.4 Element <SPSSODescriptor>"
+func (p *provider) Metadata() ([]byte, error) {
+	sp := spSSODescriptor{
+		ProtocolSupportEnumeration: protocolSAML2,
+		AuthnRequestsSigned:        p.signingCert != nil,
+		WantAssertionsSigned:       p.currentIDP().validator != nil,
+		NameIDFormats:              []string{p.nameIDPolicyFormat},
+		AssertionConsumerServices: []indexedEndpoint{
+			{Binding: bindingPOST, Location: p.redirectURI, Index: 1, IsDefault: true},
+		},
+	}
+	for _, k := range []struct {
+		use  string
+		cert *tls.Certificate
+	}{
+		{"signing", p.signingCert},
+		{"encryption", p.encryptionCert},
+	} {
+		if k.cert == nil {
+			continue
+		}
+		sp.KeyDescriptors = append(sp.KeyDescriptors, keyDescriptor{
+			Use: k.use,
+			KeyInfo: keyInfo{
+				X509Data: x509Data{X509Certificates: []string{base64.StdEncoding.EncodeToString(k.cert.Certificate[0])}},
+			},
+		})
+	}
+
+	data, err := xml.MarshalIndent(entityDescriptor{EntityID: p.entityID(), SPSSODescriptor: &sp}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal metadata: %v", err)
+	}
+	return append([]byte(xml.Header), data...), nil
 }
 
 // HandlePOST interprets a request from a SAML provider attempting to verify a
@@ -290,87 +618,164 @@ func (p *provider) POSTData(s connector.Scopes, id string) (action, value string
 //
 // * Validate XML document does not contain malicious inputs.
 // * Verify signature on XML document (or verify sig on assertion elements).
+// * Decrypt an encrypted assertion.
 // * Verify various parts of the Assertion element. Conditions, audience, etc.
 // * Map the Assertion's attribute elements to user info.
 //
 func (p *provider) HandlePOST(s connector.Scopes, samlResponse, inResponseTo string) (ident connector.Identity, err error) {
-	rawResp, err := base64.StdEncoding.DecodeString(samlResponse)
+	assertion, err := p.verifyResponse(samlResponse, inResponseTo)
 	if err != nil {
-		return ident, fmt.Errorf("decode response: %v", err)
+		return ident, err
 	}
+	return p.identity(s, assertion)
+}
 
-	byteReader := bytes.NewReader(rawResp)
-	if xrvErr := xrv.Validate(byteReader); xrvErr != nil {
-		return ident, errors.Wrap(xrvErr, "validating XML response")
+// IDPInitiatedLogin returns the client users who log in from the IdP are logged
+// in to.
+func (p *provider) IDPInitiatedLogin() (connector.IDPInitiatedLogin, bool) {
+	if p.idpInitiated == nil {
+		return connector.IDPInitiatedLogin{}, false
 	}
+	return *p.idpInitiated, true
+}
 
-	// Root element is allowed to not be signed if the Assertion element is.
-	rootElementSigned := true
-	if p.validator != nil {
-		rawResp, rootElementSigned, err = verifyResponseSig(p.validator, rawResp)
-		if err != nil {
-			return ident, fmt.Errorf("verify signature: %v", err)
+// HandleUnsolicitedPOST verifies a response the IdP sent without a request, and
+// returns the ID of its assertion and when the assertion expires.
+func (p *provider) HandleUnsolicitedPOST(s connector.Scopes, samlResponse string) (ident connector.Identity, assertionID string, expiry time.Time, err error) {
+	if p.idpInitiated == nil {
+		return ident, "", expiry, errors.New("IdP-initiated login is not enabled")
+	}
+	// An unsolicited response must not have an InResponseTo.
+	assertion, err := p.verifyResponse(samlResponse, "")
+	if err != nil {
+		return ident, "", expiry, err
+	}
+	if assertion.ID == "" {
+		return ident, "", expiry, errors.New("assertion has no ID")
+	}
+	// The assertion can't be used after the latest NotOnOrAfter, and the bearer
+	// profile requires one for the subject confirmation.
+	for _, c := range assertion.Subject.SubjectConfirmations {
+		if c.SubjectConfirmationData != nil {
+			if t := time.Time(c.SubjectConfirmationData.NotOnOrAfter); t.After(expiry) {
+				expiry = t
+			}
+		}
+	}
+	if assertion.Conditions != nil {
+		if t := time.Time(assertion.Conditions.NotOnOrAfter); t.After(expiry) {
+			expiry = t
 		}
 	}
+	if expiry.IsZero() {
+		return ident, "", expiry, errors.New("assertion has no NotOnOrAfter")
+	}
 
-	var resp response
-	if err := xml.Unmarshal(rawResp, &resp); err != nil {
-		return ident, fmt.Errorf("unmarshal response: %v", err)
+	if ident, err = p.identity(s, assertion); err != nil {
+		return ident, "", expiry, err
+	}
+	return ident, assertion.ID, expiry.Add(allowedClockDrift), nil
+}
+
+// verifyResponse verifies a response and returns its assertion.
+func (p *provider) verifyResponse(samlResponse, inResponseTo string) (*assertion, error) {
+	rawResp, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, fmt.Errorf("decode response: %v", err)
+	}
+
+	idp := p.currentIDP()
+	resp, rootElementSigned, err := p.parseResponse(idp, rawResp)
+	if err != nil {
+		return nil, err
 	}
 
 	// If the root element isn't signed, there's no reason to inspect these
 	// elements. They're not verified.
 	if rootElementSigned {
-		if p.ssoIssuer != "" && resp.Issuer != nil && resp.Issuer.Issuer != p.ssoIssuer {
-			return ident, fmt.Errorf("expected Issuer value %s, got %s", p.ssoIssuer, resp.Issuer.Issuer)
+		if idp.ssoIssuer != "" && resp.Issuer != nil && resp.Issuer.Issuer != idp.ssoIssuer {
+			return nil, fmt.Errorf("expected Issuer value %s, got %s", idp.ssoIssuer, resp.Issuer.Issuer)
 		}
 
 		// Verify InResponseTo value matches the expected ID associated with
 		// the RelayState.
 		if resp.InResponseTo != inResponseTo {
-			return ident, fmt.Errorf("expected InResponseTo value %s, got %s", inResponseTo, resp.InResponseTo)
+			return nil, fmt.Errorf("expected InResponseTo value %s, got %s", inResponseTo, resp.InResponseTo)
 		}
 
 		// Destination is optional.
 		if resp.Destination != "" && resp.Destination != p.redirectURI {
-			return ident, fmt.Errorf("expected destination %q got %q", p.redirectURI, resp.Destination)
+			return nil, fmt.Errorf("expected destination %q got %q", p.redirectURI, resp.Destination)
 		}
 
 		// Status is a required element.
 		if resp.Status == nil {
-			return ident, fmt.Errorf("response did not contain a Status element")
+			return nil, fmt.Errorf("response did not contain a Status element")
 		}
 
 		if err = p.validateStatus(resp.Status); err != nil {
-			return ident, err
+			return nil, err
 		}
 	}
 
 	assertion := resp.Assertion
 	if assertion == nil {
-		return ident, fmt.Errorf("response did not contain an assertion")
+		return nil, fmt.Errorf("response did not contain an assertion")
 	}
 
 	// Subject is usually optional, but we need it for the user ID, so complain
 	// if it's not present.
 	subject := assertion.Subject
 	if subject == nil {
-		return ident, fmt.Errorf("response did not contain a subject")
+		return nil, fmt.Errorf("response did not contain a subject")
 	}
 
 	// Validate that the response is to the request we originally sent.
 	if err = p.validateSubject(subject, inResponseTo); err != nil {
-		return ident, err
+		return nil, err
 	}
 
 	// Conditions element is optional, but must be validated if present.
 	if assertion.Conditions != nil {
 		// Validate that dex is the intended audience of this response.
 		if err = p.validateConditions(assertion.Conditions); err != nil {
-			return ident, err
+			return nil, err
 		}
 	}
 
+	return assertion, nil
+}
+
+// parseResponse validates the XML of a response, verifies its signature and
+// decrypts its assertion. It reports whether the root element is signed.
+func (p *provider) parseResponse(idp idpConfig, rawResp []byte) (*response, bool, error) {
+	if err := xrv.Validate(bytes.NewReader(rawResp)); err != nil {
+		return nil, false, errors.Wrap(err, "validating XML response")
+	}
+
+	// Root element is allowed to not be signed if the Assertion element is.
+	rootElementSigned := true
+	var err error
+	if idp.validator != nil {
+		rawResp, rootElementSigned, err = p.verifySignature(idp.validator, rawResp)
+		if err != nil {
+			return nil, false, fmt.Errorf("verify signature: %v", err)
+		}
+	} else if rawResp, _, err = p.decryptAssertion(rawResp); err != nil {
+		return nil, false, err
+	}
+
+	var resp response
+	if err := xml.Unmarshal(rawResp, &resp); err != nil {
+		return nil, false, fmt.Errorf("unmarshal response: %v", err)
+	}
+	return &resp, rootElementSigned, nil
+}
+
+// identity maps the subject and the attributes of an assertion to the user's
+// identity.
+func (p *provider) identity(s connector.Scopes, assertion *assertion) (ident connector.Identity, err error) {
+	subject := assertion.Subject
 	switch {
 	case subject.NameID != nil:
 		if ident.UserID = subject.NameID.Value; ident.UserID == "" {
@@ -549,12 +954,7 @@ func (p *provider) validateConditions(conditions *conditions) error {
 		return fmt.Errorf("at %s got response that cannot be processed because it expired at %s", now, notOnOrAfter)
 	}
 
-	// Sometimes, dex's issuer string can be different than the redirect URI,
-	// but if dex's issuer isn't explicitly provided assume the redirect URI.
-	expAud := p.entityIssuer
-	if expAud == "" {
-		expAud = p.redirectURI
-	}
+	expAud := p.entityID()
 
 	// AudienceRestriction elements indicate the intended audience(s) of an
 	// assertion. If dex isn't in these audiences, reject the assertion.
@@ -579,6 +979,26 @@ func (p *provider) validateConditions(conditions *conditions) error {
 	return nil
 }
 
+// verifySignature verifies the signature of a response like verifyResponseSig
+// and decrypts its assertion. A signature of the response covers the assertion
+// as it's encrypted, while a signature of the assertion is encrypted with it.
+func (p *provider) verifySignature(validator *dsig.ValidationContext, data []byte) (signed []byte, rootVerified bool, err error) {
+	signed, rootVerified, err = verifyResponseSig(validator, data)
+	if err == nil {
+		signed, _, err = p.decryptAssertion(signed)
+		return signed, rootVerified, err
+	}
+
+	decrypted, encrypted, decryptErr := p.decryptAssertion(data)
+	if !encrypted {
+		return nil, false, err
+	}
+	if decryptErr != nil {
+		return nil, false, decryptErr
+	}
+	return verifyResponseSig(validator, decrypted)
+}
+
 // verifyResponseSig attempts to verify the signature of a SAML response or
 // the assertion.
 //
@@ -610,7 +1030,7 @@ func verifyResponseSig(validator *dsig.ValidationContext, data []byte) (signed [
 	//
 	// TODO: Only select from child elements of the root.
 	assertion, err := etreeutils.NSSelectOne(response, "urn:oasis:names:tc:SAML:2.0:assertion", "Assertion")
-	if err != nil {
+	if err != nil || assertion == nil {
 		return nil, false, fmt.Errorf("response does not contain an Assertion element")
 	}
 	transformedAssertion, err := validator.Validate(assertion)
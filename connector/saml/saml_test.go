@@ -4,12 +4,14 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
+	"encoding/xml"
 	"errors"
 	"os"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/beevik/etree"
 	"github.com/kylelemons/godebug/pretty"
 	dsig "github.com/russellhaering/goxmldsig"
 	"github.com/sirupsen/logrus"
@@ -590,3 +592,250 @@ func TestVerifySignedMessageAndSignedAssertion(t *testing.T) {
 func TestVerifyUnsignedMessageAndUnsignedAssertion(t *testing.T) {
 	runVerify(t, "testdata/idp-cert.pem", "testdata/idp-resp.xml", false)
 }
+
+func TestSignedAuthnRequest(t *testing.T) {
+	c := Config{
+		CA:           "testdata/ca.crt",
+		UsernameAttr: "Name",
+		EmailAttr:    "email",
+		RedirectURI:  "http://127.0.0.1:5556/dex/callback",
+		EntityIssuer: "http://127.0.0.1:5556/dex/callback",
+		SSOURL:       "http://foo.bar/",
+		SigningCert:  "testdata/ca.crt",
+		SigningKey:   "testdata/ca.key",
+	}
+	conn, err := c.openConnector(logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, value, err := conn.POSTData(connector.Scopes{}, "request-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := loadCert("testdata/ca.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		t.Fatal(err)
+	}
+	// The signature must follow the issuer.
+	if children := doc.Root().ChildElements(); len(children) < 2 || children[0].Tag != "Issuer" || children[1].Tag != "Signature" {
+		t.Errorf("expected the signature to follow the issuer: %s", data)
+	}
+	validator := dsig.NewDefaultValidationContext(certStore{[]*x509.Certificate{cert}})
+	if _, err := validator.Validate(doc.Root()); err != nil {
+		t.Errorf("invalid signature: %v", err)
+	}
+}
+
+func TestConfigKeyPairs(t *testing.T) {
+	base := func() Config {
+		return Config{
+			CA:           "testdata/ca.crt",
+			UsernameAttr: "Name",
+			EmailAttr:    "email",
+			RedirectURI:  "http://127.0.0.1:5556/dex/callback",
+			SSOURL:       "http://foo.bar/",
+		}
+	}
+	certPEM, err := os.ReadFile("testdata/ca.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM, err := os.ReadFile("testdata/ca.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	badKeyPEM, err := os.ReadFile("testdata/bad-ca.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(c *Config)
+		wantErr bool
+	}{
+		{"none", func(c *Config) {}, false},
+		{"files", func(c *Config) {
+			c.SigningCert, c.SigningKey = "testdata/ca.crt", "testdata/ca.key"
+			c.EncryptionCert, c.EncryptionKey = "testdata/ca.crt", "testdata/ca.key"
+		}, false},
+		{"data", func(c *Config) {
+			c.SigningCertData, c.SigningKeyData = certPEM, keyPEM
+		}, false},
+		{"file and data", func(c *Config) {
+			c.SigningCert, c.SigningCertData, c.SigningKeyData = "testdata/ca.crt", certPEM, keyPEM
+		}, true},
+		{"missing key", func(c *Config) {
+			c.EncryptionCert = "testdata/ca.crt"
+		}, true},
+		{"mismatched key", func(c *Config) {
+			c.SigningCertData, c.SigningKeyData = certPEM, badKeyPEM
+		}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := base()
+			tc.mutate(&c)
+			_, err := c.openConnector(logrus.New())
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	c := Config{
+		CA:                 "testdata/ca.crt",
+		UsernameAttr:       "Name",
+		EmailAttr:          "email",
+		RedirectURI:        "http://127.0.0.1:5556/dex/callback",
+		EntityIssuer:       "http://127.0.0.1:5556/dex",
+		SSOURL:             "http://foo.bar/",
+		NameIDPolicyFormat: "emailAddress",
+		SigningCert:        "testdata/ca.crt",
+		SigningKey:         "testdata/ca.key",
+	}
+	conn, err := c.openConnector(logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := conn.Metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got entityDescriptor
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal metadata: %v", err)
+	}
+	cert, err := loadCert("testdata/ca.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := entityDescriptor{
+		XMLName:  xml.Name{Space: "urn:oasis:names:tc:SAML:2.0:metadata", Local: "EntityDescriptor"},
+		EntityID: "http://127.0.0.1:5556/dex",
+		SPSSODescriptor: &spSSODescriptor{
+			XMLName:                    xml.Name{Space: "urn:oasis:names:tc:SAML:2.0:metadata", Local: "SPSSODescriptor"},
+			ProtocolSupportEnumeration: protocolSAML2,
+			AuthnRequestsSigned:        true,
+			WantAssertionsSigned:       true,
+			KeyDescriptors: []keyDescriptor{{
+				XMLName: xml.Name{Space: "urn:oasis:names:tc:SAML:2.0:metadata", Local: "KeyDescriptor"},
+				Use:     "signing",
+				KeyInfo: keyInfo{
+					XMLName: xml.Name{Space: "http://www.w3.org/2000/09/xmldsig#", Local: "KeyInfo"},
+					X509Data: x509Data{
+						XMLName:          xml.Name{Space: "http://www.w3.org/2000/09/xmldsig#", Local: "X509Data"},
+						X509Certificates: []string{base64.StdEncoding.EncodeToString(cert.Raw)},
+					},
+				},
+			}},
+			NameIDFormats: []string{nameIDFormatEmailAddress},
+			AssertionConsumerServices: []indexedEndpoint{
+				{Binding: bindingPOST, Location: "http://127.0.0.1:5556/dex/callback", Index: 1, IsDefault: true},
+			},
+		},
+	}
+	if diff := pretty.Compare(want, got); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestIDPInitiated(t *testing.T) {
+	c := Config{
+		CA:           "testdata/ca.crt",
+		UsernameAttr: "Name",
+		EmailAttr:    "email",
+		RedirectURI:  "http://127.0.0.1:5556/dex/callback",
+		SSOURL:       "http://foo.bar/",
+		// Responses of the test are signed with the same key.
+		SigningCert: "testdata/ca.crt",
+		SigningKey:  "testdata/ca.key",
+		IDPInitiated: &IDPInitiatedConfig{
+			ClientID:    "portal",
+			RedirectURI: "https://app.example.com/callback",
+		},
+	}
+	p, err := c.openConnector(logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now, err := time.Parse(timeFormat, "2017-04-04T04:34:59.330Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.now = func() time.Time { return now }
+
+	login, ok := p.IDPInitiatedLogin()
+	if !ok {
+		t.Fatal("expected IdP-initiated logins to be enabled")
+	}
+	wantLogin := connector.IDPInitiatedLogin{
+		ClientID:    "portal",
+		RedirectURI: "https://app.example.com/callback",
+		Scopes:      []string{"openid", "email", "profile"},
+	}
+	if diff := pretty.Compare(wantLogin, login); diff != "" {
+		t.Error(diff)
+	}
+
+	// An unsolicited response has no InResponseTo.
+	doc := etree.NewDocument()
+	if err := doc.ReadFromFile("testdata/good-resp.tmpl"); err != nil {
+		t.Fatal(err)
+	}
+	root := doc.Root()
+	root.RemoveChild(root.SelectElement("Signature"))
+	root.RemoveAttr("InResponseTo")
+	root.FindElement("//SubjectConfirmationData").RemoveAttr("InResponseTo")
+	resp, err := doc.WriteToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = p.sign(resp); err != nil {
+		t.Fatal(err)
+	}
+
+	ident, assertionID, expiry, err := p.HandleUnsolicitedPOST(connector.Scopes{}, base64.StdEncoding.EncodeToString(resp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ident.Email != "eric.chiang+okta@coreos.com" {
+		t.Errorf("unexpected identity %#v", ident)
+	}
+	if assertionID != "id199065211253338521862321146" {
+		t.Errorf("unexpected assertion ID %q", assertionID)
+	}
+	// The assertion expires at its NotOnOrAfter, allowing for clock drift.
+	if want := now.Add(5*time.Minute + allowedClockDrift); !expiry.Equal(want) {
+		t.Errorf("expected expiry %v, got %v", want, expiry)
+	}
+
+	// Responses to a request of dex are rejected.
+	resp, err = os.ReadFile("testdata/good-resp.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := p.HandleUnsolicitedPOST(connector.Scopes{}, base64.StdEncoding.EncodeToString(resp)); err == nil {
+		t.Error("expected a solicited response to be rejected")
+	}
+
+	c.IDPInitiated = nil
+	if p, err = c.openConnector(logrus.New()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.IDPInitiatedLogin(); ok {
+		t.Error("expected IdP-initiated logins to be disabled")
+	}
+}
@@ -68,6 +68,18 @@ type authnRequest struct {
 	RequestAuthnContext *requestAuthnContext `xml:"RequestAuthnContext,omitempty"`
 }
 
+type attributeQuery struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol AttributeQuery"`
+
+	ID           string      `xml:"ID,attr"`
+	Version      samlVersion `xml:"Version,attr"`
+	IssueInstant xmlTime     `xml:"IssueInstant,attr"`
+	Destination  string      `xml:"Destination,attr,omitempty"`
+
+	Issuer  *issuer  `xml:"Issuer,omitempty"`
+	Subject *subject `xml:"Subject"`
+}
+
 type subject struct {
 	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Subject"`
 
@@ -80,7 +92,7 @@ type subject struct {
 type nameID struct {
 	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
 
-	Format string `xml:"Format,omitempty"`
+	Format string `xml:"Format,attr,omitempty"`
 	Value  string `xml:",chardata"`
 }
 
@@ -275,3 +287,86 @@ func (a attribute) String() string {
 	// "groups" = ["engineering", "docs"]
 	return fmt.Sprintf("%q = %q", a.Name, values)
 }
+
+type entitiesDescriptor struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntitiesDescriptor"`
+
+	EntitiesDescriptors []entitiesDescriptor `xml:"EntitiesDescriptor"`
+	EntityDescriptors   []entityDescriptor   `xml:"EntityDescriptor"`
+}
+
+// all returns the entities of the descriptor and of all nested descriptors.
+func (e *entitiesDescriptor) all() []entityDescriptor {
+	entities := e.EntityDescriptors
+	for _, nested := range e.EntitiesDescriptors {
+		entities = append(entities, nested.all()...)
+	}
+	return entities
+}
+
+type entityDescriptor struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+
+	EntityID string `xml:"entityID,attr"`
+
+	IDPSSODescriptor *idpSSODescriptor `xml:"IDPSSODescriptor,omitempty"`
+	SPSSODescriptor  *spSSODescriptor  `xml:"SPSSODescriptor,omitempty"`
+}
+
+type idpSSODescriptor struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+
+	ProtocolSupportEnumeration string `xml:"protocolSupportEnumeration,attr"`
+	WantAuthnRequestsSigned    bool   `xml:"WantAuthnRequestsSigned,attr,omitempty"`
+
+	KeyDescriptors       []keyDescriptor `xml:"KeyDescriptor,omitempty"`
+	NameIDFormats        []string        `xml:"urn:oasis:names:tc:SAML:2.0:metadata NameIDFormat,omitempty"`
+	SingleSignOnServices []endpoint      `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleSignOnService"`
+}
+
+type spSSODescriptor struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata SPSSODescriptor"`
+
+	ProtocolSupportEnumeration string `xml:"protocolSupportEnumeration,attr"`
+	AuthnRequestsSigned        bool   `xml:"AuthnRequestsSigned,attr"`
+	WantAssertionsSigned       bool   `xml:"WantAssertionsSigned,attr"`
+
+	KeyDescriptors            []keyDescriptor   `xml:"KeyDescriptor,omitempty"`
+	NameIDFormats             []string          `xml:"urn:oasis:names:tc:SAML:2.0:metadata NameIDFormat,omitempty"`
+	AssertionConsumerServices []indexedEndpoint `xml:"urn:oasis:names:tc:SAML:2.0:metadata AssertionConsumerService"`
+}
+
+type keyDescriptor struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+
+	Use string `xml:"use,attr,omitempty"`
+
+	KeyInfo keyInfo `xml:"KeyInfo"`
+}
+
+type keyInfo struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
+
+	X509Data x509Data `xml:"X509Data"`
+}
+
+type x509Data struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# X509Data"`
+
+	X509Certificates []string `xml:"http://www.w3.org/2000/09/xmldsig# X509Certificate"`
+}
+
+// endpoint is the type of metadata elements such as SingleSignOnService.
+type endpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// indexedEndpoint is the type of metadata elements such as
+// AssertionConsumerService.
+type indexedEndpoint struct {
+	Binding   string `xml:"Binding,attr"`
+	Location  string `xml:"Location,attr"`
+	Index     int    `xml:"index,attr"`
+	IsDefault bool   `xml:"isDefault,attr,omitempty"`
+}
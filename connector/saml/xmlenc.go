@@ -0,0 +1,230 @@
+package saml
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha1" // for crypto.SHA1.New
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+	xrv "github.com/mattermost/xml-roundtrip-validator"
+)
+
+// Algorithms of XML Encryption supported for encrypted assertions. Keys must
+// be transported with RSA-OAEP, RSA PKCS #1 v1.5 is vulnerable to padding
+// oracle attacks.
+//
+// See: https://www.w3.org/TR/xmlenc-core1/
+const (
+	xencAES128CBC = "http://www.w3.org/2001/04/xmlenc#aes128-cbc"
+	xencAES192CBC = "http://www.w3.org/2001/04/xmlenc#aes192-cbc"
+	xencAES256CBC = "http://www.w3.org/2001/04/xmlenc#aes256-cbc"
+	xencAES128GCM = "http://www.w3.org/2009/xmlenc11#aes128-gcm"
+	xencAES192GCM = "http://www.w3.org/2009/xmlenc11#aes192-gcm"
+	xencAES256GCM = "http://www.w3.org/2009/xmlenc11#aes256-gcm"
+
+	// RSA-OAEP with MGF1 with SHA-1, and RSA-OAEP with the same digest for MGF1
+	// and the label.
+	xencRSAOAEPMGF1P = "http://www.w3.org/2001/04/xmlenc#rsa-oaep-mgf1p"
+	xencRSAOAEP      = "http://www.w3.org/2009/xmlenc11#rsa-oaep"
+
+	digestSHA1   = "http://www.w3.org/2000/09/xmldsig#sha1"
+	digestSHA256 = "http://www.w3.org/2001/04/xmlenc#sha256"
+	digestSHA512 = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+// decryptAssertion replaces the EncryptedAssertion element of a response with
+// the Assertion it contains. It reports whether the response had an encrypted
+// assertion, and returns the response unchanged if it didn't.
+func (p *provider) decryptAssertion(data []byte) ([]byte, bool, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, false, fmt.Errorf("parse document: %v", err)
+	}
+	encrypted := doc.Root().SelectElement("EncryptedAssertion")
+	if encrypted == nil {
+		return data, false, nil
+	}
+	if p.encryptionCert == nil {
+		return nil, true, errors.New("response contains an encrypted assertion, but no encryption key is configured")
+	}
+
+	plaintext, err := decryptElement(encrypted, p.encryptionCert.PrivateKey.(*rsa.PrivateKey))
+	if err != nil {
+		return nil, true, fmt.Errorf("decrypt assertion: %v", err)
+	}
+	if err := xrv.Validate(bytes.NewReader(plaintext)); err != nil {
+		return nil, true, fmt.Errorf("validating XML assertion: %v", err)
+	}
+	assertionDoc := etree.NewDocument()
+	if err := assertionDoc.ReadFromBytes(plaintext); err != nil {
+		return nil, true, fmt.Errorf("parse decrypted assertion: %v", err)
+	}
+	assertion := assertionDoc.Root()
+	if assertion == nil || assertion.Tag != "Assertion" {
+		return nil, true, errors.New("encrypted data is not an assertion")
+	}
+
+	root := doc.Root()
+	index := encrypted.Index()
+	root.RemoveChildAt(index)
+	root.InsertChildAt(index, assertion)
+	data, err = doc.WriteToBytes()
+	return data, true, err
+}
+
+// decryptElement decrypts the EncryptedData child of an element. The key is
+// taken from an EncryptedKey element in the key info of the encrypted data,
+// or next to it.
+func decryptElement(el *etree.Element, key *rsa.PrivateKey) ([]byte, error) {
+	encData := el.SelectElement("EncryptedData")
+	if encData == nil {
+		return nil, errors.New("no EncryptedData element")
+	}
+	var encKey *etree.Element
+	if keyInfo := encData.SelectElement("KeyInfo"); keyInfo != nil {
+		encKey = keyInfo.SelectElement("EncryptedKey")
+	}
+	if encKey == nil {
+		encKey = el.SelectElement("EncryptedKey")
+	}
+	if encKey == nil {
+		return nil, errors.New("no EncryptedKey element")
+	}
+
+	sessionKey, err := decryptKey(encKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt key: %v", err)
+	}
+	ciphertext, err := cipherValue(encData)
+	if err != nil {
+		return nil, err
+	}
+
+	alg := encryptionMethod(encData)
+	var keySize int
+	switch alg {
+	case xencAES128CBC, xencAES128GCM:
+		keySize = 16
+	case xencAES192CBC, xencAES192GCM:
+		keySize = 24
+	case xencAES256CBC, xencAES256GCM:
+		keySize = 32
+	default:
+		return nil, fmt.Errorf("unsupported encryption method %q", alg)
+	}
+	if len(sessionKey) != keySize {
+		return nil, fmt.Errorf("expected a key of %d bytes for %q, got %d", keySize, alg, len(sessionKey))
+	}
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch alg {
+	case xencAES128GCM, xencAES192GCM, xencAES256GCM:
+		// The nonce precedes the ciphertext, the tag follows it.
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		if len(ciphertext) < gcm.NonceSize()+gcm.Overhead() {
+			return nil, errors.New("ciphertext too short")
+		}
+		nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+		return gcm.Open(nil, nonce, ciphertext, nil)
+	default:
+		// The IV precedes the ciphertext, and the last byte of the plaintext is the
+		// length of the padding. The other padding bytes are arbitrary.
+		bs := block.BlockSize()
+		if len(ciphertext) < 2*bs || len(ciphertext)%bs != 0 {
+			return nil, errors.New("invalid ciphertext length")
+		}
+		iv, ciphertext := ciphertext[:bs], ciphertext[bs:]
+		plaintext := make([]byte, len(ciphertext))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+		padding := int(plaintext[len(plaintext)-1])
+		if padding == 0 || padding > bs {
+			return nil, errors.New("invalid padding")
+		}
+		return plaintext[:len(plaintext)-padding], nil
+	}
+}
+
+func decryptKey(encKey *etree.Element, key *rsa.PrivateKey) ([]byte, error) {
+	ciphertext, err := cipherValue(encKey)
+	if err != nil {
+		return nil, err
+	}
+
+	alg := encryptionMethod(encKey)
+	if alg != xencRSAOAEPMGF1P && alg != xencRSAOAEP {
+		return nil, fmt.Errorf("unsupported key transport method %q", alg)
+	}
+	h := crypto.SHA1
+	if method := encKey.SelectElement("EncryptionMethod"); method != nil {
+		if digest := method.SelectElement("DigestMethod"); digest != nil {
+			switch d := digest.SelectAttrValue("Algorithm", ""); d {
+			case digestSHA1:
+			case digestSHA256:
+				h = crypto.SHA256
+			case digestSHA512:
+				h = crypto.SHA512
+			default:
+				return nil, fmt.Errorf("unsupported digest method %q", d)
+			}
+		}
+		// MGF1 uses SHA-1 with rsa-oaep-mgf1p, and the digest of the label
+		// otherwise, which is all rsa.DecryptOAEP supports.
+		if alg == xencRSAOAEPMGF1P && h != crypto.SHA1 {
+			return nil, fmt.Errorf("unsupported digest method for %q", alg)
+		}
+		if mgf := method.SelectElement("MGF"); mgf != nil && alg == xencRSAOAEP {
+			if want := "http://www.w3.org/2009/xmlenc11#mgf1" + mgfSuffix(h); mgf.SelectAttrValue("Algorithm", "") != want {
+				return nil, fmt.Errorf("unsupported mask generation function %q", mgf.SelectAttrValue("Algorithm", ""))
+			}
+		}
+	}
+	return rsa.DecryptOAEP(h.New(), rand.Reader, key, ciphertext, nil)
+}
+
+func mgfSuffix(h crypto.Hash) string {
+	switch h {
+	case crypto.SHA256:
+		return "sha256"
+	case crypto.SHA512:
+		return "sha512"
+	default:
+		return "sha1"
+	}
+}
+
+func encryptionMethod(el *etree.Element) string {
+	method := el.SelectElement("EncryptionMethod")
+	if method == nil {
+		return ""
+	}
+	return method.SelectAttrValue("Algorithm", "")
+}
+
+func cipherValue(el *etree.Element) ([]byte, error) {
+	cipherData := el.SelectElement("CipherData")
+	if cipherData == nil {
+		return nil, fmt.Errorf("no CipherData element in %s", el.Tag)
+	}
+	value := cipherData.SelectElement("CipherValue")
+	if value == nil {
+		return nil, fmt.Errorf("no CipherValue element in %s", el.Tag)
+	}
+	// Base64 values are often wrapped.
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value.Text()), ""))
+}
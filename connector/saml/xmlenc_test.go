@@ -0,0 +1,204 @@
+package saml
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dexidp/dex/connector"
+)
+
+// encryptedResponseTest builds a response from testdata/good-resp.tmpl with an
+// encrypted assertion, signed as configured.
+type encryptedResponseTest struct {
+	alg            string
+	signAssertion  bool
+	signResponse   bool
+	skipValidation bool
+	noKey          bool
+	wantErr        bool
+}
+
+func TestEncryptedAssertion(t *testing.T) {
+	tests := map[string]encryptedResponseTest{
+		"signed response":                       {alg: xencAES256GCM, signResponse: true},
+		"signed assertion":                      {alg: xencAES128CBC, signAssertion: true},
+		"signed response and assertion":         {alg: xencAES192GCM, signResponse: true, signAssertion: true},
+		"unsigned":                              {alg: xencAES256CBC, wantErr: true},
+		"unsigned without signature validation": {alg: xencAES256CBC, skipValidation: true},
+		"no encryption key":                     {alg: xencAES128GCM, signResponse: true, noKey: true, wantErr: true},
+		"unsupported algorithm":                 {alg: "http://www.w3.org/2001/04/xmlenc#tripledes-cbc", signResponse: true, wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, tc.run)
+	}
+}
+
+func (tc encryptedResponseTest) run(t *testing.T) {
+	c := Config{
+		CA:           "testdata/ca.crt",
+		UsernameAttr: "Name",
+		EmailAttr:    "email",
+		RedirectURI:  "http://127.0.0.1:5556/dex/callback",
+		SSOURL:       "http://foo.bar/",
+		// Responses of the test are signed with the same key.
+		SigningCert: "testdata/ca.crt",
+		SigningKey:  "testdata/ca.key",
+	}
+	if !tc.noKey {
+		c.EncryptionCert, c.EncryptionKey = "testdata/ca.crt", "testdata/ca.key"
+	}
+	p, err := c.openConnector(logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The encryption key to encrypt for, even if dex doesn't have it.
+	key, err := loadKeyPair("encryption", "testdata/ca.crt", nil, "testdata/ca.key", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromFile("testdata/good-resp.tmpl"); err != nil {
+		t.Fatal(err)
+	}
+	root := doc.Root()
+	root.RemoveChild(root.SelectElement("Signature"))
+	assertion := root.SelectElement("Assertion")
+
+	assertionDoc := etree.NewDocument()
+	assertionDoc.SetRoot(assertion.Copy())
+	plaintext, err := assertionDoc.WriteToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.signAssertion {
+		if plaintext, err = p.sign(plaintext); err != nil {
+			t.Fatal(err)
+		}
+	}
+	encrypted := etree.NewDocument()
+	if err := encrypted.ReadFromString(encryptAssertion(t, plaintext, key.Leaf.PublicKey.(*rsa.PublicKey), tc.alg)); err != nil {
+		t.Fatal(err)
+	}
+	index := assertion.Index()
+	root.RemoveChildAt(index)
+	root.InsertChildAt(index, encrypted.Root())
+
+	resp, err := doc.WriteToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.signResponse {
+		if resp, err = p.sign(resp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if tc.skipValidation {
+		p.idp.validator = nil
+	}
+	now, err := time.Parse(timeFormat, "2017-04-04T04:34:59.330Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.now = func() time.Time { return now }
+
+	ident, err := p.HandlePOST(connector.Scopes{}, base64.StdEncoding.EncodeToString(resp), "6zmm5mguyebwvajyf2sdwwcw6m")
+	if err != nil {
+		if !tc.wantErr {
+			t.Fatalf("handle response: %v", err)
+		}
+		return
+	}
+	if tc.wantErr {
+		t.Fatal("wanted error")
+	}
+	want := connector.Identity{
+		UserID:        "eric.chiang+okta@coreos.com",
+		Username:      "Eric",
+		Email:         "eric.chiang+okta@coreos.com",
+		EmailVerified: true,
+	}
+	if diff := pretty.Compare(want, ident); diff != "" {
+		t.Error(diff)
+	}
+}
+
+// encryptAssertion returns an EncryptedAssertion element of the plaintext,
+// with the key encrypted with RSA-OAEP.
+func encryptAssertion(t *testing.T, plaintext []byte, pub *rsa.PublicKey, alg string) string {
+	keySize := 16
+	switch alg {
+	case xencAES192CBC, xencAES192GCM:
+		keySize = 24
+	case xencAES256CBC, xencAES256GCM:
+		keySize = 32
+	}
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ciphertext []byte
+	switch alg {
+	case xencAES128GCM, xencAES192GCM, xencAES256GCM:
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nonce := make([]byte, gcm.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			t.Fatal(err)
+		}
+		ciphertext = gcm.Seal(nonce, nonce, plaintext, nil)
+	default:
+		bs := block.BlockSize()
+		padding := bs - len(plaintext)%bs
+		padded := append(append([]byte{}, plaintext...), make([]byte, padding)...)
+		padded[len(padded)-1] = byte(padding)
+		ciphertext = make([]byte, bs+len(padded))
+		if _, err := rand.Read(ciphertext[:bs]); err != nil {
+			t.Fatal(err)
+		}
+		cipher.NewCBCEncrypter(block, ciphertext[:bs]).CryptBlocks(ciphertext[bs:], padded)
+	}
+
+	encKey, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, pub, key, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf(`<saml2:EncryptedAssertion xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion">
+  <xenc:EncryptedData xmlns:xenc="http://www.w3.org/2001/04/xmlenc#" Type="http://www.w3.org/2001/04/xmlenc#Element">
+    <xenc:EncryptionMethod Algorithm="%s"/>
+    <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+      <xenc:EncryptedKey>
+        <xenc:EncryptionMethod Algorithm="%s">
+          <ds:DigestMethod Algorithm="%s"/>
+        </xenc:EncryptionMethod>
+        <xenc:CipherData>
+          <xenc:CipherValue>%s</xenc:CipherValue>
+        </xenc:CipherData>
+      </xenc:EncryptedKey>
+    </ds:KeyInfo>
+    <xenc:CipherData>
+      <xenc:CipherValue>%s</xenc:CipherValue>
+    </xenc:CipherData>
+  </xenc:EncryptedData>
+</saml2:EncryptedAssertion>`, alg, xencRSAOAEPMGF1P, digestSHA1,
+		base64.StdEncoding.EncodeToString(encKey), base64.StdEncoding.EncodeToString(ciphertext))
+}
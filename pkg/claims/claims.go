@@ -0,0 +1,197 @@
+// Package claims maps the claims of upstream tokens and userinfo responses to
+// the identities connectors return.
+package claims
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Path selects a claim, which may be nested in objects and arrays, with a
+// JSONPath-like expression:
+//
+//	groups
+//	realm_access.roles
+//	resource_access["my-app"].roles
+//	$.addresses[0].country
+//
+// A claim named after the whole expression is preferred, so keys containing
+// dots, like "https://example.com/groups", keep working without quoting.
+type Path struct {
+	expr string
+	// Object keys (string) and array indices (int).
+	elems []interface{}
+}
+
+// ParsePath parses a path expression. The empty expression is the zero Path,
+// which selects nothing.
+func ParsePath(expr string) (Path, error) {
+	p := Path{expr: expr}
+	if expr == "" {
+		return p, nil
+	}
+	s := expr
+	if strings.HasPrefix(s, "$.") || strings.HasPrefix(s, "$[") {
+		s = s[1:]
+	}
+
+	for s != "" {
+		switch s[0] {
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return p, fmt.Errorf("claims: unterminated '[' in path %q", expr)
+			}
+			inner := s[1:end]
+			if len(inner) >= 2 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+				p.elems = append(p.elems, inner[1:len(inner)-1])
+			} else if i, err := strconv.Atoi(inner); err == nil && i >= 0 {
+				p.elems = append(p.elems, i)
+			} else {
+				return p, fmt.Errorf("claims: invalid index %q in path %q", inner, expr)
+			}
+			s = s[end+1:]
+		case '.':
+			s = s[1:]
+			if s == "" || s[0] == '.' || s[0] == '[' {
+				return p, fmt.Errorf("claims: empty key in path %q", expr)
+			}
+		default:
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			p.elems = append(p.elems, s[:end])
+			s = s[end:]
+		}
+	}
+	return p, nil
+}
+
+// String returns the expression the path was parsed from.
+func (p Path) String() string {
+	return p.expr
+}
+
+// IsZero reports whether the path is empty.
+func (p Path) IsZero() bool {
+	return p.expr == ""
+}
+
+// Lookup returns the value the path selects in the claims.
+func (p Path) Lookup(claims map[string]interface{}) (interface{}, bool) {
+	if p.IsZero() {
+		return nil, false
+	}
+	if v, ok := claims[p.expr]; ok {
+		return v, true
+	}
+
+	var v interface{} = claims
+	for _, elem := range p.elems {
+		switch elem := elem.(type) {
+		case string:
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if v, ok = m[elem]; !ok {
+				return nil, false
+			}
+		case int:
+			a, ok := v.([]interface{})
+			if !ok || elem >= len(a) {
+				return nil, false
+			}
+			v = a[elem]
+		}
+	}
+	return v, true
+}
+
+// LookupString returns the string the path selects. It's not found if the
+// claim isn't a string.
+func (p Path) LookupString(claims map[string]interface{}) (string, bool) {
+	v, _ := p.Lookup(claims)
+	s, ok := v.(string)
+	return s, ok
+}
+
+// LookupBool returns the boolean the path selects. Some providers send
+// booleans as strings, which are accepted too.
+func (p Path) LookupBool(claims map[string]interface{}) (bool, bool) {
+	v, _ := p.Lookup(claims)
+	switch v := v.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	}
+	return false, false
+}
+
+// LookupStrings returns the list of strings the path selects. A single string
+// is a list of one. It's an error if the claim is something else.
+func (p Path) LookupStrings(claims map[string]interface{}) ([]string, bool, error) {
+	v, found := p.Lookup(claims)
+	if !found || v == nil {
+		return nil, false, nil
+	}
+	switch v := v.(type) {
+	case string:
+		return []string{v}, true, nil
+	case []interface{}:
+		strs := make([]string, 0, len(v))
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, true, fmt.Errorf("malformed %q claim", p.expr)
+			}
+			strs = append(strs, s)
+		}
+		return strs, true, nil
+	}
+	return nil, true, fmt.Errorf("malformed %q claim", p.expr)
+}
+
+// GroupsMapper filters the groups of a provider and prefixes them, so groups
+// of different connectors can be told apart.
+type GroupsMapper struct {
+	prefix string
+	filter *regexp.Regexp
+}
+
+// NewGroupsMapper returns a mapper which keeps the groups matching the
+// regular expression filter, if it isn't empty, and adds the prefix to them.
+// The filter must match the whole group, a filter "admin" doesn't keep
+// "not-admin".
+func NewGroupsMapper(prefix, filter string) (*GroupsMapper, error) {
+	m := &GroupsMapper{prefix: prefix}
+	if filter != "" {
+		re, err := regexp.Compile("^(?:" + filter + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("claims: invalid groups filter: %v", err)
+		}
+		m.filter = re
+	}
+	return m, nil
+}
+
+// Map returns the groups to put in the identity. The filter applies to the
+// groups of the provider, before they're prefixed.
+func (m *GroupsMapper) Map(groups []string) []string {
+	if m == nil || (m.prefix == "" && m.filter == nil) {
+		return groups
+	}
+	var mapped []string
+	for _, g := range groups {
+		if m.filter != nil && !m.filter.MatchString(g) {
+			continue
+		}
+		mapped = append(mapped, m.prefix+g)
+	}
+	return mapped
+}
@@ -0,0 +1,105 @@
+package claims_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/pkg/claims"
+)
+
+const testClaims = `{
+	"sub": "user",
+	"email_verified": "true",
+	"https://example.com/groups": ["a"],
+	"realm_access": {"roles": ["admin", "user"]},
+	"resource_access": {"my.app": {"roles": ["editor"]}},
+	"addresses": [{"country": "NL"}, {"country": "DE"}],
+	"department": "eng"
+}`
+
+func TestPathLookup(t *testing.T) {
+	var c map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(testClaims), &c))
+
+	strs := map[string][]string{
+		"realm_access.roles":                   {"admin", "user"},
+		"$.realm_access.roles":                 {"admin", "user"},
+		`resource_access["my.app"].roles`:      {"editor"},
+		`$["resource_access"]['my.app'].roles`: {"editor"},
+		"https://example.com/groups":           {"a"},
+		"department":                           {"eng"},
+	}
+	for expr, want := range strs {
+		t.Run(expr, func(t *testing.T) {
+			p, err := claims.ParsePath(expr)
+			require.NoError(t, err)
+			got, found, err := p.LookupStrings(c)
+			require.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, want, got)
+		})
+	}
+
+	p, err := claims.ParsePath("addresses[1].country")
+	require.NoError(t, err)
+	country, found := p.LookupString(c)
+	assert.True(t, found)
+	assert.Equal(t, "DE", country)
+
+	p, err = claims.ParsePath("email_verified")
+	require.NoError(t, err)
+	verified, found := p.LookupBool(c)
+	assert.True(t, found)
+	assert.True(t, verified)
+
+	for _, expr := range []string{"", "missing.roles", "sub.roles", "addresses[2].country", "realm_access[0]"} {
+		p, err := claims.ParsePath(expr)
+		require.NoError(t, err)
+		_, found := p.Lookup(c)
+		assert.False(t, found, expr)
+	}
+
+	p, err = claims.ParsePath("addresses")
+	require.NoError(t, err)
+	_, _, err = p.LookupStrings(c)
+	assert.Error(t, err)
+}
+
+func TestParsePathErrors(t *testing.T) {
+	for _, expr := range []string{"realm_access..roles", "roles.", "roles[", "roles[-1]", "roles[x]"} {
+		_, err := claims.ParsePath(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestGroupsMapper(t *testing.T) {
+	cases := map[string]struct {
+		prefix, filter string
+		given          []string
+		expected       []string
+	}{
+		"nothing configured": {given: []string{"a", "b"}, expected: []string{"a", "b"}},
+		"prefix":             {prefix: "kc:", given: []string{"a", "b"}, expected: []string{"kc:a", "kc:b"}},
+		"filter":             {filter: "team-.*", given: []string{"team-a", "offline_access"}, expected: []string{"team-a"}},
+		"filter is anchored": {filter: "admin|ops", given: []string{"admin", "not-admin", "ops-x", "ops"}, expected: []string{"admin", "ops"}},
+		"filter before prefix": {
+			prefix:   "team-",
+			filter:   "dev",
+			given:    []string{"dev", "team-ops"},
+			expected: []string{"team-dev"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m, err := claims.NewGroupsMapper(tc.prefix, tc.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, m.Map(tc.given))
+		})
+	}
+
+	_, err := claims.NewGroupsMapper("", "(")
+	assert.Error(t, err)
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// ACRLevel is an authentication context class users reach by logging in with certain
+// connectors. Clients ask for a level through the "acr_values" parameter and the level
+// reached is returned in the "acr" claim.
+type ACRLevel struct {
+	// Value of the level in "acr_values" and the "acr" claim.
+	Value string
+
+	// Chains of connector IDs which reach the level. The user logs in with each connector
+	// of a chain in order, a chain with a single connector is a regular login.
+	Chains [][]string
+}
+
+func validateACRLevels(levels []ACRLevel) error {
+	seen := make(map[string]bool)
+	for _, level := range levels {
+		if level.Value == "" {
+			return errors.New("acr level has no value")
+		}
+		if seen[level.Value] {
+			return fmt.Errorf("acr level %q is configured twice", level.Value)
+		}
+		seen[level.Value] = true
+
+		if len(level.Chains) == 0 {
+			return fmt.Errorf("acr level %q has no connectors", level.Value)
+		}
+		for _, chain := range level.Chains {
+			if len(chain) == 0 {
+				return fmt.Errorf("acr level %q has an empty connector chain", level.Value)
+			}
+		}
+	}
+	return nil
+}
+
+// requestedACR returns the index of the level the client asked for. Values are in order
+// of preference, unknown values are ignored. It returns -1 if no level was requested.
+func (s *Server) requestedACR(values []string) int {
+	for _, value := range values {
+		for i, level := range s.acrLevels {
+			if level.Value == value {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// achievedACR returns the index of the strongest level the chain of connectors reaches,
+// or -1 if it doesn't reach any.
+func (s *Server) achievedACR(chain []string) int {
+	achieved := -1
+	for i, level := range s.acrLevels {
+		for _, c := range level.Chains {
+			if equalStrings(c, chain) {
+				achieved = i
+			}
+		}
+	}
+	return achieved
+}
+
+// acrValue returns the "acr" claim for a chain of connectors.
+func (s *Server) acrValue(chain []string) string {
+	if i := s.achievedACR(chain); i >= 0 {
+		return s.acrLevels[i].Value
+	}
+	return ""
+}
+
+// nextACRConnector returns the connector to log in with after the chain to reach the
+// requested level.
+func (s *Server) nextACRConnector(chain []string, requested int) (string, bool) {
+	for _, level := range s.acrLevels[requested:] {
+		for _, c := range level.Chains {
+			if len(c) > len(chain) && equalStrings(c[:len(chain)], chain) {
+				return c[len(chain)], true
+			}
+		}
+	}
+	return "", false
+}
+
+// acrAllowsConnector reports whether users can start a login with the connector and
+// still reach the requested level.
+func (s *Server) acrAllowsConnector(connID string, requested int) bool {
+	if requested < 0 {
+		return true
+	}
+	for _, level := range s.acrLevels[requested:] {
+		for _, c := range level.Chains {
+			if c[0] == connID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sameACRUser reports whether a later login in a connector chain is the user who logged
+// in with the first connector. Connectors don't share user IDs, so the email is used.
+// Both connectors must have verified it, anyone can claim an unverified address.
+func sameACRUser(first, later storage.Claims) bool {
+	return first.Email != "" && first.EmailVerified && later.EmailVerified &&
+		strings.EqualFold(first.Email, later.Email)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,212 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/dexidp/dex/storage"
+)
+
+var testACRLevels = []ACRLevel{
+	{Value: "low", Chains: [][]string{{"mock"}, {"mock2"}}},
+	{Value: "high", Chains: [][]string{{"mock", "mock2"}}},
+}
+
+func TestValidateACRLevels(t *testing.T) {
+	tests := []struct {
+		name    string
+		levels  []ACRLevel
+		wantErr bool
+	}{
+		{name: "valid", levels: testACRLevels},
+		{name: "no value", levels: []ACRLevel{{Chains: [][]string{{"mock"}}}}, wantErr: true},
+		{name: "duplicate value", levels: []ACRLevel{
+			{Value: "low", Chains: [][]string{{"mock"}}},
+			{Value: "low", Chains: [][]string{{"mock2"}}},
+		}, wantErr: true},
+		{name: "no connectors", levels: []ACRLevel{{Value: "low"}}, wantErr: true},
+		{name: "empty chain", levels: []ACRLevel{{Value: "low", Chains: [][]string{{}}}}, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateACRLevels(tc.levels)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestACRLevels(t *testing.T) {
+	s := &Server{acrLevels: testACRLevels}
+
+	if got := s.requestedACR(nil); got != -1 {
+		t.Errorf("expected no requested level, got %d", got)
+	}
+	if got := s.requestedACR([]string{"unknown", "high", "low"}); got != 1 {
+		t.Errorf("expected requested level 1, got %d", got)
+	}
+
+	if got := s.acrValue([]string{"mock2"}); got != "low" {
+		t.Errorf("expected acr %q, got %q", "low", got)
+	}
+	if got := s.acrValue([]string{"mock", "mock2"}); got != "high" {
+		t.Errorf("expected acr %q, got %q", "high", got)
+	}
+	if got := s.acrValue([]string{"mock2", "mock"}); got != "" {
+		t.Errorf("expected no acr, got %q", got)
+	}
+
+	if next, ok := s.nextACRConnector([]string{"mock"}, 1); !ok || next != "mock2" {
+		t.Errorf("expected next connector %q, got %q (%t)", "mock2", next, ok)
+	}
+	if _, ok := s.nextACRConnector([]string{"mock2"}, 1); ok {
+		t.Errorf("expected no next connector after %q", "mock2")
+	}
+
+	if !s.acrAllowsConnector("mock2", -1) || !s.acrAllowsConnector("mock2", 0) {
+		t.Errorf("expected %q to be allowed for the low level", "mock2")
+	}
+	if s.acrAllowsConnector("mock2", 1) {
+		t.Errorf("expected %q not to be allowed for the high level", "mock2")
+	}
+	if !s.acrAllowsConnector("mock", 1) {
+		t.Errorf("expected %q to be allowed for the high level", "mock")
+	}
+
+	verified := func(email string) storage.Claims {
+		return storage.Claims{Email: email, EmailVerified: true}
+	}
+	if !sameACRUser(verified("Jane@example.com"), verified("jane@example.com")) {
+		t.Errorf("expected emails to match case insensitively")
+	}
+	if sameACRUser(storage.Claims{}, storage.Claims{}) {
+		t.Errorf("expected users without an email not to match")
+	}
+	if sameACRUser(verified("jane@example.com"), storage.Claims{Email: "jane@example.com"}) {
+		t.Errorf("expected an unverified email of the later login not to match")
+	}
+	if sameACRUser(storage.Claims{Email: "jane@example.com"}, verified("jane@example.com")) {
+		t.Errorf("expected an unverified email of the first login not to match")
+	}
+}
+
+func TestACRConnectorChain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServerMultipleConnectors(ctx, t, func(c *Config) {
+		c.ACRLevels = testACRLevels
+	})
+	defer httpServer.Close()
+
+	redirectURI := "https://client.example.com/callback"
+	client := storage.Client{
+		ID:           "test",
+		Secret:       "barfoo",
+		RedirectURIs: []string{redirectURI},
+	}
+	if err := s.storage.CreateClient(client); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	var visited []string
+	httpClient := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if strings.HasPrefix(req.URL.String(), redirectURI) {
+				return http.ErrUseLastResponse
+			}
+			visited = append(visited, req.URL.Path)
+			return nil
+		},
+	}
+
+	q := url.Values{
+		"client_id":     {client.ID},
+		"redirect_uri":  {redirectURI},
+		"response_type": {"code"},
+		"scope":         {"openid email"},
+		"state":         {"state"},
+		"acr_values":    {"high"},
+	}
+	resp, err := httpClient.Get(httpServer.URL + "/auth?" + q.Encode())
+	if err != nil {
+		t.Fatalf("failed to start login: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSeeOther && resp.StatusCode != http.StatusFound {
+		t.Fatalf("expected redirect to the client, got %d after visiting %q", resp.StatusCode, visited)
+	}
+
+	if !containsPrefix(visited, "/auth/mock2/chain") {
+		t.Errorf("expected login to continue with the second connector, visited %q", visited)
+	}
+
+	u, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("invalid redirect: %v", err)
+	}
+	authCode, err := s.storage.GetAuthCode(u.Query().Get("code"))
+	if err != nil {
+		t.Fatalf("failed to get auth code: %v", err)
+	}
+	if authCode.ConnectorID != "mock" {
+		t.Errorf("expected the first connector to identify the user, got %q", authCode.ConnectorID)
+	}
+	if authCode.Claims.ACR != "high" {
+		t.Errorf("expected acr %q, got %q", "high", authCode.Claims.ACR)
+	}
+
+	idToken, _, err := s.newIDToken(client.ID, authCode.Claims, authCode.Scopes, authCode.Nonce, "", "", authCode.ConnectorID)
+	if err != nil {
+		t.Fatalf("failed to create id token: %v", err)
+	}
+	if got := tokenClaims(t, idToken)["acr"]; got != "high" {
+		t.Errorf("expected acr claim %q, got %q", "high", got)
+	}
+}
+
+func TestACRRejectsUnreachableLevel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServerMultipleConnectors(ctx, t, func(c *Config) {
+		c.ACRLevels = testACRLevels
+	})
+	defer httpServer.Close()
+
+	if err := s.storage.CreateClient(storage.Client{
+		ID:           "test",
+		RedirectURIs: []string{"https://client.example.com/callback"},
+	}); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	q := url.Values{
+		"client_id":     {"test"},
+		"redirect_uri":  {"https://client.example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+		"acr_values":    {"high"},
+	}
+	resp, err := http.Get(httpServer.URL + "/auth/mock2?" + q.Encode())
+	if err != nil {
+		t.Fatalf("failed to start login: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected connector which can't reach the level to be rejected, got %d", resp.StatusCode)
+	}
+}
+
+func containsPrefix(paths []string, prefix string) bool {
+	for _, p := range paths {
+		if strings.HasPrefix(p, prefix) {
+			return true
+		}
+	}
+	return false
+}
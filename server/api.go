@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -15,7 +17,7 @@ import (
 
 // apiVersion increases every time a new call is added to the API. Clients should use this info
 // to determine if the server supports specific features.
-const apiVersion = 2
+const apiVersion = 8
 
 const (
 	// recCost is the recommended bcrypt cost, which balances hash strength and
@@ -29,11 +31,17 @@ const (
 )
 
 // NewAPI returns a server which implements the gRPC API interface.
-func NewAPI(s storage.Storage, logger log.Logger, version string) api.DexServer {
+//
+// The pairwise subject salt must match the server's, so user IDs issued to pairwise
+// clients can be mapped back to users. The password config is used for passwords
+// set in plain text.
+func NewAPI(s storage.Storage, logger log.Logger, version, pairwiseSubjectSalt string, passwords PasswordConfig) api.DexServer {
 	return dexAPI{
-		s:       s,
-		logger:  logger,
-		version: version,
+		s:                   s,
+		logger:              logger,
+		version:             version,
+		pairwiseSubjectSalt: pairwiseSubjectSalt,
+		passwords:           passwords,
 	}
 }
 
@@ -43,6 +51,26 @@ type dexAPI struct {
 	s       storage.Storage
 	logger  log.Logger
 	version string
+
+	pairwiseSubjectSalt string
+	passwords           PasswordConfig
+}
+
+// parseSubject decodes the "sub" claim of an ID token, which may be a pairwise subject.
+func (d dexAPI) parseSubject(userID string) (*internal.IDTokenSubject, error) {
+	subject, ok, err := resolvePairwiseSubject(d.s, d.pairwiseSubjectSalt, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		subject = userID
+	}
+
+	id := new(internal.IDTokenSubject)
+	if err := internal.Unmarshal(subject, id); err != nil {
+		return nil, err
+	}
+	return id, nil
 }
 
 func (d dexAPI) CreateClient(ctx context.Context, req *api.CreateClientReq) (*api.CreateClientResp, error) {
@@ -65,6 +93,12 @@ func (d dexAPI) CreateClient(ctx context.Context, req *api.CreateClientReq) (*ap
 		Public:       req.Client.Public,
 		Name:         req.Client.Name,
 		LogoURL:      req.Client.LogoUrl,
+
+		SubjectType:      req.Client.SubjectType,
+		SectorIdentifier: req.Client.SectorIdentifier,
+	}
+	if err := d.checkSubjectType(c); err != nil {
+		return nil, fmt.Errorf("create client: %v", err)
 	}
 	if err := d.s.CreateClient(c); err != nil {
 		if err == storage.ErrAlreadyExists {
@@ -97,6 +131,15 @@ func (d dexAPI) UpdateClient(ctx context.Context, req *api.UpdateClientReq) (*ap
 		if req.LogoUrl != "" {
 			old.LogoURL = req.LogoUrl
 		}
+		if req.SubjectType != "" {
+			old.SubjectType = req.SubjectType
+		}
+		if req.SectorIdentifier != "" {
+			old.SectorIdentifier = req.SectorIdentifier
+		}
+		if err := d.checkSubjectType(old); err != nil {
+			return old, err
+		}
 		return old, nil
 	})
 	if err != nil {
@@ -109,6 +152,23 @@ func (d dexAPI) UpdateClient(ctx context.Context, req *api.UpdateClientReq) (*ap
 	return &api.UpdateClientResp{}, nil
 }
 
+// checkSubjectType returns an error if the server can't issue subjects of the
+// client's subject type.
+func (d dexAPI) checkSubjectType(c storage.Client) error {
+	switch c.SubjectType {
+	case "", subjectTypePublic:
+		return nil
+	case subjectTypePairwise:
+		if d.pairwiseSubjectSalt == "" {
+			return errors.New("pairwise clients require an oauth2 pairwise subject salt")
+		}
+		_, err := sectorIdentifier(c)
+		return err
+	default:
+		return fmt.Errorf("invalid subject type %q", c.SubjectType)
+	}
+}
+
 func (d dexAPI) DeleteClient(ctx context.Context, req *api.DeleteClientReq) (*api.DeleteClientResp, error) {
 	err := d.s.DeleteClient(req.Id)
 	if err != nil {
@@ -145,7 +205,7 @@ func (d dexAPI) CreatePassword(ctx context.Context, req *api.CreatePasswordReq)
 		return nil, errors.New("no user ID supplied")
 	}
 	if req.Password.Hash != nil {
-		if err := checkCost(req.Password.Hash); err != nil {
+		if err := checkPasswordHash(req.Password.Hash); err != nil {
 			return nil, err
 		}
 	} else {
@@ -153,10 +213,15 @@ func (d dexAPI) CreatePassword(ctx context.Context, req *api.CreatePasswordReq)
 	}
 
 	p := storage.Password{
-		Email:    req.Password.Email,
-		Hash:     req.Password.Hash,
-		Username: req.Password.Username,
-		UserID:   req.Password.UserId,
+		Email:               req.Password.Email,
+		Hash:                req.Password.Hash,
+		Username:            req.Password.Username,
+		UserID:              req.Password.UserId,
+		PendingVerification: req.Password.PendingVerification,
+		PendingApproval:     req.Password.PendingApproval,
+		Groups:              req.Password.Groups,
+		DisplayName:         req.Password.DisplayName,
+		Attributes:          req.Password.Attributes,
 	}
 	if err := d.s.CreatePassword(p); err != nil {
 		if err == storage.ErrAlreadyExists {
@@ -173,18 +238,29 @@ func (d dexAPI) UpdatePassword(ctx context.Context, req *api.UpdatePasswordReq)
 	if req.Email == "" {
 		return nil, errors.New("no email supplied")
 	}
-	if req.NewHash == nil && req.NewUsername == "" {
+	if req.NewHash == nil && req.NewUsername == "" && req.NewGroups == nil &&
+		req.NewDisplayName == "" && req.NewAttributes == nil &&
+		!req.ClearGroups && !req.ClearDisplayName && !req.ClearAttributes {
 		return nil, errors.New("nothing to update")
 	}
+	switch {
+	case req.ClearGroups && req.NewGroups != nil:
+		return nil, errors.New("new groups supplied with clear groups")
+	case req.ClearDisplayName && req.NewDisplayName != "":
+		return nil, errors.New("new display name supplied with clear display name")
+	case req.ClearAttributes && req.NewAttributes != nil:
+		return nil, errors.New("new attributes supplied with clear attributes")
+	}
 
 	if req.NewHash != nil {
-		if err := checkCost(req.NewHash); err != nil {
+		if err := checkPasswordHash(req.NewHash); err != nil {
 			return nil, err
 		}
 	}
 
 	updater := func(old storage.Password) (storage.Password, error) {
 		if req.NewHash != nil {
+			old.PreviousHashes = passwordHistory(d.passwords.Policy.historySize(), old.Hash, old.PreviousHashes)
 			old.Hash = req.NewHash
 		}
 
@@ -192,6 +268,18 @@ func (d dexAPI) UpdatePassword(ctx context.Context, req *api.UpdatePasswordReq)
 			old.Username = req.NewUsername
 		}
 
+		if req.NewGroups != nil || req.ClearGroups {
+			old.Groups = req.NewGroups
+		}
+
+		if req.NewDisplayName != "" || req.ClearDisplayName {
+			old.DisplayName = req.NewDisplayName
+		}
+
+		if req.NewAttributes != nil || req.ClearAttributes {
+			old.Attributes = req.NewAttributes
+		}
+
 		return old, nil
 	}
 
@@ -206,12 +294,111 @@ func (d dexAPI) UpdatePassword(ctx context.Context, req *api.UpdatePasswordReq)
 	return &api.UpdatePasswordResp{}, nil
 }
 
+func (d dexAPI) CreatePasswordPlaintext(ctx context.Context, req *api.CreatePasswordPlaintextReq) (*api.CreatePasswordPlaintextResp, error) {
+	if req.Password == nil {
+		return nil, errors.New("no password supplied")
+	}
+	if req.Password.UserId == "" {
+		return nil, errors.New("no user ID supplied")
+	}
+	if req.PlaintextPassword == "" {
+		return nil, errors.New("no plaintext password supplied")
+	}
+
+	if violations := d.passwords.Policy.check(req.PlaintextPassword); len(violations) > 0 {
+		return &api.CreatePasswordPlaintextResp{PolicyViolations: violations}, nil
+	}
+	hash, err := d.passwords.hashPassword(req.PlaintextPassword)
+	if err != nil {
+		d.logger.Errorf("api: failed to hash password: %v", err)
+		return nil, fmt.Errorf("hash password: %v", err)
+	}
+
+	p := storage.Password{
+		Email:               req.Password.Email,
+		Hash:                hash,
+		Username:            req.Password.Username,
+		UserID:              req.Password.UserId,
+		PendingVerification: req.Password.PendingVerification,
+		PendingApproval:     req.Password.PendingApproval,
+		Groups:              req.Password.Groups,
+		DisplayName:         req.Password.DisplayName,
+		Attributes:          req.Password.Attributes,
+	}
+	if err := d.s.CreatePassword(p); err != nil {
+		if err == storage.ErrAlreadyExists {
+			return &api.CreatePasswordPlaintextResp{AlreadyExists: true}, nil
+		}
+		d.logger.Errorf("api: failed to create password: %v", err)
+		return nil, fmt.Errorf("create password: %v", err)
+	}
+
+	return &api.CreatePasswordPlaintextResp{}, nil
+}
+
+// errPasswordPolicy aborts updates of passwords which don't meet the policy.
+var errPasswordPolicy = errors.New("password policy violated")
+
+func (d dexAPI) UpdatePasswordPlaintext(ctx context.Context, req *api.UpdatePasswordPlaintextReq) (*api.UpdatePasswordPlaintextResp, error) {
+	if req.Email == "" {
+		return nil, errors.New("no email supplied")
+	}
+	if req.NewPlaintextPassword == "" {
+		return nil, errors.New("no plaintext password supplied")
+	}
+
+	hash, err := d.passwords.hashPassword(req.NewPlaintextPassword)
+	if err != nil {
+		d.logger.Errorf("api: failed to hash password: %v", err)
+		return nil, fmt.Errorf("hash password: %v", err)
+	}
+
+	var violations []string
+	updater := func(old storage.Password) (storage.Password, error) {
+		violations = d.passwords.Policy.check(req.NewPlaintextPassword, append([][]byte{old.Hash}, old.PreviousHashes...)...)
+		if len(violations) > 0 {
+			return old, errPasswordPolicy
+		}
+		old.PreviousHashes = passwordHistory(d.passwords.Policy.historySize(), old.Hash, old.PreviousHashes)
+		old.Hash = hash
+		return old, nil
+	}
+
+	if err := d.s.UpdatePassword(req.Email, updater); err != nil {
+		if err == storage.ErrNotFound {
+			return &api.UpdatePasswordPlaintextResp{NotFound: true}, nil
+		}
+		// Some storages wrap the errors of updaters.
+		if errors.Is(err, errPasswordPolicy) {
+			return &api.UpdatePasswordPlaintextResp{PolicyViolations: violations}, nil
+		}
+		d.logger.Errorf("api: failed to update password: %v", err)
+		return nil, fmt.Errorf("update password: %v", err)
+	}
+
+	return &api.UpdatePasswordPlaintextResp{}, nil
+}
+
 func (d dexAPI) DeletePassword(ctx context.Context, req *api.DeletePasswordReq) (*api.DeletePasswordResp, error) {
 	if req.Email == "" {
 		return nil, errors.New("no email supplied")
 	}
 
-	err := d.s.DeletePassword(req.Email)
+	// WebAuthn credentials are looked up by email, they would log in to a new
+	// user with the same email otherwise.
+	creds, err := d.s.ListWebAuthnCredentials(req.Email)
+	if err != nil {
+		d.logger.Errorf("api: failed to list webauthn credentials: %v", err)
+		return nil, fmt.Errorf("list webauthn credentials: %v", err)
+	}
+	for _, c := range creds {
+		if err := d.s.DeleteWebAuthnCredential(c.ID); err != nil && err != storage.ErrNotFound {
+			d.logger.Errorf("api: failed to delete webauthn credential: %v", err)
+			return nil, fmt.Errorf("delete webauthn credential: %v", err)
+		}
+	}
+
+	err = d.s.DeletePassword(req.Email)
 	if err != nil {
 		if err == storage.ErrNotFound {
 			return &api.DeletePasswordResp{NotFound: true}, nil
@@ -238,12 +425,7 @@ func (d dexAPI) ListPasswords(ctx context.Context, req *api.ListPasswordReq) (*a
 
 	passwords := make([]*api.Password, 0, len(passwordList))
 	for _, password := range passwordList {
-		p := api.Password{
-			Email:    password.Email,
-			Username: password.Username,
-			UserId:   password.UserID,
-		}
-		passwords = append(passwords, &p)
+		passwords = append(passwords, toAPIPassword(password))
 	}
 
 	return &api.ListPasswordResp{
@@ -251,6 +433,46 @@ func (d dexAPI) ListPasswords(ctx context.Context, req *api.ListPasswordReq) (*a
 	}, nil
 }
 
+func (d dexAPI) ListPasswordsByGroup(ctx context.Context, req *api.ListPasswordsByGroupReq) (*api.ListPasswordsByGroupResp, error) {
+	if req.Group == "" {
+		return nil, errors.New("no group supplied")
+	}
+
+	passwordList, err := d.s.ListPasswords()
+	if err != nil {
+		d.logger.Errorf("api: failed to list passwords: %v", err)
+		return nil, fmt.Errorf("list passwords: %v", err)
+	}
+
+	var passwords []*api.Password
+	for _, password := range passwordList {
+		for _, group := range password.Groups {
+			if group == req.Group {
+				passwords = append(passwords, toAPIPassword(password))
+				break
+			}
+		}
+	}
+
+	return &api.ListPasswordsByGroupResp{
+		Passwords: passwords,
+	}, nil
+}
+
+// toAPIPassword returns the password without its hash.
+func toAPIPassword(p storage.Password) *api.Password {
+	return &api.Password{
+		Email:               p.Email,
+		Username:            p.Username,
+		UserId:              p.UserID,
+		PendingVerification: p.PendingVerification,
+		PendingApproval:     p.PendingApproval,
+		Groups:              p.Groups,
+		DisplayName:         p.DisplayName,
+		Attributes:          p.Attributes,
+	}
+}
+
 func (d dexAPI) VerifyPassword(ctx context.Context, req *api.VerifyPasswordReq) (*api.VerifyPasswordResp, error) {
 	if req.Email == "" {
 		return nil, errors.New("no email supplied")
@@ -271,8 +493,10 @@ func (d dexAPI) VerifyPassword(ctx context.Context, req *api.VerifyPasswordReq)
 		return nil, fmt.Errorf("verify password: %v", err)
 	}
 
-	if err := bcrypt.CompareHashAndPassword(password.Hash, []byte(req.Password)); err != nil {
-		d.logger.Infof("api: password check failed: %v", err)
+	if ok, err := comparePassword(password.Hash, req.Password); !ok {
+		if err != nil {
+			d.logger.Infof("api: password check failed: %v", err)
+		}
 		return &api.VerifyPasswordResp{
 			Verified: false,
 		}, nil
@@ -283,8 +507,8 @@ func (d dexAPI) VerifyPassword(ctx context.Context, req *api.VerifyPasswordReq)
 }
 
 func (d dexAPI) ListRefresh(ctx context.Context, req *api.ListRefreshReq) (*api.ListRefreshResp, error) {
-	id := new(internal.IDTokenSubject)
-	if err := internal.Unmarshal(req.UserId, id); err != nil {
+	id, err := d.parseSubject(req.UserId)
+	if err != nil {
 		d.logger.Errorf("api: failed to unmarshal ID Token subject: %v", err)
 		return nil, err
 	}
@@ -317,8 +541,8 @@ func (d dexAPI) ListRefresh(ctx context.Context, req *api.ListRefreshReq) (*api.
 }
 
 func (d dexAPI) RevokeRefresh(ctx context.Context, req *api.RevokeRefreshReq) (*api.RevokeRefreshResp, error) {
-	id := new(internal.IDTokenSubject)
-	if err := internal.Unmarshal(req.UserId, id); err != nil {
+	id, err := d.parseSubject(req.UserId)
+	if err != nil {
 		d.logger.Errorf("api: failed to unmarshal ID Token subject: %v", err)
 		return nil, err
 	}
@@ -366,3 +590,307 @@ func (d dexAPI) RevokeRefresh(ctx context.Context, req *api.RevokeRefreshReq) (*
 
 	return &api.RevokeRefreshResp{}, nil
 }
+
+func (d dexAPI) ListConsents(ctx context.Context, req *api.ListConsentReq) (*api.ListConsentResp, error) {
+	id, err := d.parseSubject(req.UserId)
+	if err != nil {
+		d.logger.Errorf("api: failed to unmarshal ID Token subject: %v", err)
+		return nil, err
+	}
+
+	userConsent, err := d.s.GetUserConsent(id.UserId, id.ConnId)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			// The user hasn't approved any client yet.
+			return &api.ListConsentResp{}, nil
+		}
+		d.logger.Errorf("api: failed to list consents: %v", err)
+		return nil, err
+	}
+
+	consents := make([]*api.ClientConsent, 0, len(userConsent.Clients))
+	for _, consent := range userConsent.Clients {
+		consents = append(consents, &api.ClientConsent{
+			ClientId:    consent.ClientID,
+			Scopes:      consent.Scopes,
+			CreatedAt:   consent.CreatedAt.Unix(),
+			LastUpdated: consent.LastUpdated.Unix(),
+		})
+	}
+	sort.Slice(consents, func(i, j int) bool {
+		return consents[i].ClientId < consents[j].ClientId
+	})
+
+	return &api.ListConsentResp{
+		Consents: consents,
+	}, nil
+}
+
+func (d dexAPI) RevokeConsent(ctx context.Context, req *api.RevokeConsentReq) (*api.RevokeConsentResp, error) {
+	id, err := d.parseSubject(req.UserId)
+	if err != nil {
+		d.logger.Errorf("api: failed to unmarshal ID Token subject: %v", err)
+		return nil, err
+	}
+
+	var notFound bool
+	if req.ClientId == "" {
+		err = d.s.DeleteUserConsent(id.UserId, id.ConnId)
+	} else {
+		err = d.s.UpdateUserConsent(id.UserId, id.ConnId, func(old storage.UserConsent) (storage.UserConsent, error) {
+			if _, ok := old.Clients[req.ClientId]; !ok {
+				notFound = true
+				return old, storage.ErrNotFound
+			}
+			delete(old.Clients, req.ClientId)
+			return old, nil
+		})
+	}
+	if err == storage.ErrNotFound || notFound {
+		return &api.RevokeConsentResp{NotFound: true}, nil
+	}
+	if err != nil {
+		d.logger.Errorf("api: failed to revoke consent: %v", err)
+		return nil, err
+	}
+
+	return &api.RevokeConsentResp{}, nil
+}
+
+func (d dexAPI) ListLoginAttempts(ctx context.Context, req *api.ListLoginAttemptsReq) (*api.ListLoginAttemptsResp, error) {
+	attempts, err := d.s.ListLoginAttempts()
+	if err != nil {
+		d.logger.Errorf("api: failed to list login attempts: %v", err)
+		return nil, fmt.Errorf("list login attempts: %v", err)
+	}
+
+	now := time.Now()
+	var loginAttempts []*api.LoginAttempts
+	for _, a := range attempts {
+		if now.After(a.Expiry) {
+			// Forgotten, but not garbage collected yet.
+			continue
+		}
+		locked := now.Before(a.LockedUntil)
+		if req.LockedOnly && !locked {
+			continue
+		}
+		kind, connID, username, ip := parseLoginAttemptsID(a.ID)
+		if kind == "" {
+			continue
+		}
+		la := &api.LoginAttempts{
+			ConnectorId: connID,
+			Username:    username,
+			Ip:          ip,
+			Failures:    int32(a.Failures),
+			LastFailure: a.LastFailure.Unix(),
+		}
+		if locked {
+			la.LockedUntil = a.LockedUntil.Unix()
+		}
+		loginAttempts = append(loginAttempts, la)
+	}
+
+	return &api.ListLoginAttemptsResp{
+		LoginAttempts: loginAttempts,
+	}, nil
+}
+
+func (d dexAPI) UnlockLogin(ctx context.Context, req *api.UnlockLoginReq) (*api.UnlockLoginResp, error) {
+	var ids []string
+	switch {
+	case req.Username != "" && req.Ip != "":
+		return nil, errors.New("only one of username and ip can be set")
+	case req.Username != "":
+		if req.ConnectorId == "" {
+			return nil, errors.New("no connector ID supplied")
+		}
+		// TOTP codes are counted by user ID, which is listed as the username.
+		ids = []string{loginAttemptsUserID(req.ConnectorId, req.Username), totpAttemptsUserID(req.ConnectorId, req.Username)}
+	case req.Ip != "":
+		ids = []string{loginAttemptsIPID(req.Ip)}
+	default:
+		return nil, errors.New("no username or ip supplied")
+	}
+
+	notFound := true
+	for _, id := range ids {
+		if err := d.s.DeleteLoginAttempts(id); err != nil {
+			if err == storage.ErrNotFound {
+				continue
+			}
+			d.logger.Errorf("api: failed to unlock login: %v", err)
+			return nil, fmt.Errorf("unlock login: %v", err)
+		}
+		notFound = false
+	}
+
+	return &api.UnlockLoginResp{NotFound: notFound}, nil
+}
+
+func (d dexAPI) ApprovePassword(ctx context.Context, req *api.ApprovePasswordReq) (*api.ApprovePasswordResp, error) {
+	if req.Email == "" {
+		return nil, errors.New("no email supplied")
+	}
+
+	updater := func(old storage.Password) (storage.Password, error) {
+		old.PendingApproval = false
+		return old, nil
+	}
+	if err := d.s.UpdatePassword(req.Email, updater); err != nil {
+		if err == storage.ErrNotFound {
+			return &api.ApprovePasswordResp{NotFound: true}, nil
+		}
+		d.logger.Errorf("api: failed to approve password: %v", err)
+		return nil, fmt.Errorf("approve password: %v", err)
+	}
+
+	return &api.ApprovePasswordResp{}, nil
+}
+
+func samlServiceProviderFromAPI(sp *api.SAMLServiceProvider) storage.SAMLServiceProvider {
+	return storage.SAMLServiceProvider{
+		ID:             sp.Id,
+		Name:           sp.Name,
+		ACSURLs:        sp.AcsUrls,
+		NameIDFormat:   sp.NameIdFormat,
+		AttributeNames: sp.AttributeNames,
+	}
+}
+
+func (d dexAPI) CreateSAMLServiceProvider(ctx context.Context, req *api.CreateSAMLServiceProviderReq) (*api.CreateSAMLServiceProviderResp, error) {
+	if req.ServiceProvider == nil {
+		return nil, errors.New("no service provider supplied")
+	}
+	sp := samlServiceProviderFromAPI(req.ServiceProvider)
+	if err := validateSAMLServiceProvider(sp); err != nil {
+		return nil, err
+	}
+
+	if err := d.s.CreateSAMLServiceProvider(sp); err != nil {
+		if err == storage.ErrAlreadyExists {
+			return &api.CreateSAMLServiceProviderResp{AlreadyExists: true}, nil
+		}
+		d.logger.Errorf("api: failed to create saml service provider: %v", err)
+		return nil, fmt.Errorf("create saml service provider: %v", err)
+	}
+	return &api.CreateSAMLServiceProviderResp{}, nil
+}
+
+func (d dexAPI) UpdateSAMLServiceProvider(ctx context.Context, req *api.UpdateSAMLServiceProviderReq) (*api.UpdateSAMLServiceProviderResp, error) {
+	if req.ServiceProvider == nil {
+		return nil, errors.New("no service provider supplied")
+	}
+	sp := samlServiceProviderFromAPI(req.ServiceProvider)
+	if err := validateSAMLServiceProvider(sp); err != nil {
+		return nil, err
+	}
+
+	err := d.s.UpdateSAMLServiceProvider(sp.ID, func(old storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error) {
+		return sp, nil
+	})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return &api.UpdateSAMLServiceProviderResp{NotFound: true}, nil
+		}
+		d.logger.Errorf("api: failed to update saml service provider: %v", err)
+		return nil, fmt.Errorf("update saml service provider: %v", err)
+	}
+	return &api.UpdateSAMLServiceProviderResp{}, nil
+}
+
+func (d dexAPI) DeleteSAMLServiceProvider(ctx context.Context, req *api.DeleteSAMLServiceProviderReq) (*api.DeleteSAMLServiceProviderResp, error) {
+	if req.Id == "" {
+		return nil, errors.New("no entity ID supplied")
+	}
+
+	if err := d.s.DeleteSAMLServiceProvider(req.Id); err != nil {
+		if err == storage.ErrNotFound {
+			return &api.DeleteSAMLServiceProviderResp{NotFound: true}, nil
+		}
+		d.logger.Errorf("api: failed to delete saml service provider: %v", err)
+		return nil, fmt.Errorf("delete saml service provider: %v", err)
+	}
+	return &api.DeleteSAMLServiceProviderResp{}, nil
+}
+
+func (d dexAPI) ListSAMLServiceProviders(ctx context.Context, req *api.ListSAMLServiceProvidersReq) (*api.ListSAMLServiceProvidersResp, error) {
+	sps, err := d.s.ListSAMLServiceProviders()
+	if err != nil {
+		d.logger.Errorf("api: failed to list saml service providers: %v", err)
+		return nil, fmt.Errorf("list saml service providers: %v", err)
+	}
+
+	serviceProviders := make([]*api.SAMLServiceProvider, 0, len(sps))
+	for _, sp := range sps {
+		serviceProviders = append(serviceProviders, &api.SAMLServiceProvider{
+			Id:             sp.ID,
+			Name:           sp.Name,
+			AcsUrls:        sp.ACSURLs,
+			NameIdFormat:   sp.NameIDFormat,
+			AttributeNames: sp.AttributeNames,
+		})
+	}
+	return &api.ListSAMLServiceProvidersResp{
+		ServiceProviders: serviceProviders,
+	}, nil
+}
+
+func (d dexAPI) ListWebAuthnCredentials(ctx context.Context, req *api.ListWebAuthnCredentialsReq) (*api.ListWebAuthnCredentialsResp, error) {
+	if req.Email == "" {
+		return nil, errors.New("no email supplied")
+	}
+
+	creds, err := d.s.ListWebAuthnCredentials(req.Email)
+	if err != nil {
+		d.logger.Errorf("api: failed to list webauthn credentials: %v", err)
+		return nil, fmt.Errorf("list webauthn credentials: %v", err)
+	}
+
+	credentials := make([]*api.WebAuthnCredential, 0, len(creds))
+	for _, c := range creds {
+		credentials = append(credentials, &api.WebAuthnCredential{
+			Id:         c.ID,
+			Email:      c.Email,
+			Transports: c.Transports,
+			CreatedAt:  c.CreatedAt.Unix(),
+			LastUsed:   c.LastUsed.Unix(),
+		})
+	}
+	return &api.ListWebAuthnCredentialsResp{
+		Credentials: credentials,
+	}, nil
+}
+
+func (d dexAPI) DeleteWebAuthnCredential(ctx context.Context, req *api.DeleteWebAuthnCredentialReq) (*api.DeleteWebAuthnCredentialResp, error) {
+	if req.Id == "" {
+		return nil, errors.New("no id supplied")
+	}
+
+	if err := d.s.DeleteWebAuthnCredential(req.Id); err != nil {
+		if err == storage.ErrNotFound {
+			return &api.DeleteWebAuthnCredentialResp{NotFound: true}, nil
+		}
+		d.logger.Errorf("api: failed to delete webauthn credential: %v", err)
+		return nil, fmt.Errorf("delete webauthn credential: %v", err)
+	}
+	return &api.DeleteWebAuthnCredentialResp{}, nil
+}
+
+func (d dexAPI) DeleteTOTPEnrollment(ctx context.Context, req *api.DeleteTOTPEnrollmentReq) (*api.DeleteTOTPEnrollmentResp, error) {
+	id, err := d.parseSubject(req.UserId)
+	if err != nil {
+		d.logger.Errorf("api: failed to unmarshal ID Token subject: %v", err)
+		return nil, err
+	}
+
+	if err := d.s.DeleteTOTPEnrollment(id.UserId, id.ConnId); err != nil {
+		if err == storage.ErrNotFound {
+			return &api.DeleteTOTPEnrollmentResp{NotFound: true}, nil
+		}
+		d.logger.Errorf("api: failed to delete totp enrollment: %v", err)
+		return nil, fmt.Errorf("delete totp enrollment: %v", err)
+	}
+	return &api.DeleteTOTPEnrollmentResp{}, nil
+}
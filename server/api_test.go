@@ -4,6 +4,8 @@ import (
 	"context"
 	"net"
 	"os"
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -31,13 +33,17 @@ type apiClient struct {
 
 // newAPI constructs a gRCP client connected to a backing server.
 func newAPI(s storage.Storage, logger log.Logger, t *testing.T) *apiClient {
+	return newAPIWithPasswords(s, logger, PasswordConfig{}, t)
+}
+
+func newAPIWithPasswords(s storage.Storage, logger log.Logger, passwords PasswordConfig, t *testing.T) *apiClient {
 	l, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	serv := grpc.NewServer()
-	api.RegisterDexServer(serv, NewAPI(s, logger, "test"))
+	api.RegisterDexServer(serv, NewAPI(s, logger, "test", "", passwords))
 	go serv.Serve(l)
 
 	// Dial will retry automatically if the serv.Serve() goroutine
@@ -170,6 +176,220 @@ func TestPassword(t *testing.T) {
 	}
 }
 
+func TestPasswordGroups(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+
+	s := memory.New(logger)
+	client := newAPI(s, logger, t)
+	defer client.Close()
+
+	ctx := context.Background()
+	for _, p := range []*api.Password{
+		{
+			Email: "jane@example.com",
+			// bcrypt hash of the value "test1" with cost 10
+			Hash:        []byte("$2a$10$XVMN/Fid.Ks4CXgzo8fpR.iU1khOMsP5g9xQeXuBm1wXjRX8pjUtO"),
+			Username:    "jane",
+			UserId:      "jane123",
+			Groups:      []string{"admins", "developers"},
+			DisplayName: "Jane Doe",
+			Attributes:  map[string]string{"department": "engineering"},
+		},
+		{
+			Email:    "john@example.com",
+			Hash:     []byte("$2a$10$XVMN/Fid.Ks4CXgzo8fpR.iU1khOMsP5g9xQeXuBm1wXjRX8pjUtO"),
+			Username: "john",
+			UserId:   "john123",
+			Groups:   []string{"developers"},
+		},
+	} {
+		if _, err := client.CreatePassword(ctx, &api.CreatePasswordReq{Password: p}); err != nil {
+			t.Fatalf("Unable to create password: %v", err)
+		}
+	}
+
+	listByGroup := func(group string) []string {
+		resp, err := client.ListPasswordsByGroup(ctx, &api.ListPasswordsByGroupReq{Group: group})
+		if err != nil {
+			t.Fatalf("Unable to list passwords by group: %v", err)
+		}
+		var emails []string
+		for _, p := range resp.Passwords {
+			emails = append(emails, p.Email)
+		}
+		sort.Strings(emails)
+		return emails
+	}
+
+	if got, want := listByGroup("admins"), []string{"jane@example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected admins %q, got %q", want, got)
+	}
+	if got, want := listByGroup("developers"), []string{"jane@example.com", "john@example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected developers %q, got %q", want, got)
+	}
+
+	updateReq := api.UpdatePasswordReq{
+		Email:          "john@example.com",
+		NewGroups:      []string{"admins"},
+		NewDisplayName: "John Doe",
+		NewAttributes:  map[string]string{"employee_id": "42"},
+	}
+	if _, err := client.UpdatePassword(ctx, &updateReq); err != nil {
+		t.Fatalf("Unable to update password: %v", err)
+	}
+
+	if got, want := listByGroup("admins"), []string{"jane@example.com", "john@example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected admins %q, got %q", want, got)
+	}
+	if got, want := listByGroup("developers"), []string{"jane@example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected developers %q, got %q", want, got)
+	}
+
+	resp, err := client.ListPasswords(ctx, &api.ListPasswordReq{})
+	if err != nil {
+		t.Fatalf("Unable to list passwords: %v", err)
+	}
+	for _, p := range resp.Passwords {
+		if p.Email != "john@example.com" {
+			continue
+		}
+		if p.DisplayName != "John Doe" {
+			t.Errorf("Expected display name %q, got %q", "John Doe", p.DisplayName)
+		}
+		if want := map[string]string{"employee_id": "42"}; !reflect.DeepEqual(p.Attributes, want) {
+			t.Errorf("Expected attributes %v, got %v", want, p.Attributes)
+		}
+	}
+
+	// Empty values leave the fields alone, only the clear flags remove the last
+	// group, the display name and the attributes.
+	if _, err := client.UpdatePassword(ctx, &api.UpdatePasswordReq{Email: "john@example.com", NewGroups: []string{}}); err == nil {
+		t.Errorf("Expected update without changes to fail")
+	}
+	if _, err := client.UpdatePassword(ctx, &api.UpdatePasswordReq{Email: "john@example.com", NewGroups: []string{"ops"}, ClearGroups: true}); err == nil {
+		t.Errorf("Expected new groups with clear groups to fail")
+	}
+	clearReq := api.UpdatePasswordReq{
+		Email:            "john@example.com",
+		ClearGroups:      true,
+		ClearDisplayName: true,
+		ClearAttributes:  true,
+	}
+	if _, err := client.UpdatePassword(ctx, &clearReq); err != nil {
+		t.Fatalf("Unable to update password: %v", err)
+	}
+
+	if got, want := listByGroup("admins"), []string{"jane@example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected admins %q, got %q", want, got)
+	}
+	p, err := s.GetPassword("john@example.com")
+	if err != nil {
+		t.Fatalf("Unable to get password: %v", err)
+	}
+	if len(p.Groups) != 0 || p.DisplayName != "" || len(p.Attributes) != 0 {
+		t.Errorf("Expected groups, display name and attributes to be cleared, got %+v", p)
+	}
+}
+
+func TestPasswordPlaintext(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+
+	policy, err := NewPasswordPolicy(PasswordPolicyConfig{MinLength: 8, RequireDigit: true, History: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := memory.New(logger)
+	client := newAPIWithPasswords(s, logger, PasswordConfig{HashAlgorithm: hashArgon2id, Policy: policy}, t)
+	defer client.Close()
+
+	ctx := context.Background()
+	p := &api.Password{
+		Email:    "jane@example.com",
+		Username: "jane",
+		UserId:   "jane123",
+	}
+
+	createResp, err := client.CreatePasswordPlaintext(ctx, &api.CreatePasswordPlaintextReq{Password: p, PlaintextPassword: "short"})
+	if err != nil {
+		t.Fatalf("Unable to create password: %v", err)
+	}
+	if len(createResp.PolicyViolations) != 2 {
+		t.Fatalf("Expected 2 policy violations, got %q", createResp.PolicyViolations)
+	}
+	if _, err := s.GetPassword(p.Email); err != storage.ErrNotFound {
+		t.Fatalf("Expected no password to be created, got %v", err)
+	}
+
+	createResp, err = client.CreatePasswordPlaintext(ctx, &api.CreatePasswordPlaintextReq{Password: p, PlaintextPassword: "password1"})
+	if err != nil {
+		t.Fatalf("Unable to create password: %v", err)
+	}
+	if len(createResp.PolicyViolations) != 0 {
+		t.Fatalf("Unexpected policy violations %q", createResp.PolicyViolations)
+	}
+
+	stored, err := s.GetPassword(p.Email)
+	if err != nil {
+		t.Fatalf("Unable to retrieve password: %v", err)
+	}
+	if !isArgon2idHash(stored.Hash) {
+		t.Errorf("Expected an argon2id hash, got %s", stored.Hash)
+	}
+
+	verifyResp, err := client.VerifyPassword(ctx, &api.VerifyPasswordReq{Email: p.Email, Password: "password1"})
+	if err != nil {
+		t.Fatalf("Unable to verify password: %v", err)
+	}
+	if !verifyResp.Verified {
+		t.Errorf("Expected argon2id password to be verified")
+	}
+
+	// The current password can't be set again.
+	updateResp, err := client.UpdatePasswordPlaintext(ctx, &api.UpdatePasswordPlaintextReq{Email: p.Email, NewPlaintextPassword: "password1"})
+	if err != nil {
+		t.Fatalf("Unable to update password: %v", err)
+	}
+	if len(updateResp.PolicyViolations) != 1 {
+		t.Fatalf("Expected 1 policy violation, got %q", updateResp.PolicyViolations)
+	}
+
+	updateResp, err = client.UpdatePasswordPlaintext(ctx, &api.UpdatePasswordPlaintextReq{Email: p.Email, NewPlaintextPassword: "password2"})
+	if err != nil {
+		t.Fatalf("Unable to update password: %v", err)
+	}
+	if len(updateResp.PolicyViolations) != 0 {
+		t.Fatalf("Unexpected policy violations %q", updateResp.PolicyViolations)
+	}
+
+	stored, err = s.GetPassword(p.Email)
+	if err != nil {
+		t.Fatalf("Unable to retrieve password: %v", err)
+	}
+	if len(stored.PreviousHashes) != 1 {
+		t.Fatalf("Expected 1 previous hash, got %d", len(stored.PreviousHashes))
+	}
+	if ok, _ := comparePassword(stored.PreviousHashes[0], "password1"); !ok {
+		t.Errorf("Expected the previous password to be kept in the history")
+	}
+
+	updateResp, err = client.UpdatePasswordPlaintext(ctx, &api.UpdatePasswordPlaintextReq{Email: "john@example.com", NewPlaintextPassword: "password3"})
+	if err != nil {
+		t.Fatalf("Unable to update password: %v", err)
+	}
+	if !updateResp.NotFound {
+		t.Errorf("Expected not found for unknown email")
+	}
+}
+
 // Ensures checkCost returns expected values
 func TestCheckCost(t *testing.T) {
 	logger := &logrus.Logger{
@@ -341,6 +561,320 @@ func TestRefreshToken(t *testing.T) {
 	}
 }
 
+func TestConsent(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+
+	s := memory.New(logger)
+	client := newAPI(s, logger, t)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	now := time.Now().UTC().Round(time.Millisecond)
+	consent := storage.UserConsent{
+		UserID: "1",
+		ConnID: "mock",
+		Clients: map[string]*storage.ClientConsent{
+			"client_a": {
+				ClientID:    "client_a",
+				Scopes:      []string{"openid", "email"},
+				CreatedAt:   now,
+				LastUpdated: now,
+			},
+			"client_b": {
+				ClientID:    "client_b",
+				Scopes:      []string{"openid"},
+				CreatedAt:   now,
+				LastUpdated: now,
+			},
+		},
+	}
+	if err := s.CreateUserConsent(consent); err != nil {
+		t.Fatalf("create user consent: %v", err)
+	}
+
+	subjectString, err := internal.Marshal(&internal.IDTokenSubject{
+		UserId: consent.UserID,
+		ConnId: consent.ConnID,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal user ID: %v", err)
+	}
+
+	listReq := api.ListConsentReq{UserId: subjectString}
+	listResp, err := client.ListConsents(ctx, &listReq)
+	if err != nil {
+		t.Fatalf("Unable to list consents for user: %v", err)
+	}
+	if len(listResp.Consents) != 2 {
+		t.Fatalf("Expected 2 consents, got %d", len(listResp.Consents))
+	}
+	if got := listResp.Consents[0]; got.ClientId != "client_a" || !reflect.DeepEqual(got.Scopes, []string{"openid", "email"}) {
+		t.Errorf("Unexpected consent %v", got)
+	}
+
+	revokeReq := api.RevokeConsentReq{UserId: subjectString, ClientId: "client_a"}
+	resp, err := client.RevokeConsent(ctx, &revokeReq)
+	if err != nil {
+		t.Fatalf("Unable to revoke consent: %v", err)
+	}
+	if resp.NotFound {
+		t.Errorf("consent wasn't found")
+	}
+
+	resp, err = client.RevokeConsent(ctx, &revokeReq)
+	if err != nil {
+		t.Fatalf("Unable to revoke consent: %v", err)
+	}
+	if !resp.NotFound {
+		t.Errorf("consent was found after revoking it")
+	}
+
+	// Revoking without a client ID removes all remaining consents of the user.
+	resp, err = client.RevokeConsent(ctx, &api.RevokeConsentReq{UserId: subjectString})
+	if err != nil {
+		t.Fatalf("Unable to revoke consents: %v", err)
+	}
+	if resp.NotFound {
+		t.Errorf("consents weren't found")
+	}
+
+	if resp, _ := client.ListConsents(ctx, &listReq); len(resp.Consents) != 0 {
+		t.Fatalf("Consents returned in spite of revoking them.")
+	}
+}
+
+func TestUnlockLogin(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+
+	s := memory.New(logger)
+	client := newAPI(s, logger, t)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	for _, a := range []storage.LoginAttempts{
+		{
+			ID:          loginAttemptsUserID("local", "jane@example.com"),
+			Failures:    5,
+			LastFailure: now,
+			LockedUntil: now.Add(time.Hour),
+			Expiry:      now.Add(24 * time.Hour),
+		},
+		{
+			ID:          loginAttemptsIPID("192.0.2.1"),
+			Failures:    2,
+			LastFailure: now,
+			Expiry:      now.Add(24 * time.Hour),
+		},
+	} {
+		if err := s.CreateLoginAttempts(a); err != nil {
+			t.Fatalf("create login attempts: %v", err)
+		}
+	}
+
+	listResp, err := client.ListLoginAttempts(ctx, &api.ListLoginAttemptsReq{LockedOnly: true})
+	if err != nil {
+		t.Fatalf("Unable to list login attempts: %v", err)
+	}
+	if len(listResp.LoginAttempts) != 1 {
+		t.Fatalf("Expected 1 locked login, got %d", len(listResp.LoginAttempts))
+	}
+	if got := listResp.LoginAttempts[0]; got.ConnectorId != "local" || got.Username != "jane@example.com" || got.Failures != 5 || got.LockedUntil == 0 {
+		t.Errorf("Unexpected login attempts %v", got)
+	}
+
+	listResp, err = client.ListLoginAttempts(ctx, &api.ListLoginAttemptsReq{})
+	if err != nil {
+		t.Fatalf("Unable to list login attempts: %v", err)
+	}
+	if len(listResp.LoginAttempts) != 2 {
+		t.Fatalf("Expected 2 login attempts, got %d", len(listResp.LoginAttempts))
+	}
+
+	unlockReq := api.UnlockLoginReq{ConnectorId: "local", Username: "Jane@Example.com"}
+	resp, err := client.UnlockLogin(ctx, &unlockReq)
+	if err != nil {
+		t.Fatalf("Unable to unlock login: %v", err)
+	}
+	if resp.NotFound {
+		t.Errorf("login attempts weren't found")
+	}
+
+	resp, err = client.UnlockLogin(ctx, &unlockReq)
+	if err != nil {
+		t.Fatalf("Unable to unlock login: %v", err)
+	}
+	if !resp.NotFound {
+		t.Errorf("login attempts were found after unlocking")
+	}
+
+	if resp, err := client.UnlockLogin(ctx, &api.UnlockLoginReq{Ip: "192.0.2.1"}); err != nil || resp.NotFound {
+		t.Errorf("Unable to unlock client address: %v %v", resp, err)
+	}
+
+	if _, err := client.UnlockLogin(ctx, &api.UnlockLoginReq{}); err == nil {
+		t.Errorf("expected an error unlocking without a username or address")
+	}
+}
+
+func TestSAMLServiceProvider(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+
+	s := memory.New(logger)
+	client := newAPI(s, logger, t)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	sp := &api.SAMLServiceProvider{
+		Id:      "https://app.example.com/saml/metadata",
+		Name:    "Example App",
+		AcsUrls: []string{"https://app.example.com/saml/acs"},
+	}
+	for _, invalid := range []*api.SAMLServiceProvider{
+		{AcsUrls: sp.AcsUrls},
+		{Id: sp.Id},
+		{Id: sp.Id, AcsUrls: []string{"/saml/acs"}},
+		{Id: sp.Id, AcsUrls: sp.AcsUrls, NameIdFormat: "urn:oasis:names:tc:SAML:2.0:nameid-format:transient"},
+	} {
+		if _, err := client.CreateSAMLServiceProvider(ctx, &api.CreateSAMLServiceProviderReq{ServiceProvider: invalid}); err == nil {
+			t.Errorf("expected an error creating service provider %v", invalid)
+		}
+	}
+
+	createResp, err := client.CreateSAMLServiceProvider(ctx, &api.CreateSAMLServiceProviderReq{ServiceProvider: sp})
+	if err != nil {
+		t.Fatalf("Unable to create service provider: %v", err)
+	}
+	if createResp.AlreadyExists {
+		t.Fatalf("service provider already exists")
+	}
+	if createResp, err = client.CreateSAMLServiceProvider(ctx, &api.CreateSAMLServiceProviderReq{ServiceProvider: sp}); err != nil || !createResp.AlreadyExists {
+		t.Errorf("expected the service provider to exist: %v %v", createResp, err)
+	}
+
+	updated := &api.SAMLServiceProvider{
+		Id:             sp.Id,
+		AcsUrls:        []string{"https://app.example.com/saml/acs", "https://app.example.com/saml/acs2"},
+		NameIdFormat:   samlNameIDFormatEmail,
+		AttributeNames: map[string]string{"groups": "memberOf"},
+	}
+	updateResp, err := client.UpdateSAMLServiceProvider(ctx, &api.UpdateSAMLServiceProviderReq{ServiceProvider: updated})
+	if err != nil {
+		t.Fatalf("Unable to update service provider: %v", err)
+	}
+	if updateResp.NotFound {
+		t.Fatalf("service provider wasn't found")
+	}
+
+	listResp, err := client.ListSAMLServiceProviders(ctx, &api.ListSAMLServiceProvidersReq{})
+	if err != nil {
+		t.Fatalf("Unable to list service providers: %v", err)
+	}
+	if len(listResp.ServiceProviders) != 1 {
+		t.Fatalf("Expected 1 service provider, got %d", len(listResp.ServiceProviders))
+	}
+	if got := listResp.ServiceProviders[0]; got.Name != "" || len(got.AcsUrls) != 2 || got.NameIdFormat != samlNameIDFormatEmail || got.AttributeNames["groups"] != "memberOf" {
+		t.Errorf("Unexpected service provider %v", got)
+	}
+
+	deleteResp, err := client.DeleteSAMLServiceProvider(ctx, &api.DeleteSAMLServiceProviderReq{Id: sp.Id})
+	if err != nil {
+		t.Fatalf("Unable to delete service provider: %v", err)
+	}
+	if deleteResp.NotFound {
+		t.Errorf("service provider wasn't found")
+	}
+	if deleteResp, err = client.DeleteSAMLServiceProvider(ctx, &api.DeleteSAMLServiceProviderReq{Id: sp.Id}); err != nil || !deleteResp.NotFound {
+		t.Errorf("expected the service provider to be deleted: %v %v", deleteResp, err)
+	}
+	if updateResp, err = client.UpdateSAMLServiceProvider(ctx, &api.UpdateSAMLServiceProviderReq{ServiceProvider: updated}); err != nil || !updateResp.NotFound {
+		t.Errorf("expected the deleted service provider not to be found: %v %v", updateResp, err)
+	}
+}
+
+func TestWebAuthnCredentials(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+
+	s := memory.New(logger)
+	client := newAPI(s, logger, t)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	if err := s.CreatePassword(storage.Password{
+		Email:    "jane@example.com",
+		Hash:     []byte("$2a$10$33EMT0cVYVlPy6WAMCLsceLYjWhuHpbz5yuZxu/GAFj03J9Lytjuy"),
+		Username: "jane",
+		UserID:   "jane-id",
+	}); err != nil {
+		t.Fatalf("Unable to create password: %v", err)
+	}
+	now := time.Now().Round(time.Second)
+	for _, id := range []string{"cred-1", "cred-2"} {
+		if err := s.CreateWebAuthnCredential(storage.WebAuthnCredential{
+			ID:         id,
+			Email:      "jane@example.com",
+			PublicKey:  []byte("key"),
+			Transports: []string{"usb"},
+			CreatedAt:  now,
+			LastUsed:   now,
+		}); err != nil {
+			t.Fatalf("Unable to create credential: %v", err)
+		}
+	}
+
+	listResp, err := client.ListWebAuthnCredentials(ctx, &api.ListWebAuthnCredentialsReq{Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("Unable to list credentials: %v", err)
+	}
+	if len(listResp.Credentials) != 2 {
+		t.Fatalf("Expected 2 credentials, got %d", len(listResp.Credentials))
+	}
+	if got := listResp.Credentials[0]; got.Email != "jane@example.com" || got.CreatedAt != now.Unix() || len(got.Transports) != 1 {
+		t.Errorf("Unexpected credential %v", got)
+	}
+
+	// A lost authenticator can be removed.
+	deleteResp, err := client.DeleteWebAuthnCredential(ctx, &api.DeleteWebAuthnCredentialReq{Id: "cred-1"})
+	if err != nil {
+		t.Fatalf("Unable to delete credential: %v", err)
+	}
+	if deleteResp.NotFound {
+		t.Errorf("credential wasn't found")
+	}
+	if deleteResp, err = client.DeleteWebAuthnCredential(ctx, &api.DeleteWebAuthnCredentialReq{Id: "cred-1"}); err != nil || !deleteResp.NotFound {
+		t.Errorf("expected the credential to be deleted: %v %v", deleteResp, err)
+	}
+
+	// Deleting the user deletes the remaining credentials, so they can't log in to
+	// a new user with the same email.
+	if _, err := client.DeletePassword(ctx, &api.DeletePasswordReq{Email: "jane@example.com"}); err != nil {
+		t.Fatalf("Unable to delete password: %v", err)
+	}
+	if _, err := s.GetWebAuthnCredential("cred-2"); err != storage.ErrNotFound {
+		t.Errorf("expected the credential to be deleted with the password, got %v", err)
+	}
+}
+
 func TestUpdateClient(t *testing.T) {
 	logger := &logrus.Logger{
 		Out:       os.Stderr,
@@ -507,3 +1041,55 @@ func find(item string, items []string) bool {
 	}
 	return false
 }
+
+func TestDeleteTOTPEnrollment(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+
+	s := memory.New(logger)
+	client := newAPI(s, logger, t)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	if err := s.CreateTOTPEnrollment(storage.TOTPEnrollment{
+		UserID:    "user-id",
+		ConnID:    "conn-id",
+		Secret:    []byte("secret"),
+		Confirmed: true,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("Unable to create TOTP enrollment: %v", err)
+	}
+
+	subjectString, err := internal.Marshal(&internal.IDTokenSubject{
+		UserId: "user-id",
+		ConnId: "conn-id",
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal user ID: %v", err)
+	}
+
+	req := api.DeleteTOTPEnrollmentReq{UserId: subjectString}
+	resp, err := client.DeleteTOTPEnrollment(ctx, &req)
+	if err != nil {
+		t.Fatalf("Unable to delete TOTP enrollment: %v", err)
+	}
+	if resp.NotFound {
+		t.Errorf("TOTP enrollment wasn't found")
+	}
+	if _, err := s.GetTOTPEnrollment("user-id", "conn-id"); err != storage.ErrNotFound {
+		t.Errorf("Expected the TOTP enrollment to be deleted, got %v", err)
+	}
+
+	resp, err = client.DeleteTOTPEnrollment(ctx, &req)
+	if err != nil {
+		t.Fatalf("Unable to delete TOTP enrollment: %v", err)
+	}
+	if !resp.NotFound {
+		t.Errorf("TOTP enrollment was found after deleting it")
+	}
+}
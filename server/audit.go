@@ -0,0 +1,21 @@
+package server
+
+// Types of audit events.
+const (
+	auditRefreshTokenReuse = "refresh_token_reuse"
+)
+
+// auditEvent is a security relevant event. Audit events are written to the log with an
+// "audit:" prefix and a fixed set of fields, so they can be filtered from other output.
+type auditEvent struct {
+	Type        string
+	ClientID    string
+	ConnectorID string
+	UserID      string
+	Details     string
+}
+
+func (s *Server) audit(e auditEvent) {
+	s.logger.Warnf("audit: type=%q client_id=%q connector_id=%q user_id=%q details=%q",
+		e.Type, e.ClientID, e.ConnectorID, e.UserID, e.Details)
+}
@@ -0,0 +1,463 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dexidp/dex/storage"
+)
+
+const (
+	backchannelModePoll = "poll"
+	backchannelModePing = "ping"
+)
+
+// BackchannelNotification asks a user to authenticate a client initiated backchannel
+// authentication (CIBA) request.
+type BackchannelNotification struct {
+	// The login_hint the client identified the user with.
+	LoginHint string `json:"login_hint"`
+	// Optional message the client wants shown to the user.
+	BindingMessage string `json:"binding_message,omitempty"`
+
+	ClientID   string   `json:"client_id"`
+	ClientName string   `json:"client_name,omitempty"`
+	Scopes     []string `json:"scopes"`
+
+	// The URL the user opens to authenticate and approve the request.
+	VerificationURI string    `json:"verification_uri"`
+	Expiry          time.Time `json:"expiry"`
+}
+
+// BackchannelNotifier delivers backchannel authentication requests to users, for
+// example through a push notification, a chat message, or an email.
+type BackchannelNotifier interface {
+	Notify(ctx context.Context, n BackchannelNotification) error
+}
+
+// WebhookNotifier is a BackchannelNotifier which POSTs the notification as JSON to a URL.
+type WebhookNotifier struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhookNotifier returns a notifier that posts notifications to the URL, adding the
+// headers to each request. A nil client means http.DefaultClient.
+func NewWebhookNotifier(url string, headers map[string]string, client *http.Client) *WebhookNotifier {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &WebhookNotifier{url: url, headers: headers, client: client}
+}
+
+// Notify implements BackchannelNotifier.
+func (n *WebhookNotifier) Notify(ctx context.Context, notification BackchannelNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("marshal notification: %v", err)
+	}
+	return postJSON(ctx, n.client, n.url, n.headers, body)
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s returned status %s", url, resp.Status)
+	}
+	return nil
+}
+
+type backchannelAuthResponse struct {
+	AuthReqID    string `json:"auth_req_id"`
+	ExpiresIn    int    `json:"expires_in"`
+	PollInterval int    `json:"interval"`
+}
+
+// handleBackchannelAuth handles the backchannel authentication endpoint
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#auth_request
+func (s *Server) handleBackchannelAuth(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method != http.MethodPost {
+		s.tokenErrHelper(w, errInvalidRequest, "method not allowed", http.StatusBadRequest)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		s.logger.Errorf("Could not parse backchannel authentication request body: %v", err)
+		s.tokenErrHelper(w, errInvalidRequest, "", http.StatusBadRequest)
+		return
+	}
+	s.withClientFromStorage(w, r, s.createBackchannelAuthRequest)
+}
+
+func (s *Server) createBackchannelAuthRequest(w http.ResponseWriter, r *http.Request, client storage.Client) {
+	pollIntervalSeconds := 5
+
+	scopes := strings.Fields(r.PostFormValue("scope"))
+	loginHint := r.PostFormValue("login_hint")
+	notificationToken := r.PostFormValue("client_notification_token")
+
+	hasOpenIDScope := false
+	for _, scope := range scopes {
+		if scope == scopeOpenID {
+			hasOpenIDScope = true
+		}
+	}
+	if !hasOpenIDScope {
+		s.tokenErrHelper(w, errInvalidScope, `Missing required scope(s) ["openid"].`, http.StatusBadRequest)
+		return
+	}
+	if r.PostFormValue("login_hint_token") != "" || r.PostFormValue("id_token_hint") != "" {
+		s.tokenErrHelper(w, errInvalidRequest, "Only login_hint is supported to identify the user.", http.StatusBadRequest)
+		return
+	}
+	if loginHint == "" {
+		s.tokenErrHelper(w, errInvalidRequest, "Required param: login_hint.", http.StatusBadRequest)
+		return
+	}
+
+	// Clients registered with a notification endpoint use the ping mode, which needs a
+	// token to authenticate the ping. Everyone else polls and the token is ignored.
+	if client.BackchannelNotificationEndpoint == "" {
+		notificationToken = ""
+	} else if notificationToken == "" {
+		s.tokenErrHelper(w, errInvalidRequest, "Required param: client_notification_token.", http.StatusBadRequest)
+		return
+	}
+
+	validFor := s.backchannelRequestsValidFor
+	if requested := r.PostFormValue("requested_expiry"); requested != "" {
+		seconds, err := strconv.Atoi(requested)
+		if err != nil || seconds <= 0 {
+			s.tokenErrHelper(w, errInvalidRequest, "Invalid requested_expiry.", http.StatusBadRequest)
+			return
+		}
+		if d := time.Duration(seconds) * time.Second; d < validFor {
+			validFor = d
+		}
+	}
+
+	now := s.now()
+	expiry := now.Add(validFor)
+
+	req := storage.BackchannelAuthRequest{
+		ID:                      storage.NewDeviceCode(),
+		ClientID:                client.ID,
+		Scopes:                  scopes,
+		LoginHint:               loginHint,
+		BindingMessage:          r.PostFormValue("binding_message"),
+		ClientNotificationToken: notificationToken,
+		Expiry:                  expiry,
+	}
+	if err := s.storage.CreateBackchannelAuthRequest(req); err != nil {
+		s.logger.Errorf("Failed to store backchannel auth request: %v", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	// The polling state is shared with the device flow.
+	deviceToken := storage.DeviceToken{
+		DeviceCode:          req.ID,
+		Status:              deviceTokenPending,
+		Expiry:              expiry,
+		LastRequestTime:     now,
+		PollIntervalSeconds: pollIntervalSeconds,
+	}
+	if err := s.storage.CreateDeviceToken(deviceToken); err != nil {
+		s.logger.Errorf("Failed to store device token: %v", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+
+	u, err := url.Parse(s.absURL("/bc-authorize/verify"))
+	if err != nil {
+		s.logger.Errorf("Could not parse issuer URL %v", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	q := u.Query()
+	q.Set("auth_req_id", req.ID)
+	u.RawQuery = q.Encode()
+
+	notification := BackchannelNotification{
+		LoginHint:       req.LoginHint,
+		BindingMessage:  req.BindingMessage,
+		ClientID:        client.ID,
+		ClientName:      client.Name,
+		Scopes:          req.Scopes,
+		VerificationURI: u.String(),
+		Expiry:          expiry,
+	}
+	if err := s.backchannelNotifier.Notify(r.Context(), notification); err != nil {
+		s.logger.Errorf("Failed to notify user of backchannel auth request for client %q: %v", client.ID, err)
+		s.tokenErrHelper(w, errServerError, "Unable to notify the user.", http.StatusInternalServerError)
+		return
+	}
+
+	resp := backchannelAuthResponse{
+		AuthReqID:    req.ID,
+		ExpiresIn:    int(validFor.Seconds()),
+		PollInterval: pollIntervalSeconds,
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.Encode(resp)
+}
+
+// handleBackchannelVerify sends the notified user through the regular login flow on
+// behalf of the client that initiated the request.
+func (s *Server) handleBackchannelVerify(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+		return
+	}
+
+	req, err := s.storage.GetBackchannelAuthRequest(r.URL.Query().Get("auth_req_id"))
+	if err != nil || s.now().After(req.Expiry) {
+		errCode := http.StatusBadRequest
+		if err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get backchannel auth request: %v", err)
+			errCode = http.StatusInternalServerError
+		}
+		s.renderError(r, w, errCode, "Invalid or expired authentication request.")
+		return
+	}
+
+	u, err := url.Parse(path.Join(s.issuerURL.Path, "/auth"))
+	if err != nil {
+		s.renderError(r, w, http.StatusInternalServerError, "Invalid auth URI.")
+		return
+	}
+	q := u.Query()
+	q.Set("client_id", req.ClientID)
+	q.Set("state", req.ID)
+	q.Set("response_type", "code")
+	q.Set("redirect_uri", backchannelCallbackURI)
+	q.Set("scope", strings.Join(req.Scopes, " "))
+	u.RawQuery = q.Encode()
+
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+func (s *Server) handleBackchannelCallback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, fmt.Sprintf("method not implemented: %s", r.Method), http.StatusBadRequest)
+		return
+	}
+
+	reqID := r.FormValue("state")
+	code := r.FormValue("code")
+
+	// The user may have declined the request on the approval screen, which
+	// already denied it. Anyone can follow this link, so it doesn't deny anything.
+	if errMsg := r.FormValue("error"); errMsg != "" {
+		http.Error(w, errMsg+": "+r.FormValue("error_description"), http.StatusBadRequest)
+		return
+	}
+
+	if reqID == "" || code == "" {
+		s.renderError(r, w, http.StatusBadRequest, "Request was missing parameters")
+		return
+	}
+
+	authCode, err := s.storage.GetAuthCode(code)
+	if err != nil || s.now().After(authCode.Expiry) {
+		errCode := http.StatusBadRequest
+		if err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get auth code: %v", err)
+			errCode = http.StatusInternalServerError
+		}
+		s.renderError(r, w, errCode, "Invalid or expired auth code.")
+		return
+	}
+
+	req, err := s.storage.GetBackchannelAuthRequest(reqID)
+	if err != nil || s.now().After(req.Expiry) {
+		errCode := http.StatusBadRequest
+		if err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get backchannel auth request: %v", err)
+			errCode = http.StatusInternalServerError
+		}
+		s.renderError(r, w, errCode, "Invalid or expired authentication request.")
+		return
+	}
+	if authCode.ClientID != req.ClientID {
+		s.renderError(r, w, http.StatusBadRequest, "Invalid authentication request.")
+		return
+	}
+
+	client, err := s.storage.GetClient(req.ClientID)
+	if err != nil {
+		s.logger.Errorf("failed to get client: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "")
+		return
+	}
+
+	// The request was addressed to a particular user, don't hand out tokens for anyone else.
+	if !loginHintMatches(req.LoginHint, authCode.Claims) {
+		s.storage.DeleteAuthCode(authCode.ID)
+		s.denyBackchannelAuthRequest(req.ID)
+		s.renderError(r, w, http.StatusForbidden, "You are not the user this request was sent to.")
+		return
+	}
+
+	resp, err := s.exchangeAuthCode(w, authCode, client)
+	if err != nil {
+		s.logger.Errorf("Could not exchange auth code for client %q: %v", req.ClientID, err)
+		s.renderError(r, w, http.StatusInternalServerError, "Failed to exchange auth code.")
+		return
+	}
+
+	respStr, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		s.logger.Errorf("failed to marshal backchannel token response: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "")
+		return
+	}
+	updater := func(old storage.DeviceToken) (storage.DeviceToken, error) {
+		if old.Status != deviceTokenPending {
+			return old, errors.New("backchannel auth request already completed")
+		}
+		old.Token = string(respStr)
+		old.Status = deviceTokenComplete
+		return old, nil
+	}
+	if err := s.storage.UpdateDeviceToken(req.ID, updater); err != nil {
+		s.logger.Errorf("failed to update device token: %v", err)
+		s.renderError(r, w, http.StatusBadRequest, "")
+		return
+	}
+
+	if req.ClientNotificationToken != "" {
+		s.pingBackchannelClient(r.Context(), client, req)
+	}
+
+	if err := s.templates.deviceSuccess(r, w, client.Name); err != nil {
+		s.logger.Errorf("Server template error: %v", err)
+		s.renderError(r, w, http.StatusNotFound, "Page not found")
+	}
+}
+
+// handleBackchannelToken handles a token request for the CIBA grant
+// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#token_request
+func (s *Server) handleBackchannelToken(w http.ResponseWriter, r *http.Request, client storage.Client) {
+	reqID := r.PostFormValue("auth_req_id")
+	if reqID == "" {
+		s.tokenErrHelper(w, errInvalidRequest, "Required param: auth_req_id.", http.StatusBadRequest)
+		return
+	}
+
+	req, err := s.storage.GetBackchannelAuthRequest(reqID)
+	if err != nil || req.ClientID != client.ID {
+		if err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get backchannel auth request: %v", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		s.tokenErrHelper(w, errInvalidGrant, "Invalid auth_req_id.", http.StatusBadRequest)
+		return
+	}
+
+	deviceToken, err := s.storage.GetDeviceToken(req.ID)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get device token: %v", err)
+		}
+		s.tokenErrHelper(w, errInvalidGrant, "Invalid auth_req_id.", http.StatusBadRequest)
+		return
+	} else if s.now().After(deviceToken.Expiry) {
+		s.tokenErrHelper(w, deviceTokenExpired, "", http.StatusBadRequest)
+		return
+	}
+
+	s.pollDeviceToken(w, r, deviceToken)
+}
+
+// declineBackchannelAuthRequest denies the backchannel authentication request the
+// logged in user of the auth request declined on the approval screen. Only the user
+// the request was sent to can decline it.
+func (s *Server) declineBackchannelAuthRequest(authReq storage.AuthRequest) {
+	if authReq.RedirectURI != s.issuerURL.Path+backchannelCallbackURI || !authReq.LoggedIn {
+		return
+	}
+	req, err := s.storage.GetBackchannelAuthRequest(authReq.State)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get backchannel auth request: %v", err)
+		}
+		return
+	}
+	if req.ClientID != authReq.ClientID || !loginHintMatches(req.LoginHint, authReq.Claims) {
+		return
+	}
+	s.denyBackchannelAuthRequest(req.ID)
+}
+
+func (s *Server) denyBackchannelAuthRequest(id string) {
+	updater := func(old storage.DeviceToken) (storage.DeviceToken, error) {
+		if old.Status != deviceTokenPending {
+			return old, errors.New("backchannel auth request already completed")
+		}
+		old.Status = deviceTokenDenied
+		return old, nil
+	}
+	if err := s.storage.UpdateDeviceToken(id, updater); err != nil && err != storage.ErrNotFound {
+		s.logger.Errorf("failed to deny backchannel auth request: %v", err)
+	}
+}
+
+// pingBackchannelClient tells a client using the ping mode that the tokens of a request
+// are ready to be picked up at the token endpoint.
+func (s *Server) pingBackchannelClient(ctx context.Context, client storage.Client, req storage.BackchannelAuthRequest) {
+	body, err := json.Marshal(map[string]string{"auth_req_id": req.ID})
+	if err != nil {
+		s.logger.Errorf("failed to marshal backchannel ping: %v", err)
+		return
+	}
+	headers := map[string]string{"Authorization": "Bearer " + req.ClientNotificationToken}
+	if err := postJSON(ctx, s.backchannelClient, client.BackchannelNotificationEndpoint, headers, body); err != nil {
+		s.logger.Errorf("failed to ping client %q for backchannel auth request: %v", client.ID, err)
+	}
+}
+
+// loginHintMatches reports whether the user is the one the login hint names. Email
+// addresses only match once they're verified, anyone could claim them otherwise.
+func loginHintMatches(loginHint string, claims storage.Claims) bool {
+	var email string
+	if claims.EmailVerified {
+		email = claims.Email
+	}
+	for _, v := range []string{email, claims.Username, claims.PreferredUsername} {
+		if v != "" && strings.EqualFold(v, loginHint) {
+			return true
+		}
+	}
+	return false
+}
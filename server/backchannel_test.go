@@ -0,0 +1,536 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dexidp/dex/storage"
+)
+
+type recordingNotifier struct {
+	mu            sync.Mutex
+	notifications []BackchannelNotification
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, notification BackchannelNotification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func (n *recordingNotifier) last() (BackchannelNotification, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.notifications) == 0 {
+		return BackchannelNotification{}, false
+	}
+	return n.notifications[len(n.notifications)-1], true
+}
+
+func postBackchannelForm(s *Server, path string, clientID, secret string, data url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, s.absURL(path), strings.NewReader(data.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, secret)
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestBackchannelAuthRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		client       storage.Client
+		data         url.Values
+		wantCode     int
+		wantError    string
+		wantNotified bool
+	}{
+		{
+			name:         "poll mode",
+			client:       storage.Client{ID: "testclient", Secret: "secret"},
+			data:         url.Values{"scope": {"openid email"}, "login_hint": {"jane@example.com"}, "binding_message": {"W4SCT"}},
+			wantCode:     http.StatusOK,
+			wantNotified: true,
+		},
+		{
+			name: "ping mode",
+			client: storage.Client{
+				ID:                              "testclient",
+				Secret:                          "secret",
+				BackchannelNotificationEndpoint: "https://client.example.com/ciba",
+			},
+			data:         url.Values{"scope": {"openid"}, "login_hint": {"jane@example.com"}, "client_notification_token": {"token"}},
+			wantCode:     http.StatusOK,
+			wantNotified: true,
+		},
+		{
+			name: "ping mode without notification token",
+			client: storage.Client{
+				ID:                              "testclient",
+				Secret:                          "secret",
+				BackchannelNotificationEndpoint: "https://client.example.com/ciba",
+			},
+			data:      url.Values{"scope": {"openid"}, "login_hint": {"jane@example.com"}},
+			wantCode:  http.StatusBadRequest,
+			wantError: errInvalidRequest,
+		},
+		{
+			name:      "missing openid scope",
+			client:    storage.Client{ID: "testclient", Secret: "secret"},
+			data:      url.Values{"scope": {"email"}, "login_hint": {"jane@example.com"}},
+			wantCode:  http.StatusBadRequest,
+			wantError: errInvalidScope,
+		},
+		{
+			name:      "missing login hint",
+			client:    storage.Client{ID: "testclient", Secret: "secret"},
+			data:      url.Values{"scope": {"openid"}},
+			wantCode:  http.StatusBadRequest,
+			wantError: errInvalidRequest,
+		},
+		{
+			name:      "unsupported id token hint",
+			client:    storage.Client{ID: "testclient", Secret: "secret"},
+			data:      url.Values{"scope": {"openid"}, "id_token_hint": {"eyJ..."}},
+			wantCode:  http.StatusBadRequest,
+			wantError: errInvalidRequest,
+		},
+		{
+			name:      "invalid requested expiry",
+			client:    storage.Client{ID: "testclient", Secret: "secret"},
+			data:      url.Values{"scope": {"openid"}, "login_hint": {"jane@example.com"}, "requested_expiry": {"-1"}},
+			wantCode:  http.StatusBadRequest,
+			wantError: errInvalidRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			notifier := &recordingNotifier{}
+			httpServer, s := newTestServer(ctx, t, func(c *Config) {
+				c.BackchannelNotifier = notifier
+			})
+			defer httpServer.Close()
+
+			if err := s.storage.CreateClient(tc.client); err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			rr := postBackchannelForm(s, "/bc-authorize", tc.client.ID, tc.client.Secret, tc.data)
+			if rr.Code != tc.wantCode {
+				t.Fatalf("expected status %d, got %d: %s", tc.wantCode, rr.Code, rr.Body.String())
+			}
+			if tc.wantError != "" {
+				expectJSONErrorResponse(tc.name, rr.Body.Bytes(), tc.wantError, t)
+			}
+
+			notification, notified := notifier.last()
+			if notified != tc.wantNotified {
+				t.Fatalf("expected notified=%t, got %t", tc.wantNotified, notified)
+			}
+			if !notified {
+				return
+			}
+
+			var resp backchannelAuthResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			req, err := s.storage.GetBackchannelAuthRequest(resp.AuthReqID)
+			if err != nil {
+				t.Fatalf("failed to get backchannel auth request: %v", err)
+			}
+			if req.LoginHint != tc.data.Get("login_hint") || req.ClientNotificationToken != tc.data.Get("client_notification_token") {
+				t.Errorf("unexpected stored request: %#v", req)
+			}
+			if notification.LoginHint != req.LoginHint || notification.BindingMessage != tc.data.Get("binding_message") {
+				t.Errorf("unexpected notification: %#v", notification)
+			}
+			if !strings.Contains(notification.VerificationURI, url.QueryEscape(resp.AuthReqID)) {
+				t.Errorf("verification URI %q does not reference the request", notification.VerificationURI)
+			}
+		})
+	}
+}
+
+func TestBackchannelFlow(t *testing.T) {
+	tests := []struct {
+		name      string
+		loginHint string
+		ping      bool
+		wantError string
+	}{
+		{
+			name:      "poll mode",
+			loginHint: "kilgore@kilgore.trout",
+		},
+		{
+			name:      "ping mode",
+			loginHint: "Kilgore Trout",
+			ping:      true,
+		},
+		{
+			name:      "other user logs in",
+			loginHint: "jane@example.com",
+			wantError: errAccessDenied,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var (
+				mu   sync.Mutex
+				now  = time.Now()
+				ping *http.Request
+			)
+			clientServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				ping = r.Clone(context.Background())
+				body, _ := io.ReadAll(r.Body)
+				ping.Body = io.NopCloser(strings.NewReader(string(body)))
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer clientServer.Close()
+
+			notifier := &recordingNotifier{}
+			httpServer, s := newTestServer(ctx, t, func(c *Config) {
+				c.BackchannelNotifier = notifier
+				c.Now = func() time.Time {
+					mu.Lock()
+					defer mu.Unlock()
+					return now
+				}
+			})
+			defer httpServer.Close()
+
+			client := storage.Client{
+				ID:           "testclient",
+				Secret:       "secret",
+				RedirectURIs: []string{"https://client.example.com/callback"},
+				Name:         "Test Client",
+			}
+			data := url.Values{"scope": {"openid email"}, "login_hint": {tc.loginHint}}
+			if tc.ping {
+				client.BackchannelNotificationEndpoint = clientServer.URL
+				data.Set("client_notification_token", "ping-token")
+			}
+			if err := s.storage.CreateClient(client); err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			rr := postBackchannelForm(s, "/bc-authorize", client.ID, client.Secret, data)
+			if rr.Code != http.StatusOK {
+				t.Fatalf("backchannel auth request failed: %s", rr.Body.String())
+			}
+			var authResp backchannelAuthResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &authResp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			pollAfter := func(d time.Duration) *httptest.ResponseRecorder {
+				mu.Lock()
+				now = now.Add(d)
+				mu.Unlock()
+				return postBackchannelForm(s, "/token", client.ID, client.Secret, url.Values{
+					"grant_type":  {grantTypeCIBA},
+					"auth_req_id": {authResp.AuthReqID},
+				})
+			}
+			// Waits long enough after a slow_down, which raises the interval.
+			poll := func() *httptest.ResponseRecorder {
+				return pollAfter(time.Duration(2*authResp.PollInterval+1) * time.Second)
+			}
+
+			// Polling before the advertised interval passed is too fast.
+			rr = pollAfter(time.Second)
+			expectJSONErrorResponse(tc.name, rr.Body.Bytes(), deviceTokenSlowDown, t)
+
+			rr = poll()
+			if rr.Code != http.StatusUnauthorized {
+				t.Fatalf("expected pending token request, got %d: %s", rr.Code, rr.Body.String())
+			}
+			expectJSONErrorResponse(tc.name, rr.Body.Bytes(), deviceTokenPending, t)
+
+			// Another client must not be able to redeem the request.
+			if err := s.storage.CreateClient(storage.Client{ID: "other", Secret: "secret"}); err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			rr = postBackchannelForm(s, "/token", "other", "secret", url.Values{
+				"grant_type":  {grantTypeCIBA},
+				"auth_req_id": {authResp.AuthReqID},
+			})
+			expectJSONErrorResponse(tc.name, rr.Body.Bytes(), errInvalidGrant, t)
+
+			// Anyone can follow the callback link, so an error there mustn't deny the request.
+			callback := s.absURL(backchannelCallbackURI) + "?" + url.Values{
+				"error": {errAccessDenied},
+				"state": {authResp.AuthReqID},
+			}.Encode()
+			rr = httptest.NewRecorder()
+			s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, callback, nil))
+			if rr.Code != http.StatusBadRequest {
+				t.Fatalf("expected the callback to show the error, got %d", rr.Code)
+			}
+			rr = poll()
+			expectJSONErrorResponse(tc.name, rr.Body.Bytes(), deviceTokenPending, t)
+
+			// The user follows the link from the notification and logs in.
+			notification, ok := notifier.last()
+			if !ok {
+				t.Fatal("user was not notified")
+			}
+			resp, err := http.Get(notification.VerificationURI)
+			if err != nil {
+				t.Fatalf("failed to follow verification URI: %v", err)
+			}
+			resp.Body.Close()
+			if resp.Request.URL.Path != s.absPath(backchannelCallbackURI) {
+				t.Fatalf("expected to end up at the backchannel callback, got %s", resp.Request.URL)
+			}
+
+			rr = poll()
+			if tc.wantError != "" {
+				expectJSONErrorResponse(tc.name, rr.Body.Bytes(), tc.wantError, t)
+				return
+			}
+			if rr.Code != http.StatusOK {
+				t.Fatalf("token request failed: %s", rr.Body.String())
+			}
+			var tokenResp struct {
+				AccessToken string `json:"access_token"`
+				IDToken     string `json:"id_token"`
+			}
+			if err := json.Unmarshal(rr.Body.Bytes(), &tokenResp); err != nil {
+				t.Fatalf("failed to decode token response: %v", err)
+			}
+			if tokenResp.AccessToken == "" || tokenResp.IDToken == "" {
+				t.Errorf("expected access and id tokens, got %s", rr.Body.String())
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !tc.ping {
+				if ping != nil {
+					t.Errorf("client in poll mode should not be pinged")
+				}
+				return
+			}
+			if ping == nil {
+				t.Fatal("client was not pinged")
+			}
+			if got := ping.Header.Get("Authorization"); got != "Bearer ping-token" {
+				t.Errorf("unexpected ping authorization %q", got)
+			}
+			var pingBody map[string]string
+			if err := json.NewDecoder(ping.Body).Decode(&pingBody); err != nil {
+				t.Fatalf("failed to decode ping: %v", err)
+			}
+			if pingBody["auth_req_id"] != authResp.AuthReqID {
+				t.Errorf("expected ping for %q, got %q", authResp.AuthReqID, pingBody["auth_req_id"])
+			}
+		})
+	}
+}
+
+func TestBackchannelDecline(t *testing.T) {
+	tests := []struct {
+		name       string
+		claims     storage.Claims
+		wantDenied bool
+	}{
+		{
+			name:       "requested user",
+			claims:     storage.Claims{UserID: "1", Username: "Kilgore Trout"},
+			wantDenied: true,
+		},
+		{
+			name:   "other user",
+			claims: storage.Claims{UserID: "2", Username: "Jane Doe"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			httpServer, s := newTestServer(ctx, t, func(c *Config) {
+				c.BackchannelNotifier = &recordingNotifier{}
+			})
+			defer httpServer.Close()
+
+			client := storage.Client{ID: "testclient", Secret: "secret"}
+			if err := s.storage.CreateClient(client); err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			rr := postBackchannelForm(s, "/bc-authorize", client.ID, client.Secret, url.Values{
+				"scope":      {"openid"},
+				"login_hint": {"Kilgore Trout"},
+			})
+			if rr.Code != http.StatusOK {
+				t.Fatalf("backchannel auth request failed: %s", rr.Body.String())
+			}
+			var authResp backchannelAuthResponse
+			if err := json.Unmarshal(rr.Body.Bytes(), &authResp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			authReq := storage.AuthRequest{
+				ID:          storage.NewID(),
+				ClientID:    client.ID,
+				RedirectURI: s.issuerURL.Path + backchannelCallbackURI,
+				State:       authResp.AuthReqID,
+				LoggedIn:    true,
+				Claims:      tc.claims,
+				Expiry:      time.Now().Add(time.Hour),
+			}
+			if err := s.storage.CreateAuthRequest(authReq); err != nil {
+				t.Fatalf("failed to create auth request: %v", err)
+			}
+
+			data := url.Values{"req": {authReq.ID}, "approval": {"reject"}}
+			req := httptest.NewRequest(http.MethodPost, s.absURL("/approval"), strings.NewReader(data.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr = httptest.NewRecorder()
+			s.ServeHTTP(rr, req)
+
+			deviceToken, err := s.storage.GetDeviceToken(authResp.AuthReqID)
+			if err != nil {
+				t.Fatalf("failed to get device token: %v", err)
+			}
+			if denied := deviceToken.Status == deviceTokenDenied; denied != tc.wantDenied {
+				t.Errorf("expected denied %t, got status %q", tc.wantDenied, deviceToken.Status)
+			}
+		})
+	}
+}
+
+func TestLoginHintMatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		loginHint string
+		claims    storage.Claims
+		want      bool
+	}{
+		{
+			name:      "verified email",
+			loginHint: "Jane@example.com",
+			claims:    storage.Claims{Email: "jane@example.com", EmailVerified: true},
+			want:      true,
+		},
+		{
+			name:      "unverified email",
+			loginHint: "jane@example.com",
+			claims:    storage.Claims{Email: "jane@example.com"},
+		},
+		{
+			name:      "username",
+			loginHint: "jane",
+			claims:    storage.Claims{Username: "jane", Email: "jane@example.com"},
+			want:      true,
+		},
+		{
+			name:      "preferred username",
+			loginHint: "jdoe",
+			claims:    storage.Claims{PreferredUsername: "jdoe"},
+			want:      true,
+		},
+		{
+			name:      "other user",
+			loginHint: "john@example.com",
+			claims:    storage.Claims{Username: "jane", Email: "jane@example.com", EmailVerified: true},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := loginHintMatches(tc.loginHint, tc.claims); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBackchannelDisabled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	if err := s.storage.CreateClient(storage.Client{ID: "testclient", Secret: "secret"}); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	rr := postBackchannelForm(s, "/bc-authorize", "testclient", "secret", url.Values{
+		"scope":      {"openid"},
+		"login_hint": {"jane@example.com"},
+	})
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected backchannel endpoint to be disabled, got %d", rr.Code)
+	}
+
+	rr = postBackchannelForm(s, "/token", "testclient", "secret", url.Values{
+		"grant_type":  {grantTypeCIBA},
+		"auth_req_id": {"foo"},
+	})
+	expectJSONErrorResponse("disabled", rr.Body.Bytes(), errUnsupportedGrantType, t)
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var (
+		gotHeader string
+		got       BackchannelNotification
+	)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Api-Key")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("failed to decode notification: %v", err)
+		}
+	}))
+	defer webhook.Close()
+
+	want := BackchannelNotification{
+		LoginHint:       "jane@example.com",
+		BindingMessage:  "W4SCT",
+		ClientID:        "testclient",
+		Scopes:          []string{"openid"},
+		VerificationURI: "https://dex.example.com/bc-authorize/verify?auth_req_id=foo",
+		Expiry:          time.Now().UTC().Round(time.Second),
+	}
+
+	n := NewWebhookNotifier(webhook.URL, map[string]string{"X-Api-Key": "s3cr3t"}, nil)
+	if err := n.Notify(context.Background(), want); err != nil {
+		t.Fatalf("notify failed: %v", err)
+	}
+	if gotHeader != "s3cr3t" {
+		t.Errorf("expected configured header to be sent, got %q", gotHeader)
+	}
+	if got.LoginHint != want.LoginHint || got.VerificationURI != want.VerificationURI || !got.Expiry.Equal(want.Expiry) {
+		t.Errorf("unexpected notification %#v", got)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	if err := NewWebhookNotifier(failing.URL, nil, nil).Notify(context.Background(), want); err == nil {
+		t.Error("expected an error for a failing webhook")
+	}
+}
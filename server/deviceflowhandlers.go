@@ -199,6 +199,15 @@ func (s *Server) handleDeviceToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	s.pollDeviceToken(w, r, deviceToken)
+}
+
+// pollDeviceToken answers a token request for a device token that is still pending or already
+// complete, throttling clients that poll faster than the current interval. It is shared by the
+// device flow and the backchannel authentication flow.
+func (s *Server) pollDeviceToken(w http.ResponseWriter, r *http.Request, deviceToken storage.DeviceToken) {
+	now := s.now()
+
 	// Rate Limiting check
 	slowDown := false
 	pollInterval := deviceToken.PollIntervalSeconds
@@ -219,7 +228,7 @@ func (s *Server) handleDeviceToken(w http.ResponseWriter, r *http.Request) {
 			return old, nil
 		}
 		// Update device token last request time in storage
-		if err := s.storage.UpdateDeviceToken(deviceCode, updater); err != nil {
+		if err := s.storage.UpdateDeviceToken(deviceToken.DeviceCode, updater); err != nil {
 			s.logger.Errorf("failed to update device token: %v", err)
 			s.renderError(r, w, http.StatusInternalServerError, "")
 			return
@@ -231,6 +240,8 @@ func (s *Server) handleDeviceToken(w http.ResponseWriter, r *http.Request) {
 		}
 	case deviceTokenComplete:
 		w.Write([]byte(deviceToken.Token))
+	case deviceTokenDenied:
+		s.tokenErrHelper(w, errAccessDenied, "", http.StatusBadRequest)
 	}
 }
 
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Email is a plain text message sent to a user.
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// EmailSender delivers emails to users, for example password reset links.
+type EmailSender interface {
+	SendEmail(ctx context.Context, email Email) error
+}
+
+// SMTPConfig configures an SMTPSender.
+type SMTPConfig struct {
+	// Address of the SMTP server as host:port.
+	Address string
+
+	// Credentials for PLAIN authentication. No authentication is attempted if the
+	// username is empty.
+	Username string
+	Password string
+
+	// Sender address of the emails.
+	From string
+
+	// Send emails over an unencrypted connection, without STARTTLS. Only meant
+	// for local test servers.
+	DisableStartTLS bool
+}
+
+// SMTPSender is an EmailSender which delivers emails through an SMTP server. The
+// connection must be upgraded with STARTTLS, sending fails if the server doesn't
+// offer it so an attacker can't strip it.
+type SMTPSender struct {
+	config SMTPConfig
+	dialer net.Dialer
+}
+
+// NewSMTPSender returns a sender using the SMTP server of the config.
+func NewSMTPSender(c SMTPConfig) (*SMTPSender, error) {
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return nil, fmt.Errorf("invalid SMTP address %q: %v", c.Address, err)
+	}
+	if c.From == "" {
+		return nil, errors.New("no sender address for SMTP")
+	}
+	return &SMTPSender{config: c}, nil
+}
+
+// SendEmail implements EmailSender.
+func (s *SMTPSender) SendEmail(ctx context.Context, email Email) error {
+	msg, err := s.message(email)
+	if err != nil {
+		return err
+	}
+
+	conn, err := s.dialer.DialContext(ctx, "tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("dial SMTP server: %v", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	host, _, _ := net.SplitHostPort(s.config.Address)
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return fmt.Errorf("SMTP handshake: %v", err)
+	}
+	defer c.Close()
+
+	if !s.config.DisableStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return errors.New("SMTP server doesn't offer STARTTLS")
+		}
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return fmt.Errorf("SMTP STARTTLS: %v", err)
+		}
+	}
+	if s.config.Username != "" {
+		// PlainAuth refuses to send the password over an unencrypted connection
+		// to anything but localhost.
+		if err := c.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, host)); err != nil {
+			return fmt.Errorf("SMTP authentication: %v", err)
+		}
+	}
+
+	if err := c.Mail(s.config.From); err != nil {
+		return fmt.Errorf("SMTP MAIL: %v", err)
+	}
+	if err := c.Rcpt(email.To); err != nil {
+		return fmt.Errorf("SMTP RCPT: %v", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA: %v", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("write email: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("send email: %v", err)
+	}
+	return c.Quit()
+}
+
+func (s *SMTPSender) message(email Email) ([]byte, error) {
+	for _, v := range []string{email.To, email.Subject} {
+		if strings.ContainsAny(v, "\r\n") {
+			return nil, errors.New("email header contains a line break")
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", s.config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", email.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(email.Body, "\n", "\r\n"))
+	return b.Bytes(), nil
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpMessage is an email received by the test SMTP server.
+type smtpMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// newTestSMTPServer runs a minimal SMTP server which accepts every message and
+// passes it to the returned channel.
+func newTestSMTPServer(t *testing.T) (addr string, messages <-chan smtpMessage) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	ch := make(chan smtpMessage, 10)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSMTP(conn, ch)
+		}
+	}()
+	return l.Addr().String(), ch
+}
+
+func serveTestSMTP(conn net.Conn, ch chan<- smtpMessage) {
+	defer conn.Close()
+	tc := textproto.NewConn(conn)
+	tc.PrintfLine("220 localhost ESMTP")
+
+	var msg smtpMessage
+	for {
+		line, err := tc.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			tc.PrintfLine("250 localhost")
+		case "MAIL":
+			msg.From = strings.Trim(strings.TrimPrefix(line[len("MAIL"):], " FROM:"), "<>")
+			tc.PrintfLine("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, strings.Trim(strings.TrimPrefix(line[len("RCPT"):], " TO:"), "<>"))
+			tc.PrintfLine("250 OK")
+		case "DATA":
+			tc.PrintfLine("354 Go ahead")
+			data, err := tc.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.Data = string(data)
+			ch <- msg
+			msg = smtpMessage{}
+			tc.PrintfLine("250 OK")
+		case "QUIT":
+			tc.PrintfLine("221 Bye")
+			return
+		default:
+			tc.PrintfLine("502 Not implemented")
+		}
+	}
+}
+
+func receiveEmail(t *testing.T, messages <-chan smtpMessage) smtpMessage {
+	t.Helper()
+	select {
+	case msg := <-messages:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("no email received")
+	}
+	return smtpMessage{}
+}
+
+func TestSMTPSender(t *testing.T) {
+	addr, messages := newTestSMTPServer(t)
+
+	sender, err := NewSMTPSender(SMTPConfig{Address: addr, From: "dex@example.com", DisableStartTLS: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = sender.SendEmail(context.Background(), Email{
+		To:      "jane@example.com",
+		Subject: "Hello",
+		Body:    "First line\n.\nLast line\n",
+	})
+	if err != nil {
+		t.Fatalf("send email: %v", err)
+	}
+
+	msg := receiveEmail(t, messages)
+	if msg.From != "dex@example.com" || len(msg.To) != 1 || msg.To[0] != "jane@example.com" {
+		t.Errorf("unexpected envelope from %q to %q", msg.From, msg.To)
+	}
+	r := textproto.NewReader(bufio.NewReader(strings.NewReader(msg.Data)))
+	header, err := r.ReadMIMEHeader()
+	if err != nil {
+		t.Fatalf("parse email header: %v", err)
+	}
+	if got := header.Get("Subject"); got != "Hello" {
+		t.Errorf("subject %q, want %q", got, "Hello")
+	}
+	if got := header.Get("To"); got != "jane@example.com" {
+		t.Errorf("to %q, want %q", got, "jane@example.com")
+	}
+	if body := msg.Data[strings.Index(msg.Data, "\n\n")+2:]; body != "First line\n.\nLast line\n" {
+		t.Errorf("unexpected body %q", body)
+	}
+
+	err = sender.SendEmail(context.Background(), Email{
+		To:      "jane@example.com",
+		Subject: "Hello\r\nBcc: john@example.com",
+	})
+	if err == nil {
+		t.Errorf("expected an error sending an email with a line break in the subject")
+	}
+}
+
+func TestSMTPSenderRequiresStartTLS(t *testing.T) {
+	addr, messages := newTestSMTPServer(t)
+
+	sender, err := NewSMTPSender(SMTPConfig{Address: addr, From: "dex@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The test server doesn't offer STARTTLS, as if an attacker stripped it.
+	err = sender.SendEmail(context.Background(), Email{
+		To:      "jane@example.com",
+		Subject: "Hello",
+		Body:    "Secret link\n",
+	})
+	if err == nil {
+		t.Fatal("expected an error sending an email without STARTTLS")
+	}
+	select {
+	case msg := <-messages:
+		t.Errorf("unexpected email to %v", msg.To)
+	default:
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -14,7 +15,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/gorilla/mux"
 	jose "gopkg.in/square/go-jose.v2"
 
@@ -75,6 +75,8 @@ type discovery struct {
 	Keys              string   `json:"jwks_uri"`
 	UserInfo          string   `json:"userinfo_endpoint"`
 	DeviceEndpoint    string   `json:"device_authorization_endpoint"`
+	Revocation        string   `json:"revocation_endpoint"`
+	Introspection     string   `json:"introspection_endpoint"`
 	GrantTypes        []string `json:"grant_types_supported"`
 	ResponseTypes     []string `json:"response_types_supported"`
 	Subjects          []string `json:"subject_types_supported"`
@@ -83,9 +85,38 @@ type discovery struct {
 	Scopes            []string `json:"scopes_supported"`
 	AuthMethods       []string `json:"token_endpoint_auth_methods_supported"`
 	Claims            []string `json:"claims_supported"`
+	ClaimTypes        []string `json:"claim_types_supported"`
+	ResponseModes     []string `json:"response_modes_supported"`
+	PromptValues      []string `json:"prompt_values_supported"`
+	ACRValues         []string `json:"acr_values_supported,omitempty"`
+
+	// Revocation and introspection take the same client authentication as the
+	// token endpoint.
+	RevocationAuthMethods    []string `json:"revocation_endpoint_auth_methods_supported"`
+	IntrospectionAuthMethods []string `json:"introspection_endpoint_auth_methods_supported"`
+
+	// Authorization request parameters dex doesn't implement. The request_uri parameter
+	// defaults to being supported, so they're listed explicitly.
+	ClaimsParameter     bool `json:"claims_parameter_supported"`
+	RequestParameter    bool `json:"request_parameter_supported"`
+	RequestURIParameter bool `json:"request_uri_parameter_supported"`
+
+	IDTokenEncAlgs      []string `json:"id_token_encryption_alg_values_supported"`
+	IDTokenEncEncs      []string `json:"id_token_encryption_enc_values_supported"`
+	UserInfoSigningAlgs []string `json:"userinfo_signing_alg_values_supported"`
+	UserInfoEncAlgs     []string `json:"userinfo_encryption_alg_values_supported"`
+	UserInfoEncEncs     []string `json:"userinfo_encryption_enc_values_supported"`
+
+	BackchannelLogoutSupported bool `json:"backchannel_logout_supported"`
+
+	BackchannelEndpoint      string   `json:"backchannel_authentication_endpoint,omitempty"`
+	BackchannelDeliveryModes []string `json:"backchannel_token_delivery_modes_supported,omitempty"`
 }
 
-func (s *Server) discoveryHandler() (http.HandlerFunc, error) {
+// discovery returns the server's metadata. It's served both as OpenID Connect
+// discovery and as OAuth 2.0 Authorization Server Metadata (RFC 8414), which share
+// their field names.
+func (s *Server) discovery() discovery {
 	d := discovery{
 		Issuer:            s.issuerURL.String(),
 		Auth:              s.absURL("/auth"),
@@ -93,15 +124,37 @@ func (s *Server) discoveryHandler() (http.HandlerFunc, error) {
 		Keys:              s.absURL("/keys"),
 		UserInfo:          s.absURL("/userinfo"),
 		DeviceEndpoint:    s.absURL("/device/code"),
-		Subjects:          []string{"public"},
+		Revocation:        s.absURL("/token/revoke"),
+		Introspection:     s.absURL("/token/introspect"),
+		Subjects:          []string{subjectTypePublic},
 		IDTokenAlgs:       []string{string(jose.RS256)},
 		CodeChallengeAlgs: []string{codeChallengeMethodS256, codeChallengeMethodPlain},
 		Scopes:            []string{"openid", "email", "groups", "profile", "offline_access"},
-		AuthMethods:       []string{"client_secret_basic", "client_secret_post"},
+		// Public clients may leave out their secret. That isn't advertised as "none",
+		// the metadata is built once and doesn't depend on which clients exist.
+		AuthMethods:              []string{"client_secret_basic", "client_secret_post"},
+		RevocationAuthMethods:    []string{"client_secret_basic", "client_secret_post"},
+		IntrospectionAuthMethods: []string{"client_secret_basic", "client_secret_post"},
 		Claims: []string{
-			"iss", "sub", "aud", "iat", "exp", "email", "email_verified",
-			"locale", "name", "preferred_username", "at_hash",
+			"iss", "sub", "aud", "iat", "exp", "azp", "nonce", "at_hash", "c_hash",
+			"email", "email_verified", "name", "preferred_username", "groups",
+			"federated_claims",
 		},
+		ClaimTypes:          []string{"normal"},
+		ResponseModes:       responseModes,
+		PromptValues:        []string{"consent"},
+		IDTokenEncAlgs:      encryptionAlgs,
+		IDTokenEncEncs:      encryptionEncs,
+		UserInfoSigningAlgs: []string{string(jose.RS256)},
+		UserInfoEncAlgs:     encryptionAlgs,
+		UserInfoEncEncs:     encryptionEncs,
+
+		BackchannelLogoutSupported: true,
+	}
+
+	// Without a salt, tokens can't be issued to pairwise clients.
+	if s.pairwiseSubjectSalt != "" {
+		d.Subjects = append(d.Subjects, subjectTypePairwise)
 	}
 
 	for responseType := range s.supportedResponseTypes {
@@ -111,7 +164,22 @@ func (s *Server) discoveryHandler() (http.HandlerFunc, error) {
 
 	d.GrantTypes = s.supportedGrantTypes
 
-	data, err := json.MarshalIndent(d, "", "  ")
+	for _, level := range s.acrLevels {
+		d.ACRValues = append(d.ACRValues, level.Value)
+	}
+	if len(d.ACRValues) > 0 {
+		d.Claims = append(d.Claims, "acr")
+	}
+
+	if s.backchannelNotifier != nil {
+		d.BackchannelEndpoint = s.absURL("/bc-authorize")
+		d.BackchannelDeliveryModes = []string{backchannelModePoll, backchannelModePing}
+	}
+	return d
+}
+
+func (s *Server) discoveryHandler() (http.HandlerFunc, error) {
+	data, err := json.MarshalIndent(s.discovery(), "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal discovery data: %v", err)
 	}
@@ -123,6 +191,47 @@ func (s *Server) discoveryHandler() (http.HandlerFunc, error) {
 	}), nil
 }
 
+// webfingerIssuerRel is the link relation clients look up to find the issuer of a user.
+//
+// https://openid.net/specs/openid-connect-discovery-1_0.html#IssuerDiscovery
+const webfingerIssuerRel = "http://openid.net/specs/connect/1.0/issuer"
+
+type webfingerLink struct {
+	Rel  string `json:"rel"`
+	Href string `json:"href"`
+}
+
+type webfingerResponse struct {
+	Subject string          `json:"subject"`
+	Links   []webfingerLink `json:"links"`
+}
+
+// handleWebfinger answers OpenID Connect issuer discovery queries. Every user of dex
+// has the same issuer, so any resource is answered with it.
+func (s *Server) handleWebfinger(w http.ResponseWriter, r *http.Request) {
+	resource := r.URL.Query().Get("resource")
+	if resource == "" {
+		http.Error(w, "resource parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	resp := webfingerResponse{Subject: resource, Links: []webfingerLink{}}
+	rels := r.URL.Query()["rel"]
+	if len(rels) == 0 || contains(rels, webfingerIssuerRel) {
+		resp.Links = append(resp.Links, webfingerLink{Rel: webfingerIssuerRel, Href: s.discovery().Issuer})
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		s.logger.Errorf("failed to marshal webfinger response: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/jrd+json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
 // handleAuthorization handles the OAuth2 auth endpoint.
 func (s *Server) handleAuthorization(w http.ResponseWriter, r *http.Request) {
 	// Extract the arguments
@@ -142,6 +251,23 @@ func (s *Server) handleAuthorization(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Only offer connectors which can reach the authentication context class the client
+	// asked for.
+	if requested := s.requestedACR(strings.Fields(r.Form.Get("acr_values"))); requested >= 0 {
+		var allowed []storage.Connector
+		for _, c := range connectors {
+			if s.acrAllowsConnector(c.ID, requested) {
+				allowed = append(allowed, c)
+			}
+		}
+		if len(allowed) == 0 {
+			s.logger.Errorf("No connector can reach requested acr %q", s.acrLevels[requested].Value)
+			s.renderError(r, w, http.StatusBadRequest, "No login method meets the authentication level requested by the client.")
+			return
+		}
+		connectors = allowed
+	}
+
 	// We don't need connector_id any more
 	r.Form.Del("connector_id")
 
@@ -191,7 +317,7 @@ func (s *Server) handleConnectorLogin(w http.ResponseWriter, r *http.Request) {
 
 		switch authErr := err.(type) {
 		case *redirectedAuthErr:
-			authErr.Handler().ServeHTTP(w, r)
+			s.sendAuthError(w, r, authErr)
 		case *displayedAuthErr:
 			s.renderError(r, w, authErr.Status, err.Error())
 		default:
@@ -217,6 +343,12 @@ func (s *Server) handleConnectorLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if requested := s.requestedACR(authReq.ACRValues); !s.acrAllowsConnector(connID, requested) {
+		s.logger.Errorf("Connector %q can't reach requested acr %q", connID, s.acrLevels[requested].Value)
+		s.renderError(r, w, http.StatusBadRequest, "Login method doesn't meet the authentication level requested by the client.")
+		return
+	}
+
 	authReq.ConnectorID = connID
 
 	// Actually create the auth request
@@ -227,8 +359,6 @@ func (s *Server) handleConnectorLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	scopes := parseScopes(authReq.Scopes)
-
 	// Work out where the "Select another login method" link should go.
 	backLink := ""
 	if len(s.connectors) > 1 {
@@ -241,59 +371,128 @@ func (s *Server) handleConnectorLogin(w http.ResponseWriter, r *http.Request) {
 
 	switch r.Method {
 	case http.MethodGet:
-		switch conn := conn.Connector.(type) {
-		case connector.CallbackConnector:
-			// Use the auth request ID as the "state" token.
-			//
-			// TODO(ericchiang): Is this appropriate or should we also be using a nonce?
-			callbackURL, err := conn.LoginURL(scopes, s.absURL("/callback"), authReq.ID)
-			if err != nil {
-				s.logger.Errorf("Connector %q returned error when creating callback: %v", connID, err)
-				s.renderError(r, w, http.StatusInternalServerError, "Login error.")
-				return
-			}
-			http.Redirect(w, r, callbackURL, http.StatusFound)
-		case connector.PasswordConnector:
-			loginURL := url.URL{
-				Path: s.absPath("/auth", connID, "login"),
+		s.startConnectorLogin(w, r, conn, *authReq, backLink)
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+	}
+}
+
+// startConnectorLogin sends the user to the connector to log in for the auth request.
+func (s *Server) startConnectorLogin(w http.ResponseWriter, r *http.Request, conn Connector, authReq storage.AuthRequest, backLink string) {
+	connID := authReq.ConnectorID
+	scopes := parseScopes(authReq.Scopes)
+
+	switch conn := conn.Connector.(type) {
+	case connector.CallbackConnector:
+		// Use the auth request ID as the "state" token.
+		//
+		// TODO(ericchiang): Is this appropriate or should we also be using a nonce?
+		var (
+			callbackURL  string
+			callbackData []byte
+			err          error
+		)
+		if dataConn, ok := conn.(connector.CallbackDataConnector); ok {
+			callbackURL, callbackData, err = dataConn.LoginURLWithData(scopes, s.absURL("/callback"), authReq.ID)
+		} else {
+			callbackURL, err = conn.LoginURL(scopes, s.absURL("/callback"), authReq.ID)
+		}
+		if err != nil {
+			s.logger.Errorf("Connector %q returned error when creating callback: %v", connID, err)
+			s.renderError(r, w, http.StatusInternalServerError, "Login error.")
+			return
+		}
+		if callbackData != nil {
+			updater := func(a storage.AuthRequest) (storage.AuthRequest, error) {
+				a.CallbackData = callbackData
+				return a, nil
 			}
-			q := loginURL.Query()
-			q.Set("state", authReq.ID)
-			q.Set("back", backLink)
-			loginURL.RawQuery = q.Encode()
-
-			http.Redirect(w, r, loginURL.String(), http.StatusFound)
-		case connector.SAMLConnector:
-			action, value, err := conn.POSTData(scopes, authReq.ID)
-			if err != nil {
-				s.logger.Errorf("Creating SAML data: %v", err)
-				s.renderError(r, w, http.StatusInternalServerError, "Connector Login Error")
+			if err := s.storage.UpdateAuthRequest(authReq.ID, updater); err != nil {
+				s.logger.Errorf("Failed to update auth request: %v", err)
+				s.renderError(r, w, http.StatusInternalServerError, "Database error.")
 				return
 			}
+		}
+		http.Redirect(w, r, callbackURL, http.StatusFound)
+	case connector.PasswordConnector:
+		loginURL := url.URL{
+			Path: s.absPath("/auth", connID, "login"),
+		}
+		q := loginURL.Query()
+		q.Set("state", authReq.ID)
+		q.Set("back", backLink)
+		loginURL.RawQuery = q.Encode()
 
-			// TODO(ericchiang): Don't inline this.
-			fmt.Fprintf(w, `<!DOCTYPE html>
-			  <html lang="en">
-			  <head>
-			    <meta http-equiv="content-type" content="text/html; charset=utf-8">
-			    <title>SAML login</title>
-			  </head>
-			  <body>
-			    <form method="post" action="%s" >
-				    <input type="hidden" name="SAMLRequest" value="%s" />
-				    <input type="hidden" name="RelayState" value="%s" />
-			    </form>
-				<script>
-				    document.forms[0].submit();
-				</script>
-			  </body>
-			  </html>`, action, value, authReq.ID)
-		default:
-			s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+		http.Redirect(w, r, loginURL.String(), http.StatusFound)
+	case connector.SAMLConnector:
+		action, value, err := conn.POSTData(scopes, authReq.ID)
+		if err != nil {
+			s.logger.Errorf("Creating SAML data: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Connector Login Error")
+			return
 		}
+
+		// TODO(ericchiang): Don't inline this.
+		fmt.Fprintf(w, `<!DOCTYPE html>
+		  <html lang="en">
+		  <head>
+		    <meta http-equiv="content-type" content="text/html; charset=utf-8">
+		    <title>SAML login</title>
+		  </head>
+		  <body>
+		    <form method="post" action="%s" >
+			    <input type="hidden" name="SAMLRequest" value="%s" />
+			    <input type="hidden" name="RelayState" value="%s" />
+		    </form>
+			<script>
+			    document.forms[0].submit();
+			</script>
+		  </body>
+		  </html>`, action, value, authReq.ID)
 	default:
+		s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+	}
+}
+
+// handleConnectorChain continues a login which has to go through another connector
+// to reach the authentication context class requested by the client.
+func (s *Server) handleConnectorChain(w http.ResponseWriter, r *http.Request) {
+	authID := r.URL.Query().Get("state")
+	if authID == "" {
+		s.renderError(r, w, http.StatusBadRequest, "User session error.")
+		return
+	}
+
+	authReq, err := s.storage.GetAuthRequest(authID)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			s.logger.Errorf("Invalid 'state' parameter provided: %v", err)
+			s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+			return
+		}
+		s.logger.Errorf("Failed to get auth request: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+
+	if connID := mux.Vars(r)["connector"]; connID != authReq.ConnectorID || len(authReq.ConnectorChain) == 0 || authReq.LoggedIn {
+		s.logger.Errorf("Connector mismatch: connector chain continues with id %q, but login for id %q was triggered", authReq.ConnectorID, connID)
+		s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+		return
+	}
+
+	conn, err := s.getConnector(authReq.ConnectorID)
+	if err != nil {
+		s.logger.Errorf("Failed to get connector with id %q : %v", authReq.ConnectorID, err)
+		s.renderError(r, w, http.StatusInternalServerError, "Requested resource does not exist.")
+		return
+	}
+
+	if r.Method != http.MethodGet {
 		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+		return
 	}
+	s.startConnectorLogin(w, r, conn, authReq, "")
 }
 
 func (s *Server) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
@@ -339,7 +538,7 @@ func (s *Server) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 
 	switch r.Method {
 	case http.MethodGet:
-		if err := s.templates.password(r, w, r.URL.String(), "", usernamePrompt(pwConn), false, backLink); err != nil {
+		if err := s.templates.password(r, w, r.URL.String(), "", usernamePrompt(pwConn), false, backLink, s.passkeyLoginURL(authReq), s.forgotPasswordURL(authReq), s.registerURL(authReq), s.changePasswordURL(authReq, conn.Connector)); err != nil {
 			s.logger.Errorf("Server template error: %v", err)
 		}
 	case http.MethodPost:
@@ -347,18 +546,38 @@ func (s *Server) handlePasswordLogin(w http.ResponseWriter, r *http.Request) {
 		password := r.FormValue("password")
 		scopes := parseScopes(authReq.Scopes)
 
+		ip := s.clientIP(r)
+		if s.loginLocked(authReq.ConnectorID, username, ip) {
+			s.renderError(r, w, http.StatusTooManyRequests, errLoginLocked)
+			return
+		}
+
 		identity, ok, err := pwConn.Login(r.Context(), scopes, username, password)
+		if errors.Is(err, connector.ErrPasswordExpired) {
+			s.logger.Infof("Failed to login user: %v", err)
+			if postURL := s.changePasswordURL(authReq, conn.Connector); postURL != "" {
+				if err := s.templates.changePassword(r, w, postURL, r.URL.String(), usernamePrompt(pwConn), username, true, false, ""); err != nil {
+					s.logger.Errorf("Server template error: %v", err)
+				}
+				return
+			}
+			s.renderError(r, w, http.StatusForbidden, "Your password has expired. Contact your administrator to change it.")
+			return
+		}
 		if err != nil {
 			s.logger.Errorf("Failed to login user: %v", err)
 			s.renderError(r, w, http.StatusInternalServerError, fmt.Sprintf("Login error: %v", err))
 			return
 		}
 		if !ok {
-			if err := s.templates.password(r, w, r.URL.String(), username, usernamePrompt(pwConn), true, backLink); err != nil {
+			s.recordLoginFailure(authReq.ConnectorID, username, ip)
+			if err := s.templates.password(r, w, r.URL.String(), username, usernamePrompt(pwConn), true, backLink, s.passkeyLoginURL(authReq), s.forgotPasswordURL(authReq), s.registerURL(authReq), s.changePasswordURL(authReq, conn.Connector)); err != nil {
 				s.logger.Errorf("Server template error: %v", err)
 			}
 			return
 		}
+		s.recordLoginSuccess(authReq.ConnectorID, username)
+
 		redirectURL, err := s.finalizeLogin(identity, authReq, conn.Connector)
 		if err != nil {
 			s.logger.Errorf("Failed to finalize login: %v", err)
@@ -381,7 +600,9 @@ func (s *Server) handleConnectorCallback(w http.ResponseWriter, r *http.Request)
 			return
 		}
 	case http.MethodPost: // SAML POST binding
-		if authID = r.PostFormValue("RelayState"); authID == "" {
+		// Responses the IdP sends unsolicited may have no RelayState, but must be
+		// sent to the callback URL of their connector.
+		if authID = r.PostFormValue("RelayState"); authID == "" && mux.Vars(r)["connector"] == "" {
 			s.renderError(r, w, http.StatusBadRequest, "User session error.")
 			return
 		}
@@ -393,6 +614,10 @@ func (s *Server) handleConnectorCallback(w http.ResponseWriter, r *http.Request)
 	authReq, err := s.storage.GetAuthRequest(authID)
 	if err != nil {
 		if err == storage.ErrNotFound {
+			if connID := mux.Vars(r)["connector"]; r.Method == http.MethodPost && connID != "" {
+				s.handleIDPInitiatedLogin(w, r, connID)
+				return
+			}
 			s.logger.Errorf("Invalid 'state' parameter provided: %v", err)
 			s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
 			return
@@ -423,7 +648,11 @@ func (s *Server) handleConnectorCallback(w http.ResponseWriter, r *http.Request)
 			s.renderError(r, w, http.StatusBadRequest, "Invalid request")
 			return
 		}
-		identity, err = conn.HandleCallback(parseScopes(authReq.Scopes), r)
+		if dataConn, ok := conn.(connector.CallbackDataConnector); ok {
+			identity, err = dataConn.HandleCallbackWithData(parseScopes(authReq.Scopes), authReq.CallbackData, r)
+		} else {
+			identity, err = conn.HandleCallback(parseScopes(authReq.Scopes), r)
+		}
 	case connector.SAMLConnector:
 		if r.Method != http.MethodPost {
 			s.logger.Errorf("OAuth2 request mapped to SAML connector")
@@ -452,8 +681,113 @@ func (s *Server) handleConnectorCallback(w http.ResponseWriter, r *http.Request)
 	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
 }
 
+// handleIDPInitiatedLogin logs a user in with a SAML response the IdP sent
+// without a request, to the client the connector is configured with.
+func (s *Server) handleIDPInitiatedLogin(w http.ResponseWriter, r *http.Request, connID string) {
+	conn, err := s.getConnector(connID)
+	if err != nil {
+		s.logger.Errorf("Failed to get connector with id %q : %v", connID, err)
+		s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+		return
+	}
+	samlConn, ok := conn.Connector.(connector.SAMLIDPInitiatedConnector)
+	var login connector.IDPInitiatedLogin
+	if ok {
+		login, ok = samlConn.IDPInitiatedLogin()
+	}
+	if !ok {
+		s.logger.Errorf("Invalid 'RelayState' parameter provided for connector %q, which doesn't accept IdP-initiated logins", connID)
+		s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+		return
+	}
+
+	client, err := s.storage.GetClient(login.ClientID)
+	if err != nil {
+		s.logger.Errorf("Failed to get client %q of IdP-initiated logins of connector %q: %v", login.ClientID, connID, err)
+		s.renderError(r, w, http.StatusInternalServerError, "Login error.")
+		return
+	}
+	redirectURI := login.RedirectURI
+	if relayState := r.PostFormValue("RelayState"); relayState != "" {
+		redirectURI = relayState
+	}
+	if !validateRedirectURI(client, redirectURI) {
+		s.logger.Errorf("IdP-initiated login of connector %q to unregistered redirect URI %q of client %q", connID, redirectURI, client.ID)
+		s.renderError(r, w, http.StatusBadRequest, "Unregistered redirect_uri.")
+		return
+	}
+
+	authReq := storage.AuthRequest{
+		ID:            storage.NewID(),
+		ClientID:      client.ID,
+		ResponseTypes: []string{responseTypeCode},
+		Scopes:        login.Scopes,
+		RedirectURI:   redirectURI,
+		ConnectorID:   connID,
+		Expiry:        s.now().Add(s.authRequestsValidFor),
+	}
+	identity, assertionID, expiry, err := samlConn.HandleUnsolicitedPOST(parseScopes(authReq.Scopes), r.PostFormValue("SAMLResponse"))
+	if err != nil {
+		s.logger.Errorf("Failed to authenticate: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, fmt.Sprintf("Failed to authenticate: %v", err))
+		return
+	}
+
+	// The assertion is remembered until it expires, to reject it if it's replayed.
+	if err := s.storage.CreateSAMLAssertion(storage.SAMLAssertion{ID: connID + "|" + assertionID, Expiry: expiry}); err != nil {
+		if err == storage.ErrAlreadyExists {
+			s.logger.Errorf("Replayed SAML assertion %q of connector %q", assertionID, connID)
+			s.renderError(r, w, http.StatusBadRequest, "Failed to authenticate: the SAML assertion was already used.")
+			return
+		}
+		s.logger.Errorf("Failed to save SAML assertion: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+
+	if err := s.storage.CreateAuthRequest(authReq); err != nil {
+		s.logger.Errorf("Failed to create authorization request: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+	redirectURL, err := s.finalizeLogin(identity, authReq, conn.Connector)
+	if err != nil {
+		s.logger.Errorf("Failed to finalize login: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Login error.")
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+// handleSAMLMetadata serves the service provider metadata of a SAML connector.
+func (s *Server) handleSAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	connID := mux.Vars(r)["connector"]
+	conn, err := s.getConnector(connID)
+	if err != nil {
+		s.logger.Errorf("Failed to get connector: %v", err)
+		s.renderError(r, w, http.StatusNotFound, "Requested resource does not exist.")
+		return
+	}
+	metadataConn, ok := conn.Connector.(connector.SAMLMetadataConnector)
+	if !ok {
+		s.renderError(r, w, http.StatusNotFound, "Requested resource does not exist.")
+		return
+	}
+
+	data, err := metadataConn.Metadata()
+	if err != nil {
+		s.logger.Errorf("Failed to create SAML metadata of connector %q: %v", connID, err)
+		s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
 // finalizeLogin associates the user's identity with the current AuthRequest, then returns
-// the approval page's path.
+// the approval page's path. If the client asked for an authentication context class the
+// login doesn't reach yet, it returns the path of the next connector to log in with.
 func (s *Server) finalizeLogin(identity connector.Identity, authReq storage.AuthRequest, conn connector.Connector) (string, error) {
 	claims := storage.Claims{
 		UserID:            identity.UserID,
@@ -464,10 +798,50 @@ func (s *Server) finalizeLogin(identity connector.Identity, authReq storage.Auth
 		Groups:            identity.Groups,
 	}
 
+	chain := append(append([]string(nil), authReq.ConnectorChain...), authReq.ConnectorID)
+	if len(authReq.ConnectorChain) > 0 {
+		// Later connectors of a chain only confirm the identity of the first one, which
+		// is the identity the client gets.
+		if !sameACRUser(authReq.Claims, claims) {
+			return "", fmt.Errorf("user %q logged in with connector %q isn't the user %q who logged in with connector %q",
+				claims.Email, authReq.ConnectorID, authReq.Claims.Email, chain[0])
+		}
+		first, err := s.getConnector(chain[0])
+		if err != nil {
+			return "", fmt.Errorf("failed to get connector %q: %v", chain[0], err)
+		}
+		claims = authReq.Claims
+		identity.UserID = claims.UserID
+		identity.ConnectorData = authReq.ConnectorData
+		authReq.ConnectorID = chain[0]
+		conn = first.Connector
+	}
+
+	if requested := s.requestedACR(authReq.ACRValues); s.achievedACR(chain) < requested {
+		next, ok := s.nextACRConnector(chain, requested)
+		if !ok {
+			return "", fmt.Errorf("login with connectors %q doesn't reach requested acr %q", chain, s.acrLevels[requested].Value)
+		}
+		updater := func(a storage.AuthRequest) (storage.AuthRequest, error) {
+			a.Claims = claims
+			a.ConnectorData = identity.ConnectorData
+			a.ConnectorChain = chain
+			a.ConnectorID = next
+			return a, nil
+		}
+		if err := s.storage.UpdateAuthRequest(authReq.ID, updater); err != nil {
+			return "", fmt.Errorf("failed to update auth request: %v", err)
+		}
+		return s.absPath("/auth", next, "chain") + "?state=" + url.QueryEscape(authReq.ID), nil
+	}
+	claims.ACR = s.acrValue(chain)
+
 	updater := func(a storage.AuthRequest) (storage.AuthRequest, error) {
 		a.LoggedIn = true
 		a.Claims = claims
 		a.ConnectorData = identity.ConnectorData
+		a.ConnectorID = authReq.ConnectorID
+		a.ConnectorChain = chain
 		return a, nil
 	}
 	if err := s.storage.UpdateAuthRequest(authReq.ID, updater); err != nil {
@@ -538,6 +912,22 @@ func (s *Server) handleApproval(w http.ResponseWriter, r *http.Request) {
 		s.renderError(r, w, http.StatusInternalServerError, "Login process not yet finalized.")
 		return
 	}
+	if !authReq.MFAValidated && s.totpRequired(authReq.ConnectorID, authReq.ClientID) {
+		http.Redirect(w, r, s.absPath("/totp")+"?state="+url.QueryEscape(authReq.ID), http.StatusSeeOther)
+		return
+	}
+	if !authReq.MFAValidated && s.webAuthnRequired(authReq.ConnectorID) {
+		http.Redirect(w, r, s.absPath("/webauthn/login")+"?state="+url.QueryEscape(authReq.ID), http.StatusSeeOther)
+		return
+	}
+	if r.Method == http.MethodGet && r.FormValue("webauthn") != "skip" && s.offerWebAuthnRegistration(authReq) {
+		http.Redirect(w, r, s.absPath("/webauthn/register")+"?state="+url.QueryEscape(authReq.ID), http.StatusSeeOther)
+		return
+	}
+	if isSAMLAuthRequest(authReq) {
+		s.sendSAMLResponse(w, r, authReq)
+		return
+	}
 
 	switch r.Method {
 	case http.MethodGet:
@@ -545,6 +935,10 @@ func (s *Server) handleApproval(w http.ResponseWriter, r *http.Request) {
 			s.sendCodeResponse(w, r, authReq)
 			return
 		}
+		if !authReq.ForceApprovalPrompt && s.consentGranted(authReq) {
+			s.sendCodeResponse(w, r, authReq)
+			return
+		}
 		client, err := s.storage.GetClient(authReq.ClientID)
 		if err != nil {
 			s.logger.Errorf("Failed to get client %q: %v", authReq.ClientID, err)
@@ -556,13 +950,105 @@ func (s *Server) handleApproval(w http.ResponseWriter, r *http.Request) {
 		}
 	case http.MethodPost:
 		if r.FormValue("approval") != "approve" {
-			s.renderError(r, w, http.StatusInternalServerError, "Approval rejected.")
+			// Denying access also withdraws any consent given to the client earlier.
+			if err := s.revokeConsent(authReq); err != nil {
+				s.logger.Errorf("Failed to revoke user consent: %v", err)
+			}
+			s.declineBackchannelAuthRequest(authReq)
+			s.sendAccessDenied(w, r, authReq)
 			return
 		}
+		if err := s.recordConsent(authReq); err != nil {
+			s.logger.Errorf("Failed to record user consent: %v", err)
+		}
 		s.sendCodeResponse(w, r, authReq)
 	}
 }
 
+// sendAccessDenied tells the client the user rejected the request, and ends
+// the auth request.
+func (s *Server) sendAccessDenied(w http.ResponseWriter, r *http.Request, authReq storage.AuthRequest) {
+	if err := s.storage.DeleteAuthRequest(authReq.ID); err != nil && err != storage.ErrNotFound {
+		s.logger.Errorf("Failed to delete authorization request: %v", err)
+	}
+	// SAML service providers don't take OAuth2 errors.
+	if isSAMLAuthRequest(authReq) {
+		s.renderError(r, w, http.StatusForbidden, "Approval rejected.")
+		return
+	}
+	s.sendAuthError(w, r, &redirectedAuthErr{
+		State:            authReq.State,
+		RedirectURI:      authReq.RedirectURI,
+		Type:             errAccessDenied,
+		Description:      "The user rejected the request.",
+		ClientID:         authReq.ClientID,
+		ResponseMode:     authReq.ResponseMode,
+		ImplicitOrHybrid: implicitOrHybridFlow(authReq.ResponseTypes),
+	})
+}
+
+// consentGranted reports whether the user has already approved every scope of
+// the auth request for the requesting client.
+func (s *Server) consentGranted(authReq storage.AuthRequest) bool {
+	consent, err := s.storage.GetUserConsent(authReq.Claims.UserID, authReq.ConnectorID)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to get user consent: %v", err)
+		}
+		return false
+	}
+	clientConsent, ok := consent.Clients[authReq.ClientID]
+	return ok && clientConsent.Covers(authReq.Scopes)
+}
+
+// recordConsent adds the scopes of an approved auth request to the scopes the
+// user has granted to the client.
+func (s *Server) recordConsent(authReq storage.AuthRequest) error {
+	now := s.now()
+	updater := func(old storage.UserConsent) (storage.UserConsent, error) {
+		if old.Clients == nil {
+			old.Clients = make(map[string]*storage.ClientConsent)
+		}
+		clientConsent, ok := old.Clients[authReq.ClientID]
+		if !ok {
+			clientConsent = &storage.ClientConsent{
+				ClientID:  authReq.ClientID,
+				CreatedAt: now,
+			}
+			old.Clients[authReq.ClientID] = clientConsent
+		}
+		for _, scope := range authReq.Scopes {
+			if !contains(clientConsent.Scopes, scope) {
+				clientConsent.Scopes = append(clientConsent.Scopes, scope)
+			}
+		}
+		clientConsent.LastUpdated = now
+		return old, nil
+	}
+
+	err := s.storage.UpdateUserConsent(authReq.Claims.UserID, authReq.ConnectorID, updater)
+	if err != storage.ErrNotFound {
+		return err
+	}
+	consent, _ := updater(storage.UserConsent{
+		UserID: authReq.Claims.UserID,
+		ConnID: authReq.ConnectorID,
+	})
+	return s.storage.CreateUserConsent(consent)
+}
+
+// revokeConsent removes the consent the user has given to the requesting client.
+func (s *Server) revokeConsent(authReq storage.AuthRequest) error {
+	err := s.storage.UpdateUserConsent(authReq.Claims.UserID, authReq.ConnectorID, func(old storage.UserConsent) (storage.UserConsent, error) {
+		delete(old.Clients, authReq.ClientID)
+		return old, nil
+	})
+	if err == storage.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
 func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authReq storage.AuthRequest) {
 	if s.now().After(authReq.Expiry) {
 		s.renderError(r, w, http.StatusBadRequest, "User session has expired.")
@@ -652,8 +1138,8 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 		}
 	}
 
+	v := url.Values{}
 	if implicitOrHybrid {
-		v := url.Values{}
 		v.Set("access_token", accessToken)
 		v.Set("token_type", "bearer")
 		v.Set("state", authReq.State)
@@ -671,7 +1157,53 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 		if code.ID != "" {
 			v.Set("code", code.ID)
 		}
+	} else {
+		v.Set("code", code.ID)
+		v.Set("state", authReq.State)
+	}
+
+	s.sendAuthResponse(w, r, authReq, u, v, implicitOrHybrid)
+}
+
+// sendAuthResponse returns the parameters of an authorization response to the
+// client using the "response_mode" of the auth request.
+func (s *Server) sendAuthResponse(w http.ResponseWriter, r *http.Request, authReq storage.AuthRequest, u *url.URL, v url.Values, implicitOrHybrid bool) {
+	// Implicit and hybrid flows default to the fragment, the code flow to the query.
+	responseMode := responseModeQuery
+	if implicitOrHybrid {
+		responseMode = responseModeFragment
+	}
+
+	switch authReq.ResponseMode {
+	case "":
+	case responseModeJWT:
+		responseMode += ".jwt"
+	default:
+		responseMode = authReq.ResponseMode
+	}
+
+	if strings.HasSuffix(responseMode, ".jwt") {
+		response, err := s.newResponseJWT(authReq.ClientID, v)
+		if err != nil {
+			s.logger.Errorf("failed to create response JWT: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+			return
+		}
+		v = url.Values{"response": {response}}
+		responseMode = strings.TrimSuffix(responseMode, ".jwt")
+	}
 
+	switch responseMode {
+	case responseModeFormPost:
+		// The values are sent as the body of a POST request to the redirect URI,
+		// issued by an auto-submitting form.
+		//
+		// https://openid.net/specs/oauth-v2-form-post-response-mode-1_0.html
+		if err := s.templates.formPost(r, w, u.String(), v); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+		return
+	case responseModeFragment:
 		// Implicit and hybrid flows return their values as part of the fragment.
 		//
 		//   HTTP/1.1 303 See Other
@@ -683,7 +1215,7 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 		//     &state=af0ifjsldkj
 		//
 		u.Fragment = v.Encode()
-	} else {
+	default:
 		// The code flow add values to the URL query.
 		//
 		//   HTTP/1.1 303 See Other
@@ -692,8 +1224,9 @@ func (s *Server) sendCodeResponse(w http.ResponseWriter, r *http.Request, authRe
 		//     &state=af0ifjsldkj
 		//
 		q := u.Query()
-		q.Set("code", code.ID)
-		q.Set("state", authReq.State)
+		for key, values := range v {
+			q[key] = values
+		}
 		u.RawQuery = q.Encode()
 	}
 
@@ -765,6 +1298,12 @@ func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
 		s.withClientFromStorage(w, r, s.handleRefreshToken)
 	case grantTypePassword:
 		s.withClientFromStorage(w, r, s.handlePasswordGrant)
+	case grantTypeCIBA:
+		if s.backchannelNotifier == nil {
+			s.tokenErrHelper(w, errUnsupportedGrantType, "", http.StatusBadRequest)
+			return
+		}
+		s.withClientFromStorage(w, r, s.handleBackchannelToken)
 	default:
 		s.tokenErrHelper(w, errUnsupportedGrantType, "", http.StatusBadRequest)
 	}
@@ -866,7 +1405,8 @@ func (s *Server) exchangeAuthCode(w http.ResponseWriter, authCode storage.AuthCo
 	reqRefresh := func() bool {
 		// Ensure the connector supports refresh tokens.
 		//
-		// Connectors like `saml` do not implement RefreshConnector.
+		// Connectors like `saml` only implement RefreshConnector if they are
+		// configured to refresh identities.
 		conn, err := s.getConnector(authCode.ConnectorID)
 		if err != nil {
 			s.logger.Errorf("connector with ID %q not found: %v", authCode.ConnectorID, err)
@@ -998,8 +1538,7 @@ func (s *Server) handleUserInfo(w http.ResponseWriter, r *http.Request) {
 	}
 	rawIDToken := auth[len(prefix):]
 
-	verifier := oidc.NewVerifier(s.issuerURL.String(), &storageKeySet{s.storage}, &oidc.Config{SkipClientIDCheck: true})
-	idToken, err := verifier.Verify(r.Context(), rawIDToken)
+	idToken, err := s.verifyAccessToken(r.Context(), rawIDToken)
 	if err != nil {
 		s.tokenErrHelper(w, errAccessDenied, err.Error(), http.StatusForbidden)
 		return
@@ -1011,8 +1550,40 @@ func (s *Server) handleUserInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.Write(claims)
+	// Access tokens name the client as the authorized party when they were issued
+	// for a cross client audience.
+	var authorizedParty struct {
+		AZP string `json:"azp"`
+	}
+	if err := json.Unmarshal(claims, &authorizedParty); err != nil {
+		s.tokenErrHelper(w, errServerError, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	clientID := authorizedParty.AZP
+	if clientID == "" && len(idToken.Audience) > 0 {
+		clientID = idToken.Audience[0]
+	}
+
+	client, err := s.storage.GetClient(clientID)
+	if err != nil && err != storage.ErrNotFound {
+		s.logger.Errorf("failed to get client: %v", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	if client.UserInfoSignedResponseAlg == "" && client.UserInfoEncryptedResponseAlg == "" {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(claims)
+		return
+	}
+
+	resp, err := s.newUserInfoResponse(client, claims)
+	if err != nil {
+		s.logger.Errorf("failed to create userinfo response for client %q: %v", client.ID, err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/jwt")
+	w.Write([]byte(resp))
 }
 
 func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, client storage.Client) {
@@ -1082,9 +1653,20 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		return
 	}
 
+	// There's no way to ask for a second factor in the password grant.
+	if s.totpRequired(connID, client.ID) || s.webAuthnRequired(connID) {
+		s.tokenErrHelper(w, errUnauthorizedClient, "A second factor is required to log in with this connector.", http.StatusBadRequest)
+		return
+	}
+
 	// Login
 	username := q.Get("username")
 	password := q.Get("password")
+	ip := s.clientIP(r)
+	if s.loginLocked(connID, username, ip) {
+		s.tokenErrHelper(w, errAccessDenied, errLoginLocked, http.StatusTooManyRequests)
+		return
+	}
 	identity, ok, err := passwordConnector.Login(r.Context(), parseScopes(scopes), username, password)
 	if err != nil {
 		s.logger.Errorf("Failed to login user: %v", err)
@@ -1092,9 +1674,11 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		return
 	}
 	if !ok {
+		s.recordLoginFailure(connID, username, ip)
 		s.tokenErrHelper(w, errAccessDenied, "Invalid username or password", http.StatusUnauthorized)
 		return
 	}
+	s.recordLoginSuccess(connID, username)
 
 	// Build the claims to send the id token
 	claims := storage.Claims{
@@ -1104,6 +1688,7 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 		Email:             identity.Email,
 		EmailVerified:     identity.EmailVerified,
 		Groups:            identity.Groups,
+		ACR:               s.acrValue([]string{connID}),
 	}
 
 	accessToken, err := s.newAccessToken(client.ID, claims, scopes, nonce, connID)
@@ -1123,7 +1708,8 @@ func (s *Server) handlePasswordGrant(w http.ResponseWriter, r *http.Request, cli
 	reqRefresh := func() bool {
 		// Ensure the connector supports refresh tokens.
 		//
-		// Connectors like `saml` do not implement RefreshConnector.
+		// Connectors like `saml` only implement RefreshConnector if they are
+		// configured to refresh identities.
 		_, ok := conn.Connector.(connector.RefreshConnector)
 		if !ok {
 			return false
@@ -5,10 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"html"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"path"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,7 +20,9 @@ import (
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
+	jose "gopkg.in/square/go-jose.v2"
 
+	"github.com/dexidp/dex/connector"
 	"github.com/dexidp/dex/storage"
 )
 
@@ -62,6 +67,96 @@ func TestHandleHealthFailure(t *testing.T) {
 	}
 }
 
+func TestHandleDiscoveryMetadata(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, server := newTestServer(ctx, t, func(c *Config) {
+		c.Issuer += "/non-root-path"
+	})
+	defer httpServer.Close()
+
+	get := func(p string) []byte {
+		rr := httptest.NewRecorder()
+		server.ServeHTTP(rr, httptest.NewRequest("GET", p, nil))
+		require.Equal(t, http.StatusOK, rr.Code, p)
+		require.Equal(t, "application/json", rr.Header().Get("Content-Type"), p)
+		return rr.Body.Bytes()
+	}
+
+	oidcConfig := get("/non-root-path/.well-known/openid-configuration")
+	require.Equal(t, oidcConfig, get("/non-root-path/.well-known/oauth-authorization-server"))
+	require.Equal(t, oidcConfig, get("/.well-known/oauth-authorization-server/non-root-path"))
+
+	var d discovery
+	require.NoError(t, json.Unmarshal(oidcConfig, &d))
+	require.Equal(t, server.issuerURL.String(), d.Issuer)
+	require.NotContains(t, d.AuthMethods, "none")
+	require.Equal(t, server.absURL("/token/revoke"), d.Revocation)
+	require.Equal(t, server.absURL("/token/introspect"), d.Introspection)
+	require.Contains(t, d.Claims, "groups")
+	require.Equal(t, []string{codeChallengeMethodS256, codeChallengeMethodPlain}, d.CodeChallengeAlgs)
+	require.False(t, d.RequestURIParameter)
+}
+
+func TestHandleWebfinger(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, server := newTestServer(ctx, t, func(c *Config) {
+		c.Issuer += "/non-root-path"
+	})
+	defer httpServer.Close()
+
+	tests := []struct {
+		name      string
+		query     url.Values
+		wantCode  int
+		wantLinks []webfingerLink
+	}{
+		{
+			name:     "missing resource",
+			query:    url.Values{"rel": {webfingerIssuerRel}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:      "issuer",
+			query:     url.Values{"resource": {"acct:jane@example.com"}, "rel": {webfingerIssuerRel}},
+			wantCode:  http.StatusOK,
+			wantLinks: []webfingerLink{{Rel: webfingerIssuerRel, Href: server.issuerURL.String()}},
+		},
+		{
+			name:      "no rel",
+			query:     url.Values{"resource": {"https://example.com/jane"}},
+			wantCode:  http.StatusOK,
+			wantLinks: []webfingerLink{{Rel: webfingerIssuerRel, Href: server.issuerURL.String()}},
+		},
+		{
+			name:      "other rel",
+			query:     url.Values{"resource": {"acct:jane@example.com"}, "rel": {"http://webfinger.net/rel/avatar"}},
+			wantCode:  http.StatusOK,
+			wantLinks: []webfingerLink{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			server.ServeHTTP(rr, httptest.NewRequest("GET", "/.well-known/webfinger?"+tc.query.Encode(), nil))
+			require.Equal(t, tc.wantCode, rr.Code)
+			if tc.wantCode != http.StatusOK {
+				return
+			}
+
+			require.Equal(t, "application/jrd+json", rr.Header().Get("Content-Type"))
+			var resp webfingerResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Equal(t, tc.query.Get("resource"), resp.Subject)
+			require.Equal(t, tc.wantLinks, resp.Links)
+		})
+	}
+}
+
 type emptyStorage struct {
 	storage.Storage
 }
@@ -132,6 +227,40 @@ func TestHandleInvalidSAMLCallbacks(t *testing.T) {
 	}
 }
 
+func TestHandleSAMLMetadata(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, server := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	require.NoError(t, server.storage.CreateConnector(storage.Connector{
+		ID:              "saml",
+		Type:            "saml",
+		Name:            "SAML",
+		ResourceVersion: "1",
+		Config: []byte(`{
+			"ssoURL": "https://idp.example.com/sso",
+			"insecureSkipSignatureValidation": true,
+			"usernameAttr": "name",
+			"emailAttr": "email",
+			"redirectURI": "https://dex.example.com/callback"
+		}`),
+	}))
+
+	rr := httptest.NewRecorder()
+	server.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/saml/saml/metadata", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/samlmetadata+xml", rr.Header().Get("Content-Type"))
+	require.Contains(t, rr.Body.String(), `entityID="https://dex.example.com/callback"`)
+	require.Contains(t, rr.Body.String(), `Location="https://dex.example.com/callback"`)
+
+	// Only SAML connectors have metadata.
+	rr = httptest.NewRecorder()
+	server.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/saml/mock/metadata", nil))
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
 // TestHandleAuthCode checks that it is forbidden to use same code twice
 func TestHandleAuthCode(t *testing.T) {
 	tests := []struct {
@@ -301,3 +430,465 @@ func TestPasswordConnectorDataNotEmpty(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, `{"test": "true"}`, string(newSess.ConnectorData))
 }
+
+func TestHandleApprovalConsent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+	s.skipApproval = false
+
+	err := s.storage.CreateClient(storage.Client{
+		ID:           "test",
+		Secret:       "barfoo",
+		RedirectURIs: []string{"https://example.com/callback"},
+		Name:         "dex client",
+	})
+	require.NoError(t, err)
+
+	newAuthRequest := func(scopes []string, force bool) string {
+		authReq := storage.AuthRequest{
+			ID:                  storage.NewID(),
+			ClientID:            "test",
+			ConnectorID:         "mock",
+			RedirectURI:         "https://example.com/callback",
+			ResponseTypes:       []string{responseTypeCode},
+			Scopes:              scopes,
+			ForceApprovalPrompt: force,
+			LoggedIn:            true,
+			Claims:              storage.Claims{UserID: "1", Username: "jane"},
+			Expiry:              time.Now().Add(time.Minute),
+		}
+		require.NoError(t, s.storage.CreateAuthRequest(authReq))
+		return authReq.ID
+	}
+	var rr *httptest.ResponseRecorder
+	approval := func(method, id string, form url.Values) int {
+		rr = httptest.NewRecorder()
+		req := httptest.NewRequest(method, "/approval?req="+id, bytes.NewBufferString(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		s.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	// No consent has been given yet, the approval screen is shown.
+	id := newAuthRequest([]string{"openid", "email"}, false)
+	require.Equal(t, http.StatusOK, approval(http.MethodGet, id, nil))
+	require.Equal(t, http.StatusSeeOther, approval(http.MethodPost, id, url.Values{"approval": {"approve"}}))
+
+	consent, err := s.storage.GetUserConsent("1", "mock")
+	require.NoError(t, err)
+	require.Equal(t, []string{"openid", "email"}, consent.Clients["test"].Scopes)
+
+	// Requesting a subset of the approved scopes skips the approval screen.
+	id = newAuthRequest([]string{"openid"}, false)
+	require.Equal(t, http.StatusSeeOther, approval(http.MethodGet, id, nil))
+
+	// Additional scopes require another approval.
+	id = newAuthRequest([]string{"openid", "groups"}, false)
+	require.Equal(t, http.StatusOK, approval(http.MethodGet, id, nil))
+
+	// Forcing the prompt shows the approval screen, and rejecting it revokes the consent.
+	id = newAuthRequest([]string{"openid"}, true)
+	require.Equal(t, http.StatusOK, approval(http.MethodGet, id, nil))
+	require.Equal(t, http.StatusSeeOther, approval(http.MethodPost, id, url.Values{"approval": {"reject"}}))
+	require.Equal(t, errAccessDenied, queryParams(t, rr).Get("error"))
+
+	consent, err = s.storage.GetUserConsent("1", "mock")
+	require.NoError(t, err)
+	require.NotContains(t, consent.Clients, "test")
+}
+
+func TestSendCodeResponseModes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	const redirectURI = "https://example.com/callback"
+
+	tests := []struct {
+		name          string
+		responseTypes []string
+		responseMode  string
+
+		wantCode int
+		// Returns the response parameters sent to the client.
+		params func(t *testing.T, rr *httptest.ResponseRecorder) url.Values
+		// Whether the parameters are wrapped in a JWT.
+		jwt bool
+	}{
+		{
+			name:          "default code flow",
+			responseTypes: []string{responseTypeCode},
+			wantCode:      http.StatusSeeOther,
+			params:        queryParams,
+		},
+		{
+			name:          "default implicit flow",
+			responseTypes: []string{responseTypeIDToken},
+			wantCode:      http.StatusSeeOther,
+			params:        fragmentParams,
+		},
+		{
+			name:          "fragment",
+			responseTypes: []string{responseTypeCode},
+			responseMode:  responseModeFragment,
+			wantCode:      http.StatusSeeOther,
+			params:        fragmentParams,
+		},
+		{
+			name:          "form_post",
+			responseTypes: []string{responseTypeCode},
+			responseMode:  responseModeFormPost,
+			wantCode:      http.StatusOK,
+			params:        formPostParams,
+		},
+		{
+			name:          "query.jwt",
+			responseTypes: []string{responseTypeCode},
+			responseMode:  responseModeQueryJWT,
+			wantCode:      http.StatusSeeOther,
+			params:        queryParams,
+			jwt:           true,
+		},
+		{
+			name:          "jwt defaults to fragment for implicit flow",
+			responseTypes: []string{responseTypeIDToken},
+			responseMode:  responseModeJWT,
+			wantCode:      http.StatusSeeOther,
+			params:        fragmentParams,
+			jwt:           true,
+		},
+		{
+			name:          "form_post.jwt",
+			responseTypes: []string{responseTypeCode},
+			responseMode:  responseModeFormPostJWT,
+			wantCode:      http.StatusOK,
+			params:        formPostParams,
+			jwt:           true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authReq := storage.AuthRequest{
+				ID:            storage.NewID(),
+				ClientID:      "test",
+				ConnectorID:   "mock",
+				RedirectURI:   redirectURI,
+				ResponseTypes: tc.responseTypes,
+				ResponseMode:  tc.responseMode,
+				Scopes:        []string{"openid"},
+				Nonce:         "nonce",
+				State:         "state",
+				LoggedIn:      true,
+				Claims:        storage.Claims{UserID: "1", Username: "jane"},
+				Expiry:        time.Now().Add(time.Minute),
+			}
+			require.NoError(t, s.storage.CreateAuthRequest(authReq))
+
+			rr := httptest.NewRecorder()
+			s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/approval?req="+authReq.ID, nil))
+			require.Equal(t, tc.wantCode, rr.Code)
+
+			params := tc.params(t, rr)
+			if tc.jwt {
+				jws, err := jose.ParseSigned(params.Get("response"))
+				require.NoError(t, err)
+				payload, err := jws.Verify(testKey.Public())
+				require.NoError(t, err)
+
+				var claims map[string]interface{}
+				require.NoError(t, json.Unmarshal(payload, &claims))
+				require.Equal(t, s.issuerURL.String(), claims["iss"])
+				require.Equal(t, "test", claims["aud"])
+
+				params = url.Values{}
+				for key, value := range claims {
+					if str, ok := value.(string); ok {
+						params.Set(key, str)
+					}
+				}
+			}
+			require.Equal(t, "state", params.Get("state"))
+			if contains(tc.responseTypes, responseTypeCode) {
+				require.NotEmpty(t, params.Get("code"))
+			} else {
+				require.NotEmpty(t, params.Get("id_token"))
+			}
+		})
+	}
+}
+
+func TestAuthErrorResponseModes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	const redirectURI = "https://example.com/callback"
+	require.NoError(t, s.storage.CreateClient(storage.Client{
+		ID:           "test",
+		Secret:       "barfoo",
+		RedirectURIs: []string{redirectURI},
+	}))
+
+	authorize := func(responseType, responseMode string) *httptest.ResponseRecorder {
+		q := url.Values{
+			"client_id":     {"test"},
+			"redirect_uri":  {redirectURI},
+			"response_type": {responseType},
+			"response_mode": {responseMode},
+			"scope":         {"openid unknown"},
+			"state":         {"state"},
+			"nonce":         {"nonce"},
+		}
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/auth/mock?"+q.Encode(), nil))
+		return rr
+	}
+
+	t.Run("form_post", func(t *testing.T) {
+		rr := authorize(responseTypeCode, responseModeFormPost)
+		require.Equal(t, http.StatusOK, rr.Code)
+		params := formPostParams(t, rr)
+		require.Equal(t, errInvalidScope, params.Get("error"))
+		require.Equal(t, "state", params.Get("state"))
+	})
+
+	t.Run("form_post.jwt", func(t *testing.T) {
+		rr := authorize(responseTypeCode, responseModeFormPostJWT)
+		require.Equal(t, http.StatusOK, rr.Code)
+		jws, err := jose.ParseSigned(formPostParams(t, rr).Get("response"))
+		require.NoError(t, err)
+		payload, err := jws.Verify(testKey.Public())
+		require.NoError(t, err)
+		var claims map[string]interface{}
+		require.NoError(t, json.Unmarshal(payload, &claims))
+		require.Equal(t, errInvalidScope, claims["error"])
+	})
+
+	t.Run("query isn't used for the implicit flow", func(t *testing.T) {
+		rr := authorize(responseTypeIDToken, responseModeQuery)
+		require.Equal(t, http.StatusSeeOther, rr.Code)
+		require.Equal(t, errInvalidScope, fragmentParams(t, rr).Get("error"))
+	})
+}
+
+func queryParams(t *testing.T, rr *httptest.ResponseRecorder) url.Values {
+	u, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	return u.Query()
+}
+
+func fragmentParams(t *testing.T, rr *httptest.ResponseRecorder) url.Values {
+	u, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+	v, err := url.ParseQuery(u.Fragment)
+	require.NoError(t, err)
+	return v
+}
+
+var hiddenInput = regexp.MustCompile(`<input type="hidden" name="([^"]+)" value="([^"]*)"/>`)
+
+func formPostParams(t *testing.T, rr *httptest.ResponseRecorder) url.Values {
+	require.Contains(t, rr.Body.String(), `action="https://example.com/callback"`)
+	v := url.Values{}
+	for _, match := range hiddenInput.FindAllStringSubmatch(rr.Body.String(), -1) {
+		v.Add(match[1], html.UnescapeString(match[2]))
+	}
+	return v
+}
+
+func TestHandleUserInfoResponse(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	jwks := &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: testKey.Public(), Use: "enc"}}}
+
+	tests := []struct {
+		name            string
+		client          storage.Client
+		wantContentType string
+		encrypted       bool
+		signed          bool
+	}{
+		{
+			name:            "plain json",
+			client:          storage.Client{ID: "plain"},
+			wantContentType: "application/json",
+		},
+		{
+			name:            "signed",
+			client:          storage.Client{ID: "signed", UserInfoSignedResponseAlg: "RS256"},
+			wantContentType: "application/jwt",
+			signed:          true,
+		},
+		{
+			name: "encrypted",
+			client: storage.Client{
+				ID:                           "encrypted",
+				UserInfoEncryptedResponseAlg: "RSA-OAEP",
+				JWKS:                         jwks,
+			},
+			wantContentType: "application/jwt",
+			encrypted:       true,
+		},
+		{
+			name: "signed and encrypted",
+			client: storage.Client{
+				ID:                           "nested",
+				UserInfoSignedResponseAlg:    "RS256",
+				UserInfoEncryptedResponseAlg: "RSA-OAEP",
+				UserInfoEncryptedResponseEnc: "A128GCM",
+				JWKS:                         jwks,
+			},
+			wantContentType: "application/jwt",
+			signed:          true,
+			encrypted:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := s.storage.CreateClient(tc.client); err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			claims := storage.Claims{UserID: "1", Email: "jane@example.com", EmailVerified: true}
+			accessToken, err := s.newAccessToken(tc.client.ID, claims, []string{"openid", "email"}, "", "mock")
+			if err != nil {
+				t.Fatalf("failed to create access token: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/userinfo", nil)
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+			rr := httptest.NewRecorder()
+			s.handleUserInfo(rr, req)
+
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			require.Equal(t, tc.wantContentType, rr.Header().Get("Content-Type"))
+
+			body := rr.Body.Bytes()
+			if tc.encrypted {
+				obj, err := jose.ParseEncrypted(string(body))
+				require.NoError(t, err)
+				body, err = obj.Decrypt(testKey)
+				require.NoError(t, err)
+			}
+			if tc.signed {
+				jws, err := jose.ParseSigned(string(body))
+				require.NoError(t, err)
+				body, err = jws.Verify(testKey.Public())
+				require.NoError(t, err)
+			}
+
+			var got map[string]interface{}
+			require.NoError(t, json.Unmarshal(body, &got))
+			require.Equal(t, "jane@example.com", got["email"])
+			require.Equal(t, s.issuerURL.String(), got["iss"])
+			if tc.signed {
+				require.Equal(t, tc.client.ID, got["aud"])
+			}
+		})
+	}
+}
+
+// idpInitiatedConnector accepts unsolicited responses whose value is the ID of
+// their assertion.
+type idpInitiatedConnector struct {
+	login connector.IDPInitiatedLogin
+}
+
+func (c idpInitiatedConnector) POSTData(s connector.Scopes, requestID string) (string, string, error) {
+	return "", "", errors.New("not implemented")
+}
+
+func (c idpInitiatedConnector) HandlePOST(s connector.Scopes, samlResponse, inResponseTo string) (connector.Identity, error) {
+	return connector.Identity{}, errors.New("not implemented")
+}
+
+func (c idpInitiatedConnector) IDPInitiatedLogin() (connector.IDPInitiatedLogin, bool) {
+	return c.login, true
+}
+
+func (c idpInitiatedConnector) HandleUnsolicitedPOST(s connector.Scopes, samlResponse string) (connector.Identity, string, time.Time, error) {
+	ident := connector.Identity{UserID: "jane", Username: "jane", Email: "jane@example.com", EmailVerified: true}
+	return ident, samlResponse, time.Now().Add(5 * time.Minute), nil
+}
+
+func TestHandleIDPInitiatedLogin(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.SkipApprovalScreen = true
+	})
+	defer httpServer.Close()
+
+	require.NoError(t, s.storage.CreateClient(storage.Client{
+		ID:           "portal",
+		Secret:       "secret",
+		RedirectURIs: []string{"https://app.example.com/callback", "https://app.example.com/other"},
+	}))
+	require.NoError(t, s.storage.CreateConnector(storage.Connector{
+		ID:              "saml",
+		Type:            "saml",
+		Name:            "SAML",
+		ResourceVersion: "1",
+	}))
+	s.connectors["saml"] = Connector{ResourceVersion: "1", Connector: idpInitiatedConnector{
+		login: connector.IDPInitiatedLogin{
+			ClientID:    "portal",
+			RedirectURI: "https://app.example.com/callback",
+			Scopes:      []string{"openid", "email"},
+		},
+	}}
+
+	// login posts a response to the callback URL and follows the redirect to
+	// the approval page.
+	login := func(connID string, form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/callback/"+connID, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		if rr.Code != http.StatusSeeOther {
+			return rr
+		}
+		approval := rr.Header().Get("Location")
+		rr = httptest.NewRecorder()
+		s.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, approval, nil))
+		return rr
+	}
+	redirect := func(rr *httptest.ResponseRecorder) *url.URL {
+		require.Equal(t, http.StatusSeeOther, rr.Code, rr.Body.String())
+		u, err := url.Parse(rr.Header().Get("Location"))
+		require.NoError(t, err)
+		return u
+	}
+
+	u := redirect(login("saml", url.Values{"SAMLResponse": {"assertion-1"}}))
+	require.Equal(t, "https://app.example.com/callback", u.Scheme+"://"+u.Host+u.Path)
+	require.NotEmpty(t, u.Query().Get("code"))
+
+	// Assertions can't be replayed.
+	rr := login("saml", url.Values{"SAMLResponse": {"assertion-1"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The RelayState may choose another redirect URI of the client.
+	u = redirect(login("saml", url.Values{"SAMLResponse": {"assertion-2"}, "RelayState": {"https://app.example.com/other"}}))
+	require.Equal(t, "https://app.example.com/other", u.Scheme+"://"+u.Host+u.Path)
+
+	rr = login("saml", url.Values{"SAMLResponse": {"assertion-3"}, "RelayState": {"https://evil.example.com/callback"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// Other connectors don't accept unsolicited responses.
+	rr = login("mock", url.Values{"SAMLResponse": {"assertion-4"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+const errUnsupportedTokenType = "unsupported_token_type"
+
+// handleRevocation revokes refresh tokens of the requesting client.
+//
+// Access tokens are JWTs dex doesn't keep track of, they can't be revoked and
+// stay valid until they expire.
+//
+// https://www.rfc-editor.org/rfc/rfc7009
+func (s *Server) handleRevocation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.tokenErrHelper(w, errInvalidRequest, "method not allowed", http.StatusBadRequest)
+		return
+	}
+	s.withClientFromStorage(w, r, s.revokeToken)
+}
+
+func (s *Server) revokeToken(w http.ResponseWriter, r *http.Request, client storage.Client) {
+	token := r.PostFormValue("token")
+	if token == "" {
+		s.tokenErrHelper(w, errInvalidRequest, "No token is found in request.", http.StatusBadRequest)
+		return
+	}
+
+	if refresh, ok := s.lookupRefreshToken(token); ok {
+		// Revoking a token of another client is refused without telling the
+		// client whether the token exists.
+		if refresh.ClientID == client.ID {
+			s.deleteRefreshToken(refresh)
+		} else {
+			s.logger.Errorf("client %s trying to revoke token for client %s", client.ID, refresh.ClientID)
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if _, err := s.verifyAccessToken(r.Context(), token); err == nil {
+		s.tokenErrHelper(w, errUnsupportedTokenType, "Access tokens can't be revoked.", http.StatusBadRequest)
+		return
+	}
+
+	// Invalid tokens don't need to be revoked.
+	w.WriteHeader(http.StatusOK)
+}
+
+// introspectionResponse describes a token to the client which presented it.
+//
+// https://www.rfc-editor.org/rfc/rfc7662#section-2.2
+type introspectionResponse struct {
+	Active    bool     `json:"active"`
+	Scope     string   `json:"scope,omitempty"`
+	ClientID  string   `json:"client_id,omitempty"`
+	Username  string   `json:"username,omitempty"`
+	TokenType string   `json:"token_type,omitempty"`
+	Expiry    int64    `json:"exp,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	Subject   string   `json:"sub,omitempty"`
+	Audience  audience `json:"aud,omitempty"`
+	Issuer    string   `json:"iss,omitempty"`
+}
+
+// handleIntrospection tells clients whether a token is active. Clients can only
+// introspect access tokens issued for them, as audience or authorized party,
+// and their own refresh tokens. Other tokens are inactive.
+//
+// https://www.rfc-editor.org/rfc/rfc7662
+func (s *Server) handleIntrospection(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.tokenErrHelper(w, errInvalidRequest, "method not allowed", http.StatusBadRequest)
+		return
+	}
+	s.withClientFromStorage(w, r, s.introspectToken)
+}
+
+func (s *Server) introspectToken(w http.ResponseWriter, r *http.Request, client storage.Client) {
+	token := r.PostFormValue("token")
+	if token == "" {
+		s.tokenErrHelper(w, errInvalidRequest, "No token is found in request.", http.StatusBadRequest)
+		return
+	}
+
+	var resp introspectionResponse
+	if refresh, ok := s.lookupRefreshToken(token); ok {
+		if refresh.ClientID == client.ID &&
+			!s.refreshTokenPolicy.CompletelyExpired(refresh.CreatedAt) &&
+			!s.refreshTokenPolicy.ExpiredBecauseUnused(refresh.LastUsed) {
+			resp = introspectionResponse{
+				Active:    true,
+				Scope:     strings.Join(refresh.Scopes, " "),
+				ClientID:  refresh.ClientID,
+				Username:  refresh.Claims.Username,
+				TokenType: "refresh_token",
+				IssuedAt:  refresh.CreatedAt.Unix(),
+				Issuer:    s.issuerURL.String(),
+			}
+		}
+	} else if idToken, err := s.verifyAccessToken(r.Context(), token); err == nil {
+		var claims struct {
+			AuthorizedParty string `json:"azp"`
+			Name            string `json:"name"`
+		}
+		if err := idToken.Claims(&claims); err != nil {
+			s.logger.Errorf("failed to decode access token claims: %v", err)
+			s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+			return
+		}
+		clientID := claims.AuthorizedParty
+		if clientID == "" && len(idToken.Audience) > 0 {
+			clientID = idToken.Audience[0]
+		}
+		if clientID == client.ID || contains(idToken.Audience, client.ID) {
+			resp = introspectionResponse{
+				Active:    true,
+				ClientID:  clientID,
+				Username:  claims.Name,
+				TokenType: "Bearer",
+				Expiry:    idToken.Expiry.Unix(),
+				IssuedAt:  idToken.IssuedAt.Unix(),
+				Subject:   idToken.Subject,
+				Audience:  idToken.Audience,
+				Issuer:    idToken.Issuer,
+			}
+		}
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		s.logger.Errorf("failed to marshal introspection response: %v", err)
+		s.tokenErrHelper(w, errServerError, "", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(data)
+}
+
+// lookupRefreshToken returns the stored refresh token the value belongs to, if
+// it's the current token of it.
+func (s *Server) lookupRefreshToken(value string) (storage.RefreshToken, bool) {
+	token := new(internal.RefreshToken)
+	if err := internal.Unmarshal(value, token); err != nil {
+		return storage.RefreshToken{}, false
+	}
+	refresh, err := s.storage.GetRefresh(token.RefreshId)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get refresh token: %v", err)
+		}
+		return storage.RefreshToken{}, false
+	}
+	if refresh.Token != token.Token {
+		return storage.RefreshToken{}, false
+	}
+	return refresh, true
+}
+
+// verifyAccessToken verifies a token dex signed, for any client.
+func (s *Server) verifyAccessToken(ctx context.Context, token string) (*oidc.IDToken, error) {
+	verifier := oidc.NewVerifier(s.issuerURL.String(), &storageKeySet{s.storage}, &oidc.Config{SkipClientIDCheck: true})
+	return verifier.Verify(ctx, token)
+}
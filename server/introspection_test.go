@@ -0,0 +1,96 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+func postTokenEndpoint(s *Server, path, clientID, secret string, data url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(data.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, secret)
+	rr := httptest.NewRecorder()
+	s.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestIntrospectionAndRevocation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	mockRefreshTokenTestStorage(t, s.storage, false)
+	require.NoError(t, s.storage.CreateClient(storage.Client{ID: "other", Secret: "secret"}))
+
+	refreshToken, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: "bar"})
+	require.NoError(t, err)
+	accessToken, err := s.newAccessToken("test", storage.Claims{UserID: "1", Username: "jane"}, []string{"openid", "profile"}, "", "test")
+	require.NoError(t, err)
+
+	type response struct {
+		Active    bool   `json:"active"`
+		Scope     string `json:"scope"`
+		ClientID  string `json:"client_id"`
+		Username  string `json:"username"`
+		TokenType string `json:"token_type"`
+	}
+	introspect := func(clientID, secret, token string) response {
+		rr := postTokenEndpoint(s, "/token/introspect", clientID, secret, url.Values{"token": {token}})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var resp response
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := introspect("test", "barfoo", accessToken)
+	require.True(t, resp.Active)
+	require.Equal(t, "test", resp.ClientID)
+	require.Equal(t, "jane", resp.Username)
+	require.Equal(t, "Bearer", resp.TokenType)
+
+	resp = introspect("test", "barfoo", refreshToken)
+	require.True(t, resp.Active)
+	require.Equal(t, "refresh_token", resp.TokenType)
+	require.Equal(t, "openid email profile", resp.Scope)
+
+	// Tokens of other clients and garbage are inactive.
+	require.False(t, introspect("other", "secret", accessToken).Active)
+	require.False(t, introspect("other", "secret", refreshToken).Active)
+	require.False(t, introspect("test", "barfoo", "garbage").Active)
+
+	rr := postTokenEndpoint(s, "/token/introspect", "test", "wrong", url.Values{"token": {accessToken}})
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// Access tokens can't be revoked.
+	rr = postTokenEndpoint(s, "/token/revoke", "test", "barfoo", url.Values{"token": {accessToken}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	expectJSONErrorResponse("revoke access token", rr.Body.Bytes(), errUnsupportedTokenType, t)
+
+	// Another client can't revoke the refresh token.
+	rr = postTokenEndpoint(s, "/token/revoke", "other", "secret", url.Values{"token": {refreshToken}})
+	require.Equal(t, http.StatusOK, rr.Code)
+	_, err = s.storage.GetRefresh("test")
+	require.NoError(t, err)
+
+	rr = postTokenEndpoint(s, "/token/revoke", "test", "barfoo", url.Values{"token": {refreshToken}, "token_type_hint": {"refresh_token"}})
+	require.Equal(t, http.StatusOK, rr.Code)
+	_, err = s.storage.GetRefresh("test")
+	require.Equal(t, storage.ErrNotFound, err)
+	require.False(t, introspect("test", "barfoo", refreshToken).Active)
+
+	// Revoking an unknown token succeeds.
+	rr = postTokenEndpoint(s, "/token/revoke", "test", "barfoo", url.Values{"token": {refreshToken}})
+	require.Equal(t, http.StatusOK, rr.Code)
+}
@@ -0,0 +1,280 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// LockoutConfig limits how many passwords can be guessed for a username, or from a
+// client address, before further logins are refused for a while.
+type LockoutConfig struct {
+	// Number of failed logins for a username, or from a client address, after which
+	// logins are locked. Zero disables the respective limit.
+	MaxUserFailures int
+	MaxIPFailures   int
+
+	// The first lockout lasts BaseDelay and doubles with every further failure,
+	// up to MaxDelay. Default to one minute and one hour.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// Failures are forgotten after this long without another one. Defaults to 24 hours.
+	ResetAfter time.Duration
+
+	// Header carrying the client address when dex runs behind a proxy, for example
+	// "X-Forwarded-For". The last address in the header is used. If empty, the
+	// address of the connection is used.
+	ClientIPHeader string
+}
+
+func (c LockoutConfig) enabled() bool {
+	return c.MaxUserFailures > 0 || c.MaxIPFailures > 0
+}
+
+const (
+	lockoutTypeUser = "user"
+	lockoutTypeIP   = "ip"
+	// Wrong TOTP codes of a user, and of an auth request.
+	lockoutTypeTOTP        = "totp"
+	lockoutTypeTOTPRequest = "totp-request"
+	// Password reset and verification emails sent to an address, and requested
+	// from a client address.
+	lockoutTypeEmail   = "email"
+	lockoutTypeEmailIP = "email-ip"
+)
+
+// Limits of the password reset and verification emails, within the time the
+// emailed links are valid. The limit of an address also caps the number of
+// valid links for it.
+const (
+	maxEmailsPerAddress = 3
+	maxEmailsPerIP      = 20
+)
+
+// errLoginLocked is shown to users while their logins are locked. It doesn't say
+// whether the username or the address is locked.
+const errLoginLocked = "Too many failed login attempts. Try again later."
+
+func loginAttemptsUserID(connID, username string) string {
+	return lockoutTypeUser + "|" + connID + "|" + strings.ToLower(username)
+}
+
+func loginAttemptsIPID(ip string) string {
+	return lockoutTypeIP + "|" + ip
+}
+
+// totpAttemptsUserID is keyed by the user ID, since not every connector
+// returns a username.
+func totpAttemptsUserID(connID, userID string) string {
+	return lockoutTypeTOTP + "|" + connID + "|" + userID
+}
+
+func totpAttemptsRequestID(authReqID string) string {
+	return lockoutTypeTOTPRequest + "|" + authReqID
+}
+
+func emailAttemptsAddressID(email string) string {
+	return lockoutTypeEmail + "|" + strings.ToLower(email)
+}
+
+func emailAttemptsIPID(ip string) string {
+	return lockoutTypeEmailIP + "|" + ip
+}
+
+// parseLoginAttemptsID returns what the login attempts with the ID were counted for.
+// The attempts of a single auth request aren't returned.
+func parseLoginAttemptsID(id string) (kind, connID, username, ip string) {
+	parts := strings.SplitN(id, "|", 3)
+	switch {
+	case len(parts) == 3 && (parts[0] == lockoutTypeUser || parts[0] == lockoutTypeTOTP):
+		return parts[0], parts[1], parts[2], ""
+	case len(parts) == 2 && parts[0] == lockoutTypeIP:
+		return lockoutTypeIP, "", "", parts[1]
+	}
+	return "", "", "", ""
+}
+
+// clientIP returns the address password logins are counted for.
+func (s *Server) clientIP(r *http.Request) string {
+	if h := s.lockout.ClientIPHeader; h != "" {
+		if v := r.Header.Get(h); v != "" {
+			addrs := strings.Split(v, ",")
+			return strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// lockoutDelay returns how long logins are locked after the given number of
+// failures past the limit.
+func (s *Server) lockoutDelay(excess int) time.Duration {
+	delay := s.lockout.BaseDelay
+	for i := 0; i < excess && delay < s.lockout.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > s.lockout.MaxDelay {
+		delay = s.lockout.MaxDelay
+	}
+	return delay
+}
+
+// loginLocked reports whether password logins for the username from the client
+// address are currently refused.
+func (s *Server) loginLocked(connID, username, ip string) bool {
+	if !s.lockout.enabled() {
+		return false
+	}
+	for _, id := range s.loginAttemptsIDs(connID, username, ip) {
+		if s.attemptsLocked(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// attemptsLocked reports whether the attempts with the ID are currently locked.
+func (s *Server) attemptsLocked(id string) bool {
+	a, err := s.storage.GetLoginAttempts(id)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get login attempts: %v", err)
+		}
+		return false
+	}
+	return s.now().Before(a.LockedUntil)
+}
+
+func (s *Server) loginAttemptsIDs(connID, username, ip string) []string {
+	var ids []string
+	if s.lockout.MaxUserFailures > 0 && username != "" {
+		ids = append(ids, loginAttemptsUserID(connID, username))
+	}
+	if s.lockout.MaxIPFailures > 0 && ip != "" {
+		ids = append(ids, loginAttemptsIPID(ip))
+	}
+	return ids
+}
+
+// recordLoginFailure counts a failed password login for the username and the
+// client address, locking either once it reaches its limit.
+func (s *Server) recordLoginFailure(connID, username, ip string) {
+	if !s.lockout.enabled() {
+		return
+	}
+	if s.lockout.MaxUserFailures > 0 && username != "" {
+		s.countLoginFailure(loginAttemptsUserID(connID, username), lockoutTypeUser, s.lockout.MaxUserFailures)
+	}
+	if s.lockout.MaxIPFailures > 0 && ip != "" {
+		s.countLoginFailure(loginAttemptsIPID(ip), lockoutTypeIP, s.lockout.MaxIPFailures)
+	}
+}
+
+// countLoginFailure counts a failure for the ID, and returns the number of
+// failures counted so far.
+func (s *Server) countLoginFailure(id, kind string, max int) int {
+	now := s.now()
+	var (
+		locked   bool
+		failures int
+	)
+	updater := func(a storage.LoginAttempts) (storage.LoginAttempts, error) {
+		if now.After(a.Expiry) {
+			a.Failures = 0
+			a.LockedUntil = time.Time{}
+		}
+		a.Failures++
+		a.LastFailure = now
+		a.Expiry = now.Add(s.lockout.ResetAfter)
+		failures = a.Failures
+
+		locked = a.Failures >= max
+		if locked {
+			a.LockedUntil = now.Add(s.lockoutDelay(a.Failures - max))
+			if a.LockedUntil.After(a.Expiry) {
+				a.Expiry = a.LockedUntil
+			}
+		}
+		return a, nil
+	}
+
+	err := s.storage.UpdateLoginAttempts(id, updater)
+	if err == storage.ErrNotFound {
+		var a storage.LoginAttempts
+		a, _ = updater(storage.LoginAttempts{ID: id})
+		err = s.storage.CreateLoginAttempts(a)
+		if err == storage.ErrAlreadyExists {
+			// Another request created it in the meantime.
+			err = s.storage.UpdateLoginAttempts(id, updater)
+		}
+	}
+	if err != nil {
+		s.logger.Errorf("failed to record failed login: %v", err)
+		return failures
+	}
+
+	if locked {
+		s.logger.Infof("logins for %q locked after %d failed attempts", id, failures)
+		s.loginLockoutCounter.WithLabelValues(kind).Inc()
+	}
+	return failures
+}
+
+// emailAllowed counts an email for the ID, and reports whether it may be sent.
+// At most max emails are allowed until the window has passed since the last
+// one. Refused emails aren't counted.
+func (s *Server) emailAllowed(id string, max int, window time.Duration) bool {
+	now := s.now()
+	allowed := true
+	updater := func(a storage.LoginAttempts) (storage.LoginAttempts, error) {
+		if now.After(a.Expiry) {
+			a.Failures = 0
+		}
+		if a.Failures >= max {
+			allowed = false
+			return a, nil
+		}
+		a.Failures++
+		a.LastFailure = now
+		a.Expiry = now.Add(window)
+		return a, nil
+	}
+
+	err := s.storage.UpdateLoginAttempts(id, updater)
+	if err == storage.ErrNotFound {
+		var a storage.LoginAttempts
+		a, _ = updater(storage.LoginAttempts{ID: id})
+		err = s.storage.CreateLoginAttempts(a)
+		if err == storage.ErrAlreadyExists {
+			err = s.storage.UpdateLoginAttempts(id, updater)
+		}
+	}
+	if err != nil {
+		// Sending is better than locking users out of their accounts while the
+		// storage has trouble.
+		s.logger.Errorf("failed to count email: %v", err)
+		return true
+	}
+	if !allowed {
+		s.logger.Infof("email for %q not sent, limit of %d reached", id, max)
+	}
+	return allowed
+}
+
+// recordLoginSuccess forgets the failed logins for the username. Failures from the
+// client address are kept, so a valid account can't be used to keep guessing others.
+func (s *Server) recordLoginSuccess(connID, username string) {
+	if s.lockout.MaxUserFailures <= 0 {
+		return
+	}
+	if err := s.storage.DeleteLoginAttempts(loginAttemptsUserID(connID, username)); err != nil && err != storage.ErrNotFound {
+		s.logger.Errorf("failed to reset failed logins: %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/memory"
+)
+
+func TestLockoutDelay(t *testing.T) {
+	s := &Server{lockout: LockoutConfig{BaseDelay: time.Minute, MaxDelay: 10 * time.Minute}}
+	for excess, want := range []time.Duration{
+		time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute,
+	} {
+		if got := s.lockoutDelay(excess); got != want {
+			t.Errorf("lockout delay after %d further failures: got %v, want %v", excess, got, want)
+		}
+	}
+}
+
+func TestEmailAllowed(t *testing.T) {
+	now := time.Now()
+	s := &Server{storage: memory.New(logger), logger: logger, now: func() time.Time { return now }}
+
+	id := emailAttemptsAddressID("Jane@example.com")
+	for i := 0; i < 3; i++ {
+		require.True(t, s.emailAllowed(id, 3, time.Hour), "email %d", i+1)
+	}
+	require.False(t, s.emailAllowed(id, 3, time.Hour))
+	require.True(t, s.emailAllowed(emailAttemptsAddressID("john@example.com"), 3, time.Hour))
+
+	// The window starts again after the last email sent, refused ones don't
+	// extend it.
+	now = now.Add(59 * time.Minute)
+	require.False(t, s.emailAllowed(id, 3, time.Hour))
+	now = now.Add(2 * time.Minute)
+	require.True(t, s.emailAllowed(id, 3, time.Hour))
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		header     string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{remoteAddr: "192.0.2.1:1234", want: "192.0.2.1"},
+		{remoteAddr: "192.0.2.1:1234", forwarded: "198.51.100.1", want: "192.0.2.1"},
+		{header: "X-Forwarded-For", remoteAddr: "192.0.2.1:1234", want: "192.0.2.1"},
+		{header: "X-Forwarded-For", remoteAddr: "192.0.2.1:1234", forwarded: "203.0.113.1, 198.51.100.1", want: "198.51.100.1"},
+	}
+	for _, tc := range tests {
+		s := &Server{lockout: LockoutConfig{ClientIPHeader: tc.header}}
+		r := httptest.NewRequest(http.MethodPost, "/token", nil)
+		r.RemoteAddr = tc.remoteAddr
+		if tc.forwarded != "" {
+			r.Header.Set("X-Forwarded-For", tc.forwarded)
+		}
+		if got := s.clientIP(r); got != tc.want {
+			t.Errorf("client IP with header %q and %q: got %q, want %q", tc.header, tc.forwarded, got, tc.want)
+		}
+	}
+}
+
+func TestPasswordGrantLockout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := time.Now()
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.PasswordConnector = "test"
+		c.Now = func() time.Time { return now }
+		c.Lockout = LockoutConfig{
+			MaxUserFailures: 3,
+			MaxIPFailures:   5,
+			BaseDelay:       time.Minute,
+		}
+	})
+	defer httpServer.Close()
+
+	mockConnectorDataTestStorage(t, s.storage)
+
+	login := func(username, password, ip string) int {
+		v := url.Values{}
+		v.Add("scope", "openid")
+		v.Add("grant_type", "password")
+		v.Add("username", username)
+		v.Add("password", password)
+
+		req := httptest.NewRequest(http.MethodPost, "/token", bytes.NewBufferString(v.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("test", "barfoo")
+		req.RemoteAddr = ip + ":1234"
+
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusUnauthorized, login("test", "wrong", "192.0.2.1"))
+	}
+	// Even the right password is refused while the username is locked.
+	require.Equal(t, http.StatusTooManyRequests, login("test", "test", "192.0.2.2"))
+	require.Equal(t, 1.0, testutil.ToFloat64(s.loginLockoutCounter.WithLabelValues(lockoutTypeUser)))
+
+	a, err := s.storage.GetLoginAttempts(loginAttemptsUserID("test", "test"))
+	require.NoError(t, err)
+	require.Equal(t, 3, a.Failures, "attempts while locked must not be counted")
+	require.True(t, a.LockedUntil.Equal(now.Add(time.Minute)))
+
+	// Another failure after the lockout doubles it.
+	now = now.Add(time.Minute + time.Second)
+	require.Equal(t, http.StatusUnauthorized, login("test", "wrong", "192.0.2.2"))
+	a, err = s.storage.GetLoginAttempts(loginAttemptsUserID("test", "test"))
+	require.NoError(t, err)
+	require.True(t, a.LockedUntil.Equal(now.Add(2*time.Minute)))
+
+	// A successful login resets the username's failures.
+	now = now.Add(2*time.Minute + time.Second)
+	require.Equal(t, http.StatusOK, login("test", "test", "192.0.2.2"))
+	_, err = s.storage.GetLoginAttempts(loginAttemptsUserID("test", "test"))
+	require.Equal(t, storage.ErrNotFound, err)
+
+	// Guessing other usernames from one address locks the address.
+	for _, username := range []string{"a", "b", "c", "d", "e"} {
+		require.Equal(t, http.StatusUnauthorized, login(username, "wrong", "192.0.2.4"))
+	}
+	require.Equal(t, http.StatusTooManyRequests, login("test", "test", "192.0.2.4"))
+	require.Equal(t, 1.0, testutil.ToFloat64(s.loginLockoutCounter.WithLabelValues(lockoutTypeIP)))
+	require.Equal(t, http.StatusOK, login("test", "test", "192.0.2.3"))
+}
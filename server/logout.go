@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+// backchannelLogoutEvent identifies logout tokens as defined by OpenID Connect
+// Back-Channel Logout 1.0.
+//
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#LogoutToken
+const backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+type logoutTokenClaims struct {
+	Issuer   string                 `json:"iss"`
+	Subject  string                 `json:"sub"`
+	Audience audience               `json:"aud"`
+	IssuedAt int64                  `json:"iat"`
+	Expiry   int64                  `json:"exp"`
+	ID       string                 `json:"jti"`
+	Events   map[string]interface{} `json:"events"`
+}
+
+// newLogoutToken returns a signed logout token telling the client the user's session ended.
+func (s *Server) newLogoutToken(client storage.Client, userID, connID string) (string, error) {
+	keys, err := s.storage.GetKeys()
+	if err != nil {
+		return "", fmt.Errorf("get keys: %v", err)
+	}
+	if keys.SigningKey == nil {
+		return "", fmt.Errorf("no key to sign payload with")
+	}
+	signingAlg, err := signatureAlgorithm(keys.SigningKey)
+	if err != nil {
+		return "", err
+	}
+
+	subject, err := internal.Marshal(&internal.IDTokenSubject{UserId: userID, ConnId: connID})
+	if err != nil {
+		return "", fmt.Errorf("marshal subject: %v", err)
+	}
+	if subject, err = s.subjectForClient(client, subject); err != nil {
+		return "", fmt.Errorf("derive subject: %v", err)
+	}
+
+	issuedAt := s.now()
+	tok := logoutTokenClaims{
+		Issuer:   s.issuerURL.String(),
+		Subject:  subject,
+		Audience: audience{client.ID},
+		IssuedAt: issuedAt.Unix(),
+		Expiry:   issuedAt.Add(s.idTokensValidFor).Unix(),
+		ID:       storage.NewID(),
+		Events:   map[string]interface{}{backchannelLogoutEvent: struct{}{}},
+	}
+	payload, err := json.Marshal(tok)
+	if err != nil {
+		return "", fmt.Errorf("marshal logout token: %v", err)
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Key: keys.SigningKey, Algorithm: signingAlg},
+		(&jose.SignerOptions{}).WithType("logout+jwt"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("new signer: %v", err)
+	}
+	signature, err := signer.Sign(payload)
+	if err != nil {
+		return "", fmt.Errorf("signing payload: %v", err)
+	}
+	return signature.CompactSerialize()
+}
+
+// sendBackchannelLogout posts a logout token to the client's back-channel logout URI.
+func (s *Server) sendBackchannelLogout(ctx context.Context, client storage.Client, userID, connID string) error {
+	logoutToken, err := s.newLogoutToken(client, userID, connID)
+	if err != nil {
+		return err
+	}
+
+	v := url.Values{"logout_token": {logoutToken}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.BackchannelLogoutURI, strings.NewReader(v.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Cache-Control", "no-store")
+
+	resp, err := s.backchannelClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
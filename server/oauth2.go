@@ -51,29 +51,45 @@ type redirectedAuthErr struct {
 	RedirectURI string
 	Type        string
 	Description string
+
+	// The error is returned like a successful response would be, so it's signed
+	// for the client if a JWT response mode was requested.
+	ClientID         string
+	ResponseMode     string
+	ImplicitOrHybrid bool
 }
 
 func (err *redirectedAuthErr) Error() string {
 	return err.Description
 }
 
-func (err *redirectedAuthErr) Handler() http.Handler {
-	hf := func(w http.ResponseWriter, r *http.Request) {
-		v := url.Values{}
-		v.Add("state", err.State)
-		v.Add("error", err.Type)
-		if err.Description != "" {
-			v.Add("error_description", err.Description)
-		}
-		var redirectURI string
-		if strings.Contains(err.RedirectURI, "?") {
-			redirectURI = err.RedirectURI + "&" + v.Encode()
-		} else {
-			redirectURI = err.RedirectURI + "?" + v.Encode()
+// sendAuthError returns the error to the client using the response mode of the
+// request.
+func (s *Server) sendAuthError(w http.ResponseWriter, r *http.Request, authErr *redirectedAuthErr) {
+	u, err := url.Parse(authErr.RedirectURI)
+	if err != nil {
+		s.renderError(r, w, http.StatusInternalServerError, "Invalid redirect URI.")
+		return
+	}
+	v := url.Values{}
+	v.Add("state", authErr.State)
+	v.Add("error", authErr.Type)
+	if authErr.Description != "" {
+		v.Add("error_description", authErr.Description)
+	}
+	authReq := storage.AuthRequest{ClientID: authErr.ClientID, ResponseMode: authErr.ResponseMode}
+	s.sendAuthResponse(w, r, authReq, u, v, authErr.ImplicitOrHybrid)
+}
+
+// implicitOrHybridFlow reports whether the response types return tokens from the
+// authorization endpoint, instead of only a code.
+func implicitOrHybridFlow(responseTypes []string) bool {
+	for _, responseType := range responseTypes {
+		if responseType == responseTypeIDToken || responseType == responseTypeToken {
+			return true
 		}
-		http.Redirect(w, r, redirectURI, http.StatusSeeOther)
 	}
-	return http.HandlerFunc(hf)
+	return false
 }
 
 func tokenErr(w http.ResponseWriter, typ, description string, statusCode int) error {
@@ -118,7 +134,8 @@ const (
 )
 
 const (
-	deviceCallbackURI = "/device/callback"
+	deviceCallbackURI      = "/device/callback"
+	backchannelCallbackURI = "/bc-authorize/callback"
 )
 
 const (
@@ -131,6 +148,7 @@ const (
 	grantTypeImplicit          = "implicit"
 	grantTypePassword          = "password"
 	grantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
+	grantTypeCIBA              = "urn:openid:params:grant-type:ciba"
 )
 
 const (
@@ -139,11 +157,42 @@ const (
 	responseTypeIDToken = "id_token" // ID Token in url fragment
 )
 
+const (
+	responseModeQuery    = "query"
+	responseModeFragment = "fragment"
+	responseModeFormPost = "form_post"
+
+	// JWT Secured Authorization Response Modes (JARM). "jwt" uses the default
+	// mode of the response type.
+	//
+	// https://openid.net/specs/oauth-v2-jarm.html#name-response-mode-jwt
+	responseModeJWT         = "jwt"
+	responseModeQueryJWT    = "query.jwt"
+	responseModeFragmentJWT = "fragment.jwt"
+	responseModeFormPostJWT = "form_post.jwt"
+)
+
+// responseModes lists the supported "response_mode" values in the order they
+// are advertised by the discovery document.
+var responseModes = []string{
+	responseModeQuery,
+	responseModeFragment,
+	responseModeFormPost,
+	responseModeJWT,
+	responseModeQueryJWT,
+	responseModeFragmentJWT,
+	responseModeFormPostJWT,
+}
+
+// JARM responses are consumed immediately by the client, keep them short lived.
+const responseJWTValidFor = 10 * time.Minute
+
 const (
 	deviceTokenPending  = "authorization_pending"
 	deviceTokenComplete = "complete"
 	deviceTokenSlowDown = "slow_down"
 	deviceTokenExpired  = "expired_token"
+	deviceTokenDenied   = "access_denied"
 )
 
 func parseScopes(scopes []string) connector.Scopes {
@@ -207,6 +256,88 @@ func signPayload(key *jose.JSONWebKey, alg jose.SignatureAlgorithm, payload []by
 	return signature.CompactSerialize()
 }
 
+// Key management and content encryption algorithms clients can register to have ID
+// tokens and userinfo responses encrypted to them.
+var (
+	encryptionAlgs = []string{
+		string(jose.RSA_OAEP),
+		string(jose.RSA_OAEP_256),
+		string(jose.ECDH_ES),
+		string(jose.ECDH_ES_A128KW),
+		string(jose.ECDH_ES_A256KW),
+	}
+	encryptionEncs = []string{
+		string(jose.A128CBC_HS256),
+		string(jose.A256CBC_HS512),
+		string(jose.A128GCM),
+		string(jose.A256GCM),
+	}
+)
+
+// Used when a client registers an encryption algorithm without a content encryption.
+// https://openid.net/specs/openid-connect-registration-1_0.html#ClientMetadata
+const defaultEncryptionEnc = jose.A128CBC_HS256
+
+// encryptionKey picks a key from a client's JWKS that can be used with the key
+// management algorithm.
+func encryptionKey(jwks *jose.JSONWebKeySet, alg jose.KeyAlgorithm) (*jose.JSONWebKey, error) {
+	if jwks == nil {
+		return nil, errors.New("client has no jwks")
+	}
+	for i, key := range jwks.Keys {
+		if (key.Use != "" && key.Use != "enc") || (key.Algorithm != "" && key.Algorithm != string(alg)) {
+			continue
+		}
+		switch key.Key.(type) {
+		case *rsa.PublicKey:
+			if alg == jose.RSA_OAEP || alg == jose.RSA_OAEP_256 {
+				return &jwks.Keys[i], nil
+			}
+		case *ecdsa.PublicKey:
+			if alg == jose.ECDH_ES || alg == jose.ECDH_ES_A128KW || alg == jose.ECDH_ES_A256KW {
+				return &jwks.Keys[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no key in client jwks can be used with %s", alg)
+}
+
+// encryptPayload encrypts the payload to the client's JWKS. Signed JWTs must be
+// passed with the content type "JWT" to produce a nested JWT.
+func encryptPayload(jwks *jose.JSONWebKeySet, alg, enc, contentType string, payload []byte) (jwe string, err error) {
+	if !contains(encryptionAlgs, alg) {
+		return "", fmt.Errorf("unsupported encryption algorithm %q", alg)
+	}
+	if enc == "" {
+		enc = string(defaultEncryptionEnc)
+	} else if !contains(encryptionEncs, enc) {
+		return "", fmt.Errorf("unsupported content encryption %q", enc)
+	}
+
+	key, err := encryptionKey(jwks, jose.KeyAlgorithm(alg))
+	if err != nil {
+		return "", err
+	}
+
+	opts := &jose.EncrypterOptions{}
+	if contentType != "" {
+		opts = opts.WithContentType(jose.ContentType(contentType))
+	}
+	encrypter, err := jose.NewEncrypter(
+		jose.ContentEncryption(enc),
+		jose.Recipient{Algorithm: jose.KeyAlgorithm(alg), Key: key.Key, KeyID: key.KeyID},
+		opts,
+	)
+	if err != nil {
+		return "", fmt.Errorf("new encrypter: %v", err)
+	}
+	obj, err := encrypter.Encrypt(payload)
+	if err != nil {
+		return "", fmt.Errorf("encrypting payload: %v", err)
+	}
+	return obj.CompactSerialize()
+}
+
 // The hash algorithm for the at_hash is determined by the signing
 // algorithm used for the id_token. From the spec:
 //
@@ -276,6 +407,8 @@ type idTokenClaims struct {
 
 	Groups []string `json:"groups,omitempty"`
 
+	ACR string `json:"acr,omitempty"`
+
 	Name              string `json:"name,omitempty"`
 	PreferredUsername string `json:"preferred_username,omitempty"`
 
@@ -288,11 +421,52 @@ type federatedIDClaims struct {
 }
 
 func (s *Server) newAccessToken(clientID string, claims storage.Claims, scopes []string, nonce, connID string) (accessToken string, err error) {
-	idToken, _, err := s.newIDToken(clientID, claims, scopes, nonce, storage.NewID(), "", connID)
+	client, err := s.tokenClient(clientID)
+	if err != nil {
+		return "", err
+	}
+	idToken, _, err := s.signIDToken(client, claims, scopes, nonce, storage.NewID(), "", connID)
 	return idToken, err
 }
 
+// tokenClient looks up the client tokens are issued to. Tokens may be minted for
+// clients which aren't in storage, such as cross-client audiences, in which case
+// the client's defaults are used.
+func (s *Server) tokenClient(clientID string) (storage.Client, error) {
+	client, err := s.storage.GetClient(clientID)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("failed to get client: %v", err)
+			return storage.Client{}, err
+		}
+		client = storage.Client{ID: clientID}
+	}
+	return client, nil
+}
+
+// newIDToken returns an ID token for the client, encrypted if the client asked for it.
 func (s *Server) newIDToken(clientID string, claims storage.Claims, scopes []string, nonce, accessToken, code, connID string) (idToken string, expiry time.Time, err error) {
+	client, err := s.tokenClient(clientID)
+	if err != nil {
+		return "", expiry, err
+	}
+	idToken, expiry, err = s.signIDToken(client, claims, scopes, nonce, accessToken, code, connID)
+	if err != nil {
+		return "", expiry, err
+	}
+	if client.IDTokenEncryptedResponseAlg == "" {
+		return idToken, expiry, nil
+	}
+
+	idToken, err = encryptPayload(client.JWKS, client.IDTokenEncryptedResponseAlg, client.IDTokenEncryptedResponseEnc, "JWT", []byte(idToken))
+	if err != nil {
+		return "", expiry, fmt.Errorf("failed to encrypt id token: %v", err)
+	}
+	return idToken, expiry, nil
+}
+
+func (s *Server) signIDToken(client storage.Client, claims storage.Claims, scopes []string, nonce, accessToken, code, connID string) (idToken string, expiry time.Time, err error) {
+	clientID := client.ID
 	keys, err := s.storage.GetKeys()
 	if err != nil {
 		s.logger.Errorf("Failed to get keys: %v", err)
@@ -321,6 +495,10 @@ func (s *Server) newIDToken(clientID string, claims storage.Claims, scopes []str
 		s.logger.Errorf("failed to marshal offline session ID: %v", err)
 		return "", expiry, fmt.Errorf("failed to marshal offline session ID: %v", err)
 	}
+	if subjectString, err = s.subjectForClient(client, subjectString); err != nil {
+		s.logger.Errorf("failed to derive subject for client %q: %v", client.ID, err)
+		return "", expiry, fmt.Errorf("failed to derive subject: %v", err)
+	}
 
 	tok := idTokenClaims{
 		Issuer:   s.issuerURL.String(),
@@ -328,6 +506,7 @@ func (s *Server) newIDToken(clientID string, claims storage.Claims, scopes []str
 		Nonce:    nonce,
 		Expiry:   expiry.Unix(),
 		IssuedAt: issuedAt.Unix(),
+		ACR:      claims.ACR,
 	}
 
 	if accessToken != "" {
@@ -359,6 +538,11 @@ func (s *Server) newIDToken(clientID string, claims storage.Claims, scopes []str
 			tok.Name = claims.Username
 			tok.PreferredUsername = claims.PreferredUsername
 		case scope == scopeFederatedID:
+			// The upstream user ID would let clients correlate users, which is
+			// what pairwise subjects prevent.
+			if client.SubjectType == subjectTypePairwise {
+				continue
+			}
 			tok.FederatedIDClaims = &federatedIDClaims{
 				ConnectorID: connID,
 				UserID:      claims.UserID,
@@ -408,6 +592,40 @@ func (s *Server) newIDToken(clientID string, claims storage.Claims, scopes []str
 	return idToken, expiry, nil
 }
 
+// newResponseJWT wraps the parameters of an authorization response in a JWT
+// signed with the current signing key.
+func (s *Server) newResponseJWT(clientID string, v url.Values) (string, error) {
+	keys, err := s.storage.GetKeys()
+	if err != nil {
+		s.logger.Errorf("Failed to get keys: %v", err)
+		return "", err
+	}
+
+	signingKey := keys.SigningKey
+	if signingKey == nil {
+		return "", fmt.Errorf("no key to sign payload with")
+	}
+	signingAlg, err := signatureAlgorithm(signingKey)
+	if err != nil {
+		return "", err
+	}
+
+	claims := map[string]interface{}{
+		"iss": s.issuerURL.String(),
+		"aud": clientID,
+		"exp": s.now().Add(responseJWTValidFor).Unix(),
+	}
+	for key := range v {
+		claims[key] = v.Get(key)
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("could not serialize claims: %v", err)
+	}
+	return signPayload(signingKey, signingAlg, payload)
+}
+
 // parse the initial request from the OAuth2 client.
 func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthRequest, error) {
 	if err := r.ParseForm(); err != nil {
@@ -426,6 +644,7 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 	// Some clients, like the old go-oidc, provide extra whitespace. Tolerate this.
 	scopes := strings.Fields(q.Get("scope"))
 	responseTypes := strings.Fields(q.Get("response_type"))
+	responseMode := q.Get("response_mode")
 
 	codeChallenge := q.Get("code_challenge")
 	codeChallengeMethod := q.Get("code_challenge_method")
@@ -443,7 +662,11 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 		return nil, newDisplayedErr(http.StatusInternalServerError, "Database error.")
 	}
 
-	if !validateRedirectURI(client, redirectURI) {
+	// The backchannel callback belongs to dex itself and is valid for any client
+	// once backchannel authentication is enabled.
+	if redirectURI == backchannelCallbackURI && s.backchannelNotifier != nil {
+		redirectURI = s.issuerURL.Path + backchannelCallbackURI
+	} else if !validateRedirectURI(client, redirectURI) {
 		return nil, newDisplayedErr(http.StatusBadRequest, "Unregistered redirect_uri (%q).", redirectURI)
 	}
 	if redirectURI == deviceCallbackURI && client.Public {
@@ -452,7 +675,21 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 
 	// From here on out, we want to redirect back to the client with an error.
 	newRedirectedErr := func(typ, format string, a ...interface{}) *redirectedAuthErr {
-		return &redirectedAuthErr{state, redirectURI, typ, fmt.Sprintf(format, a...)}
+		err := &redirectedAuthErr{
+			State:            state,
+			RedirectURI:      redirectURI,
+			Type:             typ,
+			Description:      fmt.Sprintf(format, a...),
+			ClientID:         client.ID,
+			ImplicitOrHybrid: implicitOrHybridFlow(responseTypes),
+		}
+		// An unsupported response mode, or one unsafe for the response type,
+		// falls back to the default of the response type.
+		if contains(responseModes, responseMode) &&
+			!(err.ImplicitOrHybrid && (responseMode == responseModeQuery || responseMode == responseModeQueryJWT)) {
+			err.ResponseMode = responseMode
+		}
+		return err
 	}
 
 	if connectorID != "" {
@@ -562,12 +799,35 @@ func (s *Server) parseAuthorizationRequest(r *http.Request) (*storage.AuthReques
 		}
 	}
 
+	if responseMode != "" {
+		if !contains(responseModes, responseMode) {
+			return nil, newRedirectedErr(errInvalidRequest, "Unsupported response mode %q", responseMode)
+		}
+		// Tokens must never be passed in the query string.
+		//
+		// https://openid.net/specs/oauth-v2-multiple-response-types-1_0.html#Security
+		if (rt.token || rt.idToken) && (responseMode == responseModeQuery || responseMode == responseModeQueryJWT) {
+			return nil, newRedirectedErr(errInvalidRequest, "Response mode %q can't be used with response type %q", responseMode, strings.Join(responseTypes, " "))
+		}
+	}
+
+	// "prompt=consent" asks the server to show the approval screen even if the
+	// user already approved the requested scopes.
+	forceApprovalPrompt := q.Get("approval_prompt") == "force"
+	for _, prompt := range strings.Fields(q.Get("prompt")) {
+		if prompt == "consent" {
+			forceApprovalPrompt = true
+		}
+	}
+
 	return &storage.AuthRequest{
 		ID:                  storage.NewID(),
 		ClientID:            client.ID,
 		State:               state,
 		Nonce:               nonce,
-		ForceApprovalPrompt: q.Get("approval_prompt") == "force",
+		ResponseMode:        responseMode,
+		ACRValues:           strings.Fields(q.Get("acr_values")),
+		ForceApprovalPrompt: forceApprovalPrompt,
 		Scopes:              scopes,
 		RedirectURI:         redirectURI,
 		ResponseTypes:       responseTypes,
@@ -685,3 +945,49 @@ func (s *storageKeySet) VerifySignature(_ context.Context, jwt string) (payload
 
 	return nil, errors.New("failed to verify id token signature")
 }
+
+// newUserInfoResponse signs and/or encrypts the userinfo claims as registered by the client.
+func (s *Server) newUserInfoResponse(client storage.Client, claims []byte) (string, error) {
+	payload, contentType := claims, ""
+
+	if client.UserInfoSignedResponseAlg != "" {
+		keys, err := s.storage.GetKeys()
+		if err != nil {
+			s.logger.Errorf("Failed to get keys: %v", err)
+			return "", err
+		}
+
+		signingKey := keys.SigningKey
+		if signingKey == nil {
+			return "", fmt.Errorf("no key to sign payload with")
+		}
+		signingAlg, err := signatureAlgorithm(signingKey)
+		if err != nil {
+			return "", err
+		}
+		if client.UserInfoSignedResponseAlg != string(signingAlg) {
+			return "", fmt.Errorf("unsupported userinfo signing algorithm %q", client.UserInfoSignedResponseAlg)
+		}
+
+		// Signed responses must be audienced to the client.
+		var c map[string]interface{}
+		if err := json.Unmarshal(claims, &c); err != nil {
+			return "", fmt.Errorf("could not parse claims: %v", err)
+		}
+		c["aud"] = client.ID
+		if payload, err = json.Marshal(c); err != nil {
+			return "", fmt.Errorf("could not serialize claims: %v", err)
+		}
+
+		jws, err := signPayload(signingKey, signingAlg, payload)
+		if err != nil {
+			return "", fmt.Errorf("failed to sign payload: %v", err)
+		}
+		payload, contentType = []byte(jws), "JWT"
+	}
+
+	if client.UserInfoEncryptedResponseAlg == "" {
+		return string(payload), nil
+	}
+	return encryptPayload(client.JWKS, client.UserInfoEncryptedResponseAlg, client.UserInfoEncryptedResponseEnc, contentType, payload)
+}
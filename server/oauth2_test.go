@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"net/http"
@@ -287,6 +289,60 @@ func TestParseAuthorizationRequest(t *testing.T) {
 			},
 			expectedError: &redirectedAuthErr{Type: errInvalidRequest},
 		},
+		{
+			name: "form_post response mode",
+			clients: []storage.Client{
+				{
+					ID:           "bar",
+					RedirectURIs: []string{"https://example.com/bar"},
+				},
+			},
+			supportedResponseTypes: []string{"code"},
+			queryParams: map[string]string{
+				"client_id":     "bar",
+				"redirect_uri":  "https://example.com/bar",
+				"response_type": "code",
+				"response_mode": "form_post.jwt",
+				"scope":         "openid email profile",
+			},
+		},
+		{
+			name: "Unsupported response mode",
+			clients: []storage.Client{
+				{
+					ID:           "bar",
+					RedirectURIs: []string{"https://example.com/bar"},
+				},
+			},
+			supportedResponseTypes: []string{"code"},
+			queryParams: map[string]string{
+				"client_id":     "bar",
+				"redirect_uri":  "https://example.com/bar",
+				"response_type": "code",
+				"response_mode": "web_message",
+				"scope":         "openid email profile",
+			},
+			expectedError: &redirectedAuthErr{Type: errInvalidRequest},
+		},
+		{
+			name: "Query response mode with implicit flow",
+			clients: []storage.Client{
+				{
+					ID:           "bar",
+					RedirectURIs: []string{"https://example.com/bar"},
+				},
+			},
+			supportedResponseTypes: []string{"id_token"},
+			queryParams: map[string]string{
+				"client_id":     "bar",
+				"redirect_uri":  "https://example.com/bar",
+				"response_type": "id_token",
+				"response_mode": "query",
+				"nonce":         "abc",
+				"scope":         "openid email profile",
+			},
+			expectedError: &redirectedAuthErr{Type: errInvalidRequest},
+		},
 	}
 
 	for _, tc := range tests {
@@ -627,3 +683,156 @@ func TestStorageKeySet(t *testing.T) {
 		})
 	}
 }
+
+func TestEncryptPayload(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rsaJWK := jose.JSONWebKey{Key: testKey.Public(), KeyID: "rsa", Use: "enc"}
+	ecJWK := jose.JSONWebKey{Key: ecKey.Public(), KeyID: "ec"}
+	sigJWK := jose.JSONWebKey{Key: testKey.Public(), KeyID: "sig", Use: "sig"}
+
+	tests := []struct {
+		name    string
+		jwks    *jose.JSONWebKeySet
+		alg     string
+		enc     string
+		wantKID string
+		wantEnc string
+		wantErr bool
+	}{
+		{
+			name:    "rsa with default content encryption",
+			jwks:    &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{ecJWK, rsaJWK}},
+			alg:     "RSA-OAEP",
+			wantKID: "rsa",
+			wantEnc: "A128CBC-HS256",
+		},
+		{
+			name:    "ecdh",
+			jwks:    &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{rsaJWK, ecJWK}},
+			alg:     "ECDH-ES+A128KW",
+			enc:     "A256GCM",
+			wantKID: "ec",
+			wantEnc: "A256GCM",
+		},
+		{
+			name:    "signing keys are skipped",
+			jwks:    &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{sigJWK}},
+			alg:     "RSA-OAEP-256",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported algorithm",
+			jwks:    &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{rsaJWK}},
+			alg:     "RSA1_5",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported content encryption",
+			jwks:    &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{rsaJWK}},
+			alg:     "RSA-OAEP",
+			enc:     "A192GCM",
+			wantErr: true,
+		},
+		{
+			name:    "no jwks",
+			alg:     "RSA-OAEP",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			jwe, err := encryptPayload(tc.jwks, tc.alg, tc.enc, "", []byte("payload"))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("encrypt payload: %v", err)
+			}
+
+			obj, err := jose.ParseEncrypted(jwe)
+			if err != nil {
+				t.Fatalf("parse jwe: %v", err)
+			}
+			if obj.Header.KeyID != tc.wantKID {
+				t.Errorf("expected key %q, got %q", tc.wantKID, obj.Header.KeyID)
+			}
+			if enc := obj.Header.ExtraHeaders["enc"]; enc != tc.wantEnc {
+				t.Errorf("expected content encryption %q, got %v", tc.wantEnc, enc)
+			}
+
+			var privateKey interface{} = testKey
+			if tc.wantKID == "ec" {
+				privateKey = ecKey
+			}
+			payload, err := obj.Decrypt(privateKey)
+			if err != nil {
+				t.Fatalf("decrypt: %v", err)
+			}
+			if string(payload) != "payload" {
+				t.Errorf("unexpected payload %q", payload)
+			}
+		})
+	}
+}
+
+func TestNewIDTokenEncrypted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	client := storage.Client{
+		ID:                          "testclient",
+		IDTokenEncryptedResponseAlg: "RSA-OAEP-256",
+		IDTokenEncryptedResponseEnc: "A256GCM",
+		JWKS: &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: testKey.Public(), KeyID: "client", Use: "enc"},
+		}},
+	}
+	if err := s.storage.CreateClient(client); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	claims := storage.Claims{UserID: "1", Email: "jane@example.com"}
+	idToken, _, err := s.newIDToken(client.ID, claims, []string{"openid", "email"}, "", "", "", "mock")
+	if err != nil {
+		t.Fatalf("failed to create id token: %v", err)
+	}
+
+	obj, err := jose.ParseEncrypted(idToken)
+	if err != nil {
+		t.Fatalf("id token is not a JWE: %v", err)
+	}
+	if cty := obj.Header.ExtraHeaders[jose.HeaderContentType]; cty != "JWT" {
+		t.Errorf("expected a nested JWT, got content type %v", cty)
+	}
+	nested, err := obj.Decrypt(testKey)
+	if err != nil {
+		t.Fatalf("failed to decrypt id token: %v", err)
+	}
+	jws, err := jose.ParseSigned(string(nested))
+	if err != nil {
+		t.Fatalf("failed to parse nested jwt: %v", err)
+	}
+	if _, err := jws.Verify(testKey.Public()); err != nil {
+		t.Errorf("failed to verify nested jwt: %v", err)
+	}
+
+	// Access tokens are consumed by dex itself and are never encrypted.
+	accessToken, err := s.newAccessToken(client.ID, claims, []string{"openid"}, "", "mock")
+	if err != nil {
+		t.Fatalf("failed to create access token: %v", err)
+	}
+	if _, err := jose.ParseSigned(accessToken); err != nil {
+		t.Errorf("expected a signed access token: %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/dexidp/dex/storage"
+)
+
+const (
+	subjectTypePublic   = "public"
+	subjectTypePairwise = "pairwise"
+)
+
+// Pairwise subjects are the public subject encrypted with a key derived from the
+// configured salt and the client's sector. The nonce is derived from the subject
+// itself, so the result is stable for a user within a sector but unrelated across
+// sectors, and the server can still map it back to the user.
+func pairwiseAEAD(salt, sector string) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte("key|" + sector))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// pairwiseSubject derives the subject identifying a user to the clients of a sector.
+func pairwiseSubject(salt, sector, subject string) (string, error) {
+	aead, err := pairwiseAEAD(salt, sector)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte("nonce|" + sector + "|" + subject))
+	nonce := mac.Sum(nil)[:aead.NonceSize()]
+
+	sealed := aead.Seal(nonce, nonce, []byte(subject), []byte(sector))
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// parsePairwiseSubject returns the public subject a pairwise subject was derived from.
+// It fails if the pairwise subject was not issued for the sector.
+func parsePairwiseSubject(salt, sector, pairwise string) (string, error) {
+	sealed, err := base64.RawURLEncoding.DecodeString(pairwise)
+	if err != nil {
+		return "", err
+	}
+	aead, err := pairwiseAEAD(salt, sector)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("pairwise subject too short")
+	}
+	subject, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(sector))
+	if err != nil {
+		return "", err
+	}
+	return string(subject), nil
+}
+
+// sectorIdentifier returns the sector a pairwise client belongs to. Unless configured
+// explicitly it's the host shared by the client's redirect URIs.
+func sectorIdentifier(client storage.Client) (string, error) {
+	if client.SectorIdentifier != "" {
+		return client.SectorIdentifier, nil
+	}
+	if len(client.RedirectURIs) == 0 {
+		return client.ID, nil
+	}
+
+	var sector string
+	for _, redirectURI := range client.RedirectURIs {
+		u, err := url.Parse(redirectURI)
+		if err != nil {
+			return "", fmt.Errorf("invalid redirect URI %q: %v", redirectURI, err)
+		}
+		if sector != "" && u.Host != sector {
+			return "", fmt.Errorf("client %q has redirect URIs on multiple hosts and needs a sector identifier", client.ID)
+		}
+		sector = u.Host
+	}
+	return sector, nil
+}
+
+// subjectForClient returns the subject a client sees for the public subject.
+func (s *Server) subjectForClient(client storage.Client, subject string) (string, error) {
+	if client.SubjectType != subjectTypePairwise {
+		return subject, nil
+	}
+	if s.pairwiseSubjectSalt == "" {
+		return "", errors.New("pairwise subjects require a salt to be configured")
+	}
+	sector, err := sectorIdentifier(client)
+	if err != nil {
+		return "", err
+	}
+	return pairwiseSubject(s.pairwiseSubjectSalt, sector, subject)
+}
+
+// resolvePairwiseSubject maps a pairwise subject issued to any of the pairwise clients
+// back to the public subject. ok is false if the subject isn't a pairwise subject.
+func resolvePairwiseSubject(s storage.Storage, salt, pairwise string) (subject string, ok bool, err error) {
+	if salt == "" {
+		return "", false, nil
+	}
+	clients, err := s.ListClients()
+	if err != nil {
+		return "", false, fmt.Errorf("list clients: %v", err)
+	}
+
+	tried := make(map[string]bool)
+	for _, client := range clients {
+		if client.SubjectType != subjectTypePairwise {
+			continue
+		}
+		sector, err := sectorIdentifier(client)
+		if err != nil || tried[sector] {
+			continue
+		}
+		tried[sector] = true
+
+		if subject, err := parsePairwiseSubject(salt, sector, pairwise); err == nil {
+			return subject, true, nil
+		}
+	}
+	return "", false, nil
+}
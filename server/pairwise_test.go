@@ -0,0 +1,326 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/dexidp/dex/api/v2"
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/memory"
+)
+
+func TestPairwiseSubject(t *testing.T) {
+	subject, err := internal.Marshal(&internal.IDTokenSubject{UserId: "1", ConnId: "mock"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a1, err := pairwiseSubject("salt", "a.example.com", subject)
+	if err != nil {
+		t.Fatalf("failed to derive pairwise subject: %v", err)
+	}
+	a2, err := pairwiseSubject("salt", "a.example.com", subject)
+	if err != nil {
+		t.Fatalf("failed to derive pairwise subject: %v", err)
+	}
+	if a1 != a2 {
+		t.Errorf("expected pairwise subjects to be stable, got %q and %q", a1, a2)
+	}
+	if a1 == subject {
+		t.Errorf("expected pairwise subject to differ from public subject")
+	}
+
+	b, err := pairwiseSubject("salt", "b.example.com", subject)
+	if err != nil {
+		t.Fatalf("failed to derive pairwise subject: %v", err)
+	}
+	if a1 == b {
+		t.Errorf("expected pairwise subjects to differ between sectors")
+	}
+	other, err := pairwiseSubject("other-salt", "a.example.com", subject)
+	if err != nil {
+		t.Fatalf("failed to derive pairwise subject: %v", err)
+	}
+	if a1 == other {
+		t.Errorf("expected pairwise subjects to differ between salts")
+	}
+
+	got, err := parsePairwiseSubject("salt", "a.example.com", a1)
+	if err != nil {
+		t.Fatalf("failed to parse pairwise subject: %v", err)
+	}
+	if got != subject {
+		t.Errorf("expected subject %q, got %q", subject, got)
+	}
+	if _, err := parsePairwiseSubject("salt", "b.example.com", a1); err == nil {
+		t.Errorf("expected pairwise subject to be rejected for another sector")
+	}
+	if _, err := parsePairwiseSubject("salt", "a.example.com", subject); err == nil {
+		t.Errorf("expected public subject to be rejected")
+	}
+}
+
+func TestSectorIdentifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  storage.Client
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "explicit",
+			client: storage.Client{
+				ID:               "foo",
+				RedirectURIs:     []string{"https://a.example.com/cb", "https://b.example.com/cb"},
+				SectorIdentifier: "example.com",
+			},
+			want: "example.com",
+		},
+		{
+			name: "redirect host",
+			client: storage.Client{
+				ID:           "foo",
+				RedirectURIs: []string{"https://a.example.com/cb", "https://a.example.com/other"},
+			},
+			want: "a.example.com",
+		},
+		{
+			name:   "no redirect URIs",
+			client: storage.Client{ID: "foo"},
+			want:   "foo",
+		},
+		{
+			name: "multiple hosts",
+			client: storage.Client{
+				ID:           "foo",
+				RedirectURIs: []string{"https://a.example.com/cb", "https://b.example.com/cb"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := sectorIdentifier(tc.client)
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.wantErr {
+				t.Fatalf("expected error, got sector %q", got)
+			}
+			if got != tc.want {
+				t.Errorf("expected sector %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewIDTokenPairwise(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.PairwiseSubjectSalt = "salt"
+	})
+	defer httpServer.Close()
+
+	if subjects := s.discovery().Subjects; len(subjects) != 2 || subjects[1] != subjectTypePairwise {
+		t.Errorf("expected pairwise subjects to be advertised, got %v", subjects)
+	}
+
+	clients := []storage.Client{
+		{ID: "public", RedirectURIs: []string{"https://a.example.com/cb"}},
+		{ID: "pairwise-a", RedirectURIs: []string{"https://a.example.com/cb"}, SubjectType: subjectTypePairwise},
+		{ID: "pairwise-a2", RedirectURIs: []string{"https://a.example.com/other"}, SubjectType: subjectTypePairwise},
+		{ID: "pairwise-b", RedirectURIs: []string{"https://b.example.com/cb"}, SubjectType: subjectTypePairwise},
+	}
+	subjects := make(map[string]string)
+	claims := storage.Claims{UserID: "1", Email: "jane@example.com"}
+	for _, client := range clients {
+		if err := s.storage.CreateClient(client); err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+		idToken, _, err := s.newIDToken(client.ID, claims, []string{"openid", scopeFederatedID}, "", "", "", "mock")
+		if err != nil {
+			t.Fatalf("failed to create id token: %v", err)
+		}
+		idTokenClaims := tokenClaims(t, idToken)
+		subjects[client.ID], _ = idTokenClaims["sub"].(string)
+
+		// Federated claims hold the upstream user ID, which pairwise clients don't get.
+		_, federated := idTokenClaims["federated_claims"]
+		if want := client.SubjectType != subjectTypePairwise; federated != want {
+			t.Errorf("%s: expected federated claims %t, got %t", client.ID, want, federated)
+		}
+
+		accessToken, err := s.newAccessToken(client.ID, claims, []string{"openid"}, "", "mock")
+		if err != nil {
+			t.Fatalf("failed to create access token: %v", err)
+		}
+		if sub := tokenClaims(t, accessToken)["sub"]; sub != subjects[client.ID] {
+			t.Errorf("%s: access token subject %q doesn't match id token subject %q", client.ID, sub, subjects[client.ID])
+		}
+	}
+
+	public, err := internal.Marshal(&internal.IDTokenSubject{UserId: "1", ConnId: "mock"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subjects["public"] != public {
+		t.Errorf("expected public subject %q, got %q", public, subjects["public"])
+	}
+	if subjects["pairwise-a"] == public {
+		t.Errorf("expected pairwise client to get a pairwise subject")
+	}
+	if subjects["pairwise-a"] != subjects["pairwise-a2"] {
+		t.Errorf("expected clients of the same sector to get the same subject")
+	}
+	if subjects["pairwise-a"] == subjects["pairwise-b"] {
+		t.Errorf("expected clients of different sectors to get different subjects")
+	}
+
+	for _, clientID := range []string{"pairwise-a", "pairwise-b"} {
+		got, ok, err := resolvePairwiseSubject(s.storage, "salt", subjects[clientID])
+		if err != nil {
+			t.Fatalf("failed to resolve pairwise subject: %v", err)
+		}
+		if !ok || got != public {
+			t.Errorf("%s: expected pairwise subject to resolve to %q, got %q (%t)", clientID, public, got, ok)
+		}
+	}
+	if _, ok, _ := resolvePairwiseSubject(s.storage, "salt", public); ok {
+		t.Errorf("expected public subject not to resolve as a pairwise subject")
+	}
+}
+
+func TestNewIDTokenPairwiseWithoutSalt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	client := storage.Client{ID: "pairwise", SubjectType: subjectTypePairwise}
+	if err := s.storage.CreateClient(client); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if _, _, err := s.newIDToken(client.ID, storage.Claims{UserID: "1"}, []string{"openid"}, "", "", "", "mock"); err == nil {
+		t.Errorf("expected pairwise subjects to fail without a salt")
+	}
+	if subjects := s.discovery().Subjects; len(subjects) != 1 || subjects[0] != subjectTypePublic {
+		t.Errorf("expected only public subjects to be advertised without a salt, got %v", subjects)
+	}
+}
+
+func TestAPIPairwiseClient(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+	ctx := context.Background()
+
+	client := &api.Client{
+		Id:           "pairwise",
+		RedirectUris: []string{"https://a.example.com/cb"},
+		SubjectType:  subjectTypePairwise,
+	}
+
+	s := memory.New(logger)
+	d := NewAPI(s, logger, "test", "", PasswordConfig{})
+	if _, err := d.CreateClient(ctx, &api.CreateClientReq{Client: client}); err == nil {
+		t.Errorf("expected pairwise clients to be refused without a salt")
+	}
+	if _, err := d.CreateClient(ctx, &api.CreateClientReq{Client: &api.Client{Id: "public"}}); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if _, err := d.UpdateClient(ctx, &api.UpdateClientReq{Id: "public", SubjectType: subjectTypePairwise}); err == nil {
+		t.Errorf("expected clients not to become pairwise without a salt")
+	}
+
+	d = NewAPI(s, logger, "test", "salt", PasswordConfig{})
+	if _, err := d.CreateClient(ctx, &api.CreateClientReq{Client: &api.Client{Id: "other", SubjectType: "other"}}); err == nil {
+		t.Errorf("expected an unknown subject type to be refused")
+	}
+	if _, err := d.CreateClient(ctx, &api.CreateClientReq{Client: client}); err != nil {
+		t.Fatalf("failed to create pairwise client: %v", err)
+	}
+	stored, err := s.GetClient(client.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.SubjectType != subjectTypePairwise {
+		t.Errorf("expected a pairwise client, got subject type %q", stored.SubjectType)
+	}
+}
+
+func TestAPIPairwiseSubject(t *testing.T) {
+	logger := &logrus.Logger{
+		Out:       os.Stderr,
+		Formatter: &logrus.TextFormatter{DisableColors: true},
+		Level:     logrus.DebugLevel,
+	}
+	s := memory.New(logger)
+	d := NewAPI(s, logger, "test", "salt", PasswordConfig{})
+	ctx := context.Background()
+
+	client := storage.Client{
+		ID:           "pairwise",
+		RedirectURIs: []string{"https://a.example.com/cb"},
+		SubjectType:  subjectTypePairwise,
+	}
+	if err := s.CreateClient(client); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if err := s.CreateUserConsent(storage.UserConsent{
+		UserID: "1",
+		ConnID: "mock",
+		Clients: map[string]*storage.ClientConsent{
+			client.ID: {ClientID: client.ID, Scopes: []string{"openid"}},
+		},
+	}); err != nil {
+		t.Fatalf("failed to create user consent: %v", err)
+	}
+
+	public, err := internal.Marshal(&internal.IDTokenSubject{UserId: "1", ConnId: "mock"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairwise, err := pairwiseSubject("salt", "a.example.com", public)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, userID := range []string{public, pairwise} {
+		resp, err := d.ListConsents(ctx, &api.ListConsentReq{UserId: userID})
+		if err != nil {
+			t.Fatalf("failed to list consents for %q: %v", userID, err)
+		}
+		if len(resp.Consents) != 1 || resp.Consents[0].ClientId != client.ID {
+			t.Errorf("expected consent for %q, got %v", client.ID, resp.Consents)
+		}
+	}
+}
+
+// tokenClaims returns the claims of a token dex signed, without verifying it.
+func tokenClaims(t *testing.T, token string) map[string]interface{} {
+	t.Helper()
+	jws, err := jose.ParseSigned(token)
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(jws.UnsafePayloadWithoutVerification(), &claims); err != nil {
+		t.Fatalf("failed to decode claims: %v", err)
+	}
+	return claims
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/storage"
+)
+
+// changePasswordURL returns the link to the change password page shown on the
+// login form of connectors which can change passwords, or an empty string.
+func (s *Server) changePasswordURL(authReq storage.AuthRequest, conn connector.Connector) string {
+	if _, ok := conn.(connector.PasswordChangeConnector); !ok {
+		return ""
+	}
+	return s.absPath("/auth", authReq.ConnectorID, "password") + "?" + url.Values{"state": {authReq.ID}}.Encode()
+}
+
+// handleChangePassword lets users of a connector change their password. Users
+// who come from a login form are logged in with their new password afterwards.
+func (s *Server) handleChangePassword(w http.ResponseWriter, r *http.Request) {
+	connID := mux.Vars(r)["connector"]
+	conn, err := s.getConnector(connID)
+	if err != nil {
+		s.logger.Errorf("Failed to get connector with id %q : %v", connID, err)
+		s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+		return
+	}
+	pcConn, ok := conn.Connector.(connector.PasswordChangeConnector)
+	if !ok {
+		s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+		return
+	}
+	prompt := "Username"
+	if pwConn, ok := conn.Connector.(connector.PasswordConnector); ok {
+		prompt = usernamePrompt(pwConn)
+	}
+
+	var (
+		authReq  *storage.AuthRequest
+		backLink string
+	)
+	if state := r.URL.Query().Get("state"); state != "" {
+		req, err := s.storage.GetAuthRequest(state)
+		if err != nil {
+			if err == storage.ErrNotFound {
+				s.logger.Errorf("Invalid 'state' parameter provided: %v", err)
+				s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+				return
+			}
+			s.logger.Errorf("Failed to get auth request: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		if req.ConnectorID != connID {
+			s.logger.Errorf("Connector mismatch: authentication started with id %q, but password change for id %q was triggered", req.ConnectorID, connID)
+			s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist.")
+			return
+		}
+		authReq = &req
+		backLink = s.absPath("/auth", connID, "login") + "?" + url.Values{"state": {state}}.Encode()
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if err := s.templates.changePassword(r, w, r.URL.String(), backLink, prompt, "", false, false, ""); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	case http.MethodPost:
+		username := r.PostFormValue("login")
+		password := r.PostFormValue("password")
+		newPassword := r.PostFormValue("new_password")
+		renderForm := func(errMsg string) {
+			if err := s.templates.changePassword(r, w, r.URL.String(), backLink, prompt, username, false, false, errMsg); err != nil {
+				s.logger.Errorf("Server template error: %v", err)
+			}
+		}
+		if newPassword == "" || newPassword != r.PostFormValue("confirm") {
+			renderForm("The new passwords don't match.")
+			return
+		}
+
+		// Changing a password checks the current one just like a login does.
+		ip := s.clientIP(r)
+		if s.loginLocked(connID, username, ip) {
+			s.renderError(r, w, http.StatusTooManyRequests, errLoginLocked)
+			return
+		}
+		ok, err := pcConn.ChangePassword(r.Context(), username, password, newPassword)
+		if err != nil {
+			if errors.Is(err, connector.ErrPasswordRejected) {
+				s.logger.Errorf("New password rejected: %v", err)
+				renderForm("The new password was rejected. It may be too weak or used before.")
+				return
+			}
+			s.logger.Errorf("Failed to change password: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Failed to change password.")
+			return
+		}
+		if !ok {
+			s.recordLoginFailure(connID, username, ip)
+			renderForm("Invalid " + prompt + " or password.")
+			return
+		}
+		s.recordLoginSuccess(connID, username)
+		s.logger.Infof("password of %q changed through connector %q", username, connID)
+
+		if authReq != nil {
+			if redirectURL, ok := s.loginAfterPasswordChange(r, *authReq, conn.Connector, username, newPassword); ok {
+				http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+				return
+			}
+		}
+		if err := s.templates.changePassword(r, w, r.URL.String(), backLink, prompt, "", false, true, ""); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+	}
+}
+
+// loginAfterPasswordChange finishes the login of the auth request with the new
+// password. It fails if the upstream doesn't accept the password yet, for example
+// before it's replicated, in which case the user logs in again by themselves.
+func (s *Server) loginAfterPasswordChange(r *http.Request, authReq storage.AuthRequest, conn connector.Connector, username, password string) (string, bool) {
+	pwConn, ok := conn.(connector.PasswordConnector)
+	if !ok {
+		return "", false
+	}
+	identity, ok, err := pwConn.Login(r.Context(), parseScopes(authReq.Scopes), username, password)
+	if err != nil || !ok {
+		s.logger.Infof("Failed to log in with the changed password of %q: %v", username, err)
+		return "", false
+	}
+	redirectURL, err := s.finalizeLogin(identity, authReq, conn)
+	if err != nil {
+		s.logger.Errorf("Failed to finalize login: %v", err)
+		return "", false
+	}
+	return redirectURL, true
+}
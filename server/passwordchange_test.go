@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/storage"
+)
+
+// passwordChanger is a connector for a single user whose password can expire
+// and be changed. Passwords of fewer than six characters are rejected.
+type passwordChanger struct {
+	mu       sync.Mutex
+	password string
+	expired  bool
+}
+
+func (p *passwordChanger) Prompt() string { return "" }
+
+func (p *passwordChanger) Login(_ context.Context, _ connector.Scopes, username, password string) (connector.Identity, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if username != "jane" || password != p.password {
+		return connector.Identity{}, false, nil
+	}
+	if p.expired {
+		return connector.Identity{}, false, fmt.Errorf("password of jane: %w", connector.ErrPasswordExpired)
+	}
+	return connector.Identity{UserID: "jane-id", Username: "jane", Email: "jane@example.com"}, true, nil
+}
+
+func (p *passwordChanger) ChangePassword(_ context.Context, username, oldPassword, newPassword string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if username != "jane" || oldPassword != p.password {
+		return false, nil
+	}
+	if len(newPassword) < 6 {
+		return false, connector.ErrPasswordRejected
+	}
+	p.password = newPassword
+	p.expired = false
+	return true, nil
+}
+
+func TestChangePassword(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	require.NoError(t, s.storage.CreateConnector(storage.Connector{
+		ID:              "changer",
+		Type:            "mockPassword",
+		Name:            "Changer",
+		ResourceVersion: "1",
+		Config:          []byte(`{"username": "jane", "password": "unused"}`),
+	}))
+	conn := &passwordChanger{password: "expired", expired: true}
+	s.connectors["changer"] = Connector{ResourceVersion: "1", Connector: conn}
+
+	authReq := storage.AuthRequest{
+		ID:            storage.NewID(),
+		ClientID:      "test",
+		ConnectorID:   "changer",
+		RedirectURI:   "https://example.com/callback",
+		ResponseTypes: []string{responseTypeCode},
+		Scopes:        []string{"openid"},
+		Expiry:        time.Now().Add(time.Minute),
+	}
+	require.NoError(t, s.storage.CreateAuthRequest(authReq))
+	query := "?" + url.Values{"state": {authReq.ID}}.Encode()
+
+	do := func(method, target string, form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+	change := func(password, newPassword, confirm string) *httptest.ResponseRecorder {
+		return do(http.MethodPost, "/auth/changer/password"+query, url.Values{
+			"login":        {"jane"},
+			"password":     {password},
+			"new_password": {newPassword},
+			"confirm":      {confirm},
+		})
+	}
+
+	rr := do(http.MethodGet, "/auth/changer/login"+query, nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), "/auth/changer/password"+query)
+
+	// Logging in with an expired password leads to the change password form.
+	rr = do(http.MethodPost, "/auth/changer/login"+query, url.Values{"login": {"jane"}, "password": {"expired"}})
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), "Your password has expired.")
+
+	rr = change("wrong", "new-password", "new-password")
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "Invalid Username or password.")
+
+	rr = change("expired", "new-password", "other-password")
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "The new passwords don&#39;t match.")
+
+	rr = change("expired", "short", "short")
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "The new password was rejected.")
+
+	// The user is logged in with the new password.
+	rr = change("expired", "new-password", "new-password")
+	require.Equal(t, http.StatusSeeOther, rr.Code)
+	got, err := s.storage.GetAuthRequest(authReq.ID)
+	require.NoError(t, err)
+	require.True(t, got.LoggedIn)
+	require.Equal(t, "jane-id", got.Claims.UserID)
+
+	// Without an auth request the change is only confirmed.
+	rr = do(http.MethodPost, "/auth/changer/password", url.Values{
+		"login":        {"jane"},
+		"password":     {"new-password"},
+		"new_password": {"newer-password"},
+		"confirm":      {"newer-password"},
+	})
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), "Your password has been changed.")
+
+	// Connectors which can't change passwords have no such page.
+	rr = do(http.MethodGet, "/auth/mock/password", nil)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
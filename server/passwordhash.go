@@ -0,0 +1,183 @@
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Algorithms for hashing the passwords of the password database.
+const (
+	hashBcrypt   = "bcrypt"
+	hashArgon2id = "argon2id"
+)
+
+// Parameters of new argon2id hashes, following the OWASP recommendation of
+// 19 MiB of memory and two iterations.
+const (
+	argon2idMemory  = 19 * 1024 // KiB
+	argon2idTime    = 2
+	argon2idThreads = 1
+	argon2idSaltLen = 16
+	argon2idKeyLen  = 32
+)
+
+// Bounds of the parameters of argon2id hashes set through the API or the config.
+// Like upBoundCost for bcrypt, the upper bounds keep logins from putting an
+// unreasonable load on the server.
+const (
+	argon2idMinMemory  = 8 * 1024
+	argon2idMaxMemory  = 1024 * 1024
+	argon2idMaxTime    = 10
+	argon2idMaxThreads = 16
+)
+
+var argon2idPrefix = []byte("$argon2id$")
+
+// PasswordConfig controls how dex sets the passwords of the password database.
+type PasswordConfig struct {
+	// Algorithm of the hashes dex creates, either "bcrypt" or "argon2id". Defaults
+	// to bcrypt. Passwords hashed differently are rehashed when their users log in.
+	HashAlgorithm string
+
+	// Checked against passwords set in plain text, through the API or by users
+	// resetting their password. Nothing is checked if nil.
+	Policy *PasswordPolicy
+}
+
+func (c PasswordConfig) hashAlgorithm() string {
+	if c.HashAlgorithm == "" {
+		return hashBcrypt
+	}
+	return c.HashAlgorithm
+}
+
+func validPasswordHashAlgorithm(alg string) bool {
+	switch alg {
+	case "", hashBcrypt, hashArgon2id:
+		return true
+	}
+	return false
+}
+
+// hashPassword hashes the password with the configured algorithm.
+func (c PasswordConfig) hashPassword(password string) ([]byte, error) {
+	if c.hashAlgorithm() == hashArgon2id {
+		salt := make([]byte, argon2idSaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		key := argon2.IDKey([]byte(password), salt, argon2idTime, argon2idMemory, argon2idThreads, argon2idKeyLen)
+		return []byte(fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version, argon2idMemory, argon2idTime, argon2idThreads,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))), nil
+	}
+	return bcrypt.GenerateFromPassword([]byte(password), recCost)
+}
+
+// needsRehash reports whether a hash should be replaced after a successful login,
+// because it uses another algorithm or weaker argon2id parameters than dex would.
+func (c PasswordConfig) needsRehash(hash []byte) bool {
+	if !isArgon2idHash(hash) {
+		return c.hashAlgorithm() != hashBcrypt
+	}
+	if c.hashAlgorithm() != hashArgon2id {
+		return true
+	}
+	h, err := parseArgon2idHash(hash)
+	if err != nil {
+		return false
+	}
+	return h.memory < argon2idMemory || h.time < argon2idTime
+}
+
+func isArgon2idHash(hash []byte) bool {
+	return bytes.HasPrefix(hash, argon2idPrefix)
+}
+
+// checkPasswordHash returns an error if the hash can't be used to log in, because
+// it's malformed or its parameters are out of bounds.
+func checkPasswordHash(hash []byte) error {
+	if !isArgon2idHash(hash) {
+		return checkCost(hash)
+	}
+	h, err := parseArgon2idHash(hash)
+	if err != nil {
+		return err
+	}
+	switch {
+	case h.memory < argon2idMinMemory:
+		return fmt.Errorf("given argon2id memory = %d KiB does not meet minimum requirement = %d KiB", h.memory, argon2idMinMemory)
+	case h.memory > argon2idMaxMemory:
+		return fmt.Errorf("given argon2id memory = %d KiB is above upper bound = %d KiB", h.memory, argon2idMaxMemory)
+	case h.time > argon2idMaxTime:
+		return fmt.Errorf("given argon2id iterations = %d is above upper bound = %d", h.time, argon2idMaxTime)
+	case h.threads > argon2idMaxThreads:
+		return fmt.Errorf("given argon2id parallelism = %d is above upper bound = %d", h.threads, argon2idMaxThreads)
+	}
+	return nil
+}
+
+// comparePassword reports whether the password matches the bcrypt or argon2id hash.
+func comparePassword(hash []byte, password string) (bool, error) {
+	if !isArgon2idHash(hash) {
+		err := bcrypt.CompareHashAndPassword(hash, []byte(password))
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	h, err := parseArgon2idHash(hash)
+	if err != nil {
+		return false, err
+	}
+	key := argon2.IDKey([]byte(password), h.salt, h.time, h.memory, h.threads, uint32(len(h.key)))
+	return subtle.ConstantTimeCompare(key, h.key) == 1, nil
+}
+
+type argon2idHash struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// parseArgon2idHash parses a hash in the PHC string format, for example
+// "$argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>".
+func parseArgon2idHash(hash []byte) (h argon2idHash, err error) {
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != 6 || parts[1] != hashArgon2id {
+		return h, errors.New("malformed argon2id hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return h, fmt.Errorf("malformed argon2id hash version: %v", err)
+	}
+	if version != argon2.Version {
+		return h, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &h.memory, &h.time, &h.threads); err != nil {
+		return h, fmt.Errorf("malformed argon2id hash parameters: %v", err)
+	}
+	if h.time == 0 || h.threads == 0 {
+		return h, errors.New("malformed argon2id hash parameters")
+	}
+	if h.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return h, fmt.Errorf("malformed argon2id hash salt: %v", err)
+	}
+	if h.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return h, fmt.Errorf("malformed argon2id hash key: %v", err)
+	}
+	if len(h.key) == 0 {
+		return h, errors.New("malformed argon2id hash key")
+	}
+	return h, nil
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/memory"
+)
+
+func TestArgon2idHash(t *testing.T) {
+	c := PasswordConfig{HashAlgorithm: hashArgon2id}
+
+	hash, err := c.hashPassword("secret")
+	require.NoError(t, err)
+	require.True(t, isArgon2idHash(hash))
+	require.NoError(t, checkPasswordHash(hash))
+	require.False(t, c.needsRehash(hash))
+
+	ok, err := comparePassword(hash, "secret")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = comparePassword(hash, "not secret")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	other, err := c.hashPassword("secret")
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other, "hashes must be salted")
+
+	// Reference hash of "password" from the argon2 command line utility.
+	ok, err = comparePassword([]byte("$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc"), "password")
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestCheckPasswordHash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		hash    string
+		wantErr bool
+	}{
+		{"bcrypt", string(bcryptHash), false},
+		{"argon2id", "$argon2id$v=19$m=19456,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc", false},
+		{"too little memory", "$argon2id$v=19$m=1024,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc", true},
+		{"too much memory", "$argon2id$v=19$m=4194304,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc", true},
+		{"too many iterations", "$argon2id$v=19$m=19456,t=100,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc", true},
+		{"unsupported version", "$argon2id$v=16$m=19456,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc", true},
+		{"malformed argon2id", "$argon2id$v=19$m=19456,t=2,p=1$c29tZXNhbHQ", true},
+		{"malformed", "secret", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkPasswordHash([]byte(tc.hash))
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	weakArgon2id := []byte("$argon2id$v=19$m=8192,t=1,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc")
+
+	bcryptConfig := PasswordConfig{}
+	require.False(t, bcryptConfig.needsRehash(bcryptHash))
+	require.True(t, bcryptConfig.needsRehash(weakArgon2id))
+
+	argon2idConfig := PasswordConfig{HashAlgorithm: hashArgon2id}
+	require.True(t, argon2idConfig.needsRehash(bcryptHash))
+	require.True(t, argon2idConfig.needsRehash(weakArgon2id))
+}
+
+func TestPasswordDBRehash(t *testing.T) {
+	s := memory.New(logger)
+	conn := newPasswordDB(s, PasswordConfig{HashAlgorithm: hashArgon2id}, logger)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	require.NoError(t, s.CreatePassword(storage.Password{
+		Email:    "jane@example.com",
+		Hash:     hash,
+		Username: "jane",
+		UserID:   "foobar",
+	}))
+
+	_, ok, err := conn.Login(context.Background(), connector.Scopes{}, "jane@example.com", "secret")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	p, err := s.GetPassword("jane@example.com")
+	require.NoError(t, err)
+	require.True(t, isArgon2idHash(p.Hash), "password wasn't rehashed: %s", p.Hash)
+
+	// The new hash works for further logins.
+	_, ok, err = conn.Login(context.Background(), connector.Scopes{}, "jane@example.com", "secret")
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicyConfig lists the requirements for new passwords.
+type PasswordPolicyConfig struct {
+	// Minimum number of characters.
+	MinLength int
+
+	// Character classes every password must contain. Symbols are any characters
+	// which are neither letters nor digits.
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+
+	// Number of previous passwords which can't be reused, besides the current one.
+	// Zero lets users set their current password again.
+	History int
+
+	// File of breached passwords which are refused, one per line. Lines of 40 hex
+	// characters are read as SHA-1 hashes of passwords, optionally followed by
+	// ":<count>" as in the downloads of Have I Been Pwned.
+	//
+	// The hashes are kept in memory, 20 bytes per password. The full Have I Been
+	// Pwned list of close to a billion passwords doesn't fit, a list of the 10
+	// million passwords seen most often takes about 200 MB.
+	BreachedPasswordsFile string
+}
+
+// PasswordPolicy checks new passwords against a PasswordPolicyConfig.
+type PasswordPolicy struct {
+	config PasswordPolicyConfig
+	// Sorted SHA-1 hashes of the breached passwords.
+	breached [][sha1.Size]byte
+}
+
+// NewPasswordPolicy returns a policy, reading the breached passwords file of the
+// config if it's set.
+func NewPasswordPolicy(c PasswordPolicyConfig) (*PasswordPolicy, error) {
+	if c.MinLength < 0 || c.History < 0 {
+		return nil, fmt.Errorf("password policy: minimum length and history can't be negative")
+	}
+	p := &PasswordPolicy{config: c}
+	if c.BreachedPasswordsFile == "" {
+		return p, nil
+	}
+
+	f, err := os.Open(c.BreachedPasswordsFile)
+	if err != nil {
+		return nil, fmt.Errorf("password policy: %v", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		p.breached = append(p.breached, breachedPasswordKey(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("password policy: read %s: %v", c.BreachedPasswordsFile, err)
+	}
+	sort.Slice(p.breached, func(i, j int) bool {
+		return bytes.Compare(p.breached[i][:], p.breached[j][:]) < 0
+	})
+	return p, nil
+}
+
+// isBreached reports whether the password is in the breached passwords file.
+func (p *PasswordPolicy) isBreached(password string) bool {
+	key := sha1.Sum([]byte(password))
+	i := sort.Search(len(p.breached), func(i int) bool {
+		return bytes.Compare(p.breached[i][:], key[:]) >= 0
+	})
+	return i < len(p.breached) && p.breached[i] == key
+}
+
+func breachedPasswordKey(line string) (key [sha1.Size]byte) {
+	hash := line
+	if i := strings.IndexByte(line, ':'); i == 2*sha1.Size {
+		hash = line[:i]
+	}
+	if len(hash) == 2*sha1.Size {
+		if b, err := hex.DecodeString(hash); err == nil {
+			copy(key[:], b)
+			return key
+		}
+	}
+	return sha1.Sum([]byte(line))
+}
+
+// historySize returns how many previous hashes have to be kept for the policy.
+func (p *PasswordPolicy) historySize() int {
+	if p == nil {
+		return 0
+	}
+	return p.config.History
+}
+
+// check returns the reasons the password doesn't meet the policy, as sentences
+// which can be shown to users. The hashes are those of the current and previous
+// passwords of the user, if any.
+func (p *PasswordPolicy) check(password string, hashes ...[]byte) []string {
+	if p == nil {
+		return nil
+	}
+	c := p.config
+
+	var violations []string
+	if n := utf8.RuneCountInString(password); n < c.MinLength {
+		violations = append(violations, fmt.Sprintf("The password must be at least %d characters long.", c.MinLength))
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			symbol = true
+		}
+	}
+	for _, class := range []struct {
+		required, found bool
+		name            string
+	}{
+		{c.RequireUppercase, upper, "an uppercase letter"},
+		{c.RequireLowercase, lower, "a lowercase letter"},
+		{c.RequireDigit, digit, "a digit"},
+		{c.RequireSymbol, symbol, "a symbol"},
+	} {
+		if class.required && !class.found {
+			violations = append(violations, fmt.Sprintf("The password must contain %s.", class.name))
+		}
+	}
+
+	if p.breached != nil {
+		if p.isBreached(password) {
+			violations = append(violations, "The password appears in a list of breached passwords.")
+		}
+	}
+
+	if c.History > 0 {
+		for _, hash := range hashes {
+			if ok, _ := comparePassword(hash, password); ok {
+				violations = append(violations, "The password was used recently.")
+				break
+			}
+		}
+	}
+	return violations
+}
+
+// passwordHistory returns the previous hashes to keep when a password with the
+// hash current is replaced.
+func passwordHistory(size int, current []byte, previous [][]byte) [][]byte {
+	if size <= 0 {
+		return nil
+	}
+	history := append([][]byte{current}, previous...)
+	if len(history) > size {
+		history = history[:size]
+	}
+	return history
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	breached := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(breached, []byte(
+		"Password123!\n"+
+			// SHA-1 of "Summer2024!" in the format of Have I Been Pwned.
+			"7E8B0A3433F1210A9699D85420E363A1B162ECAC:12\n"+
+			"\n",
+	), 0o600))
+
+	policy, err := NewPasswordPolicy(PasswordPolicyConfig{
+		MinLength:             10,
+		RequireUppercase:      true,
+		RequireLowercase:      true,
+		RequireDigit:          true,
+		RequireSymbol:         true,
+		History:               2,
+		BreachedPasswordsFile: breached,
+	})
+	require.NoError(t, err)
+
+	previous, err := bcrypt.GenerateFromPassword([]byte("Previous-Pass1"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		want     []string
+	}{
+		{
+			name:     "valid",
+			password: "Correct-Horse1",
+		},
+		{
+			name:     "too short",
+			password: "Sh0rt!",
+			want:     []string{"The password must be at least 10 characters long."},
+		},
+		{
+			name:     "missing character classes",
+			password: "alllowercaseletters",
+			want: []string{
+				"The password must contain an uppercase letter.",
+				"The password must contain a digit.",
+				"The password must contain a symbol.",
+			},
+		},
+		{
+			name:     "breached",
+			password: "Password123!",
+			want:     []string{"The password appears in a list of breached passwords."},
+		},
+		{
+			name:     "breached hash",
+			password: "Summer2024!",
+			want:     []string{"The password appears in a list of breached passwords."},
+		},
+		{
+			name:     "reused",
+			password: "Previous-Pass1",
+			want:     []string{"The password was used recently."},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, policy.check(tc.password, previous))
+		})
+	}
+
+	var noPolicy *PasswordPolicy
+	require.Empty(t, noPolicy.check("a"))
+	require.Zero(t, noPolicy.historySize())
+}
+
+func TestBreachedPasswordKey(t *testing.T) {
+	require.Equal(t, breachedPasswordKey("password"), breachedPasswordKey("5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8"))
+	require.Equal(t, breachedPasswordKey("password"), breachedPasswordKey("5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8:9545824"))
+	require.NotEqual(t, breachedPasswordKey("password"), breachedPasswordKey("Password"))
+}
+
+func TestPasswordHistory(t *testing.T) {
+	current := []byte("current")
+	previous := [][]byte{[]byte("one"), []byte("two")}
+
+	require.Nil(t, passwordHistory(0, current, previous))
+	require.Equal(t, [][]byte{current}, passwordHistory(1, current, previous))
+	require.Equal(t, [][]byte{current, []byte("one"), []byte("two")}, passwordHistory(5, current, previous))
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// forgotPasswordURL returns the link to the forgot password page shown on the login
+// form of the password database, or an empty string if no emails can be sent.
+func (s *Server) forgotPasswordURL(authReq storage.AuthRequest) string {
+	if s.emailSender == nil || authReq.ConnectorID != LocalConnector {
+		return ""
+	}
+	return s.absPath("/password/forgot") + "?" + url.Values{"state": {authReq.ID}}.Encode()
+}
+
+func (s *Server) handleForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var backLink string
+	if state := r.URL.Query().Get("state"); state != "" {
+		backLink = s.absPath("/auth", LocalConnector, "login") + "?" + url.Values{"state": {state}}.Encode()
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if err := s.templates.forgotPassword(r, w, r.URL.String(), backLink, false); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	case http.MethodPost:
+		email := strings.TrimSpace(r.PostFormValue("email"))
+		if email == "" {
+			s.renderError(r, w, http.StatusBadRequest, "No email address provided.")
+			return
+		}
+
+		// Whether an account exists for the address isn't revealed, neither by the
+		// response nor by how long it takes. Neither is whether the email was
+		// held back by the limits.
+		go s.sendPasswordReset(email, s.clientIP(r))
+
+		if err := s.templates.forgotPassword(r, w, r.URL.String(), backLink, true); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+	}
+}
+
+// sendPasswordReset emails a single use reset link to the user of the password
+// database with the email address, if there is one and neither the address nor
+// the requesting client address got too many emails.
+func (s *Server) sendPasswordReset(email, ip string) {
+	if !s.emailAllowed(emailAttemptsIPID(ip), maxEmailsPerIP, s.passwordResetsValidFor) {
+		return
+	}
+	p, err := s.storage.GetPassword(email)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to get password: %v", err)
+		}
+		return
+	}
+	// Only addresses with an account are counted, so made up ones don't fill
+	// the storage.
+	if !s.emailAllowed(emailAttemptsAddressID(p.Email), maxEmailsPerAddress, s.passwordResetsValidFor) {
+		return
+	}
+
+	reset := storage.PasswordReset{
+		ID:     storage.NewID(),
+		Email:  p.Email,
+		Expiry: s.now().Add(s.passwordResetsValidFor),
+	}
+	if err := s.storage.CreatePasswordReset(reset); err != nil {
+		s.logger.Errorf("Failed to create password reset: %v", err)
+		return
+	}
+
+	link := s.absURL("/password/reset") + "?" + url.Values{"token": {reset.ID}}.Encode()
+	body := fmt.Sprintf(`Someone asked to reset the password of your account at %s.
+
+Follow this link within %s to choose a new password:
+
+%s
+
+If you didn't ask for this, you can ignore this email. Your password stays unchanged.
+`, s.issuerURL.String(), s.passwordResetsValidFor, link)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	err = s.emailSender.SendEmail(ctx, Email{
+		To:      p.Email,
+		Subject: "Reset your password",
+		Body:    body,
+	})
+	if err != nil {
+		s.logger.Errorf("Failed to send password reset email: %v", err)
+	}
+}
+
+// deletePasswordResets deletes every password reset sent to the email address.
+func (s *Server) deletePasswordResets(email string) {
+	resets, err := s.storage.ListPasswordResets(email)
+	if err != nil {
+		s.logger.Errorf("Failed to list password resets: %v", err)
+		return
+	}
+	for _, reset := range resets {
+		if err := s.storage.DeletePasswordReset(reset.ID); err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to delete password reset: %v", err)
+		}
+	}
+}
+
+func (s *Server) handleResetPassword(w http.ResponseWriter, r *http.Request) {
+	token := r.FormValue("token")
+	if token == "" {
+		s.renderError(r, w, http.StatusBadRequest, "No password reset token provided.")
+		return
+	}
+
+	reset, err := s.storage.GetPasswordReset(token)
+	if err != nil || s.now().After(reset.Expiry) {
+		if err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to get password reset: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		s.renderError(r, w, http.StatusBadRequest, "This password reset link is invalid or has expired.")
+		return
+	}
+
+	postURL := s.absPath("/password/reset")
+
+	switch r.Method {
+	case http.MethodGet:
+		if err := s.templates.resetPassword(r, w, postURL, token, false, ""); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	case http.MethodPost:
+		password := r.PostFormValue("password")
+		if password == "" || password != r.PostFormValue("confirm") {
+			if err := s.templates.resetPassword(r, w, postURL, token, false, "The passwords don't match."); err != nil {
+				s.logger.Errorf("Server template error: %v", err)
+			}
+			return
+		}
+
+		old, err := s.storage.GetPassword(reset.Email)
+		if err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to get password: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		if violations := s.passwords.Policy.check(password, append([][]byte{old.Hash}, old.PreviousHashes...)...); len(violations) > 0 {
+			if err := s.templates.resetPassword(r, w, postURL, token, false, strings.Join(violations, " ")); err != nil {
+				s.logger.Errorf("Server template error: %v", err)
+			}
+			return
+		}
+
+		// Deleting the token first makes sure it's used only once.
+		if err := s.storage.DeletePasswordReset(token); err != nil {
+			if err == storage.ErrNotFound {
+				s.renderError(r, w, http.StatusBadRequest, "This password reset link is invalid or has expired.")
+				return
+			}
+			s.logger.Errorf("Failed to delete password reset: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+
+		hash, err := s.passwords.hashPassword(password)
+		if err != nil {
+			s.logger.Errorf("Failed to hash password: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Failed to reset password.")
+			return
+		}
+		err = s.storage.UpdatePassword(reset.Email, func(p storage.Password) (storage.Password, error) {
+			p.PreviousHashes = passwordHistory(s.passwords.Policy.historySize(), p.Hash, p.PreviousHashes)
+			p.Hash = hash
+			// The link was sent to the user's email address, which confirms it.
+			p.PendingVerification = false
+			return p, nil
+		})
+		if err != nil {
+			s.logger.Errorf("Failed to update password: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Failed to reset password.")
+			return
+		}
+		s.logger.Infof("password of %q reset through an emailed link", reset.Email)
+		// Other links sent to the address stop working once one of them was used.
+		s.deletePasswordResets(reset.Email)
+		// Whoever reset the password has access to the user's email, so earlier
+		// failed logins don't need to keep the account locked.
+		s.recordLoginSuccess(LocalConnector, reset.Email)
+
+		if err := s.templates.resetPassword(r, w, postURL, "", true, ""); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+	}
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestPasswordReset(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	smtpAddr, messages := newTestSMTPServer(t)
+	sender, err := NewSMTPSender(SMTPConfig{Address: smtpAddr, From: "dex@example.com", DisableStartTLS: true})
+	require.NoError(t, err)
+
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.EmailSender = sender
+		hash, err := bcrypt.GenerateFromPassword([]byte("old password"), bcrypt.MinCost)
+		require.NoError(t, err)
+		require.NoError(t, c.Storage.CreatePassword(storage.Password{
+			Email:    "jane@example.com",
+			Hash:     hash,
+			Username: "jane",
+			UserID:   "jane-id",
+		}))
+	})
+	defer httpServer.Close()
+
+	do := func(method, target string, form url.Values) *httptest.ResponseRecorder {
+		var req *http.Request
+		if form != nil {
+			req = httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		} else {
+			req = httptest.NewRequest(method, target, nil)
+		}
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := do(http.MethodGet, "/password/forgot?state=abc", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), "/auth/local/login?state=abc")
+
+	// Unknown addresses get the same response, but no email.
+	rr = do(http.MethodPost, "/password/forgot", url.Values{"email": {"john@example.com"}})
+	require.Equal(t, http.StatusOK, rr.Code)
+	unknownResponse := rr.Body.String()
+
+	rr = do(http.MethodPost, "/password/forgot", url.Values{"email": {"Jane@Example.com"}})
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, unknownResponse, rr.Body.String())
+
+	msg := receiveEmail(t, messages)
+	require.Equal(t, []string{"jane@example.com"}, msg.To)
+	link := regexp.MustCompile(`https?://\S+/password/reset\?token=\S+`).FindString(msg.Data)
+	require.NotEmpty(t, link, "no reset link in email:\n%s", msg.Data)
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+	token := u.Query().Get("token")
+
+	select {
+	case msg := <-messages:
+		t.Fatalf("unexpected email to %v", msg.To)
+	default:
+	}
+
+	rr = do(http.MethodGet, u.RequestURI(), nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), `name="password"`)
+
+	rr = do(http.MethodPost, "/password/reset", url.Values{"token": {token}, "password": {"new password"}, "confirm": {"other password"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// Another link sent to the address earlier.
+	other := storage.PasswordReset{
+		ID:     storage.NewID(),
+		Email:  "jane@example.com",
+		Expiry: time.Now().Add(time.Hour),
+	}
+	require.NoError(t, s.storage.CreatePasswordReset(other))
+
+	rr = do(http.MethodPost, "/password/reset", url.Values{"token": {token}, "password": {"new password"}, "confirm": {"new password"}})
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	p, err := s.storage.GetPassword("jane@example.com")
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword(p.Hash, []byte("new password")))
+
+	// Reset links work only once, and the other links stop working too.
+	rr = do(http.MethodPost, "/password/reset", url.Values{"token": {token}, "password": {"evil"}, "confirm": {"evil"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	rr = do(http.MethodPost, "/password/reset", url.Values{"token": {other.ID}, "password": {"evil"}, "confirm": {"evil"}})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	expired := storage.PasswordReset{
+		ID:     storage.NewID(),
+		Email:  "jane@example.com",
+		Expiry: time.Now().Add(-time.Minute),
+	}
+	require.NoError(t, s.storage.CreatePasswordReset(expired))
+	rr = do(http.MethodGet, "/password/reset?token="+expired.ID, nil)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	// The address already got one email, only two more are sent.
+	for i := 0; i < 3; i++ {
+		s.sendPasswordReset("jane@example.com", "192.0.2.1")
+	}
+	receiveEmail(t, messages)
+	receiveEmail(t, messages)
+	select {
+	case msg := <-messages:
+		t.Fatalf("unexpected email to %v", msg.To)
+	default:
+	}
+}
+
+func TestPasswordResetPolicy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	policy, err := NewPasswordPolicy(PasswordPolicyConfig{MinLength: 12})
+	require.NoError(t, err)
+
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.EmailSender = &SMTPSender{}
+		c.Passwords = PasswordConfig{HashAlgorithm: hashArgon2id, Policy: policy}
+		hash, err := bcrypt.GenerateFromPassword([]byte("old password"), bcrypt.MinCost)
+		require.NoError(t, err)
+		require.NoError(t, c.Storage.CreatePassword(storage.Password{
+			Email:    "jane@example.com",
+			Hash:     hash,
+			Username: "jane",
+			UserID:   "jane-id",
+		}))
+	})
+	defer httpServer.Close()
+
+	reset := storage.PasswordReset{
+		ID:     storage.NewID(),
+		Email:  "jane@example.com",
+		Expiry: time.Now().Add(time.Hour),
+	}
+	require.NoError(t, s.storage.CreatePasswordReset(reset))
+
+	post := func(password string) *httptest.ResponseRecorder {
+		form := url.Values{"token": {reset.ID}, "password": {password}, "confirm": {password}}
+		req := httptest.NewRequest(http.MethodPost, "/password/reset", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := post("too short")
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "at least 12 characters")
+
+	// The rejected password doesn't use up the link.
+	rr = post("long enough password")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	p, err := s.storage.GetPassword("jane@example.com")
+	require.NoError(t, err)
+	require.True(t, isArgon2idHash(p.Hash))
+}
+
+func TestForgotPasswordURL(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	authReq := storage.AuthRequest{ID: "abc", ConnectorID: LocalConnector}
+	require.Empty(t, s.forgotPasswordURL(authReq), "no link without an email sender")
+
+	s.emailSender = &SMTPSender{}
+	require.Equal(t, "/password/forgot?state=abc", s.forgotPasswordURL(authReq))
+
+	authReq.ConnectorID = "mock"
+	require.Empty(t, s.forgotPasswordURL(authReq), "no link for other connectors")
+}
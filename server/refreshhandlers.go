@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,6 +15,10 @@ import (
 	"github.com/dexidp/dex/storage"
 )
 
+// maxRetiredRefreshTokens bounds how many rotated out tokens are remembered per
+// refresh token. Leaked tokens are usually replayed soon after they're rotated out.
+const maxRetiredRefreshTokens = 100
+
 func contains(arr []string, item string) bool {
 	for _, itemFromArray := range arr {
 		if itemFromArray == item {
@@ -61,7 +67,8 @@ func (s *Server) extractRefreshTokenFromRequest(r *http.Request) (*internal.Refr
 }
 
 // getRefreshTokenFromStorage checks that refresh token is valid and exists in the storage and gets its info
-func (s *Server) getRefreshTokenFromStorage(clientID string, token *internal.RefreshToken) (*storage.RefreshToken, *refreshError) {
+func (s *Server) getRefreshTokenFromStorage(ctx context.Context, client storage.Client, token *internal.RefreshToken) (*storage.RefreshToken, *refreshError) {
+	clientID := client.ID
 	invalidErr := newBadRequestError("Refresh token is invalid or has already been claimed by another client.")
 
 	refresh, err := s.storage.GetRefresh(token.RefreshId)
@@ -81,14 +88,19 @@ func (s *Server) getRefreshTokenFromStorage(clientID string, token *internal.Ref
 	}
 
 	if refresh.Token != token.Token {
+		obsolete := refresh.ObsoleteToken != "" && refresh.ObsoleteToken == token.Token
 		switch {
-		case !s.refreshTokenPolicy.AllowedToReuse(refresh.LastUsed):
-			fallthrough
-		case refresh.ObsoleteToken != token.Token:
-			fallthrough
-		case refresh.ObsoleteToken == "":
+		case obsolete && s.refreshTokenPolicy.AllowedToReuse(refresh.LastUsed):
+			// The previous token may be retried for a short while after rotation.
+		case obsolete, contains(refresh.RetiredTokens, hashRefreshToken(token.Token)):
 			s.logger.Errorf("refresh token with id %s claimed twice", refresh.ID)
+			s.revokeRefreshTokenFamily(ctx, client, refresh)
 			return nil, invalidErr
+		default:
+			// The token was never issued for this refresh token, so it doesn't
+			// mean the family leaked.
+			s.logger.Errorf("refresh token with id %s presented with an unknown token", refresh.ID)
+			return nil, &refreshError{msg: errInvalidGrant, desc: invalidErr.desc, code: http.StatusBadRequest}
 		}
 	}
 
@@ -106,6 +118,64 @@ func (s *Server) getRefreshTokenFromStorage(clientID string, token *internal.Ref
 	return &refresh, nil
 }
 
+// hashRefreshToken returns the hash a rotated out token is remembered by.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// revokeRefreshTokenFamily is called when a refresh token is replayed. A refresh token
+// keeps its ID when it's rotated, so the ID identifies every token descended from the
+// same grant. A replay means one of them leaked and there's no way to tell whether the
+// caller is the user or an attacker, so the whole family is revoked along with its
+// reference in the offline session.
+func (s *Server) revokeRefreshTokenFamily(ctx context.Context, client storage.Client, refresh storage.RefreshToken) {
+	s.refreshTokenReuseCounter.Inc()
+	s.audit(auditEvent{
+		Type:        auditRefreshTokenReuse,
+		ClientID:    client.ID,
+		ConnectorID: refresh.ConnectorID,
+		UserID:      refresh.Claims.UserID,
+		Details:     fmt.Sprintf("refresh token %s replayed, revoking token family", refresh.ID),
+	})
+
+	s.deleteRefreshToken(refresh)
+
+	if client.BackchannelLogoutURI != "" {
+		if err := s.sendBackchannelLogout(ctx, client, refresh.Claims.UserID, refresh.ConnectorID); err != nil {
+			s.logger.Errorf("failed to send back-channel logout to client %q: %v", client.ID, err)
+		}
+	}
+}
+
+// deleteRefreshToken deletes the refresh token and its reference in the offline
+// session.
+func (s *Server) deleteRefreshToken(refresh storage.RefreshToken) {
+	if err := s.storage.DeleteRefresh(refresh.ID); err != nil && err != storage.ErrNotFound {
+		s.logger.Errorf("failed to delete refresh token: %v", err)
+	}
+
+	var empty bool
+	err := s.storage.UpdateOfflineSessions(refresh.Claims.UserID, refresh.ConnectorID, func(old storage.OfflineSessions) (storage.OfflineSessions, error) {
+		if ref, ok := old.Refresh[refresh.ClientID]; ok && ref.ID == refresh.ID {
+			delete(old.Refresh, refresh.ClientID)
+		}
+		empty = len(old.Refresh) == 0
+		return old, nil
+	})
+	switch {
+	case err == storage.ErrNotFound:
+	case err != nil:
+		s.logger.Errorf("failed to update offline session: %v", err)
+	case empty:
+		// The offline session only holds upstream connector data for the remaining
+		// refresh tokens, drop it once there are none.
+		if err := s.storage.DeleteOfflineSessions(refresh.Claims.UserID, refresh.ConnectorID); err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("failed to delete offline session: %v", err)
+		}
+	}
+}
+
 func (s *Server) getRefreshScopes(r *http.Request, refresh *storage.RefreshToken) ([]string, *refreshError) {
 	// Per the OAuth2 spec, if the client has omitted the scopes, default to the original
 	// authorized scopes.
@@ -240,6 +310,12 @@ func (s *Server) updateRefreshToken(token *internal.RefreshToken, refresh *stora
 				return old, errors.New("refresh token claimed twice")
 			}
 
+			if old.ObsoleteToken != "" {
+				old.RetiredTokens = append(old.RetiredTokens, hashRefreshToken(old.ObsoleteToken))
+				if n := len(old.RetiredTokens); n > maxRetiredRefreshTokens {
+					old.RetiredTokens = old.RetiredTokens[n-maxRetiredRefreshTokens:]
+				}
+			}
 			old.ObsoleteToken = old.Token
 		}
 
@@ -283,7 +359,7 @@ func (s *Server) handleRefreshToken(w http.ResponseWriter, r *http.Request, clie
 		return
 	}
 
-	refresh, rerr := s.getRefreshTokenFromStorage(client.ID, token)
+	refresh, rerr := s.getRefreshTokenFromStorage(r.Context(), client, token)
 	if rerr != nil {
 		s.refreshTokenErrHelper(w, rerr)
 		return
@@ -308,6 +384,8 @@ func (s *Server) handleRefreshToken(w http.ResponseWriter, r *http.Request, clie
 		Email:             ident.Email,
 		EmailVerified:     ident.EmailVerified,
 		Groups:            ident.Groups,
+		// Refreshing never re-authenticates the user, so it keeps the original ACR.
+		ACR: refresh.Claims.ACR,
 	}
 
 	accessToken, err := s.newAccessToken(client.ID, claims, scopes, refresh.Nonce, refresh.ConnectorID)
@@ -11,7 +11,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
 
 	"github.com/dexidp/dex/server/internal"
 	"github.com/dexidp/dex/storage"
@@ -210,3 +212,161 @@ func TestRefreshTokenExpirationScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logoutTokens := make(chan string, 1)
+	clientServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logoutTokens <- r.PostFormValue("logout_token")
+	}))
+	defer clientServer.Close()
+
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.RefreshTokenPolicy = &RefreshTokenPolicy{rotateRefreshTokens: true, now: time.Now}
+	})
+	defer httpServer.Close()
+
+	// The stored token was rotated from "bar" to "testtest", so "bar" is a replay.
+	mockRefreshTokenTestStorage(t, s.storage, true)
+	err := s.storage.UpdateClient("test", func(old storage.Client) (storage.Client, error) {
+		old.BackchannelLogoutURI = clientServer.URL
+		return old, nil
+	})
+	require.NoError(t, err)
+
+	tokenData, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: "bar"})
+	require.NoError(t, err)
+
+	refresh := func(tokenData string) *httptest.ResponseRecorder {
+		v := url.Values{}
+		v.Add("grant_type", "refresh_token")
+		v.Add("refresh_token", tokenData)
+
+		req, _ := http.NewRequest("POST", s.absURL("/token"), bytes.NewBufferString(v.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("test", "barfoo")
+
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := refresh(tokenData)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	_, err = s.storage.GetRefresh("test")
+	require.Equal(t, storage.ErrNotFound, err, "expected refresh token to be revoked")
+	_, err = s.storage.GetOfflineSessions("1", "test")
+	require.Equal(t, storage.ErrNotFound, err, "expected offline session to be revoked")
+	require.Equal(t, float64(1), testutil.ToFloat64(s.refreshTokenReuseCounter))
+
+	// The current token of the family is no longer valid either.
+	currentData, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: "testtest"})
+	require.NoError(t, err)
+	rr = refresh(currentData)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var logoutToken string
+	select {
+	case logoutToken = <-logoutTokens:
+	default:
+		t.Fatal("expected a back-channel logout")
+	}
+	jws, err := jose.ParseSigned(logoutToken)
+	require.NoError(t, err)
+	require.Equal(t, "logout+jwt", jws.Signatures[0].Header.ExtraHeaders[jose.HeaderType])
+	payload, err := jws.Verify(testKey.Public())
+	require.NoError(t, err)
+
+	var claims struct {
+		Subject  string                     `json:"sub"`
+		Audience string                     `json:"aud"`
+		Events   map[string]json.RawMessage `json:"events"`
+	}
+	require.NoError(t, json.Unmarshal(payload, &claims))
+	wantSub, err := internal.Marshal(&internal.IDTokenSubject{UserId: "1", ConnId: "test"})
+	require.NoError(t, err)
+	require.Equal(t, wantSub, claims.Subject)
+	require.Equal(t, "test", claims.Audience)
+	require.Contains(t, claims.Events, backchannelLogoutEvent)
+}
+
+func TestRefreshTokenLineage(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       string
+		wantError   string
+		wantRevoked bool
+	}{
+		{
+			name:        "retired token",
+			token:       "bar",
+			wantError:   errInvalidRequest,
+			wantRevoked: true,
+		},
+		{
+			name:      "unknown token",
+			token:     "bogus",
+			wantError: errInvalidGrant,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			httpServer, s := newTestServer(ctx, t, func(c *Config) {
+				c.RefreshTokenPolicy = &RefreshTokenPolicy{rotateRefreshTokens: true, now: time.Now}
+			})
+			defer httpServer.Close()
+
+			// The stored token was rotated from "bar" to "testtest".
+			mockRefreshTokenTestStorage(t, s.storage, true)
+
+			refresh := func(token string) *httptest.ResponseRecorder {
+				tokenData, err := internal.Marshal(&internal.RefreshToken{RefreshId: "test", Token: token})
+				require.NoError(t, err)
+
+				v := url.Values{}
+				v.Add("grant_type", "refresh_token")
+				v.Add("refresh_token", tokenData)
+
+				req, _ := http.NewRequest("POST", s.absURL("/token"), bytes.NewBufferString(v.Encode()))
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				req.SetBasicAuth("test", "barfoo")
+
+				rr := httptest.NewRecorder()
+				s.ServeHTTP(rr, req)
+				return rr
+			}
+
+			// Rotating again retires "bar" and makes "testtest" the obsolete token.
+			rr := refresh("testtest")
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			stored, err := s.storage.GetRefresh("test")
+			require.NoError(t, err)
+			require.Equal(t, "testtest", stored.ObsoleteToken)
+			require.Equal(t, []string{hashRefreshToken("bar")}, stored.RetiredTokens)
+
+			rr = refresh(tc.token)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			var resp struct {
+				Error string `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+			require.Equal(t, tc.wantError, resp.Error)
+
+			_, err = s.storage.GetRefresh("test")
+			if tc.wantRevoked {
+				require.Equal(t, storage.ErrNotFound, err, "expected refresh token to be revoked")
+				require.Equal(t, float64(1), testutil.ToFloat64(s.refreshTokenReuseCounter))
+				return
+			}
+			require.NoError(t, err, "expected refresh token to be kept")
+			require.Equal(t, float64(0), testutil.ToFloat64(s.refreshTokenReuseCounter))
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// RegistrationConfig lets users create their own account in the password database.
+type RegistrationConfig struct {
+	Enabled bool
+
+	// If set, only email addresses of these domains can sign up, for example
+	// "example.com". Subdomains have to be listed separately.
+	AllowedDomains []string
+
+	// Users who signed up can't log in until an admin approved them through the
+	// gRPC API.
+	RequireApproval bool
+}
+
+func (c RegistrationConfig) domainAllowed(email string) bool {
+	if len(c.AllowedDomains) == 0 {
+		return true
+	}
+	i := strings.LastIndex(email, "@")
+	if i < 0 {
+		return false
+	}
+	domain := email[i+1:]
+	for _, d := range c.AllowedDomains {
+		if strings.EqualFold(d, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// registerURL returns the link to the sign up page shown on the login form of the
+// password database, or an empty string if users can't sign up.
+func (s *Server) registerURL(authReq storage.AuthRequest) string {
+	if !s.registration.Enabled || s.emailSender == nil || authReq.ConnectorID != LocalConnector {
+		return ""
+	}
+	return s.absPath("/register") + "?" + url.Values{"state": {authReq.ID}}.Encode()
+}
+
+func (s *Server) handleRegister(w http.ResponseWriter, r *http.Request) {
+	var backLink string
+	if state := r.URL.Query().Get("state"); state != "" {
+		backLink = s.absPath("/auth", LocalConnector, "login") + "?" + url.Values{"state": {state}}.Encode()
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if err := s.templates.register(r, w, r.URL.String(), backLink, "", "", false, ""); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	case http.MethodPost:
+		email := strings.TrimSpace(r.PostFormValue("email"))
+		username := strings.TrimSpace(r.PostFormValue("username"))
+		password := r.PostFormValue("password")
+
+		var errMsg string
+		switch {
+		case email == "" || !strings.Contains(email, "@"):
+			errMsg = "Please enter a valid email address."
+		case !s.registration.domainAllowed(email):
+			errMsg = "Accounts can't be created for this email address."
+		case username == "":
+			errMsg = "Please enter a username."
+		case password == "" || password != r.PostFormValue("confirm"):
+			errMsg = "The passwords don't match."
+		default:
+			errMsg = strings.Join(s.passwords.Policy.check(password), " ")
+		}
+		if errMsg == "" {
+			taken, err := s.usernameTaken(username, email)
+			if err != nil {
+				s.logger.Errorf("Failed to list passwords: %v", err)
+				s.renderError(r, w, http.StatusInternalServerError, "Failed to create account.")
+				return
+			}
+			if taken {
+				errMsg = "This username is already taken."
+			}
+		}
+		if errMsg != "" {
+			if err := s.templates.register(r, w, r.URL.String(), backLink, email, username, false, errMsg); err != nil {
+				s.logger.Errorf("Server template error: %v", err)
+			}
+			return
+		}
+
+		hash, err := s.passwords.hashPassword(password)
+		if err != nil {
+			s.logger.Errorf("Failed to hash password: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Failed to create account.")
+			return
+		}
+
+		p := storage.Password{
+			Email:               email,
+			Hash:                hash,
+			Username:            username,
+			UserID:              storage.NewID(),
+			PendingVerification: true,
+			PendingApproval:     s.registration.RequireApproval,
+		}
+		switch err := s.storage.CreatePassword(p); err {
+		case nil:
+			s.logger.Infof("account %q signed up, awaiting email verification", email)
+			go s.sendEmailVerification(email, s.clientIP(r))
+		case storage.ErrAlreadyExists:
+			// Answer as if the account was created, so the page can't be used
+			// to find out which email addresses have an account.
+		default:
+			s.logger.Errorf("Failed to create password: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Failed to create account.")
+			return
+		}
+
+		if err := s.templates.register(r, w, r.URL.String(), backLink, "", "", true, ""); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+	}
+}
+
+// usernameTaken reports whether another user of the password database has the
+// username, ignoring case. Signing up again for the same email address isn't
+// refused here, the page mustn't tell which addresses have an account. The
+// storages don't index usernames, so two sign ups at the same time can still
+// get the same one.
+func (s *Server) usernameTaken(username, email string) (bool, error) {
+	passwords, err := s.storage.ListPasswords()
+	if err != nil {
+		return false, err
+	}
+	for _, p := range passwords {
+		if strings.EqualFold(p.Username, username) && !strings.EqualFold(p.Email, email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// sendEmailVerification emails a link confirming the address to a user who just
+// signed up, unless the requesting client address got too many emails.
+func (s *Server) sendEmailVerification(email, ip string) {
+	if !s.emailAllowed(emailAttemptsIPID(ip), maxEmailsPerIP, s.emailVerificationsValidFor) {
+		return
+	}
+	if !s.emailAllowed(emailAttemptsAddressID(email), maxEmailsPerAddress, s.emailVerificationsValidFor) {
+		return
+	}
+	v := storage.EmailVerification{
+		ID:     storage.NewID(),
+		Email:  email,
+		Expiry: s.now().Add(s.emailVerificationsValidFor),
+	}
+	if err := s.storage.CreateEmailVerification(v); err != nil {
+		s.logger.Errorf("Failed to create email verification: %v", err)
+		return
+	}
+
+	link := s.absURL("/register/verify") + "?" + url.Values{"token": {v.ID}}.Encode()
+	body := fmt.Sprintf(`Someone signed up for an account at %s with this email address.
+
+Follow this link within %s to confirm it:
+
+%s
+
+If you didn't sign up, you can ignore this email.
+`, s.issuerURL.String(), s.emailVerificationsValidFor, link)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	err := s.emailSender.SendEmail(ctx, Email{
+		To:      email,
+		Subject: "Confirm your email address",
+		Body:    body,
+	})
+	if err != nil {
+		s.logger.Errorf("Failed to send verification email: %v", err)
+	}
+}
+
+func (s *Server) handleVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		s.renderError(r, w, http.StatusBadRequest, "No email verification token provided.")
+		return
+	}
+
+	v, err := s.storage.GetEmailVerification(token)
+	if err != nil || s.now().After(v.Expiry) {
+		if err != nil && err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to get email verification: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		s.renderError(r, w, http.StatusBadRequest, "This verification link is invalid or has expired.")
+		return
+	}
+
+	if err := s.storage.DeleteEmailVerification(token); err != nil {
+		if err == storage.ErrNotFound {
+			s.renderError(r, w, http.StatusBadRequest, "This verification link is invalid or has expired.")
+			return
+		}
+		s.logger.Errorf("Failed to delete email verification: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+
+	var pendingApproval bool
+	err = s.storage.UpdatePassword(v.Email, func(p storage.Password) (storage.Password, error) {
+		p.PendingVerification = false
+		pendingApproval = p.PendingApproval
+		return p, nil
+	})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			s.renderError(r, w, http.StatusBadRequest, "The account of this verification link doesn't exist anymore.")
+			return
+		}
+		s.logger.Errorf("Failed to update password: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Failed to verify email address.")
+		return
+	}
+	s.logger.Infof("email address %q verified", v.Email)
+
+	if err := s.templates.emailVerified(r, w, pendingApproval); err != nil {
+		s.logger.Errorf("Server template error: %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dexidp/dex/api/v2"
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/storage"
+)
+
+func TestRegistration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	smtpAddr, messages := newTestSMTPServer(t)
+	sender, err := NewSMTPSender(SMTPConfig{Address: smtpAddr, From: "dex@example.com", DisableStartTLS: true})
+	require.NoError(t, err)
+
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.EmailSender = sender
+		c.Registration = RegistrationConfig{
+			Enabled:         true,
+			AllowedDomains:  []string{"example.com"},
+			RequireApproval: true,
+		}
+	})
+	defer httpServer.Close()
+
+	do := func(method, target string, form url.Values) *httptest.ResponseRecorder {
+		var req *http.Request
+		if form != nil {
+			req = httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		} else {
+			req = httptest.NewRequest(method, target, nil)
+		}
+		rr := httptest.NewRecorder()
+		s.ServeHTTP(rr, req)
+		return rr
+	}
+	signUp := func(email, password, confirm string) *httptest.ResponseRecorder {
+		return do(http.MethodPost, "/register", url.Values{
+			"email":    {email},
+			"username": {"jane"},
+			"password": {password},
+			"confirm":  {confirm},
+		})
+	}
+
+	rr := do(http.MethodGet, "/register?state=abc", nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), "/auth/local/login?state=abc")
+
+	rr = signUp("jane@example.org", "secret", "secret")
+	require.Equal(t, http.StatusBadRequest, rr.Code, "domain isn't allowed")
+	rr = signUp("jane@example.com", "secret", "other")
+	require.Equal(t, http.StatusBadRequest, rr.Code, "passwords don't match")
+
+	rr = signUp("jane@Example.COM", "secret", "secret")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	p, err := s.storage.GetPassword("jane@example.com")
+	require.NoError(t, err)
+	require.True(t, p.PendingVerification)
+	require.True(t, p.PendingApproval)
+
+	msg := receiveEmail(t, messages)
+	require.Equal(t, []string{"jane@Example.COM"}, msg.To)
+	link := regexp.MustCompile(`https?://\S+/register/verify\?token=\S+`).FindString(msg.Data)
+	require.NotEmpty(t, link, "no verification link in email:\n%s", msg.Data)
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+
+	// Signing up again for an existing account sends no email.
+	rr = signUp("jane@example.com", "other", "other")
+	require.Equal(t, http.StatusOK, rr.Code)
+	select {
+	case msg := <-messages:
+		t.Fatalf("unexpected email to %v", msg.To)
+	default:
+	}
+
+	// Usernames of other accounts can't be taken.
+	rr = do(http.MethodPost, "/register", url.Values{
+		"email":    {"john@example.com"},
+		"username": {"Jane"},
+		"password": {"secret"},
+		"confirm":  {"secret"},
+	})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "already taken")
+	_, err = s.storage.GetPassword("john@example.com")
+	require.Equal(t, storage.ErrNotFound, err)
+
+	pwDB := newPasswordDB(s.storage, s.passwords, s.logger)
+	_, ok, err := pwDB.Login(ctx, connector.Scopes{}, "jane@example.com", "secret")
+	require.NoError(t, err)
+	require.False(t, ok, "login before approval")
+
+	rr = do(http.MethodGet, u.RequestURI(), nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), "approve")
+
+	// Verification links work only once.
+	rr = do(http.MethodGet, u.RequestURI(), nil)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+
+	dexAPI := NewAPI(s.storage, s.logger, "test", "", s.passwords)
+	resp, err := dexAPI.ApprovePassword(ctx, &api.ApprovePasswordReq{Email: "jane@example.com"})
+	require.NoError(t, err)
+	require.False(t, resp.NotFound)
+
+	identity, ok, err := pwDB.Login(ctx, connector.Scopes{}, "jane@example.com", "secret")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, identity.EmailVerified)
+	require.Equal(t, "jane", identity.Username)
+
+	resp, err = dexAPI.ApprovePassword(ctx, &api.ApprovePasswordReq{Email: "john@example.com"})
+	require.NoError(t, err)
+	require.True(t, resp.NotFound)
+}
+
+func TestUnverifiedPasswordLogin(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, nil)
+	defer httpServer.Close()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	require.NoError(t, s.storage.CreatePassword(storage.Password{
+		Email:               "jane@example.com",
+		Hash:                hash,
+		Username:            "jane",
+		UserID:              "jane-id",
+		PendingVerification: true,
+	}))
+
+	pwDB := newPasswordDB(s.storage, s.passwords, s.logger)
+	identity, ok, err := pwDB.Login(ctx, connector.Scopes{}, "jane@example.com", "password")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.False(t, identity.EmailVerified)
+}
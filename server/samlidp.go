@@ -0,0 +1,599 @@
+package server
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/gorilla/mux"
+	xrv "github.com/mattermost/xml-roundtrip-validator"
+	dsig "github.com/russellhaering/goxmldsig"
+	jose "gopkg.in/square/go-jose.v2"
+
+	"github.com/dexidp/dex/server/internal"
+	"github.com/dexidp/dex/storage"
+)
+
+// SAMLIdPConfig lets the SAML service providers registered through the API log
+// users in with dex.
+type SAMLIdPConfig struct {
+	Enabled bool
+
+	// How long assertions are valid for. Defaults to 5 minutes.
+	AssertionsValidFor time.Duration
+}
+
+const (
+	samlProtocolNS  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	samlAssertionNS = "urn:oasis:names:tc:SAML:2.0:assertion"
+	samlMetadataNS  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	xmlDSigNS       = "http://www.w3.org/2000/09/xmldsig#"
+
+	samlBindingRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	samlBindingPOST     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	// NameID formats service providers can be registered with. The persistent
+	// NameID is the "sub" claim of ID tokens.
+	samlNameIDFormatPersistent = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	samlNameIDFormatEmail      = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+
+	samlStatusSuccess           = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlConfirmationBearer      = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	samlAttrNameFormatBasic     = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+	samlAuthnContextUnspecified = "urn:oasis:names:tc:SAML:2.0:ac:classes:unspecified"
+
+	// responseTypeSAML marks the auth requests of SAML service providers. Clients
+	// can't request it, so these auth requests can't be completed through the
+	// OAuth2 endpoints.
+	responseTypeSAML = "saml"
+
+	samlTimeFormat = "2006-01-02T15:04:05Z"
+)
+
+// errSAMLEmailNotVerified is returned for users whose email address isn't
+// verified when the service provider identifies users by email. Anyone could
+// otherwise claim an address, for example by signing up with it, and take over
+// the account of its owner at the service provider.
+var errSAMLEmailNotVerified = errors.New("email address isn't verified")
+
+// samlAuthnRequest is the part of an AuthnRequest dex looks at.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-core-2.0-os.pdf
+// "3.4.1 Element <AuthnRequest>"
+type samlAuthnRequest struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+
+	ID      string `xml:"ID,attr"`
+	Version string `xml:"Version,attr"`
+
+	ProtocolBinding             string `xml:"ProtocolBinding,attr"`
+	AssertionConsumerServiceURL string `xml:"AssertionConsumerServiceURL,attr"`
+
+	Issuer string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+}
+
+// validateSAMLServiceProvider checks a service provider before it's stored.
+func validateSAMLServiceProvider(sp storage.SAMLServiceProvider) error {
+	if sp.ID == "" {
+		return errors.New("no entity ID supplied")
+	}
+	if len(sp.ACSURLs) == 0 {
+		return errors.New("no assertion consumer service URL supplied")
+	}
+	for _, acsURL := range sp.ACSURLs {
+		u, err := url.Parse(acsURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("assertion consumer service URL %q is not an absolute HTTP(S) URL", acsURL)
+		}
+	}
+	switch sp.NameIDFormat {
+	case "", samlNameIDFormatPersistent, samlNameIDFormatEmail:
+	default:
+		return fmt.Errorf("unsupported NameID format %q", sp.NameIDFormat)
+	}
+	return nil
+}
+
+// samlEntityID is the entity ID of dex as a SAML IdP, which is also the URL of
+// its metadata.
+func (s *Server) samlEntityID() string {
+	return s.absURL("/saml/idp")
+}
+
+// handleSAMLIdPMetadata serves the metadata service providers are configured
+// with. Signing keys rotate, so service providers should load it regularly.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-metadata-2.0-os.pdf
+// "2.4.3 Element <IDPSSODescriptor>"
+func (s *Server) handleSAMLIdPMetadata(w http.ResponseWriter, r *http.Request) {
+	keys, err := s.storage.GetKeys()
+	if err != nil {
+		s.logger.Errorf("failed to get keys: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+		return
+	}
+	signer, err := s.samlSigner(keys)
+	if err != nil {
+		s.logger.Errorf("Failed to create SAML signing certificate: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+		return
+	}
+	// Keys which were rotated out are listed too, so assertions signed just
+	// before a rotation can still be verified.
+	certs := [][]byte{signer.Certificate[0]}
+	for _, key := range keys.VerificationKeys {
+		cert, err := samlCertificate(key.PublicKey, signer.PrivateKey.(*rsa.PrivateKey), s.issuerURL.Host)
+		if err != nil {
+			s.logger.Errorf("Failed to create SAML certificate of key %q: %v", key.PublicKey.KeyID, err)
+			continue
+		}
+		certs = append(certs, cert)
+	}
+
+	doc := etree.NewDocument()
+	ed := doc.CreateElement("md:EntityDescriptor")
+	ed.CreateAttr("xmlns:md", samlMetadataNS)
+	ed.CreateAttr("entityID", s.samlEntityID())
+	idp := ed.CreateElement("md:IDPSSODescriptor")
+	idp.CreateAttr("protocolSupportEnumeration", samlProtocolNS)
+	for _, cert := range certs {
+		kd := idp.CreateElement("md:KeyDescriptor")
+		kd.CreateAttr("use", "signing")
+		keyInfo := kd.CreateElement("ds:KeyInfo")
+		keyInfo.CreateAttr("xmlns:ds", xmlDSigNS)
+		keyInfo.CreateElement("ds:X509Data").CreateElement("ds:X509Certificate").SetText(base64.StdEncoding.EncodeToString(cert))
+	}
+	for _, format := range []string{samlNameIDFormatPersistent, samlNameIDFormatEmail} {
+		idp.CreateElement("md:NameIDFormat").SetText(format)
+	}
+	for _, binding := range []string{samlBindingRedirect, samlBindingPOST} {
+		sso := idp.CreateElement("md:SingleSignOnService")
+		sso.CreateAttr("Binding", binding)
+		sso.CreateAttr("Location", s.absURL("/saml/idp/sso"))
+	}
+	doc.Indent(2)
+
+	data, err := doc.WriteToBytes()
+	if err != nil {
+		s.logger.Errorf("Failed to write SAML IdP metadata: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
+// handleSAMLSSO receives the AuthnRequest of a service provider, with either the
+// HTTP-Redirect or the HTTP-POST binding, and lets the user log in with one of
+// the connectors.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-bindings-2.0-os.pdf
+// "3.4 HTTP Redirect Binding" and "3.5 HTTP POST Binding"
+func (s *Server) handleSAMLSSO(w http.ResponseWriter, r *http.Request) {
+	var (
+		data []byte
+		err  error
+	)
+	switch r.Method {
+	case http.MethodGet:
+		data, err = decodeSAMLRedirect(r.URL.Query().Get("SAMLRequest"))
+	case http.MethodPost:
+		data, err = base64.StdEncoding.DecodeString(r.PostFormValue("SAMLRequest"))
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+		return
+	}
+	if err != nil {
+		s.logger.Errorf("Failed to decode SAML request: %v", err)
+		s.renderError(r, w, http.StatusBadRequest, "Invalid SAML request.")
+		return
+	}
+	req, err := parseSAMLAuthnRequest(data)
+	if err != nil {
+		s.logger.Errorf("Failed to parse SAML request: %v", err)
+		s.renderError(r, w, http.StatusBadRequest, "Invalid SAML request.")
+		return
+	}
+
+	sp, err := s.storage.GetSAMLServiceProvider(req.Issuer)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to get SAML service provider %q: %v", req.Issuer, err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		s.logger.Errorf("SAML request of unknown service provider %q", req.Issuer)
+		s.renderError(r, w, http.StatusBadRequest, "Unknown service provider.")
+		return
+	}
+	// Responses are only sent to registered URLs, that's what keeps them from
+	// anyone who sends a request in the name of the service provider.
+	acsURL, err := samlACSURL(sp, req.AssertionConsumerServiceURL)
+	if err != nil {
+		s.logger.Errorf("SAML request of service provider %q: %v", sp.ID, err)
+		s.renderError(r, w, http.StatusBadRequest, "Unregistered assertion consumer service URL.")
+		return
+	}
+
+	authReq := storage.AuthRequest{
+		ID:            storage.NewID(),
+		ClientID:      sp.ID,
+		ResponseTypes: []string{responseTypeSAML},
+		Scopes:        []string{scopeOpenID, "email", "profile", "groups"},
+		RedirectURI:   acsURL,
+		Nonce:         req.ID,
+		State:         r.FormValue("RelayState"),
+		Expiry:        s.now().Add(s.authRequestsValidFor),
+	}
+	if err := s.storage.CreateAuthRequest(authReq); err != nil {
+		s.logger.Errorf("Failed to create authorization request: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Failed to connect to the database.")
+		return
+	}
+
+	connectors, err := s.storage.ListConnectors()
+	if err != nil {
+		s.logger.Errorf("Failed to get list of connectors: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Failed to retrieve connector list.")
+		return
+	}
+	connURL := func(connID string) string {
+		return s.absPath("/saml/idp/sso", connID) + "?req=" + url.QueryEscape(authReq.ID)
+	}
+	if len(connectors) == 1 && !s.alwaysShowLogin {
+		http.Redirect(w, r, connURL(connectors[0].ID), http.StatusFound)
+		return
+	}
+	connectorInfos := make([]connectorInfo, len(connectors))
+	for i, conn := range connectors {
+		connectorInfos[i] = connectorInfo{
+			ID:   conn.ID,
+			Name: conn.Name,
+			Type: conn.Type,
+			URL:  connURL(conn.ID),
+		}
+	}
+	if err := s.templates.login(r, w, connectorInfos); err != nil {
+		s.logger.Errorf("Server template error: %v", err)
+	}
+}
+
+// handleSAMLConnectorLogin sends the user to the connector they chose to log in
+// to a service provider with.
+func (s *Server) handleSAMLConnectorLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+		return
+	}
+	authReq, err := s.storage.GetAuthRequest(r.URL.Query().Get("req"))
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to get auth request: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		s.renderError(r, w, http.StatusBadRequest, "User session error.")
+		return
+	}
+	if !isSAMLAuthRequest(authReq) || authReq.LoggedIn {
+		s.renderError(r, w, http.StatusBadRequest, "User session error.")
+		return
+	}
+
+	connID := mux.Vars(r)["connector"]
+	conn, err := s.getConnector(connID)
+	if err != nil {
+		s.logger.Errorf("Failed to get connector: %v", err)
+		s.renderError(r, w, http.StatusBadRequest, "Requested resource does not exist")
+		return
+	}
+	updater := func(a storage.AuthRequest) (storage.AuthRequest, error) {
+		a.ConnectorID = connID
+		return a, nil
+	}
+	if err := s.storage.UpdateAuthRequest(authReq.ID, updater); err != nil {
+		s.logger.Errorf("Failed to update auth request: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+	authReq.ConnectorID = connID
+	s.startConnectorLogin(w, r, conn, authReq, "")
+}
+
+func isSAMLAuthRequest(authReq storage.AuthRequest) bool {
+	return len(authReq.ResponseTypes) == 1 && authReq.ResponseTypes[0] == responseTypeSAML
+}
+
+// sendSAMLResponse posts a signed assertion of the logged in user to the
+// service provider. Users aren't asked to approve service providers, which are
+// registered by administrators and only learn the user's identity.
+func (s *Server) sendSAMLResponse(w http.ResponseWriter, r *http.Request, authReq storage.AuthRequest) {
+	if s.now().After(authReq.Expiry) {
+		s.renderError(r, w, http.StatusBadRequest, "User session has expired.")
+		return
+	}
+	if err := s.storage.DeleteAuthRequest(authReq.ID); err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to delete authorization request: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+		} else {
+			s.renderError(r, w, http.StatusBadRequest, "User session error.")
+		}
+		return
+	}
+
+	sp, err := s.storage.GetSAMLServiceProvider(authReq.ClientID)
+	if err != nil {
+		s.logger.Errorf("Failed to get SAML service provider %q: %v", authReq.ClientID, err)
+		s.renderError(r, w, http.StatusInternalServerError, "Failed to retrieve service provider.")
+		return
+	}
+	keys, err := s.storage.GetKeys()
+	if err != nil {
+		s.logger.Errorf("failed to get keys: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+		return
+	}
+	signer, err := s.samlSigner(keys)
+	if err != nil {
+		s.logger.Errorf("Failed to create SAML signing certificate: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+		return
+	}
+
+	resp, err := s.newSAMLResponse(sp, authReq, signer)
+	if errors.Is(err, errSAMLEmailNotVerified) {
+		s.renderError(r, w, http.StatusForbidden, "Your email address must be verified to log in to this application.")
+		return
+	}
+	if err != nil {
+		s.logger.Errorf("Failed to create SAML response for service provider %q: %v", sp.ID, err)
+		s.renderError(r, w, http.StatusInternalServerError, "Internal server error.")
+		return
+	}
+	values := url.Values{"SAMLResponse": {base64.StdEncoding.EncodeToString(resp)}}
+	if authReq.State != "" {
+		values.Set("RelayState", authReq.State)
+	}
+	if err := s.templates.formPost(r, w, authReq.RedirectURI, values); err != nil {
+		s.logger.Errorf("Server template error: %v", err)
+	}
+}
+
+// newSAMLResponse returns a response with a signed assertion of the user's
+// claims.
+//
+// See: https://docs.oasis-open.org/security/saml/v2.0/saml-profiles-2.0-os.pdf
+// "4.1.4.2 <Response> Usage"
+func (s *Server) newSAMLResponse(sp storage.SAMLServiceProvider, authReq storage.AuthRequest, signer tls.Certificate) ([]byte, error) {
+	now := s.now().UTC()
+	issueInstant := now.Format(samlTimeFormat)
+	notOnOrAfter := now.Add(s.samlIdP.AssertionsValidFor).Format(samlTimeFormat)
+	claims := authReq.Claims
+
+	var nameID string
+	nameIDFormat := sp.NameIDFormat
+	switch nameIDFormat {
+	case samlNameIDFormatEmail:
+		if claims.Email == "" {
+			return nil, errors.New("user has no email address for the emailAddress NameID")
+		}
+		if !claims.EmailVerified {
+			return nil, errSAMLEmailNotVerified
+		}
+		nameID = claims.Email
+	default:
+		nameIDFormat = samlNameIDFormatPersistent
+		sub, err := internal.Marshal(&internal.IDTokenSubject{
+			UserId: claims.UserID,
+			ConnId: authReq.ConnectorID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("marshal subject: %v", err)
+		}
+		nameID = sub
+	}
+
+	// The assertion is signed on its own, so it declares the namespace it uses
+	// itself rather than relying on the response.
+	assertionDoc := etree.NewDocument()
+	assertion := assertionDoc.CreateElement("saml:Assertion")
+	assertion.CreateAttr("xmlns:saml", samlAssertionNS)
+	assertion.CreateAttr("ID", "_"+storage.NewID())
+	assertion.CreateAttr("Version", "2.0")
+	assertion.CreateAttr("IssueInstant", issueInstant)
+	issuer := assertion.CreateElement("saml:Issuer")
+	issuer.SetText(s.samlEntityID())
+
+	subject := assertion.CreateElement("saml:Subject")
+	nameIDEl := subject.CreateElement("saml:NameID")
+	nameIDEl.CreateAttr("Format", nameIDFormat)
+	nameIDEl.SetText(nameID)
+	confirmation := subject.CreateElement("saml:SubjectConfirmation")
+	confirmation.CreateAttr("Method", samlConfirmationBearer)
+	data := confirmation.CreateElement("saml:SubjectConfirmationData")
+	data.CreateAttr("InResponseTo", authReq.Nonce)
+	data.CreateAttr("NotOnOrAfter", notOnOrAfter)
+	data.CreateAttr("Recipient", authReq.RedirectURI)
+
+	conditions := assertion.CreateElement("saml:Conditions")
+	conditions.CreateAttr("NotBefore", issueInstant)
+	conditions.CreateAttr("NotOnOrAfter", notOnOrAfter)
+	conditions.CreateElement("saml:AudienceRestriction").CreateElement("saml:Audience").SetText(sp.ID)
+
+	authnStatement := assertion.CreateElement("saml:AuthnStatement")
+	authnStatement.CreateAttr("AuthnInstant", issueInstant)
+	authnStatement.CreateAttr("SessionIndex", authReq.ID)
+	authnContext := authnStatement.CreateElement("saml:AuthnContext")
+	authnContext.CreateElement("saml:AuthnContextClassRef").SetText(samlAuthnContextUnspecified)
+
+	// Unverified addresses are left out, service providers may trust them.
+	var email string
+	if claims.EmailVerified {
+		email = claims.Email
+	}
+	attributes := []struct {
+		claim  string
+		values []string
+	}{
+		{"email", []string{email}},
+		{"name", []string{claims.Username}},
+		{"preferred_username", []string{claims.PreferredUsername}},
+		{"groups", claims.Groups},
+	}
+	var statement *etree.Element
+	for _, a := range attributes {
+		var values []string
+		for _, v := range a.values {
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		if statement == nil {
+			statement = assertion.CreateElement("saml:AttributeStatement")
+		}
+		name := a.claim
+		if n, ok := sp.AttributeNames[a.claim]; ok && n != "" {
+			name = n
+		}
+		attr := statement.CreateElement("saml:Attribute")
+		attr.CreateAttr("Name", name)
+		attr.CreateAttr("NameFormat", samlAttrNameFormatBasic)
+		for _, v := range values {
+			attr.CreateElement("saml:AttributeValue").SetText(v)
+		}
+	}
+
+	ctx := dsig.NewDefaultSigningContext(dsig.TLSCertKeyStore(signer))
+	ctx.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	sig, err := ctx.ConstructSignature(assertion, true)
+	if err != nil {
+		return nil, fmt.Errorf("sign assertion: %v", err)
+	}
+	// The schema requires the signature to follow the issuer.
+	assertion.InsertChildAt(issuer.Index()+1, sig)
+
+	doc := etree.NewDocument()
+	resp := doc.CreateElement("samlp:Response")
+	resp.CreateAttr("xmlns:samlp", samlProtocolNS)
+	resp.CreateAttr("xmlns:saml", samlAssertionNS)
+	resp.CreateAttr("ID", "_"+storage.NewID())
+	resp.CreateAttr("InResponseTo", authReq.Nonce)
+	resp.CreateAttr("Version", "2.0")
+	resp.CreateAttr("IssueInstant", issueInstant)
+	resp.CreateAttr("Destination", authReq.RedirectURI)
+	resp.CreateElement("saml:Issuer").SetText(s.samlEntityID())
+	resp.CreateElement("samlp:Status").CreateElement("samlp:StatusCode").CreateAttr("Value", samlStatusSuccess)
+	resp.AddChild(assertion)
+	return doc.WriteToBytes()
+}
+
+// samlSigner returns the current signing key with its certificate.
+func (s *Server) samlSigner(keys storage.Keys) (tls.Certificate, error) {
+	if keys.SigningKey == nil || keys.SigningKeyPub == nil {
+		return tls.Certificate{}, errors.New("no signing key")
+	}
+	// Signatures of XML documents are RSA signatures in practice.
+	priv, ok := keys.SigningKey.Key.(*rsa.PrivateKey)
+	if !ok {
+		return tls.Certificate{}, fmt.Errorf("unsupported signing key type %T", keys.SigningKey.Key)
+	}
+	cert, err := samlCertificate(keys.SigningKeyPub, priv, s.issuerURL.Host)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{cert}, PrivateKey: priv}, nil
+}
+
+// samlCertificate returns a certificate of one of dex's public keys, which is
+// how SAML metadata and signatures carry keys. Service providers only use the
+// key, so the certificate is signed with the current signing key and is valid
+// for as long as the key is around.
+func samlCertificate(pub *jose.JSONWebKey, signer *rsa.PrivateKey, host string) ([]byte, error) {
+	// Serial numbers are positive and at most 20 bytes long.
+	sum := sha256.Sum256([]byte(pub.KeyID))
+	serial := new(big.Int).SetBytes(sum[:16])
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: host},
+		NotBefore:    time.Unix(0, 0).UTC(),
+		// The value for certificates without an expiry, see RFC 5280 section 4.1.2.5.
+		NotAfter: time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC),
+		KeyUsage: x509.KeyUsageDigitalSignature,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub.Key, signer)
+	if err != nil {
+		return nil, fmt.Errorf("create certificate: %v", err)
+	}
+	return cert, nil
+}
+
+// decodeSAMLRedirect decodes a message of the HTTP-Redirect binding, which is
+// deflated and base64 encoded. Signatures of the query are ignored, responses
+// only go to registered URLs.
+func decodeSAMLRedirect(value string) ([]byte, error) {
+	compressed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	// A request is a few kilobytes, don't inflate more than that.
+	return io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(compressed)), 1<<20))
+}
+
+func parseSAMLAuthnRequest(data []byte) (*samlAuthnRequest, error) {
+	if err := xrv.Validate(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("validate XML: %v", err)
+	}
+	var req samlAuthnRequest
+	if err := xml.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+	switch {
+	case req.Version != "2.0":
+		return nil, fmt.Errorf("unsupported version %q", req.Version)
+	case req.ID == "":
+		return nil, errors.New("no ID")
+	case req.Issuer == "":
+		return nil, errors.New("no issuer")
+	case req.ProtocolBinding != "" && req.ProtocolBinding != samlBindingPOST:
+		return nil, fmt.Errorf("unsupported protocol binding %q", req.ProtocolBinding)
+	}
+	return &req, nil
+}
+
+// samlACSURL returns the URL to post the response to: the requested one, which
+// must be registered for the service provider, or the default one.
+func samlACSURL(sp storage.SAMLServiceProvider, requested string) (string, error) {
+	if len(sp.ACSURLs) == 0 {
+		return "", errors.New("no assertion consumer service URL registered")
+	}
+	if requested == "" {
+		return sp.ACSURLs[0], nil
+	}
+	for _, u := range sp.ACSURLs {
+		if u == requested {
+			return u, nil
+		}
+	}
+	return "", fmt.Errorf("assertion consumer service URL %q isn't registered", requested)
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dexidp/dex/connector"
+	"github.com/dexidp/dex/connector/saml"
+	"github.com/dexidp/dex/storage"
+)
+
+var samlResponseInput = regexp.MustCompile(`name="SAMLResponse" value="([^"]+)"`)
+
+// postedSAMLResponse returns the response the form of the page posts to the
+// service provider.
+func postedSAMLResponse(t *testing.T, resp *http.Response) string {
+	t.Helper()
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	m := samlResponseInput.FindSubmatch(body)
+	require.NotNil(t, m, "no SAML response in %s", body)
+	return html.UnescapeString(string(m[1]))
+}
+
+func TestSAMLIdP(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.SAMLIdP.Enabled = true
+	})
+	defer httpServer.Close()
+
+	const acsURL = "https://app.example.com/saml/acs"
+	sp := storage.SAMLServiceProvider{
+		ID:      "https://app.example.com/saml/metadata",
+		Name:    "Example App",
+		ACSURLs: []string{acsURL},
+	}
+	require.NoError(t, s.storage.CreateSAMLServiceProvider(sp))
+
+	// dex's own SAML connector is the service provider, configured with the
+	// metadata of the IdP. The test server doesn't use https, so the metadata
+	// is passed as a file.
+	newSP := func(t *testing.T) connector.SAMLConnector {
+		resp, err := http.Get(httpServer.URL + "/saml/idp")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		metadata, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		metadataFile := filepath.Join(t.TempDir(), "metadata.xml")
+		require.NoError(t, os.WriteFile(metadataFile, metadata, 0o600))
+
+		conn, err := (&saml.Config{
+			IDPMetadataFile: metadataFile,
+			EntityIssuer:    sp.ID,
+			RedirectURI:     acsURL,
+			UsernameAttr:    "name",
+			EmailAttr:       "email",
+			GroupsAttr:      "memberOf",
+		}).Open("saml", logger)
+		require.NoError(t, err)
+		return conn.(connector.SAMLConnector)
+	}
+	scopes := connector.Scopes{Groups: true}
+
+	t.Run("POST binding", func(t *testing.T) {
+		require.NoError(t, s.storage.UpdateSAMLServiceProvider(sp.ID, func(old storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error) {
+			old.AttributeNames = map[string]string{"groups": "memberOf"}
+			return old, nil
+		}))
+		spConn := newSP(t)
+		action, value, err := spConn.POSTData(scopes, "request-1")
+		require.NoError(t, err)
+		require.Equal(t, httpServer.URL+"/saml/idp/sso", action)
+
+		resp, err := http.PostForm(action, url.Values{"SAMLRequest": {value}, "RelayState": {"relay"}})
+		require.NoError(t, err)
+		ident, err := spConn.HandlePOST(scopes, postedSAMLResponse(t, resp), "request-1")
+		require.NoError(t, err)
+
+		require.Equal(t, "Kilgore Trout", ident.Username)
+		require.Equal(t, "kilgore@kilgore.trout", ident.Email)
+		require.Equal(t, []string{"authors"}, ident.Groups)
+		require.NotEmpty(t, ident.UserID)
+	})
+
+	t.Run("redirect binding", func(t *testing.T) {
+		require.NoError(t, s.storage.UpdateSAMLServiceProvider(sp.ID, func(old storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error) {
+			old.NameIDFormat = samlNameIDFormatEmail
+			return old, nil
+		}))
+		spConn := newSP(t)
+		_, value, err := spConn.POSTData(scopes, "request-2")
+		require.NoError(t, err)
+		req, err := base64.StdEncoding.DecodeString(value)
+		require.NoError(t, err)
+		var deflated bytes.Buffer
+		fw, err := flate.NewWriter(&deflated, flate.DefaultCompression)
+		require.NoError(t, err)
+		_, err = fw.Write(req)
+		require.NoError(t, err)
+		require.NoError(t, fw.Close())
+
+		q := url.Values{"SAMLRequest": {base64.StdEncoding.EncodeToString(deflated.Bytes())}}
+		resp, err := http.Get(httpServer.URL + "/saml/idp/sso?" + q.Encode())
+		require.NoError(t, err)
+		ident, err := spConn.HandlePOST(scopes, postedSAMLResponse(t, resp), "request-2")
+		require.NoError(t, err)
+		require.Equal(t, "kilgore@kilgore.trout", ident.UserID)
+	})
+
+	t.Run("rejected requests", func(t *testing.T) {
+		for name, req := range map[string]string{
+			"unknown service provider": `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="id" Version="2.0">
+  <saml:Issuer>https://other.example.com</saml:Issuer>
+</samlp:AuthnRequest>`,
+			"unregistered ACS URL": `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="id" Version="2.0" AssertionConsumerServiceURL="https://evil.example.com/acs">
+  <saml:Issuer>https://app.example.com/saml/metadata</saml:Issuer>
+</samlp:AuthnRequest>`,
+			"no ID": `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" Version="2.0">
+  <saml:Issuer>https://app.example.com/saml/metadata</saml:Issuer>
+</samlp:AuthnRequest>`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				resp, err := http.PostForm(httpServer.URL+"/saml/idp/sso", url.Values{"SAMLRequest": {base64.StdEncoding.EncodeToString([]byte(req))}})
+				require.NoError(t, err)
+				resp.Body.Close()
+				require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			})
+		}
+	})
+}
+
+func TestSAMLResponseUnverifiedEmail(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.SAMLIdP.Enabled = true
+	})
+	defer httpServer.Close()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	require.NoError(t, s.storage.CreatePassword(storage.Password{
+		Email:               "jane@example.com",
+		Hash:                hash,
+		Username:            "jane",
+		UserID:              "jane-id",
+		PendingVerification: true,
+	}))
+	identity, ok, err := newPasswordDB(s.storage, s.passwords, s.logger).Login(ctx, connector.Scopes{}, "jane@example.com", "password")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	keys, err := s.storage.GetKeys()
+	require.NoError(t, err)
+	signer, err := s.samlSigner(keys)
+	require.NoError(t, err)
+
+	authReq := storage.AuthRequest{
+		ID:          "request",
+		ClientID:    "https://app.example.com/saml/metadata",
+		RedirectURI: "https://app.example.com/saml/acs",
+		ConnectorID: LocalConnector,
+		Claims: storage.Claims{
+			UserID:        identity.UserID,
+			Username:      identity.Username,
+			Email:         identity.Email,
+			EmailVerified: identity.EmailVerified,
+		},
+	}
+	sp := storage.SAMLServiceProvider{ID: authReq.ClientID, ACSURLs: []string{authReq.RedirectURI}}
+
+	// The persistent NameID doesn't depend on the email, which is left out.
+	resp, err := s.newSAMLResponse(sp, authReq, signer)
+	require.NoError(t, err)
+	require.Contains(t, string(resp), "jane")
+	require.NotContains(t, string(resp), "jane@example.com")
+
+	sp.NameIDFormat = samlNameIDFormatEmail
+	_, err = s.newSAMLResponse(sp, authReq, signer)
+	require.ErrorIs(t, err, errSAMLEmailNotVerified)
+
+	authReq.Claims.EmailVerified = true
+	resp, err = s.newSAMLResponse(sp, authReq, signer)
+	require.NoError(t, err)
+	require.Contains(t, string(resp), "jane@example.com")
+}
@@ -1,11 +1,14 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"crypto/cipher"
 	"crypto/rsa"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"net/http"
 	"net/url"
@@ -20,10 +23,10 @@ import (
 
 	gosundheit "github.com/AppsFlyer/go-sundheit"
 	"github.com/felixge/httpsnoop"
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
-	"golang.org/x/crypto/bcrypt"
 
 	"github.com/dexidp/dex/connector"
 	"github.com/dexidp/dex/connector/atlassiancrowd"
@@ -82,10 +85,29 @@ type Config struct {
 	// If enabled, the connectors selection page will always be shown even if there's only one
 	AlwaysShowLoginScreen bool
 
-	RotateKeysAfter        time.Duration // Defaults to 6 hours.
-	IDTokensValidFor       time.Duration // Defaults to 24 hours
-	AuthRequestsValidFor   time.Duration // Defaults to 24 hours
-	DeviceRequestsValidFor time.Duration // Defaults to 5 minutes
+	RotateKeysAfter             time.Duration // Defaults to 6 hours.
+	IDTokensValidFor            time.Duration // Defaults to 24 hours
+	AuthRequestsValidFor        time.Duration // Defaults to 24 hours
+	DeviceRequestsValidFor      time.Duration // Defaults to 5 minutes
+	BackchannelRequestsValidFor time.Duration // Defaults to 5 minutes
+	PasswordResetsValidFor      time.Duration // Defaults to 1 hour
+	EmailVerificationsValidFor  time.Duration // Defaults to 24 hours
+
+	// If set, users of the password database can reset their password through a
+	// link sent to their email address.
+	EmailSender EmailSender
+
+	// Lets users sign up for the password database. Requires an EmailSender to
+	// verify their email addresses.
+	Registration RegistrationConfig
+
+	// How passwords of the password database are hashed and which passwords users
+	// can choose.
+	Passwords PasswordConfig
+
+	// If set, the server supports client initiated backchannel authentication (CIBA)
+	// and uses the notifier to ask users to authenticate those requests.
+	BackchannelNotifier BackchannelNotifier
 
 	// Refresh token expiration settings
 	RefreshTokenPolicy *RefreshTokenPolicy
@@ -93,6 +115,24 @@ type Config struct {
 	// If set, the server will use this connector to handle password grants
 	PasswordConnector string
 
+	// Salt used to derive subjects for clients with the "pairwise" subject type.
+	PairwiseSubjectSalt string
+
+	// Authentication context classes clients can request, from weakest to strongest.
+	ACRLevels []ACRLevel
+
+	// TOTP second factor asked for after logging in with a connector.
+	TOTP TOTPConfig
+
+	// WebAuthn credentials for the users of the local password database.
+	WebAuthn WebAuthnConfig
+
+	// Lockout of usernames and client addresses after failed password logins.
+	Lockout LockoutConfig
+
+	// SAML 2.0 identity provider for the service providers registered through the API.
+	SAMLIdP SAMLIdPConfig
+
 	GCFrequency time.Duration // Defaults to 5 minutes
 
 	// If specified, the server will use this function for determining time.
@@ -175,9 +215,37 @@ type Server struct {
 
 	now func() time.Time
 
-	idTokensValidFor       time.Duration
-	authRequestsValidFor   time.Duration
-	deviceRequestsValidFor time.Duration
+	idTokensValidFor            time.Duration
+	authRequestsValidFor        time.Duration
+	deviceRequestsValidFor      time.Duration
+	backchannelRequestsValidFor time.Duration
+	passwordResetsValidFor      time.Duration
+	emailVerificationsValidFor  time.Duration
+
+	emailSender  EmailSender
+	registration RegistrationConfig
+	passwords    PasswordConfig
+
+	// Used for client initiated backchannel authentication
+	backchannelNotifier BackchannelNotifier
+	backchannelClient   *http.Client
+
+	pairwiseSubjectSalt string
+
+	acrLevels []ACRLevel
+
+	totp     TOTPConfig
+	totpAEAD cipher.AEAD
+
+	webAuthnConfig WebAuthnConfig
+	webAuthn       *webauthn.WebAuthn
+
+	lockout LockoutConfig
+
+	samlIdP SAMLIdPConfig
+
+	refreshTokenReuseCounter prometheus.Counter
+	loginLockoutCounter      *prometheus.CounterVec
 
 	refreshTokenPolicy *RefreshTokenPolicy
 
@@ -213,6 +281,14 @@ func newServer(ctx context.Context, c Config, rotationStrategy rotationStrategy)
 		c.SupportedResponseTypes = []string{responseTypeCode}
 	}
 
+	if err := validateACRLevels(c.ACRLevels); err != nil {
+		return nil, fmt.Errorf("server: %v", err)
+	}
+
+	if !validPasswordHashAlgorithm(c.Passwords.HashAlgorithm) {
+		return nil, fmt.Errorf("server: unsupported password hash algorithm %q", c.Passwords.HashAlgorithm)
+	}
+
 	supportedGrant := []string{grantTypeAuthorizationCode, grantTypeRefreshToken, grantTypeDeviceCode} // default
 	supportedRes := make(map[string]bool)
 
@@ -234,6 +310,10 @@ func newServer(ctx context.Context, c Config, rotationStrategy rotationStrategy)
 		supportedGrant = append(supportedGrant, grantTypePassword)
 	}
 
+	if c.BackchannelNotifier != nil {
+		supportedGrant = append(supportedGrant, grantTypeCIBA)
+	}
+
 	sort.Strings(supportedGrant)
 
 	webFS := web.FS()
@@ -278,6 +358,54 @@ func newServer(ctx context.Context, c Config, rotationStrategy rotationStrategy)
 		templates:              tmpls,
 		passwordConnector:      c.PasswordConnector,
 		logger:                 c.Logger,
+
+		backchannelRequestsValidFor: value(c.BackchannelRequestsValidFor, 5*time.Minute),
+		passwordResetsValidFor:      value(c.PasswordResetsValidFor, time.Hour),
+		emailVerificationsValidFor:  value(c.EmailVerificationsValidFor, 24*time.Hour),
+		emailSender:                 c.EmailSender,
+		registration:                c.Registration,
+		passwords:                   c.Passwords,
+		backchannelNotifier:         c.BackchannelNotifier,
+		backchannelClient:           &http.Client{Timeout: 10 * time.Second},
+		pairwiseSubjectSalt:         c.PairwiseSubjectSalt,
+		acrLevels:                   c.ACRLevels,
+		totp:                        c.TOTP,
+		webAuthnConfig:              c.WebAuthn,
+		refreshTokenReuseCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "refresh_token_reuse_total",
+			Help: "Count of replayed refresh tokens which caused their token family to be revoked.",
+		}),
+		loginLockoutCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "login_lockouts_total",
+			Help: "Count of login lockouts, by whether a username, a client address, or the TOTP codes of a user or an auth request were locked.",
+		}, []string{"type"}),
+	}
+
+	s.lockout = c.Lockout
+	s.lockout.BaseDelay = value(c.Lockout.BaseDelay, time.Minute)
+	s.lockout.MaxDelay = value(c.Lockout.MaxDelay, time.Hour)
+	s.lockout.ResetAfter = value(c.Lockout.ResetAfter, 24*time.Hour)
+	s.samlIdP = c.SAMLIdP
+	s.samlIdP.AssertionsValidFor = value(c.SAMLIdP.AssertionsValidFor, 5*time.Minute)
+
+	if c.TOTP.EncryptionKey != "" {
+		if s.totpAEAD, err = newTOTPAEAD(c.TOTP.EncryptionKey); err != nil {
+			return nil, fmt.Errorf("server: failed to create TOTP cipher: %v", err)
+		}
+		if s.totp.Issuer == "" {
+			s.totp.Issuer = issuerURL.Host
+		}
+		if s.totp.MaxFailures <= 0 {
+			s.totp.MaxFailures = 5
+		}
+	} else if len(c.TOTP.Connectors) > 0 || len(c.TOTP.Clients) > 0 {
+		return nil, errors.New("server: TOTP requires an encryption key")
+	}
+
+	if c.WebAuthn.enabled() {
+		if s.webAuthn, err = newWebAuthn(c.WebAuthn, *issuerURL); err != nil {
+			return nil, fmt.Errorf("server: failed to configure WebAuthn: %v", err)
+		}
 	}
 
 	// Retrieves connector objects in backend storage. This list includes the static connectors
@@ -312,6 +440,22 @@ func newServer(ctx context.Context, c Config, rotationStrategy rotationStrategy)
 			return nil, fmt.Errorf("server: Failed to register Prometheus HTTP metrics: %v", err)
 		}
 
+		err = c.PrometheusRegistry.Register(s.refreshTokenReuseCounter)
+		if err != nil {
+			return nil, fmt.Errorf("server: Failed to register Prometheus refresh token metrics: %v", err)
+		}
+
+		err = c.PrometheusRegistry.Register(s.loginLockoutCounter)
+		if err != nil {
+			return nil, fmt.Errorf("server: Failed to register Prometheus login lockout metrics: %v", err)
+		}
+
+		for _, collector := range ldap.Collectors() {
+			if err := c.PrometheusRegistry.Register(collector); err != nil {
+				return nil, fmt.Errorf("server: Failed to register Prometheus LDAP metrics: %v", err)
+			}
+		}
+
 		instrumentHandlerCounter = func(handlerName string, handler http.Handler) http.HandlerFunc {
 			return func(w http.ResponseWriter, r *http.Request) {
 				m := httpsnoop.CaptureMetrics(handler, w, r)
@@ -331,7 +475,7 @@ func newServer(ctx context.Context, c Config, rotationStrategy rotationStrategy)
 		prefix := path.Join(issuerURL.Path, p)
 		r.PathPrefix(prefix).Handler(http.StripPrefix(prefix, h))
 	}
-	handleWithCORS := func(p string, h http.HandlerFunc) {
+	withCORS := func(h http.HandlerFunc) http.Handler {
 		var handler http.Handler = h
 		if len(c.AllowedOrigins) > 0 {
 			allowedHeaders := []string{
@@ -343,7 +487,10 @@ func newServer(ctx context.Context, c Config, rotationStrategy rotationStrategy)
 			)
 			handler = cors(handler)
 		}
-		r.Handle(path.Join(issuerURL.Path, p), instrumentHandlerCounter(p, handler))
+		return handler
+	}
+	handleWithCORS := func(p string, h http.HandlerFunc) {
+		r.Handle(path.Join(issuerURL.Path, p), instrumentHandlerCounter(p, withCORS(h)))
 	}
 	r.NotFoundHandler = http.NotFoundHandler()
 
@@ -352,20 +499,38 @@ func newServer(ctx context.Context, c Config, rotationStrategy rotationStrategy)
 		return nil, err
 	}
 	handleWithCORS("/.well-known/openid-configuration", discoveryHandler)
+	handleWithCORS("/.well-known/oauth-authorization-server", discoveryHandler)
+	// RFC 8414 inserts the well-known path between the host and the issuer's path
+	// rather than appending it, so issuers with a path are also served from there.
+	if issuerPath := strings.TrimSuffix(issuerURL.Path, "/"); issuerPath != "" {
+		p := "/.well-known/oauth-authorization-server" + issuerPath
+		r.Handle(p, instrumentHandlerCounter(p, withCORS(discoveryHandler)))
+	}
+	// WebFinger is always served from the root of the host.
+	r.Handle("/.well-known/webfinger", instrumentHandlerCounter("/.well-known/webfinger", withCORS(s.handleWebfinger)))
 
 	// TODO(ericchiang): rate limit certain paths based on IP.
 	handleWithCORS("/token", s.handleToken)
+	handleWithCORS("/token/revoke", s.handleRevocation)
+	handleWithCORS("/token/introspect", s.handleIntrospection)
 	handleWithCORS("/keys", s.handlePublicKeys)
 	handleWithCORS("/userinfo", s.handleUserInfo)
 	handleFunc("/auth", s.handleAuthorization)
 	handleFunc("/auth/{connector}", s.handleConnectorLogin)
 	handleFunc("/auth/{connector}/login", s.handlePasswordLogin)
+	handleFunc("/auth/{connector}/chain", s.handleConnectorChain)
+	handleFunc("/auth/{connector}/password", s.handleChangePassword)
 	handleFunc("/device", s.handleDeviceExchange)
 	handleFunc("/device/auth/verify_code", s.verifyUserCode)
 	handleFunc("/device/code", s.handleDeviceCode)
 	// TODO(nabokihms): "/device/token" endpoint is deprecated, consider using /token endpoint instead
 	handleFunc("/device/token", s.handleDeviceTokenDeprecated)
 	handleFunc(deviceCallbackURI, s.handleDeviceCallback)
+	if s.backchannelNotifier != nil {
+		handleFunc("/bc-authorize", s.handleBackchannelAuth)
+		handleFunc("/bc-authorize/verify", s.handleBackchannelVerify)
+		handleFunc(backchannelCallbackURI, s.handleBackchannelCallback)
+	}
 	r.HandleFunc(path.Join(issuerURL.Path, "/callback"), func(w http.ResponseWriter, r *http.Request) {
 		// Strip the X-Remote-* headers to prevent security issues on
 		// misconfigured authproxy connector setups.
@@ -379,7 +544,26 @@ func newServer(ctx context.Context, c Config, rotationStrategy rotationStrategy)
 	// For easier connector-specific web server configuration, e.g. for the
 	// "authproxy" connector.
 	handleFunc("/callback/{connector}", s.handleConnectorCallback)
+	handleFunc("/saml/{connector}/metadata", s.handleSAMLMetadata)
+	if c.SAMLIdP.Enabled {
+		handleFunc("/saml/idp", s.handleSAMLIdPMetadata)
+		handleFunc("/saml/idp/sso", s.handleSAMLSSO)
+		handleFunc("/saml/idp/sso/{connector}", s.handleSAMLConnectorLogin)
+	}
 	handleFunc("/approval", s.handleApproval)
+	handleFunc("/totp", s.handleTOTP)
+	handleFunc("/webauthn/login", s.handleWebAuthnLogin)
+	handleFunc("/webauthn/login/options", s.handleWebAuthnLoginOptions)
+	handleFunc("/webauthn/register", s.handleWebAuthnRegister)
+	handleFunc("/webauthn/register/options", s.handleWebAuthnRegisterOptions)
+	if c.EmailSender != nil {
+		handleFunc("/password/forgot", s.handleForgotPassword)
+		handleFunc("/password/reset", s.handleResetPassword)
+		if c.Registration.Enabled {
+			handleFunc("/register", s.handleRegister)
+			handleFunc("/register/verify", s.handleVerifyEmail)
+		}
+	}
 	handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if !c.HealthChecker.IsHealthy() {
 			s.renderError(r, w, http.StatusInternalServerError, "Health check failed.")
@@ -415,15 +599,17 @@ func (s *Server) absURL(pathItems ...string) string {
 	return u.String()
 }
 
-func newPasswordDB(s storage.Storage) interface {
+func newPasswordDB(s storage.Storage, passwords PasswordConfig, logger log.Logger) interface {
 	connector.Connector
 	connector.PasswordConnector
 } {
-	return passwordDB{s}
+	return passwordDB{s, passwords, logger}
 }
 
 type passwordDB struct {
-	s storage.Storage
+	s         storage.Storage
+	passwords PasswordConfig
+	logger    log.Logger
 }
 
 func (db passwordDB) Login(ctx context.Context, s connector.Scopes, email, password string) (connector.Identity, bool, error) {
@@ -436,18 +622,60 @@ func (db passwordDB) Login(ctx context.Context, s connector.Scopes, email, passw
 	}
 	// This check prevents dex users from logging in using static passwords
 	// configured with hash costs that are too high or low.
-	if err := checkCost(p.Hash); err != nil {
+	if err := checkPasswordHash(p.Hash); err != nil {
 		return connector.Identity{}, false, err
 	}
-	if err := bcrypt.CompareHashAndPassword(p.Hash, []byte(password)); err != nil {
+	if ok, _ := comparePassword(p.Hash, password); !ok {
 		return connector.Identity{}, false, nil
 	}
-	return connector.Identity{
+	// Users who signed up themselves can't log in before an admin approved them.
+	if p.PendingApproval {
+		return connector.Identity{}, false, nil
+	}
+	if db.passwords.needsRehash(p.Hash) {
+		db.rehash(p, password)
+	}
+	return passwordIdentity(s, p), true, nil
+}
+
+// rehash replaces the hash of a user's password with one of the configured
+// algorithm, now that the plain text password is known.
+func (db passwordDB) rehash(p storage.Password, password string) {
+	hash, err := db.passwords.hashPassword(password)
+	if err != nil {
+		db.logger.Errorf("failed to rehash password: %v", err)
+		return
+	}
+	err = db.s.UpdatePassword(p.Email, func(old storage.Password) (storage.Password, error) {
+		// Keep a password which was changed in the meantime.
+		if bytes.Equal(old.Hash, p.Hash) {
+			old.Hash = hash
+		}
+		return old, nil
+	})
+	if err != nil {
+		// Static passwords can't be updated and keep their configured hash.
+		db.logger.Debugf("failed to rehash password: %v", err)
+	}
+}
+
+// passwordIdentity returns the identity of a user of the password database.
+func passwordIdentity(s connector.Scopes, p storage.Password) connector.Identity {
+	identity := connector.Identity{
 		UserID:        p.UserID,
 		Username:      p.Username,
 		Email:         p.Email,
-		EmailVerified: true,
-	}, true, nil
+		EmailVerified: !p.PendingVerification,
+		Attributes:    p.Attributes,
+	}
+	if p.DisplayName != "" {
+		identity.Username = p.DisplayName
+		identity.PreferredUsername = p.Username
+	}
+	if s.Groups {
+		identity.Groups = p.Groups
+	}
+	return identity
 }
 
 func (db passwordDB) Refresh(ctx context.Context, s connector.Scopes, identity connector.Identity) (connector.Identity, error) {
@@ -464,15 +692,14 @@ func (db passwordDB) Refresh(ctx context.Context, s connector.Scopes, identity c
 	if p.UserID != identity.UserID {
 		return connector.Identity{}, errors.New("user not found")
 	}
+	if p.PendingApproval {
+		return connector.Identity{}, errors.New("user not approved")
+	}
 
-	// If a user has updated their username, that will be reflected in the
-	// refreshed token.
-	//
-	// No other fields are expected to be refreshable as email is effectively used
-	// as an ID and this implementation doesn't deal with groups.
-	identity.Username = p.Username
-
-	return identity, nil
+	// Changes to the username, display name, groups and attributes are reflected
+	// in the refreshed identity. The email is effectively used as an ID and can't
+	// change.
+	return passwordIdentity(s, p), nil
 }
 
 func (db passwordDB) Prompt() string {
@@ -521,8 +748,8 @@ func (s *Server) startGarbageCollection(ctx context.Context, frequency time.Dura
 				if r, err := s.storage.GarbageCollect(now()); err != nil {
 					s.logger.Errorf("garbage collection failed: %v", err)
 				} else if !r.IsEmpty() {
-					s.logger.Infof("garbage collection run, delete auth requests=%d, auth codes=%d, device requests=%d, device tokens=%d",
-						r.AuthRequests, r.AuthCodes, r.DeviceRequests, r.DeviceTokens)
+					s.logger.Infof("garbage collection run, delete auth requests=%d, auth codes=%d, device requests=%d, device tokens=%d, backchannel auth requests=%d, login attempts=%d, password resets=%d, email verifications=%d, saml assertions=%d",
+						r.AuthRequests, r.AuthCodes, r.DeviceRequests, r.DeviceTokens, r.BackchannelAuthRequests, r.LoginAttempts, r.PasswordResets, r.EmailVerifications, r.SAMLAssertions)
 				}
 			}
 		}
@@ -588,7 +815,7 @@ func (s *Server) OpenConnector(conn storage.Connector) (Connector, error) {
 	var c connector.Connector
 
 	if conn.Type == LocalConnector {
-		c = newPasswordDB(s.storage)
+		c = newPasswordDB(s.storage, s.passwords, s.logger)
 	} else {
 		var err error
 		c, err = openConnector(s.logger, conn)
@@ -602,9 +829,18 @@ func (s *Server) OpenConnector(conn storage.Connector) (Connector, error) {
 		Connector:       c,
 	}
 	s.mu.Lock()
+	old, replaced := s.connectors[conn.ID]
 	s.connectors[conn.ID] = connector
 	s.mu.Unlock()
 
+	// Connectors holding resources, like pooled connections, release them once
+	// they're replaced. Requests still using the old connector can finish.
+	if closer, ok := old.Connector.(io.Closer); replaced && ok {
+		if err := closer.Close(); err != nil {
+			s.logger.Errorf("failed to close replaced connector %s: %v", conn.ID, err)
+		}
+	}
+
 	return connector, nil
 }
 
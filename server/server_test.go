@@ -1258,7 +1258,7 @@ func TestCrossClientScopesWithAzpInAudienceByDefault(t *testing.T) {
 
 func TestPasswordDB(t *testing.T) {
 	s := memory.New(logger)
-	conn := newPasswordDB(s)
+	conn := newPasswordDB(s, PasswordConfig{}, logger)
 
 	pw := "hi"
 
@@ -1273,11 +1273,21 @@ func TestPasswordDB(t *testing.T) {
 		UserID:   "foobar",
 		Hash:     h,
 	})
+	s.CreatePassword(storage.Password{
+		Email:       "joe@example.com",
+		Username:    "joe",
+		UserID:      "barfoo",
+		Hash:        h,
+		Groups:      []string{"admins"},
+		DisplayName: "Joe Bloggs",
+		Attributes:  map[string]string{"department": "eng"},
+	})
 
 	tests := []struct {
 		name         string
 		username     string
 		password     string
+		scopes       connector.Scopes
 		wantIdentity connector.Identity
 		wantInvalid  bool
 		wantErr      bool
@@ -1293,6 +1303,34 @@ func TestPasswordDB(t *testing.T) {
 				EmailVerified: true,
 			},
 		},
+		{
+			name:     "groups and display name",
+			username: "joe@example.com",
+			password: pw,
+			scopes:   connector.Scopes{Groups: true},
+			wantIdentity: connector.Identity{
+				Email:             "joe@example.com",
+				Username:          "Joe Bloggs",
+				PreferredUsername: "joe",
+				UserID:            "barfoo",
+				EmailVerified:     true,
+				Groups:            []string{"admins"},
+				Attributes:        map[string]string{"department": "eng"},
+			},
+		},
+		{
+			name:     "groups not requested",
+			username: "joe@example.com",
+			password: pw,
+			wantIdentity: connector.Identity{
+				Email:             "joe@example.com",
+				Username:          "Joe Bloggs",
+				PreferredUsername: "joe",
+				UserID:            "barfoo",
+				EmailVerified:     true,
+				Attributes:        map[string]string{"department": "eng"},
+			},
+		},
 		{
 			name:        "unknown user",
 			username:    "john@example.com",
@@ -1308,7 +1346,7 @@ func TestPasswordDB(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		ident, valid, err := conn.Login(context.Background(), connector.Scopes{}, tc.username, tc.password)
+		ident, valid, err := conn.Login(context.Background(), tc.scopes, tc.username, tc.password)
 		if err != nil {
 			if !tc.wantErr {
 				t.Errorf("%s: %v", tc.name, err)
@@ -1339,9 +1377,58 @@ func TestPasswordDB(t *testing.T) {
 	}
 }
 
+func TestPasswordDBRefresh(t *testing.T) {
+	s := memory.New(logger)
+	conn := newPasswordDB(s, PasswordConfig{}, logger)
+
+	h, err := bcrypt.GenerateFromPassword([]byte("hi"), bcrypt.DefaultCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.CreatePassword(storage.Password{
+		Email:    "jane@example.com",
+		Username: "jane",
+		UserID:   "foobar",
+		Hash:     h,
+		Groups:   []string{"developers"},
+	})
+
+	scopes := connector.Scopes{Groups: true}
+	ident, valid, err := conn.Login(context.Background(), scopes, "jane@example.com", "hi")
+	if err != nil || !valid {
+		t.Fatalf("login failed: valid=%t, err=%v", valid, err)
+	}
+
+	if err := s.UpdatePassword("jane@example.com", func(p storage.Password) (storage.Password, error) {
+		p.Groups = []string{"admins", "developers"}
+		p.DisplayName = "Jane Doe"
+		p.Attributes = map[string]string{"team": "platform"}
+		return p, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	refreshed, err := conn.(connector.RefreshConnector).Refresh(context.Background(), scopes, ident)
+	if err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	want := connector.Identity{
+		Email:             "jane@example.com",
+		Username:          "Jane Doe",
+		PreferredUsername: "jane",
+		UserID:            "foobar",
+		EmailVerified:     true,
+		Groups:            []string{"admins", "developers"},
+		Attributes:        map[string]string{"team": "platform"},
+	}
+	if diff := pretty.Compare(want, refreshed); diff != "" {
+		t.Errorf("refreshed identity did not match: %s", diff)
+	}
+}
+
 func TestPasswordDBUsernamePrompt(t *testing.T) {
 	s := memory.New(logger)
-	conn := newPasswordDB(s)
+	conn := newPasswordDB(s, PasswordConfig{}, logger)
 
 	expected := "Email Address"
 	if actual := conn.Prompt(); actual != expected {
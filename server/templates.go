@@ -15,13 +15,21 @@ import (
 )
 
 const (
-	tmplApproval      = "approval.html"
-	tmplLogin         = "login.html"
-	tmplPassword      = "password.html"
-	tmplOOB           = "oob.html"
-	tmplError         = "error.html"
-	tmplDevice        = "device.html"
-	tmplDeviceSuccess = "device_success.html"
+	tmplApproval       = "approval.html"
+	tmplLogin          = "login.html"
+	tmplPassword       = "password.html"
+	tmplOOB            = "oob.html"
+	tmplError          = "error.html"
+	tmplDevice         = "device.html"
+	tmplDeviceSuccess  = "device_success.html"
+	tmplFormPost       = "form_post.html"
+	tmplTOTP           = "totp.html"
+	tmplTOTPRecovery   = "totp_recovery.html"
+	tmplWebAuthnLogin  = "webauthn_login.html"
+	tmplWebAuthnReg    = "webauthn_register.html"
+	tmplPasswordReset  = "password_reset.html"
+	tmplRegister       = "register.html"
+	tmplPasswordChange = "password_change.html"
 )
 
 var requiredTmpls = []string{
@@ -32,16 +40,32 @@ var requiredTmpls = []string{
 	tmplError,
 	tmplDevice,
 	tmplDeviceSuccess,
+	tmplFormPost,
+	tmplTOTP,
+	tmplTOTPRecovery,
+	tmplWebAuthnLogin,
+	tmplWebAuthnReg,
+	tmplPasswordReset,
+	tmplRegister,
+	tmplPasswordChange,
 }
 
 type templates struct {
-	loginTmpl         *template.Template
-	approvalTmpl      *template.Template
-	passwordTmpl      *template.Template
-	oobTmpl           *template.Template
-	errorTmpl         *template.Template
-	deviceTmpl        *template.Template
-	deviceSuccessTmpl *template.Template
+	loginTmpl          *template.Template
+	approvalTmpl       *template.Template
+	passwordTmpl       *template.Template
+	oobTmpl            *template.Template
+	errorTmpl          *template.Template
+	deviceTmpl         *template.Template
+	deviceSuccessTmpl  *template.Template
+	formPostTmpl       *template.Template
+	totpTmpl           *template.Template
+	totpRecoveryTmpl   *template.Template
+	webAuthnLoginTmpl  *template.Template
+	webAuthnRegTmpl    *template.Template
+	passwordResetTmpl  *template.Template
+	registerTmpl       *template.Template
+	passwordChangeTmpl *template.Template
 }
 
 type webConfig struct {
@@ -83,12 +107,11 @@ func getFuncMap(c webConfig) (template.FuncMap, error) {
 //
 // The directory layout is expected to be:
 //
-//    ( web directory )
-//    |- static
-//    |- themes
-//    |  |- (theme name)
-//    |- templates
-//
+//	( web directory )
+//	|- static
+//	|- themes
+//	|  |- (theme name)
+//	|- templates
 func loadWebConfig(c webConfig) (http.Handler, http.Handler, *templates, error) {
 	// fallback to the default theme if the legacy theme name is provided
 	if c.theme == "coreos" || c.theme == "tectonic" {
@@ -157,13 +180,21 @@ func loadTemplates(c webConfig, templatesDir string) (*templates, error) {
 		return nil, fmt.Errorf("missing template(s): %s", missingTmpls)
 	}
 	return &templates{
-		loginTmpl:         tmpls.Lookup(tmplLogin),
-		approvalTmpl:      tmpls.Lookup(tmplApproval),
-		passwordTmpl:      tmpls.Lookup(tmplPassword),
-		oobTmpl:           tmpls.Lookup(tmplOOB),
-		errorTmpl:         tmpls.Lookup(tmplError),
-		deviceTmpl:        tmpls.Lookup(tmplDevice),
-		deviceSuccessTmpl: tmpls.Lookup(tmplDeviceSuccess),
+		loginTmpl:          tmpls.Lookup(tmplLogin),
+		approvalTmpl:       tmpls.Lookup(tmplApproval),
+		passwordTmpl:       tmpls.Lookup(tmplPassword),
+		oobTmpl:            tmpls.Lookup(tmplOOB),
+		errorTmpl:          tmpls.Lookup(tmplError),
+		deviceTmpl:         tmpls.Lookup(tmplDevice),
+		deviceSuccessTmpl:  tmpls.Lookup(tmplDeviceSuccess),
+		formPostTmpl:       tmpls.Lookup(tmplFormPost),
+		totpTmpl:           tmpls.Lookup(tmplTOTP),
+		totpRecoveryTmpl:   tmpls.Lookup(tmplTOTPRecovery),
+		webAuthnLoginTmpl:  tmpls.Lookup(tmplWebAuthnLogin),
+		webAuthnRegTmpl:    tmpls.Lookup(tmplWebAuthnReg),
+		passwordResetTmpl:  tmpls.Lookup(tmplPasswordReset),
+		registerTmpl:       tmpls.Lookup(tmplRegister),
+		passwordChangeTmpl: tmpls.Lookup(tmplPasswordChange),
 	}, nil
 }
 
@@ -283,15 +314,19 @@ func (t *templates) login(r *http.Request, w http.ResponseWriter, connectors []c
 	return renderTemplate(w, t.loginTmpl, data)
 }
 
-func (t *templates) password(r *http.Request, w http.ResponseWriter, postURL, lastUsername, usernamePrompt string, lastWasInvalid bool, backLink string) error {
+func (t *templates) password(r *http.Request, w http.ResponseWriter, postURL, lastUsername, usernamePrompt string, lastWasInvalid bool, backLink, passkeyURL, forgotURL, registerURL, changePasswordURL string) error {
 	data := struct {
-		PostURL        string
-		BackLink       string
-		Username       string
-		UsernamePrompt string
-		Invalid        bool
-		ReqPath        string
-	}{postURL, backLink, lastUsername, usernamePrompt, lastWasInvalid, r.URL.Path}
+		PostURL           string
+		BackLink          string
+		Username          string
+		UsernamePrompt    string
+		Invalid           bool
+		PasskeyURL        string
+		ForgotURL         string
+		RegisterURL       string
+		ChangePasswordURL string
+		ReqPath           string
+	}{postURL, backLink, lastUsername, usernamePrompt, lastWasInvalid, passkeyURL, forgotURL, registerURL, changePasswordURL, r.URL.Path}
 	return renderTemplate(w, t.passwordTmpl, data)
 }
 
@@ -322,6 +357,155 @@ func (t *templates) oob(r *http.Request, w http.ResponseWriter, code string) err
 	return renderTemplate(w, t.oobTmpl, data)
 }
 
+// formPost renders a form that submits the values to the action URL as soon as
+// it's loaded.
+func (t *templates) formPost(r *http.Request, w http.ResponseWriter, action string, values url.Values) error {
+	data := struct {
+		// The action is a redirect URI registered by the client, which may use
+		// a custom scheme that html/template would otherwise reject.
+		Action  template.URL
+		Values  url.Values
+		ReqPath string
+	}{template.URL(action), values, r.URL.Path}
+	return renderTemplate(w, t.formPostTmpl, data)
+}
+
+// totp asks for a TOTP code. While the user sets up TOTP it also shows the secret
+// and its QR code.
+func (t *templates) totp(r *http.Request, w http.ResponseWriter, postURL string, qrCode template.URL, secret string, lastWasInvalid bool) error {
+	if lastWasInvalid {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	data := struct {
+		PostURL string
+		QRCode  template.URL
+		Secret  string
+		Invalid bool
+		ReqPath string
+	}{postURL, qrCode, secret, lastWasInvalid, r.URL.Path}
+	return renderTemplate(w, t.totpTmpl, data)
+}
+
+func (t *templates) totpRecoveryCodes(r *http.Request, w http.ResponseWriter, continueURL string, codes []string) error {
+	data := struct {
+		ContinueURL string
+		Codes       []string
+		ReqPath     string
+	}{continueURL, codes, r.URL.Path}
+	return renderTemplate(w, t.totpRecoveryTmpl, data)
+}
+
+// webAuthnLogin asks for a passkey. The page fetches the ceremony's options from
+// optionsURL and posts the browser's response to postURL.
+func (t *templates) webAuthnLogin(r *http.Request, w http.ResponseWriter, optionsURL, postURL, backLink string) error {
+	data := struct {
+		OptionsURL string
+		PostURL    string
+		BackLink   string
+		ReqPath    string
+	}{optionsURL, postURL, backLink, r.URL.Path}
+	return renderTemplate(w, t.webAuthnLoginTmpl, data)
+}
+
+func (t *templates) webAuthnRegister(r *http.Request, w http.ResponseWriter, optionsURL, postURL, skipLink string) error {
+	data := struct {
+		OptionsURL string
+		PostURL    string
+		SkipLink   string
+		ReqPath    string
+	}{optionsURL, postURL, skipLink, r.URL.Path}
+	return renderTemplate(w, t.webAuthnRegTmpl, data)
+}
+
+// forgotPassword asks for the email address to send a password reset link to, or
+// confirms the link was sent.
+func (t *templates) forgotPassword(r *http.Request, w http.ResponseWriter, postURL, backLink string, sent bool) error {
+	data := struct {
+		PostURL  string
+		BackLink string
+		Sent     bool
+		Token    string
+		Done     bool
+		Error    string
+		ReqPath  string
+	}{postURL, backLink, sent, "", false, "", r.URL.Path}
+	return renderTemplate(w, t.passwordResetTmpl, data)
+}
+
+// resetPassword asks for a new password after following a reset link, or confirms
+// the password was changed.
+func (t *templates) resetPassword(r *http.Request, w http.ResponseWriter, postURL, token string, done bool, errMsg string) error {
+	if errMsg != "" {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	data := struct {
+		PostURL  string
+		BackLink string
+		Sent     bool
+		Token    string
+		Done     bool
+		Error    string
+		ReqPath  string
+	}{postURL, "", false, token, done, errMsg, r.URL.Path}
+	return renderTemplate(w, t.passwordResetTmpl, data)
+}
+
+// changePassword asks for the current and a new password of a connector's user,
+// or confirms the password was changed. If expired is set, the user was sent here
+// by a login with an expired password.
+func (t *templates) changePassword(r *http.Request, w http.ResponseWriter, postURL, backLink, usernamePrompt, username string, expired, done bool, errMsg string) error {
+	if errMsg != "" {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	data := struct {
+		PostURL        string
+		BackLink       string
+		UsernamePrompt string
+		Username       string
+		Expired        bool
+		Done           bool
+		Error          string
+		ReqPath        string
+	}{postURL, backLink, usernamePrompt, username, expired, done, errMsg, r.URL.Path}
+	return renderTemplate(w, t.passwordChangeTmpl, data)
+}
+
+// register asks for the details of a new account, or confirms a verification link
+// was sent to its email address.
+func (t *templates) register(r *http.Request, w http.ResponseWriter, postURL, backLink, email, username string, sent bool, errMsg string) error {
+	if errMsg != "" {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	data := struct {
+		PostURL         string
+		BackLink        string
+		Email           string
+		Username        string
+		Sent            bool
+		Verified        bool
+		PendingApproval bool
+		Error           string
+		ReqPath         string
+	}{postURL, backLink, email, username, sent, false, false, errMsg, r.URL.Path}
+	return renderTemplate(w, t.registerTmpl, data)
+}
+
+// emailVerified confirms the email address of a new account.
+func (t *templates) emailVerified(r *http.Request, w http.ResponseWriter, pendingApproval bool) error {
+	data := struct {
+		PostURL         string
+		BackLink        string
+		Email           string
+		Username        string
+		Sent            bool
+		Verified        bool
+		PendingApproval bool
+		Error           string
+		ReqPath         string
+	}{"", "", "", "", false, true, pendingApproval, "", r.URL.Path}
+	return renderTemplate(w, t.registerTmpl, data)
+}
+
 func (t *templates) err(r *http.Request, w http.ResponseWriter, errCode int, errMsg string) error {
 	w.WriteHeader(errCode)
 	data := struct {
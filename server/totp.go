@@ -0,0 +1,386 @@
+package server
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// TOTPConfig configures the TOTP second factor users are asked for after logging in
+// with a connector.
+type TOTPConfig struct {
+	// Key used to encrypt the TOTP secrets kept in the storage. TOTP is disabled
+	// if no key is set.
+	EncryptionKey string
+
+	// Issuer shown to users in their authenticator app. Defaults to the host of
+	// the issuer URL.
+	Issuer string
+
+	// Users logging in with one of these connectors, or to one of these clients,
+	// must enter a TOTP code. Users who haven't set up TOTP yet are asked to do so.
+	Connectors []string
+	Clients    []string
+
+	// Number of wrong codes after which the user has to log in again. The same
+	// number of wrong codes in a row over several logins locks TOTP for the user,
+	// for the delays of the lockout config. Defaults to 5.
+	MaxFailures int
+}
+
+const (
+	totpPeriod            = 30
+	totpRecoveryCodes     = 10
+	totpRecoveryCodeChars = 10
+)
+
+var (
+	totpValidateOpts = totp.ValidateOpts{
+		Period:    totpPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+
+	totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+)
+
+func newTOTPAEAD(key string) (cipher.AEAD, error) {
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// totpRequired reports whether a user logging in with the connector to the client
+// must enter a TOTP code.
+func (s *Server) totpRequired(connID, clientID string) bool {
+	if s.totpAEAD == nil {
+		return false
+	}
+	return contains(s.totp.Connectors, connID) || contains(s.totp.Clients, clientID)
+}
+
+// The user and connector are authenticated along with the secret, so a secret can't
+// be copied over to another user's enrollment.
+func totpAdditionalData(userID, connID string) []byte {
+	return []byte(userID + "|" + connID)
+}
+
+func (s *Server) encryptTOTPSecret(userID, connID, secret string) ([]byte, error) {
+	nonce := make([]byte, s.totpAEAD.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return s.totpAEAD.Seal(nonce, nonce, []byte(secret), totpAdditionalData(userID, connID)), nil
+}
+
+func (s *Server) decryptTOTPSecret(e storage.TOTPEnrollment) (string, error) {
+	nonceSize := s.totpAEAD.NonceSize()
+	if len(e.Secret) < nonceSize {
+		return "", errors.New("totp secret too short")
+	}
+	secret, err := s.totpAEAD.Open(nil, e.Secret[:nonceSize], e.Secret[nonceSize:], totpAdditionalData(e.UserID, e.ConnID))
+	if err != nil {
+		return "", fmt.Errorf("decrypt totp secret: %v", err)
+	}
+	return string(secret), nil
+}
+
+func newTOTPSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// validateTOTPCode checks the code against the current time step and its direct
+// neighbours to allow for clock skew. Steps up to lastUsedStep were already used
+// and are skipped. It returns the step the code matched.
+func validateTOTPCode(secret, code string, now time.Time, lastUsedStep int64) (int64, bool) {
+	step := now.Unix() / totpPeriod
+	for _, s := range []int64{step - 1, step, step + 1} {
+		if s <= lastUsedStep {
+			continue
+		}
+		want, err := totp.GenerateCodeCustom(secret, time.Unix(s*totpPeriod, 0), totpValidateOpts)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(want), []byte(code)) == 1 {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// newRecoveryCodes returns single use codes users can log in with when they lose their
+// authenticator, along with the hashes to store. The codes are random, so a plain hash
+// is enough to protect them.
+func newRecoveryCodes() (codes, hashes []string, err error) {
+	for i := 0; i < totpRecoveryCodes; i++ {
+		b := make([]byte, totpRecoveryCodeChars*5/8)
+		if _, err := io.ReadFull(rand.Reader, b); err != nil {
+			return nil, nil, err
+		}
+		code := recoveryCodeEncoding.EncodeToString(b)
+		codes = append(codes, code[:totpRecoveryCodeChars/2]+"-"+code[totpRecoveryCodeChars/2:])
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(code, "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// useRecoveryCode returns the recovery codes left after the code is used, or false if
+// the code doesn't match any of them.
+func useRecoveryCode(hashes []string, code string) ([]string, bool) {
+	hash := hashRecoveryCode(code)
+	for i, h := range hashes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			return append(append([]string(nil), hashes[:i]...), hashes[i+1:]...), true
+		}
+	}
+	return hashes, false
+}
+
+// totpQRCode renders the otpauth URI of the secret as a QR code image users can scan
+// with their authenticator app.
+func (s *Server) totpQRCode(account, secret string) (template.URL, error) {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", s.totp.Issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", "6")
+	v.Set("period", fmt.Sprint(totpPeriod))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + s.totp.Issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	key, err := otp.NewKeyFromURL(u.String())
+	if err != nil {
+		return "", err
+	}
+	img, err := key.Image(200, 200)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+	return template.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
+
+// handleTOTP asks users who logged in with a connector for their TOTP code, or has
+// them set up TOTP first if they haven't yet.
+func (s *Server) handleTOTP(w http.ResponseWriter, r *http.Request) {
+	authReq, err := s.storage.GetAuthRequest(r.FormValue("state"))
+	if err != nil {
+		if err == storage.ErrNotFound {
+			s.renderError(r, w, http.StatusBadRequest, "User session error.")
+			return
+		}
+		s.logger.Errorf("Failed to get auth request: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+	if !authReq.LoggedIn {
+		s.logger.Errorf("Auth request does not have an identity for TOTP")
+		s.renderError(r, w, http.StatusBadRequest, "Login process not yet finalized.")
+		return
+	}
+
+	approvalURL := s.absPath("/approval") + "?req=" + url.QueryEscape(authReq.ID)
+	if authReq.MFAValidated || !s.totpRequired(authReq.ConnectorID, authReq.ClientID) {
+		http.Redirect(w, r, approvalURL, http.StatusSeeOther)
+		return
+	}
+
+	enrollment, err := s.storage.GetTOTPEnrollment(authReq.Claims.UserID, authReq.ConnectorID)
+	if err != nil && err != storage.ErrNotFound {
+		s.logger.Errorf("Failed to get TOTP enrollment: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+	if err == storage.ErrNotFound {
+		if enrollment, err = s.newTOTPEnrollment(authReq); err != nil {
+			s.logger.Errorf("Failed to create TOTP enrollment: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+	}
+
+	secret, err := s.decryptTOTPSecret(enrollment)
+	if err != nil {
+		s.logger.Errorf("Failed to read TOTP secret of user %q: %v", authReq.Claims.UserID, err)
+		s.renderError(r, w, http.StatusInternalServerError, "Failed to verify the authentication code.")
+		return
+	}
+
+	postURL := s.absPath("/totp") + "?state=" + url.QueryEscape(authReq.ID)
+	switch r.Method {
+	case http.MethodGet:
+		s.renderTOTP(w, r, postURL, authReq, enrollment, secret, false)
+	case http.MethodPost:
+		userAttemptsID := totpAttemptsUserID(authReq.ConnectorID, authReq.Claims.UserID)
+		if s.attemptsLocked(userAttemptsID) {
+			s.renderError(r, w, http.StatusTooManyRequests, errLoginLocked)
+			return
+		}
+
+		code := strings.TrimSpace(r.PostFormValue("code"))
+		codes, hashes, err := newRecoveryCodes()
+		if err != nil {
+			s.logger.Errorf("Failed to generate recovery codes: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Failed to verify the authentication code.")
+			return
+		}
+		confirmed := false
+		updater := func(old storage.TOTPEnrollment) (storage.TOTPEnrollment, error) {
+			confirmed = false
+			if step, ok := validateTOTPCode(secret, code, s.now(), old.LastUsedStep); ok {
+				old.LastUsedStep = step
+			} else if remaining, ok := useRecoveryCode(old.RecoveryCodes, code); ok {
+				old.RecoveryCodes = remaining
+			} else {
+				return old, errInvalidTOTPCode
+			}
+			if !old.Confirmed {
+				old.Confirmed = true
+				old.RecoveryCodes = hashes
+				confirmed = true
+			}
+			return old, nil
+		}
+		if err := s.storage.UpdateTOTPEnrollment(enrollment.UserID, enrollment.ConnID, updater); err != nil {
+			if err == errInvalidTOTPCode {
+				s.countLoginFailure(userAttemptsID, lockoutTypeTOTP, s.totp.MaxFailures)
+				failures := s.countLoginFailure(totpAttemptsRequestID(authReq.ID), lockoutTypeTOTPRequest, s.totp.MaxFailures)
+				if failures < s.totp.MaxFailures {
+					s.renderTOTP(w, r, postURL, authReq, enrollment, secret, true)
+					return
+				}
+				// The password, or whatever the first factor was, has to be
+				// entered again before more codes can be guessed.
+				if err := s.storage.DeleteAuthRequest(authReq.ID); err != nil && err != storage.ErrNotFound {
+					s.logger.Errorf("Failed to delete auth request: %v", err)
+				}
+				if err := s.storage.DeleteLoginAttempts(totpAttemptsRequestID(authReq.ID)); err != nil && err != storage.ErrNotFound {
+					s.logger.Errorf("Failed to reset failed TOTP codes: %v", err)
+				}
+				s.renderError(r, w, http.StatusForbidden, "Too many invalid authentication codes. Please log in again.")
+				return
+			}
+			s.logger.Errorf("Failed to update TOTP enrollment: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+
+		if err := s.storage.UpdateAuthRequest(authReq.ID, func(a storage.AuthRequest) (storage.AuthRequest, error) {
+			a.MFAValidated = true
+			return a, nil
+		}); err != nil {
+			s.logger.Errorf("Failed to update auth request: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+			return
+		}
+		for _, id := range []string{userAttemptsID, totpAttemptsRequestID(authReq.ID)} {
+			if err := s.storage.DeleteLoginAttempts(id); err != nil && err != storage.ErrNotFound {
+				s.logger.Errorf("Failed to reset failed TOTP codes: %v", err)
+			}
+		}
+
+		if confirmed {
+			// Recovery codes are only ever shown once, right after the user set up TOTP.
+			if err := s.templates.totpRecoveryCodes(r, w, approvalURL, codes); err != nil {
+				s.logger.Errorf("Server template error: %v", err)
+			}
+			return
+		}
+		http.Redirect(w, r, approvalURL, http.StatusSeeOther)
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+	}
+}
+
+var errInvalidTOTPCode = errors.New("invalid totp code")
+
+// newTOTPEnrollment starts setting up TOTP for the user of the auth request. The
+// enrollment isn't used for logins until the user confirms it with a valid code.
+func (s *Server) newTOTPEnrollment(authReq storage.AuthRequest) (storage.TOTPEnrollment, error) {
+	secret, err := newTOTPSecret()
+	if err != nil {
+		return storage.TOTPEnrollment{}, err
+	}
+	encrypted, err := s.encryptTOTPSecret(authReq.Claims.UserID, authReq.ConnectorID, secret)
+	if err != nil {
+		return storage.TOTPEnrollment{}, err
+	}
+	enrollment := storage.TOTPEnrollment{
+		UserID:    authReq.Claims.UserID,
+		ConnID:    authReq.ConnectorID,
+		Secret:    encrypted,
+		CreatedAt: s.now(),
+	}
+	if err := s.storage.CreateTOTPEnrollment(enrollment); err != nil {
+		if err != storage.ErrAlreadyExists {
+			return storage.TOTPEnrollment{}, err
+		}
+		// Another request of the same user got there first.
+		return s.storage.GetTOTPEnrollment(enrollment.UserID, enrollment.ConnID)
+	}
+	return enrollment, nil
+}
+
+func (s *Server) renderTOTP(w http.ResponseWriter, r *http.Request, postURL string, authReq storage.AuthRequest, enrollment storage.TOTPEnrollment, secret string, invalid bool) {
+	var qrCode template.URL
+	if !enrollment.Confirmed {
+		account := authReq.Claims.Email
+		if account == "" {
+			account = authReq.Claims.Username
+		}
+		var err error
+		if qrCode, err = s.totpQRCode(account, secret); err != nil {
+			s.logger.Errorf("Failed to render TOTP QR code: %v", err)
+			s.renderError(r, w, http.StatusInternalServerError, "Failed to set up the authenticator.")
+			return
+		}
+	} else {
+		// Only show the secret while setting up the authenticator.
+		secret = ""
+	}
+	if err := s.templates.totp(r, w, postURL, qrCode, secret, invalid); err != nil {
+		s.logger.Errorf("Server template error: %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+
+	"github.com/dexidp/dex/storage"
+)
+
+func TestValidateTOTPCode(t *testing.T) {
+	secret, err := newTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1600000000, 0)
+	step := now.Unix() / totpPeriod
+
+	code := func(at time.Time) string {
+		c, err := totp.GenerateCodeCustom(secret, at, totpValidateOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	if got, ok := validateTOTPCode(secret, code(now), now, 0); !ok || got != step {
+		t.Errorf("expected current code to be valid for step %d, got %d (%t)", step, got, ok)
+	}
+	if _, ok := validateTOTPCode(secret, code(now.Add(-totpPeriod*time.Second)), now, 0); !ok {
+		t.Errorf("expected code of the previous step to be valid")
+	}
+	if _, ok := validateTOTPCode(secret, code(now.Add(-2*totpPeriod*time.Second)), now, 0); ok {
+		t.Errorf("expected code of two steps ago to be rejected")
+	}
+	if _, ok := validateTOTPCode(secret, code(now), now, step); ok {
+		t.Errorf("expected used code to be rejected")
+	}
+	if _, ok := validateTOTPCode(secret, "", now, 0); ok {
+		t.Errorf("expected empty code to be rejected")
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != totpRecoveryCodes || len(hashes) != totpRecoveryCodes {
+		t.Fatalf("expected %d recovery codes, got %d codes and %d hashes", totpRecoveryCodes, len(codes), len(hashes))
+	}
+
+	remaining, ok := useRecoveryCode(hashes, strings.ToUpper(codes[3]))
+	if !ok {
+		t.Fatalf("expected recovery code %q to be accepted", codes[3])
+	}
+	if len(remaining) != totpRecoveryCodes-1 || len(hashes) != totpRecoveryCodes {
+		t.Errorf("expected the used recovery code to be removed from a copy")
+	}
+	if _, ok := useRecoveryCode(remaining, codes[3]); ok {
+		t.Errorf("expected recovery code to only work once")
+	}
+}
+
+func TestTOTPLogin(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := time.Now()
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.TOTP = TOTPConfig{EncryptionKey: "secret", Connectors: []string{"mock"}}
+		c.Now = func() time.Time { return now }
+	})
+	defer httpServer.Close()
+
+	redirectURI := "https://client.example.com/callback"
+	if err := s.storage.CreateClient(storage.Client{
+		ID:           "test",
+		RedirectURIs: []string{redirectURI},
+	}); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	httpClient := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if strings.HasPrefix(req.URL.String(), redirectURI) {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+	login := func() *http.Response {
+		q := url.Values{
+			"client_id":     {"test"},
+			"redirect_uri":  {redirectURI},
+			"response_type": {"code"},
+			"scope":         {"openid email"},
+		}
+		resp, err := httpClient.Get(httpServer.URL + "/auth?" + q.Encode())
+		if err != nil {
+			t.Fatalf("failed to start login: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || resp.Request.URL.Path != "/totp" {
+			t.Fatalf("expected to be asked for a TOTP code, got %d at %s", resp.StatusCode, resp.Request.URL)
+		}
+		return resp
+	}
+	submit := func(totpURL, code string) *http.Response {
+		resp, err := httpClient.PostForm(totpURL, url.Values{"code": {code}})
+		if err != nil {
+			t.Fatalf("failed to submit code: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	// The first login sets up TOTP.
+	resp := login()
+	totpURL := resp.Request.URL.String()
+	authReq, err := s.storage.GetAuthRequest(resp.Request.URL.Query().Get("state"))
+	if err != nil {
+		t.Fatalf("failed to get auth request: %v", err)
+	}
+	enrollment, err := s.storage.GetTOTPEnrollment(authReq.Claims.UserID, authReq.ConnectorID)
+	if err != nil {
+		t.Fatalf("failed to get totp enrollment: %v", err)
+	}
+	if enrollment.Confirmed {
+		t.Fatalf("expected enrollment to wait for confirmation")
+	}
+	secret, err := s.decryptTOTPSecret(enrollment)
+	if err != nil {
+		t.Fatalf("failed to decrypt secret: %v", err)
+	}
+
+	// Skipping the second factor isn't possible.
+	resp, err = httpClient.Get(httpServer.URL + "/approval?req=" + authReq.ID)
+	if err != nil {
+		t.Fatalf("failed to get approval: %v", err)
+	}
+	resp.Body.Close()
+	if resp.Request.URL.Path != "/totp" {
+		t.Errorf("expected approval to redirect to TOTP, got %s", resp.Request.URL)
+	}
+
+	if resp := submit(totpURL, "123456"); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected invalid code to be rejected, got %d", resp.StatusCode)
+	}
+
+	code, err := totp.GenerateCodeCustom(secret, now, totpValidateOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp := submit(totpURL, code); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected recovery codes to be shown, got %d", resp.StatusCode)
+	}
+	enrollment, err = s.storage.GetTOTPEnrollment(authReq.Claims.UserID, authReq.ConnectorID)
+	if err != nil {
+		t.Fatalf("failed to get totp enrollment: %v", err)
+	}
+	if !enrollment.Confirmed || len(enrollment.RecoveryCodes) != totpRecoveryCodes {
+		t.Fatalf("expected confirmed enrollment with recovery codes, got %+v", enrollment)
+	}
+
+	resp, err = httpClient.Get(httpServer.URL + "/approval?req=" + authReq.ID)
+	if err != nil {
+		t.Fatalf("failed to get approval: %v", err)
+	}
+	resp.Body.Close()
+	if !strings.HasPrefix(resp.Header.Get("Location"), redirectURI) {
+		t.Errorf("expected redirect to the client after TOTP, got %d %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+
+	// Later logins ask for a code again. Used codes are rejected, recovery codes work
+	// in place of a code.
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.storage.UpdateTOTPEnrollment(enrollment.UserID, enrollment.ConnID, func(old storage.TOTPEnrollment) (storage.TOTPEnrollment, error) {
+		old.RecoveryCodes = hashes
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update totp enrollment: %v", err)
+	}
+
+	totpURL = login().Request.URL.String()
+	if resp := submit(totpURL, code); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected used code to be rejected, got %d", resp.StatusCode)
+	}
+	if resp := submit(totpURL, codes[0]); !strings.HasPrefix(resp.Header.Get("Location"), redirectURI) {
+		t.Errorf("expected recovery code to log in, got %d %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	enrollment, err = s.storage.GetTOTPEnrollment(authReq.Claims.UserID, authReq.ConnectorID)
+	if err != nil {
+		t.Fatalf("failed to get totp enrollment: %v", err)
+	}
+	if _, ok := useRecoveryCode(enrollment.RecoveryCodes, codes[0]); ok {
+		t.Errorf("expected recovery code to be used up")
+	}
+
+	// Too many wrong codes end the login, and lock TOTP for the user.
+	resp = login()
+	totpURL = resp.Request.URL.String()
+	authReqID := resp.Request.URL.Query().Get("state")
+	for i := 1; i < s.totp.MaxFailures; i++ {
+		if resp := submit(totpURL, "000000"); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected wrong code %d to be rejected, got %d", i, resp.StatusCode)
+		}
+	}
+	if resp := submit(totpURL, "000000"); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("expected the login to end after %d wrong codes, got %d", s.totp.MaxFailures, resp.StatusCode)
+	}
+	if _, err := s.storage.GetAuthRequest(authReqID); err != storage.ErrNotFound {
+		t.Errorf("expected the auth request to be deleted, got %v", err)
+	}
+	if resp := submit(login().Request.URL.String(), codes[1]); resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected TOTP to be locked for the user, got %d", resp.StatusCode)
+	}
+}
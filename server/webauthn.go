@@ -0,0 +1,552 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// WebAuthnConfig configures WebAuthn credentials, such as passkeys and security keys,
+// for the users of the local password database.
+type WebAuthnConfig struct {
+	// Relying party ID credentials are bound to. Defaults to the host name of the
+	// issuer URL.
+	RPID string
+
+	// Name of the relying party shown by authenticators. Defaults to "dex".
+	RPDisplayName string
+
+	// Passwordless lets users log in with a passkey instead of their password.
+	// Users logging in with their password are offered to register one.
+	Passwordless bool
+
+	// SecondFactor asks users for their passkey or security key after logging in
+	// with their password. Users who haven't registered one yet are asked to do so.
+	SecondFactor bool
+}
+
+func (c WebAuthnConfig) enabled() bool {
+	return c.Passwordless || c.SecondFactor
+}
+
+func newWebAuthn(c WebAuthnConfig, issuerURL url.URL) (*webauthn.WebAuthn, error) {
+	rpID := c.RPID
+	if rpID == "" {
+		rpID = issuerURL.Hostname()
+	}
+	rpDisplayName := c.RPDisplayName
+	if rpDisplayName == "" {
+		rpDisplayName = "dex"
+	}
+	return webauthn.New(&webauthn.Config{
+		RPID:          rpID,
+		RPDisplayName: rpDisplayName,
+		RPOrigin:      issuerURL.Scheme + "://" + issuerURL.Host,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			UserVerification: protocol.VerificationPreferred,
+		},
+	})
+}
+
+// webAuthnRequired reports whether a user logging in with the connector must also
+// present one of their WebAuthn credentials.
+func (s *Server) webAuthnRequired(connID string) bool {
+	return s.webAuthn != nil && s.webAuthnConfig.SecondFactor && connID == LocalConnector
+}
+
+// passkeyLoginURL returns the page logging in with a passkey instead of a password,
+// if the auth request's connector allows it.
+func (s *Server) passkeyLoginURL(authReq storage.AuthRequest) string {
+	if s.webAuthn == nil || !s.webAuthnConfig.Passwordless || authReq.ConnectorID != LocalConnector {
+		return ""
+	}
+	return s.absPath("/webauthn/login") + "?state=" + url.QueryEscape(authReq.ID)
+}
+
+// offerWebAuthnRegistration reports whether a user who logged in with their password
+// should be offered to register a passkey for passwordless logins.
+func (s *Server) offerWebAuthnRegistration(authReq storage.AuthRequest) bool {
+	if s.webAuthn == nil || !s.webAuthnConfig.Passwordless || authReq.ConnectorID != LocalConnector {
+		return false
+	}
+	user, err := s.getWebAuthnUser(authReq.Claims.Email)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			s.logger.Errorf("Failed to get WebAuthn user: %v", err)
+		}
+		return false
+	}
+	return len(user.creds) == 0 && s.webAuthnCanRegister(authReq, user)
+}
+
+// webAuthnUser adapts a local user and their credentials to the webauthn package.
+type webAuthnUser struct {
+	password storage.Password
+	creds    []storage.WebAuthnCredential
+}
+
+// The user handle has to be stable and must not reveal the user's email, so it's the
+// user ID of the password entry.
+func (u webAuthnUser) WebAuthnID() []byte   { return []byte(u.password.UserID) }
+func (u webAuthnUser) WebAuthnName() string { return u.password.Email }
+func (u webAuthnUser) WebAuthnIcon() string { return "" }
+
+func (u webAuthnUser) WebAuthnDisplayName() string {
+	if u.password.Username != "" {
+		return u.password.Username
+	}
+	return u.password.Email
+}
+
+func (u webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, 0, len(u.creds))
+	for _, c := range u.creds {
+		id, err := base64.RawURLEncoding.DecodeString(c.ID)
+		if err != nil {
+			continue
+		}
+		transports := make([]protocol.AuthenticatorTransport, len(c.Transports))
+		for i, t := range c.Transports {
+			transports[i] = protocol.AuthenticatorTransport(t)
+		}
+		creds = append(creds, webauthn.Credential{
+			ID:              id,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transports,
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.AAGUID,
+				SignCount: c.SignCount,
+			},
+		})
+	}
+	return creds
+}
+
+func (u webAuthnUser) descriptors() []protocol.CredentialDescriptor {
+	var descriptors []protocol.CredentialDescriptor
+	for _, c := range u.WebAuthnCredentials() {
+		descriptors = append(descriptors, c.Descriptor())
+	}
+	return descriptors
+}
+
+func (s *Server) getWebAuthnUser(email string) (webAuthnUser, error) {
+	p, err := s.storage.GetPassword(email)
+	if err != nil {
+		return webAuthnUser{}, err
+	}
+	creds, err := s.storage.ListWebAuthnCredentials(p.Email)
+	if err != nil {
+		return webAuthnUser{}, fmt.Errorf("list webauthn credentials: %v", err)
+	}
+	return webAuthnUser{password: p, creds: creds}, nil
+}
+
+// webAuthnAuthRequest returns the auth request of a WebAuthn ceremony. Ceremonies are
+// only available to users of the local password database.
+func (s *Server) webAuthnAuthRequest(r *http.Request) (storage.AuthRequest, int, error) {
+	if s.webAuthn == nil {
+		return storage.AuthRequest{}, http.StatusNotFound, errors.New("WebAuthn is not enabled")
+	}
+	authReq, err := s.storage.GetAuthRequest(r.URL.Query().Get("state"))
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return authReq, http.StatusBadRequest, errors.New("User session error.")
+		}
+		s.logger.Errorf("Failed to get auth request: %v", err)
+		return authReq, http.StatusInternalServerError, errors.New("Database error.")
+	}
+	if authReq.ConnectorID != LocalConnector {
+		return authReq, http.StatusBadRequest, errors.New("Login method doesn't support passkeys.")
+	}
+	return authReq, http.StatusOK, nil
+}
+
+// webAuthnCanRegister reports whether the user of the auth request may register
+// another credential. Once a user has a credential which is required as a second
+// factor, new credentials can only be added after presenting a second factor.
+func (s *Server) webAuthnCanRegister(authReq storage.AuthRequest, user webAuthnUser) bool {
+	if !authReq.LoggedIn {
+		return false
+	}
+	if authReq.MFAValidated {
+		return true
+	}
+	if s.totpRequired(authReq.ConnectorID, authReq.ClientID) {
+		return false
+	}
+	return len(user.creds) == 0 || !s.webAuthnConfig.SecondFactor
+}
+
+// setWebAuthnChallenge remembers the challenge of the ceremony that was just started.
+func (s *Server) setWebAuthnChallenge(authReqID, challenge string) error {
+	return s.storage.UpdateAuthRequest(authReqID, func(a storage.AuthRequest) (storage.AuthRequest, error) {
+		a.WebAuthnChallenge = challenge
+		return a, nil
+	})
+}
+
+var errWebAuthnChallenge = errors.New("no webauthn ceremony in progress")
+
+// takeWebAuthnChallenge consumes the challenge of the ceremony in progress, so every
+// challenge can only be answered once.
+func (s *Server) takeWebAuthnChallenge(authReqID string) (string, error) {
+	var challenge string
+	err := s.storage.UpdateAuthRequest(authReqID, func(a storage.AuthRequest) (storage.AuthRequest, error) {
+		challenge = a.WebAuthnChallenge
+		if challenge == "" {
+			return a, errWebAuthnChallenge
+		}
+		a.WebAuthnChallenge = ""
+		return a, nil
+	})
+	return challenge, err
+}
+
+// handleWebAuthnLogin renders the page asking for a passkey, and checks the assertion
+// the page posts back. Users who aren't logged in yet log in with a discoverable
+// passkey, logged in users present one of their credentials as a second factor.
+func (s *Server) handleWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	authReq, status, err := s.webAuthnAuthRequest(r)
+	if err != nil {
+		s.renderError(r, w, status, err.Error())
+		return
+	}
+	if !authReq.LoggedIn && !s.webAuthnConfig.Passwordless {
+		s.renderError(r, w, http.StatusBadRequest, "Passwordless login is not enabled.")
+		return
+	}
+
+	approvalURL := s.absPath("/approval") + "?req=" + url.QueryEscape(authReq.ID)
+	state := "?state=" + url.QueryEscape(authReq.ID)
+
+	switch r.Method {
+	case http.MethodGet:
+		backLink := ""
+		if authReq.LoggedIn {
+			if authReq.MFAValidated {
+				http.Redirect(w, r, approvalURL, http.StatusSeeOther)
+				return
+			}
+			creds, err := s.storage.ListWebAuthnCredentials(authReq.Claims.Email)
+			if err != nil {
+				s.logger.Errorf("Failed to list WebAuthn credentials: %v", err)
+				s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+				return
+			}
+			if len(creds) == 0 {
+				http.Redirect(w, r, s.absPath("/webauthn/register")+state, http.StatusSeeOther)
+				return
+			}
+		} else {
+			backLink = s.absPath("/auth", authReq.ConnectorID, "login") + state
+		}
+		if err := s.templates.webAuthnLogin(r, w, s.absPath("/webauthn/login/options")+state, s.absPath("/webauthn/login")+state, backLink); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	case http.MethodPost:
+		if authReq.LoggedIn && authReq.MFAValidated {
+			writeWebAuthnResult(w, http.StatusOK, approvalURL, "")
+			return
+		}
+		redirectURL, err := s.finishWebAuthnLogin(r, authReq)
+		if err != nil {
+			s.logger.Errorf("WebAuthn login failed: %v", err)
+			writeWebAuthnResult(w, http.StatusBadRequest, "", "Your passkey could not be verified.")
+			return
+		}
+		writeWebAuthnResult(w, http.StatusOK, redirectURL, "")
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+	}
+}
+
+// handleWebAuthnLoginOptions starts a login ceremony and returns the options the
+// page passes to the browser.
+func (s *Server) handleWebAuthnLoginOptions(w http.ResponseWriter, r *http.Request) {
+	authReq, status, err := s.webAuthnAuthRequest(r)
+	if err != nil {
+		writeWebAuthnResult(w, status, "", err.Error())
+		return
+	}
+
+	var options *protocol.CredentialAssertion
+	var session *webauthn.SessionData
+	if authReq.LoggedIn {
+		user, err := s.getWebAuthnUser(authReq.Claims.Email)
+		if err != nil {
+			s.logger.Errorf("Failed to get WebAuthn user: %v", err)
+			writeWebAuthnResult(w, http.StatusInternalServerError, "", "Database error.")
+			return
+		}
+		options, session, err = s.webAuthn.BeginLogin(user)
+		if err != nil {
+			writeWebAuthnResult(w, http.StatusBadRequest, "", "No passkey registered.")
+			return
+		}
+	} else if s.webAuthnConfig.Passwordless {
+		// A passkey which replaces the password must verify the user itself.
+		options, session, err = s.webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+		if err != nil {
+			s.logger.Errorf("Failed to begin WebAuthn login: %v", err)
+			writeWebAuthnResult(w, http.StatusInternalServerError, "", "Login error.")
+			return
+		}
+	} else {
+		writeWebAuthnResult(w, http.StatusBadRequest, "", "Passwordless login is not enabled.")
+		return
+	}
+
+	if err := s.setWebAuthnChallenge(authReq.ID, session.Challenge); err != nil {
+		s.logger.Errorf("Failed to update auth request: %v", err)
+		writeWebAuthnResult(w, http.StatusInternalServerError, "", "Database error.")
+		return
+	}
+	writeWebAuthnJSON(w, http.StatusOK, options)
+}
+
+// finishWebAuthnLogin verifies the assertion posted for the auth request and returns
+// where to send the user next.
+func (s *Server) finishWebAuthnLogin(r *http.Request, authReq storage.AuthRequest) (string, error) {
+	parsed, err := protocol.ParseCredentialRequestResponseBody(r.Body)
+	if err != nil {
+		return "", fmt.Errorf("parse assertion: %v", err)
+	}
+	challenge, err := s.takeWebAuthnChallenge(authReq.ID)
+	if err != nil {
+		return "", err
+	}
+
+	var user webAuthnUser
+	var cred *webauthn.Credential
+	if authReq.LoggedIn {
+		if user, err = s.getWebAuthnUser(authReq.Claims.Email); err != nil {
+			return "", err
+		}
+		session := webauthn.SessionData{
+			Challenge:            challenge,
+			UserID:               user.WebAuthnID(),
+			AllowedCredentialIDs: credentialIDs(user),
+			UserVerification:     protocol.VerificationPreferred,
+		}
+		if cred, err = s.webAuthn.ValidateLogin(user, session, parsed); err != nil {
+			return "", err
+		}
+	} else {
+		session := webauthn.SessionData{
+			Challenge:        challenge,
+			UserVerification: protocol.VerificationRequired,
+		}
+		handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+			c, err := s.storage.GetWebAuthnCredential(base64.RawURLEncoding.EncodeToString(rawID))
+			if err != nil {
+				return nil, err
+			}
+			if user, err = s.getWebAuthnUser(c.Email); err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(userHandle, user.WebAuthnID()) {
+				return nil, errors.New("user handle doesn't match the credential")
+			}
+			return user, nil
+		}
+		if cred, err = s.webAuthn.ValidateDiscoverableLogin(handler, session, parsed); err != nil {
+			return "", err
+		}
+	}
+	if cred.Authenticator.CloneWarning {
+		return "", fmt.Errorf("signature counter of credential for %q didn't increase, the authenticator may have been cloned", user.password.Email)
+	}
+	// Like passwordDB.Login, users who signed up themselves can't log in before an
+	// admin approved them.
+	if user.password.PendingApproval {
+		return "", fmt.Errorf("user %q hasn't been approved yet", user.password.Email)
+	}
+
+	if err := s.storage.UpdateWebAuthnCredential(base64.RawURLEncoding.EncodeToString(cred.ID), func(c storage.WebAuthnCredential) (storage.WebAuthnCredential, error) {
+		c.SignCount = cred.Authenticator.SignCount
+		c.LastUsed = s.now()
+		return c, nil
+	}); err != nil {
+		return "", fmt.Errorf("update webauthn credential: %v", err)
+	}
+
+	// Either way the user presented something they have on top of their password,
+	// or a passkey which verified them itself.
+	if err := s.storage.UpdateAuthRequest(authReq.ID, func(a storage.AuthRequest) (storage.AuthRequest, error) {
+		a.MFAValidated = true
+		return a, nil
+	}); err != nil {
+		return "", fmt.Errorf("update auth request: %v", err)
+	}
+
+	if authReq.LoggedIn {
+		return s.absPath("/approval") + "?req=" + url.QueryEscape(authReq.ID), nil
+	}
+
+	conn, err := s.getConnector(authReq.ConnectorID)
+	if err != nil {
+		return "", fmt.Errorf("get connector %q: %v", authReq.ConnectorID, err)
+	}
+	identity := passwordIdentity(parseScopes(authReq.Scopes), user.password)
+	return s.finalizeLogin(identity, authReq, conn.Connector)
+}
+
+func credentialIDs(user webAuthnUser) [][]byte {
+	var ids [][]byte
+	for _, c := range user.WebAuthnCredentials() {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// handleWebAuthnRegister renders the page registering a new credential for the logged
+// in user, and stores the credential the page posts back.
+func (s *Server) handleWebAuthnRegister(w http.ResponseWriter, r *http.Request) {
+	authReq, status, err := s.webAuthnAuthRequest(r)
+	if err != nil {
+		s.renderError(r, w, status, err.Error())
+		return
+	}
+	user, err := s.getWebAuthnUser(authReq.Claims.Email)
+	if err != nil && err != storage.ErrNotFound {
+		s.logger.Errorf("Failed to get WebAuthn user: %v", err)
+		s.renderError(r, w, http.StatusInternalServerError, "Database error.")
+		return
+	}
+	if err == storage.ErrNotFound || !s.webAuthnCanRegister(authReq, user) {
+		s.renderError(r, w, http.StatusBadRequest, "Not allowed to register a passkey.")
+		return
+	}
+
+	state := "?state=" + url.QueryEscape(authReq.ID)
+	switch r.Method {
+	case http.MethodGet:
+		skipLink := ""
+		if !s.webAuthnRequired(authReq.ConnectorID) || authReq.MFAValidated {
+			skipLink = s.absPath("/approval") + "?req=" + url.QueryEscape(authReq.ID) + "&webauthn=skip"
+		}
+		if err := s.templates.webAuthnRegister(r, w, s.absPath("/webauthn/register/options")+state, s.absPath("/webauthn/register")+state, skipLink); err != nil {
+			s.logger.Errorf("Server template error: %v", err)
+		}
+	case http.MethodPost:
+		if err := s.finishWebAuthnRegistration(r, authReq, user); err != nil {
+			s.logger.Errorf("WebAuthn registration failed: %v", err)
+			writeWebAuthnResult(w, http.StatusBadRequest, "", "Your passkey could not be registered.")
+			return
+		}
+		writeWebAuthnResult(w, http.StatusOK, s.absPath("/approval")+"?req="+url.QueryEscape(authReq.ID), "")
+	default:
+		s.renderError(r, w, http.StatusBadRequest, "Unsupported request method.")
+	}
+}
+
+// handleWebAuthnRegisterOptions starts a registration ceremony and returns the options
+// the page passes to the browser.
+func (s *Server) handleWebAuthnRegisterOptions(w http.ResponseWriter, r *http.Request) {
+	authReq, status, err := s.webAuthnAuthRequest(r)
+	if err != nil {
+		writeWebAuthnResult(w, status, "", err.Error())
+		return
+	}
+	user, err := s.getWebAuthnUser(authReq.Claims.Email)
+	if err != nil && err != storage.ErrNotFound {
+		s.logger.Errorf("Failed to get WebAuthn user: %v", err)
+		writeWebAuthnResult(w, http.StatusInternalServerError, "", "Database error.")
+		return
+	}
+	if err == storage.ErrNotFound || !s.webAuthnCanRegister(authReq, user) {
+		writeWebAuthnResult(w, http.StatusBadRequest, "", "Not allowed to register a passkey.")
+		return
+	}
+
+	opts := []webauthn.RegistrationOption{webauthn.WithExclusions(user.descriptors())}
+	if s.webAuthnConfig.Passwordless {
+		opts = append(opts, webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementPreferred))
+	}
+	options, session, err := s.webAuthn.BeginRegistration(user, opts...)
+	if err != nil {
+		s.logger.Errorf("Failed to begin WebAuthn registration: %v", err)
+		writeWebAuthnResult(w, http.StatusInternalServerError, "", "Registration error.")
+		return
+	}
+	if err := s.setWebAuthnChallenge(authReq.ID, session.Challenge); err != nil {
+		s.logger.Errorf("Failed to update auth request: %v", err)
+		writeWebAuthnResult(w, http.StatusInternalServerError, "", "Database error.")
+		return
+	}
+	writeWebAuthnJSON(w, http.StatusOK, options)
+}
+
+func (s *Server) finishWebAuthnRegistration(r *http.Request, authReq storage.AuthRequest, user webAuthnUser) error {
+	parsed, err := protocol.ParseCredentialCreationResponseBody(r.Body)
+	if err != nil {
+		return fmt.Errorf("parse attestation: %v", err)
+	}
+	challenge, err := s.takeWebAuthnChallenge(authReq.ID)
+	if err != nil {
+		return err
+	}
+	session := webauthn.SessionData{
+		Challenge:        challenge,
+		UserID:           user.WebAuthnID(),
+		UserVerification: protocol.VerificationPreferred,
+	}
+	cred, err := s.webAuthn.CreateCredential(user, session, parsed)
+	if err != nil {
+		return err
+	}
+
+	transports := make([]string, len(cred.Transport))
+	for i, t := range cred.Transport {
+		transports[i] = string(t)
+	}
+	now := s.now()
+	if err := s.storage.CreateWebAuthnCredential(storage.WebAuthnCredential{
+		ID:              base64.RawURLEncoding.EncodeToString(cred.ID),
+		Email:           user.password.Email,
+		PublicKey:       cred.PublicKey,
+		AttestationType: cred.AttestationType,
+		Transports:      transports,
+		AAGUID:          cred.Authenticator.AAGUID,
+		SignCount:       cred.Authenticator.SignCount,
+		CreatedAt:       now,
+		LastUsed:        now,
+	}); err != nil {
+		return fmt.Errorf("create webauthn credential: %v", err)
+	}
+
+	// Like setting up TOTP, registering the first credential completes the second factor.
+	if !s.webAuthnRequired(authReq.ConnectorID) {
+		return nil
+	}
+	return s.storage.UpdateAuthRequest(authReq.ID, func(a storage.AuthRequest) (storage.AuthRequest, error) {
+		a.MFAValidated = true
+		return a, nil
+	})
+}
+
+func writeWebAuthnJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeWebAuthnResult tells the page where to continue, or what went wrong.
+func writeWebAuthnResult(w http.ResponseWriter, status int, redirect, errMsg string) {
+	writeWebAuthnJSON(w, status, struct {
+		Redirect string `json:"redirect,omitempty"`
+		Error    string `json:"error,omitempty"`
+	}{redirect, errMsg})
+}
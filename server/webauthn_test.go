@@ -0,0 +1,464 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// softAuthenticator is a WebAuthn authenticator holding a single P-256 credential,
+// producing "none" attestations and assertions the way a browser would post them.
+type softAuthenticator struct {
+	t          *testing.T
+	origin     string
+	key        *ecdsa.PrivateKey
+	credID     []byte
+	userHandle []byte
+	signCount  uint32
+}
+
+func newSoftAuthenticator(t *testing.T, origin string) *softAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credID := make([]byte, 16)
+	if _, err := rand.Read(credID); err != nil {
+		t.Fatal(err)
+	}
+	return &softAuthenticator{t: t, origin: origin, key: key, credID: credID}
+}
+
+func (a *softAuthenticator) clientData(ceremony protocol.CeremonyType, challenge []byte) []byte {
+	data, err := json.Marshal(protocol.CollectedClientData{
+		Type:      ceremony,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    a.origin,
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	return data
+}
+
+func (a *softAuthenticator) authData(rpID string, flags protocol.AuthenticatorFlags, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	var buf bytes.Buffer
+	buf.Write(rpIDHash[:])
+	buf.WriteByte(byte(flags))
+	binary.Write(&buf, binary.BigEndian, a.signCount)
+	buf.Write(attested)
+	return buf.Bytes()
+}
+
+// create answers the options of a registration ceremony.
+func (a *softAuthenticator) create(body []byte) []byte {
+	var options protocol.CredentialCreation
+	if err := json.Unmarshal(body, &options); err != nil {
+		a.t.Fatalf("failed to decode registration options %s: %v", body, err)
+	}
+	a.userHandle = options.Response.User.ID
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1, // P-256
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	var attested bytes.Buffer
+	attested.Write(make([]byte, 16)) // AAGUID
+	binary.Write(&attested, binary.BigEndian, uint16(len(a.credID)))
+	attested.Write(a.credID)
+	attested.Write(publicKey)
+
+	flags := protocol.FlagUserPresent | protocol.FlagUserVerified | protocol.FlagAttestedCredentialData
+	attestation, err := webauthncbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": a.authData(options.Response.RelyingParty.ID, flags, attested.Bytes()),
+	})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	return a.marshal(map[string]interface{}{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"attestationObject": base64.RawURLEncoding.EncodeToString(attestation),
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(a.clientData(protocol.CreateCeremony, options.Response.Challenge)),
+		},
+	})
+}
+
+// get answers the options of a login ceremony.
+func (a *softAuthenticator) get(body []byte) []byte {
+	var options protocol.CredentialAssertion
+	if err := json.Unmarshal(body, &options); err != nil {
+		a.t.Fatalf("failed to decode login options %s: %v", body, err)
+	}
+	a.signCount++
+
+	authData := a.authData(options.Response.RelyingPartyID, protocol.FlagUserPresent|protocol.FlagUserVerified, nil)
+	clientData := a.clientData(protocol.AssertCeremony, options.Response.Challenge)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		a.t.Fatal(err)
+	}
+
+	return a.marshal(map[string]interface{}{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientData),
+			"signature":         base64.RawURLEncoding.EncodeToString(sig),
+			"userHandle":        base64.RawURLEncoding.EncodeToString(a.userHandle),
+		},
+	})
+}
+
+func (a *softAuthenticator) marshal(v interface{}) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	return b
+}
+
+// webAuthnTestClient drives the local connector's login pages for a test server.
+type webAuthnTestClient struct {
+	t      *testing.T
+	server *httptest.Server
+	client *http.Client
+}
+
+func newWebAuthnTestServer(ctx context.Context, t *testing.T, config WebAuthnConfig) (*webAuthnTestClient, *Server) {
+	httpServer, s := newTestServer(ctx, t, func(c *Config) {
+		c.WebAuthn = config
+		if err := c.Storage.CreateConnector(storage.Connector{ID: LocalConnector, Type: LocalConnector, Name: "Email"}); err != nil {
+			t.Fatalf("create connector: %v", err)
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Storage.CreatePassword(storage.Password{
+			Email:    "jane@example.com",
+			Hash:     hash,
+			Username: "jane",
+			UserID:   "jane-id",
+		}); err != nil {
+			t.Fatalf("create password: %v", err)
+		}
+		if err := c.Storage.CreateClient(storage.Client{
+			ID:           "test",
+			RedirectURIs: []string{"https://client.example.com/callback"},
+		}); err != nil {
+			t.Fatalf("create client: %v", err)
+		}
+	})
+	t.Cleanup(httpServer.Close)
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if strings.HasPrefix(req.URL.String(), "https://client.example.com/callback") {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+	return &webAuthnTestClient{t: t, server: httpServer, client: client}, s
+}
+
+// start begins a login with the local connector and returns the auth request ID.
+func (c *webAuthnTestClient) start() string {
+	v := url.Values{}
+	v.Set("client_id", "test")
+	v.Set("redirect_uri", "https://client.example.com/callback")
+	v.Set("response_type", "code")
+	v.Set("scope", "openid email")
+	v.Set("state", "state")
+	resp, err := c.client.Get(c.server.URL + "/auth/local?" + v.Encode())
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	resp.Body.Close()
+	state := resp.Request.URL.Query().Get("state")
+	if state == "" {
+		c.t.Fatalf("expected to land on the login page, got %s", resp.Request.URL)
+	}
+	return state
+}
+
+// loginPassword logs in with the password and returns the response of the page the
+// user lands on next.
+func (c *webAuthnTestClient) loginPassword(state string) *http.Response {
+	resp, err := c.client.PostForm(c.server.URL+"/auth/local/login?state="+url.QueryEscape(state), url.Values{
+		"login":    {"jane@example.com"},
+		"password": {"password"},
+	})
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp
+}
+
+func (c *webAuthnTestClient) post(path, state string, body []byte) (int, []byte) {
+	resp, err := c.client.Post(c.server.URL+path+"?state="+url.QueryEscape(state), "application/json", bytes.NewReader(body))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var buf bytes.Buffer
+	buf.ReadFrom(resp.Body)
+	return resp.StatusCode, buf.Bytes()
+}
+
+// ceremony runs a registration or login ceremony and returns where the page would
+// send the user next.
+func (c *webAuthnTestClient) ceremony(path, state string, answer func([]byte) []byte) (string, bool) {
+	status, options := c.post(path+"/options", state, nil)
+	if status != http.StatusOK {
+		c.t.Logf("%s options: %d %s", path, status, options)
+		return "", false
+	}
+	status, body := c.post(path, state, answer(options))
+	var result struct {
+		Redirect string `json:"redirect"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		c.t.Fatalf("failed to decode result %s: %v", body, err)
+	}
+	return result.Redirect, status == http.StatusOK
+}
+
+// follow requests the URL and returns the auth code if the user is redirected back
+// to the client.
+func (c *webAuthnTestClient) follow(u string) (*http.Response, string) {
+	resp, err := c.client.Get(c.server.URL + strings.TrimPrefix(u, c.server.URL))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound && resp.StatusCode != http.StatusSeeOther {
+		return resp, ""
+	}
+	location, err := resp.Location()
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return resp, location.Query().Get("code")
+}
+
+func TestWebAuthnPasswordless(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, s := newWebAuthnTestServer(ctx, t, WebAuthnConfig{Passwordless: true})
+	authenticator := newSoftAuthenticator(t, c.server.URL)
+
+	// Users logging in with their password are offered to register a passkey.
+	state := c.start()
+	resp := c.loginPassword(state)
+	if resp.Request.URL.Path != "/webauthn/register" {
+		t.Fatalf("expected registration to be offered, landed on %s", resp.Request.URL)
+	}
+	redirect, ok := c.ceremony("/webauthn/register", state, authenticator.create)
+	if !ok {
+		t.Fatal("failed to register passkey")
+	}
+	if _, code := c.follow(redirect); code == "" {
+		t.Fatal("expected an auth code after registering a passkey")
+	}
+
+	creds, err := s.storage.ListWebAuthnCredentials("jane@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(creds) != 1 || creds[0].ID != base64.RawURLEncoding.EncodeToString(authenticator.credID) {
+		t.Fatalf("expected the passkey to be stored, got %v", creds)
+	}
+
+	// The passkey replaces the password on the next login.
+	state = c.start()
+	options, body := c.post("/webauthn/login/options", state, nil)
+	if options != http.StatusOK {
+		t.Fatalf("failed to get login options: %s", body)
+	}
+	assertion := authenticator.get(body)
+	status, body := c.post("/webauthn/login", state, assertion)
+	if status != http.StatusOK {
+		t.Fatalf("passkey login failed: %s", body)
+	}
+	var result struct {
+		Redirect string `json:"redirect"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatal(err)
+	}
+	_, code := c.follow(result.Redirect)
+	if code == "" {
+		t.Fatal("expected an auth code after logging in with a passkey")
+	}
+	authCode, err := s.storage.GetAuthCode(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authCode.Claims.UserID != "jane-id" || authCode.Claims.Email != "jane@example.com" {
+		t.Errorf("expected the passkey's user to be logged in, got %+v", authCode.Claims)
+	}
+
+	cred, err := s.storage.GetWebAuthnCredential(creds[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cred.SignCount != authenticator.signCount {
+		t.Errorf("expected sign count %d, got %d", authenticator.signCount, cred.SignCount)
+	}
+
+	// Every challenge can only be answered once.
+	state = c.start()
+	c.post("/webauthn/login/options", state, nil)
+	if status, _ := c.post("/webauthn/login", state, assertion); status == http.StatusOK {
+		t.Error("expected a replayed assertion to be rejected")
+	}
+}
+
+func TestWebAuthnSecondFactor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, s := newWebAuthnTestServer(ctx, t, WebAuthnConfig{SecondFactor: true})
+	authenticator := newSoftAuthenticator(t, c.server.URL)
+
+	// Users without a credential have to register one after their password.
+	state := c.start()
+	resp := c.loginPassword(state)
+	if resp.Request.URL.Path != "/webauthn/register" {
+		t.Fatalf("expected registration to be required, landed on %s", resp.Request.URL)
+	}
+	if _, code := c.follow("/approval?req=" + url.QueryEscape(state) + "&webauthn=skip"); code != "" {
+		t.Fatal("expected the second factor not to be skippable")
+	}
+	redirect, ok := c.ceremony("/webauthn/register", state, authenticator.create)
+	if !ok {
+		t.Fatal("failed to register passkey")
+	}
+	if _, code := c.follow(redirect); code == "" {
+		t.Fatal("expected an auth code after registering a passkey")
+	}
+
+	// Afterwards the password alone is not enough.
+	state = c.start()
+	resp = c.loginPassword(state)
+	if resp.Request.URL.Path != "/webauthn/login" {
+		t.Fatalf("expected to be asked for the passkey, landed on %s", resp.Request.URL)
+	}
+	if _, ok := c.ceremony("/webauthn/register", state, newSoftAuthenticator(t, c.server.URL).create); ok {
+		t.Fatal("expected registering another passkey to require the second factor")
+	}
+	if _, code := c.follow("/approval?req=" + url.QueryEscape(state)); code != "" {
+		t.Fatal("expected approval to require the second factor")
+	}
+
+	other := newSoftAuthenticator(t, c.server.URL)
+	other.credID = authenticator.credID
+	other.userHandle = []byte("jane-id")
+	if _, ok := c.ceremony("/webauthn/login", state, other.get); ok {
+		t.Fatal("expected an assertion signed with another key to be rejected")
+	}
+
+	redirect, ok = c.ceremony("/webauthn/login", state, authenticator.get)
+	if !ok {
+		t.Fatal("failed to log in with passkey")
+	}
+	_, code := c.follow(redirect)
+	if code == "" {
+		t.Fatal("expected an auth code after presenting the passkey")
+	}
+	if _, err := s.storage.GetAuthCode(code); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWebAuthnUnverifiedUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, s := newWebAuthnTestServer(ctx, t, WebAuthnConfig{Passwordless: true})
+	authenticator := newSoftAuthenticator(t, c.server.URL)
+
+	// Jane signed up and never confirmed her email address.
+	if err := s.storage.UpdatePassword("jane@example.com", func(p storage.Password) (storage.Password, error) {
+		p.PendingVerification = true
+		return p, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	state := c.start()
+	c.loginPassword(state)
+	if _, ok := c.ceremony("/webauthn/register", state, authenticator.create); !ok {
+		t.Fatal("failed to register passkey")
+	}
+
+	// The passkey doesn't verify the email address.
+	state = c.start()
+	redirect, ok := c.ceremony("/webauthn/login", state, authenticator.get)
+	if !ok {
+		t.Fatal("passkey login failed")
+	}
+	_, code := c.follow(redirect)
+	if code == "" {
+		t.Fatal("expected an auth code after logging in with a passkey")
+	}
+	authCode, err := s.storage.GetAuthCode(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authCode.Claims.EmailVerified {
+		t.Error("expected the email of an unverified user not to be verified")
+	}
+
+	// Users waiting for approval can't log in with a passkey either.
+	if err := s.storage.UpdatePassword("jane@example.com", func(p storage.Password) (storage.Password, error) {
+		p.PendingApproval = true
+		return p, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	state = c.start()
+	if _, ok := c.ceremony("/webauthn/login", state, authenticator.get); ok {
+		t.Error("expected the passkey login of an unapproved user to fail")
+	}
+}
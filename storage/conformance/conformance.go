@@ -49,6 +49,15 @@ func RunTests(t *testing.T, newStorage func() storage.Storage) {
 		{"TimezoneSupport", testTimezones},
 		{"DeviceRequestCRUD", testDeviceRequestCRUD},
 		{"DeviceTokenCRUD", testDeviceTokenCRUD},
+		{"UserConsentCRUD", testUserConsentCRUD},
+		{"TOTPEnrollmentCRUD", testTOTPEnrollmentCRUD},
+		{"WebAuthnCredentialCRUD", testWebAuthnCredentialCRUD},
+		{"LoginAttemptsCRUD", testLoginAttemptsCRUD},
+		{"PasswordResetCRUD", testPasswordResetCRUD},
+		{"EmailVerificationCRUD", testEmailVerificationCRUD},
+		{"SAMLAssertionCRUD", testSAMLAssertionCRUD},
+		{"SAMLServiceProviderCRUD", testSAMLServiceProviderCRUD},
+		{"BackchannelAuthRequestCRUD", testBackchannelAuthRequestCRUD},
 	})
 }
 
@@ -92,17 +101,24 @@ func testAuthRequestCRUD(t *testing.T, s storage.Storage) {
 		RedirectURI:         "https://localhost:80/callback",
 		Nonce:               "foo",
 		State:               "bar",
+		ResponseMode:        "form_post",
+		ACRValues:           []string{"gold", "silver"},
 		ForceApprovalPrompt: true,
 		LoggedIn:            true,
 		Expiry:              neverExpire,
 		ConnectorID:         "ldap",
 		ConnectorData:       []byte(`{"some":"data"}`),
+		ConnectorChain:      []string{"ldap"},
+		MFAValidated:        true,
+		WebAuthnChallenge:   "challenge",
+		CallbackData:        []byte("verifier"),
 		Claims: storage.Claims{
 			UserID:        "1",
 			Username:      "jane",
 			Email:         "jane.doe@example.com",
 			EmailVerified: true,
 			Groups:        []string{"a", "b"},
+			ACR:           "silver",
 		},
 		PKCE: codeChallenge,
 	}
@@ -163,6 +179,23 @@ func testAuthRequestCRUD(t *testing.T, s storage.Storage) {
 		t.Fatalf("storage does not support PKCE, wanted challenge=%#v got %#v", codeChallenge, got.PKCE)
 	}
 
+	if !reflect.DeepEqual(got.ACRValues, a1.ACRValues) || !reflect.DeepEqual(got.ConnectorChain, a1.ConnectorChain) {
+		t.Fatalf("storage does not support ACRs, wanted acr values=%v chain=%v got %v %v",
+			a1.ACRValues, a1.ConnectorChain, got.ACRValues, got.ConnectorChain)
+	}
+
+	if !got.MFAValidated {
+		t.Fatalf("storage does not support MFA, wanted MFAValidated to be set")
+	}
+
+	if got.WebAuthnChallenge != a1.WebAuthnChallenge {
+		t.Fatalf("storage does not support WebAuthn, wanted challenge %q got %q", a1.WebAuthnChallenge, got.WebAuthnChallenge)
+	}
+
+	if !reflect.DeepEqual(got.CallbackData, a1.CallbackData) {
+		t.Fatalf("storage does not support callback data, wanted %q got %q", a1.CallbackData, got.CallbackData)
+	}
+
 	if err := s.DeleteAuthRequest(a1.ID); err != nil {
 		t.Fatalf("failed to delete auth request: %v", err)
 	}
@@ -195,6 +228,7 @@ func testAuthCodeCRUD(t *testing.T, s storage.Storage) {
 			Email:         "jane.doe@example.com",
 			EmailVerified: true,
 			Groups:        []string{"a", "b"},
+			ACR:           "gold",
 		},
 	}
 
@@ -260,6 +294,16 @@ func testClientCRUD(t *testing.T, s storage.Storage) {
 		RedirectURIs: []string{"foo://bar.com/", "https://auth.example.com"},
 		Name:         "dex client",
 		LogoURL:      "https://goo.gl/JIyzIC",
+
+		BackchannelNotificationEndpoint: "https://auth.example.com/ciba",
+
+		IDTokenEncryptedResponseAlg: "RSA-OAEP",
+		IDTokenEncryptedResponseEnc: "A128GCM",
+		UserInfoSignedResponseAlg:   "RS256",
+		JWKS:                        &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{*jsonWebKeys[0].Public}},
+		SubjectType:                 "pairwise",
+		SectorIdentifier:            "example.com",
+		BackchannelLogoutURI:        "https://auth.example.com/logout",
 	}
 	err := s.DeleteClient(id1)
 	mustBeErrNotFound(t, "client", err)
@@ -299,14 +343,21 @@ func testClientCRUD(t *testing.T, s storage.Storage) {
 	getAndCompare(id1, c1)
 
 	newSecret := "barfoo"
+	newEndpoint := "https://auth.example.com/ciba/notify"
 	err = s.UpdateClient(id1, func(old storage.Client) (storage.Client, error) {
 		old.Secret = newSecret
+		old.BackchannelNotificationEndpoint = newEndpoint
+		old.UserInfoEncryptedResponseAlg = "RSA-OAEP-256"
+		old.UserInfoEncryptedResponseEnc = "A256GCM"
 		return old, nil
 	})
 	if err != nil {
 		t.Errorf("update client: %v", err)
 	}
 	c1.Secret = newSecret
+	c1.BackchannelNotificationEndpoint = newEndpoint
+	c1.UserInfoEncryptedResponseAlg = "RSA-OAEP-256"
+	c1.UserInfoEncryptedResponseEnc = "A256GCM"
 	getAndCompare(id1, c1)
 
 	if err := s.DeleteClient(id1); err != nil {
@@ -339,6 +390,7 @@ func testRefreshTokenCRUD(t *testing.T, s storage.Storage) {
 			Email:         "jane.doe@example.com",
 			EmailVerified: true,
 			Groups:        []string{"a", "b"},
+			ACR:           "gold",
 		},
 		ConnectorData: []byte(`{"some":"data"}`),
 	}
@@ -382,6 +434,7 @@ func testRefreshTokenCRUD(t *testing.T, s storage.Storage) {
 		ID:            id2,
 		Token:         "bar_2",
 		ObsoleteToken: refresh.Token,
+		RetiredTokens: []string{"retired_1", "retired_2"},
 		Nonce:         "foo_2",
 		ClientID:      "client_id_2",
 		ConnectorID:   "client_secret",
@@ -408,6 +461,8 @@ func testRefreshTokenCRUD(t *testing.T, s storage.Storage) {
 
 	updater := func(r storage.RefreshToken) (storage.RefreshToken, error) {
 		r.Token = "spam"
+		r.ObsoleteToken = "bar"
+		r.RetiredTokens = []string{"retired"}
 		r.LastUsed = updatedAt
 		return r, nil
 	}
@@ -415,6 +470,8 @@ func testRefreshTokenCRUD(t *testing.T, s storage.Storage) {
 		t.Errorf("failed to update refresh token: %v", err)
 	}
 	refresh.Token = "spam"
+	refresh.ObsoleteToken = "bar"
+	refresh.RetiredTokens = []string{"retired"}
 	refresh.LastUsed = updatedAt
 	getAndCompare(id, refresh)
 
@@ -447,10 +504,13 @@ func testPasswordCRUD(t *testing.T, s storage.Storage) {
 	}
 
 	password1 := storage.Password{
-		Email:    "jane@example.com",
-		Hash:     passwordHash1,
-		Username: "jane",
-		UserID:   "foobar",
+		Email:       "jane@example.com",
+		Hash:        passwordHash1,
+		Username:    "jane",
+		UserID:      "foobar",
+		Groups:      []string{"admins", "developers"},
+		DisplayName: "Jane Doe",
+		Attributes:  map[string]string{"department": "engineering"},
 	}
 	if err := s.CreatePassword(password1); err != nil {
 		t.Fatalf("create password token: %v", err)
@@ -499,6 +559,34 @@ func testPasswordCRUD(t *testing.T, s storage.Storage) {
 	password1.Username = "jane doe"
 	getAndCompare("jane@example.com", password1)
 
+	if err := s.UpdatePassword(password1.Email, func(old storage.Password) (storage.Password, error) {
+		old.PendingVerification = true
+		old.PendingApproval = true
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update password: %v", err)
+	}
+
+	password1.PendingVerification = true
+	password1.PendingApproval = true
+	getAndCompare("jane@example.com", password1)
+
+	if err := s.UpdatePassword(password1.Email, func(old storage.Password) (storage.Password, error) {
+		old.Groups = []string{"developers"}
+		old.DisplayName = "Jane Roe"
+		old.Attributes = map[string]string{"department": "sales", "employee_id": "42"}
+		old.PreviousHashes = [][]byte{passwordHash1}
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update password: %v", err)
+	}
+
+	password1.Groups = []string{"developers"}
+	password1.DisplayName = "Jane Roe"
+	password1.Attributes = map[string]string{"department": "sales", "employee_id": "42"}
+	password1.PreviousHashes = [][]byte{passwordHash1}
+	getAndCompare("jane@example.com", password1)
+
 	var passwordList []storage.Password
 	passwordList = append(passwordList, password1, password2)
 
@@ -603,6 +691,460 @@ func testOfflineSessionCRUD(t *testing.T, s storage.Storage) {
 	mustBeErrNotFound(t, "offline session", err)
 }
 
+func testUserConsentCRUD(t *testing.T, s storage.Storage) {
+	userID := storage.NewID()
+	consent := storage.UserConsent{
+		UserID:  userID,
+		ConnID:  "Conn1",
+		Clients: make(map[string]*storage.ClientConsent),
+	}
+
+	if err := s.CreateUserConsent(consent); err != nil {
+		t.Fatalf("create user consent with UserID = %s: %v", consent.UserID, err)
+	}
+
+	err := s.CreateUserConsent(consent)
+	mustBeErrAlreadyExists(t, "user consent", err)
+
+	getAndCompare := func(want storage.UserConsent) {
+		gr, err := s.GetUserConsent(want.UserID, want.ConnID)
+		if err != nil {
+			t.Errorf("get user consent: %v", err)
+			return
+		}
+		if diff := pretty.Compare(want, gr); diff != "" {
+			t.Errorf("user consent retrieved from storage did not match: %s", diff)
+		}
+	}
+
+	getAndCompare(consent)
+
+	clientConsent := storage.ClientConsent{
+		ClientID:    "client_id",
+		Scopes:      []string{"openid", "email"},
+		CreatedAt:   time.Now().UTC().Round(time.Millisecond),
+		LastUpdated: time.Now().UTC().Round(time.Millisecond),
+	}
+	consent.Clients[clientConsent.ClientID] = &clientConsent
+
+	if err := s.UpdateUserConsent(consent.UserID, consent.ConnID, func(old storage.UserConsent) (storage.UserConsent, error) {
+		old.Clients[clientConsent.ClientID] = &clientConsent
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update user consent: %v", err)
+	}
+
+	getAndCompare(consent)
+
+	if err := s.DeleteUserConsent(consent.UserID, consent.ConnID); err != nil {
+		t.Fatalf("failed to delete user consent: %v", err)
+	}
+
+	_, err = s.GetUserConsent(consent.UserID, consent.ConnID)
+	mustBeErrNotFound(t, "user consent", err)
+
+	err = s.DeleteUserConsent(consent.UserID, consent.ConnID)
+	mustBeErrNotFound(t, "user consent", err)
+}
+
+func testTOTPEnrollmentCRUD(t *testing.T, s storage.Storage) {
+	enrollment := storage.TOTPEnrollment{
+		UserID:        storage.NewID(),
+		ConnID:        "Conn1",
+		Secret:        []byte("encrypted secret"),
+		RecoveryCodes: []string{"hash1", "hash2"},
+		CreatedAt:     time.Now().UTC().Round(time.Millisecond),
+	}
+
+	if err := s.CreateTOTPEnrollment(enrollment); err != nil {
+		t.Fatalf("create totp enrollment with UserID = %s: %v", enrollment.UserID, err)
+	}
+
+	err := s.CreateTOTPEnrollment(enrollment)
+	mustBeErrAlreadyExists(t, "totp enrollment", err)
+
+	getAndCompare := func(want storage.TOTPEnrollment) {
+		got, err := s.GetTOTPEnrollment(want.UserID, want.ConnID)
+		if err != nil {
+			t.Errorf("get totp enrollment: %v", err)
+			return
+		}
+		if !got.CreatedAt.Equal(want.CreatedAt) {
+			t.Errorf("totp enrollment created at %v, want %v", got.CreatedAt, want.CreatedAt)
+		}
+		got.CreatedAt, want.CreatedAt = time.Time{}, time.Time{}
+		if diff := pretty.Compare(want, got); diff != "" {
+			t.Errorf("totp enrollment retrieved from storage did not match: %s", diff)
+		}
+	}
+
+	getAndCompare(enrollment)
+
+	enrollment.Confirmed = true
+	enrollment.RecoveryCodes = []string{"hash2"}
+	enrollment.LastUsedStep = 54321
+
+	if err := s.UpdateTOTPEnrollment(enrollment.UserID, enrollment.ConnID, func(old storage.TOTPEnrollment) (storage.TOTPEnrollment, error) {
+		old.Confirmed = true
+		old.RecoveryCodes = old.RecoveryCodes[1:]
+		old.LastUsedStep = 54321
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update totp enrollment: %v", err)
+	}
+
+	getAndCompare(enrollment)
+
+	if err := s.DeleteTOTPEnrollment(enrollment.UserID, enrollment.ConnID); err != nil {
+		t.Fatalf("failed to delete totp enrollment: %v", err)
+	}
+
+	_, err = s.GetTOTPEnrollment(enrollment.UserID, enrollment.ConnID)
+	mustBeErrNotFound(t, "totp enrollment", err)
+
+	err = s.DeleteTOTPEnrollment(enrollment.UserID, enrollment.ConnID)
+	mustBeErrNotFound(t, "totp enrollment", err)
+}
+
+func testWebAuthnCredentialCRUD(t *testing.T, s storage.Storage) {
+	now := time.Now().UTC().Round(time.Millisecond)
+	cred1 := storage.WebAuthnCredential{
+		ID:              storage.NewID(),
+		Email:           "jane@example.com",
+		PublicKey:       []byte("public key"),
+		AttestationType: "none",
+		Transports:      []string{"usb", "nfc"},
+		AAGUID:          []byte("0123456789abcdef"),
+		SignCount:       1,
+		CreatedAt:       now,
+		LastUsed:        now,
+	}
+	cred2 := storage.WebAuthnCredential{
+		ID:              storage.NewID(),
+		Email:           "john@example.com",
+		PublicKey:       []byte("other public key"),
+		AttestationType: "none",
+		AAGUID:          []byte("0123456789abcdef"),
+		CreatedAt:       now,
+		LastUsed:        now,
+	}
+
+	for _, cred := range []storage.WebAuthnCredential{cred1, cred2} {
+		if err := s.CreateWebAuthnCredential(cred); err != nil {
+			t.Fatalf("create webauthn credential with ID = %s: %v", cred.ID, err)
+		}
+	}
+
+	err := s.CreateWebAuthnCredential(cred1)
+	mustBeErrAlreadyExists(t, "webauthn credential", err)
+
+	getAndCompare := func(want storage.WebAuthnCredential) {
+		got, err := s.GetWebAuthnCredential(want.ID)
+		if err != nil {
+			t.Errorf("get webauthn credential: %v", err)
+			return
+		}
+		if !got.CreatedAt.Equal(want.CreatedAt) || !got.LastUsed.Equal(want.LastUsed) {
+			t.Errorf("webauthn credential times %v %v, want %v %v", got.CreatedAt, got.LastUsed, want.CreatedAt, want.LastUsed)
+		}
+		got.CreatedAt, want.CreatedAt = time.Time{}, time.Time{}
+		got.LastUsed, want.LastUsed = time.Time{}, time.Time{}
+		if diff := pretty.Compare(want, got); diff != "" {
+			t.Errorf("webauthn credential retrieved from storage did not match: %s", diff)
+		}
+	}
+
+	getAndCompare(cred1)
+
+	creds, err := s.ListWebAuthnCredentials("Jane@Example.com")
+	if err != nil {
+		t.Fatalf("list webauthn credentials: %v", err)
+	}
+	if len(creds) != 1 || creds[0].ID != cred1.ID {
+		t.Errorf("expected only credential %q to be listed, got %v", cred1.ID, creds)
+	}
+
+	cred1.SignCount = 42
+	cred1.LastUsed = now.Add(time.Minute)
+	if err := s.UpdateWebAuthnCredential(cred1.ID, func(old storage.WebAuthnCredential) (storage.WebAuthnCredential, error) {
+		old.SignCount = 42
+		old.LastUsed = now.Add(time.Minute)
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update webauthn credential: %v", err)
+	}
+
+	getAndCompare(cred1)
+
+	for _, cred := range []storage.WebAuthnCredential{cred1, cred2} {
+		if err := s.DeleteWebAuthnCredential(cred.ID); err != nil {
+			t.Fatalf("failed to delete webauthn credential: %v", err)
+		}
+	}
+
+	_, err = s.GetWebAuthnCredential(cred1.ID)
+	mustBeErrNotFound(t, "webauthn credential", err)
+
+	err = s.DeleteWebAuthnCredential(cred1.ID)
+	mustBeErrNotFound(t, "webauthn credential", err)
+}
+
+func testLoginAttemptsCRUD(t *testing.T, s storage.Storage) {
+	now := time.Now().UTC().Round(time.Millisecond)
+	a1 := storage.LoginAttempts{
+		ID:          "user|local|jane@example.com",
+		Failures:    1,
+		LastFailure: now,
+		Expiry:      now.Add(24 * time.Hour),
+	}
+	a2 := storage.LoginAttempts{
+		ID:          "ip|192.0.2.1",
+		Failures:    3,
+		LastFailure: now,
+		LockedUntil: now.Add(time.Minute),
+		Expiry:      now.Add(24 * time.Hour),
+	}
+
+	for _, a := range []storage.LoginAttempts{a1, a2} {
+		if err := s.CreateLoginAttempts(a); err != nil {
+			t.Fatalf("create login attempts with ID = %s: %v", a.ID, err)
+		}
+	}
+
+	err := s.CreateLoginAttempts(a1)
+	mustBeErrAlreadyExists(t, "login attempts", err)
+
+	getAndCompare := func(want storage.LoginAttempts) {
+		got, err := s.GetLoginAttempts(want.ID)
+		if err != nil {
+			t.Errorf("get login attempts: %v", err)
+			return
+		}
+		if !got.LastFailure.Equal(want.LastFailure) || !got.LockedUntil.Equal(want.LockedUntil) || !got.Expiry.Equal(want.Expiry) {
+			t.Errorf("login attempts times %v %v %v, want %v %v %v",
+				got.LastFailure, got.LockedUntil, got.Expiry, want.LastFailure, want.LockedUntil, want.Expiry)
+		}
+		got.LastFailure, want.LastFailure = time.Time{}, time.Time{}
+		got.LockedUntil, want.LockedUntil = time.Time{}, time.Time{}
+		got.Expiry, want.Expiry = time.Time{}, time.Time{}
+		if diff := pretty.Compare(want, got); diff != "" {
+			t.Errorf("login attempts retrieved from storage did not match: %s", diff)
+		}
+	}
+
+	getAndCompare(a1)
+	getAndCompare(a2)
+
+	attempts, err := s.ListLoginAttempts()
+	if err != nil {
+		t.Fatalf("list login attempts: %v", err)
+	}
+	if len(attempts) != 2 {
+		t.Errorf("expected 2 login attempts, got %d", len(attempts))
+	}
+
+	a1.Failures = 2
+	a1.LockedUntil = now.Add(2 * time.Minute)
+	if err := s.UpdateLoginAttempts(a1.ID, func(old storage.LoginAttempts) (storage.LoginAttempts, error) {
+		old.Failures = 2
+		old.LockedUntil = now.Add(2 * time.Minute)
+		return old, nil
+	}); err != nil {
+		t.Fatalf("failed to update login attempts: %v", err)
+	}
+
+	getAndCompare(a1)
+
+	for _, a := range []storage.LoginAttempts{a1, a2} {
+		if err := s.DeleteLoginAttempts(a.ID); err != nil {
+			t.Fatalf("failed to delete login attempts: %v", err)
+		}
+	}
+
+	_, err = s.GetLoginAttempts(a1.ID)
+	mustBeErrNotFound(t, "login attempts", err)
+
+	err = s.DeleteLoginAttempts(a1.ID)
+	mustBeErrNotFound(t, "login attempts", err)
+}
+
+func testPasswordResetCRUD(t *testing.T, s storage.Storage) {
+	r := storage.PasswordReset{
+		ID:     storage.NewID(),
+		Email:  "jane@example.com",
+		Expiry: time.Now().UTC().Round(time.Millisecond).Add(time.Hour),
+	}
+
+	if err := s.CreatePasswordReset(r); err != nil {
+		t.Fatalf("create password reset: %v", err)
+	}
+
+	err := s.CreatePasswordReset(r)
+	mustBeErrAlreadyExists(t, "password reset", err)
+
+	got, err := s.GetPasswordReset(r.ID)
+	if err != nil {
+		t.Fatalf("get password reset: %v", err)
+	}
+	if !got.Expiry.Equal(r.Expiry) {
+		t.Errorf("password reset expiry %v, want %v", got.Expiry, r.Expiry)
+	}
+	got.Expiry = r.Expiry
+	if diff := pretty.Compare(r, got); diff != "" {
+		t.Errorf("password reset retrieved from storage did not match: %s", diff)
+	}
+
+	other := storage.PasswordReset{
+		ID:     storage.NewID(),
+		Email:  "john@example.com",
+		Expiry: r.Expiry,
+	}
+	if err := s.CreatePasswordReset(other); err != nil {
+		t.Fatalf("create password reset: %v", err)
+	}
+	resets, err := s.ListPasswordResets("Jane@Example.com")
+	if err != nil {
+		t.Fatalf("list password resets: %v", err)
+	}
+	if len(resets) != 1 || resets[0].ID != r.ID {
+		t.Errorf("expected only the password reset of jane, got %v", resets)
+	}
+	if err := s.DeletePasswordReset(other.ID); err != nil {
+		t.Fatalf("delete password reset: %v", err)
+	}
+
+	if err := s.DeletePasswordReset(r.ID); err != nil {
+		t.Fatalf("delete password reset: %v", err)
+	}
+
+	_, err = s.GetPasswordReset(r.ID)
+	mustBeErrNotFound(t, "password reset", err)
+
+	err = s.DeletePasswordReset(r.ID)
+	mustBeErrNotFound(t, "password reset", err)
+}
+
+func testEmailVerificationCRUD(t *testing.T, s storage.Storage) {
+	v := storage.EmailVerification{
+		ID:     storage.NewID(),
+		Email:  "jane@example.com",
+		Expiry: time.Now().UTC().Round(time.Millisecond).Add(time.Hour),
+	}
+
+	if err := s.CreateEmailVerification(v); err != nil {
+		t.Fatalf("create email verification: %v", err)
+	}
+
+	err := s.CreateEmailVerification(v)
+	mustBeErrAlreadyExists(t, "email verification", err)
+
+	got, err := s.GetEmailVerification(v.ID)
+	if err != nil {
+		t.Fatalf("get email verification: %v", err)
+	}
+	if !got.Expiry.Equal(v.Expiry) {
+		t.Errorf("email verification expiry %v, want %v", got.Expiry, v.Expiry)
+	}
+	got.Expiry = v.Expiry
+	if diff := pretty.Compare(v, got); diff != "" {
+		t.Errorf("email verification retrieved from storage did not match: %s", diff)
+	}
+
+	if err := s.DeleteEmailVerification(v.ID); err != nil {
+		t.Fatalf("delete email verification: %v", err)
+	}
+
+	_, err = s.GetEmailVerification(v.ID)
+	mustBeErrNotFound(t, "email verification", err)
+
+	err = s.DeleteEmailVerification(v.ID)
+	mustBeErrNotFound(t, "email verification", err)
+}
+
+func testSAMLAssertionCRUD(t *testing.T, s storage.Storage) {
+	a := storage.SAMLAssertion{
+		ID:     "saml|_8e8dc5f69a98cc4c1ff3427e5ce34606fd672f91e6",
+		Expiry: time.Now().UTC().Round(time.Millisecond).Add(5 * time.Minute),
+	}
+
+	_, err := s.GetSAMLAssertion(a.ID)
+	mustBeErrNotFound(t, "saml assertion", err)
+
+	if err := s.CreateSAMLAssertion(a); err != nil {
+		t.Fatalf("create saml assertion: %v", err)
+	}
+
+	err = s.CreateSAMLAssertion(a)
+	mustBeErrAlreadyExists(t, "saml assertion", err)
+
+	got, err := s.GetSAMLAssertion(a.ID)
+	if err != nil {
+		t.Fatalf("get saml assertion: %v", err)
+	}
+	if got.ID != a.ID || !got.Expiry.Equal(a.Expiry) {
+		t.Errorf("saml assertion %q expiring %v, want %q expiring %v", got.ID, got.Expiry, a.ID, a.Expiry)
+	}
+}
+
+func testSAMLServiceProviderCRUD(t *testing.T, s storage.Storage) {
+	sp := storage.SAMLServiceProvider{
+		ID:           "https://app.example.com/saml/metadata",
+		Name:         "Example App",
+		ACSURLs:      []string{"https://app.example.com/saml/acs"},
+		NameIDFormat: "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		AttributeNames: map[string]string{
+			"email": "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+		},
+	}
+
+	_, err := s.GetSAMLServiceProvider(sp.ID)
+	mustBeErrNotFound(t, "saml service provider", err)
+
+	if err := s.CreateSAMLServiceProvider(sp); err != nil {
+		t.Fatalf("create saml service provider: %v", err)
+	}
+
+	err = s.CreateSAMLServiceProvider(sp)
+	mustBeErrAlreadyExists(t, "saml service provider", err)
+
+	getAndCompare := func(want storage.SAMLServiceProvider) {
+		got, err := s.GetSAMLServiceProvider(want.ID)
+		if err != nil {
+			t.Errorf("get saml service provider: %v", err)
+			return
+		}
+		if diff := pretty.Compare(want, got); diff != "" {
+			t.Errorf("saml service provider retrieved from storage did not match: %s", diff)
+		}
+	}
+	getAndCompare(sp)
+
+	if err := s.UpdateSAMLServiceProvider(sp.ID, func(old storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error) {
+		old.ACSURLs = append(old.ACSURLs, "https://app.example.com/saml/acs2")
+		old.AttributeNames = nil
+		return old, nil
+	}); err != nil {
+		t.Fatalf("update saml service provider: %v", err)
+	}
+	sp.ACSURLs = append(sp.ACSURLs, "https://app.example.com/saml/acs2")
+	sp.AttributeNames = nil
+	getAndCompare(sp)
+
+	sps, err := s.ListSAMLServiceProviders()
+	if err != nil {
+		t.Fatalf("list saml service providers: %v", err)
+	}
+	if diff := pretty.Compare([]storage.SAMLServiceProvider{sp}, sps); diff != "" {
+		t.Errorf("saml service providers listed from storage did not match: %s", diff)
+	}
+
+	if err := s.DeleteSAMLServiceProvider(sp.ID); err != nil {
+		t.Fatalf("delete saml service provider: %v", err)
+	}
+	_, err = s.GetSAMLServiceProvider(sp.ID)
+	mustBeErrNotFound(t, "saml service provider", err)
+}
+
 func testConnectorCRUD(t *testing.T, s storage.Storage) {
 	id1 := storage.NewID()
 	config1 := []byte(`{"issuer": "https://accounts.google.com"}`)
@@ -918,6 +1460,173 @@ func testGC(t *testing.T, s storage.Storage) {
 	} else if err != storage.ErrNotFound {
 		t.Errorf("expected storage.ErrNotFound, got %v", err)
 	}
+
+	br := storage.BackchannelAuthRequest{
+		ID:        storage.NewID(),
+		ClientID:  "client1",
+		Scopes:    []string{"openid"},
+		LoginHint: "jane@example.com",
+		Expiry:    expiry,
+	}
+
+	if err := s.CreateBackchannelAuthRequest(br); err != nil {
+		t.Fatalf("failed creating backchannel auth request: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.BackchannelAuthRequests != 0 {
+			t.Errorf("expected no backchannel auth request garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetBackchannelAuthRequest(br.ID); err != nil {
+			t.Errorf("expected to be able to get backchannel auth request after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.BackchannelAuthRequests != 1 {
+		t.Errorf("expected to garbage collect 1 backchannel auth request, got %d", r.BackchannelAuthRequests)
+	}
+
+	if _, err := s.GetBackchannelAuthRequest(br.ID); err == nil {
+		t.Errorf("expected backchannel auth request to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
+
+	la := storage.LoginAttempts{
+		ID:          "user|local|jane@example.com",
+		Failures:    1,
+		LastFailure: expiry.Add(-time.Hour),
+		Expiry:      expiry,
+	}
+
+	if err := s.CreateLoginAttempts(la); err != nil {
+		t.Fatalf("failed creating login attempts: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.LoginAttempts != 0 {
+			t.Errorf("expected no login attempts garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetLoginAttempts(la.ID); err != nil {
+			t.Errorf("expected to be able to get login attempts after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.LoginAttempts != 1 {
+		t.Errorf("expected to garbage collect 1 login attempts, got %d", r.LoginAttempts)
+	}
+
+	if _, err := s.GetLoginAttempts(la.ID); err == nil {
+		t.Errorf("expected login attempts to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
+
+	pr := storage.PasswordReset{
+		ID:     storage.NewID(),
+		Email:  "jane@example.com",
+		Expiry: expiry,
+	}
+
+	if err := s.CreatePasswordReset(pr); err != nil {
+		t.Fatalf("failed creating password reset: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.PasswordResets != 0 {
+			t.Errorf("expected no password reset garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetPasswordReset(pr.ID); err != nil {
+			t.Errorf("expected to be able to get password reset after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.PasswordResets != 1 {
+		t.Errorf("expected to garbage collect 1 password reset, got %d", r.PasswordResets)
+	}
+
+	if _, err := s.GetPasswordReset(pr.ID); err == nil {
+		t.Errorf("expected password reset to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
+
+	ev := storage.EmailVerification{
+		ID:     storage.NewID(),
+		Email:  "jane@example.com",
+		Expiry: expiry,
+	}
+
+	if err := s.CreateEmailVerification(ev); err != nil {
+		t.Fatalf("failed creating email verification: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.EmailVerifications != 0 {
+			t.Errorf("expected no email verification garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetEmailVerification(ev.ID); err != nil {
+			t.Errorf("expected to be able to get email verification after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.EmailVerifications != 1 {
+		t.Errorf("expected to garbage collect 1 email verification, got %d", r.EmailVerifications)
+	}
+
+	if _, err := s.GetEmailVerification(ev.ID); err == nil {
+		t.Errorf("expected email verification to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
+
+	sa := storage.SAMLAssertion{
+		ID:     "saml|_8e8dc5f69a98cc4c1ff3427e5ce34606fd672f91e6",
+		Expiry: expiry,
+	}
+
+	if err := s.CreateSAMLAssertion(sa); err != nil {
+		t.Fatalf("failed creating saml assertion: %v", err)
+	}
+
+	for _, tz := range []*time.Location{time.UTC, est, pst} {
+		result, err := s.GarbageCollect(expiry.Add(-time.Hour).In(tz))
+		if err != nil {
+			t.Errorf("garbage collection failed: %v", err)
+		} else if result.SAMLAssertions != 0 {
+			t.Errorf("expected no saml assertion garbage collection results, got %#v", result)
+		}
+		if _, err := s.GetSAMLAssertion(sa.ID); err != nil {
+			t.Errorf("expected to be able to get saml assertion after GC: %v", err)
+		}
+	}
+	if r, err := s.GarbageCollect(expiry.Add(time.Hour)); err != nil {
+		t.Errorf("garbage collection failed: %v", err)
+	} else if r.SAMLAssertions != 1 {
+		t.Errorf("expected to garbage collect 1 saml assertion, got %d", r.SAMLAssertions)
+	}
+
+	if _, err := s.GetSAMLAssertion(sa.ID); err == nil {
+		t.Errorf("expected saml assertion to be GC'd")
+	} else if err != storage.ErrNotFound {
+		t.Errorf("expected storage.ErrNotFound, got %v", err)
+	}
 }
 
 // testTimezones tests that backends either fully support timezones or
@@ -1030,3 +1739,40 @@ func testDeviceTokenCRUD(t *testing.T, s storage.Storage) {
 		t.Fatalf("update failed, wanted token %v got %v", "token data", got.Token)
 	}
 }
+
+func testBackchannelAuthRequestCRUD(t *testing.T, s storage.Storage) {
+	b1 := storage.BackchannelAuthRequest{
+		ID:                      storage.NewID(),
+		ClientID:                "client1",
+		Scopes:                  []string{"openid", "email"},
+		LoginHint:               "jane@example.com",
+		BindingMessage:          "W4SCT",
+		ClientNotificationToken: "8d67dc78-7faa-4d41-aabd-67707b374255",
+		Expiry:                  neverExpire,
+	}
+
+	if err := s.CreateBackchannelAuthRequest(b1); err != nil {
+		t.Fatalf("failed creating backchannel auth request: %v", err)
+	}
+
+	// Attempt to create same BackchannelAuthRequest twice.
+	err := s.CreateBackchannelAuthRequest(b1)
+	mustBeErrAlreadyExists(t, "backchannel auth request", err)
+
+	got, err := s.GetBackchannelAuthRequest(b1.ID)
+	if err != nil {
+		t.Fatalf("failed to get backchannel auth request: %v", err)
+	}
+
+	// Ignore expiry comparison, some backends don't preserve timezones.
+	got.Expiry = b1.Expiry
+	if diff := pretty.Compare(b1, got); diff != "" {
+		t.Errorf("backchannel auth request retrieved from storage did not match: %s", diff)
+	}
+
+	_, err = s.GetBackchannelAuthRequest(storage.NewID())
+	mustBeErrNotFound(t, "backchannel auth request", err)
+
+	// No manual deletes for backchannel auth requests, will be handled by garbage collection routines
+	// see testGC
+}
@@ -20,6 +20,7 @@ func (d *Database) CreateAuthCode(code storage.AuthCode) error {
 		SetClaimsUsername(code.Claims.Username).
 		SetClaimsPreferredUsername(code.Claims.PreferredUsername).
 		SetClaimsGroups(code.Claims.Groups).
+		SetClaimsAcr(code.Claims.ACR).
 		SetCodeChallenge(code.PKCE.CodeChallenge).
 		SetCodeChallengeMethod(code.PKCE.CodeChallengeMethod).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
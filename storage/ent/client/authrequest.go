@@ -17,6 +17,7 @@ func (d *Database) CreateAuthRequest(authRequest storage.AuthRequest) error {
 		SetRedirectURI(authRequest.RedirectURI).
 		SetState(authRequest.State).
 		SetNonce(authRequest.Nonce).
+		SetResponseMode(authRequest.ResponseMode).
 		SetForceApprovalPrompt(authRequest.ForceApprovalPrompt).
 		SetLoggedIn(authRequest.LoggedIn).
 		SetClaimsUserID(authRequest.Claims.UserID).
@@ -25,6 +26,12 @@ func (d *Database) CreateAuthRequest(authRequest storage.AuthRequest) error {
 		SetClaimsUsername(authRequest.Claims.Username).
 		SetClaimsPreferredUsername(authRequest.Claims.PreferredUsername).
 		SetClaimsGroups(authRequest.Claims.Groups).
+		SetClaimsAcr(authRequest.Claims.ACR).
+		SetAcrValues(authRequest.ACRValues).
+		SetConnectorChain(authRequest.ConnectorChain).
+		SetMfaValidated(authRequest.MFAValidated).
+		SetWebauthnChallenge(authRequest.WebAuthnChallenge).
+		SetCallbackData(authRequest.CallbackData).
 		SetCodeChallenge(authRequest.PKCE.CodeChallenge).
 		SetCodeChallengeMethod(authRequest.PKCE.CodeChallengeMethod).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
@@ -80,6 +87,7 @@ func (d *Database) UpdateAuthRequest(id string, updater func(old storage.AuthReq
 		SetRedirectURI(newAuthRequest.RedirectURI).
 		SetState(newAuthRequest.State).
 		SetNonce(newAuthRequest.Nonce).
+		SetResponseMode(newAuthRequest.ResponseMode).
 		SetForceApprovalPrompt(newAuthRequest.ForceApprovalPrompt).
 		SetLoggedIn(newAuthRequest.LoggedIn).
 		SetClaimsUserID(newAuthRequest.Claims.UserID).
@@ -88,6 +96,12 @@ func (d *Database) UpdateAuthRequest(id string, updater func(old storage.AuthReq
 		SetClaimsUsername(newAuthRequest.Claims.Username).
 		SetClaimsPreferredUsername(newAuthRequest.Claims.PreferredUsername).
 		SetClaimsGroups(newAuthRequest.Claims.Groups).
+		SetClaimsAcr(newAuthRequest.Claims.ACR).
+		SetAcrValues(newAuthRequest.ACRValues).
+		SetConnectorChain(newAuthRequest.ConnectorChain).
+		SetMfaValidated(newAuthRequest.MFAValidated).
+		SetWebauthnChallenge(newAuthRequest.WebAuthnChallenge).
+		SetCallbackData(newAuthRequest.CallbackData).
 		SetCodeChallenge(newAuthRequest.PKCE.CodeChallenge).
 		SetCodeChallengeMethod(newAuthRequest.PKCE.CodeChallengeMethod).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
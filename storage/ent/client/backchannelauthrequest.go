@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateBackchannelAuthRequest saves provided backchannel authentication request into the database.
+func (d *Database) CreateBackchannelAuthRequest(request storage.BackchannelAuthRequest) error {
+	_, err := d.client.BackchannelAuthRequest.Create().
+		SetID(request.ID).
+		SetClientID(request.ClientID).
+		SetScopes(request.Scopes).
+		SetLoginHint(request.LoginHint).
+		SetBindingMessage(request.BindingMessage).
+		SetClientNotificationToken(request.ClientNotificationToken).
+		// Save utc time into database because ent doesn't support comparing dates with different timezones
+		SetExpiry(request.Expiry.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create backchannel auth request: %w", err)
+	}
+	return nil
+}
+
+// GetBackchannelAuthRequest extracts a backchannel authentication request from the database by id.
+func (d *Database) GetBackchannelAuthRequest(id string) (storage.BackchannelAuthRequest, error) {
+	request, err := d.client.BackchannelAuthRequest.Get(context.TODO(), id)
+	if err != nil {
+		return storage.BackchannelAuthRequest{}, convertDBError("get backchannel auth request: %w", err)
+	}
+	return toStorageBackchannelAuthRequest(request), nil
+}
@@ -16,6 +16,16 @@ func (d *Database) CreateClient(client storage.Client) error {
 		SetLogoURL(client.LogoURL).
 		SetRedirectUris(client.RedirectURIs).
 		SetTrustedPeers(client.TrustedPeers).
+		SetBackchannelNotificationEndpoint(client.BackchannelNotificationEndpoint).
+		SetIDTokenEncryptedResponseAlg(client.IDTokenEncryptedResponseAlg).
+		SetIDTokenEncryptedResponseEnc(client.IDTokenEncryptedResponseEnc).
+		SetUserinfoSignedResponseAlg(client.UserInfoSignedResponseAlg).
+		SetUserinfoEncryptedResponseAlg(client.UserInfoEncryptedResponseAlg).
+		SetUserinfoEncryptedResponseEnc(client.UserInfoEncryptedResponseEnc).
+		SetJwks(client.JWKS).
+		SetSubjectType(client.SubjectType).
+		SetSectorIdentifier(client.SectorIdentifier).
+		SetBackchannelLogoutURI(client.BackchannelLogoutURI).
 		Save(context.TODO())
 	if err != nil {
 		return convertDBError("create oauth2 client: %w", err)
@@ -79,6 +89,16 @@ func (d *Database) UpdateClient(id string, updater func(old storage.Client) (sto
 		SetLogoURL(newClient.LogoURL).
 		SetRedirectUris(newClient.RedirectURIs).
 		SetTrustedPeers(newClient.TrustedPeers).
+		SetBackchannelNotificationEndpoint(newClient.BackchannelNotificationEndpoint).
+		SetIDTokenEncryptedResponseAlg(newClient.IDTokenEncryptedResponseAlg).
+		SetIDTokenEncryptedResponseEnc(newClient.IDTokenEncryptedResponseEnc).
+		SetUserinfoSignedResponseAlg(newClient.UserInfoSignedResponseAlg).
+		SetUserinfoEncryptedResponseAlg(newClient.UserInfoEncryptedResponseAlg).
+		SetUserinfoEncryptedResponseEnc(newClient.UserInfoEncryptedResponseEnc).
+		SetJwks(newClient.JWKS).
+		SetSubjectType(newClient.SubjectType).
+		SetSectorIdentifier(newClient.SectorIdentifier).
+		SetBackchannelLogoutURI(newClient.BackchannelLogoutURI).
 		Save(context.TODO())
 	if err != nil {
 		return rollback(tx, "update client uploading: %w", err)
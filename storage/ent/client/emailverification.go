@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateEmailVerification saves provided email verification into the database.
+func (d *Database) CreateEmailVerification(r storage.EmailVerification) error {
+	_, err := d.client.EmailVerification.Create().
+		SetID(r.ID).
+		SetEmail(strings.ToLower(r.Email)).
+		SetExpiry(r.Expiry.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create email verification: %w", err)
+	}
+	return nil
+}
+
+// GetEmailVerification extracts a email verification from the database by id.
+func (d *Database) GetEmailVerification(id string) (storage.EmailVerification, error) {
+	r, err := d.client.EmailVerification.Get(context.TODO(), id)
+	if err != nil {
+		return storage.EmailVerification{}, convertDBError("get email verification: %w", err)
+	}
+	return toStorageEmailVerification(r), nil
+}
+
+// DeleteEmailVerification deletes a email verification from the database by id.
+func (d *Database) DeleteEmailVerification(id string) error {
+	err := d.client.EmailVerification.DeleteOneID(id).Exec(context.TODO())
+	if err != nil {
+		return convertDBError("delete email verification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateLoginAttempts saves provided login attempts into the database.
+func (d *Database) CreateLoginAttempts(a storage.LoginAttempts) error {
+	_, err := d.client.LoginAttempts.Create().
+		SetID(a.ID).
+		SetFailures(a.Failures).
+		SetLastFailure(a.LastFailure.UTC()).
+		SetLockedUntil(a.LockedUntil.UTC()).
+		SetExpiry(a.Expiry.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create login attempts: %w", err)
+	}
+	return nil
+}
+
+// GetLoginAttempts extracts login attempts from the database by id.
+func (d *Database) GetLoginAttempts(id string) (storage.LoginAttempts, error) {
+	a, err := d.client.LoginAttempts.Get(context.TODO(), id)
+	if err != nil {
+		return storage.LoginAttempts{}, convertDBError("get login attempts: %w", err)
+	}
+	return toStorageLoginAttempts(a), nil
+}
+
+// ListLoginAttempts extracts an array of login attempts from the database.
+func (d *Database) ListLoginAttempts() ([]storage.LoginAttempts, error) {
+	attempts, err := d.client.LoginAttempts.Query().All(context.TODO())
+	if err != nil {
+		return nil, convertDBError("list login attempts: %w", err)
+	}
+
+	storageAttempts := make([]storage.LoginAttempts, 0, len(attempts))
+	for _, a := range attempts {
+		storageAttempts = append(storageAttempts, toStorageLoginAttempts(a))
+	}
+	return storageAttempts, nil
+}
+
+// DeleteLoginAttempts deletes login attempts from the database by id.
+func (d *Database) DeleteLoginAttempts(id string) error {
+	err := d.client.LoginAttempts.DeleteOneID(id).Exec(context.TODO())
+	if err != nil {
+		return convertDBError("delete login attempts: %w", err)
+	}
+	return nil
+}
+
+// UpdateLoginAttempts changes login attempts by id using an updater function.
+func (d *Database) UpdateLoginAttempts(id string, updater func(a storage.LoginAttempts) (storage.LoginAttempts, error)) error {
+	tx, err := d.BeginTx(context.TODO())
+	if err != nil {
+		return convertDBError("update login attempts tx: %w", err)
+	}
+
+	a, err := tx.LoginAttempts.Get(context.TODO(), id)
+	if err != nil {
+		return rollback(tx, "update login attempts database: %w", err)
+	}
+
+	newAttempts, err := updater(toStorageLoginAttempts(a))
+	if err != nil {
+		return rollback(tx, "update login attempts updating: %w", err)
+	}
+
+	_, err = tx.LoginAttempts.UpdateOneID(id).
+		SetFailures(newAttempts.Failures).
+		SetLastFailure(newAttempts.LastFailure.UTC()).
+		SetLockedUntil(newAttempts.LockedUntil.UTC()).
+		SetExpiry(newAttempts.Expiry.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return rollback(tx, "update login attempts uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update login attempts commit: %w", err)
+	}
+
+	return nil
+}
@@ -10,9 +10,14 @@ import (
 	"github.com/dexidp/dex/storage/ent/db"
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/emailverification"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
 	"github.com/dexidp/dex/storage/ent/db/migrate"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
 )
 
 var _ storage.Storage = (*Database)(nil)
@@ -106,5 +111,45 @@ func (d *Database) GarbageCollect(now time.Time) (storage.GCResult, error) {
 	}
 	result.DeviceTokens = int64(q)
 
+	q, err = d.client.BackchannelAuthRequest.Delete().
+		Where(backchannelauthrequest.ExpiryLT(utcNow)).
+		Exec(context.TODO())
+	if err != nil {
+		return result, convertDBError("gc backchannel auth request: %w", err)
+	}
+	result.BackchannelAuthRequests = int64(q)
+
+	q, err = d.client.LoginAttempts.Delete().
+		Where(loginattempts.ExpiryLT(utcNow)).
+		Exec(context.TODO())
+	if err != nil {
+		return result, convertDBError("gc login attempts: %w", err)
+	}
+	result.LoginAttempts = int64(q)
+
+	q, err = d.client.PasswordReset.Delete().
+		Where(passwordreset.ExpiryLT(utcNow)).
+		Exec(context.TODO())
+	if err != nil {
+		return result, convertDBError("gc password reset: %w", err)
+	}
+	result.PasswordResets = int64(q)
+
+	q, err = d.client.EmailVerification.Delete().
+		Where(emailverification.ExpiryLT(utcNow)).
+		Exec(context.TODO())
+	if err != nil {
+		return result, convertDBError("gc email verification: %w", err)
+	}
+	result.EmailVerifications = int64(q)
+
+	q, err = d.client.SAMLAssertion.Delete().
+		Where(samlassertion.ExpiryLT(utcNow)).
+		Exec(context.TODO())
+	if err != nil {
+		return result, convertDBError("gc saml assertion: %w", err)
+	}
+	result.SAMLAssertions = int64(q)
+
 	return result, err
 }
@@ -13,8 +13,14 @@ func (d *Database) CreatePassword(password storage.Password) error {
 	_, err := d.client.Password.Create().
 		SetEmail(password.Email).
 		SetHash(password.Hash).
+		SetPreviousHashes(password.PreviousHashes).
 		SetUsername(password.Username).
 		SetUserID(password.UserID).
+		SetPendingVerification(password.PendingVerification).
+		SetPendingApproval(password.PendingApproval).
+		SetGroups(password.Groups).
+		SetDisplayName(password.DisplayName).
+		SetAttributes(password.Attributes).
 		Save(context.TODO())
 	if err != nil {
 		return convertDBError("create password: %w", err)
@@ -85,8 +91,14 @@ func (d *Database) UpdatePassword(email string, updater func(old storage.Passwor
 		Where(password.Email(newPassword.Email)).
 		SetEmail(newPassword.Email).
 		SetHash(newPassword.Hash).
+		SetPreviousHashes(newPassword.PreviousHashes).
 		SetUsername(newPassword.Username).
 		SetUserID(newPassword.UserID).
+		SetPendingVerification(newPassword.PendingVerification).
+		SetPendingApproval(newPassword.PendingApproval).
+		SetGroups(newPassword.Groups).
+		SetDisplayName(newPassword.DisplayName).
+		SetAttributes(newPassword.Attributes).
 		Save(context.TODO())
 	if err != nil {
 		return rollback(tx, "update password uploading: %w", err)
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
+)
+
+// CreatePasswordReset saves provided password reset into the database.
+func (d *Database) CreatePasswordReset(r storage.PasswordReset) error {
+	_, err := d.client.PasswordReset.Create().
+		SetID(r.ID).
+		SetEmail(strings.ToLower(r.Email)).
+		SetExpiry(r.Expiry.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create password reset: %w", err)
+	}
+	return nil
+}
+
+// GetPasswordReset extracts a password reset from the database by id.
+func (d *Database) GetPasswordReset(id string) (storage.PasswordReset, error) {
+	r, err := d.client.PasswordReset.Get(context.TODO(), id)
+	if err != nil {
+		return storage.PasswordReset{}, convertDBError("get password reset: %w", err)
+	}
+	return toStoragePasswordReset(r), nil
+}
+
+// ListPasswordResets extracts the password resets sent to an email from the database.
+func (d *Database) ListPasswordResets(email string) ([]storage.PasswordReset, error) {
+	resets, err := d.client.PasswordReset.Query().
+		Where(passwordreset.Email(strings.ToLower(email))).
+		All(context.TODO())
+	if err != nil {
+		return nil, convertDBError("list password resets: %w", err)
+	}
+
+	storageResets := make([]storage.PasswordReset, 0, len(resets))
+	for _, r := range resets {
+		storageResets = append(storageResets, toStoragePasswordReset(r))
+	}
+	return storageResets, nil
+}
+
+// DeletePasswordReset deletes a password reset from the database by id.
+func (d *Database) DeletePasswordReset(id string) error {
+	err := d.client.PasswordReset.DeleteOneID(id).Exec(context.TODO())
+	if err != nil {
+		return convertDBError("delete password reset: %w", err)
+	}
+	return nil
+}
@@ -19,10 +19,12 @@ func (d *Database) CreateRefresh(refresh storage.RefreshToken) error {
 		SetClaimsUsername(refresh.Claims.Username).
 		SetClaimsPreferredUsername(refresh.Claims.PreferredUsername).
 		SetClaimsGroups(refresh.Claims.Groups).
+		SetClaimsAcr(refresh.Claims.ACR).
 		SetConnectorID(refresh.ConnectorID).
 		SetConnectorData(refresh.ConnectorData).
 		SetToken(refresh.Token).
 		SetObsoleteToken(refresh.ObsoleteToken).
+		SetRetiredTokens(refresh.RetiredTokens).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
 		SetLastUsed(refresh.LastUsed.UTC()).
 		SetCreatedAt(refresh.CreatedAt.UTC()).
@@ -92,10 +94,12 @@ func (d *Database) UpdateRefreshToken(id string, updater func(old storage.Refres
 		SetClaimsUsername(newtToken.Claims.Username).
 		SetClaimsPreferredUsername(newtToken.Claims.PreferredUsername).
 		SetClaimsGroups(newtToken.Claims.Groups).
+		SetClaimsAcr(newtToken.Claims.ACR).
 		SetConnectorID(newtToken.ConnectorID).
 		SetConnectorData(newtToken.ConnectorData).
 		SetToken(newtToken.Token).
 		SetObsoleteToken(newtToken.ObsoleteToken).
+		SetRetiredTokens(newtToken.RetiredTokens).
 		// Save utc time into database because ent doesn't support comparing dates with different timezones
 		SetLastUsed(newtToken.LastUsed.UTC()).
 		SetCreatedAt(newtToken.CreatedAt.UTC()).
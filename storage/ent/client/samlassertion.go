@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateSAMLAssertion saves provided SAML assertion into the database.
+func (d *Database) CreateSAMLAssertion(a storage.SAMLAssertion) error {
+	_, err := d.client.SAMLAssertion.Create().
+		SetID(a.ID).
+		SetExpiry(a.Expiry.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create saml assertion: %w", err)
+	}
+	return nil
+}
+
+// GetSAMLAssertion extracts a SAML assertion from the database by id.
+func (d *Database) GetSAMLAssertion(id string) (storage.SAMLAssertion, error) {
+	a, err := d.client.SAMLAssertion.Get(context.TODO(), id)
+	if err != nil {
+		return storage.SAMLAssertion{}, convertDBError("get saml assertion: %w", err)
+	}
+	return toStorageSAMLAssertion(a), nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateSAMLServiceProvider saves provided SAML service provider into the database.
+func (d *Database) CreateSAMLServiceProvider(sp storage.SAMLServiceProvider) error {
+	_, err := d.client.SAMLServiceProvider.Create().
+		SetID(sp.ID).
+		SetName(sp.Name).
+		SetAcsUrls(sp.ACSURLs).
+		SetNameIDFormat(sp.NameIDFormat).
+		SetAttributeNames(sp.AttributeNames).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create saml service provider: %w", err)
+	}
+	return nil
+}
+
+// ListSAMLServiceProviders extracts an array of SAML service providers from the database.
+func (d *Database) ListSAMLServiceProviders() ([]storage.SAMLServiceProvider, error) {
+	sps, err := d.client.SAMLServiceProvider.Query().All(context.TODO())
+	if err != nil {
+		return nil, convertDBError("list saml service providers: %w", err)
+	}
+
+	storageSPs := make([]storage.SAMLServiceProvider, 0, len(sps))
+	for _, sp := range sps {
+		storageSPs = append(storageSPs, toStorageSAMLServiceProvider(sp))
+	}
+	return storageSPs, nil
+}
+
+// GetSAMLServiceProvider extracts a SAML service provider from the database by id.
+func (d *Database) GetSAMLServiceProvider(id string) (storage.SAMLServiceProvider, error) {
+	sp, err := d.client.SAMLServiceProvider.Get(context.TODO(), id)
+	if err != nil {
+		return storage.SAMLServiceProvider{}, convertDBError("get saml service provider: %w", err)
+	}
+	return toStorageSAMLServiceProvider(sp), nil
+}
+
+// DeleteSAMLServiceProvider deletes a SAML service provider from the database by id.
+func (d *Database) DeleteSAMLServiceProvider(id string) error {
+	err := d.client.SAMLServiceProvider.DeleteOneID(id).Exec(context.TODO())
+	if err != nil {
+		return convertDBError("delete saml service provider: %w", err)
+	}
+	return nil
+}
+
+// UpdateSAMLServiceProvider changes a SAML service provider by id using an updater function and saves it to the database.
+func (d *Database) UpdateSAMLServiceProvider(id string, updater func(old storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error)) error {
+	tx, err := d.BeginTx(context.TODO())
+	if err != nil {
+		return convertDBError("update saml service provider tx: %w", err)
+	}
+
+	sp, err := tx.SAMLServiceProvider.Get(context.TODO(), id)
+	if err != nil {
+		return rollback(tx, "update saml service provider database: %w", err)
+	}
+
+	newSP, err := updater(toStorageSAMLServiceProvider(sp))
+	if err != nil {
+		return rollback(tx, "update saml service provider updating: %w", err)
+	}
+
+	_, err = tx.SAMLServiceProvider.UpdateOneID(id).
+		SetName(newSP.Name).
+		SetAcsUrls(newSP.ACSURLs).
+		SetNameIDFormat(newSP.NameIDFormat).
+		SetAttributeNames(newSP.AttributeNames).
+		Save(context.TODO())
+	if err != nil {
+		return rollback(tx, "update saml service provider uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update saml service provider commit: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateTOTPEnrollment saves provided TOTP enrollment into the database.
+func (d *Database) CreateTOTPEnrollment(enrollment storage.TOTPEnrollment) error {
+	id := offlineSessionID(enrollment.UserID, enrollment.ConnID, d.hasher)
+	_, err := d.client.TOTPEnrollment.Create().
+		SetID(id).
+		SetUserID(enrollment.UserID).
+		SetConnID(enrollment.ConnID).
+		SetSecret(enrollment.Secret).
+		SetConfirmed(enrollment.Confirmed).
+		SetRecoveryCodes(enrollment.RecoveryCodes).
+		SetLastUsedStep(enrollment.LastUsedStep).
+		SetCreatedAt(enrollment.CreatedAt.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create totp enrollment: %w", err)
+	}
+	return nil
+}
+
+// GetTOTPEnrollment extracts a TOTP enrollment from the database by user id and connector id.
+func (d *Database) GetTOTPEnrollment(userID, connID string) (storage.TOTPEnrollment, error) {
+	id := offlineSessionID(userID, connID, d.hasher)
+
+	enrollment, err := d.client.TOTPEnrollment.Get(context.TODO(), id)
+	if err != nil {
+		return storage.TOTPEnrollment{}, convertDBError("get totp enrollment: %w", err)
+	}
+	return toStorageTOTPEnrollment(enrollment), nil
+}
+
+// DeleteTOTPEnrollment deletes a TOTP enrollment from the database by user id and connector id.
+func (d *Database) DeleteTOTPEnrollment(userID, connID string) error {
+	id := offlineSessionID(userID, connID, d.hasher)
+
+	err := d.client.TOTPEnrollment.DeleteOneID(id).Exec(context.TODO())
+	if err != nil {
+		return convertDBError("delete totp enrollment: %w", err)
+	}
+	return nil
+}
+
+// UpdateTOTPEnrollment changes a TOTP enrollment by user id and connector id using an updater function.
+func (d *Database) UpdateTOTPEnrollment(userID string, connID string, updater func(t storage.TOTPEnrollment) (storage.TOTPEnrollment, error)) error {
+	id := offlineSessionID(userID, connID, d.hasher)
+
+	tx, err := d.BeginTx(context.TODO())
+	if err != nil {
+		return convertDBError("update totp enrollment tx: %w", err)
+	}
+
+	enrollment, err := tx.TOTPEnrollment.Get(context.TODO(), id)
+	if err != nil {
+		return rollback(tx, "update totp enrollment database: %w", err)
+	}
+
+	newEnrollment, err := updater(toStorageTOTPEnrollment(enrollment))
+	if err != nil {
+		return rollback(tx, "update totp enrollment updating: %w", err)
+	}
+
+	_, err = tx.TOTPEnrollment.UpdateOneID(id).
+		SetUserID(newEnrollment.UserID).
+		SetConnID(newEnrollment.ConnID).
+		SetSecret(newEnrollment.Secret).
+		SetConfirmed(newEnrollment.Confirmed).
+		SetRecoveryCodes(newEnrollment.RecoveryCodes).
+		SetLastUsedStep(newEnrollment.LastUsedStep).
+		SetCreatedAt(newEnrollment.CreatedAt.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return rollback(tx, "update totp enrollment uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update totp enrollment commit: %w", err)
+	}
+
+	return nil
+}
@@ -28,6 +28,12 @@ func toStorageAuthRequest(a *db.AuthRequest) storage.AuthRequest {
 		RedirectURI:         a.RedirectURI,
 		Nonce:               a.Nonce,
 		State:               a.State,
+		ResponseMode:        a.ResponseMode,
+		ACRValues:           a.AcrValues,
+		ConnectorChain:      a.ConnectorChain,
+		MFAValidated:        a.MfaValidated,
+		WebAuthnChallenge:   a.WebauthnChallenge,
+		CallbackData:        a.CallbackData,
 		ForceApprovalPrompt: a.ForceApprovalPrompt,
 		LoggedIn:            a.LoggedIn,
 		ConnectorID:         a.ConnectorID,
@@ -40,6 +46,7 @@ func toStorageAuthRequest(a *db.AuthRequest) storage.AuthRequest {
 			Email:             a.ClaimsEmail,
 			EmailVerified:     a.ClaimsEmailVerified,
 			Groups:            a.ClaimsGroups,
+			ACR:               a.ClaimsAcr,
 		},
 		PKCE: storage.PKCE{
 			CodeChallenge:       a.CodeChallenge,
@@ -65,6 +72,7 @@ func toStorageAuthCode(a *db.AuthCode) storage.AuthCode {
 			Email:             a.ClaimsEmail,
 			EmailVerified:     a.ClaimsEmailVerified,
 			Groups:            a.ClaimsGroups,
+			ACR:               a.ClaimsAcr,
 		},
 		PKCE: storage.PKCE{
 			CodeChallenge:       a.CodeChallenge,
@@ -82,6 +90,20 @@ func toStorageClient(c *db.OAuth2Client) storage.Client {
 		Public:       c.Public,
 		Name:         c.Name,
 		LogoURL:      c.LogoURL,
+
+		BackchannelNotificationEndpoint: c.BackchannelNotificationEndpoint,
+
+		IDTokenEncryptedResponseAlg:  c.IDTokenEncryptedResponseAlg,
+		IDTokenEncryptedResponseEnc:  c.IDTokenEncryptedResponseEnc,
+		UserInfoSignedResponseAlg:    c.UserinfoSignedResponseAlg,
+		UserInfoEncryptedResponseAlg: c.UserinfoEncryptedResponseAlg,
+		UserInfoEncryptedResponseEnc: c.UserinfoEncryptedResponseEnc,
+		JWKS:                         c.Jwks,
+
+		SubjectType:      c.SubjectType,
+		SectorIdentifier: c.SectorIdentifier,
+
+		BackchannelLogoutURI: c.BackchannelLogoutURI,
 	}
 }
 
@@ -118,6 +140,7 @@ func toStorageRefreshToken(r *db.RefreshToken) storage.RefreshToken {
 		ID:            r.ID,
 		Token:         r.Token,
 		ObsoleteToken: r.ObsoleteToken,
+		RetiredTokens: r.RetiredTokens,
 		CreatedAt:     r.CreatedAt,
 		LastUsed:      r.LastUsed,
 		ClientID:      r.ClientID,
@@ -132,16 +155,23 @@ func toStorageRefreshToken(r *db.RefreshToken) storage.RefreshToken {
 			Email:             r.ClaimsEmail,
 			EmailVerified:     r.ClaimsEmailVerified,
 			Groups:            r.ClaimsGroups,
+			ACR:               r.ClaimsAcr,
 		},
 	}
 }
 
 func toStoragePassword(p *db.Password) storage.Password {
 	return storage.Password{
-		Email:    p.Email,
-		Hash:     p.Hash,
-		Username: p.Username,
-		UserID:   p.UserID,
+		Email:               p.Email,
+		Hash:                p.Hash,
+		PreviousHashes:      p.PreviousHashes,
+		Username:            p.Username,
+		UserID:              p.UserID,
+		Groups:              p.Groups,
+		DisplayName:         p.DisplayName,
+		Attributes:          p.Attributes,
+		PendingVerification: p.PendingVerification,
+		PendingApproval:     p.PendingApproval,
 	}
 }
 
@@ -166,3 +196,102 @@ func toStorageDeviceToken(t *db.DeviceToken) storage.DeviceToken {
 		PollIntervalSeconds: t.PollInterval,
 	}
 }
+
+func toStorageUserConsent(c *db.UserConsent) storage.UserConsent {
+	s := storage.UserConsent{
+		UserID: c.UserID,
+		ConnID: c.ConnID,
+	}
+
+	if c.Clients != nil {
+		if err := json.Unmarshal(c.Clients, &s.Clients); err != nil {
+			// Correctness of json structure if guaranteed on uploading
+			panic(err)
+		}
+	}
+	if s.Clients == nil {
+		s.Clients = make(map[string]*storage.ClientConsent)
+	}
+	return s
+}
+
+func toStorageBackchannelAuthRequest(r *db.BackchannelAuthRequest) storage.BackchannelAuthRequest {
+	return storage.BackchannelAuthRequest{
+		ID:                      r.ID,
+		ClientID:                r.ClientID,
+		Scopes:                  r.Scopes,
+		LoginHint:               r.LoginHint,
+		BindingMessage:          r.BindingMessage,
+		ClientNotificationToken: r.ClientNotificationToken,
+		Expiry:                  r.Expiry,
+	}
+}
+
+func toStorageTOTPEnrollment(t *db.TOTPEnrollment) storage.TOTPEnrollment {
+	return storage.TOTPEnrollment{
+		UserID:        t.UserID,
+		ConnID:        t.ConnID,
+		Secret:        t.Secret,
+		Confirmed:     t.Confirmed,
+		RecoveryCodes: t.RecoveryCodes,
+		LastUsedStep:  t.LastUsedStep,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+func toStorageWebAuthnCredential(c *db.WebAuthnCredential) storage.WebAuthnCredential {
+	return storage.WebAuthnCredential{
+		ID:              c.ID,
+		Email:           c.Email,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      c.Transports,
+		AAGUID:          c.Aaguid,
+		SignCount:       c.SignCount,
+		CreatedAt:       c.CreatedAt,
+		LastUsed:        c.LastUsed,
+	}
+}
+
+func toStorageLoginAttempts(a *db.LoginAttempts) storage.LoginAttempts {
+	return storage.LoginAttempts{
+		ID:          a.ID,
+		Failures:    a.Failures,
+		LastFailure: a.LastFailure,
+		LockedUntil: a.LockedUntil,
+		Expiry:      a.Expiry,
+	}
+}
+
+func toStoragePasswordReset(r *db.PasswordReset) storage.PasswordReset {
+	return storage.PasswordReset{
+		ID:     r.ID,
+		Email:  r.Email,
+		Expiry: r.Expiry,
+	}
+}
+
+func toStorageEmailVerification(v *db.EmailVerification) storage.EmailVerification {
+	return storage.EmailVerification{
+		ID:     v.ID,
+		Email:  v.Email,
+		Expiry: v.Expiry,
+	}
+}
+
+func toStorageSAMLAssertion(a *db.SAMLAssertion) storage.SAMLAssertion {
+	return storage.SAMLAssertion{
+		ID:     a.ID,
+		Expiry: a.Expiry,
+	}
+}
+
+func toStorageSAMLServiceProvider(sp *db.SAMLServiceProvider) storage.SAMLServiceProvider {
+	return storage.SAMLServiceProvider{
+		ID:             sp.ID,
+		Name:           sp.Name,
+		ACSURLs:        sp.AcsUrls,
+		NameIDFormat:   sp.NameIDFormat,
+		AttributeNames: sp.AttributeNames,
+	}
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dexidp/dex/storage"
+)
+
+// CreateUserConsent saves provided user consent into the database.
+func (d *Database) CreateUserConsent(consent storage.UserConsent) error {
+	encodedClients, err := json.Marshal(consent.Clients)
+	if err != nil {
+		return fmt.Errorf("encode clients user consent: %w", err)
+	}
+
+	id := offlineSessionID(consent.UserID, consent.ConnID, d.hasher)
+	_, err = d.client.UserConsent.Create().
+		SetID(id).
+		SetUserID(consent.UserID).
+		SetConnID(consent.ConnID).
+		SetClients(encodedClients).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create user consent: %w", err)
+	}
+	return nil
+}
+
+// GetUserConsent extracts a user consent from the database by user id and connector id.
+func (d *Database) GetUserConsent(userID, connID string) (storage.UserConsent, error) {
+	id := offlineSessionID(userID, connID, d.hasher)
+
+	userConsent, err := d.client.UserConsent.Get(context.TODO(), id)
+	if err != nil {
+		return storage.UserConsent{}, convertDBError("get user consent: %w", err)
+	}
+	return toStorageUserConsent(userConsent), nil
+}
+
+// DeleteUserConsent deletes a user consent from the database by user id and connector id.
+func (d *Database) DeleteUserConsent(userID, connID string) error {
+	id := offlineSessionID(userID, connID, d.hasher)
+
+	err := d.client.UserConsent.DeleteOneID(id).Exec(context.TODO())
+	if err != nil {
+		return convertDBError("delete user consent: %w", err)
+	}
+	return nil
+}
+
+// UpdateUserConsent changes a user consent by user id and connector id using an updater function.
+func (d *Database) UpdateUserConsent(userID string, connID string, updater func(c storage.UserConsent) (storage.UserConsent, error)) error {
+	id := offlineSessionID(userID, connID, d.hasher)
+
+	tx, err := d.BeginTx(context.TODO())
+	if err != nil {
+		return convertDBError("update user consent tx: %w", err)
+	}
+
+	userConsent, err := tx.UserConsent.Get(context.TODO(), id)
+	if err != nil {
+		return rollback(tx, "update user consent database: %w", err)
+	}
+
+	newUserConsent, err := updater(toStorageUserConsent(userConsent))
+	if err != nil {
+		return rollback(tx, "update user consent updating: %w", err)
+	}
+
+	encodedClients, err := json.Marshal(newUserConsent.Clients)
+	if err != nil {
+		return rollback(tx, "encode clients user consent: %w", err)
+	}
+
+	_, err = tx.UserConsent.UpdateOneID(id).
+		SetUserID(newUserConsent.UserID).
+		SetConnID(newUserConsent.ConnID).
+		SetClients(encodedClients).
+		Save(context.TODO())
+	if err != nil {
+		return rollback(tx, "update user consent uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update user consent commit: %w", err)
+	}
+
+	return nil
+}
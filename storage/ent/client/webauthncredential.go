@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dexidp/dex/storage"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
+)
+
+// CreateWebAuthnCredential saves provided WebAuthn credential into the database.
+func (d *Database) CreateWebAuthnCredential(cred storage.WebAuthnCredential) error {
+	_, err := d.client.WebAuthnCredential.Create().
+		SetID(cred.ID).
+		SetEmail(strings.ToLower(cred.Email)).
+		SetPublicKey(cred.PublicKey).
+		SetAttestationType(cred.AttestationType).
+		SetTransports(cred.Transports).
+		SetAaguid(cred.AAGUID).
+		SetSignCount(cred.SignCount).
+		SetCreatedAt(cred.CreatedAt.UTC()).
+		SetLastUsed(cred.LastUsed.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return convertDBError("create webauthn credential: %w", err)
+	}
+	return nil
+}
+
+// GetWebAuthnCredential extracts a WebAuthn credential from the database by id.
+func (d *Database) GetWebAuthnCredential(id string) (storage.WebAuthnCredential, error) {
+	cred, err := d.client.WebAuthnCredential.Get(context.TODO(), id)
+	if err != nil {
+		return storage.WebAuthnCredential{}, convertDBError("get webauthn credential: %w", err)
+	}
+	return toStorageWebAuthnCredential(cred), nil
+}
+
+// ListWebAuthnCredentials extracts the WebAuthn credentials of a user from the database by email.
+func (d *Database) ListWebAuthnCredentials(email string) ([]storage.WebAuthnCredential, error) {
+	creds, err := d.client.WebAuthnCredential.Query().
+		Where(webauthncredential.Email(strings.ToLower(email))).
+		All(context.TODO())
+	if err != nil {
+		return nil, convertDBError("list webauthn credentials: %w", err)
+	}
+
+	storageCreds := make([]storage.WebAuthnCredential, 0, len(creds))
+	for _, c := range creds {
+		storageCreds = append(storageCreds, toStorageWebAuthnCredential(c))
+	}
+	return storageCreds, nil
+}
+
+// DeleteWebAuthnCredential deletes a WebAuthn credential from the database by id.
+func (d *Database) DeleteWebAuthnCredential(id string) error {
+	err := d.client.WebAuthnCredential.DeleteOneID(id).Exec(context.TODO())
+	if err != nil {
+		return convertDBError("delete webauthn credential: %w", err)
+	}
+	return nil
+}
+
+// UpdateWebAuthnCredential changes a WebAuthn credential by id using an updater function.
+func (d *Database) UpdateWebAuthnCredential(id string, updater func(c storage.WebAuthnCredential) (storage.WebAuthnCredential, error)) error {
+	tx, err := d.BeginTx(context.TODO())
+	if err != nil {
+		return convertDBError("update webauthn credential tx: %w", err)
+	}
+
+	cred, err := tx.WebAuthnCredential.Get(context.TODO(), id)
+	if err != nil {
+		return rollback(tx, "update webauthn credential database: %w", err)
+	}
+
+	newCred, err := updater(toStorageWebAuthnCredential(cred))
+	if err != nil {
+		return rollback(tx, "update webauthn credential updating: %w", err)
+	}
+
+	_, err = tx.WebAuthnCredential.UpdateOneID(id).
+		SetEmail(strings.ToLower(newCred.Email)).
+		SetPublicKey(newCred.PublicKey).
+		SetAttestationType(newCred.AttestationType).
+		SetTransports(newCred.Transports).
+		SetAaguid(newCred.AAGUID).
+		SetSignCount(newCred.SignCount).
+		SetCreatedAt(newCred.CreatedAt.UTC()).
+		SetLastUsed(newCred.LastUsed.UTC()).
+		Save(context.TODO())
+	if err != nil {
+		return rollback(tx, "update webauthn credential uploading: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return rollback(tx, "update webauthn credential commit: %w", err)
+	}
+
+	return nil
+}
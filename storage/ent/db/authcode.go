@@ -37,6 +37,8 @@ type AuthCode struct {
 	ClaimsGroups []string `json:"claims_groups,omitempty"`
 	// ClaimsPreferredUsername holds the value of the "claims_preferred_username" field.
 	ClaimsPreferredUsername string `json:"claims_preferred_username,omitempty"`
+	// ClaimsAcr holds the value of the "claims_acr" field.
+	ClaimsAcr string `json:"claims_acr,omitempty"`
 	// ConnectorID holds the value of the "connector_id" field.
 	ConnectorID string `json:"connector_id,omitempty"`
 	// ConnectorData holds the value of the "connector_data" field.
@@ -58,7 +60,7 @@ func (*AuthCode) scanValues(columns []string) ([]interface{}, error) {
 			values[i] = new([]byte)
 		case authcode.FieldClaimsEmailVerified:
 			values[i] = new(sql.NullBool)
-		case authcode.FieldID, authcode.FieldClientID, authcode.FieldNonce, authcode.FieldRedirectURI, authcode.FieldClaimsUserID, authcode.FieldClaimsUsername, authcode.FieldClaimsEmail, authcode.FieldClaimsPreferredUsername, authcode.FieldConnectorID, authcode.FieldCodeChallenge, authcode.FieldCodeChallengeMethod:
+		case authcode.FieldID, authcode.FieldClientID, authcode.FieldNonce, authcode.FieldRedirectURI, authcode.FieldClaimsUserID, authcode.FieldClaimsUsername, authcode.FieldClaimsEmail, authcode.FieldClaimsPreferredUsername, authcode.FieldClaimsAcr, authcode.FieldConnectorID, authcode.FieldCodeChallenge, authcode.FieldCodeChallengeMethod:
 			values[i] = new(sql.NullString)
 		case authcode.FieldExpiry:
 			values[i] = new(sql.NullTime)
@@ -147,6 +149,12 @@ func (ac *AuthCode) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				ac.ClaimsPreferredUsername = value.String
 			}
+		case authcode.FieldClaimsAcr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claims_acr", values[i])
+			} else if value.Valid {
+				ac.ClaimsAcr = value.String
+			}
 		case authcode.FieldConnectorID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field connector_id", values[i])
@@ -225,6 +233,8 @@ func (ac *AuthCode) String() string {
 	builder.WriteString(fmt.Sprintf("%v", ac.ClaimsGroups))
 	builder.WriteString(", claims_preferred_username=")
 	builder.WriteString(ac.ClaimsPreferredUsername)
+	builder.WriteString(", claims_acr=")
+	builder.WriteString(ac.ClaimsAcr)
 	builder.WriteString(", connector_id=")
 	builder.WriteString(ac.ConnectorID)
 	if v := ac.ConnectorData; v != nil {
@@ -27,6 +27,8 @@ const (
 	FieldClaimsGroups = "claims_groups"
 	// FieldClaimsPreferredUsername holds the string denoting the claims_preferred_username field in the database.
 	FieldClaimsPreferredUsername = "claims_preferred_username"
+	// FieldClaimsAcr holds the string denoting the claims_acr field in the database.
+	FieldClaimsAcr = "claims_acr"
 	// FieldConnectorID holds the string denoting the connector_id field in the database.
 	FieldConnectorID = "connector_id"
 	// FieldConnectorData holds the string denoting the connector_data field in the database.
@@ -54,6 +56,7 @@ var Columns = []string{
 	FieldClaimsEmailVerified,
 	FieldClaimsGroups,
 	FieldClaimsPreferredUsername,
+	FieldClaimsAcr,
 	FieldConnectorID,
 	FieldConnectorData,
 	FieldExpiry,
@@ -86,6 +89,8 @@ var (
 	ClaimsEmailValidator func(string) error
 	// DefaultClaimsPreferredUsername holds the default value on creation for the "claims_preferred_username" field.
 	DefaultClaimsPreferredUsername string
+	// DefaultClaimsAcr holds the default value on creation for the "claims_acr" field.
+	DefaultClaimsAcr string
 	// ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
 	ConnectorIDValidator func(string) error
 	// DefaultCodeChallenge holds the default value on creation for the "code_challenge" field.
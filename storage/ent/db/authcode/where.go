@@ -148,6 +148,13 @@ func ClaimsPreferredUsername(v string) predicate.AuthCode {
 	})
 }
 
+// ClaimsAcr applies equality check predicate on the "claims_acr" field. It's identical to ClaimsAcrEQ.
+func ClaimsAcr(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimsAcr), v))
+	})
+}
+
 // ConnectorID applies equality check predicate on the "connector_id" field. It's identical to ConnectorIDEQ.
 func ConnectorID(v string) predicate.AuthCode {
 	return predicate.AuthCode(func(s *sql.Selector) {
@@ -1002,6 +1009,117 @@ func ClaimsPreferredUsernameContainsFold(v string) predicate.AuthCode {
 	})
 }
 
+// ClaimsAcrEQ applies the EQ predicate on the "claims_acr" field.
+func ClaimsAcrEQ(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrNEQ applies the NEQ predicate on the "claims_acr" field.
+func ClaimsAcrNEQ(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrIn applies the In predicate on the "claims_acr" field.
+func ClaimsAcrIn(vs ...string) predicate.AuthCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClaimsAcr), v...))
+	})
+}
+
+// ClaimsAcrNotIn applies the NotIn predicate on the "claims_acr" field.
+func ClaimsAcrNotIn(vs ...string) predicate.AuthCode {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthCode(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClaimsAcr), v...))
+	})
+}
+
+// ClaimsAcrGT applies the GT predicate on the "claims_acr" field.
+func ClaimsAcrGT(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrGTE applies the GTE predicate on the "claims_acr" field.
+func ClaimsAcrGTE(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrLT applies the LT predicate on the "claims_acr" field.
+func ClaimsAcrLT(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrLTE applies the LTE predicate on the "claims_acr" field.
+func ClaimsAcrLTE(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrContains applies the Contains predicate on the "claims_acr" field.
+func ClaimsAcrContains(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrHasPrefix applies the HasPrefix predicate on the "claims_acr" field.
+func ClaimsAcrHasPrefix(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrHasSuffix applies the HasSuffix predicate on the "claims_acr" field.
+func ClaimsAcrHasSuffix(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrEqualFold applies the EqualFold predicate on the "claims_acr" field.
+func ClaimsAcrEqualFold(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrContainsFold applies the ContainsFold predicate on the "claims_acr" field.
+func ClaimsAcrContainsFold(v string) predicate.AuthCode {
+	return predicate.AuthCode(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldClaimsAcr), v))
+	})
+}
+
 // ConnectorIDEQ applies the EQ predicate on the "connector_id" field.
 func ConnectorIDEQ(v string) predicate.AuthCode {
 	return predicate.AuthCode(func(s *sql.Selector) {
@@ -88,6 +88,20 @@ func (acc *AuthCodeCreate) SetNillableClaimsPreferredUsername(s *string) *AuthCo
 	return acc
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (acc *AuthCodeCreate) SetClaimsAcr(s string) *AuthCodeCreate {
+	acc.mutation.SetClaimsAcr(s)
+	return acc
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (acc *AuthCodeCreate) SetNillableClaimsAcr(s *string) *AuthCodeCreate {
+	if s != nil {
+		acc.SetClaimsAcr(*s)
+	}
+	return acc
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (acc *AuthCodeCreate) SetConnectorID(s string) *AuthCodeCreate {
 	acc.mutation.SetConnectorID(s)
@@ -215,6 +229,10 @@ func (acc *AuthCodeCreate) defaults() {
 		v := authcode.DefaultClaimsPreferredUsername
 		acc.mutation.SetClaimsPreferredUsername(v)
 	}
+	if _, ok := acc.mutation.ClaimsAcr(); !ok {
+		v := authcode.DefaultClaimsAcr
+		acc.mutation.SetClaimsAcr(v)
+	}
 	if _, ok := acc.mutation.CodeChallenge(); !ok {
 		v := authcode.DefaultCodeChallenge
 		acc.mutation.SetCodeChallenge(v)
@@ -281,6 +299,9 @@ func (acc *AuthCodeCreate) check() error {
 	if _, ok := acc.mutation.ClaimsPreferredUsername(); !ok {
 		return &ValidationError{Name: "claims_preferred_username", err: errors.New(`db: missing required field "AuthCode.claims_preferred_username"`)}
 	}
+	if _, ok := acc.mutation.ClaimsAcr(); !ok {
+		return &ValidationError{Name: "claims_acr", err: errors.New(`db: missing required field "AuthCode.claims_acr"`)}
+	}
 	if _, ok := acc.mutation.ConnectorID(); !ok {
 		return &ValidationError{Name: "connector_id", err: errors.New(`db: missing required field "AuthCode.connector_id"`)}
 	}
@@ -419,6 +440,14 @@ func (acc *AuthCodeCreate) createSpec() (*AuthCode, *sqlgraph.CreateSpec) {
 		})
 		_node.ClaimsPreferredUsername = value
 	}
+	if value, ok := acc.mutation.ClaimsAcr(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authcode.FieldClaimsAcr,
+		})
+		_node.ClaimsAcr = value
+	}
 	if value, ok := acc.mutation.ConnectorID(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
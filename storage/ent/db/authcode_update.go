@@ -108,6 +108,20 @@ func (acu *AuthCodeUpdate) SetNillableClaimsPreferredUsername(s *string) *AuthCo
 	return acu
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (acu *AuthCodeUpdate) SetClaimsAcr(s string) *AuthCodeUpdate {
+	acu.mutation.SetClaimsAcr(s)
+	return acu
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (acu *AuthCodeUpdate) SetNillableClaimsAcr(s *string) *AuthCodeUpdate {
+	if s != nil {
+		acu.SetClaimsAcr(*s)
+	}
+	return acu
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (acu *AuthCodeUpdate) SetConnectorID(s string) *AuthCodeUpdate {
 	acu.mutation.SetConnectorID(s)
@@ -365,6 +379,13 @@ func (acu *AuthCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: authcode.FieldClaimsPreferredUsername,
 		})
 	}
+	if value, ok := acu.mutation.ClaimsAcr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authcode.FieldClaimsAcr,
+		})
+	}
 	if value, ok := acu.mutation.ConnectorID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -505,6 +526,20 @@ func (acuo *AuthCodeUpdateOne) SetNillableClaimsPreferredUsername(s *string) *Au
 	return acuo
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (acuo *AuthCodeUpdateOne) SetClaimsAcr(s string) *AuthCodeUpdateOne {
+	acuo.mutation.SetClaimsAcr(s)
+	return acuo
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (acuo *AuthCodeUpdateOne) SetNillableClaimsAcr(s *string) *AuthCodeUpdateOne {
+	if s != nil {
+		acuo.SetClaimsAcr(*s)
+	}
+	return acuo
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (acuo *AuthCodeUpdateOne) SetConnectorID(s string) *AuthCodeUpdateOne {
 	acuo.mutation.SetConnectorID(s)
@@ -786,6 +821,13 @@ func (acuo *AuthCodeUpdateOne) sqlSave(ctx context.Context) (_node *AuthCode, er
 			Column: authcode.FieldClaimsPreferredUsername,
 		})
 	}
+	if value, ok := acuo.mutation.ClaimsAcr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authcode.FieldClaimsAcr,
+		})
+	}
 	if value, ok := acuo.mutation.ConnectorID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -45,6 +45,8 @@ type AuthRequest struct {
 	ClaimsGroups []string `json:"claims_groups,omitempty"`
 	// ClaimsPreferredUsername holds the value of the "claims_preferred_username" field.
 	ClaimsPreferredUsername string `json:"claims_preferred_username,omitempty"`
+	// ClaimsAcr holds the value of the "claims_acr" field.
+	ClaimsAcr string `json:"claims_acr,omitempty"`
 	// ConnectorID holds the value of the "connector_id" field.
 	ConnectorID string `json:"connector_id,omitempty"`
 	// ConnectorData holds the value of the "connector_data" field.
@@ -55,6 +57,18 @@ type AuthRequest struct {
 	CodeChallenge string `json:"code_challenge,omitempty"`
 	// CodeChallengeMethod holds the value of the "code_challenge_method" field.
 	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
+	// ResponseMode holds the value of the "response_mode" field.
+	ResponseMode string `json:"response_mode,omitempty"`
+	// AcrValues holds the value of the "acr_values" field.
+	AcrValues []string `json:"acr_values,omitempty"`
+	// ConnectorChain holds the value of the "connector_chain" field.
+	ConnectorChain []string `json:"connector_chain,omitempty"`
+	// MfaValidated holds the value of the "mfa_validated" field.
+	MfaValidated bool `json:"mfa_validated,omitempty"`
+	// WebauthnChallenge holds the value of the "webauthn_challenge" field.
+	WebauthnChallenge string `json:"webauthn_challenge,omitempty"`
+	// CallbackData holds the value of the "callback_data" field.
+	CallbackData []byte `json:"callback_data,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -62,11 +76,11 @@ func (*AuthRequest) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case authrequest.FieldScopes, authrequest.FieldResponseTypes, authrequest.FieldClaimsGroups, authrequest.FieldConnectorData:
+		case authrequest.FieldScopes, authrequest.FieldResponseTypes, authrequest.FieldClaimsGroups, authrequest.FieldConnectorData, authrequest.FieldAcrValues, authrequest.FieldConnectorChain, authrequest.FieldCallbackData:
 			values[i] = new([]byte)
-		case authrequest.FieldForceApprovalPrompt, authrequest.FieldLoggedIn, authrequest.FieldClaimsEmailVerified:
+		case authrequest.FieldForceApprovalPrompt, authrequest.FieldLoggedIn, authrequest.FieldClaimsEmailVerified, authrequest.FieldMfaValidated:
 			values[i] = new(sql.NullBool)
-		case authrequest.FieldID, authrequest.FieldClientID, authrequest.FieldRedirectURI, authrequest.FieldNonce, authrequest.FieldState, authrequest.FieldClaimsUserID, authrequest.FieldClaimsUsername, authrequest.FieldClaimsEmail, authrequest.FieldClaimsPreferredUsername, authrequest.FieldConnectorID, authrequest.FieldCodeChallenge, authrequest.FieldCodeChallengeMethod:
+		case authrequest.FieldID, authrequest.FieldClientID, authrequest.FieldRedirectURI, authrequest.FieldNonce, authrequest.FieldState, authrequest.FieldClaimsUserID, authrequest.FieldClaimsUsername, authrequest.FieldClaimsEmail, authrequest.FieldClaimsPreferredUsername, authrequest.FieldClaimsAcr, authrequest.FieldConnectorID, authrequest.FieldCodeChallenge, authrequest.FieldCodeChallengeMethod, authrequest.FieldResponseMode, authrequest.FieldWebauthnChallenge:
 			values[i] = new(sql.NullString)
 		case authrequest.FieldExpiry:
 			values[i] = new(sql.NullTime)
@@ -181,6 +195,12 @@ func (ar *AuthRequest) assignValues(columns []string, values []interface{}) erro
 			} else if value.Valid {
 				ar.ClaimsPreferredUsername = value.String
 			}
+		case authrequest.FieldClaimsAcr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claims_acr", values[i])
+			} else if value.Valid {
+				ar.ClaimsAcr = value.String
+			}
 		case authrequest.FieldConnectorID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field connector_id", values[i])
@@ -211,6 +231,46 @@ func (ar *AuthRequest) assignValues(columns []string, values []interface{}) erro
 			} else if value.Valid {
 				ar.CodeChallengeMethod = value.String
 			}
+		case authrequest.FieldResponseMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field response_mode", values[i])
+			} else if value.Valid {
+				ar.ResponseMode = value.String
+			}
+		case authrequest.FieldAcrValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field acr_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ar.AcrValues); err != nil {
+					return fmt.Errorf("unmarshal field acr_values: %w", err)
+				}
+			}
+		case authrequest.FieldConnectorChain:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field connector_chain", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ar.ConnectorChain); err != nil {
+					return fmt.Errorf("unmarshal field connector_chain: %w", err)
+				}
+			}
+		case authrequest.FieldMfaValidated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_validated", values[i])
+			} else if value.Valid {
+				ar.MfaValidated = value.Bool
+			}
+		case authrequest.FieldWebauthnChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webauthn_challenge", values[i])
+			} else if value.Valid {
+				ar.WebauthnChallenge = value.String
+			}
+		case authrequest.FieldCallbackData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_data", values[i])
+			} else if value != nil {
+				ar.CallbackData = *value
+			}
 		}
 	}
 	return nil
@@ -267,6 +327,8 @@ func (ar *AuthRequest) String() string {
 	builder.WriteString(fmt.Sprintf("%v", ar.ClaimsGroups))
 	builder.WriteString(", claims_preferred_username=")
 	builder.WriteString(ar.ClaimsPreferredUsername)
+	builder.WriteString(", claims_acr=")
+	builder.WriteString(ar.ClaimsAcr)
 	builder.WriteString(", connector_id=")
 	builder.WriteString(ar.ConnectorID)
 	if v := ar.ConnectorData; v != nil {
@@ -279,6 +341,18 @@ func (ar *AuthRequest) String() string {
 	builder.WriteString(ar.CodeChallenge)
 	builder.WriteString(", code_challenge_method=")
 	builder.WriteString(ar.CodeChallengeMethod)
+	builder.WriteString(", response_mode=")
+	builder.WriteString(ar.ResponseMode)
+	builder.WriteString(", acr_values=")
+	builder.WriteString(fmt.Sprintf("%v", ar.AcrValues))
+	builder.WriteString(", connector_chain=")
+	builder.WriteString(fmt.Sprintf("%v", ar.ConnectorChain))
+	builder.WriteString(", mfa_validated=")
+	builder.WriteString(fmt.Sprintf("%v", ar.MfaValidated))
+	builder.WriteString(", webauthn_challenge=")
+	builder.WriteString(ar.WebauthnChallenge)
+	builder.WriteString(", callback_data=")
+	builder.WriteString(fmt.Sprintf("%v", ar.CallbackData))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,6 +35,8 @@ const (
 	FieldClaimsGroups = "claims_groups"
 	// FieldClaimsPreferredUsername holds the string denoting the claims_preferred_username field in the database.
 	FieldClaimsPreferredUsername = "claims_preferred_username"
+	// FieldClaimsAcr holds the string denoting the claims_acr field in the database.
+	FieldClaimsAcr = "claims_acr"
 	// FieldConnectorID holds the string denoting the connector_id field in the database.
 	FieldConnectorID = "connector_id"
 	// FieldConnectorData holds the string denoting the connector_data field in the database.
@@ -45,6 +47,18 @@ const (
 	FieldCodeChallenge = "code_challenge"
 	// FieldCodeChallengeMethod holds the string denoting the code_challenge_method field in the database.
 	FieldCodeChallengeMethod = "code_challenge_method"
+	// FieldResponseMode holds the string denoting the response_mode field in the database.
+	FieldResponseMode = "response_mode"
+	// FieldAcrValues holds the string denoting the acr_values field in the database.
+	FieldAcrValues = "acr_values"
+	// FieldConnectorChain holds the string denoting the connector_chain field in the database.
+	FieldConnectorChain = "connector_chain"
+	// FieldMfaValidated holds the string denoting the mfa_validated field in the database.
+	FieldMfaValidated = "mfa_validated"
+	// FieldWebauthnChallenge holds the string denoting the webauthn_challenge field in the database.
+	FieldWebauthnChallenge = "webauthn_challenge"
+	// FieldCallbackData holds the string denoting the callback_data field in the database.
+	FieldCallbackData = "callback_data"
 	// Table holds the table name of the authrequest in the database.
 	Table = "auth_requests"
 )
@@ -66,11 +80,18 @@ var Columns = []string{
 	FieldClaimsEmailVerified,
 	FieldClaimsGroups,
 	FieldClaimsPreferredUsername,
+	FieldClaimsAcr,
 	FieldConnectorID,
 	FieldConnectorData,
 	FieldExpiry,
 	FieldCodeChallenge,
 	FieldCodeChallengeMethod,
+	FieldResponseMode,
+	FieldAcrValues,
+	FieldConnectorChain,
+	FieldMfaValidated,
+	FieldWebauthnChallenge,
+	FieldCallbackData,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -86,10 +107,18 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultClaimsPreferredUsername holds the default value on creation for the "claims_preferred_username" field.
 	DefaultClaimsPreferredUsername string
+	// DefaultClaimsAcr holds the default value on creation for the "claims_acr" field.
+	DefaultClaimsAcr string
 	// DefaultCodeChallenge holds the default value on creation for the "code_challenge" field.
 	DefaultCodeChallenge string
 	// DefaultCodeChallengeMethod holds the default value on creation for the "code_challenge_method" field.
 	DefaultCodeChallengeMethod string
+	// DefaultResponseMode holds the default value on creation for the "response_mode" field.
+	DefaultResponseMode string
+	// DefaultMfaValidated holds the default value on creation for the "mfa_validated" field.
+	DefaultMfaValidated bool
+	// DefaultWebauthnChallenge holds the default value on creation for the "webauthn_challenge" field.
+	DefaultWebauthnChallenge string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
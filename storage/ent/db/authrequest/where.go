@@ -169,6 +169,13 @@ func ClaimsPreferredUsername(v string) predicate.AuthRequest {
 	})
 }
 
+// ClaimsAcr applies equality check predicate on the "claims_acr" field. It's identical to ClaimsAcrEQ.
+func ClaimsAcr(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimsAcr), v))
+	})
+}
+
 // ConnectorID applies equality check predicate on the "connector_id" field. It's identical to ConnectorIDEQ.
 func ConnectorID(v string) predicate.AuthRequest {
 	return predicate.AuthRequest(func(s *sql.Selector) {
@@ -204,6 +211,34 @@ func CodeChallengeMethod(v string) predicate.AuthRequest {
 	})
 }
 
+// ResponseMode applies equality check predicate on the "response_mode" field. It's identical to ResponseModeEQ.
+func ResponseMode(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponseMode), v))
+	})
+}
+
+// MfaValidated applies equality check predicate on the "mfa_validated" field. It's identical to MfaValidatedEQ.
+func MfaValidated(v bool) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMfaValidated), v))
+	})
+}
+
+// WebauthnChallenge applies equality check predicate on the "webauthn_challenge" field. It's identical to WebauthnChallengeEQ.
+func WebauthnChallenge(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// CallbackData applies equality check predicate on the "callback_data" field. It's identical to CallbackDataEQ.
+func CallbackData(v []byte) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallbackData), v))
+	})
+}
+
 // ClientIDEQ applies the EQ predicate on the "client_id" field.
 func ClientIDEQ(v string) predicate.AuthRequest {
 	return predicate.AuthRequest(func(s *sql.Selector) {
@@ -1176,6 +1211,117 @@ func ClaimsPreferredUsernameContainsFold(v string) predicate.AuthRequest {
 	})
 }
 
+// ClaimsAcrEQ applies the EQ predicate on the "claims_acr" field.
+func ClaimsAcrEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrNEQ applies the NEQ predicate on the "claims_acr" field.
+func ClaimsAcrNEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrIn applies the In predicate on the "claims_acr" field.
+func ClaimsAcrIn(vs ...string) predicate.AuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClaimsAcr), v...))
+	})
+}
+
+// ClaimsAcrNotIn applies the NotIn predicate on the "claims_acr" field.
+func ClaimsAcrNotIn(vs ...string) predicate.AuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClaimsAcr), v...))
+	})
+}
+
+// ClaimsAcrGT applies the GT predicate on the "claims_acr" field.
+func ClaimsAcrGT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrGTE applies the GTE predicate on the "claims_acr" field.
+func ClaimsAcrGTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrLT applies the LT predicate on the "claims_acr" field.
+func ClaimsAcrLT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrLTE applies the LTE predicate on the "claims_acr" field.
+func ClaimsAcrLTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrContains applies the Contains predicate on the "claims_acr" field.
+func ClaimsAcrContains(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrHasPrefix applies the HasPrefix predicate on the "claims_acr" field.
+func ClaimsAcrHasPrefix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrHasSuffix applies the HasSuffix predicate on the "claims_acr" field.
+func ClaimsAcrHasSuffix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrEqualFold applies the EqualFold predicate on the "claims_acr" field.
+func ClaimsAcrEqualFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldClaimsAcr), v))
+	})
+}
+
+// ClaimsAcrContainsFold applies the ContainsFold predicate on the "claims_acr" field.
+func ClaimsAcrContainsFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldClaimsAcr), v))
+	})
+}
+
 // ConnectorIDEQ applies the EQ predicate on the "connector_id" field.
 func ConnectorIDEQ(v string) predicate.AuthRequest {
 	return predicate.AuthRequest(func(s *sql.Selector) {
@@ -1675,6 +1821,360 @@ func CodeChallengeMethodContainsFold(v string) predicate.AuthRequest {
 	})
 }
 
+// ResponseModeEQ applies the EQ predicate on the "response_mode" field.
+func ResponseModeEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeNEQ applies the NEQ predicate on the "response_mode" field.
+func ResponseModeNEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeIn applies the In predicate on the "response_mode" field.
+func ResponseModeIn(vs ...string) predicate.AuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResponseMode), v...))
+	})
+}
+
+// ResponseModeNotIn applies the NotIn predicate on the "response_mode" field.
+func ResponseModeNotIn(vs ...string) predicate.AuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResponseMode), v...))
+	})
+}
+
+// ResponseModeGT applies the GT predicate on the "response_mode" field.
+func ResponseModeGT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeGTE applies the GTE predicate on the "response_mode" field.
+func ResponseModeGTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeLT applies the LT predicate on the "response_mode" field.
+func ResponseModeLT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeLTE applies the LTE predicate on the "response_mode" field.
+func ResponseModeLTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeContains applies the Contains predicate on the "response_mode" field.
+func ResponseModeContains(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeHasPrefix applies the HasPrefix predicate on the "response_mode" field.
+func ResponseModeHasPrefix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeHasSuffix applies the HasSuffix predicate on the "response_mode" field.
+func ResponseModeHasSuffix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeEqualFold applies the EqualFold predicate on the "response_mode" field.
+func ResponseModeEqualFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldResponseMode), v))
+	})
+}
+
+// ResponseModeContainsFold applies the ContainsFold predicate on the "response_mode" field.
+func ResponseModeContainsFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldResponseMode), v))
+	})
+}
+
+// AcrValuesIsNil applies the IsNil predicate on the "acr_values" field.
+func AcrValuesIsNil() predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAcrValues)))
+	})
+}
+
+// AcrValuesNotNil applies the NotNil predicate on the "acr_values" field.
+func AcrValuesNotNil() predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAcrValues)))
+	})
+}
+
+// ConnectorChainIsNil applies the IsNil predicate on the "connector_chain" field.
+func ConnectorChainIsNil() predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldConnectorChain)))
+	})
+}
+
+// ConnectorChainNotNil applies the NotNil predicate on the "connector_chain" field.
+func ConnectorChainNotNil() predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldConnectorChain)))
+	})
+}
+
+// MfaValidatedEQ applies the EQ predicate on the "mfa_validated" field.
+func MfaValidatedEQ(v bool) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMfaValidated), v))
+	})
+}
+
+// MfaValidatedNEQ applies the NEQ predicate on the "mfa_validated" field.
+func MfaValidatedNEQ(v bool) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMfaValidated), v))
+	})
+}
+
+// WebauthnChallengeEQ applies the EQ predicate on the "webauthn_challenge" field.
+func WebauthnChallengeEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeNEQ applies the NEQ predicate on the "webauthn_challenge" field.
+func WebauthnChallengeNEQ(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeIn applies the In predicate on the "webauthn_challenge" field.
+func WebauthnChallengeIn(vs ...string) predicate.AuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWebauthnChallenge), v...))
+	})
+}
+
+// WebauthnChallengeNotIn applies the NotIn predicate on the "webauthn_challenge" field.
+func WebauthnChallengeNotIn(vs ...string) predicate.AuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWebauthnChallenge), v...))
+	})
+}
+
+// WebauthnChallengeGT applies the GT predicate on the "webauthn_challenge" field.
+func WebauthnChallengeGT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeGTE applies the GTE predicate on the "webauthn_challenge" field.
+func WebauthnChallengeGTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeLT applies the LT predicate on the "webauthn_challenge" field.
+func WebauthnChallengeLT(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeLTE applies the LTE predicate on the "webauthn_challenge" field.
+func WebauthnChallengeLTE(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeContains applies the Contains predicate on the "webauthn_challenge" field.
+func WebauthnChallengeContains(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeHasPrefix applies the HasPrefix predicate on the "webauthn_challenge" field.
+func WebauthnChallengeHasPrefix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeHasSuffix applies the HasSuffix predicate on the "webauthn_challenge" field.
+func WebauthnChallengeHasSuffix(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeEqualFold applies the EqualFold predicate on the "webauthn_challenge" field.
+func WebauthnChallengeEqualFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// WebauthnChallengeContainsFold applies the ContainsFold predicate on the "webauthn_challenge" field.
+func WebauthnChallengeContainsFold(v string) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldWebauthnChallenge), v))
+	})
+}
+
+// CallbackDataEQ applies the EQ predicate on the "callback_data" field.
+func CallbackDataEQ(v []byte) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallbackData), v))
+	})
+}
+
+// CallbackDataNEQ applies the NEQ predicate on the "callback_data" field.
+func CallbackDataNEQ(v []byte) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCallbackData), v))
+	})
+}
+
+// CallbackDataIn applies the In predicate on the "callback_data" field.
+func CallbackDataIn(vs ...[]byte) predicate.AuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCallbackData), v...))
+	})
+}
+
+// CallbackDataNotIn applies the NotIn predicate on the "callback_data" field.
+func CallbackDataNotIn(vs ...[]byte) predicate.AuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCallbackData), v...))
+	})
+}
+
+// CallbackDataGT applies the GT predicate on the "callback_data" field.
+func CallbackDataGT(v []byte) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCallbackData), v))
+	})
+}
+
+// CallbackDataGTE applies the GTE predicate on the "callback_data" field.
+func CallbackDataGTE(v []byte) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCallbackData), v))
+	})
+}
+
+// CallbackDataLT applies the LT predicate on the "callback_data" field.
+func CallbackDataLT(v []byte) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCallbackData), v))
+	})
+}
+
+// CallbackDataLTE applies the LTE predicate on the "callback_data" field.
+func CallbackDataLTE(v []byte) predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCallbackData), v))
+	})
+}
+
+// CallbackDataIsNil applies the IsNil predicate on the "callback_data" field.
+func CallbackDataIsNil() predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCallbackData)))
+	})
+}
+
+// CallbackDataNotNil applies the NotNil predicate on the "callback_data" field.
+func CallbackDataNotNil() predicate.AuthRequest {
+	return predicate.AuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCallbackData)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AuthRequest) predicate.AuthRequest {
 	return predicate.AuthRequest(func(s *sql.Selector) {
@@ -112,6 +112,20 @@ func (arc *AuthRequestCreate) SetNillableClaimsPreferredUsername(s *string) *Aut
 	return arc
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (arc *AuthRequestCreate) SetClaimsAcr(s string) *AuthRequestCreate {
+	arc.mutation.SetClaimsAcr(s)
+	return arc
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (arc *AuthRequestCreate) SetNillableClaimsAcr(s *string) *AuthRequestCreate {
+	if s != nil {
+		arc.SetClaimsAcr(*s)
+	}
+	return arc
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (arc *AuthRequestCreate) SetConnectorID(s string) *AuthRequestCreate {
 	arc.mutation.SetConnectorID(s)
@@ -158,6 +172,66 @@ func (arc *AuthRequestCreate) SetNillableCodeChallengeMethod(s *string) *AuthReq
 	return arc
 }
 
+// SetResponseMode sets the "response_mode" field.
+func (arc *AuthRequestCreate) SetResponseMode(s string) *AuthRequestCreate {
+	arc.mutation.SetResponseMode(s)
+	return arc
+}
+
+// SetNillableResponseMode sets the "response_mode" field if the given value is not nil.
+func (arc *AuthRequestCreate) SetNillableResponseMode(s *string) *AuthRequestCreate {
+	if s != nil {
+		arc.SetResponseMode(*s)
+	}
+	return arc
+}
+
+// SetAcrValues sets the "acr_values" field.
+func (arc *AuthRequestCreate) SetAcrValues(s []string) *AuthRequestCreate {
+	arc.mutation.SetAcrValues(s)
+	return arc
+}
+
+// SetConnectorChain sets the "connector_chain" field.
+func (arc *AuthRequestCreate) SetConnectorChain(s []string) *AuthRequestCreate {
+	arc.mutation.SetConnectorChain(s)
+	return arc
+}
+
+// SetMfaValidated sets the "mfa_validated" field.
+func (arc *AuthRequestCreate) SetMfaValidated(b bool) *AuthRequestCreate {
+	arc.mutation.SetMfaValidated(b)
+	return arc
+}
+
+// SetNillableMfaValidated sets the "mfa_validated" field if the given value is not nil.
+func (arc *AuthRequestCreate) SetNillableMfaValidated(b *bool) *AuthRequestCreate {
+	if b != nil {
+		arc.SetMfaValidated(*b)
+	}
+	return arc
+}
+
+// SetWebauthnChallenge sets the "webauthn_challenge" field.
+func (arc *AuthRequestCreate) SetWebauthnChallenge(s string) *AuthRequestCreate {
+	arc.mutation.SetWebauthnChallenge(s)
+	return arc
+}
+
+// SetNillableWebauthnChallenge sets the "webauthn_challenge" field if the given value is not nil.
+func (arc *AuthRequestCreate) SetNillableWebauthnChallenge(s *string) *AuthRequestCreate {
+	if s != nil {
+		arc.SetWebauthnChallenge(*s)
+	}
+	return arc
+}
+
+// SetCallbackData sets the "callback_data" field.
+func (arc *AuthRequestCreate) SetCallbackData(b []byte) *AuthRequestCreate {
+	arc.mutation.SetCallbackData(b)
+	return arc
+}
+
 // SetID sets the "id" field.
 func (arc *AuthRequestCreate) SetID(s string) *AuthRequestCreate {
 	arc.mutation.SetID(s)
@@ -239,6 +313,10 @@ func (arc *AuthRequestCreate) defaults() {
 		v := authrequest.DefaultClaimsPreferredUsername
 		arc.mutation.SetClaimsPreferredUsername(v)
 	}
+	if _, ok := arc.mutation.ClaimsAcr(); !ok {
+		v := authrequest.DefaultClaimsAcr
+		arc.mutation.SetClaimsAcr(v)
+	}
 	if _, ok := arc.mutation.CodeChallenge(); !ok {
 		v := authrequest.DefaultCodeChallenge
 		arc.mutation.SetCodeChallenge(v)
@@ -247,6 +325,18 @@ func (arc *AuthRequestCreate) defaults() {
 		v := authrequest.DefaultCodeChallengeMethod
 		arc.mutation.SetCodeChallengeMethod(v)
 	}
+	if _, ok := arc.mutation.ResponseMode(); !ok {
+		v := authrequest.DefaultResponseMode
+		arc.mutation.SetResponseMode(v)
+	}
+	if _, ok := arc.mutation.MfaValidated(); !ok {
+		v := authrequest.DefaultMfaValidated
+		arc.mutation.SetMfaValidated(v)
+	}
+	if _, ok := arc.mutation.WebauthnChallenge(); !ok {
+		v := authrequest.DefaultWebauthnChallenge
+		arc.mutation.SetWebauthnChallenge(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -284,6 +374,9 @@ func (arc *AuthRequestCreate) check() error {
 	if _, ok := arc.mutation.ClaimsPreferredUsername(); !ok {
 		return &ValidationError{Name: "claims_preferred_username", err: errors.New(`db: missing required field "AuthRequest.claims_preferred_username"`)}
 	}
+	if _, ok := arc.mutation.ClaimsAcr(); !ok {
+		return &ValidationError{Name: "claims_acr", err: errors.New(`db: missing required field "AuthRequest.claims_acr"`)}
+	}
 	if _, ok := arc.mutation.ConnectorID(); !ok {
 		return &ValidationError{Name: "connector_id", err: errors.New(`db: missing required field "AuthRequest.connector_id"`)}
 	}
@@ -296,6 +389,15 @@ func (arc *AuthRequestCreate) check() error {
 	if _, ok := arc.mutation.CodeChallengeMethod(); !ok {
 		return &ValidationError{Name: "code_challenge_method", err: errors.New(`db: missing required field "AuthRequest.code_challenge_method"`)}
 	}
+	if _, ok := arc.mutation.ResponseMode(); !ok {
+		return &ValidationError{Name: "response_mode", err: errors.New(`db: missing required field "AuthRequest.response_mode"`)}
+	}
+	if _, ok := arc.mutation.MfaValidated(); !ok {
+		return &ValidationError{Name: "mfa_validated", err: errors.New(`db: missing required field "AuthRequest.mfa_validated"`)}
+	}
+	if _, ok := arc.mutation.WebauthnChallenge(); !ok {
+		return &ValidationError{Name: "webauthn_challenge", err: errors.New(`db: missing required field "AuthRequest.webauthn_challenge"`)}
+	}
 	if v, ok := arc.mutation.ID(); ok {
 		if err := authrequest.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "AuthRequest.id": %w`, err)}
@@ -449,6 +551,14 @@ func (arc *AuthRequestCreate) createSpec() (*AuthRequest, *sqlgraph.CreateSpec)
 		})
 		_node.ClaimsPreferredUsername = value
 	}
+	if value, ok := arc.mutation.ClaimsAcr(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldClaimsAcr,
+		})
+		_node.ClaimsAcr = value
+	}
 	if value, ok := arc.mutation.ConnectorID(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -489,6 +599,54 @@ func (arc *AuthRequestCreate) createSpec() (*AuthRequest, *sqlgraph.CreateSpec)
 		})
 		_node.CodeChallengeMethod = value
 	}
+	if value, ok := arc.mutation.ResponseMode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldResponseMode,
+		})
+		_node.ResponseMode = value
+	}
+	if value, ok := arc.mutation.AcrValues(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: authrequest.FieldAcrValues,
+		})
+		_node.AcrValues = value
+	}
+	if value, ok := arc.mutation.ConnectorChain(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: authrequest.FieldConnectorChain,
+		})
+		_node.ConnectorChain = value
+	}
+	if value, ok := arc.mutation.MfaValidated(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: authrequest.FieldMfaValidated,
+		})
+		_node.MfaValidated = value
+	}
+	if value, ok := arc.mutation.WebauthnChallenge(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldWebauthnChallenge,
+		})
+		_node.WebauthnChallenge = value
+	}
+	if value, ok := arc.mutation.CallbackData(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: authrequest.FieldCallbackData,
+		})
+		_node.CallbackData = value
+	}
 	return _node, _spec
 }
 
@@ -138,6 +138,20 @@ func (aru *AuthRequestUpdate) SetNillableClaimsPreferredUsername(s *string) *Aut
 	return aru
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (aru *AuthRequestUpdate) SetClaimsAcr(s string) *AuthRequestUpdate {
+	aru.mutation.SetClaimsAcr(s)
+	return aru
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (aru *AuthRequestUpdate) SetNillableClaimsAcr(s *string) *AuthRequestUpdate {
+	if s != nil {
+		aru.SetClaimsAcr(*s)
+	}
+	return aru
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (aru *AuthRequestUpdate) SetConnectorID(s string) *AuthRequestUpdate {
 	aru.mutation.SetConnectorID(s)
@@ -190,6 +204,84 @@ func (aru *AuthRequestUpdate) SetNillableCodeChallengeMethod(s *string) *AuthReq
 	return aru
 }
 
+// SetResponseMode sets the "response_mode" field.
+func (aru *AuthRequestUpdate) SetResponseMode(s string) *AuthRequestUpdate {
+	aru.mutation.SetResponseMode(s)
+	return aru
+}
+
+// SetNillableResponseMode sets the "response_mode" field if the given value is not nil.
+func (aru *AuthRequestUpdate) SetNillableResponseMode(s *string) *AuthRequestUpdate {
+	if s != nil {
+		aru.SetResponseMode(*s)
+	}
+	return aru
+}
+
+// SetAcrValues sets the "acr_values" field.
+func (aru *AuthRequestUpdate) SetAcrValues(s []string) *AuthRequestUpdate {
+	aru.mutation.SetAcrValues(s)
+	return aru
+}
+
+// ClearAcrValues clears the value of the "acr_values" field.
+func (aru *AuthRequestUpdate) ClearAcrValues() *AuthRequestUpdate {
+	aru.mutation.ClearAcrValues()
+	return aru
+}
+
+// SetConnectorChain sets the "connector_chain" field.
+func (aru *AuthRequestUpdate) SetConnectorChain(s []string) *AuthRequestUpdate {
+	aru.mutation.SetConnectorChain(s)
+	return aru
+}
+
+// ClearConnectorChain clears the value of the "connector_chain" field.
+func (aru *AuthRequestUpdate) ClearConnectorChain() *AuthRequestUpdate {
+	aru.mutation.ClearConnectorChain()
+	return aru
+}
+
+// SetMfaValidated sets the "mfa_validated" field.
+func (aru *AuthRequestUpdate) SetMfaValidated(b bool) *AuthRequestUpdate {
+	aru.mutation.SetMfaValidated(b)
+	return aru
+}
+
+// SetNillableMfaValidated sets the "mfa_validated" field if the given value is not nil.
+func (aru *AuthRequestUpdate) SetNillableMfaValidated(b *bool) *AuthRequestUpdate {
+	if b != nil {
+		aru.SetMfaValidated(*b)
+	}
+	return aru
+}
+
+// SetWebauthnChallenge sets the "webauthn_challenge" field.
+func (aru *AuthRequestUpdate) SetWebauthnChallenge(s string) *AuthRequestUpdate {
+	aru.mutation.SetWebauthnChallenge(s)
+	return aru
+}
+
+// SetNillableWebauthnChallenge sets the "webauthn_challenge" field if the given value is not nil.
+func (aru *AuthRequestUpdate) SetNillableWebauthnChallenge(s *string) *AuthRequestUpdate {
+	if s != nil {
+		aru.SetWebauthnChallenge(*s)
+	}
+	return aru
+}
+
+// SetCallbackData sets the "callback_data" field.
+func (aru *AuthRequestUpdate) SetCallbackData(b []byte) *AuthRequestUpdate {
+	aru.mutation.SetCallbackData(b)
+	return aru
+}
+
+// ClearCallbackData clears the value of the "callback_data" field.
+func (aru *AuthRequestUpdate) ClearCallbackData() *AuthRequestUpdate {
+	aru.mutation.ClearCallbackData()
+	return aru
+}
+
 // Mutation returns the AuthRequestMutation object of the builder.
 func (aru *AuthRequestUpdate) Mutation() *AuthRequestMutation {
 	return aru.mutation
@@ -383,6 +475,13 @@ func (aru *AuthRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: authrequest.FieldClaimsPreferredUsername,
 		})
 	}
+	if value, ok := aru.mutation.ClaimsAcr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldClaimsAcr,
+		})
+	}
 	if value, ok := aru.mutation.ConnectorID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -424,6 +523,66 @@ func (aru *AuthRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: authrequest.FieldCodeChallengeMethod,
 		})
 	}
+	if value, ok := aru.mutation.ResponseMode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldResponseMode,
+		})
+	}
+	if value, ok := aru.mutation.AcrValues(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: authrequest.FieldAcrValues,
+		})
+	}
+	if aru.mutation.AcrValuesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: authrequest.FieldAcrValues,
+		})
+	}
+	if value, ok := aru.mutation.ConnectorChain(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: authrequest.FieldConnectorChain,
+		})
+	}
+	if aru.mutation.ConnectorChainCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: authrequest.FieldConnectorChain,
+		})
+	}
+	if value, ok := aru.mutation.MfaValidated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: authrequest.FieldMfaValidated,
+		})
+	}
+	if value, ok := aru.mutation.WebauthnChallenge(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldWebauthnChallenge,
+		})
+	}
+	if value, ok := aru.mutation.CallbackData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: authrequest.FieldCallbackData,
+		})
+	}
+	if aru.mutation.CallbackDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: authrequest.FieldCallbackData,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{authrequest.Label}
@@ -553,6 +712,20 @@ func (aruo *AuthRequestUpdateOne) SetNillableClaimsPreferredUsername(s *string)
 	return aruo
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (aruo *AuthRequestUpdateOne) SetClaimsAcr(s string) *AuthRequestUpdateOne {
+	aruo.mutation.SetClaimsAcr(s)
+	return aruo
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (aruo *AuthRequestUpdateOne) SetNillableClaimsAcr(s *string) *AuthRequestUpdateOne {
+	if s != nil {
+		aruo.SetClaimsAcr(*s)
+	}
+	return aruo
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (aruo *AuthRequestUpdateOne) SetConnectorID(s string) *AuthRequestUpdateOne {
 	aruo.mutation.SetConnectorID(s)
@@ -605,6 +778,84 @@ func (aruo *AuthRequestUpdateOne) SetNillableCodeChallengeMethod(s *string) *Aut
 	return aruo
 }
 
+// SetResponseMode sets the "response_mode" field.
+func (aruo *AuthRequestUpdateOne) SetResponseMode(s string) *AuthRequestUpdateOne {
+	aruo.mutation.SetResponseMode(s)
+	return aruo
+}
+
+// SetNillableResponseMode sets the "response_mode" field if the given value is not nil.
+func (aruo *AuthRequestUpdateOne) SetNillableResponseMode(s *string) *AuthRequestUpdateOne {
+	if s != nil {
+		aruo.SetResponseMode(*s)
+	}
+	return aruo
+}
+
+// SetAcrValues sets the "acr_values" field.
+func (aruo *AuthRequestUpdateOne) SetAcrValues(s []string) *AuthRequestUpdateOne {
+	aruo.mutation.SetAcrValues(s)
+	return aruo
+}
+
+// ClearAcrValues clears the value of the "acr_values" field.
+func (aruo *AuthRequestUpdateOne) ClearAcrValues() *AuthRequestUpdateOne {
+	aruo.mutation.ClearAcrValues()
+	return aruo
+}
+
+// SetConnectorChain sets the "connector_chain" field.
+func (aruo *AuthRequestUpdateOne) SetConnectorChain(s []string) *AuthRequestUpdateOne {
+	aruo.mutation.SetConnectorChain(s)
+	return aruo
+}
+
+// ClearConnectorChain clears the value of the "connector_chain" field.
+func (aruo *AuthRequestUpdateOne) ClearConnectorChain() *AuthRequestUpdateOne {
+	aruo.mutation.ClearConnectorChain()
+	return aruo
+}
+
+// SetMfaValidated sets the "mfa_validated" field.
+func (aruo *AuthRequestUpdateOne) SetMfaValidated(b bool) *AuthRequestUpdateOne {
+	aruo.mutation.SetMfaValidated(b)
+	return aruo
+}
+
+// SetNillableMfaValidated sets the "mfa_validated" field if the given value is not nil.
+func (aruo *AuthRequestUpdateOne) SetNillableMfaValidated(b *bool) *AuthRequestUpdateOne {
+	if b != nil {
+		aruo.SetMfaValidated(*b)
+	}
+	return aruo
+}
+
+// SetWebauthnChallenge sets the "webauthn_challenge" field.
+func (aruo *AuthRequestUpdateOne) SetWebauthnChallenge(s string) *AuthRequestUpdateOne {
+	aruo.mutation.SetWebauthnChallenge(s)
+	return aruo
+}
+
+// SetNillableWebauthnChallenge sets the "webauthn_challenge" field if the given value is not nil.
+func (aruo *AuthRequestUpdateOne) SetNillableWebauthnChallenge(s *string) *AuthRequestUpdateOne {
+	if s != nil {
+		aruo.SetWebauthnChallenge(*s)
+	}
+	return aruo
+}
+
+// SetCallbackData sets the "callback_data" field.
+func (aruo *AuthRequestUpdateOne) SetCallbackData(b []byte) *AuthRequestUpdateOne {
+	aruo.mutation.SetCallbackData(b)
+	return aruo
+}
+
+// ClearCallbackData clears the value of the "callback_data" field.
+func (aruo *AuthRequestUpdateOne) ClearCallbackData() *AuthRequestUpdateOne {
+	aruo.mutation.ClearCallbackData()
+	return aruo
+}
+
 // Mutation returns the AuthRequestMutation object of the builder.
 func (aruo *AuthRequestUpdateOne) Mutation() *AuthRequestMutation {
 	return aruo.mutation
@@ -822,6 +1073,13 @@ func (aruo *AuthRequestUpdateOne) sqlSave(ctx context.Context) (_node *AuthReque
 			Column: authrequest.FieldClaimsPreferredUsername,
 		})
 	}
+	if value, ok := aruo.mutation.ClaimsAcr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldClaimsAcr,
+		})
+	}
 	if value, ok := aruo.mutation.ConnectorID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -863,6 +1121,66 @@ func (aruo *AuthRequestUpdateOne) sqlSave(ctx context.Context) (_node *AuthReque
 			Column: authrequest.FieldCodeChallengeMethod,
 		})
 	}
+	if value, ok := aruo.mutation.ResponseMode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldResponseMode,
+		})
+	}
+	if value, ok := aruo.mutation.AcrValues(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: authrequest.FieldAcrValues,
+		})
+	}
+	if aruo.mutation.AcrValuesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: authrequest.FieldAcrValues,
+		})
+	}
+	if value, ok := aruo.mutation.ConnectorChain(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: authrequest.FieldConnectorChain,
+		})
+	}
+	if aruo.mutation.ConnectorChainCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: authrequest.FieldConnectorChain,
+		})
+	}
+	if value, ok := aruo.mutation.MfaValidated(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: authrequest.FieldMfaValidated,
+		})
+	}
+	if value, ok := aruo.mutation.WebauthnChallenge(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: authrequest.FieldWebauthnChallenge,
+		})
+	}
+	if value, ok := aruo.mutation.CallbackData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: authrequest.FieldCallbackData,
+		})
+	}
+	if aruo.mutation.CallbackDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: authrequest.FieldCallbackData,
+		})
+	}
 	_node = &AuthRequest{config: aruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,155 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
+)
+
+// BackchannelAuthRequest is the model entity for the BackchannelAuthRequest schema.
+type BackchannelAuthRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// LoginHint holds the value of the "login_hint" field.
+	LoginHint string `json:"login_hint,omitempty"`
+	// BindingMessage holds the value of the "binding_message" field.
+	BindingMessage string `json:"binding_message,omitempty"`
+	// ClientNotificationToken holds the value of the "client_notification_token" field.
+	ClientNotificationToken string `json:"client_notification_token,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackchannelAuthRequest) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backchannelauthrequest.FieldScopes:
+			values[i] = new([]byte)
+		case backchannelauthrequest.FieldID, backchannelauthrequest.FieldClientID, backchannelauthrequest.FieldLoginHint, backchannelauthrequest.FieldBindingMessage, backchannelauthrequest.FieldClientNotificationToken:
+			values[i] = new(sql.NullString)
+		case backchannelauthrequest.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type BackchannelAuthRequest", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackchannelAuthRequest fields.
+func (bar *BackchannelAuthRequest) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backchannelauthrequest.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				bar.ID = value.String
+			}
+		case backchannelauthrequest.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				bar.ClientID = value.String
+			}
+		case backchannelauthrequest.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bar.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case backchannelauthrequest.FieldLoginHint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field login_hint", values[i])
+			} else if value.Valid {
+				bar.LoginHint = value.String
+			}
+		case backchannelauthrequest.FieldBindingMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field binding_message", values[i])
+			} else if value.Valid {
+				bar.BindingMessage = value.String
+			}
+		case backchannelauthrequest.FieldClientNotificationToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_notification_token", values[i])
+			} else if value.Valid {
+				bar.ClientNotificationToken = value.String
+			}
+		case backchannelauthrequest.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				bar.Expiry = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this BackchannelAuthRequest.
+// Note that you need to call BackchannelAuthRequest.Unwrap() before calling this method if this BackchannelAuthRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bar *BackchannelAuthRequest) Update() *BackchannelAuthRequestUpdateOne {
+	return (&BackchannelAuthRequestClient{config: bar.config}).UpdateOne(bar)
+}
+
+// Unwrap unwraps the BackchannelAuthRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bar *BackchannelAuthRequest) Unwrap() *BackchannelAuthRequest {
+	tx, ok := bar.config.driver.(*txDriver)
+	if !ok {
+		panic("db: BackchannelAuthRequest is not a transactional entity")
+	}
+	bar.config.driver = tx.drv
+	return bar
+}
+
+// String implements the fmt.Stringer.
+func (bar *BackchannelAuthRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackchannelAuthRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v", bar.ID))
+	builder.WriteString(", client_id=")
+	builder.WriteString(bar.ClientID)
+	builder.WriteString(", scopes=")
+	builder.WriteString(fmt.Sprintf("%v", bar.Scopes))
+	builder.WriteString(", login_hint=")
+	builder.WriteString(bar.LoginHint)
+	builder.WriteString(", binding_message=")
+	builder.WriteString(bar.BindingMessage)
+	builder.WriteString(", client_notification_token=")
+	builder.WriteString(bar.ClientNotificationToken)
+	builder.WriteString(", expiry=")
+	builder.WriteString(bar.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackchannelAuthRequests is a parsable slice of BackchannelAuthRequest.
+type BackchannelAuthRequests []*BackchannelAuthRequest
+
+func (bar BackchannelAuthRequests) config(cfg config) {
+	for _i := range bar {
+		bar[_i].config = cfg
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by entc, DO NOT EDIT.
+
+package backchannelauthrequest
+
+const (
+	// Label holds the string label denoting the backchannelauthrequest type in the database.
+	Label = "backchannel_auth_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldLoginHint holds the string denoting the login_hint field in the database.
+	FieldLoginHint = "login_hint"
+	// FieldBindingMessage holds the string denoting the binding_message field in the database.
+	FieldBindingMessage = "binding_message"
+	// FieldClientNotificationToken holds the string denoting the client_notification_token field in the database.
+	FieldClientNotificationToken = "client_notification_token"
+	// FieldExpiry holds the string denoting the expiry field in the database.
+	FieldExpiry = "expiry"
+	// Table holds the table name of the backchannelauthrequest in the database.
+	Table = "backchannel_auth_requests"
+)
+
+// Columns holds all SQL columns for backchannelauthrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldClientID,
+	FieldScopes,
+	FieldLoginHint,
+	FieldBindingMessage,
+	FieldClientNotificationToken,
+	FieldExpiry,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ClientIDValidator func(string) error
+	// DefaultBindingMessage holds the default value on creation for the "binding_message" field.
+	DefaultBindingMessage string
+	// DefaultClientNotificationToken holds the default value on creation for the "client_notification_token" field.
+	DefaultClientNotificationToken string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
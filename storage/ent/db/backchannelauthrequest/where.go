@@ -0,0 +1,694 @@
+// Code generated by entc, DO NOT EDIT.
+
+package backchannelauthrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClientID), v))
+	})
+}
+
+// LoginHint applies equality check predicate on the "login_hint" field. It's identical to LoginHintEQ.
+func LoginHint(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLoginHint), v))
+	})
+}
+
+// BindingMessage applies equality check predicate on the "binding_message" field. It's identical to BindingMessageEQ.
+func BindingMessage(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBindingMessage), v))
+	})
+}
+
+// ClientNotificationToken applies equality check predicate on the "client_notification_token" field. It's identical to ClientNotificationTokenEQ.
+func ClientNotificationToken(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// Expiry applies equality check predicate on the "expiry" field. It's identical to ExpiryEQ.
+func Expiry(v time.Time) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiry), v))
+	})
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClientID), v...))
+	})
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClientID), v...))
+	})
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldClientID), v))
+	})
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldScopes)))
+	})
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldScopes)))
+	})
+}
+
+// LoginHintEQ applies the EQ predicate on the "login_hint" field.
+func LoginHintEQ(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintNEQ applies the NEQ predicate on the "login_hint" field.
+func LoginHintNEQ(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintIn applies the In predicate on the "login_hint" field.
+func LoginHintIn(vs ...string) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLoginHint), v...))
+	})
+}
+
+// LoginHintNotIn applies the NotIn predicate on the "login_hint" field.
+func LoginHintNotIn(vs ...string) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLoginHint), v...))
+	})
+}
+
+// LoginHintGT applies the GT predicate on the "login_hint" field.
+func LoginHintGT(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintGTE applies the GTE predicate on the "login_hint" field.
+func LoginHintGTE(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintLT applies the LT predicate on the "login_hint" field.
+func LoginHintLT(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintLTE applies the LTE predicate on the "login_hint" field.
+func LoginHintLTE(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintContains applies the Contains predicate on the "login_hint" field.
+func LoginHintContains(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintHasPrefix applies the HasPrefix predicate on the "login_hint" field.
+func LoginHintHasPrefix(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintHasSuffix applies the HasSuffix predicate on the "login_hint" field.
+func LoginHintHasSuffix(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintEqualFold applies the EqualFold predicate on the "login_hint" field.
+func LoginHintEqualFold(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLoginHint), v))
+	})
+}
+
+// LoginHintContainsFold applies the ContainsFold predicate on the "login_hint" field.
+func LoginHintContainsFold(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLoginHint), v))
+	})
+}
+
+// BindingMessageEQ applies the EQ predicate on the "binding_message" field.
+func BindingMessageEQ(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageNEQ applies the NEQ predicate on the "binding_message" field.
+func BindingMessageNEQ(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageIn applies the In predicate on the "binding_message" field.
+func BindingMessageIn(vs ...string) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBindingMessage), v...))
+	})
+}
+
+// BindingMessageNotIn applies the NotIn predicate on the "binding_message" field.
+func BindingMessageNotIn(vs ...string) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBindingMessage), v...))
+	})
+}
+
+// BindingMessageGT applies the GT predicate on the "binding_message" field.
+func BindingMessageGT(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageGTE applies the GTE predicate on the "binding_message" field.
+func BindingMessageGTE(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageLT applies the LT predicate on the "binding_message" field.
+func BindingMessageLT(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageLTE applies the LTE predicate on the "binding_message" field.
+func BindingMessageLTE(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageContains applies the Contains predicate on the "binding_message" field.
+func BindingMessageContains(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageHasPrefix applies the HasPrefix predicate on the "binding_message" field.
+func BindingMessageHasPrefix(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageHasSuffix applies the HasSuffix predicate on the "binding_message" field.
+func BindingMessageHasSuffix(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageEqualFold applies the EqualFold predicate on the "binding_message" field.
+func BindingMessageEqualFold(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBindingMessage), v))
+	})
+}
+
+// BindingMessageContainsFold applies the ContainsFold predicate on the "binding_message" field.
+func BindingMessageContainsFold(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBindingMessage), v))
+	})
+}
+
+// ClientNotificationTokenEQ applies the EQ predicate on the "client_notification_token" field.
+func ClientNotificationTokenEQ(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenNEQ applies the NEQ predicate on the "client_notification_token" field.
+func ClientNotificationTokenNEQ(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenIn applies the In predicate on the "client_notification_token" field.
+func ClientNotificationTokenIn(vs ...string) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClientNotificationToken), v...))
+	})
+}
+
+// ClientNotificationTokenNotIn applies the NotIn predicate on the "client_notification_token" field.
+func ClientNotificationTokenNotIn(vs ...string) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClientNotificationToken), v...))
+	})
+}
+
+// ClientNotificationTokenGT applies the GT predicate on the "client_notification_token" field.
+func ClientNotificationTokenGT(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenGTE applies the GTE predicate on the "client_notification_token" field.
+func ClientNotificationTokenGTE(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenLT applies the LT predicate on the "client_notification_token" field.
+func ClientNotificationTokenLT(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenLTE applies the LTE predicate on the "client_notification_token" field.
+func ClientNotificationTokenLTE(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenContains applies the Contains predicate on the "client_notification_token" field.
+func ClientNotificationTokenContains(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenHasPrefix applies the HasPrefix predicate on the "client_notification_token" field.
+func ClientNotificationTokenHasPrefix(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenHasSuffix applies the HasSuffix predicate on the "client_notification_token" field.
+func ClientNotificationTokenHasSuffix(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenEqualFold applies the EqualFold predicate on the "client_notification_token" field.
+func ClientNotificationTokenEqualFold(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ClientNotificationTokenContainsFold applies the ContainsFold predicate on the "client_notification_token" field.
+func ClientNotificationTokenContainsFold(v string) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldClientNotificationToken), v))
+	})
+}
+
+// ExpiryEQ applies the EQ predicate on the "expiry" field.
+func ExpiryEQ(v time.Time) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryNEQ applies the NEQ predicate on the "expiry" field.
+func ExpiryNEQ(v time.Time) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryIn applies the In predicate on the "expiry" field.
+func ExpiryIn(vs ...time.Time) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiry), v...))
+	})
+}
+
+// ExpiryNotIn applies the NotIn predicate on the "expiry" field.
+func ExpiryNotIn(vs ...time.Time) predicate.BackchannelAuthRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiry), v...))
+	})
+}
+
+// ExpiryGT applies the GT predicate on the "expiry" field.
+func ExpiryGT(v time.Time) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryGTE applies the GTE predicate on the "expiry" field.
+func ExpiryGTE(v time.Time) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryLT applies the LT predicate on the "expiry" field.
+func ExpiryLT(v time.Time) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryLTE applies the LTE predicate on the "expiry" field.
+func ExpiryLTE(v time.Time) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiry), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BackchannelAuthRequest) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BackchannelAuthRequest) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BackchannelAuthRequest) predicate.BackchannelAuthRequest {
+	return predicate.BackchannelAuthRequest(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,354 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
+)
+
+// BackchannelAuthRequestCreate is the builder for creating a BackchannelAuthRequest entity.
+type BackchannelAuthRequestCreate struct {
+	config
+	mutation *BackchannelAuthRequestMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (barc *BackchannelAuthRequestCreate) SetClientID(s string) *BackchannelAuthRequestCreate {
+	barc.mutation.SetClientID(s)
+	return barc
+}
+
+// SetScopes sets the "scopes" field.
+func (barc *BackchannelAuthRequestCreate) SetScopes(s []string) *BackchannelAuthRequestCreate {
+	barc.mutation.SetScopes(s)
+	return barc
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (barc *BackchannelAuthRequestCreate) SetLoginHint(s string) *BackchannelAuthRequestCreate {
+	barc.mutation.SetLoginHint(s)
+	return barc
+}
+
+// SetBindingMessage sets the "binding_message" field.
+func (barc *BackchannelAuthRequestCreate) SetBindingMessage(s string) *BackchannelAuthRequestCreate {
+	barc.mutation.SetBindingMessage(s)
+	return barc
+}
+
+// SetNillableBindingMessage sets the "binding_message" field if the given value is not nil.
+func (barc *BackchannelAuthRequestCreate) SetNillableBindingMessage(s *string) *BackchannelAuthRequestCreate {
+	if s != nil {
+		barc.SetBindingMessage(*s)
+	}
+	return barc
+}
+
+// SetClientNotificationToken sets the "client_notification_token" field.
+func (barc *BackchannelAuthRequestCreate) SetClientNotificationToken(s string) *BackchannelAuthRequestCreate {
+	barc.mutation.SetClientNotificationToken(s)
+	return barc
+}
+
+// SetNillableClientNotificationToken sets the "client_notification_token" field if the given value is not nil.
+func (barc *BackchannelAuthRequestCreate) SetNillableClientNotificationToken(s *string) *BackchannelAuthRequestCreate {
+	if s != nil {
+		barc.SetClientNotificationToken(*s)
+	}
+	return barc
+}
+
+// SetExpiry sets the "expiry" field.
+func (barc *BackchannelAuthRequestCreate) SetExpiry(t time.Time) *BackchannelAuthRequestCreate {
+	barc.mutation.SetExpiry(t)
+	return barc
+}
+
+// SetID sets the "id" field.
+func (barc *BackchannelAuthRequestCreate) SetID(s string) *BackchannelAuthRequestCreate {
+	barc.mutation.SetID(s)
+	return barc
+}
+
+// Mutation returns the BackchannelAuthRequestMutation object of the builder.
+func (barc *BackchannelAuthRequestCreate) Mutation() *BackchannelAuthRequestMutation {
+	return barc.mutation
+}
+
+// Save creates the BackchannelAuthRequest in the database.
+func (barc *BackchannelAuthRequestCreate) Save(ctx context.Context) (*BackchannelAuthRequest, error) {
+	var (
+		err  error
+		node *BackchannelAuthRequest
+	)
+	barc.defaults()
+	if len(barc.hooks) == 0 {
+		if err = barc.check(); err != nil {
+			return nil, err
+		}
+		node, err = barc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BackchannelAuthRequestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = barc.check(); err != nil {
+				return nil, err
+			}
+			barc.mutation = mutation
+			if node, err = barc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(barc.hooks) - 1; i >= 0; i-- {
+			if barc.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = barc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, barc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (barc *BackchannelAuthRequestCreate) SaveX(ctx context.Context) *BackchannelAuthRequest {
+	v, err := barc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (barc *BackchannelAuthRequestCreate) Exec(ctx context.Context) error {
+	_, err := barc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (barc *BackchannelAuthRequestCreate) ExecX(ctx context.Context) {
+	if err := barc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (barc *BackchannelAuthRequestCreate) defaults() {
+	if _, ok := barc.mutation.BindingMessage(); !ok {
+		v := backchannelauthrequest.DefaultBindingMessage
+		barc.mutation.SetBindingMessage(v)
+	}
+	if _, ok := barc.mutation.ClientNotificationToken(); !ok {
+		v := backchannelauthrequest.DefaultClientNotificationToken
+		barc.mutation.SetClientNotificationToken(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (barc *BackchannelAuthRequestCreate) check() error {
+	if _, ok := barc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`db: missing required field "BackchannelAuthRequest.client_id"`)}
+	}
+	if v, ok := barc.mutation.ClientID(); ok {
+		if err := backchannelauthrequest.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "BackchannelAuthRequest.client_id": %w`, err)}
+		}
+	}
+	if _, ok := barc.mutation.LoginHint(); !ok {
+		return &ValidationError{Name: "login_hint", err: errors.New(`db: missing required field "BackchannelAuthRequest.login_hint"`)}
+	}
+	if _, ok := barc.mutation.BindingMessage(); !ok {
+		return &ValidationError{Name: "binding_message", err: errors.New(`db: missing required field "BackchannelAuthRequest.binding_message"`)}
+	}
+	if _, ok := barc.mutation.ClientNotificationToken(); !ok {
+		return &ValidationError{Name: "client_notification_token", err: errors.New(`db: missing required field "BackchannelAuthRequest.client_notification_token"`)}
+	}
+	if _, ok := barc.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "BackchannelAuthRequest.expiry"`)}
+	}
+	if v, ok := barc.mutation.ID(); ok {
+		if err := backchannelauthrequest.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "BackchannelAuthRequest.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (barc *BackchannelAuthRequestCreate) sqlSave(ctx context.Context) (*BackchannelAuthRequest, error) {
+	_node, _spec := barc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, barc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BackchannelAuthRequest.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (barc *BackchannelAuthRequestCreate) createSpec() (*BackchannelAuthRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackchannelAuthRequest{config: barc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: backchannelauthrequest.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: backchannelauthrequest.FieldID,
+			},
+		}
+	)
+	if id, ok := barc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := barc.mutation.ClientID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldClientID,
+		})
+		_node.ClientID = value
+	}
+	if value, ok := barc.mutation.Scopes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: backchannelauthrequest.FieldScopes,
+		})
+		_node.Scopes = value
+	}
+	if value, ok := barc.mutation.LoginHint(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldLoginHint,
+		})
+		_node.LoginHint = value
+	}
+	if value, ok := barc.mutation.BindingMessage(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldBindingMessage,
+		})
+		_node.BindingMessage = value
+	}
+	if value, ok := barc.mutation.ClientNotificationToken(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldClientNotificationToken,
+		})
+		_node.ClientNotificationToken = value
+	}
+	if value, ok := barc.mutation.Expiry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: backchannelauthrequest.FieldExpiry,
+		})
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// BackchannelAuthRequestCreateBulk is the builder for creating many BackchannelAuthRequest entities in bulk.
+type BackchannelAuthRequestCreateBulk struct {
+	config
+	builders []*BackchannelAuthRequestCreate
+}
+
+// Save creates the BackchannelAuthRequest entities in the database.
+func (barcb *BackchannelAuthRequestCreateBulk) Save(ctx context.Context) ([]*BackchannelAuthRequest, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(barcb.builders))
+	nodes := make([]*BackchannelAuthRequest, len(barcb.builders))
+	mutators := make([]Mutator, len(barcb.builders))
+	for i := range barcb.builders {
+		func(i int, root context.Context) {
+			builder := barcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackchannelAuthRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, barcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, barcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, barcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (barcb *BackchannelAuthRequestCreateBulk) SaveX(ctx context.Context) []*BackchannelAuthRequest {
+	v, err := barcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (barcb *BackchannelAuthRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := barcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (barcb *BackchannelAuthRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := barcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
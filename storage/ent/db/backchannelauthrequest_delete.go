@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// BackchannelAuthRequestDelete is the builder for deleting a BackchannelAuthRequest entity.
+type BackchannelAuthRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *BackchannelAuthRequestMutation
+}
+
+// Where appends a list predicates to the BackchannelAuthRequestDelete builder.
+func (bard *BackchannelAuthRequestDelete) Where(ps ...predicate.BackchannelAuthRequest) *BackchannelAuthRequestDelete {
+	bard.mutation.Where(ps...)
+	return bard
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bard *BackchannelAuthRequestDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bard.hooks) == 0 {
+		affected, err = bard.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BackchannelAuthRequestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bard.mutation = mutation
+			affected, err = bard.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bard.hooks) - 1; i >= 0; i-- {
+			if bard.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = bard.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bard.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bard *BackchannelAuthRequestDelete) ExecX(ctx context.Context) int {
+	n, err := bard.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bard *BackchannelAuthRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: backchannelauthrequest.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: backchannelauthrequest.FieldID,
+			},
+		},
+	}
+	if ps := bard.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, bard.driver, _spec)
+}
+
+// BackchannelAuthRequestDeleteOne is the builder for deleting a single BackchannelAuthRequest entity.
+type BackchannelAuthRequestDeleteOne struct {
+	bard *BackchannelAuthRequestDelete
+}
+
+// Exec executes the deletion query.
+func (bardo *BackchannelAuthRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := bardo.bard.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{backchannelauthrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bardo *BackchannelAuthRequestDeleteOne) ExecX(ctx context.Context) {
+	bardo.bard.ExecX(ctx)
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// BackchannelAuthRequestQuery is the builder for querying BackchannelAuthRequest entities.
+type BackchannelAuthRequestQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.BackchannelAuthRequest
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BackchannelAuthRequestQuery builder.
+func (barq *BackchannelAuthRequestQuery) Where(ps ...predicate.BackchannelAuthRequest) *BackchannelAuthRequestQuery {
+	barq.predicates = append(barq.predicates, ps...)
+	return barq
+}
+
+// Limit adds a limit step to the query.
+func (barq *BackchannelAuthRequestQuery) Limit(limit int) *BackchannelAuthRequestQuery {
+	barq.limit = &limit
+	return barq
+}
+
+// Offset adds an offset step to the query.
+func (barq *BackchannelAuthRequestQuery) Offset(offset int) *BackchannelAuthRequestQuery {
+	barq.offset = &offset
+	return barq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (barq *BackchannelAuthRequestQuery) Unique(unique bool) *BackchannelAuthRequestQuery {
+	barq.unique = &unique
+	return barq
+}
+
+// Order adds an order step to the query.
+func (barq *BackchannelAuthRequestQuery) Order(o ...OrderFunc) *BackchannelAuthRequestQuery {
+	barq.order = append(barq.order, o...)
+	return barq
+}
+
+// First returns the first BackchannelAuthRequest entity from the query.
+// Returns a *NotFoundError when no BackchannelAuthRequest was found.
+func (barq *BackchannelAuthRequestQuery) First(ctx context.Context) (*BackchannelAuthRequest, error) {
+	nodes, err := barq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{backchannelauthrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (barq *BackchannelAuthRequestQuery) FirstX(ctx context.Context) *BackchannelAuthRequest {
+	node, err := barq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BackchannelAuthRequest ID from the query.
+// Returns a *NotFoundError when no BackchannelAuthRequest ID was found.
+func (barq *BackchannelAuthRequestQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = barq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{backchannelauthrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (barq *BackchannelAuthRequestQuery) FirstIDX(ctx context.Context) string {
+	id, err := barq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BackchannelAuthRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BackchannelAuthRequest entity is found.
+// Returns a *NotFoundError when no BackchannelAuthRequest entities are found.
+func (barq *BackchannelAuthRequestQuery) Only(ctx context.Context) (*BackchannelAuthRequest, error) {
+	nodes, err := barq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{backchannelauthrequest.Label}
+	default:
+		return nil, &NotSingularError{backchannelauthrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (barq *BackchannelAuthRequestQuery) OnlyX(ctx context.Context) *BackchannelAuthRequest {
+	node, err := barq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BackchannelAuthRequest ID in the query.
+// Returns a *NotSingularError when more than one BackchannelAuthRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (barq *BackchannelAuthRequestQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = barq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = &NotSingularError{backchannelauthrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (barq *BackchannelAuthRequestQuery) OnlyIDX(ctx context.Context) string {
+	id, err := barq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BackchannelAuthRequests.
+func (barq *BackchannelAuthRequestQuery) All(ctx context.Context) ([]*BackchannelAuthRequest, error) {
+	if err := barq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return barq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (barq *BackchannelAuthRequestQuery) AllX(ctx context.Context) []*BackchannelAuthRequest {
+	nodes, err := barq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BackchannelAuthRequest IDs.
+func (barq *BackchannelAuthRequestQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := barq.Select(backchannelauthrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (barq *BackchannelAuthRequestQuery) IDsX(ctx context.Context) []string {
+	ids, err := barq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (barq *BackchannelAuthRequestQuery) Count(ctx context.Context) (int, error) {
+	if err := barq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return barq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (barq *BackchannelAuthRequestQuery) CountX(ctx context.Context) int {
+	count, err := barq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (barq *BackchannelAuthRequestQuery) Exist(ctx context.Context) (bool, error) {
+	if err := barq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return barq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (barq *BackchannelAuthRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := barq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BackchannelAuthRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (barq *BackchannelAuthRequestQuery) Clone() *BackchannelAuthRequestQuery {
+	if barq == nil {
+		return nil
+	}
+	return &BackchannelAuthRequestQuery{
+		config:     barq.config,
+		limit:      barq.limit,
+		offset:     barq.offset,
+		order:      append([]OrderFunc{}, barq.order...),
+		predicates: append([]predicate.BackchannelAuthRequest{}, barq.predicates...),
+		// clone intermediate query.
+		sql:    barq.sql.Clone(),
+		path:   barq.path,
+		unique: barq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID string `json:"client_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BackchannelAuthRequest.Query().
+//		GroupBy(backchannelauthrequest.FieldClientID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+//
+func (barq *BackchannelAuthRequestQuery) GroupBy(field string, fields ...string) *BackchannelAuthRequestGroupBy {
+	group := &BackchannelAuthRequestGroupBy{config: barq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := barq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return barq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID string `json:"client_id,omitempty"`
+//	}
+//
+//	client.BackchannelAuthRequest.Query().
+//		Select(backchannelauthrequest.FieldClientID).
+//		Scan(ctx, &v)
+//
+func (barq *BackchannelAuthRequestQuery) Select(fields ...string) *BackchannelAuthRequestSelect {
+	barq.fields = append(barq.fields, fields...)
+	return &BackchannelAuthRequestSelect{BackchannelAuthRequestQuery: barq}
+}
+
+func (barq *BackchannelAuthRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range barq.fields {
+		if !backchannelauthrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if barq.path != nil {
+		prev, err := barq.path(ctx)
+		if err != nil {
+			return err
+		}
+		barq.sql = prev
+	}
+	return nil
+}
+
+func (barq *BackchannelAuthRequestQuery) sqlAll(ctx context.Context) ([]*BackchannelAuthRequest, error) {
+	var (
+		nodes = []*BackchannelAuthRequest{}
+		_spec = barq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &BackchannelAuthRequest{config: barq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, barq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (barq *BackchannelAuthRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := barq.querySpec()
+	_spec.Node.Columns = barq.fields
+	if len(barq.fields) > 0 {
+		_spec.Unique = barq.unique != nil && *barq.unique
+	}
+	return sqlgraph.CountNodes(ctx, barq.driver, _spec)
+}
+
+func (barq *BackchannelAuthRequestQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := barq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (barq *BackchannelAuthRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   backchannelauthrequest.Table,
+			Columns: backchannelauthrequest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: backchannelauthrequest.FieldID,
+			},
+		},
+		From:   barq.sql,
+		Unique: true,
+	}
+	if unique := barq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := barq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backchannelauthrequest.FieldID)
+		for i := range fields {
+			if fields[i] != backchannelauthrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := barq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := barq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := barq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := barq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (barq *BackchannelAuthRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(barq.driver.Dialect())
+	t1 := builder.Table(backchannelauthrequest.Table)
+	columns := barq.fields
+	if len(columns) == 0 {
+		columns = backchannelauthrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if barq.sql != nil {
+		selector = barq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if barq.unique != nil && *barq.unique {
+		selector.Distinct()
+	}
+	for _, p := range barq.predicates {
+		p(selector)
+	}
+	for _, p := range barq.order {
+		p(selector)
+	}
+	if offset := barq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := barq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BackchannelAuthRequestGroupBy is the group-by builder for BackchannelAuthRequest entities.
+type BackchannelAuthRequestGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bargb *BackchannelAuthRequestGroupBy) Aggregate(fns ...AggregateFunc) *BackchannelAuthRequestGroupBy {
+	bargb.fns = append(bargb.fns, fns...)
+	return bargb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bargb *BackchannelAuthRequestGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bargb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bargb.sql = query
+	return bargb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := bargb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bargb *BackchannelAuthRequestGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(bargb.fields) > 1 {
+		return nil, errors.New("db: BackchannelAuthRequestGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := bargb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) StringsX(ctx context.Context) []string {
+	v, err := bargb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bargb *BackchannelAuthRequestGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bargb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = fmt.Errorf("db: BackchannelAuthRequestGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) StringX(ctx context.Context) string {
+	v, err := bargb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bargb *BackchannelAuthRequestGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(bargb.fields) > 1 {
+		return nil, errors.New("db: BackchannelAuthRequestGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := bargb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) IntsX(ctx context.Context) []int {
+	v, err := bargb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bargb *BackchannelAuthRequestGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bargb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = fmt.Errorf("db: BackchannelAuthRequestGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) IntX(ctx context.Context) int {
+	v, err := bargb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bargb *BackchannelAuthRequestGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bargb.fields) > 1 {
+		return nil, errors.New("db: BackchannelAuthRequestGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := bargb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := bargb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bargb *BackchannelAuthRequestGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bargb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = fmt.Errorf("db: BackchannelAuthRequestGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := bargb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (bargb *BackchannelAuthRequestGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(bargb.fields) > 1 {
+		return nil, errors.New("db: BackchannelAuthRequestGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := bargb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := bargb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (bargb *BackchannelAuthRequestGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bargb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = fmt.Errorf("db: BackchannelAuthRequestGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bargb *BackchannelAuthRequestGroupBy) BoolX(ctx context.Context) bool {
+	v, err := bargb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bargb *BackchannelAuthRequestGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bargb.fields {
+		if !backchannelauthrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bargb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bargb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bargb *BackchannelAuthRequestGroupBy) sqlQuery() *sql.Selector {
+	selector := bargb.sql.Select()
+	aggregation := make([]string, 0, len(bargb.fns))
+	for _, fn := range bargb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bargb.fields)+len(bargb.fns))
+		for _, f := range bargb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bargb.fields...)...)
+}
+
+// BackchannelAuthRequestSelect is the builder for selecting fields of BackchannelAuthRequest entities.
+type BackchannelAuthRequestSelect struct {
+	*BackchannelAuthRequestQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bars *BackchannelAuthRequestSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bars.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bars.sql = bars.BackchannelAuthRequestQuery.sqlQuery(ctx)
+	return bars.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := bars.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (bars *BackchannelAuthRequestSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(bars.fields) > 1 {
+		return nil, errors.New("db: BackchannelAuthRequestSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := bars.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) StringsX(ctx context.Context) []string {
+	v, err := bars.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (bars *BackchannelAuthRequestSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = bars.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = fmt.Errorf("db: BackchannelAuthRequestSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) StringX(ctx context.Context) string {
+	v, err := bars.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (bars *BackchannelAuthRequestSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(bars.fields) > 1 {
+		return nil, errors.New("db: BackchannelAuthRequestSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := bars.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) IntsX(ctx context.Context) []int {
+	v, err := bars.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (bars *BackchannelAuthRequestSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = bars.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = fmt.Errorf("db: BackchannelAuthRequestSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) IntX(ctx context.Context) int {
+	v, err := bars.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (bars *BackchannelAuthRequestSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bars.fields) > 1 {
+		return nil, errors.New("db: BackchannelAuthRequestSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := bars.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := bars.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (bars *BackchannelAuthRequestSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = bars.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = fmt.Errorf("db: BackchannelAuthRequestSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) Float64X(ctx context.Context) float64 {
+	v, err := bars.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (bars *BackchannelAuthRequestSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(bars.fields) > 1 {
+		return nil, errors.New("db: BackchannelAuthRequestSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := bars.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) BoolsX(ctx context.Context) []bool {
+	v, err := bars.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (bars *BackchannelAuthRequestSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = bars.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{backchannelauthrequest.Label}
+	default:
+		err = fmt.Errorf("db: BackchannelAuthRequestSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (bars *BackchannelAuthRequestSelect) BoolX(ctx context.Context) bool {
+	v, err := bars.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bars *BackchannelAuthRequestSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bars.sql.Query()
+	if err := bars.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,484 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// BackchannelAuthRequestUpdate is the builder for updating BackchannelAuthRequest entities.
+type BackchannelAuthRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackchannelAuthRequestMutation
+}
+
+// Where appends a list predicates to the BackchannelAuthRequestUpdate builder.
+func (baru *BackchannelAuthRequestUpdate) Where(ps ...predicate.BackchannelAuthRequest) *BackchannelAuthRequestUpdate {
+	baru.mutation.Where(ps...)
+	return baru
+}
+
+// SetClientID sets the "client_id" field.
+func (baru *BackchannelAuthRequestUpdate) SetClientID(s string) *BackchannelAuthRequestUpdate {
+	baru.mutation.SetClientID(s)
+	return baru
+}
+
+// SetScopes sets the "scopes" field.
+func (baru *BackchannelAuthRequestUpdate) SetScopes(s []string) *BackchannelAuthRequestUpdate {
+	baru.mutation.SetScopes(s)
+	return baru
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (baru *BackchannelAuthRequestUpdate) ClearScopes() *BackchannelAuthRequestUpdate {
+	baru.mutation.ClearScopes()
+	return baru
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (baru *BackchannelAuthRequestUpdate) SetLoginHint(s string) *BackchannelAuthRequestUpdate {
+	baru.mutation.SetLoginHint(s)
+	return baru
+}
+
+// SetBindingMessage sets the "binding_message" field.
+func (baru *BackchannelAuthRequestUpdate) SetBindingMessage(s string) *BackchannelAuthRequestUpdate {
+	baru.mutation.SetBindingMessage(s)
+	return baru
+}
+
+// SetNillableBindingMessage sets the "binding_message" field if the given value is not nil.
+func (baru *BackchannelAuthRequestUpdate) SetNillableBindingMessage(s *string) *BackchannelAuthRequestUpdate {
+	if s != nil {
+		baru.SetBindingMessage(*s)
+	}
+	return baru
+}
+
+// SetClientNotificationToken sets the "client_notification_token" field.
+func (baru *BackchannelAuthRequestUpdate) SetClientNotificationToken(s string) *BackchannelAuthRequestUpdate {
+	baru.mutation.SetClientNotificationToken(s)
+	return baru
+}
+
+// SetNillableClientNotificationToken sets the "client_notification_token" field if the given value is not nil.
+func (baru *BackchannelAuthRequestUpdate) SetNillableClientNotificationToken(s *string) *BackchannelAuthRequestUpdate {
+	if s != nil {
+		baru.SetClientNotificationToken(*s)
+	}
+	return baru
+}
+
+// SetExpiry sets the "expiry" field.
+func (baru *BackchannelAuthRequestUpdate) SetExpiry(t time.Time) *BackchannelAuthRequestUpdate {
+	baru.mutation.SetExpiry(t)
+	return baru
+}
+
+// Mutation returns the BackchannelAuthRequestMutation object of the builder.
+func (baru *BackchannelAuthRequestUpdate) Mutation() *BackchannelAuthRequestMutation {
+	return baru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (baru *BackchannelAuthRequestUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(baru.hooks) == 0 {
+		if err = baru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = baru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BackchannelAuthRequestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = baru.check(); err != nil {
+				return 0, err
+			}
+			baru.mutation = mutation
+			affected, err = baru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(baru.hooks) - 1; i >= 0; i-- {
+			if baru.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = baru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, baru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (baru *BackchannelAuthRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := baru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (baru *BackchannelAuthRequestUpdate) Exec(ctx context.Context) error {
+	_, err := baru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (baru *BackchannelAuthRequestUpdate) ExecX(ctx context.Context) {
+	if err := baru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (baru *BackchannelAuthRequestUpdate) check() error {
+	if v, ok := baru.mutation.ClientID(); ok {
+		if err := backchannelauthrequest.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "BackchannelAuthRequest.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (baru *BackchannelAuthRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   backchannelauthrequest.Table,
+			Columns: backchannelauthrequest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: backchannelauthrequest.FieldID,
+			},
+		},
+	}
+	if ps := baru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := baru.mutation.ClientID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldClientID,
+		})
+	}
+	if value, ok := baru.mutation.Scopes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: backchannelauthrequest.FieldScopes,
+		})
+	}
+	if baru.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: backchannelauthrequest.FieldScopes,
+		})
+	}
+	if value, ok := baru.mutation.LoginHint(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldLoginHint,
+		})
+	}
+	if value, ok := baru.mutation.BindingMessage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldBindingMessage,
+		})
+	}
+	if value, ok := baru.mutation.ClientNotificationToken(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldClientNotificationToken,
+		})
+	}
+	if value, ok := baru.mutation.Expiry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: backchannelauthrequest.FieldExpiry,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, baru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backchannelauthrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BackchannelAuthRequestUpdateOne is the builder for updating a single BackchannelAuthRequest entity.
+type BackchannelAuthRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackchannelAuthRequestMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (baruo *BackchannelAuthRequestUpdateOne) SetClientID(s string) *BackchannelAuthRequestUpdateOne {
+	baruo.mutation.SetClientID(s)
+	return baruo
+}
+
+// SetScopes sets the "scopes" field.
+func (baruo *BackchannelAuthRequestUpdateOne) SetScopes(s []string) *BackchannelAuthRequestUpdateOne {
+	baruo.mutation.SetScopes(s)
+	return baruo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (baruo *BackchannelAuthRequestUpdateOne) ClearScopes() *BackchannelAuthRequestUpdateOne {
+	baruo.mutation.ClearScopes()
+	return baruo
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (baruo *BackchannelAuthRequestUpdateOne) SetLoginHint(s string) *BackchannelAuthRequestUpdateOne {
+	baruo.mutation.SetLoginHint(s)
+	return baruo
+}
+
+// SetBindingMessage sets the "binding_message" field.
+func (baruo *BackchannelAuthRequestUpdateOne) SetBindingMessage(s string) *BackchannelAuthRequestUpdateOne {
+	baruo.mutation.SetBindingMessage(s)
+	return baruo
+}
+
+// SetNillableBindingMessage sets the "binding_message" field if the given value is not nil.
+func (baruo *BackchannelAuthRequestUpdateOne) SetNillableBindingMessage(s *string) *BackchannelAuthRequestUpdateOne {
+	if s != nil {
+		baruo.SetBindingMessage(*s)
+	}
+	return baruo
+}
+
+// SetClientNotificationToken sets the "client_notification_token" field.
+func (baruo *BackchannelAuthRequestUpdateOne) SetClientNotificationToken(s string) *BackchannelAuthRequestUpdateOne {
+	baruo.mutation.SetClientNotificationToken(s)
+	return baruo
+}
+
+// SetNillableClientNotificationToken sets the "client_notification_token" field if the given value is not nil.
+func (baruo *BackchannelAuthRequestUpdateOne) SetNillableClientNotificationToken(s *string) *BackchannelAuthRequestUpdateOne {
+	if s != nil {
+		baruo.SetClientNotificationToken(*s)
+	}
+	return baruo
+}
+
+// SetExpiry sets the "expiry" field.
+func (baruo *BackchannelAuthRequestUpdateOne) SetExpiry(t time.Time) *BackchannelAuthRequestUpdateOne {
+	baruo.mutation.SetExpiry(t)
+	return baruo
+}
+
+// Mutation returns the BackchannelAuthRequestMutation object of the builder.
+func (baruo *BackchannelAuthRequestUpdateOne) Mutation() *BackchannelAuthRequestMutation {
+	return baruo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (baruo *BackchannelAuthRequestUpdateOne) Select(field string, fields ...string) *BackchannelAuthRequestUpdateOne {
+	baruo.fields = append([]string{field}, fields...)
+	return baruo
+}
+
+// Save executes the query and returns the updated BackchannelAuthRequest entity.
+func (baruo *BackchannelAuthRequestUpdateOne) Save(ctx context.Context) (*BackchannelAuthRequest, error) {
+	var (
+		err  error
+		node *BackchannelAuthRequest
+	)
+	if len(baruo.hooks) == 0 {
+		if err = baruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = baruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BackchannelAuthRequestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = baruo.check(); err != nil {
+				return nil, err
+			}
+			baruo.mutation = mutation
+			node, err = baruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(baruo.hooks) - 1; i >= 0; i-- {
+			if baruo.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = baruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, baruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (baruo *BackchannelAuthRequestUpdateOne) SaveX(ctx context.Context) *BackchannelAuthRequest {
+	node, err := baruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (baruo *BackchannelAuthRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := baruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (baruo *BackchannelAuthRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := baruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (baruo *BackchannelAuthRequestUpdateOne) check() error {
+	if v, ok := baruo.mutation.ClientID(); ok {
+		if err := backchannelauthrequest.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`db: validator failed for field "BackchannelAuthRequest.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (baruo *BackchannelAuthRequestUpdateOne) sqlSave(ctx context.Context) (_node *BackchannelAuthRequest, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   backchannelauthrequest.Table,
+			Columns: backchannelauthrequest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: backchannelauthrequest.FieldID,
+			},
+		},
+	}
+	id, ok := baruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "BackchannelAuthRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := baruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backchannelauthrequest.FieldID)
+		for _, f := range fields {
+			if !backchannelauthrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != backchannelauthrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := baruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := baruo.mutation.ClientID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldClientID,
+		})
+	}
+	if value, ok := baruo.mutation.Scopes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: backchannelauthrequest.FieldScopes,
+		})
+	}
+	if baruo.mutation.ScopesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: backchannelauthrequest.FieldScopes,
+		})
+	}
+	if value, ok := baruo.mutation.LoginHint(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldLoginHint,
+		})
+	}
+	if value, ok := baruo.mutation.BindingMessage(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldBindingMessage,
+		})
+	}
+	if value, ok := baruo.mutation.ClientNotificationToken(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: backchannelauthrequest.FieldClientNotificationToken,
+		})
+	}
+	if value, ok := baruo.mutation.Expiry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: backchannelauthrequest.FieldExpiry,
+		})
+	}
+	_node = &BackchannelAuthRequest{config: baruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, baruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backchannelauthrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -11,14 +11,23 @@ import (
 
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
 	"github.com/dexidp/dex/storage/ent/db/connector"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/emailverification"
 	"github.com/dexidp/dex/storage/ent/db/keys"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/offlinesession"
 	"github.com/dexidp/dex/storage/ent/db/password"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
 	"github.com/dexidp/dex/storage/ent/db/refreshtoken"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -33,22 +42,40 @@ type Client struct {
 	AuthCode *AuthCodeClient
 	// AuthRequest is the client for interacting with the AuthRequest builders.
 	AuthRequest *AuthRequestClient
+	// BackchannelAuthRequest is the client for interacting with the BackchannelAuthRequest builders.
+	BackchannelAuthRequest *BackchannelAuthRequestClient
 	// Connector is the client for interacting with the Connector builders.
 	Connector *ConnectorClient
 	// DeviceRequest is the client for interacting with the DeviceRequest builders.
 	DeviceRequest *DeviceRequestClient
 	// DeviceToken is the client for interacting with the DeviceToken builders.
 	DeviceToken *DeviceTokenClient
+	// EmailVerification is the client for interacting with the EmailVerification builders.
+	EmailVerification *EmailVerificationClient
 	// Keys is the client for interacting with the Keys builders.
 	Keys *KeysClient
+	// LoginAttempts is the client for interacting with the LoginAttempts builders.
+	LoginAttempts *LoginAttemptsClient
 	// OAuth2Client is the client for interacting with the OAuth2Client builders.
 	OAuth2Client *OAuth2ClientClient
 	// OfflineSession is the client for interacting with the OfflineSession builders.
 	OfflineSession *OfflineSessionClient
 	// Password is the client for interacting with the Password builders.
 	Password *PasswordClient
+	// PasswordReset is the client for interacting with the PasswordReset builders.
+	PasswordReset *PasswordResetClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// SAMLAssertion is the client for interacting with the SAMLAssertion builders.
+	SAMLAssertion *SAMLAssertionClient
+	// SAMLServiceProvider is the client for interacting with the SAMLServiceProvider builders.
+	SAMLServiceProvider *SAMLServiceProviderClient
+	// TOTPEnrollment is the client for interacting with the TOTPEnrollment builders.
+	TOTPEnrollment *TOTPEnrollmentClient
+	// UserConsent is the client for interacting with the UserConsent builders.
+	UserConsent *UserConsentClient
+	// WebAuthnCredential is the client for interacting with the WebAuthnCredential builders.
+	WebAuthnCredential *WebAuthnCredentialClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -64,14 +91,23 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AuthCode = NewAuthCodeClient(c.config)
 	c.AuthRequest = NewAuthRequestClient(c.config)
+	c.BackchannelAuthRequest = NewBackchannelAuthRequestClient(c.config)
 	c.Connector = NewConnectorClient(c.config)
 	c.DeviceRequest = NewDeviceRequestClient(c.config)
 	c.DeviceToken = NewDeviceTokenClient(c.config)
+	c.EmailVerification = NewEmailVerificationClient(c.config)
 	c.Keys = NewKeysClient(c.config)
+	c.LoginAttempts = NewLoginAttemptsClient(c.config)
 	c.OAuth2Client = NewOAuth2ClientClient(c.config)
 	c.OfflineSession = NewOfflineSessionClient(c.config)
 	c.Password = NewPasswordClient(c.config)
+	c.PasswordReset = NewPasswordResetClient(c.config)
 	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.SAMLAssertion = NewSAMLAssertionClient(c.config)
+	c.SAMLServiceProvider = NewSAMLServiceProviderClient(c.config)
+	c.TOTPEnrollment = NewTOTPEnrollmentClient(c.config)
+	c.UserConsent = NewUserConsentClient(c.config)
+	c.WebAuthnCredential = NewWebAuthnCredentialClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -103,18 +139,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		AuthCode:       NewAuthCodeClient(cfg),
-		AuthRequest:    NewAuthRequestClient(cfg),
-		Connector:      NewConnectorClient(cfg),
-		DeviceRequest:  NewDeviceRequestClient(cfg),
-		DeviceToken:    NewDeviceTokenClient(cfg),
-		Keys:           NewKeysClient(cfg),
-		OAuth2Client:   NewOAuth2ClientClient(cfg),
-		OfflineSession: NewOfflineSessionClient(cfg),
-		Password:       NewPasswordClient(cfg),
-		RefreshToken:   NewRefreshTokenClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AuthCode:               NewAuthCodeClient(cfg),
+		AuthRequest:            NewAuthRequestClient(cfg),
+		BackchannelAuthRequest: NewBackchannelAuthRequestClient(cfg),
+		Connector:              NewConnectorClient(cfg),
+		DeviceRequest:          NewDeviceRequestClient(cfg),
+		DeviceToken:            NewDeviceTokenClient(cfg),
+		EmailVerification:      NewEmailVerificationClient(cfg),
+		Keys:                   NewKeysClient(cfg),
+		LoginAttempts:          NewLoginAttemptsClient(cfg),
+		OAuth2Client:           NewOAuth2ClientClient(cfg),
+		OfflineSession:         NewOfflineSessionClient(cfg),
+		Password:               NewPasswordClient(cfg),
+		PasswordReset:          NewPasswordResetClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		SAMLAssertion:          NewSAMLAssertionClient(cfg),
+		SAMLServiceProvider:    NewSAMLServiceProviderClient(cfg),
+		TOTPEnrollment:         NewTOTPEnrollmentClient(cfg),
+		UserConsent:            NewUserConsentClient(cfg),
+		WebAuthnCredential:     NewWebAuthnCredentialClient(cfg),
 	}, nil
 }
 
@@ -132,18 +177,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		AuthCode:       NewAuthCodeClient(cfg),
-		AuthRequest:    NewAuthRequestClient(cfg),
-		Connector:      NewConnectorClient(cfg),
-		DeviceRequest:  NewDeviceRequestClient(cfg),
-		DeviceToken:    NewDeviceTokenClient(cfg),
-		Keys:           NewKeysClient(cfg),
-		OAuth2Client:   NewOAuth2ClientClient(cfg),
-		OfflineSession: NewOfflineSessionClient(cfg),
-		Password:       NewPasswordClient(cfg),
-		RefreshToken:   NewRefreshTokenClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AuthCode:               NewAuthCodeClient(cfg),
+		AuthRequest:            NewAuthRequestClient(cfg),
+		BackchannelAuthRequest: NewBackchannelAuthRequestClient(cfg),
+		Connector:              NewConnectorClient(cfg),
+		DeviceRequest:          NewDeviceRequestClient(cfg),
+		DeviceToken:            NewDeviceTokenClient(cfg),
+		EmailVerification:      NewEmailVerificationClient(cfg),
+		Keys:                   NewKeysClient(cfg),
+		LoginAttempts:          NewLoginAttemptsClient(cfg),
+		OAuth2Client:           NewOAuth2ClientClient(cfg),
+		OfflineSession:         NewOfflineSessionClient(cfg),
+		Password:               NewPasswordClient(cfg),
+		PasswordReset:          NewPasswordResetClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		SAMLAssertion:          NewSAMLAssertionClient(cfg),
+		SAMLServiceProvider:    NewSAMLServiceProviderClient(cfg),
+		TOTPEnrollment:         NewTOTPEnrollmentClient(cfg),
+		UserConsent:            NewUserConsentClient(cfg),
+		WebAuthnCredential:     NewWebAuthnCredentialClient(cfg),
 	}, nil
 }
 
@@ -175,14 +229,23 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	c.AuthCode.Use(hooks...)
 	c.AuthRequest.Use(hooks...)
+	c.BackchannelAuthRequest.Use(hooks...)
 	c.Connector.Use(hooks...)
 	c.DeviceRequest.Use(hooks...)
 	c.DeviceToken.Use(hooks...)
+	c.EmailVerification.Use(hooks...)
 	c.Keys.Use(hooks...)
+	c.LoginAttempts.Use(hooks...)
 	c.OAuth2Client.Use(hooks...)
 	c.OfflineSession.Use(hooks...)
 	c.Password.Use(hooks...)
+	c.PasswordReset.Use(hooks...)
 	c.RefreshToken.Use(hooks...)
+	c.SAMLAssertion.Use(hooks...)
+	c.SAMLServiceProvider.Use(hooks...)
+	c.TOTPEnrollment.Use(hooks...)
+	c.UserConsent.Use(hooks...)
+	c.WebAuthnCredential.Use(hooks...)
 }
 
 // AuthCodeClient is a client for the AuthCode schema.
@@ -365,6 +428,96 @@ func (c *AuthRequestClient) Hooks() []Hook {
 	return c.hooks.AuthRequest
 }
 
+// BackchannelAuthRequestClient is a client for the BackchannelAuthRequest schema.
+type BackchannelAuthRequestClient struct {
+	config
+}
+
+// NewBackchannelAuthRequestClient returns a client for the BackchannelAuthRequest from the given config.
+func NewBackchannelAuthRequestClient(c config) *BackchannelAuthRequestClient {
+	return &BackchannelAuthRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backchannelauthrequest.Hooks(f(g(h())))`.
+func (c *BackchannelAuthRequestClient) Use(hooks ...Hook) {
+	c.hooks.BackchannelAuthRequest = append(c.hooks.BackchannelAuthRequest, hooks...)
+}
+
+// Create returns a create builder for BackchannelAuthRequest.
+func (c *BackchannelAuthRequestClient) Create() *BackchannelAuthRequestCreate {
+	mutation := newBackchannelAuthRequestMutation(c.config, OpCreate)
+	return &BackchannelAuthRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackchannelAuthRequest entities.
+func (c *BackchannelAuthRequestClient) CreateBulk(builders ...*BackchannelAuthRequestCreate) *BackchannelAuthRequestCreateBulk {
+	return &BackchannelAuthRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackchannelAuthRequest.
+func (c *BackchannelAuthRequestClient) Update() *BackchannelAuthRequestUpdate {
+	mutation := newBackchannelAuthRequestMutation(c.config, OpUpdate)
+	return &BackchannelAuthRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackchannelAuthRequestClient) UpdateOne(bar *BackchannelAuthRequest) *BackchannelAuthRequestUpdateOne {
+	mutation := newBackchannelAuthRequestMutation(c.config, OpUpdateOne, withBackchannelAuthRequest(bar))
+	return &BackchannelAuthRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackchannelAuthRequestClient) UpdateOneID(id string) *BackchannelAuthRequestUpdateOne {
+	mutation := newBackchannelAuthRequestMutation(c.config, OpUpdateOne, withBackchannelAuthRequestID(id))
+	return &BackchannelAuthRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackchannelAuthRequest.
+func (c *BackchannelAuthRequestClient) Delete() *BackchannelAuthRequestDelete {
+	mutation := newBackchannelAuthRequestMutation(c.config, OpDelete)
+	return &BackchannelAuthRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *BackchannelAuthRequestClient) DeleteOne(bar *BackchannelAuthRequest) *BackchannelAuthRequestDeleteOne {
+	return c.DeleteOneID(bar.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *BackchannelAuthRequestClient) DeleteOneID(id string) *BackchannelAuthRequestDeleteOne {
+	builder := c.Delete().Where(backchannelauthrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackchannelAuthRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for BackchannelAuthRequest.
+func (c *BackchannelAuthRequestClient) Query() *BackchannelAuthRequestQuery {
+	return &BackchannelAuthRequestQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a BackchannelAuthRequest entity by its id.
+func (c *BackchannelAuthRequestClient) Get(ctx context.Context, id string) (*BackchannelAuthRequest, error) {
+	return c.Query().Where(backchannelauthrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackchannelAuthRequestClient) GetX(ctx context.Context, id string) *BackchannelAuthRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BackchannelAuthRequestClient) Hooks() []Hook {
+	return c.hooks.BackchannelAuthRequest
+}
+
 // ConnectorClient is a client for the Connector schema.
 type ConnectorClient struct {
 	config
@@ -635,6 +788,96 @@ func (c *DeviceTokenClient) Hooks() []Hook {
 	return c.hooks.DeviceToken
 }
 
+// EmailVerificationClient is a client for the EmailVerification schema.
+type EmailVerificationClient struct {
+	config
+}
+
+// NewEmailVerificationClient returns a client for the EmailVerification from the given config.
+func NewEmailVerificationClient(c config) *EmailVerificationClient {
+	return &EmailVerificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailverification.Hooks(f(g(h())))`.
+func (c *EmailVerificationClient) Use(hooks ...Hook) {
+	c.hooks.EmailVerification = append(c.hooks.EmailVerification, hooks...)
+}
+
+// Create returns a create builder for EmailVerification.
+func (c *EmailVerificationClient) Create() *EmailVerificationCreate {
+	mutation := newEmailVerificationMutation(c.config, OpCreate)
+	return &EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailVerification entities.
+func (c *EmailVerificationClient) CreateBulk(builders ...*EmailVerificationCreate) *EmailVerificationCreateBulk {
+	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailVerification.
+func (c *EmailVerificationClient) Update() *EmailVerificationUpdate {
+	mutation := newEmailVerificationMutation(c.config, OpUpdate)
+	return &EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailVerificationClient) UpdateOne(ev *EmailVerification) *EmailVerificationUpdateOne {
+	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerification(ev))
+	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailVerificationClient) UpdateOneID(id string) *EmailVerificationUpdateOne {
+	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerificationID(id))
+	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailVerification.
+func (c *EmailVerificationClient) Delete() *EmailVerificationDelete {
+	mutation := newEmailVerificationMutation(c.config, OpDelete)
+	return &EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *EmailVerificationClient) DeleteOne(ev *EmailVerification) *EmailVerificationDeleteOne {
+	return c.DeleteOneID(ev.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *EmailVerificationClient) DeleteOneID(id string) *EmailVerificationDeleteOne {
+	builder := c.Delete().Where(emailverification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailVerificationDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailVerification.
+func (c *EmailVerificationClient) Query() *EmailVerificationQuery {
+	return &EmailVerificationQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a EmailVerification entity by its id.
+func (c *EmailVerificationClient) Get(ctx context.Context, id string) (*EmailVerification, error) {
+	return c.Query().Where(emailverification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailVerificationClient) GetX(ctx context.Context, id string) *EmailVerification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailVerificationClient) Hooks() []Hook {
+	return c.hooks.EmailVerification
+}
+
 // KeysClient is a client for the Keys schema.
 type KeysClient struct {
 	config
@@ -725,6 +968,96 @@ func (c *KeysClient) Hooks() []Hook {
 	return c.hooks.Keys
 }
 
+// LoginAttemptsClient is a client for the LoginAttempts schema.
+type LoginAttemptsClient struct {
+	config
+}
+
+// NewLoginAttemptsClient returns a client for the LoginAttempts from the given config.
+func NewLoginAttemptsClient(c config) *LoginAttemptsClient {
+	return &LoginAttemptsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginattempts.Hooks(f(g(h())))`.
+func (c *LoginAttemptsClient) Use(hooks ...Hook) {
+	c.hooks.LoginAttempts = append(c.hooks.LoginAttempts, hooks...)
+}
+
+// Create returns a create builder for LoginAttempts.
+func (c *LoginAttemptsClient) Create() *LoginAttemptsCreate {
+	mutation := newLoginAttemptsMutation(c.config, OpCreate)
+	return &LoginAttemptsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginAttempts entities.
+func (c *LoginAttemptsClient) CreateBulk(builders ...*LoginAttemptsCreate) *LoginAttemptsCreateBulk {
+	return &LoginAttemptsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginAttempts.
+func (c *LoginAttemptsClient) Update() *LoginAttemptsUpdate {
+	mutation := newLoginAttemptsMutation(c.config, OpUpdate)
+	return &LoginAttemptsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginAttemptsClient) UpdateOne(la *LoginAttempts) *LoginAttemptsUpdateOne {
+	mutation := newLoginAttemptsMutation(c.config, OpUpdateOne, withLoginAttempts(la))
+	return &LoginAttemptsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginAttemptsClient) UpdateOneID(id string) *LoginAttemptsUpdateOne {
+	mutation := newLoginAttemptsMutation(c.config, OpUpdateOne, withLoginAttemptsID(id))
+	return &LoginAttemptsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginAttempts.
+func (c *LoginAttemptsClient) Delete() *LoginAttemptsDelete {
+	mutation := newLoginAttemptsMutation(c.config, OpDelete)
+	return &LoginAttemptsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *LoginAttemptsClient) DeleteOne(la *LoginAttempts) *LoginAttemptsDeleteOne {
+	return c.DeleteOneID(la.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *LoginAttemptsClient) DeleteOneID(id string) *LoginAttemptsDeleteOne {
+	builder := c.Delete().Where(loginattempts.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginAttemptsDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginAttempts.
+func (c *LoginAttemptsClient) Query() *LoginAttemptsQuery {
+	return &LoginAttemptsQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a LoginAttempts entity by its id.
+func (c *LoginAttemptsClient) Get(ctx context.Context, id string) (*LoginAttempts, error) {
+	return c.Query().Where(loginattempts.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginAttemptsClient) GetX(ctx context.Context, id string) *LoginAttempts {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LoginAttemptsClient) Hooks() []Hook {
+	return c.hooks.LoginAttempts
+}
+
 // OAuth2ClientClient is a client for the OAuth2Client schema.
 type OAuth2ClientClient struct {
 	config
@@ -995,6 +1328,96 @@ func (c *PasswordClient) Hooks() []Hook {
 	return c.hooks.Password
 }
 
+// PasswordResetClient is a client for the PasswordReset schema.
+type PasswordResetClient struct {
+	config
+}
+
+// NewPasswordResetClient returns a client for the PasswordReset from the given config.
+func NewPasswordResetClient(c config) *PasswordResetClient {
+	return &PasswordResetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordreset.Hooks(f(g(h())))`.
+func (c *PasswordResetClient) Use(hooks ...Hook) {
+	c.hooks.PasswordReset = append(c.hooks.PasswordReset, hooks...)
+}
+
+// Create returns a create builder for PasswordReset.
+func (c *PasswordResetClient) Create() *PasswordResetCreate {
+	mutation := newPasswordResetMutation(c.config, OpCreate)
+	return &PasswordResetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordReset entities.
+func (c *PasswordResetClient) CreateBulk(builders ...*PasswordResetCreate) *PasswordResetCreateBulk {
+	return &PasswordResetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordReset.
+func (c *PasswordResetClient) Update() *PasswordResetUpdate {
+	mutation := newPasswordResetMutation(c.config, OpUpdate)
+	return &PasswordResetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordResetClient) UpdateOne(pr *PasswordReset) *PasswordResetUpdateOne {
+	mutation := newPasswordResetMutation(c.config, OpUpdateOne, withPasswordReset(pr))
+	return &PasswordResetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordResetClient) UpdateOneID(id string) *PasswordResetUpdateOne {
+	mutation := newPasswordResetMutation(c.config, OpUpdateOne, withPasswordResetID(id))
+	return &PasswordResetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordReset.
+func (c *PasswordResetClient) Delete() *PasswordResetDelete {
+	mutation := newPasswordResetMutation(c.config, OpDelete)
+	return &PasswordResetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PasswordResetClient) DeleteOne(pr *PasswordReset) *PasswordResetDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PasswordResetClient) DeleteOneID(id string) *PasswordResetDeleteOne {
+	builder := c.Delete().Where(passwordreset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordResetDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordReset.
+func (c *PasswordResetClient) Query() *PasswordResetQuery {
+	return &PasswordResetQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PasswordReset entity by its id.
+func (c *PasswordResetClient) Get(ctx context.Context, id string) (*PasswordReset, error) {
+	return c.Query().Where(passwordreset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordResetClient) GetX(ctx context.Context, id string) *PasswordReset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordResetClient) Hooks() []Hook {
+	return c.hooks.PasswordReset
+}
+
 // RefreshTokenClient is a client for the RefreshToken schema.
 type RefreshTokenClient struct {
 	config
@@ -1084,3 +1507,453 @@ func (c *RefreshTokenClient) GetX(ctx context.Context, id string) *RefreshToken
 func (c *RefreshTokenClient) Hooks() []Hook {
 	return c.hooks.RefreshToken
 }
+
+// SAMLAssertionClient is a client for the SAMLAssertion schema.
+type SAMLAssertionClient struct {
+	config
+}
+
+// NewSAMLAssertionClient returns a client for the SAMLAssertion from the given config.
+func NewSAMLAssertionClient(c config) *SAMLAssertionClient {
+	return &SAMLAssertionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `samlassertion.Hooks(f(g(h())))`.
+func (c *SAMLAssertionClient) Use(hooks ...Hook) {
+	c.hooks.SAMLAssertion = append(c.hooks.SAMLAssertion, hooks...)
+}
+
+// Create returns a create builder for SAMLAssertion.
+func (c *SAMLAssertionClient) Create() *SAMLAssertionCreate {
+	mutation := newSAMLAssertionMutation(c.config, OpCreate)
+	return &SAMLAssertionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SAMLAssertion entities.
+func (c *SAMLAssertionClient) CreateBulk(builders ...*SAMLAssertionCreate) *SAMLAssertionCreateBulk {
+	return &SAMLAssertionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SAMLAssertion.
+func (c *SAMLAssertionClient) Update() *SAMLAssertionUpdate {
+	mutation := newSAMLAssertionMutation(c.config, OpUpdate)
+	return &SAMLAssertionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SAMLAssertionClient) UpdateOne(sa *SAMLAssertion) *SAMLAssertionUpdateOne {
+	mutation := newSAMLAssertionMutation(c.config, OpUpdateOne, withSAMLAssertion(sa))
+	return &SAMLAssertionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SAMLAssertionClient) UpdateOneID(id string) *SAMLAssertionUpdateOne {
+	mutation := newSAMLAssertionMutation(c.config, OpUpdateOne, withSAMLAssertionID(id))
+	return &SAMLAssertionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SAMLAssertion.
+func (c *SAMLAssertionClient) Delete() *SAMLAssertionDelete {
+	mutation := newSAMLAssertionMutation(c.config, OpDelete)
+	return &SAMLAssertionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *SAMLAssertionClient) DeleteOne(sa *SAMLAssertion) *SAMLAssertionDeleteOne {
+	return c.DeleteOneID(sa.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *SAMLAssertionClient) DeleteOneID(id string) *SAMLAssertionDeleteOne {
+	builder := c.Delete().Where(samlassertion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SAMLAssertionDeleteOne{builder}
+}
+
+// Query returns a query builder for SAMLAssertion.
+func (c *SAMLAssertionClient) Query() *SAMLAssertionQuery {
+	return &SAMLAssertionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SAMLAssertion entity by its id.
+func (c *SAMLAssertionClient) Get(ctx context.Context, id string) (*SAMLAssertion, error) {
+	return c.Query().Where(samlassertion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SAMLAssertionClient) GetX(ctx context.Context, id string) *SAMLAssertion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SAMLAssertionClient) Hooks() []Hook {
+	return c.hooks.SAMLAssertion
+}
+
+// SAMLServiceProviderClient is a client for the SAMLServiceProvider schema.
+type SAMLServiceProviderClient struct {
+	config
+}
+
+// NewSAMLServiceProviderClient returns a client for the SAMLServiceProvider from the given config.
+func NewSAMLServiceProviderClient(c config) *SAMLServiceProviderClient {
+	return &SAMLServiceProviderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `samlserviceprovider.Hooks(f(g(h())))`.
+func (c *SAMLServiceProviderClient) Use(hooks ...Hook) {
+	c.hooks.SAMLServiceProvider = append(c.hooks.SAMLServiceProvider, hooks...)
+}
+
+// Create returns a create builder for SAMLServiceProvider.
+func (c *SAMLServiceProviderClient) Create() *SAMLServiceProviderCreate {
+	mutation := newSAMLServiceProviderMutation(c.config, OpCreate)
+	return &SAMLServiceProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SAMLServiceProvider entities.
+func (c *SAMLServiceProviderClient) CreateBulk(builders ...*SAMLServiceProviderCreate) *SAMLServiceProviderCreateBulk {
+	return &SAMLServiceProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SAMLServiceProvider.
+func (c *SAMLServiceProviderClient) Update() *SAMLServiceProviderUpdate {
+	mutation := newSAMLServiceProviderMutation(c.config, OpUpdate)
+	return &SAMLServiceProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SAMLServiceProviderClient) UpdateOne(ssp *SAMLServiceProvider) *SAMLServiceProviderUpdateOne {
+	mutation := newSAMLServiceProviderMutation(c.config, OpUpdateOne, withSAMLServiceProvider(ssp))
+	return &SAMLServiceProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SAMLServiceProviderClient) UpdateOneID(id string) *SAMLServiceProviderUpdateOne {
+	mutation := newSAMLServiceProviderMutation(c.config, OpUpdateOne, withSAMLServiceProviderID(id))
+	return &SAMLServiceProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SAMLServiceProvider.
+func (c *SAMLServiceProviderClient) Delete() *SAMLServiceProviderDelete {
+	mutation := newSAMLServiceProviderMutation(c.config, OpDelete)
+	return &SAMLServiceProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *SAMLServiceProviderClient) DeleteOne(ssp *SAMLServiceProvider) *SAMLServiceProviderDeleteOne {
+	return c.DeleteOneID(ssp.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *SAMLServiceProviderClient) DeleteOneID(id string) *SAMLServiceProviderDeleteOne {
+	builder := c.Delete().Where(samlserviceprovider.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SAMLServiceProviderDeleteOne{builder}
+}
+
+// Query returns a query builder for SAMLServiceProvider.
+func (c *SAMLServiceProviderClient) Query() *SAMLServiceProviderQuery {
+	return &SAMLServiceProviderQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SAMLServiceProvider entity by its id.
+func (c *SAMLServiceProviderClient) Get(ctx context.Context, id string) (*SAMLServiceProvider, error) {
+	return c.Query().Where(samlserviceprovider.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SAMLServiceProviderClient) GetX(ctx context.Context, id string) *SAMLServiceProvider {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SAMLServiceProviderClient) Hooks() []Hook {
+	return c.hooks.SAMLServiceProvider
+}
+
+// TOTPEnrollmentClient is a client for the TOTPEnrollment schema.
+type TOTPEnrollmentClient struct {
+	config
+}
+
+// NewTOTPEnrollmentClient returns a client for the TOTPEnrollment from the given config.
+func NewTOTPEnrollmentClient(c config) *TOTPEnrollmentClient {
+	return &TOTPEnrollmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `totpenrollment.Hooks(f(g(h())))`.
+func (c *TOTPEnrollmentClient) Use(hooks ...Hook) {
+	c.hooks.TOTPEnrollment = append(c.hooks.TOTPEnrollment, hooks...)
+}
+
+// Create returns a create builder for TOTPEnrollment.
+func (c *TOTPEnrollmentClient) Create() *TOTPEnrollmentCreate {
+	mutation := newTOTPEnrollmentMutation(c.config, OpCreate)
+	return &TOTPEnrollmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TOTPEnrollment entities.
+func (c *TOTPEnrollmentClient) CreateBulk(builders ...*TOTPEnrollmentCreate) *TOTPEnrollmentCreateBulk {
+	return &TOTPEnrollmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TOTPEnrollment.
+func (c *TOTPEnrollmentClient) Update() *TOTPEnrollmentUpdate {
+	mutation := newTOTPEnrollmentMutation(c.config, OpUpdate)
+	return &TOTPEnrollmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TOTPEnrollmentClient) UpdateOne(te *TOTPEnrollment) *TOTPEnrollmentUpdateOne {
+	mutation := newTOTPEnrollmentMutation(c.config, OpUpdateOne, withTOTPEnrollment(te))
+	return &TOTPEnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TOTPEnrollmentClient) UpdateOneID(id string) *TOTPEnrollmentUpdateOne {
+	mutation := newTOTPEnrollmentMutation(c.config, OpUpdateOne, withTOTPEnrollmentID(id))
+	return &TOTPEnrollmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TOTPEnrollment.
+func (c *TOTPEnrollmentClient) Delete() *TOTPEnrollmentDelete {
+	mutation := newTOTPEnrollmentMutation(c.config, OpDelete)
+	return &TOTPEnrollmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *TOTPEnrollmentClient) DeleteOne(te *TOTPEnrollment) *TOTPEnrollmentDeleteOne {
+	return c.DeleteOneID(te.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *TOTPEnrollmentClient) DeleteOneID(id string) *TOTPEnrollmentDeleteOne {
+	builder := c.Delete().Where(totpenrollment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TOTPEnrollmentDeleteOne{builder}
+}
+
+// Query returns a query builder for TOTPEnrollment.
+func (c *TOTPEnrollmentClient) Query() *TOTPEnrollmentQuery {
+	return &TOTPEnrollmentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a TOTPEnrollment entity by its id.
+func (c *TOTPEnrollmentClient) Get(ctx context.Context, id string) (*TOTPEnrollment, error) {
+	return c.Query().Where(totpenrollment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TOTPEnrollmentClient) GetX(ctx context.Context, id string) *TOTPEnrollment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TOTPEnrollmentClient) Hooks() []Hook {
+	return c.hooks.TOTPEnrollment
+}
+
+// UserConsentClient is a client for the UserConsent schema.
+type UserConsentClient struct {
+	config
+}
+
+// NewUserConsentClient returns a client for the UserConsent from the given config.
+func NewUserConsentClient(c config) *UserConsentClient {
+	return &UserConsentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userconsent.Hooks(f(g(h())))`.
+func (c *UserConsentClient) Use(hooks ...Hook) {
+	c.hooks.UserConsent = append(c.hooks.UserConsent, hooks...)
+}
+
+// Create returns a create builder for UserConsent.
+func (c *UserConsentClient) Create() *UserConsentCreate {
+	mutation := newUserConsentMutation(c.config, OpCreate)
+	return &UserConsentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserConsent entities.
+func (c *UserConsentClient) CreateBulk(builders ...*UserConsentCreate) *UserConsentCreateBulk {
+	return &UserConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserConsent.
+func (c *UserConsentClient) Update() *UserConsentUpdate {
+	mutation := newUserConsentMutation(c.config, OpUpdate)
+	return &UserConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserConsentClient) UpdateOne(uc *UserConsent) *UserConsentUpdateOne {
+	mutation := newUserConsentMutation(c.config, OpUpdateOne, withUserConsent(uc))
+	return &UserConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserConsentClient) UpdateOneID(id string) *UserConsentUpdateOne {
+	mutation := newUserConsentMutation(c.config, OpUpdateOne, withUserConsentID(id))
+	return &UserConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserConsent.
+func (c *UserConsentClient) Delete() *UserConsentDelete {
+	mutation := newUserConsentMutation(c.config, OpDelete)
+	return &UserConsentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *UserConsentClient) DeleteOne(uc *UserConsent) *UserConsentDeleteOne {
+	return c.DeleteOneID(uc.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *UserConsentClient) DeleteOneID(id string) *UserConsentDeleteOne {
+	builder := c.Delete().Where(userconsent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserConsentDeleteOne{builder}
+}
+
+// Query returns a query builder for UserConsent.
+func (c *UserConsentClient) Query() *UserConsentQuery {
+	return &UserConsentQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a UserConsent entity by its id.
+func (c *UserConsentClient) Get(ctx context.Context, id string) (*UserConsent, error) {
+	return c.Query().Where(userconsent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserConsentClient) GetX(ctx context.Context, id string) *UserConsent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserConsentClient) Hooks() []Hook {
+	return c.hooks.UserConsent
+}
+
+// WebAuthnCredentialClient is a client for the WebAuthnCredential schema.
+type WebAuthnCredentialClient struct {
+	config
+}
+
+// NewWebAuthnCredentialClient returns a client for the WebAuthnCredential from the given config.
+func NewWebAuthnCredentialClient(c config) *WebAuthnCredentialClient {
+	return &WebAuthnCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webauthncredential.Hooks(f(g(h())))`.
+func (c *WebAuthnCredentialClient) Use(hooks ...Hook) {
+	c.hooks.WebAuthnCredential = append(c.hooks.WebAuthnCredential, hooks...)
+}
+
+// Create returns a create builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Create() *WebAuthnCredentialCreate {
+	mutation := newWebAuthnCredentialMutation(c.config, OpCreate)
+	return &WebAuthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebAuthnCredential entities.
+func (c *WebAuthnCredentialClient) CreateBulk(builders ...*WebAuthnCredentialCreate) *WebAuthnCredentialCreateBulk {
+	return &WebAuthnCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Update() *WebAuthnCredentialUpdate {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdate)
+	return &WebAuthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebAuthnCredentialClient) UpdateOne(wac *WebAuthnCredential) *WebAuthnCredentialUpdateOne {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdateOne, withWebAuthnCredential(wac))
+	return &WebAuthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebAuthnCredentialClient) UpdateOneID(id string) *WebAuthnCredentialUpdateOne {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdateOne, withWebAuthnCredentialID(id))
+	return &WebAuthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Delete() *WebAuthnCredentialDelete {
+	mutation := newWebAuthnCredentialMutation(c.config, OpDelete)
+	return &WebAuthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebAuthnCredentialClient) DeleteOne(wac *WebAuthnCredential) *WebAuthnCredentialDeleteOne {
+	return c.DeleteOneID(wac.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebAuthnCredentialClient) DeleteOneID(id string) *WebAuthnCredentialDeleteOne {
+	builder := c.Delete().Where(webauthncredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebAuthnCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Query() *WebAuthnCredentialQuery {
+	return &WebAuthnCredentialQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WebAuthnCredential entity by its id.
+func (c *WebAuthnCredentialClient) Get(ctx context.Context, id string) (*WebAuthnCredential, error) {
+	return c.Query().Where(webauthncredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebAuthnCredentialClient) GetX(ctx context.Context, id string) *WebAuthnCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebAuthnCredentialClient) Hooks() []Hook {
+	return c.hooks.WebAuthnCredential
+}
@@ -24,16 +24,25 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	AuthCode       []ent.Hook
-	AuthRequest    []ent.Hook
-	Connector      []ent.Hook
-	DeviceRequest  []ent.Hook
-	DeviceToken    []ent.Hook
-	Keys           []ent.Hook
-	OAuth2Client   []ent.Hook
-	OfflineSession []ent.Hook
-	Password       []ent.Hook
-	RefreshToken   []ent.Hook
+	AuthCode               []ent.Hook
+	AuthRequest            []ent.Hook
+	BackchannelAuthRequest []ent.Hook
+	Connector              []ent.Hook
+	DeviceRequest          []ent.Hook
+	DeviceToken            []ent.Hook
+	EmailVerification      []ent.Hook
+	Keys                   []ent.Hook
+	LoginAttempts          []ent.Hook
+	OAuth2Client           []ent.Hook
+	OfflineSession         []ent.Hook
+	Password               []ent.Hook
+	PasswordReset          []ent.Hook
+	RefreshToken           []ent.Hook
+	SAMLAssertion          []ent.Hook
+	SAMLServiceProvider    []ent.Hook
+	TOTPEnrollment         []ent.Hook
+	UserConsent            []ent.Hook
+	WebAuthnCredential     []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -0,0 +1,110 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/emailverification"
+)
+
+// EmailVerification is the model entity for the EmailVerification schema.
+type EmailVerification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailVerification) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailverification.FieldID, emailverification.FieldEmail:
+			values[i] = new(sql.NullString)
+		case emailverification.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type EmailVerification", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailVerification fields.
+func (ev *EmailVerification) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailverification.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ev.ID = value.String
+			}
+		case emailverification.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ev.Email = value.String
+			}
+		case emailverification.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				ev.Expiry = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this EmailVerification.
+// Note that you need to call EmailVerification.Unwrap() before calling this method if this EmailVerification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ev *EmailVerification) Update() *EmailVerificationUpdateOne {
+	return (&EmailVerificationClient{config: ev.config}).UpdateOne(ev)
+}
+
+// Unwrap unwraps the EmailVerification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ev *EmailVerification) Unwrap() *EmailVerification {
+	tx, ok := ev.config.driver.(*txDriver)
+	if !ok {
+		panic("db: EmailVerification is not a transactional entity")
+	}
+	ev.config.driver = tx.drv
+	return ev
+}
+
+// String implements the fmt.Stringer.
+func (ev *EmailVerification) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailVerification(")
+	builder.WriteString(fmt.Sprintf("id=%v", ev.ID))
+	builder.WriteString(", email=")
+	builder.WriteString(ev.Email)
+	builder.WriteString(", expiry=")
+	builder.WriteString(ev.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailVerifications is a parsable slice of EmailVerification.
+type EmailVerifications []*EmailVerification
+
+func (ev EmailVerifications) config(cfg config) {
+	for _i := range ev {
+		ev[_i].config = cfg
+	}
+}
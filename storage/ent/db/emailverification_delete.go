@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/emailverification"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// EmailVerificationDelete is the builder for deleting a EmailVerification entity.
+type EmailVerificationDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmailVerificationMutation
+}
+
+// Where appends a list predicates to the EmailVerificationDelete builder.
+func (evd *EmailVerificationDelete) Where(ps ...predicate.EmailVerification) *EmailVerificationDelete {
+	evd.mutation.Where(ps...)
+	return evd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (evd *EmailVerificationDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(evd.hooks) == 0 {
+		affected, err = evd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EmailVerificationMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			evd.mutation = mutation
+			affected, err = evd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(evd.hooks) - 1; i >= 0; i-- {
+			if evd.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = evd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, evd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evd *EmailVerificationDelete) ExecX(ctx context.Context) int {
+	n, err := evd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (evd *EmailVerificationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: emailverification.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: emailverification.FieldID,
+			},
+		},
+	}
+	if ps := evd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, evd.driver, _spec)
+}
+
+// EmailVerificationDeleteOne is the builder for deleting a single EmailVerification entity.
+type EmailVerificationDeleteOne struct {
+	evd *EmailVerificationDelete
+}
+
+// Exec executes the deletion query.
+func (evdo *EmailVerificationDeleteOne) Exec(ctx context.Context) error {
+	n, err := evdo.evd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emailverification.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evdo *EmailVerificationDeleteOne) ExecX(ctx context.Context) {
+	evdo.evd.ExecX(ctx)
+}
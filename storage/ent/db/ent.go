@@ -10,14 +10,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
 	"github.com/dexidp/dex/storage/ent/db/connector"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/emailverification"
 	"github.com/dexidp/dex/storage/ent/db/keys"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/offlinesession"
 	"github.com/dexidp/dex/storage/ent/db/password"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
 	"github.com/dexidp/dex/storage/ent/db/refreshtoken"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -38,16 +47,25 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		authcode.Table:       authcode.ValidColumn,
-		authrequest.Table:    authrequest.ValidColumn,
-		connector.Table:      connector.ValidColumn,
-		devicerequest.Table:  devicerequest.ValidColumn,
-		devicetoken.Table:    devicetoken.ValidColumn,
-		keys.Table:           keys.ValidColumn,
-		oauth2client.Table:   oauth2client.ValidColumn,
-		offlinesession.Table: offlinesession.ValidColumn,
-		password.Table:       password.ValidColumn,
-		refreshtoken.Table:   refreshtoken.ValidColumn,
+		authcode.Table:               authcode.ValidColumn,
+		authrequest.Table:            authrequest.ValidColumn,
+		backchannelauthrequest.Table: backchannelauthrequest.ValidColumn,
+		connector.Table:              connector.ValidColumn,
+		devicerequest.Table:          devicerequest.ValidColumn,
+		devicetoken.Table:            devicetoken.ValidColumn,
+		emailverification.Table:      emailverification.ValidColumn,
+		keys.Table:                   keys.ValidColumn,
+		loginattempts.Table:          loginattempts.ValidColumn,
+		oauth2client.Table:           oauth2client.ValidColumn,
+		offlinesession.Table:         offlinesession.ValidColumn,
+		password.Table:               password.ValidColumn,
+		passwordreset.Table:          passwordreset.ValidColumn,
+		refreshtoken.Table:           refreshtoken.ValidColumn,
+		samlassertion.Table:          samlassertion.ValidColumn,
+		samlserviceprovider.Table:    samlserviceprovider.ValidColumn,
+		totpenrollment.Table:         totpenrollment.ValidColumn,
+		userconsent.Table:            userconsent.ValidColumn,
+		webauthncredential.Table:     webauthncredential.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -35,6 +35,19 @@ func (f AuthRequestFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, e
 	return f(ctx, mv)
 }
 
+// The BackchannelAuthRequestFunc type is an adapter to allow the use of ordinary
+// function as BackchannelAuthRequest mutator.
+type BackchannelAuthRequestFunc func(context.Context, *db.BackchannelAuthRequestMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BackchannelAuthRequestFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.BackchannelAuthRequestMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.BackchannelAuthRequestMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The ConnectorFunc type is an adapter to allow the use of ordinary
 // function as Connector mutator.
 type ConnectorFunc func(context.Context, *db.ConnectorMutation) (db.Value, error)
@@ -74,6 +87,19 @@ func (f DeviceTokenFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, e
 	return f(ctx, mv)
 }
 
+// The EmailVerificationFunc type is an adapter to allow the use of ordinary
+// function as EmailVerification mutator.
+type EmailVerificationFunc func(context.Context, *db.EmailVerificationMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailVerificationFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.EmailVerificationMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.EmailVerificationMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The KeysFunc type is an adapter to allow the use of ordinary
 // function as Keys mutator.
 type KeysFunc func(context.Context, *db.KeysMutation) (db.Value, error)
@@ -87,6 +113,19 @@ func (f KeysFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
 	return f(ctx, mv)
 }
 
+// The LoginAttemptsFunc type is an adapter to allow the use of ordinary
+// function as LoginAttempts mutator.
+type LoginAttemptsFunc func(context.Context, *db.LoginAttemptsMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginAttemptsFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.LoginAttemptsMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.LoginAttemptsMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The OAuth2ClientFunc type is an adapter to allow the use of ordinary
 // function as OAuth2Client mutator.
 type OAuth2ClientFunc func(context.Context, *db.OAuth2ClientMutation) (db.Value, error)
@@ -126,6 +165,19 @@ func (f PasswordFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, erro
 	return f(ctx, mv)
 }
 
+// The PasswordResetFunc type is an adapter to allow the use of ordinary
+// function as PasswordReset mutator.
+type PasswordResetFunc func(context.Context, *db.PasswordResetMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PasswordResetFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.PasswordResetMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.PasswordResetMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The RefreshTokenFunc type is an adapter to allow the use of ordinary
 // function as RefreshToken mutator.
 type RefreshTokenFunc func(context.Context, *db.RefreshTokenMutation) (db.Value, error)
@@ -139,6 +191,71 @@ func (f RefreshTokenFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value,
 	return f(ctx, mv)
 }
 
+// The SAMLAssertionFunc type is an adapter to allow the use of ordinary
+// function as SAMLAssertion mutator.
+type SAMLAssertionFunc func(context.Context, *db.SAMLAssertionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SAMLAssertionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.SAMLAssertionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SAMLAssertionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The SAMLServiceProviderFunc type is an adapter to allow the use of ordinary
+// function as SAMLServiceProvider mutator.
+type SAMLServiceProviderFunc func(context.Context, *db.SAMLServiceProviderMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SAMLServiceProviderFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.SAMLServiceProviderMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SAMLServiceProviderMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TOTPEnrollmentFunc type is an adapter to allow the use of ordinary
+// function as TOTPEnrollment mutator.
+type TOTPEnrollmentFunc func(context.Context, *db.TOTPEnrollmentMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TOTPEnrollmentFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.TOTPEnrollmentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.TOTPEnrollmentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The UserConsentFunc type is an adapter to allow the use of ordinary
+// function as UserConsent mutator.
+type UserConsentFunc func(context.Context, *db.UserConsentMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserConsentFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.UserConsentMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.UserConsentMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WebAuthnCredentialFunc type is an adapter to allow the use of ordinary
+// function as WebAuthnCredential mutator.
+type WebAuthnCredentialFunc func(context.Context, *db.WebAuthnCredentialMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebAuthnCredentialFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	mv, ok := m.(*db.WebAuthnCredentialMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *db.WebAuthnCredentialMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, db.Mutation) bool
 
@@ -0,0 +1,132 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
+)
+
+// LoginAttempts is the model entity for the LoginAttempts schema.
+type LoginAttempts struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Failures holds the value of the "failures" field.
+	Failures int `json:"failures,omitempty"`
+	// LastFailure holds the value of the "last_failure" field.
+	LastFailure time.Time `json:"last_failure,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil time.Time `json:"locked_until,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginAttempts) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginattempts.FieldFailures:
+			values[i] = new(sql.NullInt64)
+		case loginattempts.FieldID:
+			values[i] = new(sql.NullString)
+		case loginattempts.FieldLastFailure, loginattempts.FieldLockedUntil, loginattempts.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type LoginAttempts", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginAttempts fields.
+func (la *LoginAttempts) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginattempts.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				la.ID = value.String
+			}
+		case loginattempts.FieldFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value.Valid {
+				la.Failures = int(value.Int64)
+			}
+		case loginattempts.FieldLastFailure:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failure", values[i])
+			} else if value.Valid {
+				la.LastFailure = value.Time
+			}
+		case loginattempts.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				la.LockedUntil = value.Time
+			}
+		case loginattempts.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				la.Expiry = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this LoginAttempts.
+// Note that you need to call LoginAttempts.Unwrap() before calling this method if this LoginAttempts
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (la *LoginAttempts) Update() *LoginAttemptsUpdateOne {
+	return (&LoginAttemptsClient{config: la.config}).UpdateOne(la)
+}
+
+// Unwrap unwraps the LoginAttempts entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (la *LoginAttempts) Unwrap() *LoginAttempts {
+	tx, ok := la.config.driver.(*txDriver)
+	if !ok {
+		panic("db: LoginAttempts is not a transactional entity")
+	}
+	la.config.driver = tx.drv
+	return la
+}
+
+// String implements the fmt.Stringer.
+func (la *LoginAttempts) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginAttempts(")
+	builder.WriteString(fmt.Sprintf("id=%v", la.ID))
+	builder.WriteString(", failures=")
+	builder.WriteString(fmt.Sprintf("%v", la.Failures))
+	builder.WriteString(", last_failure=")
+	builder.WriteString(la.LastFailure.Format(time.ANSIC))
+	builder.WriteString(", locked_until=")
+	builder.WriteString(la.LockedUntil.Format(time.ANSIC))
+	builder.WriteString(", expiry=")
+	builder.WriteString(la.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginAttemptsSlice is a parsable slice of LoginAttempts.
+type LoginAttemptsSlice []*LoginAttempts
+
+func (la LoginAttemptsSlice) config(cfg config) {
+	for _i := range la {
+		la[_i].config = cfg
+	}
+}
@@ -0,0 +1,44 @@
+// Code generated by entc, DO NOT EDIT.
+
+package loginattempts
+
+const (
+	// Label holds the string label denoting the loginattempts type in the database.
+	Label = "login_attempts"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFailures holds the string denoting the failures field in the database.
+	FieldFailures = "failures"
+	// FieldLastFailure holds the string denoting the last_failure field in the database.
+	FieldLastFailure = "last_failure"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldExpiry holds the string denoting the expiry field in the database.
+	FieldExpiry = "expiry"
+	// Table holds the table name of the loginattempts in the database.
+	Table = "login_attempts"
+)
+
+// Columns holds all SQL columns for loginattempts fields.
+var Columns = []string{
+	FieldID,
+	FieldFailures,
+	FieldLastFailure,
+	FieldLockedUntil,
+	FieldExpiry,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
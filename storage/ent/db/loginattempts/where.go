@@ -0,0 +1,457 @@
+// Code generated by entc, DO NOT EDIT.
+
+package loginattempts
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Failures applies equality check predicate on the "failures" field. It's identical to FailuresEQ.
+func Failures(v int) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFailures), v))
+	})
+}
+
+// LastFailure applies equality check predicate on the "last_failure" field. It's identical to LastFailureEQ.
+func LastFailure(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastFailure), v))
+	})
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLockedUntil), v))
+	})
+}
+
+// Expiry applies equality check predicate on the "expiry" field. It's identical to ExpiryEQ.
+func Expiry(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiry), v))
+	})
+}
+
+// FailuresEQ applies the EQ predicate on the "failures" field.
+func FailuresEQ(v int) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFailures), v))
+	})
+}
+
+// FailuresNEQ applies the NEQ predicate on the "failures" field.
+func FailuresNEQ(v int) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFailures), v))
+	})
+}
+
+// FailuresIn applies the In predicate on the "failures" field.
+func FailuresIn(vs ...int) predicate.LoginAttempts {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFailures), v...))
+	})
+}
+
+// FailuresNotIn applies the NotIn predicate on the "failures" field.
+func FailuresNotIn(vs ...int) predicate.LoginAttempts {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFailures), v...))
+	})
+}
+
+// FailuresGT applies the GT predicate on the "failures" field.
+func FailuresGT(v int) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFailures), v))
+	})
+}
+
+// FailuresGTE applies the GTE predicate on the "failures" field.
+func FailuresGTE(v int) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFailures), v))
+	})
+}
+
+// FailuresLT applies the LT predicate on the "failures" field.
+func FailuresLT(v int) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFailures), v))
+	})
+}
+
+// FailuresLTE applies the LTE predicate on the "failures" field.
+func FailuresLTE(v int) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFailures), v))
+	})
+}
+
+// LastFailureEQ applies the EQ predicate on the "last_failure" field.
+func LastFailureEQ(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastFailure), v))
+	})
+}
+
+// LastFailureNEQ applies the NEQ predicate on the "last_failure" field.
+func LastFailureNEQ(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastFailure), v))
+	})
+}
+
+// LastFailureIn applies the In predicate on the "last_failure" field.
+func LastFailureIn(vs ...time.Time) predicate.LoginAttempts {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastFailure), v...))
+	})
+}
+
+// LastFailureNotIn applies the NotIn predicate on the "last_failure" field.
+func LastFailureNotIn(vs ...time.Time) predicate.LoginAttempts {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastFailure), v...))
+	})
+}
+
+// LastFailureGT applies the GT predicate on the "last_failure" field.
+func LastFailureGT(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastFailure), v))
+	})
+}
+
+// LastFailureGTE applies the GTE predicate on the "last_failure" field.
+func LastFailureGTE(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastFailure), v))
+	})
+}
+
+// LastFailureLT applies the LT predicate on the "last_failure" field.
+func LastFailureLT(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastFailure), v))
+	})
+}
+
+// LastFailureLTE applies the LTE predicate on the "last_failure" field.
+func LastFailureLTE(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastFailure), v))
+	})
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.LoginAttempts {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLockedUntil), v...))
+	})
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.LoginAttempts {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLockedUntil), v...))
+	})
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLockedUntil), v))
+	})
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLockedUntil), v))
+	})
+}
+
+// ExpiryEQ applies the EQ predicate on the "expiry" field.
+func ExpiryEQ(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryNEQ applies the NEQ predicate on the "expiry" field.
+func ExpiryNEQ(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryIn applies the In predicate on the "expiry" field.
+func ExpiryIn(vs ...time.Time) predicate.LoginAttempts {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiry), v...))
+	})
+}
+
+// ExpiryNotIn applies the NotIn predicate on the "expiry" field.
+func ExpiryNotIn(vs ...time.Time) predicate.LoginAttempts {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiry), v...))
+	})
+}
+
+// ExpiryGT applies the GT predicate on the "expiry" field.
+func ExpiryGT(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryGTE applies the GTE predicate on the "expiry" field.
+func ExpiryGTE(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryLT applies the LT predicate on the "expiry" field.
+func ExpiryLT(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiry), v))
+	})
+}
+
+// ExpiryLTE applies the LTE predicate on the "expiry" field.
+func ExpiryLTE(v time.Time) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiry), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoginAttempts) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoginAttempts) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoginAttempts) predicate.LoginAttempts {
+	return predicate.LoginAttempts(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
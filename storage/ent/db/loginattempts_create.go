@@ -0,0 +1,288 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
+)
+
+// LoginAttemptsCreate is the builder for creating a LoginAttempts entity.
+type LoginAttemptsCreate struct {
+	config
+	mutation *LoginAttemptsMutation
+	hooks    []Hook
+}
+
+// SetFailures sets the "failures" field.
+func (lac *LoginAttemptsCreate) SetFailures(i int) *LoginAttemptsCreate {
+	lac.mutation.SetFailures(i)
+	return lac
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (lac *LoginAttemptsCreate) SetLastFailure(t time.Time) *LoginAttemptsCreate {
+	lac.mutation.SetLastFailure(t)
+	return lac
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (lac *LoginAttemptsCreate) SetLockedUntil(t time.Time) *LoginAttemptsCreate {
+	lac.mutation.SetLockedUntil(t)
+	return lac
+}
+
+// SetExpiry sets the "expiry" field.
+func (lac *LoginAttemptsCreate) SetExpiry(t time.Time) *LoginAttemptsCreate {
+	lac.mutation.SetExpiry(t)
+	return lac
+}
+
+// SetID sets the "id" field.
+func (lac *LoginAttemptsCreate) SetID(s string) *LoginAttemptsCreate {
+	lac.mutation.SetID(s)
+	return lac
+}
+
+// Mutation returns the LoginAttemptsMutation object of the builder.
+func (lac *LoginAttemptsCreate) Mutation() *LoginAttemptsMutation {
+	return lac.mutation
+}
+
+// Save creates the LoginAttempts in the database.
+func (lac *LoginAttemptsCreate) Save(ctx context.Context) (*LoginAttempts, error) {
+	var (
+		err  error
+		node *LoginAttempts
+	)
+	if len(lac.hooks) == 0 {
+		if err = lac.check(); err != nil {
+			return nil, err
+		}
+		node, err = lac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LoginAttemptsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lac.check(); err != nil {
+				return nil, err
+			}
+			lac.mutation = mutation
+			if node, err = lac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lac.hooks) - 1; i >= 0; i-- {
+			if lac.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = lac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LoginAttemptsCreate) SaveX(ctx context.Context) *LoginAttempts {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LoginAttemptsCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LoginAttemptsCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LoginAttemptsCreate) check() error {
+	if _, ok := lac.mutation.Failures(); !ok {
+		return &ValidationError{Name: "failures", err: errors.New(`db: missing required field "LoginAttempts.failures"`)}
+	}
+	if _, ok := lac.mutation.LastFailure(); !ok {
+		return &ValidationError{Name: "last_failure", err: errors.New(`db: missing required field "LoginAttempts.last_failure"`)}
+	}
+	if _, ok := lac.mutation.LockedUntil(); !ok {
+		return &ValidationError{Name: "locked_until", err: errors.New(`db: missing required field "LoginAttempts.locked_until"`)}
+	}
+	if _, ok := lac.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "LoginAttempts.expiry"`)}
+	}
+	if v, ok := lac.mutation.ID(); ok {
+		if err := loginattempts.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "LoginAttempts.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lac *LoginAttemptsCreate) sqlSave(ctx context.Context) (*LoginAttempts, error) {
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LoginAttempts.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (lac *LoginAttemptsCreate) createSpec() (*LoginAttempts, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginAttempts{config: lac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: loginattempts.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginattempts.FieldID,
+			},
+		}
+	)
+	if id, ok := lac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lac.mutation.Failures(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: loginattempts.FieldFailures,
+		})
+		_node.Failures = value
+	}
+	if value, ok := lac.mutation.LastFailure(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldLastFailure,
+		})
+		_node.LastFailure = value
+	}
+	if value, ok := lac.mutation.LockedUntil(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldLockedUntil,
+		})
+		_node.LockedUntil = value
+	}
+	if value, ok := lac.mutation.Expiry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldExpiry,
+		})
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// LoginAttemptsCreateBulk is the builder for creating many LoginAttempts entities in bulk.
+type LoginAttemptsCreateBulk struct {
+	config
+	builders []*LoginAttemptsCreate
+}
+
+// Save creates the LoginAttempts entities in the database.
+func (lacb *LoginAttemptsCreateBulk) Save(ctx context.Context) ([]*LoginAttempts, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LoginAttempts, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginAttemptsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LoginAttemptsCreateBulk) SaveX(ctx context.Context) []*LoginAttempts {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LoginAttemptsCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LoginAttemptsCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
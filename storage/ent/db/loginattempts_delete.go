@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// LoginAttemptsDelete is the builder for deleting a LoginAttempts entity.
+type LoginAttemptsDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoginAttemptsMutation
+}
+
+// Where appends a list predicates to the LoginAttemptsDelete builder.
+func (lad *LoginAttemptsDelete) Where(ps ...predicate.LoginAttempts) *LoginAttemptsDelete {
+	lad.mutation.Where(ps...)
+	return lad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lad *LoginAttemptsDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lad.hooks) == 0 {
+		affected, err = lad.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LoginAttemptsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lad.mutation = mutation
+			affected, err = lad.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lad.hooks) - 1; i >= 0; i-- {
+			if lad.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = lad.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lad.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lad *LoginAttemptsDelete) ExecX(ctx context.Context) int {
+	n, err := lad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lad *LoginAttemptsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: loginattempts.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginattempts.FieldID,
+			},
+		},
+	}
+	if ps := lad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, lad.driver, _spec)
+}
+
+// LoginAttemptsDeleteOne is the builder for deleting a single LoginAttempts entity.
+type LoginAttemptsDeleteOne struct {
+	lad *LoginAttemptsDelete
+}
+
+// Exec executes the deletion query.
+func (lado *LoginAttemptsDeleteOne) Exec(ctx context.Context) error {
+	n, err := lado.lad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loginattempts.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lado *LoginAttemptsDeleteOne) ExecX(ctx context.Context) {
+	lado.lad.ExecX(ctx)
+}
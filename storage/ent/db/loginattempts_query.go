@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// LoginAttemptsQuery is the builder for querying LoginAttempts entities.
+type LoginAttemptsQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.LoginAttempts
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoginAttemptsQuery builder.
+func (laq *LoginAttemptsQuery) Where(ps ...predicate.LoginAttempts) *LoginAttemptsQuery {
+	laq.predicates = append(laq.predicates, ps...)
+	return laq
+}
+
+// Limit adds a limit step to the query.
+func (laq *LoginAttemptsQuery) Limit(limit int) *LoginAttemptsQuery {
+	laq.limit = &limit
+	return laq
+}
+
+// Offset adds an offset step to the query.
+func (laq *LoginAttemptsQuery) Offset(offset int) *LoginAttemptsQuery {
+	laq.offset = &offset
+	return laq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (laq *LoginAttemptsQuery) Unique(unique bool) *LoginAttemptsQuery {
+	laq.unique = &unique
+	return laq
+}
+
+// Order adds an order step to the query.
+func (laq *LoginAttemptsQuery) Order(o ...OrderFunc) *LoginAttemptsQuery {
+	laq.order = append(laq.order, o...)
+	return laq
+}
+
+// First returns the first LoginAttempts entity from the query.
+// Returns a *NotFoundError when no LoginAttempts was found.
+func (laq *LoginAttemptsQuery) First(ctx context.Context) (*LoginAttempts, error) {
+	nodes, err := laq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loginattempts.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (laq *LoginAttemptsQuery) FirstX(ctx context.Context) *LoginAttempts {
+	node, err := laq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoginAttempts ID from the query.
+// Returns a *NotFoundError when no LoginAttempts ID was found.
+func (laq *LoginAttemptsQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = laq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loginattempts.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (laq *LoginAttemptsQuery) FirstIDX(ctx context.Context) string {
+	id, err := laq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoginAttempts entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoginAttempts entity is found.
+// Returns a *NotFoundError when no LoginAttempts entities are found.
+func (laq *LoginAttemptsQuery) Only(ctx context.Context) (*LoginAttempts, error) {
+	nodes, err := laq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loginattempts.Label}
+	default:
+		return nil, &NotSingularError{loginattempts.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (laq *LoginAttemptsQuery) OnlyX(ctx context.Context) *LoginAttempts {
+	node, err := laq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoginAttempts ID in the query.
+// Returns a *NotSingularError when more than one LoginAttempts ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (laq *LoginAttemptsQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = laq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = &NotSingularError{loginattempts.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (laq *LoginAttemptsQuery) OnlyIDX(ctx context.Context) string {
+	id, err := laq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoginAttemptsSlice.
+func (laq *LoginAttemptsQuery) All(ctx context.Context) ([]*LoginAttempts, error) {
+	if err := laq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return laq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (laq *LoginAttemptsQuery) AllX(ctx context.Context) []*LoginAttempts {
+	nodes, err := laq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoginAttempts IDs.
+func (laq *LoginAttemptsQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := laq.Select(loginattempts.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (laq *LoginAttemptsQuery) IDsX(ctx context.Context) []string {
+	ids, err := laq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (laq *LoginAttemptsQuery) Count(ctx context.Context) (int, error) {
+	if err := laq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return laq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (laq *LoginAttemptsQuery) CountX(ctx context.Context) int {
+	count, err := laq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (laq *LoginAttemptsQuery) Exist(ctx context.Context) (bool, error) {
+	if err := laq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return laq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (laq *LoginAttemptsQuery) ExistX(ctx context.Context) bool {
+	exist, err := laq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoginAttemptsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (laq *LoginAttemptsQuery) Clone() *LoginAttemptsQuery {
+	if laq == nil {
+		return nil
+	}
+	return &LoginAttemptsQuery{
+		config:     laq.config,
+		limit:      laq.limit,
+		offset:     laq.offset,
+		order:      append([]OrderFunc{}, laq.order...),
+		predicates: append([]predicate.LoginAttempts{}, laq.predicates...),
+		// clone intermediate query.
+		sql:    laq.sql.Clone(),
+		path:   laq.path,
+		unique: laq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Failures int `json:"failures,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoginAttempts.Query().
+//		GroupBy(loginattempts.FieldFailures).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+//
+func (laq *LoginAttemptsQuery) GroupBy(field string, fields ...string) *LoginAttemptsGroupBy {
+	group := &LoginAttemptsGroupBy{config: laq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := laq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return laq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Failures int `json:"failures,omitempty"`
+//	}
+//
+//	client.LoginAttempts.Query().
+//		Select(loginattempts.FieldFailures).
+//		Scan(ctx, &v)
+//
+func (laq *LoginAttemptsQuery) Select(fields ...string) *LoginAttemptsSelect {
+	laq.fields = append(laq.fields, fields...)
+	return &LoginAttemptsSelect{LoginAttemptsQuery: laq}
+}
+
+func (laq *LoginAttemptsQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range laq.fields {
+		if !loginattempts.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if laq.path != nil {
+		prev, err := laq.path(ctx)
+		if err != nil {
+			return err
+		}
+		laq.sql = prev
+	}
+	return nil
+}
+
+func (laq *LoginAttemptsQuery) sqlAll(ctx context.Context) ([]*LoginAttempts, error) {
+	var (
+		nodes = []*LoginAttempts{}
+		_spec = laq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &LoginAttempts{config: laq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, laq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (laq *LoginAttemptsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := laq.querySpec()
+	_spec.Node.Columns = laq.fields
+	if len(laq.fields) > 0 {
+		_spec.Unique = laq.unique != nil && *laq.unique
+	}
+	return sqlgraph.CountNodes(ctx, laq.driver, _spec)
+}
+
+func (laq *LoginAttemptsQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := laq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (laq *LoginAttemptsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   loginattempts.Table,
+			Columns: loginattempts.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginattempts.FieldID,
+			},
+		},
+		From:   laq.sql,
+		Unique: true,
+	}
+	if unique := laq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := laq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginattempts.FieldID)
+		for i := range fields {
+			if fields[i] != loginattempts.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := laq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := laq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := laq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := laq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (laq *LoginAttemptsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(laq.driver.Dialect())
+	t1 := builder.Table(loginattempts.Table)
+	columns := laq.fields
+	if len(columns) == 0 {
+		columns = loginattempts.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if laq.sql != nil {
+		selector = laq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if laq.unique != nil && *laq.unique {
+		selector.Distinct()
+	}
+	for _, p := range laq.predicates {
+		p(selector)
+	}
+	for _, p := range laq.order {
+		p(selector)
+	}
+	if offset := laq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := laq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LoginAttemptsGroupBy is the group-by builder for LoginAttempts entities.
+type LoginAttemptsGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lagb *LoginAttemptsGroupBy) Aggregate(fns ...AggregateFunc) *LoginAttemptsGroupBy {
+	lagb.fns = append(lagb.fns, fns...)
+	return lagb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (lagb *LoginAttemptsGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := lagb.path(ctx)
+	if err != nil {
+		return err
+	}
+	lagb.sql = query
+	return lagb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := lagb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lagb *LoginAttemptsGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(lagb.fields) > 1 {
+		return nil, errors.New("db: LoginAttemptsGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := lagb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) StringsX(ctx context.Context) []string {
+	v, err := lagb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lagb *LoginAttemptsGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = lagb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = fmt.Errorf("db: LoginAttemptsGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) StringX(ctx context.Context) string {
+	v, err := lagb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lagb *LoginAttemptsGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(lagb.fields) > 1 {
+		return nil, errors.New("db: LoginAttemptsGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := lagb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) IntsX(ctx context.Context) []int {
+	v, err := lagb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lagb *LoginAttemptsGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = lagb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = fmt.Errorf("db: LoginAttemptsGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) IntX(ctx context.Context) int {
+	v, err := lagb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lagb *LoginAttemptsGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(lagb.fields) > 1 {
+		return nil, errors.New("db: LoginAttemptsGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := lagb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := lagb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lagb *LoginAttemptsGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = lagb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = fmt.Errorf("db: LoginAttemptsGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := lagb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (lagb *LoginAttemptsGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(lagb.fields) > 1 {
+		return nil, errors.New("db: LoginAttemptsGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := lagb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := lagb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (lagb *LoginAttemptsGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = lagb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = fmt.Errorf("db: LoginAttemptsGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (lagb *LoginAttemptsGroupBy) BoolX(ctx context.Context) bool {
+	v, err := lagb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (lagb *LoginAttemptsGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range lagb.fields {
+		if !loginattempts.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := lagb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lagb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (lagb *LoginAttemptsGroupBy) sqlQuery() *sql.Selector {
+	selector := lagb.sql.Select()
+	aggregation := make([]string, 0, len(lagb.fns))
+	for _, fn := range lagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(lagb.fields)+len(lagb.fns))
+		for _, f := range lagb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(lagb.fields...)...)
+}
+
+// LoginAttemptsSelect is the builder for selecting fields of LoginAttempts entities.
+type LoginAttemptsSelect struct {
+	*LoginAttemptsQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (las *LoginAttemptsSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := las.prepareQuery(ctx); err != nil {
+		return err
+	}
+	las.sql = las.LoginAttemptsQuery.sqlQuery(ctx)
+	return las.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (las *LoginAttemptsSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := las.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (las *LoginAttemptsSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(las.fields) > 1 {
+		return nil, errors.New("db: LoginAttemptsSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := las.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (las *LoginAttemptsSelect) StringsX(ctx context.Context) []string {
+	v, err := las.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (las *LoginAttemptsSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = las.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = fmt.Errorf("db: LoginAttemptsSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (las *LoginAttemptsSelect) StringX(ctx context.Context) string {
+	v, err := las.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (las *LoginAttemptsSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(las.fields) > 1 {
+		return nil, errors.New("db: LoginAttemptsSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := las.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (las *LoginAttemptsSelect) IntsX(ctx context.Context) []int {
+	v, err := las.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (las *LoginAttemptsSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = las.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = fmt.Errorf("db: LoginAttemptsSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (las *LoginAttemptsSelect) IntX(ctx context.Context) int {
+	v, err := las.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (las *LoginAttemptsSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(las.fields) > 1 {
+		return nil, errors.New("db: LoginAttemptsSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := las.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (las *LoginAttemptsSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := las.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (las *LoginAttemptsSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = las.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = fmt.Errorf("db: LoginAttemptsSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (las *LoginAttemptsSelect) Float64X(ctx context.Context) float64 {
+	v, err := las.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (las *LoginAttemptsSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(las.fields) > 1 {
+		return nil, errors.New("db: LoginAttemptsSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := las.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (las *LoginAttemptsSelect) BoolsX(ctx context.Context) []bool {
+	v, err := las.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (las *LoginAttemptsSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = las.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{loginattempts.Label}
+	default:
+		err = fmt.Errorf("db: LoginAttemptsSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (las *LoginAttemptsSelect) BoolX(ctx context.Context) bool {
+	v, err := las.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (las *LoginAttemptsSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := las.sql.Query()
+	if err := las.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,372 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// LoginAttemptsUpdate is the builder for updating LoginAttempts entities.
+type LoginAttemptsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginAttemptsMutation
+}
+
+// Where appends a list predicates to the LoginAttemptsUpdate builder.
+func (lau *LoginAttemptsUpdate) Where(ps ...predicate.LoginAttempts) *LoginAttemptsUpdate {
+	lau.mutation.Where(ps...)
+	return lau
+}
+
+// SetFailures sets the "failures" field.
+func (lau *LoginAttemptsUpdate) SetFailures(i int) *LoginAttemptsUpdate {
+	lau.mutation.ResetFailures()
+	lau.mutation.SetFailures(i)
+	return lau
+}
+
+// AddFailures adds i to the "failures" field.
+func (lau *LoginAttemptsUpdate) AddFailures(i int) *LoginAttemptsUpdate {
+	lau.mutation.AddFailures(i)
+	return lau
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (lau *LoginAttemptsUpdate) SetLastFailure(t time.Time) *LoginAttemptsUpdate {
+	lau.mutation.SetLastFailure(t)
+	return lau
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (lau *LoginAttemptsUpdate) SetLockedUntil(t time.Time) *LoginAttemptsUpdate {
+	lau.mutation.SetLockedUntil(t)
+	return lau
+}
+
+// SetExpiry sets the "expiry" field.
+func (lau *LoginAttemptsUpdate) SetExpiry(t time.Time) *LoginAttemptsUpdate {
+	lau.mutation.SetExpiry(t)
+	return lau
+}
+
+// Mutation returns the LoginAttemptsMutation object of the builder.
+func (lau *LoginAttemptsUpdate) Mutation() *LoginAttemptsMutation {
+	return lau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lau *LoginAttemptsUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lau.hooks) == 0 {
+		affected, err = lau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LoginAttemptsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lau.mutation = mutation
+			affected, err = lau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lau.hooks) - 1; i >= 0; i-- {
+			if lau.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = lau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lau *LoginAttemptsUpdate) SaveX(ctx context.Context) int {
+	affected, err := lau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lau *LoginAttemptsUpdate) Exec(ctx context.Context) error {
+	_, err := lau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lau *LoginAttemptsUpdate) ExecX(ctx context.Context) {
+	if err := lau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lau *LoginAttemptsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   loginattempts.Table,
+			Columns: loginattempts.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginattempts.FieldID,
+			},
+		},
+	}
+	if ps := lau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lau.mutation.Failures(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: loginattempts.FieldFailures,
+		})
+	}
+	if value, ok := lau.mutation.AddedFailures(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: loginattempts.FieldFailures,
+		})
+	}
+	if value, ok := lau.mutation.LastFailure(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldLastFailure,
+		})
+	}
+	if value, ok := lau.mutation.LockedUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldLockedUntil,
+		})
+	}
+	if value, ok := lau.mutation.Expiry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldExpiry,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginattempts.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// LoginAttemptsUpdateOne is the builder for updating a single LoginAttempts entity.
+type LoginAttemptsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginAttemptsMutation
+}
+
+// SetFailures sets the "failures" field.
+func (lauo *LoginAttemptsUpdateOne) SetFailures(i int) *LoginAttemptsUpdateOne {
+	lauo.mutation.ResetFailures()
+	lauo.mutation.SetFailures(i)
+	return lauo
+}
+
+// AddFailures adds i to the "failures" field.
+func (lauo *LoginAttemptsUpdateOne) AddFailures(i int) *LoginAttemptsUpdateOne {
+	lauo.mutation.AddFailures(i)
+	return lauo
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (lauo *LoginAttemptsUpdateOne) SetLastFailure(t time.Time) *LoginAttemptsUpdateOne {
+	lauo.mutation.SetLastFailure(t)
+	return lauo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (lauo *LoginAttemptsUpdateOne) SetLockedUntil(t time.Time) *LoginAttemptsUpdateOne {
+	lauo.mutation.SetLockedUntil(t)
+	return lauo
+}
+
+// SetExpiry sets the "expiry" field.
+func (lauo *LoginAttemptsUpdateOne) SetExpiry(t time.Time) *LoginAttemptsUpdateOne {
+	lauo.mutation.SetExpiry(t)
+	return lauo
+}
+
+// Mutation returns the LoginAttemptsMutation object of the builder.
+func (lauo *LoginAttemptsUpdateOne) Mutation() *LoginAttemptsMutation {
+	return lauo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lauo *LoginAttemptsUpdateOne) Select(field string, fields ...string) *LoginAttemptsUpdateOne {
+	lauo.fields = append([]string{field}, fields...)
+	return lauo
+}
+
+// Save executes the query and returns the updated LoginAttempts entity.
+func (lauo *LoginAttemptsUpdateOne) Save(ctx context.Context) (*LoginAttempts, error) {
+	var (
+		err  error
+		node *LoginAttempts
+	)
+	if len(lauo.hooks) == 0 {
+		node, err = lauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LoginAttemptsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lauo.mutation = mutation
+			node, err = lauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lauo.hooks) - 1; i >= 0; i-- {
+			if lauo.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = lauo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lauo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lauo *LoginAttemptsUpdateOne) SaveX(ctx context.Context) *LoginAttempts {
+	node, err := lauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lauo *LoginAttemptsUpdateOne) Exec(ctx context.Context) error {
+	_, err := lauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lauo *LoginAttemptsUpdateOne) ExecX(ctx context.Context) {
+	if err := lauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lauo *LoginAttemptsUpdateOne) sqlSave(ctx context.Context) (_node *LoginAttempts, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   loginattempts.Table,
+			Columns: loginattempts.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginattempts.FieldID,
+			},
+		},
+	}
+	id, ok := lauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "LoginAttempts.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginattempts.FieldID)
+		for _, f := range fields {
+			if !loginattempts.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != loginattempts.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lauo.mutation.Failures(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: loginattempts.FieldFailures,
+		})
+	}
+	if value, ok := lauo.mutation.AddedFailures(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: loginattempts.FieldFailures,
+		})
+	}
+	if value, ok := lauo.mutation.LastFailure(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldLastFailure,
+		})
+	}
+	if value, ok := lauo.mutation.LockedUntil(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldLockedUntil,
+		})
+	}
+	if value, ok := lauo.mutation.Expiry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginattempts.FieldExpiry,
+		})
+	}
+	_node = &LoginAttempts{config: lauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginattempts.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
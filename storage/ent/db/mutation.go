@@ -12,15 +12,24 @@ import (
 	"github.com/dexidp/dex/storage"
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
 	"github.com/dexidp/dex/storage/ent/db/connector"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/emailverification"
 	"github.com/dexidp/dex/storage/ent/db/keys"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/offlinesession"
 	"github.com/dexidp/dex/storage/ent/db/password"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
 	"github.com/dexidp/dex/storage/ent/db/predicate"
 	"github.com/dexidp/dex/storage/ent/db/refreshtoken"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
 	"gopkg.in/square/go-jose.v2"
 
 	"entgo.io/ent"
@@ -35,16 +44,25 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAuthCode       = "AuthCode"
-	TypeAuthRequest    = "AuthRequest"
-	TypeConnector      = "Connector"
-	TypeDeviceRequest  = "DeviceRequest"
-	TypeDeviceToken    = "DeviceToken"
-	TypeKeys           = "Keys"
-	TypeOAuth2Client   = "OAuth2Client"
-	TypeOfflineSession = "OfflineSession"
-	TypePassword       = "Password"
-	TypeRefreshToken   = "RefreshToken"
+	TypeAuthCode               = "AuthCode"
+	TypeAuthRequest            = "AuthRequest"
+	TypeBackchannelAuthRequest = "BackchannelAuthRequest"
+	TypeConnector              = "Connector"
+	TypeDeviceRequest          = "DeviceRequest"
+	TypeDeviceToken            = "DeviceToken"
+	TypeEmailVerification      = "EmailVerification"
+	TypeKeys                   = "Keys"
+	TypeLoginAttempts          = "LoginAttempts"
+	TypeOAuth2Client           = "OAuth2Client"
+	TypeOfflineSession         = "OfflineSession"
+	TypePassword               = "Password"
+	TypePasswordReset          = "PasswordReset"
+	TypeRefreshToken           = "RefreshToken"
+	TypeSAMLAssertion          = "SAMLAssertion"
+	TypeSAMLServiceProvider    = "SAMLServiceProvider"
+	TypeTOTPEnrollment         = "TOTPEnrollment"
+	TypeUserConsent            = "UserConsent"
+	TypeWebAuthnCredential     = "WebAuthnCredential"
 )
 
 // AuthCodeMutation represents an operation that mutates the AuthCode nodes in the graph.
@@ -63,6 +81,7 @@ type AuthCodeMutation struct {
 	claims_email_verified     *bool
 	claims_groups             *[]string
 	claims_preferred_username *string
+	claims_acr                *string
 	connector_id              *string
 	connector_data            *[]byte
 	expiry                    *time.Time
@@ -564,6 +583,42 @@ func (m *AuthCodeMutation) ResetClaimsPreferredUsername() {
 	m.claims_preferred_username = nil
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (m *AuthCodeMutation) SetClaimsAcr(s string) {
+	m.claims_acr = &s
+}
+
+// ClaimsAcr returns the value of the "claims_acr" field in the mutation.
+func (m *AuthCodeMutation) ClaimsAcr() (r string, exists bool) {
+	v := m.claims_acr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsAcr returns the old "claims_acr" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthCodeMutation) OldClaimsAcr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsAcr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsAcr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsAcr: %w", err)
+	}
+	return oldValue.ClaimsAcr, nil
+}
+
+// ResetClaimsAcr resets all changes to the "claims_acr" field.
+func (m *AuthCodeMutation) ResetClaimsAcr() {
+	m.claims_acr = nil
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (m *AuthCodeMutation) SetConnectorID(s string) {
 	m.connector_id = &s
@@ -776,7 +831,7 @@ func (m *AuthCodeMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AuthCodeMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+	fields := make([]string, 0, 16)
 	if m.client_id != nil {
 		fields = append(fields, authcode.FieldClientID)
 	}
@@ -807,6 +862,9 @@ func (m *AuthCodeMutation) Fields() []string {
 	if m.claims_preferred_username != nil {
 		fields = append(fields, authcode.FieldClaimsPreferredUsername)
 	}
+	if m.claims_acr != nil {
+		fields = append(fields, authcode.FieldClaimsAcr)
+	}
 	if m.connector_id != nil {
 		fields = append(fields, authcode.FieldConnectorID)
 	}
@@ -850,6 +908,8 @@ func (m *AuthCodeMutation) Field(name string) (ent.Value, bool) {
 		return m.ClaimsGroups()
 	case authcode.FieldClaimsPreferredUsername:
 		return m.ClaimsPreferredUsername()
+	case authcode.FieldClaimsAcr:
+		return m.ClaimsAcr()
 	case authcode.FieldConnectorID:
 		return m.ConnectorID()
 	case authcode.FieldConnectorData:
@@ -889,6 +949,8 @@ func (m *AuthCodeMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldClaimsGroups(ctx)
 	case authcode.FieldClaimsPreferredUsername:
 		return m.OldClaimsPreferredUsername(ctx)
+	case authcode.FieldClaimsAcr:
+		return m.OldClaimsAcr(ctx)
 	case authcode.FieldConnectorID:
 		return m.OldConnectorID(ctx)
 	case authcode.FieldConnectorData:
@@ -978,6 +1040,13 @@ func (m *AuthCodeMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetClaimsPreferredUsername(v)
 		return nil
+	case authcode.FieldClaimsAcr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsAcr(v)
+		return nil
 	case authcode.FieldConnectorID:
 		v, ok := value.(string)
 		if !ok {
@@ -1113,6 +1182,9 @@ func (m *AuthCodeMutation) ResetField(name string) error {
 	case authcode.FieldClaimsPreferredUsername:
 		m.ResetClaimsPreferredUsername()
 		return nil
+	case authcode.FieldClaimsAcr:
+		m.ResetClaimsAcr()
+		return nil
 	case authcode.FieldConnectorID:
 		m.ResetConnectorID()
 		return nil
@@ -1200,11 +1272,18 @@ type AuthRequestMutation struct {
 	claims_email_verified     *bool
 	claims_groups             *[]string
 	claims_preferred_username *string
+	claims_acr                *string
 	connector_id              *string
 	connector_data            *[]byte
 	expiry                    *time.Time
 	code_challenge            *string
 	code_challenge_method     *string
+	response_mode             *string
+	acr_values                *[]string
+	connector_chain           *[]string
+	mfa_validated             *bool
+	webauthn_challenge        *string
+	callback_data             *[]byte
 	clearedFields             map[string]struct{}
 	done                      bool
 	oldValue                  func(context.Context) (*AuthRequest, error)
@@ -1858,6 +1937,42 @@ func (m *AuthRequestMutation) ResetClaimsPreferredUsername() {
 	m.claims_preferred_username = nil
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (m *AuthRequestMutation) SetClaimsAcr(s string) {
+	m.claims_acr = &s
+}
+
+// ClaimsAcr returns the value of the "claims_acr" field in the mutation.
+func (m *AuthRequestMutation) ClaimsAcr() (r string, exists bool) {
+	v := m.claims_acr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsAcr returns the old "claims_acr" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldClaimsAcr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsAcr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsAcr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsAcr: %w", err)
+	}
+	return oldValue.ClaimsAcr, nil
+}
+
+// ResetClaimsAcr resets all changes to the "claims_acr" field.
+func (m *AuthRequestMutation) ResetClaimsAcr() {
+	m.claims_acr = nil
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (m *AuthRequestMutation) SetConnectorID(s string) {
 	m.connector_id = &s
@@ -2051,6 +2166,261 @@ func (m *AuthRequestMutation) ResetCodeChallengeMethod() {
 	m.code_challenge_method = nil
 }
 
+// SetResponseMode sets the "response_mode" field.
+func (m *AuthRequestMutation) SetResponseMode(s string) {
+	m.response_mode = &s
+}
+
+// ResponseMode returns the value of the "response_mode" field in the mutation.
+func (m *AuthRequestMutation) ResponseMode() (r string, exists bool) {
+	v := m.response_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseMode returns the old "response_mode" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldResponseMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseMode: %w", err)
+	}
+	return oldValue.ResponseMode, nil
+}
+
+// ResetResponseMode resets all changes to the "response_mode" field.
+func (m *AuthRequestMutation) ResetResponseMode() {
+	m.response_mode = nil
+}
+
+// SetAcrValues sets the "acr_values" field.
+func (m *AuthRequestMutation) SetAcrValues(s []string) {
+	m.acr_values = &s
+}
+
+// AcrValues returns the value of the "acr_values" field in the mutation.
+func (m *AuthRequestMutation) AcrValues() (r []string, exists bool) {
+	v := m.acr_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcrValues returns the old "acr_values" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldAcrValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcrValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcrValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcrValues: %w", err)
+	}
+	return oldValue.AcrValues, nil
+}
+
+// ClearAcrValues clears the value of the "acr_values" field.
+func (m *AuthRequestMutation) ClearAcrValues() {
+	m.acr_values = nil
+	m.clearedFields[authrequest.FieldAcrValues] = struct{}{}
+}
+
+// AcrValuesCleared returns if the "acr_values" field was cleared in this mutation.
+func (m *AuthRequestMutation) AcrValuesCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldAcrValues]
+	return ok
+}
+
+// ResetAcrValues resets all changes to the "acr_values" field.
+func (m *AuthRequestMutation) ResetAcrValues() {
+	m.acr_values = nil
+	delete(m.clearedFields, authrequest.FieldAcrValues)
+}
+
+// SetConnectorChain sets the "connector_chain" field.
+func (m *AuthRequestMutation) SetConnectorChain(s []string) {
+	m.connector_chain = &s
+}
+
+// ConnectorChain returns the value of the "connector_chain" field in the mutation.
+func (m *AuthRequestMutation) ConnectorChain() (r []string, exists bool) {
+	v := m.connector_chain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectorChain returns the old "connector_chain" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldConnectorChain(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectorChain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectorChain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectorChain: %w", err)
+	}
+	return oldValue.ConnectorChain, nil
+}
+
+// ClearConnectorChain clears the value of the "connector_chain" field.
+func (m *AuthRequestMutation) ClearConnectorChain() {
+	m.connector_chain = nil
+	m.clearedFields[authrequest.FieldConnectorChain] = struct{}{}
+}
+
+// ConnectorChainCleared returns if the "connector_chain" field was cleared in this mutation.
+func (m *AuthRequestMutation) ConnectorChainCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldConnectorChain]
+	return ok
+}
+
+// ResetConnectorChain resets all changes to the "connector_chain" field.
+func (m *AuthRequestMutation) ResetConnectorChain() {
+	m.connector_chain = nil
+	delete(m.clearedFields, authrequest.FieldConnectorChain)
+}
+
+// SetMfaValidated sets the "mfa_validated" field.
+func (m *AuthRequestMutation) SetMfaValidated(b bool) {
+	m.mfa_validated = &b
+}
+
+// MfaValidated returns the value of the "mfa_validated" field in the mutation.
+func (m *AuthRequestMutation) MfaValidated() (r bool, exists bool) {
+	v := m.mfa_validated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaValidated returns the old "mfa_validated" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldMfaValidated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaValidated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaValidated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaValidated: %w", err)
+	}
+	return oldValue.MfaValidated, nil
+}
+
+// ResetMfaValidated resets all changes to the "mfa_validated" field.
+func (m *AuthRequestMutation) ResetMfaValidated() {
+	m.mfa_validated = nil
+}
+
+// SetWebauthnChallenge sets the "webauthn_challenge" field.
+func (m *AuthRequestMutation) SetWebauthnChallenge(s string) {
+	m.webauthn_challenge = &s
+}
+
+// WebauthnChallenge returns the value of the "webauthn_challenge" field in the mutation.
+func (m *AuthRequestMutation) WebauthnChallenge() (r string, exists bool) {
+	v := m.webauthn_challenge
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebauthnChallenge returns the old "webauthn_challenge" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldWebauthnChallenge(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebauthnChallenge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebauthnChallenge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebauthnChallenge: %w", err)
+	}
+	return oldValue.WebauthnChallenge, nil
+}
+
+// ResetWebauthnChallenge resets all changes to the "webauthn_challenge" field.
+func (m *AuthRequestMutation) ResetWebauthnChallenge() {
+	m.webauthn_challenge = nil
+}
+
+// SetCallbackData sets the "callback_data" field.
+func (m *AuthRequestMutation) SetCallbackData(b []byte) {
+	m.callback_data = &b
+}
+
+// CallbackData returns the value of the "callback_data" field in the mutation.
+func (m *AuthRequestMutation) CallbackData() (r []byte, exists bool) {
+	v := m.callback_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCallbackData returns the old "callback_data" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldCallbackData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCallbackData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCallbackData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCallbackData: %w", err)
+	}
+	return oldValue.CallbackData, nil
+}
+
+// ClearCallbackData clears the value of the "callback_data" field.
+func (m *AuthRequestMutation) ClearCallbackData() {
+	m.callback_data = nil
+	m.clearedFields[authrequest.FieldCallbackData] = struct{}{}
+}
+
+// CallbackDataCleared returns if the "callback_data" field was cleared in this mutation.
+func (m *AuthRequestMutation) CallbackDataCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldCallbackData]
+	return ok
+}
+
+// ResetCallbackData resets all changes to the "callback_data" field.
+func (m *AuthRequestMutation) ResetCallbackData() {
+	m.callback_data = nil
+	delete(m.clearedFields, authrequest.FieldCallbackData)
+}
+
 // Where appends a list predicates to the AuthRequestMutation builder.
 func (m *AuthRequestMutation) Where(ps ...predicate.AuthRequest) {
 	m.predicates = append(m.predicates, ps...)
@@ -2070,7 +2440,7 @@ func (m *AuthRequestMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AuthRequestMutation) Fields() []string {
-	fields := make([]string, 0, 19)
+	fields := make([]string, 0, 26)
 	if m.client_id != nil {
 		fields = append(fields, authrequest.FieldClientID)
 	}
@@ -2113,6 +2483,9 @@ func (m *AuthRequestMutation) Fields() []string {
 	if m.claims_preferred_username != nil {
 		fields = append(fields, authrequest.FieldClaimsPreferredUsername)
 	}
+	if m.claims_acr != nil {
+		fields = append(fields, authrequest.FieldClaimsAcr)
+	}
 	if m.connector_id != nil {
 		fields = append(fields, authrequest.FieldConnectorID)
 	}
@@ -2128,6 +2501,24 @@ func (m *AuthRequestMutation) Fields() []string {
 	if m.code_challenge_method != nil {
 		fields = append(fields, authrequest.FieldCodeChallengeMethod)
 	}
+	if m.response_mode != nil {
+		fields = append(fields, authrequest.FieldResponseMode)
+	}
+	if m.acr_values != nil {
+		fields = append(fields, authrequest.FieldAcrValues)
+	}
+	if m.connector_chain != nil {
+		fields = append(fields, authrequest.FieldConnectorChain)
+	}
+	if m.mfa_validated != nil {
+		fields = append(fields, authrequest.FieldMfaValidated)
+	}
+	if m.webauthn_challenge != nil {
+		fields = append(fields, authrequest.FieldWebauthnChallenge)
+	}
+	if m.callback_data != nil {
+		fields = append(fields, authrequest.FieldCallbackData)
+	}
 	return fields
 }
 
@@ -2164,6 +2555,8 @@ func (m *AuthRequestMutation) Field(name string) (ent.Value, bool) {
 		return m.ClaimsGroups()
 	case authrequest.FieldClaimsPreferredUsername:
 		return m.ClaimsPreferredUsername()
+	case authrequest.FieldClaimsAcr:
+		return m.ClaimsAcr()
 	case authrequest.FieldConnectorID:
 		return m.ConnectorID()
 	case authrequest.FieldConnectorData:
@@ -2174,6 +2567,18 @@ func (m *AuthRequestMutation) Field(name string) (ent.Value, bool) {
 		return m.CodeChallenge()
 	case authrequest.FieldCodeChallengeMethod:
 		return m.CodeChallengeMethod()
+	case authrequest.FieldResponseMode:
+		return m.ResponseMode()
+	case authrequest.FieldAcrValues:
+		return m.AcrValues()
+	case authrequest.FieldConnectorChain:
+		return m.ConnectorChain()
+	case authrequest.FieldMfaValidated:
+		return m.MfaValidated()
+	case authrequest.FieldWebauthnChallenge:
+		return m.WebauthnChallenge()
+	case authrequest.FieldCallbackData:
+		return m.CallbackData()
 	}
 	return nil, false
 }
@@ -2211,6 +2616,8 @@ func (m *AuthRequestMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldClaimsGroups(ctx)
 	case authrequest.FieldClaimsPreferredUsername:
 		return m.OldClaimsPreferredUsername(ctx)
+	case authrequest.FieldClaimsAcr:
+		return m.OldClaimsAcr(ctx)
 	case authrequest.FieldConnectorID:
 		return m.OldConnectorID(ctx)
 	case authrequest.FieldConnectorData:
@@ -2221,6 +2628,18 @@ func (m *AuthRequestMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldCodeChallenge(ctx)
 	case authrequest.FieldCodeChallengeMethod:
 		return m.OldCodeChallengeMethod(ctx)
+	case authrequest.FieldResponseMode:
+		return m.OldResponseMode(ctx)
+	case authrequest.FieldAcrValues:
+		return m.OldAcrValues(ctx)
+	case authrequest.FieldConnectorChain:
+		return m.OldConnectorChain(ctx)
+	case authrequest.FieldMfaValidated:
+		return m.OldMfaValidated(ctx)
+	case authrequest.FieldWebauthnChallenge:
+		return m.OldWebauthnChallenge(ctx)
+	case authrequest.FieldCallbackData:
+		return m.OldCallbackData(ctx)
 	}
 	return nil, fmt.Errorf("unknown AuthRequest field %s", name)
 }
@@ -2328,6 +2747,13 @@ func (m *AuthRequestMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetClaimsPreferredUsername(v)
 		return nil
+	case authrequest.FieldClaimsAcr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsAcr(v)
+		return nil
 	case authrequest.FieldConnectorID:
 		v, ok := value.(string)
 		if !ok {
@@ -2363,6 +2789,48 @@ func (m *AuthRequestMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCodeChallengeMethod(v)
 		return nil
+	case authrequest.FieldResponseMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseMode(v)
+		return nil
+	case authrequest.FieldAcrValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcrValues(v)
+		return nil
+	case authrequest.FieldConnectorChain:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectorChain(v)
+		return nil
+	case authrequest.FieldMfaValidated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaValidated(v)
+		return nil
+	case authrequest.FieldWebauthnChallenge:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebauthnChallenge(v)
+		return nil
+	case authrequest.FieldCallbackData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCallbackData(v)
+		return nil
 	}
 	return fmt.Errorf("unknown AuthRequest field %s", name)
 }
@@ -2405,6 +2873,15 @@ func (m *AuthRequestMutation) ClearedFields() []string {
 	if m.FieldCleared(authrequest.FieldConnectorData) {
 		fields = append(fields, authrequest.FieldConnectorData)
 	}
+	if m.FieldCleared(authrequest.FieldAcrValues) {
+		fields = append(fields, authrequest.FieldAcrValues)
+	}
+	if m.FieldCleared(authrequest.FieldConnectorChain) {
+		fields = append(fields, authrequest.FieldConnectorChain)
+	}
+	if m.FieldCleared(authrequest.FieldCallbackData) {
+		fields = append(fields, authrequest.FieldCallbackData)
+	}
 	return fields
 }
 
@@ -2431,6 +2908,15 @@ func (m *AuthRequestMutation) ClearField(name string) error {
 	case authrequest.FieldConnectorData:
 		m.ClearConnectorData()
 		return nil
+	case authrequest.FieldAcrValues:
+		m.ClearAcrValues()
+		return nil
+	case authrequest.FieldConnectorChain:
+		m.ClearConnectorChain()
+		return nil
+	case authrequest.FieldCallbackData:
+		m.ClearCallbackData()
+		return nil
 	}
 	return fmt.Errorf("unknown AuthRequest nullable field %s", name)
 }
@@ -2481,6 +2967,9 @@ func (m *AuthRequestMutation) ResetField(name string) error {
 	case authrequest.FieldClaimsPreferredUsername:
 		m.ResetClaimsPreferredUsername()
 		return nil
+	case authrequest.FieldClaimsAcr:
+		m.ResetClaimsAcr()
+		return nil
 	case authrequest.FieldConnectorID:
 		m.ResetConnectorID()
 		return nil
@@ -2496,6 +2985,24 @@ func (m *AuthRequestMutation) ResetField(name string) error {
 	case authrequest.FieldCodeChallengeMethod:
 		m.ResetCodeChallengeMethod()
 		return nil
+	case authrequest.FieldResponseMode:
+		m.ResetResponseMode()
+		return nil
+	case authrequest.FieldAcrValues:
+		m.ResetAcrValues()
+		return nil
+	case authrequest.FieldConnectorChain:
+		m.ResetConnectorChain()
+		return nil
+	case authrequest.FieldMfaValidated:
+		m.ResetMfaValidated()
+		return nil
+	case authrequest.FieldWebauthnChallenge:
+		m.ResetWebauthnChallenge()
+		return nil
+	case authrequest.FieldCallbackData:
+		m.ResetCallbackData()
+		return nil
 	}
 	return fmt.Errorf("unknown AuthRequest field %s", name)
 }
@@ -2548,33 +3055,35 @@ func (m *AuthRequestMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AuthRequest edge %s", name)
 }
 
-// ConnectorMutation represents an operation that mutates the Connector nodes in the graph.
-type ConnectorMutation struct {
+// BackchannelAuthRequestMutation represents an operation that mutates the BackchannelAuthRequest nodes in the graph.
+type BackchannelAuthRequestMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *string
-	_type            *string
-	name             *string
-	resource_version *string
-	_config          *[]byte
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*Connector, error)
-	predicates       []predicate.Connector
+	op                        Op
+	typ                       string
+	id                        *string
+	client_id                 *string
+	scopes                    *[]string
+	login_hint                *string
+	binding_message           *string
+	client_notification_token *string
+	expiry                    *time.Time
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*BackchannelAuthRequest, error)
+	predicates                []predicate.BackchannelAuthRequest
 }
 
-var _ ent.Mutation = (*ConnectorMutation)(nil)
+var _ ent.Mutation = (*BackchannelAuthRequestMutation)(nil)
 
-// connectorOption allows management of the mutation configuration using functional options.
-type connectorOption func(*ConnectorMutation)
+// backchannelauthrequestOption allows management of the mutation configuration using functional options.
+type backchannelauthrequestOption func(*BackchannelAuthRequestMutation)
 
-// newConnectorMutation creates new mutation for the Connector entity.
-func newConnectorMutation(c config, op Op, opts ...connectorOption) *ConnectorMutation {
-	m := &ConnectorMutation{
+// newBackchannelAuthRequestMutation creates new mutation for the BackchannelAuthRequest entity.
+func newBackchannelAuthRequestMutation(c config, op Op, opts ...backchannelauthrequestOption) *BackchannelAuthRequestMutation {
+	m := &BackchannelAuthRequestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConnector,
+		typ:           TypeBackchannelAuthRequest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2583,20 +3092,20 @@ func newConnectorMutation(c config, op Op, opts ...connectorOption) *ConnectorMu
 	return m
 }
 
-// withConnectorID sets the ID field of the mutation.
-func withConnectorID(id string) connectorOption {
-	return func(m *ConnectorMutation) {
+// withBackchannelAuthRequestID sets the ID field of the mutation.
+func withBackchannelAuthRequestID(id string) backchannelauthrequestOption {
+	return func(m *BackchannelAuthRequestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Connector
+			value *BackchannelAuthRequest
 		)
-		m.oldValue = func(ctx context.Context) (*Connector, error) {
+		m.oldValue = func(ctx context.Context) (*BackchannelAuthRequest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Connector.Get(ctx, id)
+					value, err = m.Client().BackchannelAuthRequest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2605,10 +3114,10 @@ func withConnectorID(id string) connectorOption {
 	}
 }
 
-// withConnector sets the old Connector of the mutation.
-func withConnector(node *Connector) connectorOption {
-	return func(m *ConnectorMutation) {
-		m.oldValue = func(context.Context) (*Connector, error) {
+// withBackchannelAuthRequest sets the old BackchannelAuthRequest of the mutation.
+func withBackchannelAuthRequest(node *BackchannelAuthRequest) backchannelauthrequestOption {
+	return func(m *BackchannelAuthRequestMutation) {
+		m.oldValue = func(context.Context) (*BackchannelAuthRequest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2617,7 +3126,7 @@ func withConnector(node *Connector) connectorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConnectorMutation) Client() *Client {
+func (m BackchannelAuthRequestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2625,7 +3134,7 @@ func (m ConnectorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConnectorMutation) Tx() (*Tx, error) {
+func (m BackchannelAuthRequestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -2635,14 +3144,14 @@ func (m ConnectorMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Connector entities.
-func (m *ConnectorMutation) SetID(id string) {
+// operation is only accepted on creation of BackchannelAuthRequest entities.
+func (m *BackchannelAuthRequestMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConnectorMutation) ID() (id string, exists bool) {
+func (m *BackchannelAuthRequestMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2653,7 +3162,7 @@ func (m *ConnectorMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConnectorMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BackchannelAuthRequestMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2662,187 +3171,278 @@ func (m *ConnectorMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Connector.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackchannelAuthRequest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *ConnectorMutation) SetType(s string) {
-	m._type = &s
+// SetClientID sets the "client_id" field.
+func (m *BackchannelAuthRequestMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ConnectorMutation) GetType() (r string, exists bool) {
-	v := m._type
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *BackchannelAuthRequestMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Connector entity.
-// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the BackchannelAuthRequest entity.
+// If the BackchannelAuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectorMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *BackchannelAuthRequestMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.ClientID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ConnectorMutation) ResetType() {
-	m._type = nil
+// ResetClientID resets all changes to the "client_id" field.
+func (m *BackchannelAuthRequestMutation) ResetClientID() {
+	m.client_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *ConnectorMutation) SetName(s string) {
-	m.name = &s
+// SetScopes sets the "scopes" field.
+func (m *BackchannelAuthRequestMutation) SetScopes(s []string) {
+	m.scopes = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ConnectorMutation) Name() (r string, exists bool) {
-	v := m.name
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *BackchannelAuthRequestMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Connector entity.
-// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the BackchannelAuthRequest entity.
+// If the BackchannelAuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectorMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BackchannelAuthRequestMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ConnectorMutation) ResetName() {
-	m.name = nil
+// ClearScopes clears the value of the "scopes" field.
+func (m *BackchannelAuthRequestMutation) ClearScopes() {
+	m.scopes = nil
+	m.clearedFields[backchannelauthrequest.FieldScopes] = struct{}{}
 }
 
-// SetResourceVersion sets the "resource_version" field.
-func (m *ConnectorMutation) SetResourceVersion(s string) {
-	m.resource_version = &s
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *BackchannelAuthRequestMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[backchannelauthrequest.FieldScopes]
+	return ok
 }
 
-// ResourceVersion returns the value of the "resource_version" field in the mutation.
-func (m *ConnectorMutation) ResourceVersion() (r string, exists bool) {
-	v := m.resource_version
+// ResetScopes resets all changes to the "scopes" field.
+func (m *BackchannelAuthRequestMutation) ResetScopes() {
+	m.scopes = nil
+	delete(m.clearedFields, backchannelauthrequest.FieldScopes)
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (m *BackchannelAuthRequestMutation) SetLoginHint(s string) {
+	m.login_hint = &s
+}
+
+// LoginHint returns the value of the "login_hint" field in the mutation.
+func (m *BackchannelAuthRequestMutation) LoginHint() (r string, exists bool) {
+	v := m.login_hint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceVersion returns the old "resource_version" field's value of the Connector entity.
-// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// OldLoginHint returns the old "login_hint" field's value of the BackchannelAuthRequest entity.
+// If the BackchannelAuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectorMutation) OldResourceVersion(ctx context.Context) (v string, err error) {
+func (m *BackchannelAuthRequestMutation) OldLoginHint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldLoginHint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceVersion requires an ID field in the mutation")
+		return v, errors.New("OldLoginHint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldLoginHint: %w", err)
 	}
-	return oldValue.ResourceVersion, nil
+	return oldValue.LoginHint, nil
 }
 
-// ResetResourceVersion resets all changes to the "resource_version" field.
-func (m *ConnectorMutation) ResetResourceVersion() {
-	m.resource_version = nil
+// ResetLoginHint resets all changes to the "login_hint" field.
+func (m *BackchannelAuthRequestMutation) ResetLoginHint() {
+	m.login_hint = nil
 }
 
-// SetConfig sets the "config" field.
-func (m *ConnectorMutation) SetConfig(b []byte) {
-	m._config = &b
+// SetBindingMessage sets the "binding_message" field.
+func (m *BackchannelAuthRequestMutation) SetBindingMessage(s string) {
+	m.binding_message = &s
 }
 
-// Config returns the value of the "config" field in the mutation.
-func (m *ConnectorMutation) Config() (r []byte, exists bool) {
-	v := m._config
+// BindingMessage returns the value of the "binding_message" field in the mutation.
+func (m *BackchannelAuthRequestMutation) BindingMessage() (r string, exists bool) {
+	v := m.binding_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfig returns the old "config" field's value of the Connector entity.
-// If the Connector object wasn't provided to the builder, the object is fetched from the database.
+// OldBindingMessage returns the old "binding_message" field's value of the BackchannelAuthRequest entity.
+// If the BackchannelAuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConnectorMutation) OldConfig(ctx context.Context) (v []byte, err error) {
+func (m *BackchannelAuthRequestMutation) OldBindingMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldBindingMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfig requires an ID field in the mutation")
+		return v, errors.New("OldBindingMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldBindingMessage: %w", err)
 	}
-	return oldValue.Config, nil
+	return oldValue.BindingMessage, nil
 }
 
-// ResetConfig resets all changes to the "config" field.
-func (m *ConnectorMutation) ResetConfig() {
-	m._config = nil
+// ResetBindingMessage resets all changes to the "binding_message" field.
+func (m *BackchannelAuthRequestMutation) ResetBindingMessage() {
+	m.binding_message = nil
 }
 
-// Where appends a list predicates to the ConnectorMutation builder.
-func (m *ConnectorMutation) Where(ps ...predicate.Connector) {
+// SetClientNotificationToken sets the "client_notification_token" field.
+func (m *BackchannelAuthRequestMutation) SetClientNotificationToken(s string) {
+	m.client_notification_token = &s
+}
+
+// ClientNotificationToken returns the value of the "client_notification_token" field in the mutation.
+func (m *BackchannelAuthRequestMutation) ClientNotificationToken() (r string, exists bool) {
+	v := m.client_notification_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientNotificationToken returns the old "client_notification_token" field's value of the BackchannelAuthRequest entity.
+// If the BackchannelAuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackchannelAuthRequestMutation) OldClientNotificationToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientNotificationToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientNotificationToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientNotificationToken: %w", err)
+	}
+	return oldValue.ClientNotificationToken, nil
+}
+
+// ResetClientNotificationToken resets all changes to the "client_notification_token" field.
+func (m *BackchannelAuthRequestMutation) ResetClientNotificationToken() {
+	m.client_notification_token = nil
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *BackchannelAuthRequestMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *BackchannelAuthRequestMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the BackchannelAuthRequest entity.
+// If the BackchannelAuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackchannelAuthRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *BackchannelAuthRequestMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// Where appends a list predicates to the BackchannelAuthRequestMutation builder.
+func (m *BackchannelAuthRequestMutation) Where(ps ...predicate.BackchannelAuthRequest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *ConnectorMutation) Op() Op {
+func (m *BackchannelAuthRequestMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Connector).
-func (m *ConnectorMutation) Type() string {
+// Type returns the node type of this mutation (BackchannelAuthRequest).
+func (m *BackchannelAuthRequestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConnectorMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m._type != nil {
-		fields = append(fields, connector.FieldType)
+func (m *BackchannelAuthRequestMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.client_id != nil {
+		fields = append(fields, backchannelauthrequest.FieldClientID)
 	}
-	if m.name != nil {
-		fields = append(fields, connector.FieldName)
+	if m.scopes != nil {
+		fields = append(fields, backchannelauthrequest.FieldScopes)
 	}
-	if m.resource_version != nil {
-		fields = append(fields, connector.FieldResourceVersion)
+	if m.login_hint != nil {
+		fields = append(fields, backchannelauthrequest.FieldLoginHint)
 	}
-	if m._config != nil {
-		fields = append(fields, connector.FieldConfig)
+	if m.binding_message != nil {
+		fields = append(fields, backchannelauthrequest.FieldBindingMessage)
+	}
+	if m.client_notification_token != nil {
+		fields = append(fields, backchannelauthrequest.FieldClientNotificationToken)
+	}
+	if m.expiry != nil {
+		fields = append(fields, backchannelauthrequest.FieldExpiry)
 	}
 	return fields
 }
@@ -2850,16 +3450,20 @@ func (m *ConnectorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConnectorMutation) Field(name string) (ent.Value, bool) {
+func (m *BackchannelAuthRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case connector.FieldType:
-		return m.GetType()
-	case connector.FieldName:
-		return m.Name()
-	case connector.FieldResourceVersion:
-		return m.ResourceVersion()
-	case connector.FieldConfig:
-		return m.Config()
+	case backchannelauthrequest.FieldClientID:
+		return m.ClientID()
+	case backchannelauthrequest.FieldScopes:
+		return m.Scopes()
+	case backchannelauthrequest.FieldLoginHint:
+		return m.LoginHint()
+	case backchannelauthrequest.FieldBindingMessage:
+		return m.BindingMessage()
+	case backchannelauthrequest.FieldClientNotificationToken:
+		return m.ClientNotificationToken()
+	case backchannelauthrequest.FieldExpiry:
+		return m.Expiry()
 	}
 	return nil, false
 }
@@ -2867,195 +3471,226 @@ func (m *ConnectorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConnectorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackchannelAuthRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case connector.FieldType:
-		return m.OldType(ctx)
-	case connector.FieldName:
-		return m.OldName(ctx)
-	case connector.FieldResourceVersion:
-		return m.OldResourceVersion(ctx)
-	case connector.FieldConfig:
-		return m.OldConfig(ctx)
+	case backchannelauthrequest.FieldClientID:
+		return m.OldClientID(ctx)
+	case backchannelauthrequest.FieldScopes:
+		return m.OldScopes(ctx)
+	case backchannelauthrequest.FieldLoginHint:
+		return m.OldLoginHint(ctx)
+	case backchannelauthrequest.FieldBindingMessage:
+		return m.OldBindingMessage(ctx)
+	case backchannelauthrequest.FieldClientNotificationToken:
+		return m.OldClientNotificationToken(ctx)
+	case backchannelauthrequest.FieldExpiry:
+		return m.OldExpiry(ctx)
 	}
-	return nil, fmt.Errorf("unknown Connector field %s", name)
+	return nil, fmt.Errorf("unknown BackchannelAuthRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConnectorMutation) SetField(name string, value ent.Value) error {
+func (m *BackchannelAuthRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case connector.FieldType:
+	case backchannelauthrequest.FieldClientID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetClientID(v)
 		return nil
-	case connector.FieldName:
+	case backchannelauthrequest.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case backchannelauthrequest.FieldLoginHint:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetLoginHint(v)
 		return nil
-	case connector.FieldResourceVersion:
+	case backchannelauthrequest.FieldBindingMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceVersion(v)
+		m.SetBindingMessage(v)
 		return nil
-	case connector.FieldConfig:
-		v, ok := value.([]byte)
+	case backchannelauthrequest.FieldClientNotificationToken:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfig(v)
+		m.SetClientNotificationToken(v)
+		return nil
+	case backchannelauthrequest.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Connector field %s", name)
+	return fmt.Errorf("unknown BackchannelAuthRequest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConnectorMutation) AddedFields() []string {
+func (m *BackchannelAuthRequestMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConnectorMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackchannelAuthRequestMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConnectorMutation) AddField(name string, value ent.Value) error {
+func (m *BackchannelAuthRequestMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Connector numeric field %s", name)
+	return fmt.Errorf("unknown BackchannelAuthRequest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConnectorMutation) ClearedFields() []string {
-	return nil
+func (m *BackchannelAuthRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backchannelauthrequest.FieldScopes) {
+		fields = append(fields, backchannelauthrequest.FieldScopes)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConnectorMutation) FieldCleared(name string) bool {
+func (m *BackchannelAuthRequestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConnectorMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Connector nullable field %s", name)
+func (m *BackchannelAuthRequestMutation) ClearField(name string) error {
+	switch name {
+	case backchannelauthrequest.FieldScopes:
+		m.ClearScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown BackchannelAuthRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConnectorMutation) ResetField(name string) error {
+func (m *BackchannelAuthRequestMutation) ResetField(name string) error {
 	switch name {
-	case connector.FieldType:
-		m.ResetType()
+	case backchannelauthrequest.FieldClientID:
+		m.ResetClientID()
 		return nil
-	case connector.FieldName:
-		m.ResetName()
+	case backchannelauthrequest.FieldScopes:
+		m.ResetScopes()
 		return nil
-	case connector.FieldResourceVersion:
-		m.ResetResourceVersion()
+	case backchannelauthrequest.FieldLoginHint:
+		m.ResetLoginHint()
 		return nil
-	case connector.FieldConfig:
-		m.ResetConfig()
+	case backchannelauthrequest.FieldBindingMessage:
+		m.ResetBindingMessage()
+		return nil
+	case backchannelauthrequest.FieldClientNotificationToken:
+		m.ResetClientNotificationToken()
+		return nil
+	case backchannelauthrequest.FieldExpiry:
+		m.ResetExpiry()
 		return nil
 	}
-	return fmt.Errorf("unknown Connector field %s", name)
+	return fmt.Errorf("unknown BackchannelAuthRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConnectorMutation) AddedEdges() []string {
+func (m *BackchannelAuthRequestMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConnectorMutation) AddedIDs(name string) []ent.Value {
+func (m *BackchannelAuthRequestMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConnectorMutation) RemovedEdges() []string {
+func (m *BackchannelAuthRequestMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConnectorMutation) RemovedIDs(name string) []ent.Value {
+func (m *BackchannelAuthRequestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConnectorMutation) ClearedEdges() []string {
+func (m *BackchannelAuthRequestMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConnectorMutation) EdgeCleared(name string) bool {
+func (m *BackchannelAuthRequestMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConnectorMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Connector unique edge %s", name)
+func (m *BackchannelAuthRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BackchannelAuthRequest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConnectorMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Connector edge %s", name)
+func (m *BackchannelAuthRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BackchannelAuthRequest edge %s", name)
 }
 
-// DeviceRequestMutation represents an operation that mutates the DeviceRequest nodes in the graph.
-type DeviceRequestMutation struct {
+// ConnectorMutation represents an operation that mutates the Connector nodes in the graph.
+type ConnectorMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	user_code     *string
-	device_code   *string
-	client_id     *string
-	client_secret *string
-	scopes        *[]string
-	expiry        *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*DeviceRequest, error)
-	predicates    []predicate.DeviceRequest
+	op               Op
+	typ              string
+	id               *string
+	_type            *string
+	name             *string
+	resource_version *string
+	_config          *[]byte
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Connector, error)
+	predicates       []predicate.Connector
 }
 
-var _ ent.Mutation = (*DeviceRequestMutation)(nil)
+var _ ent.Mutation = (*ConnectorMutation)(nil)
 
-// devicerequestOption allows management of the mutation configuration using functional options.
-type devicerequestOption func(*DeviceRequestMutation)
+// connectorOption allows management of the mutation configuration using functional options.
+type connectorOption func(*ConnectorMutation)
 
-// newDeviceRequestMutation creates new mutation for the DeviceRequest entity.
-func newDeviceRequestMutation(c config, op Op, opts ...devicerequestOption) *DeviceRequestMutation {
-	m := &DeviceRequestMutation{
+// newConnectorMutation creates new mutation for the Connector entity.
+func newConnectorMutation(c config, op Op, opts ...connectorOption) *ConnectorMutation {
+	m := &ConnectorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeviceRequest,
+		typ:           TypeConnector,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3064,20 +3699,20 @@ func newDeviceRequestMutation(c config, op Op, opts ...devicerequestOption) *Dev
 	return m
 }
 
-// withDeviceRequestID sets the ID field of the mutation.
-func withDeviceRequestID(id int) devicerequestOption {
-	return func(m *DeviceRequestMutation) {
+// withConnectorID sets the ID field of the mutation.
+func withConnectorID(id string) connectorOption {
+	return func(m *ConnectorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeviceRequest
+			value *Connector
 		)
-		m.oldValue = func(ctx context.Context) (*DeviceRequest, error) {
+		m.oldValue = func(ctx context.Context) (*Connector, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeviceRequest.Get(ctx, id)
+					value, err = m.Client().Connector.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3086,10 +3721,10 @@ func withDeviceRequestID(id int) devicerequestOption {
 	}
 }
 
-// withDeviceRequest sets the old DeviceRequest of the mutation.
-func withDeviceRequest(node *DeviceRequest) devicerequestOption {
-	return func(m *DeviceRequestMutation) {
-		m.oldValue = func(context.Context) (*DeviceRequest, error) {
+// withConnector sets the old Connector of the mutation.
+func withConnector(node *Connector) connectorOption {
+	return func(m *ConnectorMutation) {
+		m.oldValue = func(context.Context) (*Connector, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3098,7 +3733,7 @@ func withDeviceRequest(node *DeviceRequest) devicerequestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceRequestMutation) Client() *Client {
+func (m ConnectorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3106,7 +3741,7 @@ func (m DeviceRequestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeviceRequestMutation) Tx() (*Tx, error) {
+func (m ConnectorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -3115,9 +3750,15 @@ func (m DeviceRequestMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Connector entities.
+func (m *ConnectorMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeviceRequestMutation) ID() (id int, exists bool) {
+func (m *ConnectorMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3128,287 +3769,196 @@ func (m *DeviceRequestMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeviceRequestMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConnectorMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeviceRequest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Connector.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserCode sets the "user_code" field.
-func (m *DeviceRequestMutation) SetUserCode(s string) {
-	m.user_code = &s
+// SetType sets the "type" field.
+func (m *ConnectorMutation) SetType(s string) {
+	m._type = &s
 }
 
-// UserCode returns the value of the "user_code" field in the mutation.
-func (m *DeviceRequestMutation) UserCode() (r string, exists bool) {
-	v := m.user_code
+// GetType returns the value of the "type" field in the mutation.
+func (m *ConnectorMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserCode returns the old "user_code" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Connector entity.
+// If the Connector object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldUserCode(ctx context.Context) (v string, err error) {
+func (m *ConnectorMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserCode requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.UserCode, nil
+	return oldValue.Type, nil
 }
 
-// ResetUserCode resets all changes to the "user_code" field.
-func (m *DeviceRequestMutation) ResetUserCode() {
-	m.user_code = nil
+// ResetType resets all changes to the "type" field.
+func (m *ConnectorMutation) ResetType() {
+	m._type = nil
 }
 
-// SetDeviceCode sets the "device_code" field.
-func (m *DeviceRequestMutation) SetDeviceCode(s string) {
-	m.device_code = &s
+// SetName sets the "name" field.
+func (m *ConnectorMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DeviceCode returns the value of the "device_code" field in the mutation.
-func (m *DeviceRequestMutation) DeviceCode() (r string, exists bool) {
-	v := m.device_code
+// Name returns the value of the "name" field in the mutation.
+func (m *ConnectorMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceCode returns the old "device_code" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Connector entity.
+// If the Connector object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
+func (m *ConnectorMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.DeviceCode, nil
+	return oldValue.Name, nil
 }
 
-// ResetDeviceCode resets all changes to the "device_code" field.
-func (m *DeviceRequestMutation) ResetDeviceCode() {
-	m.device_code = nil
+// ResetName resets all changes to the "name" field.
+func (m *ConnectorMutation) ResetName() {
+	m.name = nil
 }
 
-// SetClientID sets the "client_id" field.
-func (m *DeviceRequestMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetResourceVersion sets the "resource_version" field.
+func (m *ConnectorMutation) SetResourceVersion(s string) {
+	m.resource_version = &s
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *DeviceRequestMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// ResourceVersion returns the value of the "resource_version" field in the mutation.
+func (m *ConnectorMutation) ResourceVersion() (r string, exists bool) {
+	v := m.resource_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceVersion returns the old "resource_version" field's value of the Connector entity.
+// If the Connector object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *ConnectorMutation) OldResourceVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldResourceVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceVersion: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.ResourceVersion, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *DeviceRequestMutation) ResetClientID() {
-	m.client_id = nil
+// ResetResourceVersion resets all changes to the "resource_version" field.
+func (m *ConnectorMutation) ResetResourceVersion() {
+	m.resource_version = nil
 }
 
-// SetClientSecret sets the "client_secret" field.
-func (m *DeviceRequestMutation) SetClientSecret(s string) {
-	m.client_secret = &s
+// SetConfig sets the "config" field.
+func (m *ConnectorMutation) SetConfig(b []byte) {
+	m._config = &b
 }
 
-// ClientSecret returns the value of the "client_secret" field in the mutation.
-func (m *DeviceRequestMutation) ClientSecret() (r string, exists bool) {
-	v := m.client_secret
+// Config returns the value of the "config" field in the mutation.
+func (m *ConnectorMutation) Config() (r []byte, exists bool) {
+	v := m._config
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientSecret returns the old "client_secret" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
+// OldConfig returns the old "config" field's value of the Connector entity.
+// If the Connector object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+func (m *ConnectorMutation) OldConfig(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+		return v, errors.New("OldConfig requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
 	}
-	return oldValue.ClientSecret, nil
+	return oldValue.Config, nil
 }
 
-// ResetClientSecret resets all changes to the "client_secret" field.
-func (m *DeviceRequestMutation) ResetClientSecret() {
-	m.client_secret = nil
+// ResetConfig resets all changes to the "config" field.
+func (m *ConnectorMutation) ResetConfig() {
+	m._config = nil
 }
 
-// SetScopes sets the "scopes" field.
-func (m *DeviceRequestMutation) SetScopes(s []string) {
-	m.scopes = &s
+// Where appends a list predicates to the ConnectorMutation builder.
+func (m *ConnectorMutation) Where(ps ...predicate.Connector) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *DeviceRequestMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
-	if v == nil {
-		return
-	}
-	return *v, true
+// Op returns the operation name.
+func (m *ConnectorMutation) Op() Op {
+	return m.op
 }
 
-// OldScopes returns the old "scopes" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldScopes(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
-	}
-	return oldValue.Scopes, nil
-}
-
-// ClearScopes clears the value of the "scopes" field.
-func (m *DeviceRequestMutation) ClearScopes() {
-	m.scopes = nil
-	m.clearedFields[devicerequest.FieldScopes] = struct{}{}
-}
-
-// ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *DeviceRequestMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[devicerequest.FieldScopes]
-	return ok
-}
-
-// ResetScopes resets all changes to the "scopes" field.
-func (m *DeviceRequestMutation) ResetScopes() {
-	m.scopes = nil
-	delete(m.clearedFields, devicerequest.FieldScopes)
-}
-
-// SetExpiry sets the "expiry" field.
-func (m *DeviceRequestMutation) SetExpiry(t time.Time) {
-	m.expiry = &t
-}
-
-// Expiry returns the value of the "expiry" field in the mutation.
-func (m *DeviceRequestMutation) Expiry() (r time.Time, exists bool) {
-	v := m.expiry
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldExpiry returns the old "expiry" field's value of the DeviceRequest entity.
-// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiry requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
-	}
-	return oldValue.Expiry, nil
-}
-
-// ResetExpiry resets all changes to the "expiry" field.
-func (m *DeviceRequestMutation) ResetExpiry() {
-	m.expiry = nil
-}
-
-// Where appends a list predicates to the DeviceRequestMutation builder.
-func (m *DeviceRequestMutation) Where(ps ...predicate.DeviceRequest) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// Op returns the operation name.
-func (m *DeviceRequestMutation) Op() Op {
-	return m.op
-}
-
-// Type returns the node type of this mutation (DeviceRequest).
-func (m *DeviceRequestMutation) Type() string {
+// Type returns the node type of this mutation (Connector).
+func (m *ConnectorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceRequestMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.user_code != nil {
-		fields = append(fields, devicerequest.FieldUserCode)
-	}
-	if m.device_code != nil {
-		fields = append(fields, devicerequest.FieldDeviceCode)
-	}
-	if m.client_id != nil {
-		fields = append(fields, devicerequest.FieldClientID)
+func (m *ConnectorMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m._type != nil {
+		fields = append(fields, connector.FieldType)
 	}
-	if m.client_secret != nil {
-		fields = append(fields, devicerequest.FieldClientSecret)
+	if m.name != nil {
+		fields = append(fields, connector.FieldName)
 	}
-	if m.scopes != nil {
-		fields = append(fields, devicerequest.FieldScopes)
+	if m.resource_version != nil {
+		fields = append(fields, connector.FieldResourceVersion)
 	}
-	if m.expiry != nil {
-		fields = append(fields, devicerequest.FieldExpiry)
+	if m._config != nil {
+		fields = append(fields, connector.FieldConfig)
 	}
 	return fields
 }
@@ -3416,20 +3966,16 @@ func (m *DeviceRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *ConnectorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case devicerequest.FieldUserCode:
-		return m.UserCode()
-	case devicerequest.FieldDeviceCode:
-		return m.DeviceCode()
-	case devicerequest.FieldClientID:
-		return m.ClientID()
-	case devicerequest.FieldClientSecret:
-		return m.ClientSecret()
-	case devicerequest.FieldScopes:
-		return m.Scopes()
-	case devicerequest.FieldExpiry:
-		return m.Expiry()
+	case connector.FieldType:
+		return m.GetType()
+	case connector.FieldName:
+		return m.Name()
+	case connector.FieldResourceVersion:
+		return m.ResourceVersion()
+	case connector.FieldConfig:
+		return m.Config()
 	}
 	return nil, false
 }
@@ -3437,229 +3983,195 @@ func (m *DeviceRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConnectorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case devicerequest.FieldUserCode:
-		return m.OldUserCode(ctx)
-	case devicerequest.FieldDeviceCode:
-		return m.OldDeviceCode(ctx)
-	case devicerequest.FieldClientID:
-		return m.OldClientID(ctx)
-	case devicerequest.FieldClientSecret:
-		return m.OldClientSecret(ctx)
-	case devicerequest.FieldScopes:
-		return m.OldScopes(ctx)
-	case devicerequest.FieldExpiry:
-		return m.OldExpiry(ctx)
+	case connector.FieldType:
+		return m.OldType(ctx)
+	case connector.FieldName:
+		return m.OldName(ctx)
+	case connector.FieldResourceVersion:
+		return m.OldResourceVersion(ctx)
+	case connector.FieldConfig:
+		return m.OldConfig(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeviceRequest field %s", name)
+	return nil, fmt.Errorf("unknown Connector field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceRequestMutation) SetField(name string, value ent.Value) error {
+func (m *ConnectorMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case devicerequest.FieldUserCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserCode(v)
-		return nil
-	case devicerequest.FieldDeviceCode:
+	case connector.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceCode(v)
+		m.SetType(v)
 		return nil
-	case devicerequest.FieldClientID:
+	case connector.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetName(v)
 		return nil
-	case devicerequest.FieldClientSecret:
+	case connector.FieldResourceVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientSecret(v)
-		return nil
-	case devicerequest.FieldScopes:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScopes(v)
+		m.SetResourceVersion(v)
 		return nil
-	case devicerequest.FieldExpiry:
-		v, ok := value.(time.Time)
+	case connector.FieldConfig:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiry(v)
+		m.SetConfig(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceRequest field %s", name)
+	return fmt.Errorf("unknown Connector field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceRequestMutation) AddedFields() []string {
+func (m *ConnectorMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConnectorMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceRequestMutation) AddField(name string, value ent.Value) error {
+func (m *ConnectorMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DeviceRequest numeric field %s", name)
+	return fmt.Errorf("unknown Connector numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceRequestMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(devicerequest.FieldScopes) {
-		fields = append(fields, devicerequest.FieldScopes)
-	}
-	return fields
+func (m *ConnectorMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceRequestMutation) FieldCleared(name string) bool {
+func (m *ConnectorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceRequestMutation) ClearField(name string) error {
-	switch name {
-	case devicerequest.FieldScopes:
-		m.ClearScopes()
-		return nil
-	}
-	return fmt.Errorf("unknown DeviceRequest nullable field %s", name)
+func (m *ConnectorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Connector nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceRequestMutation) ResetField(name string) error {
+func (m *ConnectorMutation) ResetField(name string) error {
 	switch name {
-	case devicerequest.FieldUserCode:
-		m.ResetUserCode()
-		return nil
-	case devicerequest.FieldDeviceCode:
-		m.ResetDeviceCode()
-		return nil
-	case devicerequest.FieldClientID:
-		m.ResetClientID()
+	case connector.FieldType:
+		m.ResetType()
 		return nil
-	case devicerequest.FieldClientSecret:
-		m.ResetClientSecret()
+	case connector.FieldName:
+		m.ResetName()
 		return nil
-	case devicerequest.FieldScopes:
-		m.ResetScopes()
+	case connector.FieldResourceVersion:
+		m.ResetResourceVersion()
 		return nil
-	case devicerequest.FieldExpiry:
-		m.ResetExpiry()
+	case connector.FieldConfig:
+		m.ResetConfig()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceRequest field %s", name)
+	return fmt.Errorf("unknown Connector field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceRequestMutation) AddedEdges() []string {
+func (m *ConnectorMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceRequestMutation) AddedIDs(name string) []ent.Value {
+func (m *ConnectorMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceRequestMutation) RemovedEdges() []string {
+func (m *ConnectorMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceRequestMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConnectorMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceRequestMutation) ClearedEdges() []string {
+func (m *ConnectorMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceRequestMutation) EdgeCleared(name string) bool {
+func (m *ConnectorMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceRequestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DeviceRequest unique edge %s", name)
+func (m *ConnectorMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Connector unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceRequestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DeviceRequest edge %s", name)
+func (m *ConnectorMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Connector edge %s", name)
 }
 
-// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
-type DeviceTokenMutation struct {
+// DeviceRequestMutation represents an operation that mutates the DeviceRequest nodes in the graph.
+type DeviceRequestMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	device_code      *string
-	status           *string
-	token            *[]byte
-	expiry           *time.Time
-	last_request     *time.Time
-	poll_interval    *int
-	addpoll_interval *int
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*DeviceToken, error)
-	predicates       []predicate.DeviceToken
+	op            Op
+	typ           string
+	id            *int
+	user_code     *string
+	device_code   *string
+	client_id     *string
+	client_secret *string
+	scopes        *[]string
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeviceRequest, error)
+	predicates    []predicate.DeviceRequest
 }
 
-var _ ent.Mutation = (*DeviceTokenMutation)(nil)
+var _ ent.Mutation = (*DeviceRequestMutation)(nil)
 
-// devicetokenOption allows management of the mutation configuration using functional options.
-type devicetokenOption func(*DeviceTokenMutation)
+// devicerequestOption allows management of the mutation configuration using functional options.
+type devicerequestOption func(*DeviceRequestMutation)
 
-// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
-func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
-	m := &DeviceTokenMutation{
+// newDeviceRequestMutation creates new mutation for the DeviceRequest entity.
+func newDeviceRequestMutation(c config, op Op, opts ...devicerequestOption) *DeviceRequestMutation {
+	m := &DeviceRequestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeviceToken,
+		typ:           TypeDeviceRequest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3668,20 +4180,20 @@ func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceT
 	return m
 }
 
-// withDeviceTokenID sets the ID field of the mutation.
-func withDeviceTokenID(id int) devicetokenOption {
-	return func(m *DeviceTokenMutation) {
+// withDeviceRequestID sets the ID field of the mutation.
+func withDeviceRequestID(id int) devicerequestOption {
+	return func(m *DeviceRequestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeviceToken
+			value *DeviceRequest
 		)
-		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
+		m.oldValue = func(ctx context.Context) (*DeviceRequest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeviceToken.Get(ctx, id)
+					value, err = m.Client().DeviceRequest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3690,10 +4202,10 @@ func withDeviceTokenID(id int) devicetokenOption {
 	}
 }
 
-// withDeviceToken sets the old DeviceToken of the mutation.
-func withDeviceToken(node *DeviceToken) devicetokenOption {
-	return func(m *DeviceTokenMutation) {
-		m.oldValue = func(context.Context) (*DeviceToken, error) {
+// withDeviceRequest sets the old DeviceRequest of the mutation.
+func withDeviceRequest(node *DeviceRequest) devicerequestOption {
+	return func(m *DeviceRequestMutation) {
+		m.oldValue = func(context.Context) (*DeviceRequest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3702,7 +4214,7 @@ func withDeviceToken(node *DeviceToken) devicetokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceTokenMutation) Client() *Client {
+func (m DeviceRequestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3710,7 +4222,7 @@ func (m DeviceTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeviceTokenMutation) Tx() (*Tx, error) {
+func (m DeviceRequestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -3721,7 +4233,7 @@ func (m DeviceTokenMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeviceTokenMutation) ID() (id int, exists bool) {
+func (m *DeviceRequestMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3732,7 +4244,7 @@ func (m *DeviceTokenMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeviceRequestMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3741,298 +4253,278 @@ func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeviceRequest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDeviceCode sets the "device_code" field.
-func (m *DeviceTokenMutation) SetDeviceCode(s string) {
-	m.device_code = &s
+// SetUserCode sets the "user_code" field.
+func (m *DeviceRequestMutation) SetUserCode(s string) {
+	m.user_code = &s
 }
 
-// DeviceCode returns the value of the "device_code" field in the mutation.
-func (m *DeviceTokenMutation) DeviceCode() (r string, exists bool) {
-	v := m.device_code
+// UserCode returns the value of the "user_code" field in the mutation.
+func (m *DeviceRequestMutation) UserCode() (r string, exists bool) {
+	v := m.user_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceCode returns the old "device_code" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUserCode returns the old "user_code" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
+func (m *DeviceRequestMutation) OldUserCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
+		return v, errors.New("OldUserCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserCode: %w", err)
 	}
-	return oldValue.DeviceCode, nil
+	return oldValue.UserCode, nil
 }
 
-// ResetDeviceCode resets all changes to the "device_code" field.
-func (m *DeviceTokenMutation) ResetDeviceCode() {
-	m.device_code = nil
+// ResetUserCode resets all changes to the "user_code" field.
+func (m *DeviceRequestMutation) ResetUserCode() {
+	m.user_code = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *DeviceTokenMutation) SetStatus(s string) {
-	m.status = &s
+// SetDeviceCode sets the "device_code" field.
+func (m *DeviceRequestMutation) SetDeviceCode(s string) {
+	m.device_code = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DeviceTokenMutation) Status() (r string, exists bool) {
-	v := m.status
+// DeviceCode returns the value of the "device_code" field in the mutation.
+func (m *DeviceRequestMutation) DeviceCode() (r string, exists bool) {
+	v := m.device_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceCode returns the old "device_code" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *DeviceRequestMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.DeviceCode, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DeviceTokenMutation) ResetStatus() {
-	m.status = nil
+// ResetDeviceCode resets all changes to the "device_code" field.
+func (m *DeviceRequestMutation) ResetDeviceCode() {
+	m.device_code = nil
 }
 
-// SetToken sets the "token" field.
-func (m *DeviceTokenMutation) SetToken(b []byte) {
-	m.token = &b
+// SetClientID sets the "client_id" field.
+func (m *DeviceRequestMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *DeviceTokenMutation) Token() (r []byte, exists bool) {
-	v := m.token
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *DeviceRequestMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldToken(ctx context.Context) (v *[]byte, err error) {
+func (m *DeviceRequestMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.Token, nil
-}
-
-// ClearToken clears the value of the "token" field.
-func (m *DeviceTokenMutation) ClearToken() {
-	m.token = nil
-	m.clearedFields[devicetoken.FieldToken] = struct{}{}
-}
-
-// TokenCleared returns if the "token" field was cleared in this mutation.
-func (m *DeviceTokenMutation) TokenCleared() bool {
-	_, ok := m.clearedFields[devicetoken.FieldToken]
-	return ok
+	return oldValue.ClientID, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *DeviceTokenMutation) ResetToken() {
-	m.token = nil
-	delete(m.clearedFields, devicetoken.FieldToken)
+// ResetClientID resets all changes to the "client_id" field.
+func (m *DeviceRequestMutation) ResetClientID() {
+	m.client_id = nil
 }
 
-// SetExpiry sets the "expiry" field.
-func (m *DeviceTokenMutation) SetExpiry(t time.Time) {
-	m.expiry = &t
+// SetClientSecret sets the "client_secret" field.
+func (m *DeviceRequestMutation) SetClientSecret(s string) {
+	m.client_secret = &s
 }
 
-// Expiry returns the value of the "expiry" field in the mutation.
-func (m *DeviceTokenMutation) Expiry() (r time.Time, exists bool) {
-	v := m.expiry
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *DeviceRequestMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiry returns the old "expiry" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClientSecret returns the old "client_secret" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceRequestMutation) OldClientSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiry requires an ID field in the mutation")
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
 	}
-	return oldValue.Expiry, nil
+	return oldValue.ClientSecret, nil
 }
 
-// ResetExpiry resets all changes to the "expiry" field.
-func (m *DeviceTokenMutation) ResetExpiry() {
-	m.expiry = nil
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *DeviceRequestMutation) ResetClientSecret() {
+	m.client_secret = nil
 }
 
-// SetLastRequest sets the "last_request" field.
-func (m *DeviceTokenMutation) SetLastRequest(t time.Time) {
-	m.last_request = &t
+// SetScopes sets the "scopes" field.
+func (m *DeviceRequestMutation) SetScopes(s []string) {
+	m.scopes = &s
 }
 
-// LastRequest returns the value of the "last_request" field in the mutation.
-func (m *DeviceTokenMutation) LastRequest() (r time.Time, exists bool) {
-	v := m.last_request
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *DeviceRequestMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastRequest returns the old "last_request" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldLastRequest(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceRequestMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastRequest is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastRequest requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastRequest: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.LastRequest, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetLastRequest resets all changes to the "last_request" field.
-func (m *DeviceTokenMutation) ResetLastRequest() {
-	m.last_request = nil
-}
+// ClearScopes clears the value of the "scopes" field.
+func (m *DeviceRequestMutation) ClearScopes() {
+	m.scopes = nil
+	m.clearedFields[devicerequest.FieldScopes] = struct{}{}
+}
 
-// SetPollInterval sets the "poll_interval" field.
-func (m *DeviceTokenMutation) SetPollInterval(i int) {
-	m.poll_interval = &i
-	m.addpoll_interval = nil
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *DeviceRequestMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[devicerequest.FieldScopes]
+	return ok
 }
 
-// PollInterval returns the value of the "poll_interval" field in the mutation.
-func (m *DeviceTokenMutation) PollInterval() (r int, exists bool) {
-	v := m.poll_interval
+// ResetScopes resets all changes to the "scopes" field.
+func (m *DeviceRequestMutation) ResetScopes() {
+	m.scopes = nil
+	delete(m.clearedFields, devicerequest.FieldScopes)
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *DeviceRequestMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *DeviceRequestMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPollInterval returns the old "poll_interval" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiry returns the old "expiry" field's value of the DeviceRequest entity.
+// If the DeviceRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldPollInterval(ctx context.Context) (v int, err error) {
+func (m *DeviceRequestMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPollInterval is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPollInterval requires an ID field in the mutation")
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPollInterval: %w", err)
-	}
-	return oldValue.PollInterval, nil
-}
-
-// AddPollInterval adds i to the "poll_interval" field.
-func (m *DeviceTokenMutation) AddPollInterval(i int) {
-	if m.addpoll_interval != nil {
-		*m.addpoll_interval += i
-	} else {
-		m.addpoll_interval = &i
-	}
-}
-
-// AddedPollInterval returns the value that was added to the "poll_interval" field in this mutation.
-func (m *DeviceTokenMutation) AddedPollInterval() (r int, exists bool) {
-	v := m.addpoll_interval
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
 	}
-	return *v, true
+	return oldValue.Expiry, nil
 }
 
-// ResetPollInterval resets all changes to the "poll_interval" field.
-func (m *DeviceTokenMutation) ResetPollInterval() {
-	m.poll_interval = nil
-	m.addpoll_interval = nil
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *DeviceRequestMutation) ResetExpiry() {
+	m.expiry = nil
 }
 
-// Where appends a list predicates to the DeviceTokenMutation builder.
-func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
+// Where appends a list predicates to the DeviceRequestMutation builder.
+func (m *DeviceRequestMutation) Where(ps ...predicate.DeviceRequest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *DeviceTokenMutation) Op() Op {
+func (m *DeviceRequestMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (DeviceToken).
-func (m *DeviceTokenMutation) Type() string {
+// Type returns the node type of this mutation (DeviceRequest).
+func (m *DeviceRequestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceTokenMutation) Fields() []string {
+func (m *DeviceRequestMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.device_code != nil {
-		fields = append(fields, devicetoken.FieldDeviceCode)
+	if m.user_code != nil {
+		fields = append(fields, devicerequest.FieldUserCode)
 	}
-	if m.status != nil {
-		fields = append(fields, devicetoken.FieldStatus)
+	if m.device_code != nil {
+		fields = append(fields, devicerequest.FieldDeviceCode)
 	}
-	if m.token != nil {
-		fields = append(fields, devicetoken.FieldToken)
+	if m.client_id != nil {
+		fields = append(fields, devicerequest.FieldClientID)
 	}
-	if m.expiry != nil {
-		fields = append(fields, devicetoken.FieldExpiry)
+	if m.client_secret != nil {
+		fields = append(fields, devicerequest.FieldClientSecret)
 	}
-	if m.last_request != nil {
-		fields = append(fields, devicetoken.FieldLastRequest)
+	if m.scopes != nil {
+		fields = append(fields, devicerequest.FieldScopes)
 	}
-	if m.poll_interval != nil {
-		fields = append(fields, devicetoken.FieldPollInterval)
+	if m.expiry != nil {
+		fields = append(fields, devicerequest.FieldExpiry)
 	}
 	return fields
 }
@@ -4040,20 +4532,20 @@ func (m *DeviceTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *DeviceRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case devicetoken.FieldDeviceCode:
+	case devicerequest.FieldUserCode:
+		return m.UserCode()
+	case devicerequest.FieldDeviceCode:
 		return m.DeviceCode()
-	case devicetoken.FieldStatus:
-		return m.Status()
-	case devicetoken.FieldToken:
-		return m.Token()
-	case devicetoken.FieldExpiry:
+	case devicerequest.FieldClientID:
+		return m.ClientID()
+	case devicerequest.FieldClientSecret:
+		return m.ClientSecret()
+	case devicerequest.FieldScopes:
+		return m.Scopes()
+	case devicerequest.FieldExpiry:
 		return m.Expiry()
-	case devicetoken.FieldLastRequest:
-		return m.LastRequest()
-	case devicetoken.FieldPollInterval:
-		return m.PollInterval()
 	}
 	return nil, false
 }
@@ -4061,241 +4553,229 @@ func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeviceRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case devicetoken.FieldDeviceCode:
+	case devicerequest.FieldUserCode:
+		return m.OldUserCode(ctx)
+	case devicerequest.FieldDeviceCode:
 		return m.OldDeviceCode(ctx)
-	case devicetoken.FieldStatus:
-		return m.OldStatus(ctx)
-	case devicetoken.FieldToken:
-		return m.OldToken(ctx)
-	case devicetoken.FieldExpiry:
+	case devicerequest.FieldClientID:
+		return m.OldClientID(ctx)
+	case devicerequest.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case devicerequest.FieldScopes:
+		return m.OldScopes(ctx)
+	case devicerequest.FieldExpiry:
 		return m.OldExpiry(ctx)
-	case devicetoken.FieldLastRequest:
-		return m.OldLastRequest(ctx)
-	case devicetoken.FieldPollInterval:
-		return m.OldPollInterval(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
+	return nil, fmt.Errorf("unknown DeviceRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
+func (m *DeviceRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case devicetoken.FieldDeviceCode:
+	case devicerequest.FieldUserCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceCode(v)
+		m.SetUserCode(v)
 		return nil
-	case devicetoken.FieldStatus:
+	case devicerequest.FieldDeviceCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetDeviceCode(v)
 		return nil
-	case devicetoken.FieldToken:
-		v, ok := value.([]byte)
+	case devicerequest.FieldClientID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetClientID(v)
 		return nil
-	case devicetoken.FieldExpiry:
-		v, ok := value.(time.Time)
+	case devicerequest.FieldClientSecret:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiry(v)
+		m.SetClientSecret(v)
 		return nil
-	case devicetoken.FieldLastRequest:
-		v, ok := value.(time.Time)
+	case devicerequest.FieldScopes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastRequest(v)
+		m.SetScopes(v)
 		return nil
-	case devicetoken.FieldPollInterval:
-		v, ok := value.(int)
+	case devicerequest.FieldExpiry:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPollInterval(v)
+		m.SetExpiry(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken field %s", name)
+	return fmt.Errorf("unknown DeviceRequest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceTokenMutation) AddedFields() []string {
-	var fields []string
-	if m.addpoll_interval != nil {
-		fields = append(fields, devicetoken.FieldPollInterval)
-	}
-	return fields
+func (m *DeviceRequestMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case devicetoken.FieldPollInterval:
-		return m.AddedPollInterval()
-	}
+func (m *DeviceRequestMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
+func (m *DeviceRequestMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case devicetoken.FieldPollInterval:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPollInterval(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
+	return fmt.Errorf("unknown DeviceRequest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceTokenMutation) ClearedFields() []string {
+func (m *DeviceRequestMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(devicetoken.FieldToken) {
-		fields = append(fields, devicetoken.FieldToken)
+	if m.FieldCleared(devicerequest.FieldScopes) {
+		fields = append(fields, devicerequest.FieldScopes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceTokenMutation) FieldCleared(name string) bool {
+func (m *DeviceRequestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceTokenMutation) ClearField(name string) error {
+func (m *DeviceRequestMutation) ClearField(name string) error {
 	switch name {
-	case devicetoken.FieldToken:
-		m.ClearToken()
+	case devicerequest.FieldScopes:
+		m.ClearScopes()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
+	return fmt.Errorf("unknown DeviceRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceTokenMutation) ResetField(name string) error {
+func (m *DeviceRequestMutation) ResetField(name string) error {
 	switch name {
-	case devicetoken.FieldDeviceCode:
-		m.ResetDeviceCode()
+	case devicerequest.FieldUserCode:
+		m.ResetUserCode()
 		return nil
-	case devicetoken.FieldStatus:
-		m.ResetStatus()
+	case devicerequest.FieldDeviceCode:
+		m.ResetDeviceCode()
 		return nil
-	case devicetoken.FieldToken:
-		m.ResetToken()
+	case devicerequest.FieldClientID:
+		m.ResetClientID()
 		return nil
-	case devicetoken.FieldExpiry:
-		m.ResetExpiry()
+	case devicerequest.FieldClientSecret:
+		m.ResetClientSecret()
 		return nil
-	case devicetoken.FieldLastRequest:
-		m.ResetLastRequest()
+	case devicerequest.FieldScopes:
+		m.ResetScopes()
 		return nil
-	case devicetoken.FieldPollInterval:
-		m.ResetPollInterval()
+	case devicerequest.FieldExpiry:
+		m.ResetExpiry()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken field %s", name)
+	return fmt.Errorf("unknown DeviceRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceTokenMutation) AddedEdges() []string {
+func (m *DeviceRequestMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *DeviceRequestMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceTokenMutation) RemovedEdges() []string {
+func (m *DeviceRequestMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *DeviceRequestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceTokenMutation) ClearedEdges() []string {
+func (m *DeviceRequestMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
+func (m *DeviceRequestMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceTokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
+func (m *DeviceRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceRequest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceTokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DeviceToken edge %s", name)
+func (m *DeviceRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceRequest edge %s", name)
 }
 
-// KeysMutation represents an operation that mutates the Keys nodes in the graph.
-type KeysMutation struct {
+// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
+type DeviceTokenMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	verification_keys *[]storage.VerificationKey
-	signing_key       *jose.JSONWebKey
-	signing_key_pub   *jose.JSONWebKey
-	next_rotation     *time.Time
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*Keys, error)
-	predicates        []predicate.Keys
+	op               Op
+	typ              string
+	id               *int
+	device_code      *string
+	status           *string
+	token            *[]byte
+	expiry           *time.Time
+	last_request     *time.Time
+	poll_interval    *int
+	addpoll_interval *int
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*DeviceToken, error)
+	predicates       []predicate.DeviceToken
 }
 
-var _ ent.Mutation = (*KeysMutation)(nil)
+var _ ent.Mutation = (*DeviceTokenMutation)(nil)
 
-// keysOption allows management of the mutation configuration using functional options.
-type keysOption func(*KeysMutation)
+// devicetokenOption allows management of the mutation configuration using functional options.
+type devicetokenOption func(*DeviceTokenMutation)
 
-// newKeysMutation creates new mutation for the Keys entity.
-func newKeysMutation(c config, op Op, opts ...keysOption) *KeysMutation {
-	m := &KeysMutation{
+// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
+func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
+	m := &DeviceTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeKeys,
+		typ:           TypeDeviceToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4304,20 +4784,20 @@ func newKeysMutation(c config, op Op, opts ...keysOption) *KeysMutation {
 	return m
 }
 
-// withKeysID sets the ID field of the mutation.
-func withKeysID(id string) keysOption {
-	return func(m *KeysMutation) {
+// withDeviceTokenID sets the ID field of the mutation.
+func withDeviceTokenID(id int) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Keys
+			value *DeviceToken
 		)
-		m.oldValue = func(ctx context.Context) (*Keys, error) {
+		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Keys.Get(ctx, id)
+					value, err = m.Client().DeviceToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4326,10 +4806,10 @@ func withKeysID(id string) keysOption {
 	}
 }
 
-// withKeys sets the old Keys of the mutation.
-func withKeys(node *Keys) keysOption {
-	return func(m *KeysMutation) {
-		m.oldValue = func(context.Context) (*Keys, error) {
+// withDeviceToken sets the old DeviceToken of the mutation.
+func withDeviceToken(node *DeviceToken) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
+		m.oldValue = func(context.Context) (*DeviceToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4338,7 +4818,7 @@ func withKeys(node *Keys) keysOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m KeysMutation) Client() *Client {
+func (m DeviceTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4346,7 +4826,7 @@ func (m KeysMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m KeysMutation) Tx() (*Tx, error) {
+func (m DeviceTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -4355,15 +4835,9 @@ func (m KeysMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Keys entities.
-func (m *KeysMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *KeysMutation) ID() (id string, exists bool) {
+func (m *DeviceTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4374,196 +4848,307 @@ func (m *KeysMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *KeysMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Keys.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetVerificationKeys sets the "verification_keys" field.
-func (m *KeysMutation) SetVerificationKeys(sk []storage.VerificationKey) {
-	m.verification_keys = &sk
+// SetDeviceCode sets the "device_code" field.
+func (m *DeviceTokenMutation) SetDeviceCode(s string) {
+	m.device_code = &s
 }
 
-// VerificationKeys returns the value of the "verification_keys" field in the mutation.
-func (m *KeysMutation) VerificationKeys() (r []storage.VerificationKey, exists bool) {
-	v := m.verification_keys
+// DeviceCode returns the value of the "device_code" field in the mutation.
+func (m *DeviceTokenMutation) DeviceCode() (r string, exists bool) {
+	v := m.device_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVerificationKeys returns the old "verification_keys" field's value of the Keys entity.
-// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceCode returns the old "device_code" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeysMutation) OldVerificationKeys(ctx context.Context) (v []storage.VerificationKey, err error) {
+func (m *DeviceTokenMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerificationKeys is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerificationKeys requires an ID field in the mutation")
+		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerificationKeys: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
 	}
-	return oldValue.VerificationKeys, nil
+	return oldValue.DeviceCode, nil
 }
 
-// ResetVerificationKeys resets all changes to the "verification_keys" field.
-func (m *KeysMutation) ResetVerificationKeys() {
-	m.verification_keys = nil
+// ResetDeviceCode resets all changes to the "device_code" field.
+func (m *DeviceTokenMutation) ResetDeviceCode() {
+	m.device_code = nil
 }
 
-// SetSigningKey sets the "signing_key" field.
-func (m *KeysMutation) SetSigningKey(jwk jose.JSONWebKey) {
-	m.signing_key = &jwk
+// SetStatus sets the "status" field.
+func (m *DeviceTokenMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// SigningKey returns the value of the "signing_key" field in the mutation.
-func (m *KeysMutation) SigningKey() (r jose.JSONWebKey, exists bool) {
-	v := m.signing_key
+// Status returns the value of the "status" field in the mutation.
+func (m *DeviceTokenMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSigningKey returns the old "signing_key" field's value of the Keys entity.
-// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeysMutation) OldSigningKey(ctx context.Context) (v jose.JSONWebKey, err error) {
+func (m *DeviceTokenMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSigningKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSigningKey requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSigningKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.SigningKey, nil
+	return oldValue.Status, nil
 }
 
-// ResetSigningKey resets all changes to the "signing_key" field.
-func (m *KeysMutation) ResetSigningKey() {
-	m.signing_key = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *DeviceTokenMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetSigningKeyPub sets the "signing_key_pub" field.
-func (m *KeysMutation) SetSigningKeyPub(jwk jose.JSONWebKey) {
-	m.signing_key_pub = &jwk
+// SetToken sets the "token" field.
+func (m *DeviceTokenMutation) SetToken(b []byte) {
+	m.token = &b
 }
 
-// SigningKeyPub returns the value of the "signing_key_pub" field in the mutation.
-func (m *KeysMutation) SigningKeyPub() (r jose.JSONWebKey, exists bool) {
-	v := m.signing_key_pub
+// Token returns the value of the "token" field in the mutation.
+func (m *DeviceTokenMutation) Token() (r []byte, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSigningKeyPub returns the old "signing_key_pub" field's value of the Keys entity.
-// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeysMutation) OldSigningKeyPub(ctx context.Context) (v jose.JSONWebKey, err error) {
+func (m *DeviceTokenMutation) OldToken(ctx context.Context) (v *[]byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSigningKeyPub is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSigningKeyPub requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSigningKeyPub: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.SigningKeyPub, nil
+	return oldValue.Token, nil
 }
 
-// ResetSigningKeyPub resets all changes to the "signing_key_pub" field.
-func (m *KeysMutation) ResetSigningKeyPub() {
-	m.signing_key_pub = nil
+// ClearToken clears the value of the "token" field.
+func (m *DeviceTokenMutation) ClearToken() {
+	m.token = nil
+	m.clearedFields[devicetoken.FieldToken] = struct{}{}
 }
 
-// SetNextRotation sets the "next_rotation" field.
-func (m *KeysMutation) SetNextRotation(t time.Time) {
-	m.next_rotation = &t
+// TokenCleared returns if the "token" field was cleared in this mutation.
+func (m *DeviceTokenMutation) TokenCleared() bool {
+	_, ok := m.clearedFields[devicetoken.FieldToken]
+	return ok
 }
 
-// NextRotation returns the value of the "next_rotation" field in the mutation.
-func (m *KeysMutation) NextRotation() (r time.Time, exists bool) {
-	v := m.next_rotation
+// ResetToken resets all changes to the "token" field.
+func (m *DeviceTokenMutation) ResetToken() {
+	m.token = nil
+	delete(m.clearedFields, devicetoken.FieldToken)
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *DeviceTokenMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *DeviceTokenMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNextRotation returns the old "next_rotation" field's value of the Keys entity.
-// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiry returns the old "expiry" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeysMutation) OldNextRotation(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceTokenMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextRotation is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextRotation requires an ID field in the mutation")
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextRotation: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
 	}
-	return oldValue.NextRotation, nil
-}
-
-// ResetNextRotation resets all changes to the "next_rotation" field.
-func (m *KeysMutation) ResetNextRotation() {
-	m.next_rotation = nil
+	return oldValue.Expiry, nil
 }
 
-// Where appends a list predicates to the KeysMutation builder.
-func (m *KeysMutation) Where(ps ...predicate.Keys) {
-	m.predicates = append(m.predicates, ps...)
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *DeviceTokenMutation) ResetExpiry() {
+	m.expiry = nil
 }
 
-// Op returns the operation name.
-func (m *KeysMutation) Op() Op {
-	return m.op
+// SetLastRequest sets the "last_request" field.
+func (m *DeviceTokenMutation) SetLastRequest(t time.Time) {
+	m.last_request = &t
 }
 
-// Type returns the node type of this mutation (Keys).
-func (m *KeysMutation) Type() string {
-	return m.typ
+// LastRequest returns the value of the "last_request" field in the mutation.
+func (m *DeviceTokenMutation) LastRequest() (r time.Time, exists bool) {
+	v := m.last_request
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRequest returns the old "last_request" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldLastRequest(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRequest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRequest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRequest: %w", err)
+	}
+	return oldValue.LastRequest, nil
+}
+
+// ResetLastRequest resets all changes to the "last_request" field.
+func (m *DeviceTokenMutation) ResetLastRequest() {
+	m.last_request = nil
+}
+
+// SetPollInterval sets the "poll_interval" field.
+func (m *DeviceTokenMutation) SetPollInterval(i int) {
+	m.poll_interval = &i
+	m.addpoll_interval = nil
+}
+
+// PollInterval returns the value of the "poll_interval" field in the mutation.
+func (m *DeviceTokenMutation) PollInterval() (r int, exists bool) {
+	v := m.poll_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollInterval returns the old "poll_interval" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldPollInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollInterval: %w", err)
+	}
+	return oldValue.PollInterval, nil
+}
+
+// AddPollInterval adds i to the "poll_interval" field.
+func (m *DeviceTokenMutation) AddPollInterval(i int) {
+	if m.addpoll_interval != nil {
+		*m.addpoll_interval += i
+	} else {
+		m.addpoll_interval = &i
+	}
+}
+
+// AddedPollInterval returns the value that was added to the "poll_interval" field in this mutation.
+func (m *DeviceTokenMutation) AddedPollInterval() (r int, exists bool) {
+	v := m.addpoll_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPollInterval resets all changes to the "poll_interval" field.
+func (m *DeviceTokenMutation) ResetPollInterval() {
+	m.poll_interval = nil
+	m.addpoll_interval = nil
+}
+
+// Where appends a list predicates to the DeviceTokenMutation builder.
+func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *DeviceTokenMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (DeviceToken).
+func (m *DeviceTokenMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *KeysMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.verification_keys != nil {
-		fields = append(fields, keys.FieldVerificationKeys)
+func (m *DeviceTokenMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.device_code != nil {
+		fields = append(fields, devicetoken.FieldDeviceCode)
 	}
-	if m.signing_key != nil {
-		fields = append(fields, keys.FieldSigningKey)
+	if m.status != nil {
+		fields = append(fields, devicetoken.FieldStatus)
 	}
-	if m.signing_key_pub != nil {
-		fields = append(fields, keys.FieldSigningKeyPub)
+	if m.token != nil {
+		fields = append(fields, devicetoken.FieldToken)
 	}
-	if m.next_rotation != nil {
-		fields = append(fields, keys.FieldNextRotation)
+	if m.expiry != nil {
+		fields = append(fields, devicetoken.FieldExpiry)
+	}
+	if m.last_request != nil {
+		fields = append(fields, devicetoken.FieldLastRequest)
+	}
+	if m.poll_interval != nil {
+		fields = append(fields, devicetoken.FieldPollInterval)
 	}
 	return fields
 }
@@ -4571,16 +5156,20 @@ func (m *KeysMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *KeysMutation) Field(name string) (ent.Value, bool) {
+func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case keys.FieldVerificationKeys:
-		return m.VerificationKeys()
-	case keys.FieldSigningKey:
-		return m.SigningKey()
-	case keys.FieldSigningKeyPub:
-		return m.SigningKeyPub()
-	case keys.FieldNextRotation:
-		return m.NextRotation()
+	case devicetoken.FieldDeviceCode:
+		return m.DeviceCode()
+	case devicetoken.FieldStatus:
+		return m.Status()
+	case devicetoken.FieldToken:
+		return m.Token()
+	case devicetoken.FieldExpiry:
+		return m.Expiry()
+	case devicetoken.FieldLastRequest:
+		return m.LastRequest()
+	case devicetoken.FieldPollInterval:
+		return m.PollInterval()
 	}
 	return nil, false
 }
@@ -4588,195 +5177,239 @@ func (m *KeysMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *KeysMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case keys.FieldVerificationKeys:
-		return m.OldVerificationKeys(ctx)
-	case keys.FieldSigningKey:
-		return m.OldSigningKey(ctx)
-	case keys.FieldSigningKeyPub:
-		return m.OldSigningKeyPub(ctx)
-	case keys.FieldNextRotation:
-		return m.OldNextRotation(ctx)
+	case devicetoken.FieldDeviceCode:
+		return m.OldDeviceCode(ctx)
+	case devicetoken.FieldStatus:
+		return m.OldStatus(ctx)
+	case devicetoken.FieldToken:
+		return m.OldToken(ctx)
+	case devicetoken.FieldExpiry:
+		return m.OldExpiry(ctx)
+	case devicetoken.FieldLastRequest:
+		return m.OldLastRequest(ctx)
+	case devicetoken.FieldPollInterval:
+		return m.OldPollInterval(ctx)
 	}
-	return nil, fmt.Errorf("unknown Keys field %s", name)
+	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KeysMutation) SetField(name string, value ent.Value) error {
+func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case keys.FieldVerificationKeys:
-		v, ok := value.([]storage.VerificationKey)
+	case devicetoken.FieldDeviceCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVerificationKeys(v)
+		m.SetDeviceCode(v)
 		return nil
-	case keys.FieldSigningKey:
-		v, ok := value.(jose.JSONWebKey)
+	case devicetoken.FieldStatus:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSigningKey(v)
+		m.SetStatus(v)
 		return nil
-	case keys.FieldSigningKeyPub:
-		v, ok := value.(jose.JSONWebKey)
+	case devicetoken.FieldToken:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSigningKeyPub(v)
+		m.SetToken(v)
 		return nil
-	case keys.FieldNextRotation:
+	case devicetoken.FieldExpiry:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNextRotation(v)
+		m.SetExpiry(v)
+		return nil
+	case devicetoken.FieldLastRequest:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRequest(v)
+		return nil
+	case devicetoken.FieldPollInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollInterval(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Keys field %s", name)
+	return fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *KeysMutation) AddedFields() []string {
-	return nil
+func (m *DeviceTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.addpoll_interval != nil {
+		fields = append(fields, devicetoken.FieldPollInterval)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *KeysMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case devicetoken.FieldPollInterval:
+		return m.AddedPollInterval()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KeysMutation) AddField(name string, value ent.Value) error {
+func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case devicetoken.FieldPollInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPollInterval(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Keys numeric field %s", name)
+	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *KeysMutation) ClearedFields() []string {
-	return nil
+func (m *DeviceTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(devicetoken.FieldToken) {
+		fields = append(fields, devicetoken.FieldToken)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *KeysMutation) FieldCleared(name string) bool {
+func (m *DeviceTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *KeysMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Keys nullable field %s", name)
+func (m *DeviceTokenMutation) ClearField(name string) error {
+	switch name {
+	case devicetoken.FieldToken:
+		m.ClearToken()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *KeysMutation) ResetField(name string) error {
+func (m *DeviceTokenMutation) ResetField(name string) error {
 	switch name {
-	case keys.FieldVerificationKeys:
-		m.ResetVerificationKeys()
+	case devicetoken.FieldDeviceCode:
+		m.ResetDeviceCode()
 		return nil
-	case keys.FieldSigningKey:
-		m.ResetSigningKey()
+	case devicetoken.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case keys.FieldSigningKeyPub:
-		m.ResetSigningKeyPub()
+	case devicetoken.FieldToken:
+		m.ResetToken()
 		return nil
-	case keys.FieldNextRotation:
-		m.ResetNextRotation()
+	case devicetoken.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	case devicetoken.FieldLastRequest:
+		m.ResetLastRequest()
+		return nil
+	case devicetoken.FieldPollInterval:
+		m.ResetPollInterval()
 		return nil
 	}
-	return fmt.Errorf("unknown Keys field %s", name)
+	return fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *KeysMutation) AddedEdges() []string {
+func (m *DeviceTokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *KeysMutation) AddedIDs(name string) []ent.Value {
+func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *KeysMutation) RemovedEdges() []string {
+func (m *DeviceTokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *KeysMutation) RemovedIDs(name string) []ent.Value {
+func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *KeysMutation) ClearedEdges() []string {
+func (m *DeviceTokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *KeysMutation) EdgeCleared(name string) bool {
+func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *KeysMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Keys unique edge %s", name)
+func (m *DeviceTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *KeysMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Keys edge %s", name)
+func (m *DeviceTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceToken edge %s", name)
 }
 
-// OAuth2ClientMutation represents an operation that mutates the OAuth2Client nodes in the graph.
-type OAuth2ClientMutation struct {
+// EmailVerificationMutation represents an operation that mutates the EmailVerification nodes in the graph.
+type EmailVerificationMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	secret        *string
-	redirect_uris *[]string
-	trusted_peers *[]string
-	public        *bool
-	name          *string
-	logo_url      *string
+	email         *string
+	expiry        *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*OAuth2Client, error)
-	predicates    []predicate.OAuth2Client
+	oldValue      func(context.Context) (*EmailVerification, error)
+	predicates    []predicate.EmailVerification
 }
 
-var _ ent.Mutation = (*OAuth2ClientMutation)(nil)
+var _ ent.Mutation = (*EmailVerificationMutation)(nil)
 
-// oauth2clientOption allows management of the mutation configuration using functional options.
-type oauth2clientOption func(*OAuth2ClientMutation)
+// emailverificationOption allows management of the mutation configuration using functional options.
+type emailverificationOption func(*EmailVerificationMutation)
 
-// newOAuth2ClientMutation creates new mutation for the OAuth2Client entity.
-func newOAuth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *OAuth2ClientMutation {
-	m := &OAuth2ClientMutation{
+// newEmailVerificationMutation creates new mutation for the EmailVerification entity.
+func newEmailVerificationMutation(c config, op Op, opts ...emailverificationOption) *EmailVerificationMutation {
+	m := &EmailVerificationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOAuth2Client,
+		typ:           TypeEmailVerification,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4785,20 +5418,20 @@ func newOAuth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *OAuth
 	return m
 }
 
-// withOAuth2ClientID sets the ID field of the mutation.
-func withOAuth2ClientID(id string) oauth2clientOption {
-	return func(m *OAuth2ClientMutation) {
+// withEmailVerificationID sets the ID field of the mutation.
+func withEmailVerificationID(id string) emailverificationOption {
+	return func(m *EmailVerificationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OAuth2Client
+			value *EmailVerification
 		)
-		m.oldValue = func(ctx context.Context) (*OAuth2Client, error) {
+		m.oldValue = func(ctx context.Context) (*EmailVerification, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OAuth2Client.Get(ctx, id)
+					value, err = m.Client().EmailVerification.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4807,10 +5440,10 @@ func withOAuth2ClientID(id string) oauth2clientOption {
 	}
 }
 
-// withOAuth2Client sets the old OAuth2Client of the mutation.
-func withOAuth2Client(node *OAuth2Client) oauth2clientOption {
-	return func(m *OAuth2ClientMutation) {
-		m.oldValue = func(context.Context) (*OAuth2Client, error) {
+// withEmailVerification sets the old EmailVerification of the mutation.
+func withEmailVerification(node *EmailVerification) emailverificationOption {
+	return func(m *EmailVerificationMutation) {
+		m.oldValue = func(context.Context) (*EmailVerification, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4819,7 +5452,7 @@ func withOAuth2Client(node *OAuth2Client) oauth2clientOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OAuth2ClientMutation) Client() *Client {
+func (m EmailVerificationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4827,7 +5460,7 @@ func (m OAuth2ClientMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OAuth2ClientMutation) Tx() (*Tx, error) {
+func (m EmailVerificationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -4837,14 +5470,14 @@ func (m OAuth2ClientMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of OAuth2Client entities.
-func (m *OAuth2ClientMutation) SetID(id string) {
+// operation is only accepted on creation of EmailVerification entities.
+func (m *EmailVerificationMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OAuth2ClientMutation) ID() (id string, exists bool) {
+func (m *EmailVerificationMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4855,7 +5488,7 @@ func (m *OAuth2ClientMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OAuth2ClientMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *EmailVerificationMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4864,312 +5497,5621 @@ func (m *OAuth2ClientMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OAuth2Client.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EmailVerification.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSecret sets the "secret" field.
-func (m *OAuth2ClientMutation) SetSecret(s string) {
-	m.secret = &s
+// SetEmail sets the "email" field.
+func (m *EmailVerificationMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Secret returns the value of the "secret" field in the mutation.
-func (m *OAuth2ClientMutation) Secret() (r string, exists bool) {
-	v := m.secret
+// Email returns the value of the "email" field in the mutation.
+func (m *EmailVerificationMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecret returns the old "secret" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldSecret(ctx context.Context) (v string, err error) {
+func (m *EmailVerificationMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecret requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Secret, nil
+	return oldValue.Email, nil
 }
 
-// ResetSecret resets all changes to the "secret" field.
-func (m *OAuth2ClientMutation) ResetSecret() {
-	m.secret = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *EmailVerificationMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetRedirectUris sets the "redirect_uris" field.
-func (m *OAuth2ClientMutation) SetRedirectUris(s []string) {
-	m.redirect_uris = &s
+// SetExpiry sets the "expiry" field.
+func (m *EmailVerificationMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
 }
 
-// RedirectUris returns the value of the "redirect_uris" field in the mutation.
-func (m *OAuth2ClientMutation) RedirectUris() (r []string, exists bool) {
-	v := m.redirect_uris
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *EmailVerificationMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirectUris returns the old "redirect_uris" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiry returns the old "expiry" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
+func (m *EmailVerificationMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
 	}
-	return oldValue.RedirectUris, nil
-}
-
-// ClearRedirectUris clears the value of the "redirect_uris" field.
-func (m *OAuth2ClientMutation) ClearRedirectUris() {
-	m.redirect_uris = nil
-	m.clearedFields[oauth2client.FieldRedirectUris] = struct{}{}
+	return oldValue.Expiry, nil
 }
 
-// RedirectUrisCleared returns if the "redirect_uris" field was cleared in this mutation.
-func (m *OAuth2ClientMutation) RedirectUrisCleared() bool {
-	_, ok := m.clearedFields[oauth2client.FieldRedirectUris]
-	return ok
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *EmailVerificationMutation) ResetExpiry() {
+	m.expiry = nil
 }
 
-// ResetRedirectUris resets all changes to the "redirect_uris" field.
-func (m *OAuth2ClientMutation) ResetRedirectUris() {
-	m.redirect_uris = nil
-	delete(m.clearedFields, oauth2client.FieldRedirectUris)
+// Where appends a list predicates to the EmailVerificationMutation builder.
+func (m *EmailVerificationMutation) Where(ps ...predicate.EmailVerification) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// SetTrustedPeers sets the "trusted_peers" field.
-func (m *OAuth2ClientMutation) SetTrustedPeers(s []string) {
-	m.trusted_peers = &s
+// Op returns the operation name.
+func (m *EmailVerificationMutation) Op() Op {
+	return m.op
 }
 
-// TrustedPeers returns the value of the "trusted_peers" field in the mutation.
-func (m *OAuth2ClientMutation) TrustedPeers() (r []string, exists bool) {
-	v := m.trusted_peers
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (EmailVerification).
+func (m *EmailVerificationMutation) Type() string {
+	return m.typ
 }
 
-// OldTrustedPeers returns the old "trusted_peers" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldTrustedPeers(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrustedPeers is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrustedPeers requires an ID field in the mutation")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailVerificationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.email != nil {
+		fields = append(fields, emailverification.FieldEmail)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrustedPeers: %w", err)
+	if m.expiry != nil {
+		fields = append(fields, emailverification.FieldExpiry)
 	}
-	return oldValue.TrustedPeers, nil
+	return fields
 }
 
-// ClearTrustedPeers clears the value of the "trusted_peers" field.
-func (m *OAuth2ClientMutation) ClearTrustedPeers() {
-	m.trusted_peers = nil
-	m.clearedFields[oauth2client.FieldTrustedPeers] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailVerificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emailverification.FieldEmail:
+		return m.Email()
+	case emailverification.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
 }
 
-// TrustedPeersCleared returns if the "trusted_peers" field was cleared in this mutation.
-func (m *OAuth2ClientMutation) TrustedPeersCleared() bool {
-	_, ok := m.clearedFields[oauth2client.FieldTrustedPeers]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailVerificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emailverification.FieldEmail:
+		return m.OldEmail(ctx)
+	case emailverification.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailVerification field %s", name)
 }
 
-// ResetTrustedPeers resets all changes to the "trusted_peers" field.
-func (m *OAuth2ClientMutation) ResetTrustedPeers() {
-	m.trusted_peers = nil
-	delete(m.clearedFields, oauth2client.FieldTrustedPeers)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emailverification.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case emailverification.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification field %s", name)
 }
 
-// SetPublic sets the "public" field.
-func (m *OAuth2ClientMutation) SetPublic(b bool) {
-	m.public = &b
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailVerificationMutation) AddedFields() []string {
+	return nil
 }
 
-// Public returns the value of the "public" field in the mutation.
-func (m *OAuth2ClientMutation) Public() (r bool, exists bool) {
-	v := m.public
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailVerificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldPublic returns the old "public" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldPublic(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublic requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.Public, nil
+	return fmt.Errorf("unknown EmailVerification numeric field %s", name)
 }
 
-// ResetPublic resets all changes to the "public" field.
-func (m *OAuth2ClientMutation) ResetPublic() {
-	m.public = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailVerificationMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetName sets the "name" field.
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailVerificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailVerificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EmailVerification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailVerificationMutation) ResetField(name string) error {
+	switch name {
+	case emailverification.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case emailverification.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailVerificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailVerificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailVerificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailVerificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailVerificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailVerificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailVerificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmailVerification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailVerificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmailVerification edge %s", name)
+}
+
+// KeysMutation represents an operation that mutates the Keys nodes in the graph.
+type KeysMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	verification_keys *[]storage.VerificationKey
+	signing_key       *jose.JSONWebKey
+	signing_key_pub   *jose.JSONWebKey
+	next_rotation     *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Keys, error)
+	predicates        []predicate.Keys
+}
+
+var _ ent.Mutation = (*KeysMutation)(nil)
+
+// keysOption allows management of the mutation configuration using functional options.
+type keysOption func(*KeysMutation)
+
+// newKeysMutation creates new mutation for the Keys entity.
+func newKeysMutation(c config, op Op, opts ...keysOption) *KeysMutation {
+	m := &KeysMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeys,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeysID sets the ID field of the mutation.
+func withKeysID(id string) keysOption {
+	return func(m *KeysMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Keys
+		)
+		m.oldValue = func(ctx context.Context) (*Keys, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Keys.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeys sets the old Keys of the mutation.
+func withKeys(node *Keys) keysOption {
+	return func(m *KeysMutation) {
+		m.oldValue = func(context.Context) (*Keys, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeysMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeysMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Keys entities.
+func (m *KeysMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeysMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeysMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Keys.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVerificationKeys sets the "verification_keys" field.
+func (m *KeysMutation) SetVerificationKeys(sk []storage.VerificationKey) {
+	m.verification_keys = &sk
+}
+
+// VerificationKeys returns the value of the "verification_keys" field in the mutation.
+func (m *KeysMutation) VerificationKeys() (r []storage.VerificationKey, exists bool) {
+	v := m.verification_keys
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationKeys returns the old "verification_keys" field's value of the Keys entity.
+// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeysMutation) OldVerificationKeys(ctx context.Context) (v []storage.VerificationKey, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationKeys is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationKeys requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationKeys: %w", err)
+	}
+	return oldValue.VerificationKeys, nil
+}
+
+// ResetVerificationKeys resets all changes to the "verification_keys" field.
+func (m *KeysMutation) ResetVerificationKeys() {
+	m.verification_keys = nil
+}
+
+// SetSigningKey sets the "signing_key" field.
+func (m *KeysMutation) SetSigningKey(jwk jose.JSONWebKey) {
+	m.signing_key = &jwk
+}
+
+// SigningKey returns the value of the "signing_key" field in the mutation.
+func (m *KeysMutation) SigningKey() (r jose.JSONWebKey, exists bool) {
+	v := m.signing_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigningKey returns the old "signing_key" field's value of the Keys entity.
+// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeysMutation) OldSigningKey(ctx context.Context) (v jose.JSONWebKey, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigningKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigningKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigningKey: %w", err)
+	}
+	return oldValue.SigningKey, nil
+}
+
+// ResetSigningKey resets all changes to the "signing_key" field.
+func (m *KeysMutation) ResetSigningKey() {
+	m.signing_key = nil
+}
+
+// SetSigningKeyPub sets the "signing_key_pub" field.
+func (m *KeysMutation) SetSigningKeyPub(jwk jose.JSONWebKey) {
+	m.signing_key_pub = &jwk
+}
+
+// SigningKeyPub returns the value of the "signing_key_pub" field in the mutation.
+func (m *KeysMutation) SigningKeyPub() (r jose.JSONWebKey, exists bool) {
+	v := m.signing_key_pub
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigningKeyPub returns the old "signing_key_pub" field's value of the Keys entity.
+// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeysMutation) OldSigningKeyPub(ctx context.Context) (v jose.JSONWebKey, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigningKeyPub is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigningKeyPub requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigningKeyPub: %w", err)
+	}
+	return oldValue.SigningKeyPub, nil
+}
+
+// ResetSigningKeyPub resets all changes to the "signing_key_pub" field.
+func (m *KeysMutation) ResetSigningKeyPub() {
+	m.signing_key_pub = nil
+}
+
+// SetNextRotation sets the "next_rotation" field.
+func (m *KeysMutation) SetNextRotation(t time.Time) {
+	m.next_rotation = &t
+}
+
+// NextRotation returns the value of the "next_rotation" field in the mutation.
+func (m *KeysMutation) NextRotation() (r time.Time, exists bool) {
+	v := m.next_rotation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRotation returns the old "next_rotation" field's value of the Keys entity.
+// If the Keys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeysMutation) OldNextRotation(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRotation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRotation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRotation: %w", err)
+	}
+	return oldValue.NextRotation, nil
+}
+
+// ResetNextRotation resets all changes to the "next_rotation" field.
+func (m *KeysMutation) ResetNextRotation() {
+	m.next_rotation = nil
+}
+
+// Where appends a list predicates to the KeysMutation builder.
+func (m *KeysMutation) Where(ps ...predicate.Keys) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *KeysMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Keys).
+func (m *KeysMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeysMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.verification_keys != nil {
+		fields = append(fields, keys.FieldVerificationKeys)
+	}
+	if m.signing_key != nil {
+		fields = append(fields, keys.FieldSigningKey)
+	}
+	if m.signing_key_pub != nil {
+		fields = append(fields, keys.FieldSigningKeyPub)
+	}
+	if m.next_rotation != nil {
+		fields = append(fields, keys.FieldNextRotation)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeysMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keys.FieldVerificationKeys:
+		return m.VerificationKeys()
+	case keys.FieldSigningKey:
+		return m.SigningKey()
+	case keys.FieldSigningKeyPub:
+		return m.SigningKeyPub()
+	case keys.FieldNextRotation:
+		return m.NextRotation()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeysMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keys.FieldVerificationKeys:
+		return m.OldVerificationKeys(ctx)
+	case keys.FieldSigningKey:
+		return m.OldSigningKey(ctx)
+	case keys.FieldSigningKeyPub:
+		return m.OldSigningKeyPub(ctx)
+	case keys.FieldNextRotation:
+		return m.OldNextRotation(ctx)
+	}
+	return nil, fmt.Errorf("unknown Keys field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeysMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keys.FieldVerificationKeys:
+		v, ok := value.([]storage.VerificationKey)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerificationKeys(v)
+		return nil
+	case keys.FieldSigningKey:
+		v, ok := value.(jose.JSONWebKey)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigningKey(v)
+		return nil
+	case keys.FieldSigningKeyPub:
+		v, ok := value.(jose.JSONWebKey)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigningKeyPub(v)
+		return nil
+	case keys.FieldNextRotation:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRotation(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Keys field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeysMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeysMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeysMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Keys numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeysMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeysMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeysMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Keys nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeysMutation) ResetField(name string) error {
+	switch name {
+	case keys.FieldVerificationKeys:
+		m.ResetVerificationKeys()
+		return nil
+	case keys.FieldSigningKey:
+		m.ResetSigningKey()
+		return nil
+	case keys.FieldSigningKeyPub:
+		m.ResetSigningKeyPub()
+		return nil
+	case keys.FieldNextRotation:
+		m.ResetNextRotation()
+		return nil
+	}
+	return fmt.Errorf("unknown Keys field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeysMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeysMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeysMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeysMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeysMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeysMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeysMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Keys unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeysMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Keys edge %s", name)
+}
+
+// LoginAttemptsMutation represents an operation that mutates the LoginAttempts nodes in the graph.
+type LoginAttemptsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	failures      *int
+	addfailures   *int
+	last_failure  *time.Time
+	locked_until  *time.Time
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LoginAttempts, error)
+	predicates    []predicate.LoginAttempts
+}
+
+var _ ent.Mutation = (*LoginAttemptsMutation)(nil)
+
+// loginattemptsOption allows management of the mutation configuration using functional options.
+type loginattemptsOption func(*LoginAttemptsMutation)
+
+// newLoginAttemptsMutation creates new mutation for the LoginAttempts entity.
+func newLoginAttemptsMutation(c config, op Op, opts ...loginattemptsOption) *LoginAttemptsMutation {
+	m := &LoginAttemptsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoginAttempts,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoginAttemptsID sets the ID field of the mutation.
+func withLoginAttemptsID(id string) loginattemptsOption {
+	return func(m *LoginAttemptsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoginAttempts
+		)
+		m.oldValue = func(ctx context.Context) (*LoginAttempts, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoginAttempts.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoginAttempts sets the old LoginAttempts of the mutation.
+func withLoginAttempts(node *LoginAttempts) loginattemptsOption {
+	return func(m *LoginAttemptsMutation) {
+		m.oldValue = func(context.Context) (*LoginAttempts, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoginAttemptsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoginAttemptsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LoginAttempts entities.
+func (m *LoginAttemptsMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoginAttemptsMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoginAttemptsMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoginAttempts.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFailures sets the "failures" field.
+func (m *LoginAttemptsMutation) SetFailures(i int) {
+	m.failures = &i
+	m.addfailures = nil
+}
+
+// Failures returns the value of the "failures" field in the mutation.
+func (m *LoginAttemptsMutation) Failures() (r int, exists bool) {
+	v := m.failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailures returns the old "failures" field's value of the LoginAttempts entity.
+// If the LoginAttempts object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptsMutation) OldFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailures: %w", err)
+	}
+	return oldValue.Failures, nil
+}
+
+// AddFailures adds i to the "failures" field.
+func (m *LoginAttemptsMutation) AddFailures(i int) {
+	if m.addfailures != nil {
+		*m.addfailures += i
+	} else {
+		m.addfailures = &i
+	}
+}
+
+// AddedFailures returns the value that was added to the "failures" field in this mutation.
+func (m *LoginAttemptsMutation) AddedFailures() (r int, exists bool) {
+	v := m.addfailures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailures resets all changes to the "failures" field.
+func (m *LoginAttemptsMutation) ResetFailures() {
+	m.failures = nil
+	m.addfailures = nil
+}
+
+// SetLastFailure sets the "last_failure" field.
+func (m *LoginAttemptsMutation) SetLastFailure(t time.Time) {
+	m.last_failure = &t
+}
+
+// LastFailure returns the value of the "last_failure" field in the mutation.
+func (m *LoginAttemptsMutation) LastFailure() (r time.Time, exists bool) {
+	v := m.last_failure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFailure returns the old "last_failure" field's value of the LoginAttempts entity.
+// If the LoginAttempts object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptsMutation) OldLastFailure(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFailure is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFailure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFailure: %w", err)
+	}
+	return oldValue.LastFailure, nil
+}
+
+// ResetLastFailure resets all changes to the "last_failure" field.
+func (m *LoginAttemptsMutation) ResetLastFailure() {
+	m.last_failure = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *LoginAttemptsMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *LoginAttemptsMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the LoginAttempts entity.
+// If the LoginAttempts object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptsMutation) OldLockedUntil(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *LoginAttemptsMutation) ResetLockedUntil() {
+	m.locked_until = nil
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *LoginAttemptsMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *LoginAttemptsMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the LoginAttempts entity.
+// If the LoginAttempts object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptsMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *LoginAttemptsMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// Where appends a list predicates to the LoginAttemptsMutation builder.
+func (m *LoginAttemptsMutation) Where(ps ...predicate.LoginAttempts) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *LoginAttemptsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (LoginAttempts).
+func (m *LoginAttemptsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginAttemptsMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.failures != nil {
+		fields = append(fields, loginattempts.FieldFailures)
+	}
+	if m.last_failure != nil {
+		fields = append(fields, loginattempts.FieldLastFailure)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, loginattempts.FieldLockedUntil)
+	}
+	if m.expiry != nil {
+		fields = append(fields, loginattempts.FieldExpiry)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginAttemptsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loginattempts.FieldFailures:
+		return m.Failures()
+	case loginattempts.FieldLastFailure:
+		return m.LastFailure()
+	case loginattempts.FieldLockedUntil:
+		return m.LockedUntil()
+	case loginattempts.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginAttemptsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loginattempts.FieldFailures:
+		return m.OldFailures(ctx)
+	case loginattempts.FieldLastFailure:
+		return m.OldLastFailure(ctx)
+	case loginattempts.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case loginattempts.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginAttempts field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginAttemptsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loginattempts.FieldFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailures(v)
+		return nil
+	case loginattempts.FieldLastFailure:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFailure(v)
+		return nil
+	case loginattempts.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case loginattempts.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginAttempts field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginAttemptsMutation) AddedFields() []string {
+	var fields []string
+	if m.addfailures != nil {
+		fields = append(fields, loginattempts.FieldFailures)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginAttemptsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loginattempts.FieldFailures:
+		return m.AddedFailures()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginAttemptsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loginattempts.FieldFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailures(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginAttempts numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginAttemptsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginAttemptsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginAttemptsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LoginAttempts nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginAttemptsMutation) ResetField(name string) error {
+	switch name {
+	case loginattempts.FieldFailures:
+		m.ResetFailures()
+		return nil
+	case loginattempts.FieldLastFailure:
+		m.ResetLastFailure()
+		return nil
+	case loginattempts.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case loginattempts.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginAttempts field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginAttemptsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginAttemptsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginAttemptsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginAttemptsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginAttemptsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginAttemptsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginAttemptsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LoginAttempts unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginAttemptsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LoginAttempts edge %s", name)
+}
+
+// OAuth2ClientMutation represents an operation that mutates the OAuth2Client nodes in the graph.
+type OAuth2ClientMutation struct {
+	config
+	op                                Op
+	typ                               string
+	id                                *string
+	secret                            *string
+	redirect_uris                     *[]string
+	trusted_peers                     *[]string
+	public                            *bool
+	name                              *string
+	logo_url                          *string
+	backchannel_notification_endpoint *string
+	id_token_encrypted_response_alg   *string
+	id_token_encrypted_response_enc   *string
+	userinfo_signed_response_alg      *string
+	userinfo_encrypted_response_alg   *string
+	userinfo_encrypted_response_enc   *string
+	jwks                              **jose.JSONWebKeySet
+	subject_type                      *string
+	sector_identifier                 *string
+	backchannel_logout_uri            *string
+	clearedFields                     map[string]struct{}
+	done                              bool
+	oldValue                          func(context.Context) (*OAuth2Client, error)
+	predicates                        []predicate.OAuth2Client
+}
+
+var _ ent.Mutation = (*OAuth2ClientMutation)(nil)
+
+// oauth2clientOption allows management of the mutation configuration using functional options.
+type oauth2clientOption func(*OAuth2ClientMutation)
+
+// newOAuth2ClientMutation creates new mutation for the OAuth2Client entity.
+func newOAuth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *OAuth2ClientMutation {
+	m := &OAuth2ClientMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOAuth2Client,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOAuth2ClientID sets the ID field of the mutation.
+func withOAuth2ClientID(id string) oauth2clientOption {
+	return func(m *OAuth2ClientMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OAuth2Client
+		)
+		m.oldValue = func(ctx context.Context) (*OAuth2Client, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OAuth2Client.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOAuth2Client sets the old OAuth2Client of the mutation.
+func withOAuth2Client(node *OAuth2Client) oauth2clientOption {
+	return func(m *OAuth2ClientMutation) {
+		m.oldValue = func(context.Context) (*OAuth2Client, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OAuth2ClientMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OAuth2ClientMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OAuth2Client entities.
+func (m *OAuth2ClientMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OAuth2ClientMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OAuth2ClientMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OAuth2Client.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSecret sets the "secret" field.
+func (m *OAuth2ClientMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *OAuth2ClientMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *OAuth2ClientMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (m *OAuth2ClientMutation) SetRedirectUris(s []string) {
+	m.redirect_uris = &s
+}
+
+// RedirectUris returns the value of the "redirect_uris" field in the mutation.
+func (m *OAuth2ClientMutation) RedirectUris() (r []string, exists bool) {
+	v := m.redirect_uris
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedirectUris returns the old "redirect_uris" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
+	}
+	return oldValue.RedirectUris, nil
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (m *OAuth2ClientMutation) ClearRedirectUris() {
+	m.redirect_uris = nil
+	m.clearedFields[oauth2client.FieldRedirectUris] = struct{}{}
+}
+
+// RedirectUrisCleared returns if the "redirect_uris" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) RedirectUrisCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldRedirectUris]
+	return ok
+}
+
+// ResetRedirectUris resets all changes to the "redirect_uris" field.
+func (m *OAuth2ClientMutation) ResetRedirectUris() {
+	m.redirect_uris = nil
+	delete(m.clearedFields, oauth2client.FieldRedirectUris)
+}
+
+// SetTrustedPeers sets the "trusted_peers" field.
+func (m *OAuth2ClientMutation) SetTrustedPeers(s []string) {
+	m.trusted_peers = &s
+}
+
+// TrustedPeers returns the value of the "trusted_peers" field in the mutation.
+func (m *OAuth2ClientMutation) TrustedPeers() (r []string, exists bool) {
+	v := m.trusted_peers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrustedPeers returns the old "trusted_peers" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldTrustedPeers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrustedPeers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrustedPeers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrustedPeers: %w", err)
+	}
+	return oldValue.TrustedPeers, nil
+}
+
+// ClearTrustedPeers clears the value of the "trusted_peers" field.
+func (m *OAuth2ClientMutation) ClearTrustedPeers() {
+	m.trusted_peers = nil
+	m.clearedFields[oauth2client.FieldTrustedPeers] = struct{}{}
+}
+
+// TrustedPeersCleared returns if the "trusted_peers" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) TrustedPeersCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldTrustedPeers]
+	return ok
+}
+
+// ResetTrustedPeers resets all changes to the "trusted_peers" field.
+func (m *OAuth2ClientMutation) ResetTrustedPeers() {
+	m.trusted_peers = nil
+	delete(m.clearedFields, oauth2client.FieldTrustedPeers)
+}
+
+// SetPublic sets the "public" field.
+func (m *OAuth2ClientMutation) SetPublic(b bool) {
+	m.public = &b
+}
+
+// Public returns the value of the "public" field in the mutation.
+func (m *OAuth2ClientMutation) Public() (r bool, exists bool) {
+	v := m.public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublic returns the old "public" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+	}
+	return oldValue.Public, nil
+}
+
+// ResetPublic resets all changes to the "public" field.
+func (m *OAuth2ClientMutation) ResetPublic() {
+	m.public = nil
+}
+
+// SetName sets the "name" field.
 func (m *OAuth2ClientMutation) SetName(s string) {
 	m.name = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OAuth2ClientMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// Name returns the value of the "name" field in the mutation.
+func (m *OAuth2ClientMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OAuth2ClientMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (m *OAuth2ClientMutation) SetLogoURL(s string) {
+	m.logo_url = &s
+}
+
+// LogoURL returns the value of the "logo_url" field in the mutation.
+func (m *OAuth2ClientMutation) LogoURL() (r string, exists bool) {
+	v := m.logo_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoURL returns the old "logo_url" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldLogoURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+	}
+	return oldValue.LogoURL, nil
+}
+
+// ResetLogoURL resets all changes to the "logo_url" field.
+func (m *OAuth2ClientMutation) ResetLogoURL() {
+	m.logo_url = nil
+}
+
+// SetBackchannelNotificationEndpoint sets the "backchannel_notification_endpoint" field.
+func (m *OAuth2ClientMutation) SetBackchannelNotificationEndpoint(s string) {
+	m.backchannel_notification_endpoint = &s
+}
+
+// BackchannelNotificationEndpoint returns the value of the "backchannel_notification_endpoint" field in the mutation.
+func (m *OAuth2ClientMutation) BackchannelNotificationEndpoint() (r string, exists bool) {
+	v := m.backchannel_notification_endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackchannelNotificationEndpoint returns the old "backchannel_notification_endpoint" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldBackchannelNotificationEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackchannelNotificationEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackchannelNotificationEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackchannelNotificationEndpoint: %w", err)
+	}
+	return oldValue.BackchannelNotificationEndpoint, nil
+}
+
+// ResetBackchannelNotificationEndpoint resets all changes to the "backchannel_notification_endpoint" field.
+func (m *OAuth2ClientMutation) ResetBackchannelNotificationEndpoint() {
+	m.backchannel_notification_endpoint = nil
+}
+
+// SetIDTokenEncryptedResponseAlg sets the "id_token_encrypted_response_alg" field.
+func (m *OAuth2ClientMutation) SetIDTokenEncryptedResponseAlg(s string) {
+	m.id_token_encrypted_response_alg = &s
+}
+
+// IDTokenEncryptedResponseAlg returns the value of the "id_token_encrypted_response_alg" field in the mutation.
+func (m *OAuth2ClientMutation) IDTokenEncryptedResponseAlg() (r string, exists bool) {
+	v := m.id_token_encrypted_response_alg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIDTokenEncryptedResponseAlg returns the old "id_token_encrypted_response_alg" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldIDTokenEncryptedResponseAlg(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIDTokenEncryptedResponseAlg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIDTokenEncryptedResponseAlg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIDTokenEncryptedResponseAlg: %w", err)
+	}
+	return oldValue.IDTokenEncryptedResponseAlg, nil
+}
+
+// ResetIDTokenEncryptedResponseAlg resets all changes to the "id_token_encrypted_response_alg" field.
+func (m *OAuth2ClientMutation) ResetIDTokenEncryptedResponseAlg() {
+	m.id_token_encrypted_response_alg = nil
+}
+
+// SetIDTokenEncryptedResponseEnc sets the "id_token_encrypted_response_enc" field.
+func (m *OAuth2ClientMutation) SetIDTokenEncryptedResponseEnc(s string) {
+	m.id_token_encrypted_response_enc = &s
+}
+
+// IDTokenEncryptedResponseEnc returns the value of the "id_token_encrypted_response_enc" field in the mutation.
+func (m *OAuth2ClientMutation) IDTokenEncryptedResponseEnc() (r string, exists bool) {
+	v := m.id_token_encrypted_response_enc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIDTokenEncryptedResponseEnc returns the old "id_token_encrypted_response_enc" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldIDTokenEncryptedResponseEnc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIDTokenEncryptedResponseEnc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIDTokenEncryptedResponseEnc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIDTokenEncryptedResponseEnc: %w", err)
+	}
+	return oldValue.IDTokenEncryptedResponseEnc, nil
+}
+
+// ResetIDTokenEncryptedResponseEnc resets all changes to the "id_token_encrypted_response_enc" field.
+func (m *OAuth2ClientMutation) ResetIDTokenEncryptedResponseEnc() {
+	m.id_token_encrypted_response_enc = nil
+}
+
+// SetUserinfoSignedResponseAlg sets the "userinfo_signed_response_alg" field.
+func (m *OAuth2ClientMutation) SetUserinfoSignedResponseAlg(s string) {
+	m.userinfo_signed_response_alg = &s
+}
+
+// UserinfoSignedResponseAlg returns the value of the "userinfo_signed_response_alg" field in the mutation.
+func (m *OAuth2ClientMutation) UserinfoSignedResponseAlg() (r string, exists bool) {
+	v := m.userinfo_signed_response_alg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserinfoSignedResponseAlg returns the old "userinfo_signed_response_alg" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldUserinfoSignedResponseAlg(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserinfoSignedResponseAlg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserinfoSignedResponseAlg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserinfoSignedResponseAlg: %w", err)
+	}
+	return oldValue.UserinfoSignedResponseAlg, nil
+}
+
+// ResetUserinfoSignedResponseAlg resets all changes to the "userinfo_signed_response_alg" field.
+func (m *OAuth2ClientMutation) ResetUserinfoSignedResponseAlg() {
+	m.userinfo_signed_response_alg = nil
+}
+
+// SetUserinfoEncryptedResponseAlg sets the "userinfo_encrypted_response_alg" field.
+func (m *OAuth2ClientMutation) SetUserinfoEncryptedResponseAlg(s string) {
+	m.userinfo_encrypted_response_alg = &s
+}
+
+// UserinfoEncryptedResponseAlg returns the value of the "userinfo_encrypted_response_alg" field in the mutation.
+func (m *OAuth2ClientMutation) UserinfoEncryptedResponseAlg() (r string, exists bool) {
+	v := m.userinfo_encrypted_response_alg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserinfoEncryptedResponseAlg returns the old "userinfo_encrypted_response_alg" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldUserinfoEncryptedResponseAlg(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserinfoEncryptedResponseAlg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserinfoEncryptedResponseAlg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserinfoEncryptedResponseAlg: %w", err)
+	}
+	return oldValue.UserinfoEncryptedResponseAlg, nil
+}
+
+// ResetUserinfoEncryptedResponseAlg resets all changes to the "userinfo_encrypted_response_alg" field.
+func (m *OAuth2ClientMutation) ResetUserinfoEncryptedResponseAlg() {
+	m.userinfo_encrypted_response_alg = nil
+}
+
+// SetUserinfoEncryptedResponseEnc sets the "userinfo_encrypted_response_enc" field.
+func (m *OAuth2ClientMutation) SetUserinfoEncryptedResponseEnc(s string) {
+	m.userinfo_encrypted_response_enc = &s
+}
+
+// UserinfoEncryptedResponseEnc returns the value of the "userinfo_encrypted_response_enc" field in the mutation.
+func (m *OAuth2ClientMutation) UserinfoEncryptedResponseEnc() (r string, exists bool) {
+	v := m.userinfo_encrypted_response_enc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserinfoEncryptedResponseEnc returns the old "userinfo_encrypted_response_enc" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldUserinfoEncryptedResponseEnc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserinfoEncryptedResponseEnc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserinfoEncryptedResponseEnc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserinfoEncryptedResponseEnc: %w", err)
+	}
+	return oldValue.UserinfoEncryptedResponseEnc, nil
+}
+
+// ResetUserinfoEncryptedResponseEnc resets all changes to the "userinfo_encrypted_response_enc" field.
+func (m *OAuth2ClientMutation) ResetUserinfoEncryptedResponseEnc() {
+	m.userinfo_encrypted_response_enc = nil
+}
+
+// SetJwks sets the "jwks" field.
+func (m *OAuth2ClientMutation) SetJwks(jwks *jose.JSONWebKeySet) {
+	m.jwks = &jwks
+}
+
+// Jwks returns the value of the "jwks" field in the mutation.
+func (m *OAuth2ClientMutation) Jwks() (r *jose.JSONWebKeySet, exists bool) {
+	v := m.jwks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJwks returns the old "jwks" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldJwks(ctx context.Context) (v *jose.JSONWebKeySet, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJwks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJwks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJwks: %w", err)
+	}
+	return oldValue.Jwks, nil
+}
+
+// ClearJwks clears the value of the "jwks" field.
+func (m *OAuth2ClientMutation) ClearJwks() {
+	m.jwks = nil
+	m.clearedFields[oauth2client.FieldJwks] = struct{}{}
+}
+
+// JwksCleared returns if the "jwks" field was cleared in this mutation.
+func (m *OAuth2ClientMutation) JwksCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldJwks]
+	return ok
+}
+
+// ResetJwks resets all changes to the "jwks" field.
+func (m *OAuth2ClientMutation) ResetJwks() {
+	m.jwks = nil
+	delete(m.clearedFields, oauth2client.FieldJwks)
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (m *OAuth2ClientMutation) SetSubjectType(s string) {
+	m.subject_type = &s
+}
+
+// SubjectType returns the value of the "subject_type" field in the mutation.
+func (m *OAuth2ClientMutation) SubjectType() (r string, exists bool) {
+	v := m.subject_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectType returns the old "subject_type" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldSubjectType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectType: %w", err)
+	}
+	return oldValue.SubjectType, nil
+}
+
+// ResetSubjectType resets all changes to the "subject_type" field.
+func (m *OAuth2ClientMutation) ResetSubjectType() {
+	m.subject_type = nil
+}
+
+// SetSectorIdentifier sets the "sector_identifier" field.
+func (m *OAuth2ClientMutation) SetSectorIdentifier(s string) {
+	m.sector_identifier = &s
+}
+
+// SectorIdentifier returns the value of the "sector_identifier" field in the mutation.
+func (m *OAuth2ClientMutation) SectorIdentifier() (r string, exists bool) {
+	v := m.sector_identifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSectorIdentifier returns the old "sector_identifier" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldSectorIdentifier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSectorIdentifier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSectorIdentifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSectorIdentifier: %w", err)
+	}
+	return oldValue.SectorIdentifier, nil
+}
+
+// ResetSectorIdentifier resets all changes to the "sector_identifier" field.
+func (m *OAuth2ClientMutation) ResetSectorIdentifier() {
+	m.sector_identifier = nil
+}
+
+// SetBackchannelLogoutURI sets the "backchannel_logout_uri" field.
+func (m *OAuth2ClientMutation) SetBackchannelLogoutURI(s string) {
+	m.backchannel_logout_uri = &s
+}
+
+// BackchannelLogoutURI returns the value of the "backchannel_logout_uri" field in the mutation.
+func (m *OAuth2ClientMutation) BackchannelLogoutURI() (r string, exists bool) {
+	v := m.backchannel_logout_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackchannelLogoutURI returns the old "backchannel_logout_uri" field's value of the OAuth2Client entity.
+// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OAuth2ClientMutation) OldBackchannelLogoutURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackchannelLogoutURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackchannelLogoutURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackchannelLogoutURI: %w", err)
+	}
+	return oldValue.BackchannelLogoutURI, nil
+}
+
+// ResetBackchannelLogoutURI resets all changes to the "backchannel_logout_uri" field.
+func (m *OAuth2ClientMutation) ResetBackchannelLogoutURI() {
+	m.backchannel_logout_uri = nil
+}
+
+// Where appends a list predicates to the OAuth2ClientMutation builder.
+func (m *OAuth2ClientMutation) Where(ps ...predicate.OAuth2Client) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *OAuth2ClientMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (OAuth2Client).
+func (m *OAuth2ClientMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OAuth2ClientMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.secret != nil {
+		fields = append(fields, oauth2client.FieldSecret)
+	}
+	if m.redirect_uris != nil {
+		fields = append(fields, oauth2client.FieldRedirectUris)
+	}
+	if m.trusted_peers != nil {
+		fields = append(fields, oauth2client.FieldTrustedPeers)
+	}
+	if m.public != nil {
+		fields = append(fields, oauth2client.FieldPublic)
+	}
+	if m.name != nil {
+		fields = append(fields, oauth2client.FieldName)
+	}
+	if m.logo_url != nil {
+		fields = append(fields, oauth2client.FieldLogoURL)
+	}
+	if m.backchannel_notification_endpoint != nil {
+		fields = append(fields, oauth2client.FieldBackchannelNotificationEndpoint)
+	}
+	if m.id_token_encrypted_response_alg != nil {
+		fields = append(fields, oauth2client.FieldIDTokenEncryptedResponseAlg)
+	}
+	if m.id_token_encrypted_response_enc != nil {
+		fields = append(fields, oauth2client.FieldIDTokenEncryptedResponseEnc)
+	}
+	if m.userinfo_signed_response_alg != nil {
+		fields = append(fields, oauth2client.FieldUserinfoSignedResponseAlg)
+	}
+	if m.userinfo_encrypted_response_alg != nil {
+		fields = append(fields, oauth2client.FieldUserinfoEncryptedResponseAlg)
+	}
+	if m.userinfo_encrypted_response_enc != nil {
+		fields = append(fields, oauth2client.FieldUserinfoEncryptedResponseEnc)
+	}
+	if m.jwks != nil {
+		fields = append(fields, oauth2client.FieldJwks)
+	}
+	if m.subject_type != nil {
+		fields = append(fields, oauth2client.FieldSubjectType)
+	}
+	if m.sector_identifier != nil {
+		fields = append(fields, oauth2client.FieldSectorIdentifier)
+	}
+	if m.backchannel_logout_uri != nil {
+		fields = append(fields, oauth2client.FieldBackchannelLogoutURI)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OAuth2ClientMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case oauth2client.FieldSecret:
+		return m.Secret()
+	case oauth2client.FieldRedirectUris:
+		return m.RedirectUris()
+	case oauth2client.FieldTrustedPeers:
+		return m.TrustedPeers()
+	case oauth2client.FieldPublic:
+		return m.Public()
+	case oauth2client.FieldName:
+		return m.Name()
+	case oauth2client.FieldLogoURL:
+		return m.LogoURL()
+	case oauth2client.FieldBackchannelNotificationEndpoint:
+		return m.BackchannelNotificationEndpoint()
+	case oauth2client.FieldIDTokenEncryptedResponseAlg:
+		return m.IDTokenEncryptedResponseAlg()
+	case oauth2client.FieldIDTokenEncryptedResponseEnc:
+		return m.IDTokenEncryptedResponseEnc()
+	case oauth2client.FieldUserinfoSignedResponseAlg:
+		return m.UserinfoSignedResponseAlg()
+	case oauth2client.FieldUserinfoEncryptedResponseAlg:
+		return m.UserinfoEncryptedResponseAlg()
+	case oauth2client.FieldUserinfoEncryptedResponseEnc:
+		return m.UserinfoEncryptedResponseEnc()
+	case oauth2client.FieldJwks:
+		return m.Jwks()
+	case oauth2client.FieldSubjectType:
+		return m.SubjectType()
+	case oauth2client.FieldSectorIdentifier:
+		return m.SectorIdentifier()
+	case oauth2client.FieldBackchannelLogoutURI:
+		return m.BackchannelLogoutURI()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OAuth2ClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case oauth2client.FieldSecret:
+		return m.OldSecret(ctx)
+	case oauth2client.FieldRedirectUris:
+		return m.OldRedirectUris(ctx)
+	case oauth2client.FieldTrustedPeers:
+		return m.OldTrustedPeers(ctx)
+	case oauth2client.FieldPublic:
+		return m.OldPublic(ctx)
+	case oauth2client.FieldName:
+		return m.OldName(ctx)
+	case oauth2client.FieldLogoURL:
+		return m.OldLogoURL(ctx)
+	case oauth2client.FieldBackchannelNotificationEndpoint:
+		return m.OldBackchannelNotificationEndpoint(ctx)
+	case oauth2client.FieldIDTokenEncryptedResponseAlg:
+		return m.OldIDTokenEncryptedResponseAlg(ctx)
+	case oauth2client.FieldIDTokenEncryptedResponseEnc:
+		return m.OldIDTokenEncryptedResponseEnc(ctx)
+	case oauth2client.FieldUserinfoSignedResponseAlg:
+		return m.OldUserinfoSignedResponseAlg(ctx)
+	case oauth2client.FieldUserinfoEncryptedResponseAlg:
+		return m.OldUserinfoEncryptedResponseAlg(ctx)
+	case oauth2client.FieldUserinfoEncryptedResponseEnc:
+		return m.OldUserinfoEncryptedResponseEnc(ctx)
+	case oauth2client.FieldJwks:
+		return m.OldJwks(ctx)
+	case oauth2client.FieldSubjectType:
+		return m.OldSubjectType(ctx)
+	case oauth2client.FieldSectorIdentifier:
+		return m.OldSectorIdentifier(ctx)
+	case oauth2client.FieldBackchannelLogoutURI:
+		return m.OldBackchannelLogoutURI(ctx)
+	}
+	return nil, fmt.Errorf("unknown OAuth2Client field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OAuth2ClientMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case oauth2client.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case oauth2client.FieldRedirectUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectUris(v)
+		return nil
+	case oauth2client.FieldTrustedPeers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrustedPeers(v)
+		return nil
+	case oauth2client.FieldPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublic(v)
+		return nil
+	case oauth2client.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case oauth2client.FieldLogoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoURL(v)
+		return nil
+	case oauth2client.FieldBackchannelNotificationEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackchannelNotificationEndpoint(v)
+		return nil
+	case oauth2client.FieldIDTokenEncryptedResponseAlg:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIDTokenEncryptedResponseAlg(v)
+		return nil
+	case oauth2client.FieldIDTokenEncryptedResponseEnc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIDTokenEncryptedResponseEnc(v)
+		return nil
+	case oauth2client.FieldUserinfoSignedResponseAlg:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserinfoSignedResponseAlg(v)
+		return nil
+	case oauth2client.FieldUserinfoEncryptedResponseAlg:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserinfoEncryptedResponseAlg(v)
+		return nil
+	case oauth2client.FieldUserinfoEncryptedResponseEnc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserinfoEncryptedResponseEnc(v)
+		return nil
+	case oauth2client.FieldJwks:
+		v, ok := value.(*jose.JSONWebKeySet)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJwks(v)
+		return nil
+	case oauth2client.FieldSubjectType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectType(v)
+		return nil
+	case oauth2client.FieldSectorIdentifier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSectorIdentifier(v)
+		return nil
+	case oauth2client.FieldBackchannelLogoutURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackchannelLogoutURI(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OAuth2Client field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OAuth2ClientMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OAuth2ClientMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OAuth2ClientMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OAuth2Client numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OAuth2ClientMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(oauth2client.FieldRedirectUris) {
+		fields = append(fields, oauth2client.FieldRedirectUris)
+	}
+	if m.FieldCleared(oauth2client.FieldTrustedPeers) {
+		fields = append(fields, oauth2client.FieldTrustedPeers)
+	}
+	if m.FieldCleared(oauth2client.FieldJwks) {
+		fields = append(fields, oauth2client.FieldJwks)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OAuth2ClientMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OAuth2ClientMutation) ClearField(name string) error {
+	switch name {
+	case oauth2client.FieldRedirectUris:
+		m.ClearRedirectUris()
+		return nil
+	case oauth2client.FieldTrustedPeers:
+		m.ClearTrustedPeers()
+		return nil
+	case oauth2client.FieldJwks:
+		m.ClearJwks()
+		return nil
+	}
+	return fmt.Errorf("unknown OAuth2Client nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OAuth2ClientMutation) ResetField(name string) error {
+	switch name {
+	case oauth2client.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case oauth2client.FieldRedirectUris:
+		m.ResetRedirectUris()
+		return nil
+	case oauth2client.FieldTrustedPeers:
+		m.ResetTrustedPeers()
+		return nil
+	case oauth2client.FieldPublic:
+		m.ResetPublic()
+		return nil
+	case oauth2client.FieldName:
+		m.ResetName()
+		return nil
+	case oauth2client.FieldLogoURL:
+		m.ResetLogoURL()
+		return nil
+	case oauth2client.FieldBackchannelNotificationEndpoint:
+		m.ResetBackchannelNotificationEndpoint()
+		return nil
+	case oauth2client.FieldIDTokenEncryptedResponseAlg:
+		m.ResetIDTokenEncryptedResponseAlg()
+		return nil
+	case oauth2client.FieldIDTokenEncryptedResponseEnc:
+		m.ResetIDTokenEncryptedResponseEnc()
+		return nil
+	case oauth2client.FieldUserinfoSignedResponseAlg:
+		m.ResetUserinfoSignedResponseAlg()
+		return nil
+	case oauth2client.FieldUserinfoEncryptedResponseAlg:
+		m.ResetUserinfoEncryptedResponseAlg()
+		return nil
+	case oauth2client.FieldUserinfoEncryptedResponseEnc:
+		m.ResetUserinfoEncryptedResponseEnc()
+		return nil
+	case oauth2client.FieldJwks:
+		m.ResetJwks()
+		return nil
+	case oauth2client.FieldSubjectType:
+		m.ResetSubjectType()
+		return nil
+	case oauth2client.FieldSectorIdentifier:
+		m.ResetSectorIdentifier()
+		return nil
+	case oauth2client.FieldBackchannelLogoutURI:
+		m.ResetBackchannelLogoutURI()
+		return nil
+	}
+	return fmt.Errorf("unknown OAuth2Client field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OAuth2ClientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OAuth2ClientMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OAuth2ClientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OAuth2ClientMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OAuth2ClientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OAuth2ClientMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OAuth2ClientMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OAuth2Client unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OAuth2ClientMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OAuth2Client edge %s", name)
+}
+
+// OfflineSessionMutation represents an operation that mutates the OfflineSession nodes in the graph.
+type OfflineSessionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	user_id        *string
+	conn_id        *string
+	refresh        *[]byte
+	connector_data *[]byte
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*OfflineSession, error)
+	predicates     []predicate.OfflineSession
+}
+
+var _ ent.Mutation = (*OfflineSessionMutation)(nil)
+
+// offlinesessionOption allows management of the mutation configuration using functional options.
+type offlinesessionOption func(*OfflineSessionMutation)
+
+// newOfflineSessionMutation creates new mutation for the OfflineSession entity.
+func newOfflineSessionMutation(c config, op Op, opts ...offlinesessionOption) *OfflineSessionMutation {
+	m := &OfflineSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOfflineSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOfflineSessionID sets the ID field of the mutation.
+func withOfflineSessionID(id string) offlinesessionOption {
+	return func(m *OfflineSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OfflineSession
+		)
+		m.oldValue = func(ctx context.Context) (*OfflineSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OfflineSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOfflineSession sets the old OfflineSession of the mutation.
+func withOfflineSession(node *OfflineSession) offlinesessionOption {
+	return func(m *OfflineSessionMutation) {
+		m.oldValue = func(context.Context) (*OfflineSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OfflineSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OfflineSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OfflineSession entities.
+func (m *OfflineSessionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OfflineSessionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OfflineSessionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OfflineSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OfflineSessionMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OfflineSessionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfflineSessionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OfflineSessionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetConnID sets the "conn_id" field.
+func (m *OfflineSessionMutation) SetConnID(s string) {
+	m.conn_id = &s
+}
+
+// ConnID returns the value of the "conn_id" field in the mutation.
+func (m *OfflineSessionMutation) ConnID() (r string, exists bool) {
+	v := m.conn_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnID returns the old "conn_id" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfflineSessionMutation) OldConnID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnID: %w", err)
+	}
+	return oldValue.ConnID, nil
+}
+
+// ResetConnID resets all changes to the "conn_id" field.
+func (m *OfflineSessionMutation) ResetConnID() {
+	m.conn_id = nil
+}
+
+// SetRefresh sets the "refresh" field.
+func (m *OfflineSessionMutation) SetRefresh(b []byte) {
+	m.refresh = &b
+}
+
+// Refresh returns the value of the "refresh" field in the mutation.
+func (m *OfflineSessionMutation) Refresh() (r []byte, exists bool) {
+	v := m.refresh
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefresh returns the old "refresh" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfflineSessionMutation) OldRefresh(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefresh is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefresh requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefresh: %w", err)
+	}
+	return oldValue.Refresh, nil
+}
+
+// ResetRefresh resets all changes to the "refresh" field.
+func (m *OfflineSessionMutation) ResetRefresh() {
+	m.refresh = nil
+}
+
+// SetConnectorData sets the "connector_data" field.
+func (m *OfflineSessionMutation) SetConnectorData(b []byte) {
+	m.connector_data = &b
+}
+
+// ConnectorData returns the value of the "connector_data" field in the mutation.
+func (m *OfflineSessionMutation) ConnectorData() (r []byte, exists bool) {
+	v := m.connector_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectorData returns the old "connector_data" field's value of the OfflineSession entity.
+// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfflineSessionMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+	}
+	return oldValue.ConnectorData, nil
+}
+
+// ClearConnectorData clears the value of the "connector_data" field.
+func (m *OfflineSessionMutation) ClearConnectorData() {
+	m.connector_data = nil
+	m.clearedFields[offlinesession.FieldConnectorData] = struct{}{}
+}
+
+// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
+func (m *OfflineSessionMutation) ConnectorDataCleared() bool {
+	_, ok := m.clearedFields[offlinesession.FieldConnectorData]
+	return ok
+}
+
+// ResetConnectorData resets all changes to the "connector_data" field.
+func (m *OfflineSessionMutation) ResetConnectorData() {
+	m.connector_data = nil
+	delete(m.clearedFields, offlinesession.FieldConnectorData)
+}
+
+// Where appends a list predicates to the OfflineSessionMutation builder.
+func (m *OfflineSessionMutation) Where(ps ...predicate.OfflineSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *OfflineSessionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (OfflineSession).
+func (m *OfflineSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OfflineSessionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user_id != nil {
+		fields = append(fields, offlinesession.FieldUserID)
+	}
+	if m.conn_id != nil {
+		fields = append(fields, offlinesession.FieldConnID)
+	}
+	if m.refresh != nil {
+		fields = append(fields, offlinesession.FieldRefresh)
+	}
+	if m.connector_data != nil {
+		fields = append(fields, offlinesession.FieldConnectorData)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OfflineSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case offlinesession.FieldUserID:
+		return m.UserID()
+	case offlinesession.FieldConnID:
+		return m.ConnID()
+	case offlinesession.FieldRefresh:
+		return m.Refresh()
+	case offlinesession.FieldConnectorData:
+		return m.ConnectorData()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OfflineSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case offlinesession.FieldUserID:
+		return m.OldUserID(ctx)
+	case offlinesession.FieldConnID:
+		return m.OldConnID(ctx)
+	case offlinesession.FieldRefresh:
+		return m.OldRefresh(ctx)
+	case offlinesession.FieldConnectorData:
+		return m.OldConnectorData(ctx)
+	}
+	return nil, fmt.Errorf("unknown OfflineSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OfflineSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case offlinesession.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case offlinesession.FieldConnID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnID(v)
+		return nil
+	case offlinesession.FieldRefresh:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefresh(v)
+		return nil
+	case offlinesession.FieldConnectorData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectorData(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OfflineSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OfflineSessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OfflineSessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OfflineSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OfflineSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OfflineSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(offlinesession.FieldConnectorData) {
+		fields = append(fields, offlinesession.FieldConnectorData)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OfflineSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OfflineSessionMutation) ClearField(name string) error {
+	switch name {
+	case offlinesession.FieldConnectorData:
+		m.ClearConnectorData()
+		return nil
+	}
+	return fmt.Errorf("unknown OfflineSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OfflineSessionMutation) ResetField(name string) error {
+	switch name {
+	case offlinesession.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case offlinesession.FieldConnID:
+		m.ResetConnID()
+		return nil
+	case offlinesession.FieldRefresh:
+		m.ResetRefresh()
+		return nil
+	case offlinesession.FieldConnectorData:
+		m.ResetConnectorData()
+		return nil
+	}
+	return fmt.Errorf("unknown OfflineSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OfflineSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OfflineSessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OfflineSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OfflineSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OfflineSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OfflineSessionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OfflineSessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OfflineSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OfflineSessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OfflineSession edge %s", name)
+}
+
+// PasswordMutation represents an operation that mutates the Password nodes in the graph.
+type PasswordMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	email                *string
+	hash                 *[]byte
+	previous_hashes      *[][]uint8
+	username             *string
+	user_id              *string
+	pending_verification *bool
+	pending_approval     *bool
+	groups               *[]string
+	display_name         *string
+	attributes           *map[string]string
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*Password, error)
+	predicates           []predicate.Password
+}
+
+var _ ent.Mutation = (*PasswordMutation)(nil)
+
+// passwordOption allows management of the mutation configuration using functional options.
+type passwordOption func(*PasswordMutation)
+
+// newPasswordMutation creates new mutation for the Password entity.
+func newPasswordMutation(c config, op Op, opts ...passwordOption) *PasswordMutation {
+	m := &PasswordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePassword,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordID sets the ID field of the mutation.
+func withPasswordID(id int) passwordOption {
+	return func(m *PasswordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Password
+		)
+		m.oldValue = func(ctx context.Context) (*Password, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Password.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPassword sets the old Password of the mutation.
+func withPassword(node *Password) passwordOption {
+	return func(m *PasswordMutation) {
+		m.oldValue = func(context.Context) (*Password, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Password.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *PasswordMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *PasswordMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *PasswordMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *PasswordMutation) SetHash(b []byte) {
+	m.hash = &b
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *PasswordMutation) Hash() (r []byte, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *PasswordMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetPreviousHashes sets the "previous_hashes" field.
+func (m *PasswordMutation) SetPreviousHashes(u [][]uint8) {
+	m.previous_hashes = &u
+}
+
+// PreviousHashes returns the value of the "previous_hashes" field in the mutation.
+func (m *PasswordMutation) PreviousHashes() (r [][]uint8, exists bool) {
+	v := m.previous_hashes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousHashes returns the old "previous_hashes" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldPreviousHashes(ctx context.Context) (v [][]uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousHashes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousHashes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousHashes: %w", err)
+	}
+	return oldValue.PreviousHashes, nil
+}
+
+// ClearPreviousHashes clears the value of the "previous_hashes" field.
+func (m *PasswordMutation) ClearPreviousHashes() {
+	m.previous_hashes = nil
+	m.clearedFields[password.FieldPreviousHashes] = struct{}{}
+}
+
+// PreviousHashesCleared returns if the "previous_hashes" field was cleared in this mutation.
+func (m *PasswordMutation) PreviousHashesCleared() bool {
+	_, ok := m.clearedFields[password.FieldPreviousHashes]
+	return ok
+}
+
+// ResetPreviousHashes resets all changes to the "previous_hashes" field.
+func (m *PasswordMutation) ResetPreviousHashes() {
+	m.previous_hashes = nil
+	delete(m.clearedFields, password.FieldPreviousHashes)
+}
+
+// SetUsername sets the "username" field.
+func (m *PasswordMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *PasswordMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *PasswordMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PasswordMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetPendingVerification sets the "pending_verification" field.
+func (m *PasswordMutation) SetPendingVerification(b bool) {
+	m.pending_verification = &b
+}
+
+// PendingVerification returns the value of the "pending_verification" field in the mutation.
+func (m *PasswordMutation) PendingVerification() (r bool, exists bool) {
+	v := m.pending_verification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingVerification returns the old "pending_verification" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldPendingVerification(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingVerification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingVerification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingVerification: %w", err)
+	}
+	return oldValue.PendingVerification, nil
+}
+
+// ResetPendingVerification resets all changes to the "pending_verification" field.
+func (m *PasswordMutation) ResetPendingVerification() {
+	m.pending_verification = nil
+}
+
+// SetPendingApproval sets the "pending_approval" field.
+func (m *PasswordMutation) SetPendingApproval(b bool) {
+	m.pending_approval = &b
+}
+
+// PendingApproval returns the value of the "pending_approval" field in the mutation.
+func (m *PasswordMutation) PendingApproval() (r bool, exists bool) {
+	v := m.pending_approval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingApproval returns the old "pending_approval" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldPendingApproval(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingApproval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingApproval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingApproval: %w", err)
+	}
+	return oldValue.PendingApproval, nil
+}
+
+// ResetPendingApproval resets all changes to the "pending_approval" field.
+func (m *PasswordMutation) ResetPendingApproval() {
+	m.pending_approval = nil
+}
+
+// SetGroups sets the "groups" field.
+func (m *PasswordMutation) SetGroups(s []string) {
+	m.groups = &s
+}
+
+// Groups returns the value of the "groups" field in the mutation.
+func (m *PasswordMutation) Groups() (r []string, exists bool) {
+	v := m.groups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroups returns the old "groups" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldGroups(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroups: %w", err)
+	}
+	return oldValue.Groups, nil
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (m *PasswordMutation) ClearGroups() {
+	m.groups = nil
+	m.clearedFields[password.FieldGroups] = struct{}{}
+}
+
+// GroupsCleared returns if the "groups" field was cleared in this mutation.
+func (m *PasswordMutation) GroupsCleared() bool {
+	_, ok := m.clearedFields[password.FieldGroups]
+	return ok
+}
+
+// ResetGroups resets all changes to the "groups" field.
+func (m *PasswordMutation) ResetGroups() {
+	m.groups = nil
+	delete(m.clearedFields, password.FieldGroups)
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *PasswordMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *PasswordMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *PasswordMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetAttributes sets the "attributes" field.
+func (m *PasswordMutation) SetAttributes(value map[string]string) {
+	m.attributes = &value
+}
+
+// Attributes returns the value of the "attributes" field in the mutation.
+func (m *PasswordMutation) Attributes() (r map[string]string, exists bool) {
+	v := m.attributes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributes returns the old "attributes" field's value of the Password entity.
+// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordMutation) OldAttributes(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
+	}
+	return oldValue.Attributes, nil
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (m *PasswordMutation) ClearAttributes() {
+	m.attributes = nil
+	m.clearedFields[password.FieldAttributes] = struct{}{}
+}
+
+// AttributesCleared returns if the "attributes" field was cleared in this mutation.
+func (m *PasswordMutation) AttributesCleared() bool {
+	_, ok := m.clearedFields[password.FieldAttributes]
+	return ok
+}
+
+// ResetAttributes resets all changes to the "attributes" field.
+func (m *PasswordMutation) ResetAttributes() {
+	m.attributes = nil
+	delete(m.clearedFields, password.FieldAttributes)
+}
+
+// Where appends a list predicates to the PasswordMutation builder.
+func (m *PasswordMutation) Where(ps ...predicate.Password) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PasswordMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Password).
+func (m *PasswordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.email != nil {
+		fields = append(fields, password.FieldEmail)
+	}
+	if m.hash != nil {
+		fields = append(fields, password.FieldHash)
+	}
+	if m.previous_hashes != nil {
+		fields = append(fields, password.FieldPreviousHashes)
+	}
+	if m.username != nil {
+		fields = append(fields, password.FieldUsername)
+	}
+	if m.user_id != nil {
+		fields = append(fields, password.FieldUserID)
+	}
+	if m.pending_verification != nil {
+		fields = append(fields, password.FieldPendingVerification)
+	}
+	if m.pending_approval != nil {
+		fields = append(fields, password.FieldPendingApproval)
+	}
+	if m.groups != nil {
+		fields = append(fields, password.FieldGroups)
+	}
+	if m.display_name != nil {
+		fields = append(fields, password.FieldDisplayName)
+	}
+	if m.attributes != nil {
+		fields = append(fields, password.FieldAttributes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case password.FieldEmail:
+		return m.Email()
+	case password.FieldHash:
+		return m.Hash()
+	case password.FieldPreviousHashes:
+		return m.PreviousHashes()
+	case password.FieldUsername:
+		return m.Username()
+	case password.FieldUserID:
+		return m.UserID()
+	case password.FieldPendingVerification:
+		return m.PendingVerification()
+	case password.FieldPendingApproval:
+		return m.PendingApproval()
+	case password.FieldGroups:
+		return m.Groups()
+	case password.FieldDisplayName:
+		return m.DisplayName()
+	case password.FieldAttributes:
+		return m.Attributes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case password.FieldEmail:
+		return m.OldEmail(ctx)
+	case password.FieldHash:
+		return m.OldHash(ctx)
+	case password.FieldPreviousHashes:
+		return m.OldPreviousHashes(ctx)
+	case password.FieldUsername:
+		return m.OldUsername(ctx)
+	case password.FieldUserID:
+		return m.OldUserID(ctx)
+	case password.FieldPendingVerification:
+		return m.OldPendingVerification(ctx)
+	case password.FieldPendingApproval:
+		return m.OldPendingApproval(ctx)
+	case password.FieldGroups:
+		return m.OldGroups(ctx)
+	case password.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case password.FieldAttributes:
+		return m.OldAttributes(ctx)
+	}
+	return nil, fmt.Errorf("unknown Password field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case password.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case password.FieldHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case password.FieldPreviousHashes:
+		v, ok := value.([][]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousHashes(v)
+		return nil
+	case password.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case password.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case password.FieldPendingVerification:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingVerification(v)
+		return nil
+	case password.FieldPendingApproval:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingApproval(v)
+		return nil
+	case password.FieldGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroups(v)
+		return nil
+	case password.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case password.FieldAttributes:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Password field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Password numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(password.FieldPreviousHashes) {
+		fields = append(fields, password.FieldPreviousHashes)
+	}
+	if m.FieldCleared(password.FieldGroups) {
+		fields = append(fields, password.FieldGroups)
+	}
+	if m.FieldCleared(password.FieldAttributes) {
+		fields = append(fields, password.FieldAttributes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordMutation) ClearField(name string) error {
+	switch name {
+	case password.FieldPreviousHashes:
+		m.ClearPreviousHashes()
+		return nil
+	case password.FieldGroups:
+		m.ClearGroups()
+		return nil
+	case password.FieldAttributes:
+		m.ClearAttributes()
+		return nil
+	}
+	return fmt.Errorf("unknown Password nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordMutation) ResetField(name string) error {
+	switch name {
+	case password.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case password.FieldHash:
+		m.ResetHash()
+		return nil
+	case password.FieldPreviousHashes:
+		m.ResetPreviousHashes()
+		return nil
+	case password.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case password.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case password.FieldPendingVerification:
+		m.ResetPendingVerification()
+		return nil
+	case password.FieldPendingApproval:
+		m.ResetPendingApproval()
+		return nil
+	case password.FieldGroups:
+		m.ResetGroups()
+		return nil
+	case password.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case password.FieldAttributes:
+		m.ResetAttributes()
+		return nil
+	}
+	return fmt.Errorf("unknown Password field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Password unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Password edge %s", name)
+}
+
+// PasswordResetMutation represents an operation that mutates the PasswordReset nodes in the graph.
+type PasswordResetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	email         *string
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PasswordReset, error)
+	predicates    []predicate.PasswordReset
+}
+
+var _ ent.Mutation = (*PasswordResetMutation)(nil)
+
+// passwordresetOption allows management of the mutation configuration using functional options.
+type passwordresetOption func(*PasswordResetMutation)
+
+// newPasswordResetMutation creates new mutation for the PasswordReset entity.
+func newPasswordResetMutation(c config, op Op, opts ...passwordresetOption) *PasswordResetMutation {
+	m := &PasswordResetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasswordReset,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordResetID sets the ID field of the mutation.
+func withPasswordResetID(id string) passwordresetOption {
+	return func(m *PasswordResetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PasswordReset
+		)
+		m.oldValue = func(ctx context.Context) (*PasswordReset, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PasswordReset.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasswordReset sets the old PasswordReset of the mutation.
+func withPasswordReset(node *PasswordReset) passwordresetOption {
+	return func(m *PasswordResetMutation) {
+		m.oldValue = func(context.Context) (*PasswordReset, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordResetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordResetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PasswordReset entities.
+func (m *PasswordResetMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordResetMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordResetMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PasswordReset.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *PasswordResetMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *PasswordResetMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the PasswordReset entity.
+// If the PasswordReset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *PasswordResetMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *PasswordResetMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *PasswordResetMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the PasswordReset entity.
+// If the PasswordReset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *PasswordResetMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// Where appends a list predicates to the PasswordResetMutation builder.
+func (m *PasswordResetMutation) Where(ps ...predicate.PasswordReset) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PasswordResetMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PasswordReset).
+func (m *PasswordResetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordResetMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.email != nil {
+		fields = append(fields, passwordreset.FieldEmail)
+	}
+	if m.expiry != nil {
+		fields = append(fields, passwordreset.FieldExpiry)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordResetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordreset.FieldEmail:
+		return m.Email()
+	case passwordreset.FieldExpiry:
+		return m.Expiry()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordResetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordreset.FieldEmail:
+		return m.OldEmail(ctx)
+	case passwordreset.FieldExpiry:
+		return m.OldExpiry(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordReset field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordreset.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case passwordreset.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordReset field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordResetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordResetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PasswordReset numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordResetMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordResetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordResetMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PasswordReset nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordResetMutation) ResetField(name string) error {
+	switch name {
+	case passwordreset.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case passwordreset.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordReset field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordResetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordResetMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordResetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordResetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordResetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordResetMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordResetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PasswordReset unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordResetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PasswordReset edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	client_id                 *string
+	scopes                    *[]string
+	nonce                     *string
+	claims_user_id            *string
+	claims_username           *string
+	claims_email              *string
+	claims_email_verified     *bool
+	claims_groups             *[]string
+	claims_preferred_username *string
+	claims_acr                *string
+	connector_id              *string
+	connector_data            *[]byte
+	token                     *string
+	obsolete_token            *string
+	retired_tokens            *[]string
+	created_at                *time.Time
+	last_used                 *time.Time
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*RefreshToken, error)
+	predicates                []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id string) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RefreshToken entities.
+func (m *RefreshTokenMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetClientID sets the "client_id" field.
+func (m *RefreshTokenMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *RefreshTokenMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *RefreshTokenMutation) ResetClientID() {
+	m.client_id = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *RefreshTokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *RefreshTokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *RefreshTokenMutation) ClearScopes() {
+	m.scopes = nil
+	m.clearedFields[refreshtoken.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *RefreshTokenMutation) ResetScopes() {
+	m.scopes = nil
+	delete(m.clearedFields, refreshtoken.FieldScopes)
+}
+
+// SetNonce sets the "nonce" field.
+func (m *RefreshTokenMutation) SetNonce(s string) {
+	m.nonce = &s
+}
+
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *RefreshTokenMutation) Nonce() (r string, exists bool) {
+	v := m.nonce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNonce returns the old "nonce" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldNonce(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNonce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+	}
+	return oldValue.Nonce, nil
+}
+
+// ResetNonce resets all changes to the "nonce" field.
+func (m *RefreshTokenMutation) ResetNonce() {
+	m.nonce = nil
+}
+
+// SetClaimsUserID sets the "claims_user_id" field.
+func (m *RefreshTokenMutation) SetClaimsUserID(s string) {
+	m.claims_user_id = &s
+}
+
+// ClaimsUserID returns the value of the "claims_user_id" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsUserID() (r string, exists bool) {
+	v := m.claims_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsUserID returns the old "claims_user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsUserID: %w", err)
+	}
+	return oldValue.ClaimsUserID, nil
+}
+
+// ResetClaimsUserID resets all changes to the "claims_user_id" field.
+func (m *RefreshTokenMutation) ResetClaimsUserID() {
+	m.claims_user_id = nil
+}
+
+// SetClaimsUsername sets the "claims_username" field.
+func (m *RefreshTokenMutation) SetClaimsUsername(s string) {
+	m.claims_username = &s
+}
+
+// ClaimsUsername returns the value of the "claims_username" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsUsername() (r string, exists bool) {
+	v := m.claims_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsUsername returns the old "claims_username" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsUsername: %w", err)
+	}
+	return oldValue.ClaimsUsername, nil
+}
+
+// ResetClaimsUsername resets all changes to the "claims_username" field.
+func (m *RefreshTokenMutation) ResetClaimsUsername() {
+	m.claims_username = nil
+}
+
+// SetClaimsEmail sets the "claims_email" field.
+func (m *RefreshTokenMutation) SetClaimsEmail(s string) {
+	m.claims_email = &s
+}
+
+// ClaimsEmail returns the value of the "claims_email" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsEmail() (r string, exists bool) {
+	v := m.claims_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsEmail returns the old "claims_email" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsEmail: %w", err)
+	}
+	return oldValue.ClaimsEmail, nil
+}
+
+// ResetClaimsEmail resets all changes to the "claims_email" field.
+func (m *RefreshTokenMutation) ResetClaimsEmail() {
+	m.claims_email = nil
+}
+
+// SetClaimsEmailVerified sets the "claims_email_verified" field.
+func (m *RefreshTokenMutation) SetClaimsEmailVerified(b bool) {
+	m.claims_email_verified = &b
+}
+
+// ClaimsEmailVerified returns the value of the "claims_email_verified" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsEmailVerified() (r bool, exists bool) {
+	v := m.claims_email_verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsEmailVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsEmailVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsEmailVerified: %w", err)
+	}
+	return oldValue.ClaimsEmailVerified, nil
+}
+
+// ResetClaimsEmailVerified resets all changes to the "claims_email_verified" field.
+func (m *RefreshTokenMutation) ResetClaimsEmailVerified() {
+	m.claims_email_verified = nil
+}
+
+// SetClaimsGroups sets the "claims_groups" field.
+func (m *RefreshTokenMutation) SetClaimsGroups(s []string) {
+	m.claims_groups = &s
+}
+
+// ClaimsGroups returns the value of the "claims_groups" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsGroups() (r []string, exists bool) {
+	v := m.claims_groups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsGroups returns the old "claims_groups" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsGroups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsGroups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsGroups: %w", err)
+	}
+	return oldValue.ClaimsGroups, nil
+}
+
+// ClearClaimsGroups clears the value of the "claims_groups" field.
+func (m *RefreshTokenMutation) ClearClaimsGroups() {
+	m.claims_groups = nil
+	m.clearedFields[refreshtoken.FieldClaimsGroups] = struct{}{}
+}
+
+// ClaimsGroupsCleared returns if the "claims_groups" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ClaimsGroupsCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldClaimsGroups]
+	return ok
+}
+
+// ResetClaimsGroups resets all changes to the "claims_groups" field.
+func (m *RefreshTokenMutation) ResetClaimsGroups() {
+	m.claims_groups = nil
+	delete(m.clearedFields, refreshtoken.FieldClaimsGroups)
+}
+
+// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
+func (m *RefreshTokenMutation) SetClaimsPreferredUsername(s string) {
+	m.claims_preferred_username = &s
+}
+
+// ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsPreferredUsername() (r string, exists bool) {
+	v := m.claims_preferred_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsPreferredUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsPreferredUsername: %w", err)
+	}
+	return oldValue.ClaimsPreferredUsername, nil
+}
+
+// ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
+func (m *RefreshTokenMutation) ResetClaimsPreferredUsername() {
+	m.claims_preferred_username = nil
+}
+
+// SetClaimsAcr sets the "claims_acr" field.
+func (m *RefreshTokenMutation) SetClaimsAcr(s string) {
+	m.claims_acr = &s
+}
+
+// ClaimsAcr returns the value of the "claims_acr" field in the mutation.
+func (m *RefreshTokenMutation) ClaimsAcr() (r string, exists bool) {
+	v := m.claims_acr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimsAcr returns the old "claims_acr" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldClaimsAcr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimsAcr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimsAcr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimsAcr: %w", err)
+	}
+	return oldValue.ClaimsAcr, nil
+}
+
+// ResetClaimsAcr resets all changes to the "claims_acr" field.
+func (m *RefreshTokenMutation) ResetClaimsAcr() {
+	m.claims_acr = nil
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (m *RefreshTokenMutation) SetConnectorID(s string) {
+	m.connector_id = &s
+}
+
+// ConnectorID returns the value of the "connector_id" field in the mutation.
+func (m *RefreshTokenMutation) ConnectorID() (r string, exists bool) {
+	v := m.connector_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectorID returns the old "connector_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldConnectorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectorID: %w", err)
+	}
+	return oldValue.ConnectorID, nil
+}
+
+// ResetConnectorID resets all changes to the "connector_id" field.
+func (m *RefreshTokenMutation) ResetConnectorID() {
+	m.connector_id = nil
+}
+
+// SetConnectorData sets the "connector_data" field.
+func (m *RefreshTokenMutation) SetConnectorData(b []byte) {
+	m.connector_data = &b
+}
+
+// ConnectorData returns the value of the "connector_data" field in the mutation.
+func (m *RefreshTokenMutation) ConnectorData() (r []byte, exists bool) {
+	v := m.connector_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnectorData returns the old "connector_data" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+	}
+	return oldValue.ConnectorData, nil
+}
+
+// ClearConnectorData clears the value of the "connector_data" field.
+func (m *RefreshTokenMutation) ClearConnectorData() {
+	m.connector_data = nil
+	m.clearedFields[refreshtoken.FieldConnectorData] = struct{}{}
+}
+
+// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ConnectorDataCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldConnectorData]
+	return ok
+}
+
+// ResetConnectorData resets all changes to the "connector_data" field.
+func (m *RefreshTokenMutation) ResetConnectorData() {
+	m.connector_data = nil
+	delete(m.clearedFields, refreshtoken.FieldConnectorData)
+}
+
+// SetToken sets the "token" field.
+func (m *RefreshTokenMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *RefreshTokenMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *RefreshTokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetObsoleteToken sets the "obsolete_token" field.
+func (m *RefreshTokenMutation) SetObsoleteToken(s string) {
+	m.obsolete_token = &s
+}
+
+// ObsoleteToken returns the value of the "obsolete_token" field in the mutation.
+func (m *RefreshTokenMutation) ObsoleteToken() (r string, exists bool) {
+	v := m.obsolete_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObsoleteToken returns the old "obsolete_token" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldObsoleteToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObsoleteToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObsoleteToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObsoleteToken: %w", err)
+	}
+	return oldValue.ObsoleteToken, nil
+}
+
+// ResetObsoleteToken resets all changes to the "obsolete_token" field.
+func (m *RefreshTokenMutation) ResetObsoleteToken() {
+	m.obsolete_token = nil
+}
+
+// SetRetiredTokens sets the "retired_tokens" field.
+func (m *RefreshTokenMutation) SetRetiredTokens(s []string) {
+	m.retired_tokens = &s
+}
+
+// RetiredTokens returns the value of the "retired_tokens" field in the mutation.
+func (m *RefreshTokenMutation) RetiredTokens() (r []string, exists bool) {
+	v := m.retired_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetiredTokens returns the old "retired_tokens" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRetiredTokens(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetiredTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetiredTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetiredTokens: %w", err)
+	}
+	return oldValue.RetiredTokens, nil
+}
+
+// ClearRetiredTokens clears the value of the "retired_tokens" field.
+func (m *RefreshTokenMutation) ClearRetiredTokens() {
+	m.retired_tokens = nil
+	m.clearedFields[refreshtoken.FieldRetiredTokens] = struct{}{}
+}
+
+// RetiredTokensCleared returns if the "retired_tokens" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RetiredTokensCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRetiredTokens]
+	return ok
+}
+
+// ResetRetiredTokens resets all changes to the "retired_tokens" field.
+func (m *RefreshTokenMutation) ResetRetiredTokens() {
+	m.retired_tokens = nil
+	delete(m.clearedFields, refreshtoken.FieldRetiredTokens)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastUsed sets the "last_used" field.
+func (m *RefreshTokenMutation) SetLastUsed(t time.Time) {
+	m.last_used = &t
+}
+
+// LastUsed returns the value of the "last_used" field in the mutation.
+func (m *RefreshTokenMutation) LastUsed() (r time.Time, exists bool) {
+	v := m.last_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsed returns the old "last_used" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldLastUsed(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
+	}
+	return oldValue.LastUsed, nil
+}
+
+// ResetLastUsed resets all changes to the "last_used" field.
+func (m *RefreshTokenMutation) ResetLastUsed() {
+	m.last_used = nil
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.client_id != nil {
+		fields = append(fields, refreshtoken.FieldClientID)
+	}
+	if m.scopes != nil {
+		fields = append(fields, refreshtoken.FieldScopes)
+	}
+	if m.nonce != nil {
+		fields = append(fields, refreshtoken.FieldNonce)
+	}
+	if m.claims_user_id != nil {
+		fields = append(fields, refreshtoken.FieldClaimsUserID)
+	}
+	if m.claims_username != nil {
+		fields = append(fields, refreshtoken.FieldClaimsUsername)
+	}
+	if m.claims_email != nil {
+		fields = append(fields, refreshtoken.FieldClaimsEmail)
+	}
+	if m.claims_email_verified != nil {
+		fields = append(fields, refreshtoken.FieldClaimsEmailVerified)
+	}
+	if m.claims_groups != nil {
+		fields = append(fields, refreshtoken.FieldClaimsGroups)
+	}
+	if m.claims_preferred_username != nil {
+		fields = append(fields, refreshtoken.FieldClaimsPreferredUsername)
+	}
+	if m.claims_acr != nil {
+		fields = append(fields, refreshtoken.FieldClaimsAcr)
+	}
+	if m.connector_id != nil {
+		fields = append(fields, refreshtoken.FieldConnectorID)
+	}
+	if m.connector_data != nil {
+		fields = append(fields, refreshtoken.FieldConnectorData)
+	}
+	if m.token != nil {
+		fields = append(fields, refreshtoken.FieldToken)
+	}
+	if m.obsolete_token != nil {
+		fields = append(fields, refreshtoken.FieldObsoleteToken)
+	}
+	if m.retired_tokens != nil {
+		fields = append(fields, refreshtoken.FieldRetiredTokens)
+	}
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
+	}
+	if m.last_used != nil {
+		fields = append(fields, refreshtoken.FieldLastUsed)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldClientID:
+		return m.ClientID()
+	case refreshtoken.FieldScopes:
+		return m.Scopes()
+	case refreshtoken.FieldNonce:
+		return m.Nonce()
+	case refreshtoken.FieldClaimsUserID:
+		return m.ClaimsUserID()
+	case refreshtoken.FieldClaimsUsername:
+		return m.ClaimsUsername()
+	case refreshtoken.FieldClaimsEmail:
+		return m.ClaimsEmail()
+	case refreshtoken.FieldClaimsEmailVerified:
+		return m.ClaimsEmailVerified()
+	case refreshtoken.FieldClaimsGroups:
+		return m.ClaimsGroups()
+	case refreshtoken.FieldClaimsPreferredUsername:
+		return m.ClaimsPreferredUsername()
+	case refreshtoken.FieldClaimsAcr:
+		return m.ClaimsAcr()
+	case refreshtoken.FieldConnectorID:
+		return m.ConnectorID()
+	case refreshtoken.FieldConnectorData:
+		return m.ConnectorData()
+	case refreshtoken.FieldToken:
+		return m.Token()
+	case refreshtoken.FieldObsoleteToken:
+		return m.ObsoleteToken()
+	case refreshtoken.FieldRetiredTokens:
+		return m.RetiredTokens()
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case refreshtoken.FieldLastUsed:
+		return m.LastUsed()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldClientID:
+		return m.OldClientID(ctx)
+	case refreshtoken.FieldScopes:
+		return m.OldScopes(ctx)
+	case refreshtoken.FieldNonce:
+		return m.OldNonce(ctx)
+	case refreshtoken.FieldClaimsUserID:
+		return m.OldClaimsUserID(ctx)
+	case refreshtoken.FieldClaimsUsername:
+		return m.OldClaimsUsername(ctx)
+	case refreshtoken.FieldClaimsEmail:
+		return m.OldClaimsEmail(ctx)
+	case refreshtoken.FieldClaimsEmailVerified:
+		return m.OldClaimsEmailVerified(ctx)
+	case refreshtoken.FieldClaimsGroups:
+		return m.OldClaimsGroups(ctx)
+	case refreshtoken.FieldClaimsPreferredUsername:
+		return m.OldClaimsPreferredUsername(ctx)
+	case refreshtoken.FieldClaimsAcr:
+		return m.OldClaimsAcr(ctx)
+	case refreshtoken.FieldConnectorID:
+		return m.OldConnectorID(ctx)
+	case refreshtoken.FieldConnectorData:
+		return m.OldConnectorData(ctx)
+	case refreshtoken.FieldToken:
+		return m.OldToken(ctx)
+	case refreshtoken.FieldObsoleteToken:
+		return m.OldObsoleteToken(ctx)
+	case refreshtoken.FieldRetiredTokens:
+		return m.OldRetiredTokens(ctx)
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case refreshtoken.FieldLastUsed:
+		return m.OldLastUsed(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case refreshtoken.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case refreshtoken.FieldNonce:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNonce(v)
+		return nil
+	case refreshtoken.FieldClaimsUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsUserID(v)
+		return nil
+	case refreshtoken.FieldClaimsUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsUsername(v)
+		return nil
+	case refreshtoken.FieldClaimsEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsEmail(v)
+		return nil
+	case refreshtoken.FieldClaimsEmailVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsEmailVerified(v)
+		return nil
+	case refreshtoken.FieldClaimsGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsGroups(v)
+		return nil
+	case refreshtoken.FieldClaimsPreferredUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsPreferredUsername(v)
+		return nil
+	case refreshtoken.FieldClaimsAcr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimsAcr(v)
+		return nil
+	case refreshtoken.FieldConnectorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectorID(v)
+		return nil
+	case refreshtoken.FieldConnectorData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnectorData(v)
+		return nil
+	case refreshtoken.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case refreshtoken.FieldObsoleteToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObsoleteToken(v)
+		return nil
+	case refreshtoken.FieldRetiredTokens:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetiredTokens(v)
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case refreshtoken.FieldLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldScopes) {
+		fields = append(fields, refreshtoken.FieldScopes)
+	}
+	if m.FieldCleared(refreshtoken.FieldClaimsGroups) {
+		fields = append(fields, refreshtoken.FieldClaimsGroups)
+	}
+	if m.FieldCleared(refreshtoken.FieldConnectorData) {
+		fields = append(fields, refreshtoken.FieldConnectorData)
+	}
+	if m.FieldCleared(refreshtoken.FieldRetiredTokens) {
+		fields = append(fields, refreshtoken.FieldRetiredTokens)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case refreshtoken.FieldClaimsGroups:
+		m.ClearClaimsGroups()
+		return nil
+	case refreshtoken.FieldConnectorData:
+		m.ClearConnectorData()
+		return nil
+	case refreshtoken.FieldRetiredTokens:
+		m.ClearRetiredTokens()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case refreshtoken.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case refreshtoken.FieldNonce:
+		m.ResetNonce()
+		return nil
+	case refreshtoken.FieldClaimsUserID:
+		m.ResetClaimsUserID()
+		return nil
+	case refreshtoken.FieldClaimsUsername:
+		m.ResetClaimsUsername()
+		return nil
+	case refreshtoken.FieldClaimsEmail:
+		m.ResetClaimsEmail()
+		return nil
+	case refreshtoken.FieldClaimsEmailVerified:
+		m.ResetClaimsEmailVerified()
+		return nil
+	case refreshtoken.FieldClaimsGroups:
+		m.ResetClaimsGroups()
+		return nil
+	case refreshtoken.FieldClaimsPreferredUsername:
+		m.ResetClaimsPreferredUsername()
+		return nil
+	case refreshtoken.FieldClaimsAcr:
+		m.ResetClaimsAcr()
+		return nil
+	case refreshtoken.FieldConnectorID:
+		m.ResetConnectorID()
+		return nil
+	case refreshtoken.FieldConnectorData:
+		m.ResetConnectorData()
+		return nil
+	case refreshtoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case refreshtoken.FieldObsoleteToken:
+		m.ResetObsoleteToken()
+		return nil
+	case refreshtoken.FieldRetiredTokens:
+		m.ResetRetiredTokens()
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case refreshtoken.FieldLastUsed:
+		m.ResetLastUsed()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// SAMLAssertionMutation represents an operation that mutates the SAMLAssertion nodes in the graph.
+type SAMLAssertionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	expiry        *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SAMLAssertion, error)
+	predicates    []predicate.SAMLAssertion
+}
+
+var _ ent.Mutation = (*SAMLAssertionMutation)(nil)
+
+// samlassertionOption allows management of the mutation configuration using functional options.
+type samlassertionOption func(*SAMLAssertionMutation)
+
+// newSAMLAssertionMutation creates new mutation for the SAMLAssertion entity.
+func newSAMLAssertionMutation(c config, op Op, opts ...samlassertionOption) *SAMLAssertionMutation {
+	m := &SAMLAssertionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSAMLAssertion,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldName returns the old "name" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+// withSAMLAssertionID sets the ID field of the mutation.
+func withSAMLAssertionID(id string) samlassertionOption {
+	return func(m *SAMLAssertionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SAMLAssertion
+		)
+		m.oldValue = func(ctx context.Context) (*SAMLAssertion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SAMLAssertion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+}
+
+// withSAMLAssertion sets the old SAMLAssertion of the mutation.
+func withSAMLAssertion(node *SAMLAssertion) samlassertionOption {
+	return func(m *SAMLAssertionMutation) {
+		m.oldValue = func(context.Context) (*SAMLAssertion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SAMLAssertionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SAMLAssertionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
 	}
-	return oldValue.Name, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *OAuth2ClientMutation) ResetName() {
-	m.name = nil
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SAMLAssertion entities.
+func (m *SAMLAssertionMutation) SetID(id string) {
+	m.id = &id
 }
 
-// SetLogoURL sets the "logo_url" field.
-func (m *OAuth2ClientMutation) SetLogoURL(s string) {
-	m.logo_url = &s
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SAMLAssertionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// LogoURL returns the value of the "logo_url" field in the mutation.
-func (m *OAuth2ClientMutation) LogoURL() (r string, exists bool) {
-	v := m.logo_url
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SAMLAssertionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SAMLAssertion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *SAMLAssertionMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *SAMLAssertionMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLogoURL returns the old "logo_url" field's value of the OAuth2Client entity.
-// If the OAuth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiry returns the old "expiry" field's value of the SAMLAssertion entity.
+// If the SAMLAssertion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OAuth2ClientMutation) OldLogoURL(ctx context.Context) (v string, err error) {
+func (m *SAMLAssertionMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
 	}
-	return oldValue.LogoURL, nil
+	return oldValue.Expiry, nil
 }
 
-// ResetLogoURL resets all changes to the "logo_url" field.
-func (m *OAuth2ClientMutation) ResetLogoURL() {
-	m.logo_url = nil
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *SAMLAssertionMutation) ResetExpiry() {
+	m.expiry = nil
 }
 
-// Where appends a list predicates to the OAuth2ClientMutation builder.
-func (m *OAuth2ClientMutation) Where(ps ...predicate.OAuth2Client) {
+// Where appends a list predicates to the SAMLAssertionMutation builder.
+func (m *SAMLAssertionMutation) Where(ps ...predicate.SAMLAssertion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *OAuth2ClientMutation) Op() Op {
+func (m *SAMLAssertionMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (OAuth2Client).
-func (m *OAuth2ClientMutation) Type() string {
+// Type returns the node type of this mutation (SAMLAssertion).
+func (m *SAMLAssertionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OAuth2ClientMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.secret != nil {
-		fields = append(fields, oauth2client.FieldSecret)
-	}
-	if m.redirect_uris != nil {
-		fields = append(fields, oauth2client.FieldRedirectUris)
-	}
-	if m.trusted_peers != nil {
-		fields = append(fields, oauth2client.FieldTrustedPeers)
-	}
-	if m.public != nil {
-		fields = append(fields, oauth2client.FieldPublic)
-	}
-	if m.name != nil {
-		fields = append(fields, oauth2client.FieldName)
-	}
-	if m.logo_url != nil {
-		fields = append(fields, oauth2client.FieldLogoURL)
+func (m *SAMLAssertionMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.expiry != nil {
+		fields = append(fields, samlassertion.FieldExpiry)
 	}
 	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *OAuth2ClientMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case oauth2client.FieldSecret:
-		return m.Secret()
-	case oauth2client.FieldRedirectUris:
-		return m.RedirectUris()
-	case oauth2client.FieldTrustedPeers:
-		return m.TrustedPeers()
-	case oauth2client.FieldPublic:
-		return m.Public()
-	case oauth2client.FieldName:
-		return m.Name()
-	case oauth2client.FieldLogoURL:
-		return m.LogoURL()
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SAMLAssertionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case samlassertion.FieldExpiry:
+		return m.Expiry()
 	}
 	return nil, false
 }
@@ -5177,232 +11119,157 @@ func (m *OAuth2ClientMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OAuth2ClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SAMLAssertionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case oauth2client.FieldSecret:
-		return m.OldSecret(ctx)
-	case oauth2client.FieldRedirectUris:
-		return m.OldRedirectUris(ctx)
-	case oauth2client.FieldTrustedPeers:
-		return m.OldTrustedPeers(ctx)
-	case oauth2client.FieldPublic:
-		return m.OldPublic(ctx)
-	case oauth2client.FieldName:
-		return m.OldName(ctx)
-	case oauth2client.FieldLogoURL:
-		return m.OldLogoURL(ctx)
+	case samlassertion.FieldExpiry:
+		return m.OldExpiry(ctx)
 	}
-	return nil, fmt.Errorf("unknown OAuth2Client field %s", name)
+	return nil, fmt.Errorf("unknown SAMLAssertion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OAuth2ClientMutation) SetField(name string, value ent.Value) error {
+func (m *SAMLAssertionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case oauth2client.FieldSecret:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSecret(v)
-		return nil
-	case oauth2client.FieldRedirectUris:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRedirectUris(v)
-		return nil
-	case oauth2client.FieldTrustedPeers:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTrustedPeers(v)
-		return nil
-	case oauth2client.FieldPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPublic(v)
-		return nil
-	case oauth2client.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case oauth2client.FieldLogoURL:
-		v, ok := value.(string)
+	case samlassertion.FieldExpiry:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLogoURL(v)
+		m.SetExpiry(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OAuth2Client field %s", name)
+	return fmt.Errorf("unknown SAMLAssertion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OAuth2ClientMutation) AddedFields() []string {
+func (m *SAMLAssertionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OAuth2ClientMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SAMLAssertionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OAuth2ClientMutation) AddField(name string, value ent.Value) error {
+func (m *SAMLAssertionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown OAuth2Client numeric field %s", name)
+	return fmt.Errorf("unknown SAMLAssertion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OAuth2ClientMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(oauth2client.FieldRedirectUris) {
-		fields = append(fields, oauth2client.FieldRedirectUris)
-	}
-	if m.FieldCleared(oauth2client.FieldTrustedPeers) {
-		fields = append(fields, oauth2client.FieldTrustedPeers)
-	}
-	return fields
+func (m *SAMLAssertionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OAuth2ClientMutation) FieldCleared(name string) bool {
+func (m *SAMLAssertionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OAuth2ClientMutation) ClearField(name string) error {
-	switch name {
-	case oauth2client.FieldRedirectUris:
-		m.ClearRedirectUris()
-		return nil
-	case oauth2client.FieldTrustedPeers:
-		m.ClearTrustedPeers()
-		return nil
-	}
-	return fmt.Errorf("unknown OAuth2Client nullable field %s", name)
+func (m *SAMLAssertionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SAMLAssertion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OAuth2ClientMutation) ResetField(name string) error {
+func (m *SAMLAssertionMutation) ResetField(name string) error {
 	switch name {
-	case oauth2client.FieldSecret:
-		m.ResetSecret()
-		return nil
-	case oauth2client.FieldRedirectUris:
-		m.ResetRedirectUris()
-		return nil
-	case oauth2client.FieldTrustedPeers:
-		m.ResetTrustedPeers()
-		return nil
-	case oauth2client.FieldPublic:
-		m.ResetPublic()
-		return nil
-	case oauth2client.FieldName:
-		m.ResetName()
-		return nil
-	case oauth2client.FieldLogoURL:
-		m.ResetLogoURL()
+	case samlassertion.FieldExpiry:
+		m.ResetExpiry()
 		return nil
 	}
-	return fmt.Errorf("unknown OAuth2Client field %s", name)
+	return fmt.Errorf("unknown SAMLAssertion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OAuth2ClientMutation) AddedEdges() []string {
+func (m *SAMLAssertionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OAuth2ClientMutation) AddedIDs(name string) []ent.Value {
+func (m *SAMLAssertionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OAuth2ClientMutation) RemovedEdges() []string {
+func (m *SAMLAssertionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OAuth2ClientMutation) RemovedIDs(name string) []ent.Value {
+func (m *SAMLAssertionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OAuth2ClientMutation) ClearedEdges() []string {
+func (m *SAMLAssertionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OAuth2ClientMutation) EdgeCleared(name string) bool {
+func (m *SAMLAssertionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OAuth2ClientMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown OAuth2Client unique edge %s", name)
+func (m *SAMLAssertionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SAMLAssertion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OAuth2ClientMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown OAuth2Client edge %s", name)
+func (m *SAMLAssertionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SAMLAssertion edge %s", name)
 }
 
-// OfflineSessionMutation represents an operation that mutates the OfflineSession nodes in the graph.
-type OfflineSessionMutation struct {
+// SAMLServiceProviderMutation represents an operation that mutates the SAMLServiceProvider nodes in the graph.
+type SAMLServiceProviderMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *string
-	user_id        *string
-	conn_id        *string
-	refresh        *[]byte
-	connector_data *[]byte
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*OfflineSession, error)
-	predicates     []predicate.OfflineSession
-}
-
-var _ ent.Mutation = (*OfflineSessionMutation)(nil)
-
-// offlinesessionOption allows management of the mutation configuration using functional options.
-type offlinesessionOption func(*OfflineSessionMutation)
-
-// newOfflineSessionMutation creates new mutation for the OfflineSession entity.
-func newOfflineSessionMutation(c config, op Op, opts ...offlinesessionOption) *OfflineSessionMutation {
-	m := &OfflineSessionMutation{
+	op              Op
+	typ             string
+	id              *string
+	name            *string
+	acs_urls        *[]string
+	name_id_format  *string
+	attribute_names *map[string]string
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*SAMLServiceProvider, error)
+	predicates      []predicate.SAMLServiceProvider
+}
+
+var _ ent.Mutation = (*SAMLServiceProviderMutation)(nil)
+
+// samlserviceproviderOption allows management of the mutation configuration using functional options.
+type samlserviceproviderOption func(*SAMLServiceProviderMutation)
+
+// newSAMLServiceProviderMutation creates new mutation for the SAMLServiceProvider entity.
+func newSAMLServiceProviderMutation(c config, op Op, opts ...samlserviceproviderOption) *SAMLServiceProviderMutation {
+	m := &SAMLServiceProviderMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOfflineSession,
+		typ:           TypeSAMLServiceProvider,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5411,20 +11278,20 @@ func newOfflineSessionMutation(c config, op Op, opts ...offlinesessionOption) *O
 	return m
 }
 
-// withOfflineSessionID sets the ID field of the mutation.
-func withOfflineSessionID(id string) offlinesessionOption {
-	return func(m *OfflineSessionMutation) {
+// withSAMLServiceProviderID sets the ID field of the mutation.
+func withSAMLServiceProviderID(id string) samlserviceproviderOption {
+	return func(m *SAMLServiceProviderMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OfflineSession
+			value *SAMLServiceProvider
 		)
-		m.oldValue = func(ctx context.Context) (*OfflineSession, error) {
+		m.oldValue = func(ctx context.Context) (*SAMLServiceProvider, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OfflineSession.Get(ctx, id)
+					value, err = m.Client().SAMLServiceProvider.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5433,10 +11300,10 @@ func withOfflineSessionID(id string) offlinesessionOption {
 	}
 }
 
-// withOfflineSession sets the old OfflineSession of the mutation.
-func withOfflineSession(node *OfflineSession) offlinesessionOption {
-	return func(m *OfflineSessionMutation) {
-		m.oldValue = func(context.Context) (*OfflineSession, error) {
+// withSAMLServiceProvider sets the old SAMLServiceProvider of the mutation.
+func withSAMLServiceProvider(node *SAMLServiceProvider) samlserviceproviderOption {
+	return func(m *SAMLServiceProviderMutation) {
+		m.oldValue = func(context.Context) (*SAMLServiceProvider, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5445,7 +11312,7 @@ func withOfflineSession(node *OfflineSession) offlinesessionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OfflineSessionMutation) Client() *Client {
+func (m SAMLServiceProviderMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5453,7 +11320,7 @@ func (m OfflineSessionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OfflineSessionMutation) Tx() (*Tx, error) {
+func (m SAMLServiceProviderMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -5463,14 +11330,14 @@ func (m OfflineSessionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of OfflineSession entities.
-func (m *OfflineSessionMutation) SetID(id string) {
+// operation is only accepted on creation of SAMLServiceProvider entities.
+func (m *SAMLServiceProviderMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OfflineSessionMutation) ID() (id string, exists bool) {
+func (m *SAMLServiceProviderMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5481,7 +11348,7 @@ func (m *OfflineSessionMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OfflineSessionMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *SAMLServiceProviderMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5490,200 +11357,213 @@ func (m *OfflineSessionMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OfflineSession.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SAMLServiceProvider.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *OfflineSessionMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetName sets the "name" field.
+func (m *SAMLServiceProviderMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *OfflineSessionMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// Name returns the value of the "name" field in the mutation.
+func (m *SAMLServiceProviderMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the OfflineSession entity.
-// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the SAMLServiceProvider entity.
+// If the SAMLServiceProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OfflineSessionMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *SAMLServiceProviderMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Name, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *OfflineSessionMutation) ResetUserID() {
-	m.user_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *SAMLServiceProviderMutation) ResetName() {
+	m.name = nil
 }
 
-// SetConnID sets the "conn_id" field.
-func (m *OfflineSessionMutation) SetConnID(s string) {
-	m.conn_id = &s
+// SetAcsUrls sets the "acs_urls" field.
+func (m *SAMLServiceProviderMutation) SetAcsUrls(s []string) {
+	m.acs_urls = &s
 }
 
-// ConnID returns the value of the "conn_id" field in the mutation.
-func (m *OfflineSessionMutation) ConnID() (r string, exists bool) {
-	v := m.conn_id
+// AcsUrls returns the value of the "acs_urls" field in the mutation.
+func (m *SAMLServiceProviderMutation) AcsUrls() (r []string, exists bool) {
+	v := m.acs_urls
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnID returns the old "conn_id" field's value of the OfflineSession entity.
-// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// OldAcsUrls returns the old "acs_urls" field's value of the SAMLServiceProvider entity.
+// If the SAMLServiceProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OfflineSessionMutation) OldConnID(ctx context.Context) (v string, err error) {
+func (m *SAMLServiceProviderMutation) OldAcsUrls(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAcsUrls is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnID requires an ID field in the mutation")
+		return v, errors.New("OldAcsUrls requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAcsUrls: %w", err)
 	}
-	return oldValue.ConnID, nil
+	return oldValue.AcsUrls, nil
 }
 
-// ResetConnID resets all changes to the "conn_id" field.
-func (m *OfflineSessionMutation) ResetConnID() {
-	m.conn_id = nil
+// ClearAcsUrls clears the value of the "acs_urls" field.
+func (m *SAMLServiceProviderMutation) ClearAcsUrls() {
+	m.acs_urls = nil
+	m.clearedFields[samlserviceprovider.FieldAcsUrls] = struct{}{}
 }
 
-// SetRefresh sets the "refresh" field.
-func (m *OfflineSessionMutation) SetRefresh(b []byte) {
-	m.refresh = &b
+// AcsUrlsCleared returns if the "acs_urls" field was cleared in this mutation.
+func (m *SAMLServiceProviderMutation) AcsUrlsCleared() bool {
+	_, ok := m.clearedFields[samlserviceprovider.FieldAcsUrls]
+	return ok
 }
 
-// Refresh returns the value of the "refresh" field in the mutation.
-func (m *OfflineSessionMutation) Refresh() (r []byte, exists bool) {
-	v := m.refresh
+// ResetAcsUrls resets all changes to the "acs_urls" field.
+func (m *SAMLServiceProviderMutation) ResetAcsUrls() {
+	m.acs_urls = nil
+	delete(m.clearedFields, samlserviceprovider.FieldAcsUrls)
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (m *SAMLServiceProviderMutation) SetNameIDFormat(s string) {
+	m.name_id_format = &s
+}
+
+// NameIDFormat returns the value of the "name_id_format" field in the mutation.
+func (m *SAMLServiceProviderMutation) NameIDFormat() (r string, exists bool) {
+	v := m.name_id_format
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefresh returns the old "refresh" field's value of the OfflineSession entity.
-// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// OldNameIDFormat returns the old "name_id_format" field's value of the SAMLServiceProvider entity.
+// If the SAMLServiceProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OfflineSessionMutation) OldRefresh(ctx context.Context) (v []byte, err error) {
+func (m *SAMLServiceProviderMutation) OldNameIDFormat(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefresh is only allowed on UpdateOne operations")
+		return v, errors.New("OldNameIDFormat is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefresh requires an ID field in the mutation")
+		return v, errors.New("OldNameIDFormat requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefresh: %w", err)
+		return v, fmt.Errorf("querying old value for OldNameIDFormat: %w", err)
 	}
-	return oldValue.Refresh, nil
+	return oldValue.NameIDFormat, nil
 }
 
-// ResetRefresh resets all changes to the "refresh" field.
-func (m *OfflineSessionMutation) ResetRefresh() {
-	m.refresh = nil
+// ResetNameIDFormat resets all changes to the "name_id_format" field.
+func (m *SAMLServiceProviderMutation) ResetNameIDFormat() {
+	m.name_id_format = nil
 }
 
-// SetConnectorData sets the "connector_data" field.
-func (m *OfflineSessionMutation) SetConnectorData(b []byte) {
-	m.connector_data = &b
+// SetAttributeNames sets the "attribute_names" field.
+func (m *SAMLServiceProviderMutation) SetAttributeNames(value map[string]string) {
+	m.attribute_names = &value
 }
 
-// ConnectorData returns the value of the "connector_data" field in the mutation.
-func (m *OfflineSessionMutation) ConnectorData() (r []byte, exists bool) {
-	v := m.connector_data
+// AttributeNames returns the value of the "attribute_names" field in the mutation.
+func (m *SAMLServiceProviderMutation) AttributeNames() (r map[string]string, exists bool) {
+	v := m.attribute_names
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectorData returns the old "connector_data" field's value of the OfflineSession entity.
-// If the OfflineSession object wasn't provided to the builder, the object is fetched from the database.
+// OldAttributeNames returns the old "attribute_names" field's value of the SAMLServiceProvider entity.
+// If the SAMLServiceProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OfflineSessionMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+func (m *SAMLServiceProviderMutation) OldAttributeNames(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttributeNames is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+		return v, errors.New("OldAttributeNames requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttributeNames: %w", err)
 	}
-	return oldValue.ConnectorData, nil
+	return oldValue.AttributeNames, nil
 }
 
-// ClearConnectorData clears the value of the "connector_data" field.
-func (m *OfflineSessionMutation) ClearConnectorData() {
-	m.connector_data = nil
-	m.clearedFields[offlinesession.FieldConnectorData] = struct{}{}
+// ClearAttributeNames clears the value of the "attribute_names" field.
+func (m *SAMLServiceProviderMutation) ClearAttributeNames() {
+	m.attribute_names = nil
+	m.clearedFields[samlserviceprovider.FieldAttributeNames] = struct{}{}
 }
 
-// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
-func (m *OfflineSessionMutation) ConnectorDataCleared() bool {
-	_, ok := m.clearedFields[offlinesession.FieldConnectorData]
+// AttributeNamesCleared returns if the "attribute_names" field was cleared in this mutation.
+func (m *SAMLServiceProviderMutation) AttributeNamesCleared() bool {
+	_, ok := m.clearedFields[samlserviceprovider.FieldAttributeNames]
 	return ok
 }
 
-// ResetConnectorData resets all changes to the "connector_data" field.
-func (m *OfflineSessionMutation) ResetConnectorData() {
-	m.connector_data = nil
-	delete(m.clearedFields, offlinesession.FieldConnectorData)
+// ResetAttributeNames resets all changes to the "attribute_names" field.
+func (m *SAMLServiceProviderMutation) ResetAttributeNames() {
+	m.attribute_names = nil
+	delete(m.clearedFields, samlserviceprovider.FieldAttributeNames)
 }
 
-// Where appends a list predicates to the OfflineSessionMutation builder.
-func (m *OfflineSessionMutation) Where(ps ...predicate.OfflineSession) {
+// Where appends a list predicates to the SAMLServiceProviderMutation builder.
+func (m *SAMLServiceProviderMutation) Where(ps ...predicate.SAMLServiceProvider) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *OfflineSessionMutation) Op() Op {
+func (m *SAMLServiceProviderMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (OfflineSession).
-func (m *OfflineSessionMutation) Type() string {
+// Type returns the node type of this mutation (SAMLServiceProvider).
+func (m *SAMLServiceProviderMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OfflineSessionMutation) Fields() []string {
+func (m *SAMLServiceProviderMutation) Fields() []string {
 	fields := make([]string, 0, 4)
-	if m.user_id != nil {
-		fields = append(fields, offlinesession.FieldUserID)
+	if m.name != nil {
+		fields = append(fields, samlserviceprovider.FieldName)
 	}
-	if m.conn_id != nil {
-		fields = append(fields, offlinesession.FieldConnID)
+	if m.acs_urls != nil {
+		fields = append(fields, samlserviceprovider.FieldAcsUrls)
 	}
-	if m.refresh != nil {
-		fields = append(fields, offlinesession.FieldRefresh)
+	if m.name_id_format != nil {
+		fields = append(fields, samlserviceprovider.FieldNameIDFormat)
 	}
-	if m.connector_data != nil {
-		fields = append(fields, offlinesession.FieldConnectorData)
+	if m.attribute_names != nil {
+		fields = append(fields, samlserviceprovider.FieldAttributeNames)
 	}
 	return fields
 }
@@ -5691,16 +11571,16 @@ func (m *OfflineSessionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OfflineSessionMutation) Field(name string) (ent.Value, bool) {
+func (m *SAMLServiceProviderMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case offlinesession.FieldUserID:
-		return m.UserID()
-	case offlinesession.FieldConnID:
-		return m.ConnID()
-	case offlinesession.FieldRefresh:
-		return m.Refresh()
-	case offlinesession.FieldConnectorData:
-		return m.ConnectorData()
+	case samlserviceprovider.FieldName:
+		return m.Name()
+	case samlserviceprovider.FieldAcsUrls:
+		return m.AcsUrls()
+	case samlserviceprovider.FieldNameIDFormat:
+		return m.NameIDFormat()
+	case samlserviceprovider.FieldAttributeNames:
+		return m.AttributeNames()
 	}
 	return nil, false
 }
@@ -5708,202 +11588,212 @@ func (m *OfflineSessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OfflineSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SAMLServiceProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case offlinesession.FieldUserID:
-		return m.OldUserID(ctx)
-	case offlinesession.FieldConnID:
-		return m.OldConnID(ctx)
-	case offlinesession.FieldRefresh:
-		return m.OldRefresh(ctx)
-	case offlinesession.FieldConnectorData:
-		return m.OldConnectorData(ctx)
+	case samlserviceprovider.FieldName:
+		return m.OldName(ctx)
+	case samlserviceprovider.FieldAcsUrls:
+		return m.OldAcsUrls(ctx)
+	case samlserviceprovider.FieldNameIDFormat:
+		return m.OldNameIDFormat(ctx)
+	case samlserviceprovider.FieldAttributeNames:
+		return m.OldAttributeNames(ctx)
 	}
-	return nil, fmt.Errorf("unknown OfflineSession field %s", name)
+	return nil, fmt.Errorf("unknown SAMLServiceProvider field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OfflineSessionMutation) SetField(name string, value ent.Value) error {
+func (m *SAMLServiceProviderMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case offlinesession.FieldUserID:
+	case samlserviceprovider.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetName(v)
 		return nil
-	case offlinesession.FieldConnID:
-		v, ok := value.(string)
+	case samlserviceprovider.FieldAcsUrls:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnID(v)
+		m.SetAcsUrls(v)
 		return nil
-	case offlinesession.FieldRefresh:
-		v, ok := value.([]byte)
+	case samlserviceprovider.FieldNameIDFormat:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefresh(v)
+		m.SetNameIDFormat(v)
 		return nil
-	case offlinesession.FieldConnectorData:
-		v, ok := value.([]byte)
+	case samlserviceprovider.FieldAttributeNames:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnectorData(v)
+		m.SetAttributeNames(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OfflineSession field %s", name)
+	return fmt.Errorf("unknown SAMLServiceProvider field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OfflineSessionMutation) AddedFields() []string {
+func (m *SAMLServiceProviderMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OfflineSessionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SAMLServiceProviderMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OfflineSessionMutation) AddField(name string, value ent.Value) error {
+func (m *SAMLServiceProviderMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown OfflineSession numeric field %s", name)
+	return fmt.Errorf("unknown SAMLServiceProvider numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OfflineSessionMutation) ClearedFields() []string {
+func (m *SAMLServiceProviderMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(offlinesession.FieldConnectorData) {
-		fields = append(fields, offlinesession.FieldConnectorData)
+	if m.FieldCleared(samlserviceprovider.FieldAcsUrls) {
+		fields = append(fields, samlserviceprovider.FieldAcsUrls)
+	}
+	if m.FieldCleared(samlserviceprovider.FieldAttributeNames) {
+		fields = append(fields, samlserviceprovider.FieldAttributeNames)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OfflineSessionMutation) FieldCleared(name string) bool {
+func (m *SAMLServiceProviderMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OfflineSessionMutation) ClearField(name string) error {
+func (m *SAMLServiceProviderMutation) ClearField(name string) error {
 	switch name {
-	case offlinesession.FieldConnectorData:
-		m.ClearConnectorData()
+	case samlserviceprovider.FieldAcsUrls:
+		m.ClearAcsUrls()
+		return nil
+	case samlserviceprovider.FieldAttributeNames:
+		m.ClearAttributeNames()
 		return nil
 	}
-	return fmt.Errorf("unknown OfflineSession nullable field %s", name)
+	return fmt.Errorf("unknown SAMLServiceProvider nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OfflineSessionMutation) ResetField(name string) error {
+func (m *SAMLServiceProviderMutation) ResetField(name string) error {
 	switch name {
-	case offlinesession.FieldUserID:
-		m.ResetUserID()
+	case samlserviceprovider.FieldName:
+		m.ResetName()
 		return nil
-	case offlinesession.FieldConnID:
-		m.ResetConnID()
+	case samlserviceprovider.FieldAcsUrls:
+		m.ResetAcsUrls()
 		return nil
-	case offlinesession.FieldRefresh:
-		m.ResetRefresh()
+	case samlserviceprovider.FieldNameIDFormat:
+		m.ResetNameIDFormat()
 		return nil
-	case offlinesession.FieldConnectorData:
-		m.ResetConnectorData()
+	case samlserviceprovider.FieldAttributeNames:
+		m.ResetAttributeNames()
 		return nil
 	}
-	return fmt.Errorf("unknown OfflineSession field %s", name)
+	return fmt.Errorf("unknown SAMLServiceProvider field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OfflineSessionMutation) AddedEdges() []string {
+func (m *SAMLServiceProviderMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OfflineSessionMutation) AddedIDs(name string) []ent.Value {
+func (m *SAMLServiceProviderMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OfflineSessionMutation) RemovedEdges() []string {
+func (m *SAMLServiceProviderMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OfflineSessionMutation) RemovedIDs(name string) []ent.Value {
+func (m *SAMLServiceProviderMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OfflineSessionMutation) ClearedEdges() []string {
+func (m *SAMLServiceProviderMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OfflineSessionMutation) EdgeCleared(name string) bool {
+func (m *SAMLServiceProviderMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OfflineSessionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown OfflineSession unique edge %s", name)
+func (m *SAMLServiceProviderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SAMLServiceProvider unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OfflineSessionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown OfflineSession edge %s", name)
+func (m *SAMLServiceProviderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SAMLServiceProvider edge %s", name)
 }
 
-// PasswordMutation represents an operation that mutates the Password nodes in the graph.
-type PasswordMutation struct {
+// TOTPEnrollmentMutation represents an operation that mutates the TOTPEnrollment nodes in the graph.
+type TOTPEnrollmentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	email         *string
-	hash          *[]byte
-	username      *string
-	user_id       *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Password, error)
-	predicates    []predicate.Password
+	op                Op
+	typ               string
+	id                *string
+	user_id           *string
+	conn_id           *string
+	secret            *[]byte
+	confirmed         *bool
+	recovery_codes    *[]string
+	last_used_step    *int64
+	addlast_used_step *int64
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*TOTPEnrollment, error)
+	predicates        []predicate.TOTPEnrollment
 }
 
-var _ ent.Mutation = (*PasswordMutation)(nil)
+var _ ent.Mutation = (*TOTPEnrollmentMutation)(nil)
 
-// passwordOption allows management of the mutation configuration using functional options.
-type passwordOption func(*PasswordMutation)
+// totpenrollmentOption allows management of the mutation configuration using functional options.
+type totpenrollmentOption func(*TOTPEnrollmentMutation)
 
-// newPasswordMutation creates new mutation for the Password entity.
-func newPasswordMutation(c config, op Op, opts ...passwordOption) *PasswordMutation {
-	m := &PasswordMutation{
+// newTOTPEnrollmentMutation creates new mutation for the TOTPEnrollment entity.
+func newTOTPEnrollmentMutation(c config, op Op, opts ...totpenrollmentOption) *TOTPEnrollmentMutation {
+	m := &TOTPEnrollmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePassword,
+		typ:           TypeTOTPEnrollment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5912,20 +11802,20 @@ func newPasswordMutation(c config, op Op, opts ...passwordOption) *PasswordMutat
 	return m
 }
 
-// withPasswordID sets the ID field of the mutation.
-func withPasswordID(id int) passwordOption {
-	return func(m *PasswordMutation) {
+// withTOTPEnrollmentID sets the ID field of the mutation.
+func withTOTPEnrollmentID(id string) totpenrollmentOption {
+	return func(m *TOTPEnrollmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Password
+			value *TOTPEnrollment
 		)
-		m.oldValue = func(ctx context.Context) (*Password, error) {
+		m.oldValue = func(ctx context.Context) (*TOTPEnrollment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Password.Get(ctx, id)
+					value, err = m.Client().TOTPEnrollment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5934,10 +11824,10 @@ func withPasswordID(id int) passwordOption {
 	}
 }
 
-// withPassword sets the old Password of the mutation.
-func withPassword(node *Password) passwordOption {
-	return func(m *PasswordMutation) {
-		m.oldValue = func(context.Context) (*Password, error) {
+// withTOTPEnrollment sets the old TOTPEnrollment of the mutation.
+func withTOTPEnrollment(node *TOTPEnrollment) totpenrollmentOption {
+	return func(m *TOTPEnrollmentMutation) {
+		m.oldValue = func(context.Context) (*TOTPEnrollment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5946,7 +11836,7 @@ func withPassword(node *Password) passwordOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PasswordMutation) Client() *Client {
+func (m TOTPEnrollmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5954,7 +11844,7 @@ func (m PasswordMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PasswordMutation) Tx() (*Tx, error) {
+func (m TOTPEnrollmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -5963,9 +11853,15 @@ func (m PasswordMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TOTPEnrollment entities.
+func (m *TOTPEnrollmentMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PasswordMutation) ID() (id int, exists bool) {
+func (m *TOTPEnrollmentMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5976,196 +11872,346 @@ func (m *PasswordMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PasswordMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TOTPEnrollmentMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Password.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TOTPEnrollment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *PasswordMutation) SetEmail(s string) {
-	m.email = &s
+// SetUserID sets the "user_id" field.
+func (m *TOTPEnrollmentMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *PasswordMutation) Email() (r string, exists bool) {
-	v := m.email
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TOTPEnrollmentMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the TOTPEnrollment entity.
+// If the TOTPEnrollment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *TOTPEnrollmentMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.UserID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *PasswordMutation) ResetEmail() {
-	m.email = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TOTPEnrollmentMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetHash sets the "hash" field.
-func (m *PasswordMutation) SetHash(b []byte) {
-	m.hash = &b
+// SetConnID sets the "conn_id" field.
+func (m *TOTPEnrollmentMutation) SetConnID(s string) {
+	m.conn_id = &s
 }
 
-// Hash returns the value of the "hash" field in the mutation.
-func (m *PasswordMutation) Hash() (r []byte, exists bool) {
-	v := m.hash
+// ConnID returns the value of the "conn_id" field in the mutation.
+func (m *TOTPEnrollmentMutation) ConnID() (r string, exists bool) {
+	v := m.conn_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHash returns the old "hash" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// OldConnID returns the old "conn_id" field's value of the TOTPEnrollment entity.
+// If the TOTPEnrollment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldHash(ctx context.Context) (v []byte, err error) {
+func (m *TOTPEnrollmentMutation) OldConnID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHash requires an ID field in the mutation")
+		return v, errors.New("OldConnID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnID: %w", err)
 	}
-	return oldValue.Hash, nil
+	return oldValue.ConnID, nil
 }
 
-// ResetHash resets all changes to the "hash" field.
-func (m *PasswordMutation) ResetHash() {
-	m.hash = nil
+// ResetConnID resets all changes to the "conn_id" field.
+func (m *TOTPEnrollmentMutation) ResetConnID() {
+	m.conn_id = nil
 }
 
-// SetUsername sets the "username" field.
-func (m *PasswordMutation) SetUsername(s string) {
-	m.username = &s
+// SetSecret sets the "secret" field.
+func (m *TOTPEnrollmentMutation) SetSecret(b []byte) {
+	m.secret = &b
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *PasswordMutation) Username() (r string, exists bool) {
-	v := m.username
+// Secret returns the value of the "secret" field in the mutation.
+func (m *TOTPEnrollmentMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the TOTPEnrollment entity.
+// If the TOTPEnrollment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *TOTPEnrollmentMutation) OldSecret(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *TOTPEnrollmentMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetConfirmed sets the "confirmed" field.
+func (m *TOTPEnrollmentMutation) SetConfirmed(b bool) {
+	m.confirmed = &b
+}
+
+// Confirmed returns the value of the "confirmed" field in the mutation.
+func (m *TOTPEnrollmentMutation) Confirmed() (r bool, exists bool) {
+	v := m.confirmed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfirmed returns the old "confirmed" field's value of the TOTPEnrollment entity.
+// If the TOTPEnrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TOTPEnrollmentMutation) OldConfirmed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfirmed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfirmed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfirmed: %w", err)
+	}
+	return oldValue.Confirmed, nil
+}
+
+// ResetConfirmed resets all changes to the "confirmed" field.
+func (m *TOTPEnrollmentMutation) ResetConfirmed() {
+	m.confirmed = nil
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (m *TOTPEnrollmentMutation) SetRecoveryCodes(s []string) {
+	m.recovery_codes = &s
+}
+
+// RecoveryCodes returns the value of the "recovery_codes" field in the mutation.
+func (m *TOTPEnrollmentMutation) RecoveryCodes() (r []string, exists bool) {
+	v := m.recovery_codes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecoveryCodes returns the old "recovery_codes" field's value of the TOTPEnrollment entity.
+// If the TOTPEnrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TOTPEnrollmentMutation) OldRecoveryCodes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecoveryCodes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecoveryCodes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecoveryCodes: %w", err)
+	}
+	return oldValue.RecoveryCodes, nil
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (m *TOTPEnrollmentMutation) ClearRecoveryCodes() {
+	m.recovery_codes = nil
+	m.clearedFields[totpenrollment.FieldRecoveryCodes] = struct{}{}
+}
+
+// RecoveryCodesCleared returns if the "recovery_codes" field was cleared in this mutation.
+func (m *TOTPEnrollmentMutation) RecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[totpenrollment.FieldRecoveryCodes]
+	return ok
+}
+
+// ResetRecoveryCodes resets all changes to the "recovery_codes" field.
+func (m *TOTPEnrollmentMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	delete(m.clearedFields, totpenrollment.FieldRecoveryCodes)
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (m *TOTPEnrollmentMutation) SetLastUsedStep(i int64) {
+	m.last_used_step = &i
+	m.addlast_used_step = nil
+}
+
+// LastUsedStep returns the value of the "last_used_step" field in the mutation.
+func (m *TOTPEnrollmentMutation) LastUsedStep() (r int64, exists bool) {
+	v := m.last_used_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedStep returns the old "last_used_step" field's value of the TOTPEnrollment entity.
+// If the TOTPEnrollment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TOTPEnrollmentMutation) OldLastUsedStep(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedStep is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedStep requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedStep: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.LastUsedStep, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *PasswordMutation) ResetUsername() {
-	m.username = nil
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (m *TOTPEnrollmentMutation) AddLastUsedStep(i int64) {
+	if m.addlast_used_step != nil {
+		*m.addlast_used_step += i
+	} else {
+		m.addlast_used_step = &i
+	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PasswordMutation) SetUserID(s string) {
-	m.user_id = &s
+// AddedLastUsedStep returns the value that was added to the "last_used_step" field in this mutation.
+func (m *TOTPEnrollmentMutation) AddedLastUsedStep() (r int64, exists bool) {
+	v := m.addlast_used_step
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PasswordMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// ResetLastUsedStep resets all changes to the "last_used_step" field.
+func (m *TOTPEnrollmentMutation) ResetLastUsedStep() {
+	m.last_used_step = nil
+	m.addlast_used_step = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TOTPEnrollmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TOTPEnrollmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Password entity.
-// If the Password object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TOTPEnrollment entity.
+// If the TOTPEnrollment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *TOTPEnrollmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PasswordMutation) ResetUserID() {
-	m.user_id = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TOTPEnrollmentMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the PasswordMutation builder.
-func (m *PasswordMutation) Where(ps ...predicate.Password) {
+// Where appends a list predicates to the TOTPEnrollmentMutation builder.
+func (m *TOTPEnrollmentMutation) Where(ps ...predicate.TOTPEnrollment) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *PasswordMutation) Op() Op {
+func (m *TOTPEnrollmentMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Password).
-func (m *PasswordMutation) Type() string {
+// Type returns the node type of this mutation (TOTPEnrollment).
+func (m *TOTPEnrollmentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PasswordMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.email != nil {
-		fields = append(fields, password.FieldEmail)
+func (m *TOTPEnrollmentMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user_id != nil {
+		fields = append(fields, totpenrollment.FieldUserID)
 	}
-	if m.hash != nil {
-		fields = append(fields, password.FieldHash)
+	if m.conn_id != nil {
+		fields = append(fields, totpenrollment.FieldConnID)
 	}
-	if m.username != nil {
-		fields = append(fields, password.FieldUsername)
+	if m.secret != nil {
+		fields = append(fields, totpenrollment.FieldSecret)
 	}
-	if m.user_id != nil {
-		fields = append(fields, password.FieldUserID)
+	if m.confirmed != nil {
+		fields = append(fields, totpenrollment.FieldConfirmed)
+	}
+	if m.recovery_codes != nil {
+		fields = append(fields, totpenrollment.FieldRecoveryCodes)
+	}
+	if m.last_used_step != nil {
+		fields = append(fields, totpenrollment.FieldLastUsedStep)
+	}
+	if m.created_at != nil {
+		fields = append(fields, totpenrollment.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6173,16 +12219,22 @@ func (m *PasswordMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PasswordMutation) Field(name string) (ent.Value, bool) {
+func (m *TOTPEnrollmentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case password.FieldEmail:
-		return m.Email()
-	case password.FieldHash:
-		return m.Hash()
-	case password.FieldUsername:
-		return m.Username()
-	case password.FieldUserID:
+	case totpenrollment.FieldUserID:
 		return m.UserID()
+	case totpenrollment.FieldConnID:
+		return m.ConnID()
+	case totpenrollment.FieldSecret:
+		return m.Secret()
+	case totpenrollment.FieldConfirmed:
+		return m.Confirmed()
+	case totpenrollment.FieldRecoveryCodes:
+		return m.RecoveryCodes()
+	case totpenrollment.FieldLastUsedStep:
+		return m.LastUsedStep()
+	case totpenrollment.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -6190,204 +12242,252 @@ func (m *PasswordMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PasswordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TOTPEnrollmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case password.FieldEmail:
-		return m.OldEmail(ctx)
-	case password.FieldHash:
-		return m.OldHash(ctx)
-	case password.FieldUsername:
-		return m.OldUsername(ctx)
-	case password.FieldUserID:
+	case totpenrollment.FieldUserID:
 		return m.OldUserID(ctx)
+	case totpenrollment.FieldConnID:
+		return m.OldConnID(ctx)
+	case totpenrollment.FieldSecret:
+		return m.OldSecret(ctx)
+	case totpenrollment.FieldConfirmed:
+		return m.OldConfirmed(ctx)
+	case totpenrollment.FieldRecoveryCodes:
+		return m.OldRecoveryCodes(ctx)
+	case totpenrollment.FieldLastUsedStep:
+		return m.OldLastUsedStep(ctx)
+	case totpenrollment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Password field %s", name)
+	return nil, fmt.Errorf("unknown TOTPEnrollment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordMutation) SetField(name string, value ent.Value) error {
+func (m *TOTPEnrollmentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case password.FieldEmail:
+	case totpenrollment.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetUserID(v)
 		return nil
-	case password.FieldHash:
+	case totpenrollment.FieldConnID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnID(v)
+		return nil
+	case totpenrollment.FieldSecret:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHash(v)
+		m.SetSecret(v)
 		return nil
-	case password.FieldUsername:
-		v, ok := value.(string)
+	case totpenrollment.FieldConfirmed:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetConfirmed(v)
 		return nil
-	case password.FieldUserID:
-		v, ok := value.(string)
+	case totpenrollment.FieldRecoveryCodes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetRecoveryCodes(v)
+		return nil
+	case totpenrollment.FieldLastUsedStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedStep(v)
+		return nil
+	case totpenrollment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Password field %s", name)
+	return fmt.Errorf("unknown TOTPEnrollment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PasswordMutation) AddedFields() []string {
-	return nil
+func (m *TOTPEnrollmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_used_step != nil {
+		fields = append(fields, totpenrollment.FieldLastUsedStep)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PasswordMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TOTPEnrollmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case totpenrollment.FieldLastUsedStep:
+		return m.AddedLastUsedStep()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordMutation) AddField(name string, value ent.Value) error {
+func (m *TOTPEnrollmentMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case totpenrollment.FieldLastUsedStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastUsedStep(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Password numeric field %s", name)
+	return fmt.Errorf("unknown TOTPEnrollment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PasswordMutation) ClearedFields() []string {
-	return nil
+func (m *TOTPEnrollmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(totpenrollment.FieldRecoveryCodes) {
+		fields = append(fields, totpenrollment.FieldRecoveryCodes)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PasswordMutation) FieldCleared(name string) bool {
+func (m *TOTPEnrollmentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PasswordMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Password nullable field %s", name)
+func (m *TOTPEnrollmentMutation) ClearField(name string) error {
+	switch name {
+	case totpenrollment.FieldRecoveryCodes:
+		m.ClearRecoveryCodes()
+		return nil
+	}
+	return fmt.Errorf("unknown TOTPEnrollment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PasswordMutation) ResetField(name string) error {
+func (m *TOTPEnrollmentMutation) ResetField(name string) error {
 	switch name {
-	case password.FieldEmail:
-		m.ResetEmail()
+	case totpenrollment.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case password.FieldHash:
-		m.ResetHash()
+	case totpenrollment.FieldConnID:
+		m.ResetConnID()
 		return nil
-	case password.FieldUsername:
-		m.ResetUsername()
+	case totpenrollment.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case password.FieldUserID:
-		m.ResetUserID()
+	case totpenrollment.FieldConfirmed:
+		m.ResetConfirmed()
+		return nil
+	case totpenrollment.FieldRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
+	case totpenrollment.FieldLastUsedStep:
+		m.ResetLastUsedStep()
+		return nil
+	case totpenrollment.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Password field %s", name)
+	return fmt.Errorf("unknown TOTPEnrollment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PasswordMutation) AddedEdges() []string {
+func (m *TOTPEnrollmentMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PasswordMutation) AddedIDs(name string) []ent.Value {
+func (m *TOTPEnrollmentMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PasswordMutation) RemovedEdges() []string {
+func (m *TOTPEnrollmentMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PasswordMutation) RemovedIDs(name string) []ent.Value {
+func (m *TOTPEnrollmentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PasswordMutation) ClearedEdges() []string {
+func (m *TOTPEnrollmentMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PasswordMutation) EdgeCleared(name string) bool {
+func (m *TOTPEnrollmentMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PasswordMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Password unique edge %s", name)
+func (m *TOTPEnrollmentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TOTPEnrollment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PasswordMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Password edge %s", name)
+func (m *TOTPEnrollmentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TOTPEnrollment edge %s", name)
 }
 
-// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
-type RefreshTokenMutation struct {
+// UserConsentMutation represents an operation that mutates the UserConsent nodes in the graph.
+type UserConsentMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *string
-	client_id                 *string
-	scopes                    *[]string
-	nonce                     *string
-	claims_user_id            *string
-	claims_username           *string
-	claims_email              *string
-	claims_email_verified     *bool
-	claims_groups             *[]string
-	claims_preferred_username *string
-	connector_id              *string
-	connector_data            *[]byte
-	token                     *string
-	obsolete_token            *string
-	created_at                *time.Time
-	last_used                 *time.Time
-	clearedFields             map[string]struct{}
-	done                      bool
-	oldValue                  func(context.Context) (*RefreshToken, error)
-	predicates                []predicate.RefreshToken
+	op            Op
+	typ           string
+	id            *string
+	user_id       *string
+	conn_id       *string
+	clients       *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UserConsent, error)
+	predicates    []predicate.UserConsent
 }
 
-var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+var _ ent.Mutation = (*UserConsentMutation)(nil)
 
-// refreshtokenOption allows management of the mutation configuration using functional options.
-type refreshtokenOption func(*RefreshTokenMutation)
+// userconsentOption allows management of the mutation configuration using functional options.
+type userconsentOption func(*UserConsentMutation)
 
-// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
-func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
-	m := &RefreshTokenMutation{
+// newUserConsentMutation creates new mutation for the UserConsent entity.
+func newUserConsentMutation(c config, op Op, opts ...userconsentOption) *UserConsentMutation {
+	m := &UserConsentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRefreshToken,
+		typ:           TypeUserConsent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6396,20 +12496,20 @@ func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *Refre
 	return m
 }
 
-// withRefreshTokenID sets the ID field of the mutation.
-func withRefreshTokenID(id string) refreshtokenOption {
-	return func(m *RefreshTokenMutation) {
+// withUserConsentID sets the ID field of the mutation.
+func withUserConsentID(id string) userconsentOption {
+	return func(m *UserConsentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RefreshToken
+			value *UserConsent
 		)
-		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+		m.oldValue = func(ctx context.Context) (*UserConsent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RefreshToken.Get(ctx, id)
+					value, err = m.Client().UserConsent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6418,10 +12518,10 @@ func withRefreshTokenID(id string) refreshtokenOption {
 	}
 }
 
-// withRefreshToken sets the old RefreshToken of the mutation.
-func withRefreshToken(node *RefreshToken) refreshtokenOption {
-	return func(m *RefreshTokenMutation) {
-		m.oldValue = func(context.Context) (*RefreshToken, error) {
+// withUserConsent sets the old UserConsent of the mutation.
+func withUserConsent(node *UserConsent) userconsentOption {
+	return func(m *UserConsentMutation) {
+		m.oldValue = func(context.Context) (*UserConsent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6430,7 +12530,7 @@ func withRefreshToken(node *RefreshToken) refreshtokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RefreshTokenMutation) Client() *Client {
+func (m UserConsentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6438,7 +12538,7 @@ func (m RefreshTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RefreshTokenMutation) Tx() (*Tx, error) {
+func (m UserConsentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -6448,14 +12548,14 @@ func (m RefreshTokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RefreshToken entities.
-func (m *RefreshTokenMutation) SetID(id string) {
+// operation is only accepted on creation of UserConsent entities.
+func (m *UserConsentMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RefreshTokenMutation) ID() (id string, exists bool) {
+func (m *UserConsentMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6466,7 +12566,7 @@ func (m *RefreshTokenMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UserConsentMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6475,526 +12575,712 @@ func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserConsent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *RefreshTokenMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetUserID sets the "user_id" field.
+func (m *UserConsentMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserConsentMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserConsent entity.
+// If the UserConsent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserConsentMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserConsentMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *RefreshTokenMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// SetConnID sets the "conn_id" field.
+func (m *UserConsentMutation) SetConnID(s string) {
+	m.conn_id = &s
+}
+
+// ConnID returns the value of the "conn_id" field in the mutation.
+func (m *UserConsentMutation) ConnID() (r string, exists bool) {
+	v := m.conn_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldConnID returns the old "conn_id" field's value of the UserConsent entity.
+// If the UserConsent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *UserConsentMutation) OldConnID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldConnID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldConnID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldConnID: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.ConnID, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *RefreshTokenMutation) ResetClientID() {
-	m.client_id = nil
+// ResetConnID resets all changes to the "conn_id" field.
+func (m *UserConsentMutation) ResetConnID() {
+	m.conn_id = nil
 }
 
-// SetScopes sets the "scopes" field.
-func (m *RefreshTokenMutation) SetScopes(s []string) {
-	m.scopes = &s
+// SetClients sets the "clients" field.
+func (m *UserConsentMutation) SetClients(b []byte) {
+	m.clients = &b
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *RefreshTokenMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
+// Clients returns the value of the "clients" field in the mutation.
+func (m *UserConsentMutation) Clients() (r []byte, exists bool) {
+	v := m.clients
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClients returns the old "clients" field's value of the UserConsent entity.
+// If the UserConsent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *UserConsentMutation) OldClients(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+		return v, errors.New("OldClients is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
+		return v, errors.New("OldClients requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+		return v, fmt.Errorf("querying old value for OldClients: %w", err)
 	}
-	return oldValue.Scopes, nil
+	return oldValue.Clients, nil
 }
 
-// ClearScopes clears the value of the "scopes" field.
-func (m *RefreshTokenMutation) ClearScopes() {
-	m.scopes = nil
-	m.clearedFields[refreshtoken.FieldScopes] = struct{}{}
+// ResetClients resets all changes to the "clients" field.
+func (m *UserConsentMutation) ResetClients() {
+	m.clients = nil
 }
 
-// ScopesCleared returns if the "scopes" field was cleared in this mutation.
-func (m *RefreshTokenMutation) ScopesCleared() bool {
-	_, ok := m.clearedFields[refreshtoken.FieldScopes]
-	return ok
+// Where appends a list predicates to the UserConsentMutation builder.
+func (m *UserConsentMutation) Where(ps ...predicate.UserConsent) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetScopes resets all changes to the "scopes" field.
-func (m *RefreshTokenMutation) ResetScopes() {
-	m.scopes = nil
-	delete(m.clearedFields, refreshtoken.FieldScopes)
+// Op returns the operation name.
+func (m *UserConsentMutation) Op() Op {
+	return m.op
 }
 
-// SetNonce sets the "nonce" field.
-func (m *RefreshTokenMutation) SetNonce(s string) {
-	m.nonce = &s
+// Type returns the node type of this mutation (UserConsent).
+func (m *UserConsentMutation) Type() string {
+	return m.typ
 }
 
-// Nonce returns the value of the "nonce" field in the mutation.
-func (m *RefreshTokenMutation) Nonce() (r string, exists bool) {
-	v := m.nonce
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserConsentMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, userconsent.FieldUserID)
 	}
-	return *v, true
+	if m.conn_id != nil {
+		fields = append(fields, userconsent.FieldConnID)
+	}
+	if m.clients != nil {
+		fields = append(fields, userconsent.FieldClients)
+	}
+	return fields
 }
 
-// OldNonce returns the old "nonce" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldNonce(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserConsentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userconsent.FieldUserID:
+		return m.UserID()
+	case userconsent.FieldConnID:
+		return m.ConnID()
+	case userconsent.FieldClients:
+		return m.Clients()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNonce requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserConsentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userconsent.FieldUserID:
+		return m.OldUserID(ctx)
+	case userconsent.FieldConnID:
+		return m.OldConnID(ctx)
+	case userconsent.FieldClients:
+		return m.OldClients(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+	return nil, fmt.Errorf("unknown UserConsent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserConsentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userconsent.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case userconsent.FieldConnID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnID(v)
+		return nil
+	case userconsent.FieldClients:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClients(v)
+		return nil
 	}
-	return oldValue.Nonce, nil
+	return fmt.Errorf("unknown UserConsent field %s", name)
 }
 
-// ResetNonce resets all changes to the "nonce" field.
-func (m *RefreshTokenMutation) ResetNonce() {
-	m.nonce = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserConsentMutation) AddedFields() []string {
+	return nil
 }
 
-// SetClaimsUserID sets the "claims_user_id" field.
-func (m *RefreshTokenMutation) SetClaimsUserID(s string) {
-	m.claims_user_id = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserConsentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// ClaimsUserID returns the value of the "claims_user_id" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsUserID() (r string, exists bool) {
-	v := m.claims_user_id
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserConsentMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown UserConsent numeric field %s", name)
 }
 
-// OldClaimsUserID returns the old "claims_user_id" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsUserID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsUserID: %w", err)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserConsentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserConsentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserConsentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserConsent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserConsentMutation) ResetField(name string) error {
+	switch name {
+	case userconsent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case userconsent.FieldConnID:
+		m.ResetConnID()
+		return nil
+	case userconsent.FieldClients:
+		m.ResetClients()
+		return nil
 	}
-	return oldValue.ClaimsUserID, nil
+	return fmt.Errorf("unknown UserConsent field %s", name)
 }
 
-// ResetClaimsUserID resets all changes to the "claims_user_id" field.
-func (m *RefreshTokenMutation) ResetClaimsUserID() {
-	m.claims_user_id = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserConsentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetClaimsUsername sets the "claims_username" field.
-func (m *RefreshTokenMutation) SetClaimsUsername(s string) {
-	m.claims_username = &s
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserConsentMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClaimsUsername returns the value of the "claims_username" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsUsername() (r string, exists bool) {
-	v := m.claims_username
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserConsentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserConsentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserConsentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserConsentMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserConsentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserConsent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserConsentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserConsent edge %s", name)
+}
+
+// WebAuthnCredentialMutation represents an operation that mutates the WebAuthnCredential nodes in the graph.
+type WebAuthnCredentialMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	email            *string
+	public_key       *[]byte
+	attestation_type *string
+	transports       *[]string
+	aaguid           *[]byte
+	sign_count       *uint32
+	addsign_count    *int32
+	created_at       *time.Time
+	last_used        *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*WebAuthnCredential, error)
+	predicates       []predicate.WebAuthnCredential
+}
+
+var _ ent.Mutation = (*WebAuthnCredentialMutation)(nil)
+
+// webauthncredentialOption allows management of the mutation configuration using functional options.
+type webauthncredentialOption func(*WebAuthnCredentialMutation)
+
+// newWebAuthnCredentialMutation creates new mutation for the WebAuthnCredential entity.
+func newWebAuthnCredentialMutation(c config, op Op, opts ...webauthncredentialOption) *WebAuthnCredentialMutation {
+	m := &WebAuthnCredentialMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebAuthnCredential,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebAuthnCredentialID sets the ID field of the mutation.
+func withWebAuthnCredentialID(id string) webauthncredentialOption {
+	return func(m *WebAuthnCredentialMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebAuthnCredential
+		)
+		m.oldValue = func(ctx context.Context) (*WebAuthnCredential, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebAuthnCredential.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldClaimsUsername returns the old "claims_username" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsUsername requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsUsername: %w", err)
+// withWebAuthnCredential sets the old WebAuthnCredential of the mutation.
+func withWebAuthnCredential(node *WebAuthnCredential) webauthncredentialOption {
+	return func(m *WebAuthnCredentialMutation) {
+		m.oldValue = func(context.Context) (*WebAuthnCredential, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.ClaimsUsername, nil
 }
 
-// ResetClaimsUsername resets all changes to the "claims_username" field.
-func (m *RefreshTokenMutation) ResetClaimsUsername() {
-	m.claims_username = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebAuthnCredentialMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetClaimsEmail sets the "claims_email" field.
-func (m *RefreshTokenMutation) SetClaimsEmail(s string) {
-	m.claims_email = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebAuthnCredentialMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClaimsEmail returns the value of the "claims_email" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsEmail() (r string, exists bool) {
-	v := m.claims_email
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebAuthnCredential entities.
+func (m *WebAuthnCredentialMutation) SetID(id string) {
+	m.id = &id
 }
 
-// OldClaimsEmail returns the old "claims_email" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsEmail: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebAuthnCredentialMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.ClaimsEmail, nil
+	return *m.id, true
 }
 
-// ResetClaimsEmail resets all changes to the "claims_email" field.
-func (m *RefreshTokenMutation) ResetClaimsEmail() {
-	m.claims_email = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebAuthnCredentialMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebAuthnCredential.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetClaimsEmailVerified sets the "claims_email_verified" field.
-func (m *RefreshTokenMutation) SetClaimsEmailVerified(b bool) {
-	m.claims_email_verified = &b
+// SetEmail sets the "email" field.
+func (m *WebAuthnCredentialMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// ClaimsEmailVerified returns the value of the "claims_email_verified" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsEmailVerified() (r bool, exists bool) {
-	v := m.claims_email_verified
+// Email returns the value of the "email" field in the mutation.
+func (m *WebAuthnCredentialMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClaimsEmailVerified returns the old "claims_email_verified" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsEmailVerified(ctx context.Context) (v bool, err error) {
+func (m *WebAuthnCredentialMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsEmailVerified is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsEmailVerified requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsEmailVerified: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.ClaimsEmailVerified, nil
+	return oldValue.Email, nil
 }
 
-// ResetClaimsEmailVerified resets all changes to the "claims_email_verified" field.
-func (m *RefreshTokenMutation) ResetClaimsEmailVerified() {
-	m.claims_email_verified = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *WebAuthnCredentialMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetClaimsGroups sets the "claims_groups" field.
-func (m *RefreshTokenMutation) SetClaimsGroups(s []string) {
-	m.claims_groups = &s
+// SetPublicKey sets the "public_key" field.
+func (m *WebAuthnCredentialMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
 }
 
-// ClaimsGroups returns the value of the "claims_groups" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsGroups() (r []string, exists bool) {
-	v := m.claims_groups
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *WebAuthnCredentialMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClaimsGroups returns the old "claims_groups" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldPublicKey returns the old "public_key" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsGroups(ctx context.Context) (v []string, err error) {
+func (m *WebAuthnCredentialMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsGroups is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsGroups requires an ID field in the mutation")
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsGroups: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
 	}
-	return oldValue.ClaimsGroups, nil
-}
-
-// ClearClaimsGroups clears the value of the "claims_groups" field.
-func (m *RefreshTokenMutation) ClearClaimsGroups() {
-	m.claims_groups = nil
-	m.clearedFields[refreshtoken.FieldClaimsGroups] = struct{}{}
-}
-
-// ClaimsGroupsCleared returns if the "claims_groups" field was cleared in this mutation.
-func (m *RefreshTokenMutation) ClaimsGroupsCleared() bool {
-	_, ok := m.clearedFields[refreshtoken.FieldClaimsGroups]
-	return ok
+	return oldValue.PublicKey, nil
 }
 
-// ResetClaimsGroups resets all changes to the "claims_groups" field.
-func (m *RefreshTokenMutation) ResetClaimsGroups() {
-	m.claims_groups = nil
-	delete(m.clearedFields, refreshtoken.FieldClaimsGroups)
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *WebAuthnCredentialMutation) ResetPublicKey() {
+	m.public_key = nil
 }
 
-// SetClaimsPreferredUsername sets the "claims_preferred_username" field.
-func (m *RefreshTokenMutation) SetClaimsPreferredUsername(s string) {
-	m.claims_preferred_username = &s
+// SetAttestationType sets the "attestation_type" field.
+func (m *WebAuthnCredentialMutation) SetAttestationType(s string) {
+	m.attestation_type = &s
 }
 
-// ClaimsPreferredUsername returns the value of the "claims_preferred_username" field in the mutation.
-func (m *RefreshTokenMutation) ClaimsPreferredUsername() (r string, exists bool) {
-	v := m.claims_preferred_username
+// AttestationType returns the value of the "attestation_type" field in the mutation.
+func (m *WebAuthnCredentialMutation) AttestationType() (r string, exists bool) {
+	v := m.attestation_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClaimsPreferredUsername returns the old "claims_preferred_username" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldAttestationType returns the old "attestation_type" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldClaimsPreferredUsername(ctx context.Context) (v string, err error) {
+func (m *WebAuthnCredentialMutation) OldAttestationType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClaimsPreferredUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttestationType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClaimsPreferredUsername requires an ID field in the mutation")
+		return v, errors.New("OldAttestationType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClaimsPreferredUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttestationType: %w", err)
 	}
-	return oldValue.ClaimsPreferredUsername, nil
+	return oldValue.AttestationType, nil
 }
 
-// ResetClaimsPreferredUsername resets all changes to the "claims_preferred_username" field.
-func (m *RefreshTokenMutation) ResetClaimsPreferredUsername() {
-	m.claims_preferred_username = nil
+// ResetAttestationType resets all changes to the "attestation_type" field.
+func (m *WebAuthnCredentialMutation) ResetAttestationType() {
+	m.attestation_type = nil
 }
 
-// SetConnectorID sets the "connector_id" field.
-func (m *RefreshTokenMutation) SetConnectorID(s string) {
-	m.connector_id = &s
+// SetTransports sets the "transports" field.
+func (m *WebAuthnCredentialMutation) SetTransports(s []string) {
+	m.transports = &s
 }
 
-// ConnectorID returns the value of the "connector_id" field in the mutation.
-func (m *RefreshTokenMutation) ConnectorID() (r string, exists bool) {
-	v := m.connector_id
+// Transports returns the value of the "transports" field in the mutation.
+func (m *WebAuthnCredentialMutation) Transports() (r []string, exists bool) {
+	v := m.transports
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectorID returns the old "connector_id" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldTransports returns the old "transports" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldConnectorID(ctx context.Context) (v string, err error) {
+func (m *WebAuthnCredentialMutation) OldTransports(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTransports is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectorID requires an ID field in the mutation")
+		return v, errors.New("OldTransports requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTransports: %w", err)
 	}
-	return oldValue.ConnectorID, nil
+	return oldValue.Transports, nil
 }
 
-// ResetConnectorID resets all changes to the "connector_id" field.
-func (m *RefreshTokenMutation) ResetConnectorID() {
-	m.connector_id = nil
+// ClearTransports clears the value of the "transports" field.
+func (m *WebAuthnCredentialMutation) ClearTransports() {
+	m.transports = nil
+	m.clearedFields[webauthncredential.FieldTransports] = struct{}{}
 }
 
-// SetConnectorData sets the "connector_data" field.
-func (m *RefreshTokenMutation) SetConnectorData(b []byte) {
-	m.connector_data = &b
+// TransportsCleared returns if the "transports" field was cleared in this mutation.
+func (m *WebAuthnCredentialMutation) TransportsCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldTransports]
+	return ok
 }
 
-// ConnectorData returns the value of the "connector_data" field in the mutation.
-func (m *RefreshTokenMutation) ConnectorData() (r []byte, exists bool) {
-	v := m.connector_data
+// ResetTransports resets all changes to the "transports" field.
+func (m *WebAuthnCredentialMutation) ResetTransports() {
+	m.transports = nil
+	delete(m.clearedFields, webauthncredential.FieldTransports)
+}
+
+// SetAaguid sets the "aaguid" field.
+func (m *WebAuthnCredentialMutation) SetAaguid(b []byte) {
+	m.aaguid = &b
+}
+
+// Aaguid returns the value of the "aaguid" field in the mutation.
+func (m *WebAuthnCredentialMutation) Aaguid() (r []byte, exists bool) {
+	v := m.aaguid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConnectorData returns the old "connector_data" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldAaguid returns the old "aaguid" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldConnectorData(ctx context.Context) (v *[]byte, err error) {
+func (m *WebAuthnCredentialMutation) OldAaguid(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConnectorData is only allowed on UpdateOne operations")
+		return v, errors.New("OldAaguid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConnectorData requires an ID field in the mutation")
+		return v, errors.New("OldAaguid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConnectorData: %w", err)
+		return v, fmt.Errorf("querying old value for OldAaguid: %w", err)
 	}
-	return oldValue.ConnectorData, nil
+	return oldValue.Aaguid, nil
 }
 
-// ClearConnectorData clears the value of the "connector_data" field.
-func (m *RefreshTokenMutation) ClearConnectorData() {
-	m.connector_data = nil
-	m.clearedFields[refreshtoken.FieldConnectorData] = struct{}{}
+// ClearAaguid clears the value of the "aaguid" field.
+func (m *WebAuthnCredentialMutation) ClearAaguid() {
+	m.aaguid = nil
+	m.clearedFields[webauthncredential.FieldAaguid] = struct{}{}
 }
 
-// ConnectorDataCleared returns if the "connector_data" field was cleared in this mutation.
-func (m *RefreshTokenMutation) ConnectorDataCleared() bool {
-	_, ok := m.clearedFields[refreshtoken.FieldConnectorData]
+// AaguidCleared returns if the "aaguid" field was cleared in this mutation.
+func (m *WebAuthnCredentialMutation) AaguidCleared() bool {
+	_, ok := m.clearedFields[webauthncredential.FieldAaguid]
 	return ok
 }
 
-// ResetConnectorData resets all changes to the "connector_data" field.
-func (m *RefreshTokenMutation) ResetConnectorData() {
-	m.connector_data = nil
-	delete(m.clearedFields, refreshtoken.FieldConnectorData)
+// ResetAaguid resets all changes to the "aaguid" field.
+func (m *WebAuthnCredentialMutation) ResetAaguid() {
+	m.aaguid = nil
+	delete(m.clearedFields, webauthncredential.FieldAaguid)
 }
 
-// SetToken sets the "token" field.
-func (m *RefreshTokenMutation) SetToken(s string) {
-	m.token = &s
+// SetSignCount sets the "sign_count" field.
+func (m *WebAuthnCredentialMutation) SetSignCount(u uint32) {
+	m.sign_count = &u
+	m.addsign_count = nil
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *RefreshTokenMutation) Token() (r string, exists bool) {
-	v := m.token
+// SignCount returns the value of the "sign_count" field in the mutation.
+func (m *WebAuthnCredentialMutation) SignCount() (r uint32, exists bool) {
+	v := m.sign_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldSignCount returns the old "sign_count" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *WebAuthnCredentialMutation) OldSignCount(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldSignCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldSignCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldSignCount: %w", err)
 	}
-	return oldValue.Token, nil
-}
-
-// ResetToken resets all changes to the "token" field.
-func (m *RefreshTokenMutation) ResetToken() {
-	m.token = nil
+	return oldValue.SignCount, nil
 }
 
-// SetObsoleteToken sets the "obsolete_token" field.
-func (m *RefreshTokenMutation) SetObsoleteToken(s string) {
-	m.obsolete_token = &s
+// AddSignCount adds u to the "sign_count" field.
+func (m *WebAuthnCredentialMutation) AddSignCount(u int32) {
+	if m.addsign_count != nil {
+		*m.addsign_count += u
+	} else {
+		m.addsign_count = &u
+	}
 }
 
-// ObsoleteToken returns the value of the "obsolete_token" field in the mutation.
-func (m *RefreshTokenMutation) ObsoleteToken() (r string, exists bool) {
-	v := m.obsolete_token
+// AddedSignCount returns the value that was added to the "sign_count" field in this mutation.
+func (m *WebAuthnCredentialMutation) AddedSignCount() (r int32, exists bool) {
+	v := m.addsign_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldObsoleteToken returns the old "obsolete_token" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldObsoleteToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldObsoleteToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldObsoleteToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldObsoleteToken: %w", err)
-	}
-	return oldValue.ObsoleteToken, nil
-}
-
-// ResetObsoleteToken resets all changes to the "obsolete_token" field.
-func (m *RefreshTokenMutation) ResetObsoleteToken() {
-	m.obsolete_token = nil
+// ResetSignCount resets all changes to the "sign_count" field.
+func (m *WebAuthnCredentialMutation) ResetSignCount() {
+	m.sign_count = nil
+	m.addsign_count = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+func (m *WebAuthnCredentialMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebAuthnCredentialMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7002,10 +13288,10 @@ func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebAuthnCredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7020,17 +13306,17 @@ func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RefreshTokenMutation) ResetCreatedAt() {
+func (m *WebAuthnCredentialMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetLastUsed sets the "last_used" field.
-func (m *RefreshTokenMutation) SetLastUsed(t time.Time) {
+func (m *WebAuthnCredentialMutation) SetLastUsed(t time.Time) {
 	m.last_used = &t
 }
 
 // LastUsed returns the value of the "last_used" field in the mutation.
-func (m *RefreshTokenMutation) LastUsed() (r time.Time, exists bool) {
+func (m *WebAuthnCredentialMutation) LastUsed() (r time.Time, exists bool) {
 	v := m.last_used
 	if v == nil {
 		return
@@ -7038,10 +13324,10 @@ func (m *RefreshTokenMutation) LastUsed() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldLastUsed returns the old "last_used" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsed returns the old "last_used" field's value of the WebAuthnCredential entity.
+// If the WebAuthnCredential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldLastUsed(ctx context.Context) (v time.Time, err error) {
+func (m *WebAuthnCredentialMutation) OldLastUsed(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
 	}
@@ -7056,74 +13342,53 @@ func (m *RefreshTokenMutation) OldLastUsed(ctx context.Context) (v time.Time, er
 }
 
 // ResetLastUsed resets all changes to the "last_used" field.
-func (m *RefreshTokenMutation) ResetLastUsed() {
+func (m *WebAuthnCredentialMutation) ResetLastUsed() {
 	m.last_used = nil
 }
 
-// Where appends a list predicates to the RefreshTokenMutation builder.
-func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+// Where appends a list predicates to the WebAuthnCredentialMutation builder.
+func (m *WebAuthnCredentialMutation) Where(ps ...predicate.WebAuthnCredential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *RefreshTokenMutation) Op() Op {
+func (m *WebAuthnCredentialMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (RefreshToken).
-func (m *RefreshTokenMutation) Type() string {
+// Type returns the node type of this mutation (WebAuthnCredential).
+func (m *WebAuthnCredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RefreshTokenMutation) Fields() []string {
-	fields := make([]string, 0, 15)
-	if m.client_id != nil {
-		fields = append(fields, refreshtoken.FieldClientID)
-	}
-	if m.scopes != nil {
-		fields = append(fields, refreshtoken.FieldScopes)
-	}
-	if m.nonce != nil {
-		fields = append(fields, refreshtoken.FieldNonce)
-	}
-	if m.claims_user_id != nil {
-		fields = append(fields, refreshtoken.FieldClaimsUserID)
-	}
-	if m.claims_username != nil {
-		fields = append(fields, refreshtoken.FieldClaimsUsername)
-	}
-	if m.claims_email != nil {
-		fields = append(fields, refreshtoken.FieldClaimsEmail)
-	}
-	if m.claims_email_verified != nil {
-		fields = append(fields, refreshtoken.FieldClaimsEmailVerified)
-	}
-	if m.claims_groups != nil {
-		fields = append(fields, refreshtoken.FieldClaimsGroups)
+func (m *WebAuthnCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.email != nil {
+		fields = append(fields, webauthncredential.FieldEmail)
 	}
-	if m.claims_preferred_username != nil {
-		fields = append(fields, refreshtoken.FieldClaimsPreferredUsername)
+	if m.public_key != nil {
+		fields = append(fields, webauthncredential.FieldPublicKey)
 	}
-	if m.connector_id != nil {
-		fields = append(fields, refreshtoken.FieldConnectorID)
+	if m.attestation_type != nil {
+		fields = append(fields, webauthncredential.FieldAttestationType)
 	}
-	if m.connector_data != nil {
-		fields = append(fields, refreshtoken.FieldConnectorData)
+	if m.transports != nil {
+		fields = append(fields, webauthncredential.FieldTransports)
 	}
-	if m.token != nil {
-		fields = append(fields, refreshtoken.FieldToken)
+	if m.aaguid != nil {
+		fields = append(fields, webauthncredential.FieldAaguid)
 	}
-	if m.obsolete_token != nil {
-		fields = append(fields, refreshtoken.FieldObsoleteToken)
+	if m.sign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
 	}
 	if m.created_at != nil {
-		fields = append(fields, refreshtoken.FieldCreatedAt)
+		fields = append(fields, webauthncredential.FieldCreatedAt)
 	}
 	if m.last_used != nil {
-		fields = append(fields, refreshtoken.FieldLastUsed)
+		fields = append(fields, webauthncredential.FieldLastUsed)
 	}
 	return fields
 }
@@ -7131,37 +13396,23 @@ func (m *RefreshTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *WebAuthnCredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case refreshtoken.FieldClientID:
-		return m.ClientID()
-	case refreshtoken.FieldScopes:
-		return m.Scopes()
-	case refreshtoken.FieldNonce:
-		return m.Nonce()
-	case refreshtoken.FieldClaimsUserID:
-		return m.ClaimsUserID()
-	case refreshtoken.FieldClaimsUsername:
-		return m.ClaimsUsername()
-	case refreshtoken.FieldClaimsEmail:
-		return m.ClaimsEmail()
-	case refreshtoken.FieldClaimsEmailVerified:
-		return m.ClaimsEmailVerified()
-	case refreshtoken.FieldClaimsGroups:
-		return m.ClaimsGroups()
-	case refreshtoken.FieldClaimsPreferredUsername:
-		return m.ClaimsPreferredUsername()
-	case refreshtoken.FieldConnectorID:
-		return m.ConnectorID()
-	case refreshtoken.FieldConnectorData:
-		return m.ConnectorData()
-	case refreshtoken.FieldToken:
-		return m.Token()
-	case refreshtoken.FieldObsoleteToken:
-		return m.ObsoleteToken()
-	case refreshtoken.FieldCreatedAt:
+	case webauthncredential.FieldEmail:
+		return m.Email()
+	case webauthncredential.FieldPublicKey:
+		return m.PublicKey()
+	case webauthncredential.FieldAttestationType:
+		return m.AttestationType()
+	case webauthncredential.FieldTransports:
+		return m.Transports()
+	case webauthncredential.FieldAaguid:
+		return m.Aaguid()
+	case webauthncredential.FieldSignCount:
+		return m.SignCount()
+	case webauthncredential.FieldCreatedAt:
 		return m.CreatedAt()
-	case refreshtoken.FieldLastUsed:
+	case webauthncredential.FieldLastUsed:
 		return m.LastUsed()
 	}
 	return nil, false
@@ -7170,146 +13421,83 @@ func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebAuthnCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case refreshtoken.FieldClientID:
-		return m.OldClientID(ctx)
-	case refreshtoken.FieldScopes:
-		return m.OldScopes(ctx)
-	case refreshtoken.FieldNonce:
-		return m.OldNonce(ctx)
-	case refreshtoken.FieldClaimsUserID:
-		return m.OldClaimsUserID(ctx)
-	case refreshtoken.FieldClaimsUsername:
-		return m.OldClaimsUsername(ctx)
-	case refreshtoken.FieldClaimsEmail:
-		return m.OldClaimsEmail(ctx)
-	case refreshtoken.FieldClaimsEmailVerified:
-		return m.OldClaimsEmailVerified(ctx)
-	case refreshtoken.FieldClaimsGroups:
-		return m.OldClaimsGroups(ctx)
-	case refreshtoken.FieldClaimsPreferredUsername:
-		return m.OldClaimsPreferredUsername(ctx)
-	case refreshtoken.FieldConnectorID:
-		return m.OldConnectorID(ctx)
-	case refreshtoken.FieldConnectorData:
-		return m.OldConnectorData(ctx)
-	case refreshtoken.FieldToken:
-		return m.OldToken(ctx)
-	case refreshtoken.FieldObsoleteToken:
-		return m.OldObsoleteToken(ctx)
-	case refreshtoken.FieldCreatedAt:
+	case webauthncredential.FieldEmail:
+		return m.OldEmail(ctx)
+	case webauthncredential.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case webauthncredential.FieldAttestationType:
+		return m.OldAttestationType(ctx)
+	case webauthncredential.FieldTransports:
+		return m.OldTransports(ctx)
+	case webauthncredential.FieldAaguid:
+		return m.OldAaguid(ctx)
+	case webauthncredential.FieldSignCount:
+		return m.OldSignCount(ctx)
+	case webauthncredential.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case refreshtoken.FieldLastUsed:
+	case webauthncredential.FieldLastUsed:
 		return m.OldLastUsed(ctx)
 	}
-	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+	return nil, fmt.Errorf("unknown WebAuthnCredential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+func (m *WebAuthnCredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case refreshtoken.FieldClientID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClientID(v)
-		return nil
-	case refreshtoken.FieldScopes:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScopes(v)
-		return nil
-	case refreshtoken.FieldNonce:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNonce(v)
-		return nil
-	case refreshtoken.FieldClaimsUserID:
+	case webauthncredential.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaimsUserID(v)
+		m.SetEmail(v)
 		return nil
-	case refreshtoken.FieldClaimsUsername:
-		v, ok := value.(string)
+	case webauthncredential.FieldPublicKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaimsUsername(v)
+		m.SetPublicKey(v)
 		return nil
-	case refreshtoken.FieldClaimsEmail:
+	case webauthncredential.FieldAttestationType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaimsEmail(v)
-		return nil
-	case refreshtoken.FieldClaimsEmailVerified:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClaimsEmailVerified(v)
+		m.SetAttestationType(v)
 		return nil
-	case refreshtoken.FieldClaimsGroups:
+	case webauthncredential.FieldTransports:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaimsGroups(v)
-		return nil
-	case refreshtoken.FieldClaimsPreferredUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClaimsPreferredUsername(v)
-		return nil
-	case refreshtoken.FieldConnectorID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConnectorID(v)
+		m.SetTransports(v)
 		return nil
-	case refreshtoken.FieldConnectorData:
+	case webauthncredential.FieldAaguid:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConnectorData(v)
-		return nil
-	case refreshtoken.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
+		m.SetAaguid(v)
 		return nil
-	case refreshtoken.FieldObsoleteToken:
-		v, ok := value.(string)
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetObsoleteToken(v)
+		m.SetSignCount(v)
 		return nil
-	case refreshtoken.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case refreshtoken.FieldLastUsed:
+	case webauthncredential.FieldLastUsed:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -7317,168 +13505,156 @@ func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
 		m.SetLastUsed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RefreshToken field %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RefreshTokenMutation) AddedFields() []string {
-	return nil
+func (m *WebAuthnCredentialMutation) AddedFields() []string {
+	var fields []string
+	if m.addsign_count != nil {
+		fields = append(fields, webauthncredential.FieldSignCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebAuthnCredentialMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webauthncredential.FieldSignCount:
+		return m.AddedSignCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+func (m *WebAuthnCredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webauthncredential.FieldSignCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSignCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RefreshTokenMutation) ClearedFields() []string {
+func (m *WebAuthnCredentialMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(refreshtoken.FieldScopes) {
-		fields = append(fields, refreshtoken.FieldScopes)
-	}
-	if m.FieldCleared(refreshtoken.FieldClaimsGroups) {
-		fields = append(fields, refreshtoken.FieldClaimsGroups)
+	if m.FieldCleared(webauthncredential.FieldTransports) {
+		fields = append(fields, webauthncredential.FieldTransports)
 	}
-	if m.FieldCleared(refreshtoken.FieldConnectorData) {
-		fields = append(fields, refreshtoken.FieldConnectorData)
+	if m.FieldCleared(webauthncredential.FieldAaguid) {
+		fields = append(fields, webauthncredential.FieldAaguid)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+func (m *WebAuthnCredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RefreshTokenMutation) ClearField(name string) error {
+func (m *WebAuthnCredentialMutation) ClearField(name string) error {
 	switch name {
-	case refreshtoken.FieldScopes:
-		m.ClearScopes()
-		return nil
-	case refreshtoken.FieldClaimsGroups:
-		m.ClearClaimsGroups()
+	case webauthncredential.FieldTransports:
+		m.ClearTransports()
 		return nil
-	case refreshtoken.FieldConnectorData:
-		m.ClearConnectorData()
+	case webauthncredential.FieldAaguid:
+		m.ClearAaguid()
 		return nil
 	}
-	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RefreshTokenMutation) ResetField(name string) error {
+func (m *WebAuthnCredentialMutation) ResetField(name string) error {
 	switch name {
-	case refreshtoken.FieldClientID:
-		m.ResetClientID()
-		return nil
-	case refreshtoken.FieldScopes:
-		m.ResetScopes()
-		return nil
-	case refreshtoken.FieldNonce:
-		m.ResetNonce()
-		return nil
-	case refreshtoken.FieldClaimsUserID:
-		m.ResetClaimsUserID()
-		return nil
-	case refreshtoken.FieldClaimsUsername:
-		m.ResetClaimsUsername()
-		return nil
-	case refreshtoken.FieldClaimsEmail:
-		m.ResetClaimsEmail()
-		return nil
-	case refreshtoken.FieldClaimsEmailVerified:
-		m.ResetClaimsEmailVerified()
-		return nil
-	case refreshtoken.FieldClaimsGroups:
-		m.ResetClaimsGroups()
+	case webauthncredential.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case refreshtoken.FieldClaimsPreferredUsername:
-		m.ResetClaimsPreferredUsername()
+	case webauthncredential.FieldPublicKey:
+		m.ResetPublicKey()
 		return nil
-	case refreshtoken.FieldConnectorID:
-		m.ResetConnectorID()
+	case webauthncredential.FieldAttestationType:
+		m.ResetAttestationType()
 		return nil
-	case refreshtoken.FieldConnectorData:
-		m.ResetConnectorData()
+	case webauthncredential.FieldTransports:
+		m.ResetTransports()
 		return nil
-	case refreshtoken.FieldToken:
-		m.ResetToken()
+	case webauthncredential.FieldAaguid:
+		m.ResetAaguid()
 		return nil
-	case refreshtoken.FieldObsoleteToken:
-		m.ResetObsoleteToken()
+	case webauthncredential.FieldSignCount:
+		m.ResetSignCount()
 		return nil
-	case refreshtoken.FieldCreatedAt:
+	case webauthncredential.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case refreshtoken.FieldLastUsed:
+	case webauthncredential.FieldLastUsed:
 		m.ResetLastUsed()
 		return nil
 	}
-	return fmt.Errorf("unknown RefreshToken field %s", name)
+	return fmt.Errorf("unknown WebAuthnCredential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RefreshTokenMutation) AddedEdges() []string {
+func (m *WebAuthnCredentialMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *WebAuthnCredentialMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RefreshTokenMutation) RemovedEdges() []string {
+func (m *WebAuthnCredentialMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebAuthnCredentialMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RefreshTokenMutation) ClearedEdges() []string {
+func (m *WebAuthnCredentialMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+func (m *WebAuthnCredentialMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RefreshTokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+func (m *WebAuthnCredentialMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebAuthnCredential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RefreshTokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown RefreshToken edge %s", name)
+func (m *WebAuthnCredentialMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebAuthnCredential edge %s", name)
 }
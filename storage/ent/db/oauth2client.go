@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
+	"gopkg.in/square/go-jose.v2"
 )
 
 // OAuth2Client is the model entity for the OAuth2Client schema.
@@ -28,6 +29,26 @@ type OAuth2Client struct {
 	Name string `json:"name,omitempty"`
 	// LogoURL holds the value of the "logo_url" field.
 	LogoURL string `json:"logo_url,omitempty"`
+	// BackchannelNotificationEndpoint holds the value of the "backchannel_notification_endpoint" field.
+	BackchannelNotificationEndpoint string `json:"backchannel_notification_endpoint,omitempty"`
+	// IDTokenEncryptedResponseAlg holds the value of the "id_token_encrypted_response_alg" field.
+	IDTokenEncryptedResponseAlg string `json:"id_token_encrypted_response_alg,omitempty"`
+	// IDTokenEncryptedResponseEnc holds the value of the "id_token_encrypted_response_enc" field.
+	IDTokenEncryptedResponseEnc string `json:"id_token_encrypted_response_enc,omitempty"`
+	// UserinfoSignedResponseAlg holds the value of the "userinfo_signed_response_alg" field.
+	UserinfoSignedResponseAlg string `json:"userinfo_signed_response_alg,omitempty"`
+	// UserinfoEncryptedResponseAlg holds the value of the "userinfo_encrypted_response_alg" field.
+	UserinfoEncryptedResponseAlg string `json:"userinfo_encrypted_response_alg,omitempty"`
+	// UserinfoEncryptedResponseEnc holds the value of the "userinfo_encrypted_response_enc" field.
+	UserinfoEncryptedResponseEnc string `json:"userinfo_encrypted_response_enc,omitempty"`
+	// Jwks holds the value of the "jwks" field.
+	Jwks *jose.JSONWebKeySet `json:"jwks,omitempty"`
+	// SubjectType holds the value of the "subject_type" field.
+	SubjectType string `json:"subject_type,omitempty"`
+	// SectorIdentifier holds the value of the "sector_identifier" field.
+	SectorIdentifier string `json:"sector_identifier,omitempty"`
+	// BackchannelLogoutURI holds the value of the "backchannel_logout_uri" field.
+	BackchannelLogoutURI string `json:"backchannel_logout_uri,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -35,11 +56,11 @@ func (*OAuth2Client) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case oauth2client.FieldRedirectUris, oauth2client.FieldTrustedPeers:
+		case oauth2client.FieldRedirectUris, oauth2client.FieldTrustedPeers, oauth2client.FieldJwks:
 			values[i] = new([]byte)
 		case oauth2client.FieldPublic:
 			values[i] = new(sql.NullBool)
-		case oauth2client.FieldID, oauth2client.FieldSecret, oauth2client.FieldName, oauth2client.FieldLogoURL:
+		case oauth2client.FieldID, oauth2client.FieldSecret, oauth2client.FieldName, oauth2client.FieldLogoURL, oauth2client.FieldBackchannelNotificationEndpoint, oauth2client.FieldIDTokenEncryptedResponseAlg, oauth2client.FieldIDTokenEncryptedResponseEnc, oauth2client.FieldUserinfoSignedResponseAlg, oauth2client.FieldUserinfoEncryptedResponseAlg, oauth2client.FieldUserinfoEncryptedResponseEnc, oauth2client.FieldSubjectType, oauth2client.FieldSectorIdentifier, oauth2client.FieldBackchannelLogoutURI:
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type OAuth2Client", columns[i])
@@ -102,6 +123,68 @@ func (o *OAuth2Client) assignValues(columns []string, values []interface{}) erro
 			} else if value.Valid {
 				o.LogoURL = value.String
 			}
+		case oauth2client.FieldBackchannelNotificationEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backchannel_notification_endpoint", values[i])
+			} else if value.Valid {
+				o.BackchannelNotificationEndpoint = value.String
+			}
+		case oauth2client.FieldIDTokenEncryptedResponseAlg:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id_token_encrypted_response_alg", values[i])
+			} else if value.Valid {
+				o.IDTokenEncryptedResponseAlg = value.String
+			}
+		case oauth2client.FieldIDTokenEncryptedResponseEnc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id_token_encrypted_response_enc", values[i])
+			} else if value.Valid {
+				o.IDTokenEncryptedResponseEnc = value.String
+			}
+		case oauth2client.FieldUserinfoSignedResponseAlg:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field userinfo_signed_response_alg", values[i])
+			} else if value.Valid {
+				o.UserinfoSignedResponseAlg = value.String
+			}
+		case oauth2client.FieldUserinfoEncryptedResponseAlg:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field userinfo_encrypted_response_alg", values[i])
+			} else if value.Valid {
+				o.UserinfoEncryptedResponseAlg = value.String
+			}
+		case oauth2client.FieldUserinfoEncryptedResponseEnc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field userinfo_encrypted_response_enc", values[i])
+			} else if value.Valid {
+				o.UserinfoEncryptedResponseEnc = value.String
+			}
+		case oauth2client.FieldJwks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field jwks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.Jwks); err != nil {
+					return fmt.Errorf("unmarshal field jwks: %w", err)
+				}
+			}
+		case oauth2client.FieldSubjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_type", values[i])
+			} else if value.Valid {
+				o.SubjectType = value.String
+			}
+		case oauth2client.FieldSectorIdentifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sector_identifier", values[i])
+			} else if value.Valid {
+				o.SectorIdentifier = value.String
+			}
+		case oauth2client.FieldBackchannelLogoutURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backchannel_logout_uri", values[i])
+			} else if value.Valid {
+				o.BackchannelLogoutURI = value.String
+			}
 		}
 	}
 	return nil
@@ -142,6 +225,26 @@ func (o *OAuth2Client) String() string {
 	builder.WriteString(o.Name)
 	builder.WriteString(", logo_url=")
 	builder.WriteString(o.LogoURL)
+	builder.WriteString(", backchannel_notification_endpoint=")
+	builder.WriteString(o.BackchannelNotificationEndpoint)
+	builder.WriteString(", id_token_encrypted_response_alg=")
+	builder.WriteString(o.IDTokenEncryptedResponseAlg)
+	builder.WriteString(", id_token_encrypted_response_enc=")
+	builder.WriteString(o.IDTokenEncryptedResponseEnc)
+	builder.WriteString(", userinfo_signed_response_alg=")
+	builder.WriteString(o.UserinfoSignedResponseAlg)
+	builder.WriteString(", userinfo_encrypted_response_alg=")
+	builder.WriteString(o.UserinfoEncryptedResponseAlg)
+	builder.WriteString(", userinfo_encrypted_response_enc=")
+	builder.WriteString(o.UserinfoEncryptedResponseEnc)
+	builder.WriteString(", jwks=")
+	builder.WriteString(fmt.Sprintf("%v", o.Jwks))
+	builder.WriteString(", subject_type=")
+	builder.WriteString(o.SubjectType)
+	builder.WriteString(", sector_identifier=")
+	builder.WriteString(o.SectorIdentifier)
+	builder.WriteString(", backchannel_logout_uri=")
+	builder.WriteString(o.BackchannelLogoutURI)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -19,6 +19,26 @@ const (
 	FieldName = "name"
 	// FieldLogoURL holds the string denoting the logo_url field in the database.
 	FieldLogoURL = "logo_url"
+	// FieldBackchannelNotificationEndpoint holds the string denoting the backchannel_notification_endpoint field in the database.
+	FieldBackchannelNotificationEndpoint = "backchannel_notification_endpoint"
+	// FieldIDTokenEncryptedResponseAlg holds the string denoting the id_token_encrypted_response_alg field in the database.
+	FieldIDTokenEncryptedResponseAlg = "id_token_encrypted_response_alg"
+	// FieldIDTokenEncryptedResponseEnc holds the string denoting the id_token_encrypted_response_enc field in the database.
+	FieldIDTokenEncryptedResponseEnc = "id_token_encrypted_response_enc"
+	// FieldUserinfoSignedResponseAlg holds the string denoting the userinfo_signed_response_alg field in the database.
+	FieldUserinfoSignedResponseAlg = "userinfo_signed_response_alg"
+	// FieldUserinfoEncryptedResponseAlg holds the string denoting the userinfo_encrypted_response_alg field in the database.
+	FieldUserinfoEncryptedResponseAlg = "userinfo_encrypted_response_alg"
+	// FieldUserinfoEncryptedResponseEnc holds the string denoting the userinfo_encrypted_response_enc field in the database.
+	FieldUserinfoEncryptedResponseEnc = "userinfo_encrypted_response_enc"
+	// FieldJwks holds the string denoting the jwks field in the database.
+	FieldJwks = "jwks"
+	// FieldSubjectType holds the string denoting the subject_type field in the database.
+	FieldSubjectType = "subject_type"
+	// FieldSectorIdentifier holds the string denoting the sector_identifier field in the database.
+	FieldSectorIdentifier = "sector_identifier"
+	// FieldBackchannelLogoutURI holds the string denoting the backchannel_logout_uri field in the database.
+	FieldBackchannelLogoutURI = "backchannel_logout_uri"
 	// Table holds the table name of the oauth2client in the database.
 	Table = "oauth2clients"
 )
@@ -32,6 +52,16 @@ var Columns = []string{
 	FieldPublic,
 	FieldName,
 	FieldLogoURL,
+	FieldBackchannelNotificationEndpoint,
+	FieldIDTokenEncryptedResponseAlg,
+	FieldIDTokenEncryptedResponseEnc,
+	FieldUserinfoSignedResponseAlg,
+	FieldUserinfoEncryptedResponseAlg,
+	FieldUserinfoEncryptedResponseEnc,
+	FieldJwks,
+	FieldSubjectType,
+	FieldSectorIdentifier,
+	FieldBackchannelLogoutURI,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -51,6 +81,24 @@ var (
 	NameValidator func(string) error
 	// LogoURLValidator is a validator for the "logo_url" field. It is called by the builders before save.
 	LogoURLValidator func(string) error
+	// DefaultBackchannelNotificationEndpoint holds the default value on creation for the "backchannel_notification_endpoint" field.
+	DefaultBackchannelNotificationEndpoint string
+	// DefaultIDTokenEncryptedResponseAlg holds the default value on creation for the "id_token_encrypted_response_alg" field.
+	DefaultIDTokenEncryptedResponseAlg string
+	// DefaultIDTokenEncryptedResponseEnc holds the default value on creation for the "id_token_encrypted_response_enc" field.
+	DefaultIDTokenEncryptedResponseEnc string
+	// DefaultUserinfoSignedResponseAlg holds the default value on creation for the "userinfo_signed_response_alg" field.
+	DefaultUserinfoSignedResponseAlg string
+	// DefaultUserinfoEncryptedResponseAlg holds the default value on creation for the "userinfo_encrypted_response_alg" field.
+	DefaultUserinfoEncryptedResponseAlg string
+	// DefaultUserinfoEncryptedResponseEnc holds the default value on creation for the "userinfo_encrypted_response_enc" field.
+	DefaultUserinfoEncryptedResponseEnc string
+	// DefaultSubjectType holds the default value on creation for the "subject_type" field.
+	DefaultSubjectType string
+	// DefaultSectorIdentifier holds the default value on creation for the "sector_identifier" field.
+	DefaultSectorIdentifier string
+	// DefaultBackchannelLogoutURI holds the default value on creation for the "backchannel_logout_uri" field.
+	DefaultBackchannelLogoutURI string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -118,6 +118,69 @@ func LogoURL(v string) predicate.OAuth2Client {
 	})
 }
 
+// BackchannelNotificationEndpoint applies equality check predicate on the "backchannel_notification_endpoint" field. It's identical to BackchannelNotificationEndpointEQ.
+func BackchannelNotificationEndpoint(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlg applies equality check predicate on the "id_token_encrypted_response_alg" field. It's identical to IDTokenEncryptedResponseAlgEQ.
+func IDTokenEncryptedResponseAlg(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseEnc applies equality check predicate on the "id_token_encrypted_response_enc" field. It's identical to IDTokenEncryptedResponseEncEQ.
+func IDTokenEncryptedResponseEnc(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoSignedResponseAlg applies equality check predicate on the "userinfo_signed_response_alg" field. It's identical to UserinfoSignedResponseAlgEQ.
+func UserinfoSignedResponseAlg(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlg applies equality check predicate on the "userinfo_encrypted_response_alg" field. It's identical to UserinfoEncryptedResponseAlgEQ.
+func UserinfoEncryptedResponseAlg(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseEnc applies equality check predicate on the "userinfo_encrypted_response_enc" field. It's identical to UserinfoEncryptedResponseEncEQ.
+func UserinfoEncryptedResponseEnc(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// SubjectType applies equality check predicate on the "subject_type" field. It's identical to SubjectTypeEQ.
+func SubjectType(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubjectType), v))
+	})
+}
+
+// SectorIdentifier applies equality check predicate on the "sector_identifier" field. It's identical to SectorIdentifierEQ.
+func SectorIdentifier(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// BackchannelLogoutURI applies equality check predicate on the "backchannel_logout_uri" field. It's identical to BackchannelLogoutURIEQ.
+func BackchannelLogoutURI(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
 // SecretEQ applies the EQ predicate on the "secret" field.
 func SecretEQ(v string) predicate.OAuth2Client {
 	return predicate.OAuth2Client(func(s *sql.Selector) {
@@ -493,6 +556,1019 @@ func LogoURLContainsFold(v string) predicate.OAuth2Client {
 	})
 }
 
+// BackchannelNotificationEndpointEQ applies the EQ predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointNEQ applies the NEQ predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointIn applies the In predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBackchannelNotificationEndpoint), v...))
+	})
+}
+
+// BackchannelNotificationEndpointNotIn applies the NotIn predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointNotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBackchannelNotificationEndpoint), v...))
+	})
+}
+
+// BackchannelNotificationEndpointGT applies the GT predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointGTE applies the GTE predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointLT applies the LT predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointLTE applies the LTE predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointContains applies the Contains predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointHasPrefix applies the HasPrefix predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointHasSuffix applies the HasSuffix predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointEqualFold applies the EqualFold predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// BackchannelNotificationEndpointContainsFold applies the ContainsFold predicate on the "backchannel_notification_endpoint" field.
+func BackchannelNotificationEndpointContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBackchannelNotificationEndpoint), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgEQ applies the EQ predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgNEQ applies the NEQ predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgIn applies the In predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIDTokenEncryptedResponseAlg), v...))
+	})
+}
+
+// IDTokenEncryptedResponseAlgNotIn applies the NotIn predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgNotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIDTokenEncryptedResponseAlg), v...))
+	})
+}
+
+// IDTokenEncryptedResponseAlgGT applies the GT predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgGTE applies the GTE predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgLT applies the LT predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgLTE applies the LTE predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgContains applies the Contains predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgHasPrefix applies the HasPrefix predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgHasSuffix applies the HasSuffix predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgEqualFold applies the EqualFold predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseAlgContainsFold applies the ContainsFold predicate on the "id_token_encrypted_response_alg" field.
+func IDTokenEncryptedResponseAlgContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIDTokenEncryptedResponseAlg), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncEQ applies the EQ predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncNEQ applies the NEQ predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncIn applies the In predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIDTokenEncryptedResponseEnc), v...))
+	})
+}
+
+// IDTokenEncryptedResponseEncNotIn applies the NotIn predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncNotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIDTokenEncryptedResponseEnc), v...))
+	})
+}
+
+// IDTokenEncryptedResponseEncGT applies the GT predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncGTE applies the GTE predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncLT applies the LT predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncLTE applies the LTE predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncContains applies the Contains predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncHasPrefix applies the HasPrefix predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncHasSuffix applies the HasSuffix predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncEqualFold applies the EqualFold predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// IDTokenEncryptedResponseEncContainsFold applies the ContainsFold predicate on the "id_token_encrypted_response_enc" field.
+func IDTokenEncryptedResponseEncContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIDTokenEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoSignedResponseAlgEQ applies the EQ predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgNEQ applies the NEQ predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgIn applies the In predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserinfoSignedResponseAlg), v...))
+	})
+}
+
+// UserinfoSignedResponseAlgNotIn applies the NotIn predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgNotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserinfoSignedResponseAlg), v...))
+	})
+}
+
+// UserinfoSignedResponseAlgGT applies the GT predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgGTE applies the GTE predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgLT applies the LT predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgLTE applies the LTE predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgContains applies the Contains predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgHasPrefix applies the HasPrefix predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgHasSuffix applies the HasSuffix predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgEqualFold applies the EqualFold predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoSignedResponseAlgContainsFold applies the ContainsFold predicate on the "userinfo_signed_response_alg" field.
+func UserinfoSignedResponseAlgContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUserinfoSignedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgEQ applies the EQ predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgNEQ applies the NEQ predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgIn applies the In predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserinfoEncryptedResponseAlg), v...))
+	})
+}
+
+// UserinfoEncryptedResponseAlgNotIn applies the NotIn predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgNotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserinfoEncryptedResponseAlg), v...))
+	})
+}
+
+// UserinfoEncryptedResponseAlgGT applies the GT predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgGTE applies the GTE predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgLT applies the LT predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgLTE applies the LTE predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgContains applies the Contains predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgHasPrefix applies the HasPrefix predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgHasSuffix applies the HasSuffix predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgEqualFold applies the EqualFold predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseAlgContainsFold applies the ContainsFold predicate on the "userinfo_encrypted_response_alg" field.
+func UserinfoEncryptedResponseAlgContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUserinfoEncryptedResponseAlg), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncEQ applies the EQ predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncNEQ applies the NEQ predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncIn applies the In predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserinfoEncryptedResponseEnc), v...))
+	})
+}
+
+// UserinfoEncryptedResponseEncNotIn applies the NotIn predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncNotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserinfoEncryptedResponseEnc), v...))
+	})
+}
+
+// UserinfoEncryptedResponseEncGT applies the GT predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncGTE applies the GTE predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncLT applies the LT predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncLTE applies the LTE predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncContains applies the Contains predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncHasPrefix applies the HasPrefix predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncHasSuffix applies the HasSuffix predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncEqualFold applies the EqualFold predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// UserinfoEncryptedResponseEncContainsFold applies the ContainsFold predicate on the "userinfo_encrypted_response_enc" field.
+func UserinfoEncryptedResponseEncContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUserinfoEncryptedResponseEnc), v))
+	})
+}
+
+// JwksIsNil applies the IsNil predicate on the "jwks" field.
+func JwksIsNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldJwks)))
+	})
+}
+
+// JwksNotNil applies the NotNil predicate on the "jwks" field.
+func JwksNotNil() predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldJwks)))
+	})
+}
+
+// SubjectTypeEQ applies the EQ predicate on the "subject_type" field.
+func SubjectTypeEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeNEQ applies the NEQ predicate on the "subject_type" field.
+func SubjectTypeNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeIn applies the In predicate on the "subject_type" field.
+func SubjectTypeIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubjectType), v...))
+	})
+}
+
+// SubjectTypeNotIn applies the NotIn predicate on the "subject_type" field.
+func SubjectTypeNotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubjectType), v...))
+	})
+}
+
+// SubjectTypeGT applies the GT predicate on the "subject_type" field.
+func SubjectTypeGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeGTE applies the GTE predicate on the "subject_type" field.
+func SubjectTypeGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeLT applies the LT predicate on the "subject_type" field.
+func SubjectTypeLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeLTE applies the LTE predicate on the "subject_type" field.
+func SubjectTypeLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeContains applies the Contains predicate on the "subject_type" field.
+func SubjectTypeContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeHasPrefix applies the HasPrefix predicate on the "subject_type" field.
+func SubjectTypeHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeHasSuffix applies the HasSuffix predicate on the "subject_type" field.
+func SubjectTypeHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeEqualFold applies the EqualFold predicate on the "subject_type" field.
+func SubjectTypeEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSubjectType), v))
+	})
+}
+
+// SubjectTypeContainsFold applies the ContainsFold predicate on the "subject_type" field.
+func SubjectTypeContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSubjectType), v))
+	})
+}
+
+// SectorIdentifierEQ applies the EQ predicate on the "sector_identifier" field.
+func SectorIdentifierEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierNEQ applies the NEQ predicate on the "sector_identifier" field.
+func SectorIdentifierNEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierIn applies the In predicate on the "sector_identifier" field.
+func SectorIdentifierIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSectorIdentifier), v...))
+	})
+}
+
+// SectorIdentifierNotIn applies the NotIn predicate on the "sector_identifier" field.
+func SectorIdentifierNotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSectorIdentifier), v...))
+	})
+}
+
+// SectorIdentifierGT applies the GT predicate on the "sector_identifier" field.
+func SectorIdentifierGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierGTE applies the GTE predicate on the "sector_identifier" field.
+func SectorIdentifierGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierLT applies the LT predicate on the "sector_identifier" field.
+func SectorIdentifierLT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierLTE applies the LTE predicate on the "sector_identifier" field.
+func SectorIdentifierLTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierContains applies the Contains predicate on the "sector_identifier" field.
+func SectorIdentifierContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierHasPrefix applies the HasPrefix predicate on the "sector_identifier" field.
+func SectorIdentifierHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierHasSuffix applies the HasSuffix predicate on the "sector_identifier" field.
+func SectorIdentifierHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierEqualFold applies the EqualFold predicate on the "sector_identifier" field.
+func SectorIdentifierEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// SectorIdentifierContainsFold applies the ContainsFold predicate on the "sector_identifier" field.
+func SectorIdentifierContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSectorIdentifier), v))
+	})
+}
+
+// BackchannelLogoutURIEQ applies the EQ predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURINEQ applies the NEQ predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURINEQ(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURIIn applies the In predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBackchannelLogoutURI), v...))
+	})
+}
+
+// BackchannelLogoutURINotIn applies the NotIn predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURINotIn(vs ...string) predicate.OAuth2Client {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBackchannelLogoutURI), v...))
+	})
+}
+
+// BackchannelLogoutURIGT applies the GT predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIGT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURIGTE applies the GTE predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIGTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURILT applies the LT predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURILT(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURILTE applies the LTE predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURILTE(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURIContains applies the Contains predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIContains(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURIHasPrefix applies the HasPrefix predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIHasPrefix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURIHasSuffix applies the HasSuffix predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIHasSuffix(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURIEqualFold applies the EqualFold predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIEqualFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
+// BackchannelLogoutURIContainsFold applies the ContainsFold predicate on the "backchannel_logout_uri" field.
+func BackchannelLogoutURIContainsFold(v string) predicate.OAuth2Client {
+	return predicate.OAuth2Client(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBackchannelLogoutURI), v))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OAuth2Client) predicate.OAuth2Client {
 	return predicate.OAuth2Client(func(s *sql.Selector) {
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
+	"gopkg.in/square/go-jose.v2"
 )
 
 // OAuth2ClientCreate is the builder for creating a OAuth2Client entity.
@@ -55,6 +56,138 @@ func (oc *OAuth2ClientCreate) SetLogoURL(s string) *OAuth2ClientCreate {
 	return oc
 }
 
+// SetBackchannelNotificationEndpoint sets the "backchannel_notification_endpoint" field.
+func (oc *OAuth2ClientCreate) SetBackchannelNotificationEndpoint(s string) *OAuth2ClientCreate {
+	oc.mutation.SetBackchannelNotificationEndpoint(s)
+	return oc
+}
+
+// SetNillableBackchannelNotificationEndpoint sets the "backchannel_notification_endpoint" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableBackchannelNotificationEndpoint(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetBackchannelNotificationEndpoint(*s)
+	}
+	return oc
+}
+
+// SetIDTokenEncryptedResponseAlg sets the "id_token_encrypted_response_alg" field.
+func (oc *OAuth2ClientCreate) SetIDTokenEncryptedResponseAlg(s string) *OAuth2ClientCreate {
+	oc.mutation.SetIDTokenEncryptedResponseAlg(s)
+	return oc
+}
+
+// SetNillableIDTokenEncryptedResponseAlg sets the "id_token_encrypted_response_alg" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableIDTokenEncryptedResponseAlg(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetIDTokenEncryptedResponseAlg(*s)
+	}
+	return oc
+}
+
+// SetIDTokenEncryptedResponseEnc sets the "id_token_encrypted_response_enc" field.
+func (oc *OAuth2ClientCreate) SetIDTokenEncryptedResponseEnc(s string) *OAuth2ClientCreate {
+	oc.mutation.SetIDTokenEncryptedResponseEnc(s)
+	return oc
+}
+
+// SetNillableIDTokenEncryptedResponseEnc sets the "id_token_encrypted_response_enc" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableIDTokenEncryptedResponseEnc(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetIDTokenEncryptedResponseEnc(*s)
+	}
+	return oc
+}
+
+// SetUserinfoSignedResponseAlg sets the "userinfo_signed_response_alg" field.
+func (oc *OAuth2ClientCreate) SetUserinfoSignedResponseAlg(s string) *OAuth2ClientCreate {
+	oc.mutation.SetUserinfoSignedResponseAlg(s)
+	return oc
+}
+
+// SetNillableUserinfoSignedResponseAlg sets the "userinfo_signed_response_alg" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableUserinfoSignedResponseAlg(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetUserinfoSignedResponseAlg(*s)
+	}
+	return oc
+}
+
+// SetUserinfoEncryptedResponseAlg sets the "userinfo_encrypted_response_alg" field.
+func (oc *OAuth2ClientCreate) SetUserinfoEncryptedResponseAlg(s string) *OAuth2ClientCreate {
+	oc.mutation.SetUserinfoEncryptedResponseAlg(s)
+	return oc
+}
+
+// SetNillableUserinfoEncryptedResponseAlg sets the "userinfo_encrypted_response_alg" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableUserinfoEncryptedResponseAlg(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetUserinfoEncryptedResponseAlg(*s)
+	}
+	return oc
+}
+
+// SetUserinfoEncryptedResponseEnc sets the "userinfo_encrypted_response_enc" field.
+func (oc *OAuth2ClientCreate) SetUserinfoEncryptedResponseEnc(s string) *OAuth2ClientCreate {
+	oc.mutation.SetUserinfoEncryptedResponseEnc(s)
+	return oc
+}
+
+// SetNillableUserinfoEncryptedResponseEnc sets the "userinfo_encrypted_response_enc" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableUserinfoEncryptedResponseEnc(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetUserinfoEncryptedResponseEnc(*s)
+	}
+	return oc
+}
+
+// SetJwks sets the "jwks" field.
+func (oc *OAuth2ClientCreate) SetJwks(jwks *jose.JSONWebKeySet) *OAuth2ClientCreate {
+	oc.mutation.SetJwks(jwks)
+	return oc
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (oc *OAuth2ClientCreate) SetSubjectType(s string) *OAuth2ClientCreate {
+	oc.mutation.SetSubjectType(s)
+	return oc
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableSubjectType(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetSubjectType(*s)
+	}
+	return oc
+}
+
+// SetSectorIdentifier sets the "sector_identifier" field.
+func (oc *OAuth2ClientCreate) SetSectorIdentifier(s string) *OAuth2ClientCreate {
+	oc.mutation.SetSectorIdentifier(s)
+	return oc
+}
+
+// SetNillableSectorIdentifier sets the "sector_identifier" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableSectorIdentifier(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetSectorIdentifier(*s)
+	}
+	return oc
+}
+
+// SetBackchannelLogoutURI sets the "backchannel_logout_uri" field.
+func (oc *OAuth2ClientCreate) SetBackchannelLogoutURI(s string) *OAuth2ClientCreate {
+	oc.mutation.SetBackchannelLogoutURI(s)
+	return oc
+}
+
+// SetNillableBackchannelLogoutURI sets the "backchannel_logout_uri" field if the given value is not nil.
+func (oc *OAuth2ClientCreate) SetNillableBackchannelLogoutURI(s *string) *OAuth2ClientCreate {
+	if s != nil {
+		oc.SetBackchannelLogoutURI(*s)
+	}
+	return oc
+}
+
 // SetID sets the "id" field.
 func (oc *OAuth2ClientCreate) SetID(s string) *OAuth2ClientCreate {
 	oc.mutation.SetID(s)
@@ -72,6 +205,7 @@ func (oc *OAuth2ClientCreate) Save(ctx context.Context) (*OAuth2Client, error) {
 		err  error
 		node *OAuth2Client
 	)
+	oc.defaults()
 	if len(oc.hooks) == 0 {
 		if err = oc.check(); err != nil {
 			return nil, err
@@ -129,6 +263,46 @@ func (oc *OAuth2ClientCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (oc *OAuth2ClientCreate) defaults() {
+	if _, ok := oc.mutation.BackchannelNotificationEndpoint(); !ok {
+		v := oauth2client.DefaultBackchannelNotificationEndpoint
+		oc.mutation.SetBackchannelNotificationEndpoint(v)
+	}
+	if _, ok := oc.mutation.IDTokenEncryptedResponseAlg(); !ok {
+		v := oauth2client.DefaultIDTokenEncryptedResponseAlg
+		oc.mutation.SetIDTokenEncryptedResponseAlg(v)
+	}
+	if _, ok := oc.mutation.IDTokenEncryptedResponseEnc(); !ok {
+		v := oauth2client.DefaultIDTokenEncryptedResponseEnc
+		oc.mutation.SetIDTokenEncryptedResponseEnc(v)
+	}
+	if _, ok := oc.mutation.UserinfoSignedResponseAlg(); !ok {
+		v := oauth2client.DefaultUserinfoSignedResponseAlg
+		oc.mutation.SetUserinfoSignedResponseAlg(v)
+	}
+	if _, ok := oc.mutation.UserinfoEncryptedResponseAlg(); !ok {
+		v := oauth2client.DefaultUserinfoEncryptedResponseAlg
+		oc.mutation.SetUserinfoEncryptedResponseAlg(v)
+	}
+	if _, ok := oc.mutation.UserinfoEncryptedResponseEnc(); !ok {
+		v := oauth2client.DefaultUserinfoEncryptedResponseEnc
+		oc.mutation.SetUserinfoEncryptedResponseEnc(v)
+	}
+	if _, ok := oc.mutation.SubjectType(); !ok {
+		v := oauth2client.DefaultSubjectType
+		oc.mutation.SetSubjectType(v)
+	}
+	if _, ok := oc.mutation.SectorIdentifier(); !ok {
+		v := oauth2client.DefaultSectorIdentifier
+		oc.mutation.SetSectorIdentifier(v)
+	}
+	if _, ok := oc.mutation.BackchannelLogoutURI(); !ok {
+		v := oauth2client.DefaultBackchannelLogoutURI
+		oc.mutation.SetBackchannelLogoutURI(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (oc *OAuth2ClientCreate) check() error {
 	if _, ok := oc.mutation.Secret(); !ok {
@@ -158,6 +332,33 @@ func (oc *OAuth2ClientCreate) check() error {
 			return &ValidationError{Name: "logo_url", err: fmt.Errorf(`db: validator failed for field "OAuth2Client.logo_url": %w`, err)}
 		}
 	}
+	if _, ok := oc.mutation.BackchannelNotificationEndpoint(); !ok {
+		return &ValidationError{Name: "backchannel_notification_endpoint", err: errors.New(`db: missing required field "OAuth2Client.backchannel_notification_endpoint"`)}
+	}
+	if _, ok := oc.mutation.IDTokenEncryptedResponseAlg(); !ok {
+		return &ValidationError{Name: "id_token_encrypted_response_alg", err: errors.New(`db: missing required field "OAuth2Client.id_token_encrypted_response_alg"`)}
+	}
+	if _, ok := oc.mutation.IDTokenEncryptedResponseEnc(); !ok {
+		return &ValidationError{Name: "id_token_encrypted_response_enc", err: errors.New(`db: missing required field "OAuth2Client.id_token_encrypted_response_enc"`)}
+	}
+	if _, ok := oc.mutation.UserinfoSignedResponseAlg(); !ok {
+		return &ValidationError{Name: "userinfo_signed_response_alg", err: errors.New(`db: missing required field "OAuth2Client.userinfo_signed_response_alg"`)}
+	}
+	if _, ok := oc.mutation.UserinfoEncryptedResponseAlg(); !ok {
+		return &ValidationError{Name: "userinfo_encrypted_response_alg", err: errors.New(`db: missing required field "OAuth2Client.userinfo_encrypted_response_alg"`)}
+	}
+	if _, ok := oc.mutation.UserinfoEncryptedResponseEnc(); !ok {
+		return &ValidationError{Name: "userinfo_encrypted_response_enc", err: errors.New(`db: missing required field "OAuth2Client.userinfo_encrypted_response_enc"`)}
+	}
+	if _, ok := oc.mutation.SubjectType(); !ok {
+		return &ValidationError{Name: "subject_type", err: errors.New(`db: missing required field "OAuth2Client.subject_type"`)}
+	}
+	if _, ok := oc.mutation.SectorIdentifier(); !ok {
+		return &ValidationError{Name: "sector_identifier", err: errors.New(`db: missing required field "OAuth2Client.sector_identifier"`)}
+	}
+	if _, ok := oc.mutation.BackchannelLogoutURI(); !ok {
+		return &ValidationError{Name: "backchannel_logout_uri", err: errors.New(`db: missing required field "OAuth2Client.backchannel_logout_uri"`)}
+	}
 	if v, ok := oc.mutation.ID(); ok {
 		if err := oauth2client.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "OAuth2Client.id": %w`, err)}
@@ -247,6 +448,86 @@ func (oc *OAuth2ClientCreate) createSpec() (*OAuth2Client, *sqlgraph.CreateSpec)
 		})
 		_node.LogoURL = value
 	}
+	if value, ok := oc.mutation.BackchannelNotificationEndpoint(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldBackchannelNotificationEndpoint,
+		})
+		_node.BackchannelNotificationEndpoint = value
+	}
+	if value, ok := oc.mutation.IDTokenEncryptedResponseAlg(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldIDTokenEncryptedResponseAlg,
+		})
+		_node.IDTokenEncryptedResponseAlg = value
+	}
+	if value, ok := oc.mutation.IDTokenEncryptedResponseEnc(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldIDTokenEncryptedResponseEnc,
+		})
+		_node.IDTokenEncryptedResponseEnc = value
+	}
+	if value, ok := oc.mutation.UserinfoSignedResponseAlg(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoSignedResponseAlg,
+		})
+		_node.UserinfoSignedResponseAlg = value
+	}
+	if value, ok := oc.mutation.UserinfoEncryptedResponseAlg(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoEncryptedResponseAlg,
+		})
+		_node.UserinfoEncryptedResponseAlg = value
+	}
+	if value, ok := oc.mutation.UserinfoEncryptedResponseEnc(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoEncryptedResponseEnc,
+		})
+		_node.UserinfoEncryptedResponseEnc = value
+	}
+	if value, ok := oc.mutation.Jwks(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauth2client.FieldJwks,
+		})
+		_node.Jwks = value
+	}
+	if value, ok := oc.mutation.SubjectType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldSubjectType,
+		})
+		_node.SubjectType = value
+	}
+	if value, ok := oc.mutation.SectorIdentifier(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldSectorIdentifier,
+		})
+		_node.SectorIdentifier = value
+	}
+	if value, ok := oc.mutation.BackchannelLogoutURI(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldBackchannelLogoutURI,
+		})
+		_node.BackchannelLogoutURI = value
+	}
 	return _node, _spec
 }
 
@@ -264,6 +545,7 @@ func (ocb *OAuth2ClientCreateBulk) Save(ctx context.Context) ([]*OAuth2Client, e
 	for i := range ocb.builders {
 		func(i int, root context.Context) {
 			builder := ocb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*OAuth2ClientMutation)
 				if !ok {
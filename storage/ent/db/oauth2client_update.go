@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"gopkg.in/square/go-jose.v2"
 )
 
 // OAuth2ClientUpdate is the builder for updating OAuth2Client entities.
@@ -75,6 +76,144 @@ func (ou *OAuth2ClientUpdate) SetLogoURL(s string) *OAuth2ClientUpdate {
 	return ou
 }
 
+// SetBackchannelNotificationEndpoint sets the "backchannel_notification_endpoint" field.
+func (ou *OAuth2ClientUpdate) SetBackchannelNotificationEndpoint(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetBackchannelNotificationEndpoint(s)
+	return ou
+}
+
+// SetNillableBackchannelNotificationEndpoint sets the "backchannel_notification_endpoint" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableBackchannelNotificationEndpoint(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetBackchannelNotificationEndpoint(*s)
+	}
+	return ou
+}
+
+// SetIDTokenEncryptedResponseAlg sets the "id_token_encrypted_response_alg" field.
+func (ou *OAuth2ClientUpdate) SetIDTokenEncryptedResponseAlg(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetIDTokenEncryptedResponseAlg(s)
+	return ou
+}
+
+// SetNillableIDTokenEncryptedResponseAlg sets the "id_token_encrypted_response_alg" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableIDTokenEncryptedResponseAlg(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetIDTokenEncryptedResponseAlg(*s)
+	}
+	return ou
+}
+
+// SetIDTokenEncryptedResponseEnc sets the "id_token_encrypted_response_enc" field.
+func (ou *OAuth2ClientUpdate) SetIDTokenEncryptedResponseEnc(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetIDTokenEncryptedResponseEnc(s)
+	return ou
+}
+
+// SetNillableIDTokenEncryptedResponseEnc sets the "id_token_encrypted_response_enc" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableIDTokenEncryptedResponseEnc(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetIDTokenEncryptedResponseEnc(*s)
+	}
+	return ou
+}
+
+// SetUserinfoSignedResponseAlg sets the "userinfo_signed_response_alg" field.
+func (ou *OAuth2ClientUpdate) SetUserinfoSignedResponseAlg(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetUserinfoSignedResponseAlg(s)
+	return ou
+}
+
+// SetNillableUserinfoSignedResponseAlg sets the "userinfo_signed_response_alg" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableUserinfoSignedResponseAlg(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetUserinfoSignedResponseAlg(*s)
+	}
+	return ou
+}
+
+// SetUserinfoEncryptedResponseAlg sets the "userinfo_encrypted_response_alg" field.
+func (ou *OAuth2ClientUpdate) SetUserinfoEncryptedResponseAlg(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetUserinfoEncryptedResponseAlg(s)
+	return ou
+}
+
+// SetNillableUserinfoEncryptedResponseAlg sets the "userinfo_encrypted_response_alg" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableUserinfoEncryptedResponseAlg(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetUserinfoEncryptedResponseAlg(*s)
+	}
+	return ou
+}
+
+// SetUserinfoEncryptedResponseEnc sets the "userinfo_encrypted_response_enc" field.
+func (ou *OAuth2ClientUpdate) SetUserinfoEncryptedResponseEnc(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetUserinfoEncryptedResponseEnc(s)
+	return ou
+}
+
+// SetNillableUserinfoEncryptedResponseEnc sets the "userinfo_encrypted_response_enc" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableUserinfoEncryptedResponseEnc(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetUserinfoEncryptedResponseEnc(*s)
+	}
+	return ou
+}
+
+// SetJwks sets the "jwks" field.
+func (ou *OAuth2ClientUpdate) SetJwks(jwks *jose.JSONWebKeySet) *OAuth2ClientUpdate {
+	ou.mutation.SetJwks(jwks)
+	return ou
+}
+
+// ClearJwks clears the value of the "jwks" field.
+func (ou *OAuth2ClientUpdate) ClearJwks() *OAuth2ClientUpdate {
+	ou.mutation.ClearJwks()
+	return ou
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (ou *OAuth2ClientUpdate) SetSubjectType(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetSubjectType(s)
+	return ou
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableSubjectType(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetSubjectType(*s)
+	}
+	return ou
+}
+
+// SetSectorIdentifier sets the "sector_identifier" field.
+func (ou *OAuth2ClientUpdate) SetSectorIdentifier(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetSectorIdentifier(s)
+	return ou
+}
+
+// SetNillableSectorIdentifier sets the "sector_identifier" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableSectorIdentifier(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetSectorIdentifier(*s)
+	}
+	return ou
+}
+
+// SetBackchannelLogoutURI sets the "backchannel_logout_uri" field.
+func (ou *OAuth2ClientUpdate) SetBackchannelLogoutURI(s string) *OAuth2ClientUpdate {
+	ou.mutation.SetBackchannelLogoutURI(s)
+	return ou
+}
+
+// SetNillableBackchannelLogoutURI sets the "backchannel_logout_uri" field if the given value is not nil.
+func (ou *OAuth2ClientUpdate) SetNillableBackchannelLogoutURI(s *string) *OAuth2ClientUpdate {
+	if s != nil {
+		ou.SetBackchannelLogoutURI(*s)
+	}
+	return ou
+}
+
 // Mutation returns the OAuth2ClientMutation object of the builder.
 func (ou *OAuth2ClientUpdate) Mutation() *OAuth2ClientMutation {
 	return ou.mutation
@@ -232,6 +371,82 @@ func (ou *OAuth2ClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: oauth2client.FieldLogoURL,
 		})
 	}
+	if value, ok := ou.mutation.BackchannelNotificationEndpoint(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldBackchannelNotificationEndpoint,
+		})
+	}
+	if value, ok := ou.mutation.IDTokenEncryptedResponseAlg(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldIDTokenEncryptedResponseAlg,
+		})
+	}
+	if value, ok := ou.mutation.IDTokenEncryptedResponseEnc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldIDTokenEncryptedResponseEnc,
+		})
+	}
+	if value, ok := ou.mutation.UserinfoSignedResponseAlg(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoSignedResponseAlg,
+		})
+	}
+	if value, ok := ou.mutation.UserinfoEncryptedResponseAlg(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoEncryptedResponseAlg,
+		})
+	}
+	if value, ok := ou.mutation.UserinfoEncryptedResponseEnc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoEncryptedResponseEnc,
+		})
+	}
+	if value, ok := ou.mutation.Jwks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauth2client.FieldJwks,
+		})
+	}
+	if ou.mutation.JwksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauth2client.FieldJwks,
+		})
+	}
+	if value, ok := ou.mutation.SubjectType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldSubjectType,
+		})
+	}
+	if value, ok := ou.mutation.SectorIdentifier(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldSectorIdentifier,
+		})
+	}
+	if value, ok := ou.mutation.BackchannelLogoutURI(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldBackchannelLogoutURI,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{oauth2client.Label}
@@ -299,6 +514,144 @@ func (ouo *OAuth2ClientUpdateOne) SetLogoURL(s string) *OAuth2ClientUpdateOne {
 	return ouo
 }
 
+// SetBackchannelNotificationEndpoint sets the "backchannel_notification_endpoint" field.
+func (ouo *OAuth2ClientUpdateOne) SetBackchannelNotificationEndpoint(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetBackchannelNotificationEndpoint(s)
+	return ouo
+}
+
+// SetNillableBackchannelNotificationEndpoint sets the "backchannel_notification_endpoint" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableBackchannelNotificationEndpoint(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetBackchannelNotificationEndpoint(*s)
+	}
+	return ouo
+}
+
+// SetIDTokenEncryptedResponseAlg sets the "id_token_encrypted_response_alg" field.
+func (ouo *OAuth2ClientUpdateOne) SetIDTokenEncryptedResponseAlg(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetIDTokenEncryptedResponseAlg(s)
+	return ouo
+}
+
+// SetNillableIDTokenEncryptedResponseAlg sets the "id_token_encrypted_response_alg" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableIDTokenEncryptedResponseAlg(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetIDTokenEncryptedResponseAlg(*s)
+	}
+	return ouo
+}
+
+// SetIDTokenEncryptedResponseEnc sets the "id_token_encrypted_response_enc" field.
+func (ouo *OAuth2ClientUpdateOne) SetIDTokenEncryptedResponseEnc(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetIDTokenEncryptedResponseEnc(s)
+	return ouo
+}
+
+// SetNillableIDTokenEncryptedResponseEnc sets the "id_token_encrypted_response_enc" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableIDTokenEncryptedResponseEnc(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetIDTokenEncryptedResponseEnc(*s)
+	}
+	return ouo
+}
+
+// SetUserinfoSignedResponseAlg sets the "userinfo_signed_response_alg" field.
+func (ouo *OAuth2ClientUpdateOne) SetUserinfoSignedResponseAlg(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetUserinfoSignedResponseAlg(s)
+	return ouo
+}
+
+// SetNillableUserinfoSignedResponseAlg sets the "userinfo_signed_response_alg" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableUserinfoSignedResponseAlg(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetUserinfoSignedResponseAlg(*s)
+	}
+	return ouo
+}
+
+// SetUserinfoEncryptedResponseAlg sets the "userinfo_encrypted_response_alg" field.
+func (ouo *OAuth2ClientUpdateOne) SetUserinfoEncryptedResponseAlg(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetUserinfoEncryptedResponseAlg(s)
+	return ouo
+}
+
+// SetNillableUserinfoEncryptedResponseAlg sets the "userinfo_encrypted_response_alg" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableUserinfoEncryptedResponseAlg(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetUserinfoEncryptedResponseAlg(*s)
+	}
+	return ouo
+}
+
+// SetUserinfoEncryptedResponseEnc sets the "userinfo_encrypted_response_enc" field.
+func (ouo *OAuth2ClientUpdateOne) SetUserinfoEncryptedResponseEnc(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetUserinfoEncryptedResponseEnc(s)
+	return ouo
+}
+
+// SetNillableUserinfoEncryptedResponseEnc sets the "userinfo_encrypted_response_enc" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableUserinfoEncryptedResponseEnc(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetUserinfoEncryptedResponseEnc(*s)
+	}
+	return ouo
+}
+
+// SetJwks sets the "jwks" field.
+func (ouo *OAuth2ClientUpdateOne) SetJwks(jwks *jose.JSONWebKeySet) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetJwks(jwks)
+	return ouo
+}
+
+// ClearJwks clears the value of the "jwks" field.
+func (ouo *OAuth2ClientUpdateOne) ClearJwks() *OAuth2ClientUpdateOne {
+	ouo.mutation.ClearJwks()
+	return ouo
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (ouo *OAuth2ClientUpdateOne) SetSubjectType(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetSubjectType(s)
+	return ouo
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableSubjectType(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetSubjectType(*s)
+	}
+	return ouo
+}
+
+// SetSectorIdentifier sets the "sector_identifier" field.
+func (ouo *OAuth2ClientUpdateOne) SetSectorIdentifier(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetSectorIdentifier(s)
+	return ouo
+}
+
+// SetNillableSectorIdentifier sets the "sector_identifier" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableSectorIdentifier(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetSectorIdentifier(*s)
+	}
+	return ouo
+}
+
+// SetBackchannelLogoutURI sets the "backchannel_logout_uri" field.
+func (ouo *OAuth2ClientUpdateOne) SetBackchannelLogoutURI(s string) *OAuth2ClientUpdateOne {
+	ouo.mutation.SetBackchannelLogoutURI(s)
+	return ouo
+}
+
+// SetNillableBackchannelLogoutURI sets the "backchannel_logout_uri" field if the given value is not nil.
+func (ouo *OAuth2ClientUpdateOne) SetNillableBackchannelLogoutURI(s *string) *OAuth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetBackchannelLogoutURI(*s)
+	}
+	return ouo
+}
+
 // Mutation returns the OAuth2ClientMutation object of the builder.
 func (ouo *OAuth2ClientUpdateOne) Mutation() *OAuth2ClientMutation {
 	return ouo.mutation
@@ -480,6 +833,82 @@ func (ouo *OAuth2ClientUpdateOne) sqlSave(ctx context.Context) (_node *OAuth2Cli
 			Column: oauth2client.FieldLogoURL,
 		})
 	}
+	if value, ok := ouo.mutation.BackchannelNotificationEndpoint(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldBackchannelNotificationEndpoint,
+		})
+	}
+	if value, ok := ouo.mutation.IDTokenEncryptedResponseAlg(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldIDTokenEncryptedResponseAlg,
+		})
+	}
+	if value, ok := ouo.mutation.IDTokenEncryptedResponseEnc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldIDTokenEncryptedResponseEnc,
+		})
+	}
+	if value, ok := ouo.mutation.UserinfoSignedResponseAlg(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoSignedResponseAlg,
+		})
+	}
+	if value, ok := ouo.mutation.UserinfoEncryptedResponseAlg(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoEncryptedResponseAlg,
+		})
+	}
+	if value, ok := ouo.mutation.UserinfoEncryptedResponseEnc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldUserinfoEncryptedResponseEnc,
+		})
+	}
+	if value, ok := ouo.mutation.Jwks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: oauth2client.FieldJwks,
+		})
+	}
+	if ouo.mutation.JwksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: oauth2client.FieldJwks,
+		})
+	}
+	if value, ok := ouo.mutation.SubjectType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldSubjectType,
+		})
+	}
+	if value, ok := ouo.mutation.SectorIdentifier(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldSectorIdentifier,
+		})
+	}
+	if value, ok := ouo.mutation.BackchannelLogoutURI(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: oauth2client.FieldBackchannelLogoutURI,
+		})
+	}
 	_node = &OAuth2Client{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,6 +3,7 @@
 package db
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -19,10 +20,22 @@ type Password struct {
 	Email string `json:"email,omitempty"`
 	// Hash holds the value of the "hash" field.
 	Hash []byte `json:"hash,omitempty"`
+	// PreviousHashes holds the value of the "previous_hashes" field.
+	PreviousHashes [][]uint8 `json:"previous_hashes,omitempty"`
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// PendingVerification holds the value of the "pending_verification" field.
+	PendingVerification bool `json:"pending_verification,omitempty"`
+	// PendingApproval holds the value of the "pending_approval" field.
+	PendingApproval bool `json:"pending_approval,omitempty"`
+	// Groups holds the value of the "groups" field.
+	Groups []string `json:"groups,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// Attributes holds the value of the "attributes" field.
+	Attributes map[string]string `json:"attributes,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -30,11 +43,13 @@ func (*Password) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case password.FieldHash:
+		case password.FieldHash, password.FieldPreviousHashes, password.FieldGroups, password.FieldAttributes:
 			values[i] = new([]byte)
+		case password.FieldPendingVerification, password.FieldPendingApproval:
+			values[i] = new(sql.NullBool)
 		case password.FieldID:
 			values[i] = new(sql.NullInt64)
-		case password.FieldEmail, password.FieldUsername, password.FieldUserID:
+		case password.FieldEmail, password.FieldUsername, password.FieldUserID, password.FieldDisplayName:
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Password", columns[i])
@@ -69,6 +84,14 @@ func (pa *Password) assignValues(columns []string, values []interface{}) error {
 			} else if value != nil {
 				pa.Hash = *value
 			}
+		case password.FieldPreviousHashes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_hashes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.PreviousHashes); err != nil {
+					return fmt.Errorf("unmarshal field previous_hashes: %w", err)
+				}
+			}
 		case password.FieldUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field username", values[i])
@@ -81,6 +104,40 @@ func (pa *Password) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				pa.UserID = value.String
 			}
+		case password.FieldPendingVerification:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_verification", values[i])
+			} else if value.Valid {
+				pa.PendingVerification = value.Bool
+			}
+		case password.FieldPendingApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_approval", values[i])
+			} else if value.Valid {
+				pa.PendingApproval = value.Bool
+			}
+		case password.FieldGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.Groups); err != nil {
+					return fmt.Errorf("unmarshal field groups: %w", err)
+				}
+			}
+		case password.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				pa.DisplayName = value.String
+			}
+		case password.FieldAttributes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attributes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.Attributes); err != nil {
+					return fmt.Errorf("unmarshal field attributes: %w", err)
+				}
+			}
 		}
 	}
 	return nil
@@ -113,10 +170,22 @@ func (pa *Password) String() string {
 	builder.WriteString(pa.Email)
 	builder.WriteString(", hash=")
 	builder.WriteString(fmt.Sprintf("%v", pa.Hash))
+	builder.WriteString(", previous_hashes=")
+	builder.WriteString(fmt.Sprintf("%v", pa.PreviousHashes))
 	builder.WriteString(", username=")
 	builder.WriteString(pa.Username)
 	builder.WriteString(", user_id=")
 	builder.WriteString(pa.UserID)
+	builder.WriteString(", pending_verification=")
+	builder.WriteString(fmt.Sprintf("%v", pa.PendingVerification))
+	builder.WriteString(", pending_approval=")
+	builder.WriteString(fmt.Sprintf("%v", pa.PendingApproval))
+	builder.WriteString(", groups=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Groups))
+	builder.WriteString(", display_name=")
+	builder.WriteString(pa.DisplayName)
+	builder.WriteString(", attributes=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Attributes))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -11,10 +11,22 @@ const (
 	FieldEmail = "email"
 	// FieldHash holds the string denoting the hash field in the database.
 	FieldHash = "hash"
+	// FieldPreviousHashes holds the string denoting the previous_hashes field in the database.
+	FieldPreviousHashes = "previous_hashes"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldPendingVerification holds the string denoting the pending_verification field in the database.
+	FieldPendingVerification = "pending_verification"
+	// FieldPendingApproval holds the string denoting the pending_approval field in the database.
+	FieldPendingApproval = "pending_approval"
+	// FieldGroups holds the string denoting the groups field in the database.
+	FieldGroups = "groups"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldAttributes holds the string denoting the attributes field in the database.
+	FieldAttributes = "attributes"
 	// Table holds the table name of the password in the database.
 	Table = "passwords"
 )
@@ -24,8 +36,14 @@ var Columns = []string{
 	FieldID,
 	FieldEmail,
 	FieldHash,
+	FieldPreviousHashes,
 	FieldUsername,
 	FieldUserID,
+	FieldPendingVerification,
+	FieldPendingApproval,
+	FieldGroups,
+	FieldDisplayName,
+	FieldAttributes,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -45,4 +63,10 @@ var (
 	UsernameValidator func(string) error
 	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	UserIDValidator func(string) error
+	// DefaultPendingVerification holds the default value on creation for the "pending_verification" field.
+	DefaultPendingVerification bool
+	// DefaultPendingApproval holds the default value on creation for the "pending_approval" field.
+	DefaultPendingApproval bool
+	// DefaultDisplayName holds the default value on creation for the "display_name" field.
+	DefaultDisplayName string
 )
@@ -118,6 +118,27 @@ func UserID(v string) predicate.Password {
 	})
 }
 
+// PendingVerification applies equality check predicate on the "pending_verification" field. It's identical to PendingVerificationEQ.
+func PendingVerification(v bool) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPendingVerification), v))
+	})
+}
+
+// PendingApproval applies equality check predicate on the "pending_approval" field. It's identical to PendingApprovalEQ.
+func PendingApproval(v bool) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPendingApproval), v))
+	})
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDisplayName), v))
+	})
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.Password {
 	return predicate.Password(func(s *sql.Selector) {
@@ -305,6 +326,20 @@ func HashLTE(v []byte) predicate.Password {
 	})
 }
 
+// PreviousHashesIsNil applies the IsNil predicate on the "previous_hashes" field.
+func PreviousHashesIsNil() predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPreviousHashes)))
+	})
+}
+
+// PreviousHashesNotNil applies the NotNil predicate on the "previous_hashes" field.
+func PreviousHashesNotNil() predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPreviousHashes)))
+	})
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.Password {
 	return predicate.Password(func(s *sql.Selector) {
@@ -527,6 +562,173 @@ func UserIDContainsFold(v string) predicate.Password {
 	})
 }
 
+// PendingVerificationEQ applies the EQ predicate on the "pending_verification" field.
+func PendingVerificationEQ(v bool) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPendingVerification), v))
+	})
+}
+
+// PendingVerificationNEQ applies the NEQ predicate on the "pending_verification" field.
+func PendingVerificationNEQ(v bool) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPendingVerification), v))
+	})
+}
+
+// PendingApprovalEQ applies the EQ predicate on the "pending_approval" field.
+func PendingApprovalEQ(v bool) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPendingApproval), v))
+	})
+}
+
+// PendingApprovalNEQ applies the NEQ predicate on the "pending_approval" field.
+func PendingApprovalNEQ(v bool) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPendingApproval), v))
+	})
+}
+
+// GroupsIsNil applies the IsNil predicate on the "groups" field.
+func GroupsIsNil() predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldGroups)))
+	})
+}
+
+// GroupsNotNil applies the NotNil predicate on the "groups" field.
+func GroupsNotNil() predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldGroups)))
+	})
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.Password {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Password(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDisplayName), v...))
+	})
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.Password {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Password(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDisplayName), v...))
+	})
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDisplayName), v))
+	})
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDisplayName), v))
+	})
+}
+
+// AttributesIsNil applies the IsNil predicate on the "attributes" field.
+func AttributesIsNil() predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAttributes)))
+	})
+}
+
+// AttributesNotNil applies the NotNil predicate on the "attributes" field.
+func AttributesNotNil() predicate.Password {
+	return predicate.Password(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAttributes)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Password) predicate.Password {
 	return predicate.Password(func(s *sql.Selector) {
@@ -31,6 +31,12 @@ func (pc *PasswordCreate) SetHash(b []byte) *PasswordCreate {
 	return pc
 }
 
+// SetPreviousHashes sets the "previous_hashes" field.
+func (pc *PasswordCreate) SetPreviousHashes(u [][]uint8) *PasswordCreate {
+	pc.mutation.SetPreviousHashes(u)
+	return pc
+}
+
 // SetUsername sets the "username" field.
 func (pc *PasswordCreate) SetUsername(s string) *PasswordCreate {
 	pc.mutation.SetUsername(s)
@@ -43,6 +49,60 @@ func (pc *PasswordCreate) SetUserID(s string) *PasswordCreate {
 	return pc
 }
 
+// SetPendingVerification sets the "pending_verification" field.
+func (pc *PasswordCreate) SetPendingVerification(b bool) *PasswordCreate {
+	pc.mutation.SetPendingVerification(b)
+	return pc
+}
+
+// SetNillablePendingVerification sets the "pending_verification" field if the given value is not nil.
+func (pc *PasswordCreate) SetNillablePendingVerification(b *bool) *PasswordCreate {
+	if b != nil {
+		pc.SetPendingVerification(*b)
+	}
+	return pc
+}
+
+// SetPendingApproval sets the "pending_approval" field.
+func (pc *PasswordCreate) SetPendingApproval(b bool) *PasswordCreate {
+	pc.mutation.SetPendingApproval(b)
+	return pc
+}
+
+// SetNillablePendingApproval sets the "pending_approval" field if the given value is not nil.
+func (pc *PasswordCreate) SetNillablePendingApproval(b *bool) *PasswordCreate {
+	if b != nil {
+		pc.SetPendingApproval(*b)
+	}
+	return pc
+}
+
+// SetGroups sets the "groups" field.
+func (pc *PasswordCreate) SetGroups(s []string) *PasswordCreate {
+	pc.mutation.SetGroups(s)
+	return pc
+}
+
+// SetDisplayName sets the "display_name" field.
+func (pc *PasswordCreate) SetDisplayName(s string) *PasswordCreate {
+	pc.mutation.SetDisplayName(s)
+	return pc
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (pc *PasswordCreate) SetNillableDisplayName(s *string) *PasswordCreate {
+	if s != nil {
+		pc.SetDisplayName(*s)
+	}
+	return pc
+}
+
+// SetAttributes sets the "attributes" field.
+func (pc *PasswordCreate) SetAttributes(m map[string]string) *PasswordCreate {
+	pc.mutation.SetAttributes(m)
+	return pc
+}
+
 // Mutation returns the PasswordMutation object of the builder.
 func (pc *PasswordCreate) Mutation() *PasswordMutation {
 	return pc.mutation
@@ -54,6 +114,7 @@ func (pc *PasswordCreate) Save(ctx context.Context) (*Password, error) {
 		err  error
 		node *Password
 	)
+	pc.defaults()
 	if len(pc.hooks) == 0 {
 		if err = pc.check(); err != nil {
 			return nil, err
@@ -111,6 +172,22 @@ func (pc *PasswordCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (pc *PasswordCreate) defaults() {
+	if _, ok := pc.mutation.PendingVerification(); !ok {
+		v := password.DefaultPendingVerification
+		pc.mutation.SetPendingVerification(v)
+	}
+	if _, ok := pc.mutation.PendingApproval(); !ok {
+		v := password.DefaultPendingApproval
+		pc.mutation.SetPendingApproval(v)
+	}
+	if _, ok := pc.mutation.DisplayName(); !ok {
+		v := password.DefaultDisplayName
+		pc.mutation.SetDisplayName(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (pc *PasswordCreate) check() error {
 	if _, ok := pc.mutation.Email(); !ok {
@@ -140,6 +217,15 @@ func (pc *PasswordCreate) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`db: validator failed for field "Password.user_id": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.PendingVerification(); !ok {
+		return &ValidationError{Name: "pending_verification", err: errors.New(`db: missing required field "Password.pending_verification"`)}
+	}
+	if _, ok := pc.mutation.PendingApproval(); !ok {
+		return &ValidationError{Name: "pending_approval", err: errors.New(`db: missing required field "Password.pending_approval"`)}
+	}
+	if _, ok := pc.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`db: missing required field "Password.display_name"`)}
+	}
 	return nil
 }
 
@@ -183,6 +269,14 @@ func (pc *PasswordCreate) createSpec() (*Password, *sqlgraph.CreateSpec) {
 		})
 		_node.Hash = value
 	}
+	if value, ok := pc.mutation.PreviousHashes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldPreviousHashes,
+		})
+		_node.PreviousHashes = value
+	}
 	if value, ok := pc.mutation.Username(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -199,6 +293,46 @@ func (pc *PasswordCreate) createSpec() (*Password, *sqlgraph.CreateSpec) {
 		})
 		_node.UserID = value
 	}
+	if value, ok := pc.mutation.PendingVerification(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: password.FieldPendingVerification,
+		})
+		_node.PendingVerification = value
+	}
+	if value, ok := pc.mutation.PendingApproval(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: password.FieldPendingApproval,
+		})
+		_node.PendingApproval = value
+	}
+	if value, ok := pc.mutation.Groups(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldGroups,
+		})
+		_node.Groups = value
+	}
+	if value, ok := pc.mutation.DisplayName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: password.FieldDisplayName,
+		})
+		_node.DisplayName = value
+	}
+	if value, ok := pc.mutation.Attributes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldAttributes,
+		})
+		_node.Attributes = value
+	}
 	return _node, _spec
 }
 
@@ -216,6 +350,7 @@ func (pcb *PasswordCreateBulk) Save(ctx context.Context) ([]*Password, error) {
 	for i := range pcb.builders {
 		func(i int, root context.Context) {
 			builder := pcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PasswordMutation)
 				if !ok {
@@ -39,6 +39,18 @@ func (pu *PasswordUpdate) SetHash(b []byte) *PasswordUpdate {
 	return pu
 }
 
+// SetPreviousHashes sets the "previous_hashes" field.
+func (pu *PasswordUpdate) SetPreviousHashes(u [][]uint8) *PasswordUpdate {
+	pu.mutation.SetPreviousHashes(u)
+	return pu
+}
+
+// ClearPreviousHashes clears the value of the "previous_hashes" field.
+func (pu *PasswordUpdate) ClearPreviousHashes() *PasswordUpdate {
+	pu.mutation.ClearPreviousHashes()
+	return pu
+}
+
 // SetUsername sets the "username" field.
 func (pu *PasswordUpdate) SetUsername(s string) *PasswordUpdate {
 	pu.mutation.SetUsername(s)
@@ -51,6 +63,72 @@ func (pu *PasswordUpdate) SetUserID(s string) *PasswordUpdate {
 	return pu
 }
 
+// SetPendingVerification sets the "pending_verification" field.
+func (pu *PasswordUpdate) SetPendingVerification(b bool) *PasswordUpdate {
+	pu.mutation.SetPendingVerification(b)
+	return pu
+}
+
+// SetNillablePendingVerification sets the "pending_verification" field if the given value is not nil.
+func (pu *PasswordUpdate) SetNillablePendingVerification(b *bool) *PasswordUpdate {
+	if b != nil {
+		pu.SetPendingVerification(*b)
+	}
+	return pu
+}
+
+// SetPendingApproval sets the "pending_approval" field.
+func (pu *PasswordUpdate) SetPendingApproval(b bool) *PasswordUpdate {
+	pu.mutation.SetPendingApproval(b)
+	return pu
+}
+
+// SetNillablePendingApproval sets the "pending_approval" field if the given value is not nil.
+func (pu *PasswordUpdate) SetNillablePendingApproval(b *bool) *PasswordUpdate {
+	if b != nil {
+		pu.SetPendingApproval(*b)
+	}
+	return pu
+}
+
+// SetGroups sets the "groups" field.
+func (pu *PasswordUpdate) SetGroups(s []string) *PasswordUpdate {
+	pu.mutation.SetGroups(s)
+	return pu
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (pu *PasswordUpdate) ClearGroups() *PasswordUpdate {
+	pu.mutation.ClearGroups()
+	return pu
+}
+
+// SetDisplayName sets the "display_name" field.
+func (pu *PasswordUpdate) SetDisplayName(s string) *PasswordUpdate {
+	pu.mutation.SetDisplayName(s)
+	return pu
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (pu *PasswordUpdate) SetNillableDisplayName(s *string) *PasswordUpdate {
+	if s != nil {
+		pu.SetDisplayName(*s)
+	}
+	return pu
+}
+
+// SetAttributes sets the "attributes" field.
+func (pu *PasswordUpdate) SetAttributes(m map[string]string) *PasswordUpdate {
+	pu.mutation.SetAttributes(m)
+	return pu
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (pu *PasswordUpdate) ClearAttributes() *PasswordUpdate {
+	pu.mutation.ClearAttributes()
+	return pu
+}
+
 // Mutation returns the PasswordMutation object of the builder.
 func (pu *PasswordUpdate) Mutation() *PasswordMutation {
 	return pu.mutation
@@ -168,6 +246,19 @@ func (pu *PasswordUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: password.FieldHash,
 		})
 	}
+	if value, ok := pu.mutation.PreviousHashes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldPreviousHashes,
+		})
+	}
+	if pu.mutation.PreviousHashesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: password.FieldPreviousHashes,
+		})
+	}
 	if value, ok := pu.mutation.Username(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -182,6 +273,53 @@ func (pu *PasswordUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: password.FieldUserID,
 		})
 	}
+	if value, ok := pu.mutation.PendingVerification(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: password.FieldPendingVerification,
+		})
+	}
+	if value, ok := pu.mutation.PendingApproval(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: password.FieldPendingApproval,
+		})
+	}
+	if value, ok := pu.mutation.Groups(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldGroups,
+		})
+	}
+	if pu.mutation.GroupsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: password.FieldGroups,
+		})
+	}
+	if value, ok := pu.mutation.DisplayName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: password.FieldDisplayName,
+		})
+	}
+	if value, ok := pu.mutation.Attributes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldAttributes,
+		})
+	}
+	if pu.mutation.AttributesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: password.FieldAttributes,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{password.Label}
@@ -213,6 +351,18 @@ func (puo *PasswordUpdateOne) SetHash(b []byte) *PasswordUpdateOne {
 	return puo
 }
 
+// SetPreviousHashes sets the "previous_hashes" field.
+func (puo *PasswordUpdateOne) SetPreviousHashes(u [][]uint8) *PasswordUpdateOne {
+	puo.mutation.SetPreviousHashes(u)
+	return puo
+}
+
+// ClearPreviousHashes clears the value of the "previous_hashes" field.
+func (puo *PasswordUpdateOne) ClearPreviousHashes() *PasswordUpdateOne {
+	puo.mutation.ClearPreviousHashes()
+	return puo
+}
+
 // SetUsername sets the "username" field.
 func (puo *PasswordUpdateOne) SetUsername(s string) *PasswordUpdateOne {
 	puo.mutation.SetUsername(s)
@@ -225,6 +375,72 @@ func (puo *PasswordUpdateOne) SetUserID(s string) *PasswordUpdateOne {
 	return puo
 }
 
+// SetPendingVerification sets the "pending_verification" field.
+func (puo *PasswordUpdateOne) SetPendingVerification(b bool) *PasswordUpdateOne {
+	puo.mutation.SetPendingVerification(b)
+	return puo
+}
+
+// SetNillablePendingVerification sets the "pending_verification" field if the given value is not nil.
+func (puo *PasswordUpdateOne) SetNillablePendingVerification(b *bool) *PasswordUpdateOne {
+	if b != nil {
+		puo.SetPendingVerification(*b)
+	}
+	return puo
+}
+
+// SetPendingApproval sets the "pending_approval" field.
+func (puo *PasswordUpdateOne) SetPendingApproval(b bool) *PasswordUpdateOne {
+	puo.mutation.SetPendingApproval(b)
+	return puo
+}
+
+// SetNillablePendingApproval sets the "pending_approval" field if the given value is not nil.
+func (puo *PasswordUpdateOne) SetNillablePendingApproval(b *bool) *PasswordUpdateOne {
+	if b != nil {
+		puo.SetPendingApproval(*b)
+	}
+	return puo
+}
+
+// SetGroups sets the "groups" field.
+func (puo *PasswordUpdateOne) SetGroups(s []string) *PasswordUpdateOne {
+	puo.mutation.SetGroups(s)
+	return puo
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (puo *PasswordUpdateOne) ClearGroups() *PasswordUpdateOne {
+	puo.mutation.ClearGroups()
+	return puo
+}
+
+// SetDisplayName sets the "display_name" field.
+func (puo *PasswordUpdateOne) SetDisplayName(s string) *PasswordUpdateOne {
+	puo.mutation.SetDisplayName(s)
+	return puo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (puo *PasswordUpdateOne) SetNillableDisplayName(s *string) *PasswordUpdateOne {
+	if s != nil {
+		puo.SetDisplayName(*s)
+	}
+	return puo
+}
+
+// SetAttributes sets the "attributes" field.
+func (puo *PasswordUpdateOne) SetAttributes(m map[string]string) *PasswordUpdateOne {
+	puo.mutation.SetAttributes(m)
+	return puo
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (puo *PasswordUpdateOne) ClearAttributes() *PasswordUpdateOne {
+	puo.mutation.ClearAttributes()
+	return puo
+}
+
 // Mutation returns the PasswordMutation object of the builder.
 func (puo *PasswordUpdateOne) Mutation() *PasswordMutation {
 	return puo.mutation
@@ -366,6 +582,19 @@ func (puo *PasswordUpdateOne) sqlSave(ctx context.Context) (_node *Password, err
 			Column: password.FieldHash,
 		})
 	}
+	if value, ok := puo.mutation.PreviousHashes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldPreviousHashes,
+		})
+	}
+	if puo.mutation.PreviousHashesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: password.FieldPreviousHashes,
+		})
+	}
 	if value, ok := puo.mutation.Username(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -380,6 +609,53 @@ func (puo *PasswordUpdateOne) sqlSave(ctx context.Context) (_node *Password, err
 			Column: password.FieldUserID,
 		})
 	}
+	if value, ok := puo.mutation.PendingVerification(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: password.FieldPendingVerification,
+		})
+	}
+	if value, ok := puo.mutation.PendingApproval(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: password.FieldPendingApproval,
+		})
+	}
+	if value, ok := puo.mutation.Groups(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldGroups,
+		})
+	}
+	if puo.mutation.GroupsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: password.FieldGroups,
+		})
+	}
+	if value, ok := puo.mutation.DisplayName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: password.FieldDisplayName,
+		})
+	}
+	if value, ok := puo.mutation.Attributes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: password.FieldAttributes,
+		})
+	}
+	if puo.mutation.AttributesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: password.FieldAttributes,
+		})
+	}
 	_node = &Password{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,110 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
+)
+
+// PasswordReset is the model entity for the PasswordReset schema.
+type PasswordReset struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PasswordReset) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passwordreset.FieldID, passwordreset.FieldEmail:
+			values[i] = new(sql.NullString)
+		case passwordreset.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PasswordReset", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PasswordReset fields.
+func (pr *PasswordReset) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passwordreset.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pr.ID = value.String
+			}
+		case passwordreset.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				pr.Email = value.String
+			}
+		case passwordreset.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				pr.Expiry = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this PasswordReset.
+// Note that you need to call PasswordReset.Unwrap() before calling this method if this PasswordReset
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PasswordReset) Update() *PasswordResetUpdateOne {
+	return (&PasswordResetClient{config: pr.config}).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PasswordReset entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PasswordReset) Unwrap() *PasswordReset {
+	tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("db: PasswordReset is not a transactional entity")
+	}
+	pr.config.driver = tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PasswordReset) String() string {
+	var builder strings.Builder
+	builder.WriteString("PasswordReset(")
+	builder.WriteString(fmt.Sprintf("id=%v", pr.ID))
+	builder.WriteString(", email=")
+	builder.WriteString(pr.Email)
+	builder.WriteString(", expiry=")
+	builder.WriteString(pr.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PasswordResets is a parsable slice of PasswordReset.
+type PasswordResets []*PasswordReset
+
+func (pr PasswordResets) config(cfg config) {
+	for _i := range pr {
+		pr[_i].config = cfg
+	}
+}
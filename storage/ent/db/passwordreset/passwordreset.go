@@ -0,0 +1,40 @@
+// Code generated by entc, DO NOT EDIT.
+
+package passwordreset
+
+const (
+	// Label holds the string label denoting the passwordreset type in the database.
+	Label = "password_reset"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldExpiry holds the string denoting the expiry field in the database.
+	FieldExpiry = "expiry"
+	// Table holds the table name of the passwordreset in the database.
+	Table = "password_resets"
+)
+
+// Columns holds all SQL columns for passwordreset fields.
+var Columns = []string{
+	FieldID,
+	FieldEmail,
+	FieldExpiry,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
@@ -0,0 +1,259 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
+)
+
+// PasswordResetCreate is the builder for creating a PasswordReset entity.
+type PasswordResetCreate struct {
+	config
+	mutation *PasswordResetMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (prc *PasswordResetCreate) SetEmail(s string) *PasswordResetCreate {
+	prc.mutation.SetEmail(s)
+	return prc
+}
+
+// SetExpiry sets the "expiry" field.
+func (prc *PasswordResetCreate) SetExpiry(t time.Time) *PasswordResetCreate {
+	prc.mutation.SetExpiry(t)
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PasswordResetCreate) SetID(s string) *PasswordResetCreate {
+	prc.mutation.SetID(s)
+	return prc
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (prc *PasswordResetCreate) Mutation() *PasswordResetMutation {
+	return prc.mutation
+}
+
+// Save creates the PasswordReset in the database.
+func (prc *PasswordResetCreate) Save(ctx context.Context) (*PasswordReset, error) {
+	var (
+		err  error
+		node *PasswordReset
+	)
+	if len(prc.hooks) == 0 {
+		if err = prc.check(); err != nil {
+			return nil, err
+		}
+		node, err = prc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasswordResetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = prc.check(); err != nil {
+				return nil, err
+			}
+			prc.mutation = mutation
+			if node, err = prc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(prc.hooks) - 1; i >= 0; i-- {
+			if prc.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = prc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, prc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PasswordResetCreate) SaveX(ctx context.Context) *PasswordReset {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PasswordResetCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PasswordResetCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PasswordResetCreate) check() error {
+	if _, ok := prc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`db: missing required field "PasswordReset.email"`)}
+	}
+	if v, ok := prc.mutation.Email(); ok {
+		if err := passwordreset.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`db: validator failed for field "PasswordReset.email": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "PasswordReset.expiry"`)}
+	}
+	if v, ok := prc.mutation.ID(); ok {
+		if err := passwordreset.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "PasswordReset.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (prc *PasswordResetCreate) sqlSave(ctx context.Context) (*PasswordReset, error) {
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PasswordReset.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (prc *PasswordResetCreate) createSpec() (*PasswordReset, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasswordReset{config: prc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: passwordreset.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: passwordreset.FieldID,
+			},
+		}
+	)
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := prc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passwordreset.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := prc.mutation.Expiry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passwordreset.FieldExpiry,
+		})
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// PasswordResetCreateBulk is the builder for creating many PasswordReset entities in bulk.
+type PasswordResetCreateBulk struct {
+	config
+	builders []*PasswordResetCreate
+}
+
+// Save creates the PasswordReset entities in the database.
+func (prcb *PasswordResetCreateBulk) Save(ctx context.Context) ([]*PasswordReset, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PasswordReset, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasswordResetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PasswordResetCreateBulk) SaveX(ctx context.Context) []*PasswordReset {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PasswordResetCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PasswordResetCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
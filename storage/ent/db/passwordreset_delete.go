@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// PasswordResetDelete is the builder for deleting a PasswordReset entity.
+type PasswordResetDelete struct {
+	config
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// Where appends a list predicates to the PasswordResetDelete builder.
+func (prd *PasswordResetDelete) Where(ps ...predicate.PasswordReset) *PasswordResetDelete {
+	prd.mutation.Where(ps...)
+	return prd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (prd *PasswordResetDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(prd.hooks) == 0 {
+		affected, err = prd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasswordResetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			prd.mutation = mutation
+			affected, err = prd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(prd.hooks) - 1; i >= 0; i-- {
+			if prd.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = prd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, prd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prd *PasswordResetDelete) ExecX(ctx context.Context) int {
+	n, err := prd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (prd *PasswordResetDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: passwordreset.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: passwordreset.FieldID,
+			},
+		},
+	}
+	if ps := prd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, prd.driver, _spec)
+}
+
+// PasswordResetDeleteOne is the builder for deleting a single PasswordReset entity.
+type PasswordResetDeleteOne struct {
+	prd *PasswordResetDelete
+}
+
+// Exec executes the deletion query.
+func (prdo *PasswordResetDeleteOne) Exec(ctx context.Context) error {
+	n, err := prdo.prd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{passwordreset.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prdo *PasswordResetDeleteOne) ExecX(ctx context.Context) {
+	prdo.prd.ExecX(ctx)
+}
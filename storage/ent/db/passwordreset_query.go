@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// PasswordResetQuery is the builder for querying PasswordReset entities.
+type PasswordResetQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PasswordReset
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PasswordResetQuery builder.
+func (prq *PasswordResetQuery) Where(ps ...predicate.PasswordReset) *PasswordResetQuery {
+	prq.predicates = append(prq.predicates, ps...)
+	return prq
+}
+
+// Limit adds a limit step to the query.
+func (prq *PasswordResetQuery) Limit(limit int) *PasswordResetQuery {
+	prq.limit = &limit
+	return prq
+}
+
+// Offset adds an offset step to the query.
+func (prq *PasswordResetQuery) Offset(offset int) *PasswordResetQuery {
+	prq.offset = &offset
+	return prq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (prq *PasswordResetQuery) Unique(unique bool) *PasswordResetQuery {
+	prq.unique = &unique
+	return prq
+}
+
+// Order adds an order step to the query.
+func (prq *PasswordResetQuery) Order(o ...OrderFunc) *PasswordResetQuery {
+	prq.order = append(prq.order, o...)
+	return prq
+}
+
+// First returns the first PasswordReset entity from the query.
+// Returns a *NotFoundError when no PasswordReset was found.
+func (prq *PasswordResetQuery) First(ctx context.Context) (*PasswordReset, error) {
+	nodes, err := prq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{passwordreset.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (prq *PasswordResetQuery) FirstX(ctx context.Context) *PasswordReset {
+	node, err := prq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PasswordReset ID from the query.
+// Returns a *NotFoundError when no PasswordReset ID was found.
+func (prq *PasswordResetQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = prq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{passwordreset.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (prq *PasswordResetQuery) FirstIDX(ctx context.Context) string {
+	id, err := prq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PasswordReset entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PasswordReset entity is found.
+// Returns a *NotFoundError when no PasswordReset entities are found.
+func (prq *PasswordResetQuery) Only(ctx context.Context) (*PasswordReset, error) {
+	nodes, err := prq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{passwordreset.Label}
+	default:
+		return nil, &NotSingularError{passwordreset.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (prq *PasswordResetQuery) OnlyX(ctx context.Context) *PasswordReset {
+	node, err := prq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PasswordReset ID in the query.
+// Returns a *NotSingularError when more than one PasswordReset ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (prq *PasswordResetQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = prq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = &NotSingularError{passwordreset.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (prq *PasswordResetQuery) OnlyIDX(ctx context.Context) string {
+	id, err := prq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PasswordResets.
+func (prq *PasswordResetQuery) All(ctx context.Context) ([]*PasswordReset, error) {
+	if err := prq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return prq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (prq *PasswordResetQuery) AllX(ctx context.Context) []*PasswordReset {
+	nodes, err := prq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PasswordReset IDs.
+func (prq *PasswordResetQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := prq.Select(passwordreset.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (prq *PasswordResetQuery) IDsX(ctx context.Context) []string {
+	ids, err := prq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (prq *PasswordResetQuery) Count(ctx context.Context) (int, error) {
+	if err := prq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return prq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (prq *PasswordResetQuery) CountX(ctx context.Context) int {
+	count, err := prq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (prq *PasswordResetQuery) Exist(ctx context.Context) (bool, error) {
+	if err := prq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return prq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (prq *PasswordResetQuery) ExistX(ctx context.Context) bool {
+	exist, err := prq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PasswordResetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (prq *PasswordResetQuery) Clone() *PasswordResetQuery {
+	if prq == nil {
+		return nil
+	}
+	return &PasswordResetQuery{
+		config:     prq.config,
+		limit:      prq.limit,
+		offset:     prq.offset,
+		order:      append([]OrderFunc{}, prq.order...),
+		predicates: append([]predicate.PasswordReset{}, prq.predicates...),
+		// clone intermediate query.
+		sql:    prq.sql.Clone(),
+		path:   prq.path,
+		unique: prq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PasswordReset.Query().
+//		GroupBy(passwordreset.FieldEmail).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+//
+func (prq *PasswordResetQuery) GroupBy(field string, fields ...string) *PasswordResetGroupBy {
+	group := &PasswordResetGroupBy{config: prq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return prq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//	}
+//
+//	client.PasswordReset.Query().
+//		Select(passwordreset.FieldEmail).
+//		Scan(ctx, &v)
+//
+func (prq *PasswordResetQuery) Select(fields ...string) *PasswordResetSelect {
+	prq.fields = append(prq.fields, fields...)
+	return &PasswordResetSelect{PasswordResetQuery: prq}
+}
+
+func (prq *PasswordResetQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range prq.fields {
+		if !passwordreset.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if prq.path != nil {
+		prev, err := prq.path(ctx)
+		if err != nil {
+			return err
+		}
+		prq.sql = prev
+	}
+	return nil
+}
+
+func (prq *PasswordResetQuery) sqlAll(ctx context.Context) ([]*PasswordReset, error) {
+	var (
+		nodes = []*PasswordReset{}
+		_spec = prq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &PasswordReset{config: prq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, prq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (prq *PasswordResetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := prq.querySpec()
+	_spec.Node.Columns = prq.fields
+	if len(prq.fields) > 0 {
+		_spec.Unique = prq.unique != nil && *prq.unique
+	}
+	return sqlgraph.CountNodes(ctx, prq.driver, _spec)
+}
+
+func (prq *PasswordResetQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := prq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (prq *PasswordResetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   passwordreset.Table,
+			Columns: passwordreset.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: passwordreset.FieldID,
+			},
+		},
+		From:   prq.sql,
+		Unique: true,
+	}
+	if unique := prq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := prq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordreset.FieldID)
+		for i := range fields {
+			if fields[i] != passwordreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := prq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := prq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := prq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := prq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (prq *PasswordResetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(prq.driver.Dialect())
+	t1 := builder.Table(passwordreset.Table)
+	columns := prq.fields
+	if len(columns) == 0 {
+		columns = passwordreset.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if prq.sql != nil {
+		selector = prq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if prq.unique != nil && *prq.unique {
+		selector.Distinct()
+	}
+	for _, p := range prq.predicates {
+		p(selector)
+	}
+	for _, p := range prq.order {
+		p(selector)
+	}
+	if offset := prq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := prq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PasswordResetGroupBy is the group-by builder for PasswordReset entities.
+type PasswordResetGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (prgb *PasswordResetGroupBy) Aggregate(fns ...AggregateFunc) *PasswordResetGroupBy {
+	prgb.fns = append(prgb.fns, fns...)
+	return prgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (prgb *PasswordResetGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := prgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	prgb.sql = query
+	return prgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := prgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PasswordResetGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(prgb.fields) > 1 {
+		return nil, errors.New("db: PasswordResetGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := prgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) StringsX(ctx context.Context) []string {
+	v, err := prgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PasswordResetGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = prgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = fmt.Errorf("db: PasswordResetGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) StringX(ctx context.Context) string {
+	v, err := prgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PasswordResetGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(prgb.fields) > 1 {
+		return nil, errors.New("db: PasswordResetGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := prgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) IntsX(ctx context.Context) []int {
+	v, err := prgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PasswordResetGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = prgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = fmt.Errorf("db: PasswordResetGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) IntX(ctx context.Context) int {
+	v, err := prgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PasswordResetGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(prgb.fields) > 1 {
+		return nil, errors.New("db: PasswordResetGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := prgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := prgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PasswordResetGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = prgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = fmt.Errorf("db: PasswordResetGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := prgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PasswordResetGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(prgb.fields) > 1 {
+		return nil, errors.New("db: PasswordResetGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := prgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := prgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (prgb *PasswordResetGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = prgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = fmt.Errorf("db: PasswordResetGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (prgb *PasswordResetGroupBy) BoolX(ctx context.Context) bool {
+	v, err := prgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (prgb *PasswordResetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range prgb.fields {
+		if !passwordreset.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := prgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (prgb *PasswordResetGroupBy) sqlQuery() *sql.Selector {
+	selector := prgb.sql.Select()
+	aggregation := make([]string, 0, len(prgb.fns))
+	for _, fn := range prgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(prgb.fields)+len(prgb.fns))
+		for _, f := range prgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(prgb.fields...)...)
+}
+
+// PasswordResetSelect is the builder for selecting fields of PasswordReset entities.
+type PasswordResetSelect struct {
+	*PasswordResetQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prs *PasswordResetSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := prs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	prs.sql = prs.PasswordResetQuery.sqlQuery(ctx)
+	return prs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (prs *PasswordResetSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := prs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (prs *PasswordResetSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(prs.fields) > 1 {
+		return nil, errors.New("db: PasswordResetSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := prs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (prs *PasswordResetSelect) StringsX(ctx context.Context) []string {
+	v, err := prs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (prs *PasswordResetSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = prs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = fmt.Errorf("db: PasswordResetSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (prs *PasswordResetSelect) StringX(ctx context.Context) string {
+	v, err := prs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (prs *PasswordResetSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(prs.fields) > 1 {
+		return nil, errors.New("db: PasswordResetSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := prs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (prs *PasswordResetSelect) IntsX(ctx context.Context) []int {
+	v, err := prs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (prs *PasswordResetSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = prs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = fmt.Errorf("db: PasswordResetSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (prs *PasswordResetSelect) IntX(ctx context.Context) int {
+	v, err := prs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (prs *PasswordResetSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(prs.fields) > 1 {
+		return nil, errors.New("db: PasswordResetSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := prs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (prs *PasswordResetSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := prs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (prs *PasswordResetSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = prs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = fmt.Errorf("db: PasswordResetSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (prs *PasswordResetSelect) Float64X(ctx context.Context) float64 {
+	v, err := prs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (prs *PasswordResetSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(prs.fields) > 1 {
+		return nil, errors.New("db: PasswordResetSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := prs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (prs *PasswordResetSelect) BoolsX(ctx context.Context) []bool {
+	v, err := prs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (prs *PasswordResetSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = prs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{passwordreset.Label}
+	default:
+		err = fmt.Errorf("db: PasswordResetSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (prs *PasswordResetSelect) BoolX(ctx context.Context) bool {
+	v, err := prs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (prs *PasswordResetSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := prs.sql.Query()
+	if err := prs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
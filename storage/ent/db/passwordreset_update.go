@@ -0,0 +1,324 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// PasswordResetUpdate is the builder for updating PasswordReset entities.
+type PasswordResetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// Where appends a list predicates to the PasswordResetUpdate builder.
+func (pru *PasswordResetUpdate) Where(ps ...predicate.PasswordReset) *PasswordResetUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetEmail sets the "email" field.
+func (pru *PasswordResetUpdate) SetEmail(s string) *PasswordResetUpdate {
+	pru.mutation.SetEmail(s)
+	return pru
+}
+
+// SetExpiry sets the "expiry" field.
+func (pru *PasswordResetUpdate) SetExpiry(t time.Time) *PasswordResetUpdate {
+	pru.mutation.SetExpiry(t)
+	return pru
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (pru *PasswordResetUpdate) Mutation() *PasswordResetMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PasswordResetUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pru.hooks) == 0 {
+		if err = pru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasswordResetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pru.check(); err != nil {
+				return 0, err
+			}
+			pru.mutation = mutation
+			affected, err = pru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pru.hooks) - 1; i >= 0; i-- {
+			if pru.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = pru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PasswordResetUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PasswordResetUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PasswordResetUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PasswordResetUpdate) check() error {
+	if v, ok := pru.mutation.Email(); ok {
+		if err := passwordreset.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`db: validator failed for field "PasswordReset.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pru *PasswordResetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   passwordreset.Table,
+			Columns: passwordreset.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: passwordreset.FieldID,
+			},
+		},
+	}
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passwordreset.FieldEmail,
+		})
+	}
+	if value, ok := pru.mutation.Expiry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passwordreset.FieldExpiry,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PasswordResetUpdateOne is the builder for updating a single PasswordReset entity.
+type PasswordResetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// SetEmail sets the "email" field.
+func (pruo *PasswordResetUpdateOne) SetEmail(s string) *PasswordResetUpdateOne {
+	pruo.mutation.SetEmail(s)
+	return pruo
+}
+
+// SetExpiry sets the "expiry" field.
+func (pruo *PasswordResetUpdateOne) SetExpiry(t time.Time) *PasswordResetUpdateOne {
+	pruo.mutation.SetExpiry(t)
+	return pruo
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (pruo *PasswordResetUpdateOne) Mutation() *PasswordResetMutation {
+	return pruo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PasswordResetUpdateOne) Select(field string, fields ...string) *PasswordResetUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PasswordReset entity.
+func (pruo *PasswordResetUpdateOne) Save(ctx context.Context) (*PasswordReset, error) {
+	var (
+		err  error
+		node *PasswordReset
+	)
+	if len(pruo.hooks) == 0 {
+		if err = pruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = pruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasswordResetMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pruo.check(); err != nil {
+				return nil, err
+			}
+			pruo.mutation = mutation
+			node, err = pruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pruo.hooks) - 1; i >= 0; i-- {
+			if pruo.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = pruo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pruo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PasswordResetUpdateOne) SaveX(ctx context.Context) *PasswordReset {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PasswordResetUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PasswordResetUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PasswordResetUpdateOne) check() error {
+	if v, ok := pruo.mutation.Email(); ok {
+		if err := passwordreset.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`db: validator failed for field "PasswordReset.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pruo *PasswordResetUpdateOne) sqlSave(ctx context.Context) (_node *PasswordReset, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   passwordreset.Table,
+			Columns: passwordreset.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: passwordreset.FieldID,
+			},
+		},
+	}
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "PasswordReset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordreset.FieldID)
+		for _, f := range fields {
+			if !passwordreset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != passwordreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passwordreset.FieldEmail,
+		})
+	}
+	if value, ok := pruo.mutation.Expiry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passwordreset.FieldExpiry,
+		})
+	}
+	_node = &PasswordReset{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
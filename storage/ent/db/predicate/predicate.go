@@ -12,6 +12,9 @@ type AuthCode func(*sql.Selector)
 // AuthRequest is the predicate function for authrequest builders.
 type AuthRequest func(*sql.Selector)
 
+// BackchannelAuthRequest is the predicate function for backchannelauthrequest builders.
+type BackchannelAuthRequest func(*sql.Selector)
+
 // Connector is the predicate function for connector builders.
 type Connector func(*sql.Selector)
 
@@ -21,9 +24,15 @@ type DeviceRequest func(*sql.Selector)
 // DeviceToken is the predicate function for devicetoken builders.
 type DeviceToken func(*sql.Selector)
 
+// EmailVerification is the predicate function for emailverification builders.
+type EmailVerification func(*sql.Selector)
+
 // Keys is the predicate function for keys builders.
 type Keys func(*sql.Selector)
 
+// LoginAttempts is the predicate function for loginattempts builders.
+type LoginAttempts func(*sql.Selector)
+
 // OAuth2Client is the predicate function for oauth2client builders.
 type OAuth2Client func(*sql.Selector)
 
@@ -33,5 +42,23 @@ type OfflineSession func(*sql.Selector)
 // Password is the predicate function for password builders.
 type Password func(*sql.Selector)
 
+// PasswordReset is the predicate function for passwordreset builders.
+type PasswordReset func(*sql.Selector)
+
 // RefreshToken is the predicate function for refreshtoken builders.
 type RefreshToken func(*sql.Selector)
+
+// SAMLAssertion is the predicate function for samlassertion builders.
+type SAMLAssertion func(*sql.Selector)
+
+// SAMLServiceProvider is the predicate function for samlserviceprovider builders.
+type SAMLServiceProvider func(*sql.Selector)
+
+// TOTPEnrollment is the predicate function for totpenrollment builders.
+type TOTPEnrollment func(*sql.Selector)
+
+// UserConsent is the predicate function for userconsent builders.
+type UserConsent func(*sql.Selector)
+
+// WebAuthnCredential is the predicate function for webauthncredential builders.
+type WebAuthnCredential func(*sql.Selector)
@@ -35,6 +35,8 @@ type RefreshToken struct {
 	ClaimsGroups []string `json:"claims_groups,omitempty"`
 	// ClaimsPreferredUsername holds the value of the "claims_preferred_username" field.
 	ClaimsPreferredUsername string `json:"claims_preferred_username,omitempty"`
+	// ClaimsAcr holds the value of the "claims_acr" field.
+	ClaimsAcr string `json:"claims_acr,omitempty"`
 	// ConnectorID holds the value of the "connector_id" field.
 	ConnectorID string `json:"connector_id,omitempty"`
 	// ConnectorData holds the value of the "connector_data" field.
@@ -43,6 +45,8 @@ type RefreshToken struct {
 	Token string `json:"token,omitempty"`
 	// ObsoleteToken holds the value of the "obsolete_token" field.
 	ObsoleteToken string `json:"obsolete_token,omitempty"`
+	// RetiredTokens holds the value of the "retired_tokens" field.
+	RetiredTokens []string `json:"retired_tokens,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// LastUsed holds the value of the "last_used" field.
@@ -54,11 +58,11 @@ func (*RefreshToken) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case refreshtoken.FieldScopes, refreshtoken.FieldClaimsGroups, refreshtoken.FieldConnectorData:
+		case refreshtoken.FieldScopes, refreshtoken.FieldClaimsGroups, refreshtoken.FieldConnectorData, refreshtoken.FieldRetiredTokens:
 			values[i] = new([]byte)
 		case refreshtoken.FieldClaimsEmailVerified:
 			values[i] = new(sql.NullBool)
-		case refreshtoken.FieldID, refreshtoken.FieldClientID, refreshtoken.FieldNonce, refreshtoken.FieldClaimsUserID, refreshtoken.FieldClaimsUsername, refreshtoken.FieldClaimsEmail, refreshtoken.FieldClaimsPreferredUsername, refreshtoken.FieldConnectorID, refreshtoken.FieldToken, refreshtoken.FieldObsoleteToken:
+		case refreshtoken.FieldID, refreshtoken.FieldClientID, refreshtoken.FieldNonce, refreshtoken.FieldClaimsUserID, refreshtoken.FieldClaimsUsername, refreshtoken.FieldClaimsEmail, refreshtoken.FieldClaimsPreferredUsername, refreshtoken.FieldClaimsAcr, refreshtoken.FieldConnectorID, refreshtoken.FieldToken, refreshtoken.FieldObsoleteToken:
 			values[i] = new(sql.NullString)
 		case refreshtoken.FieldCreatedAt, refreshtoken.FieldLastUsed:
 			values[i] = new(sql.NullTime)
@@ -141,6 +145,12 @@ func (rt *RefreshToken) assignValues(columns []string, values []interface{}) err
 			} else if value.Valid {
 				rt.ClaimsPreferredUsername = value.String
 			}
+		case refreshtoken.FieldClaimsAcr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claims_acr", values[i])
+			} else if value.Valid {
+				rt.ClaimsAcr = value.String
+			}
 		case refreshtoken.FieldConnectorID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field connector_id", values[i])
@@ -165,6 +175,14 @@ func (rt *RefreshToken) assignValues(columns []string, values []interface{}) err
 			} else if value.Valid {
 				rt.ObsoleteToken = value.String
 			}
+		case refreshtoken.FieldRetiredTokens:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retired_tokens", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rt.RetiredTokens); err != nil {
+					return fmt.Errorf("unmarshal field retired_tokens: %w", err)
+				}
+			}
 		case refreshtoken.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -223,6 +241,8 @@ func (rt *RefreshToken) String() string {
 	builder.WriteString(fmt.Sprintf("%v", rt.ClaimsGroups))
 	builder.WriteString(", claims_preferred_username=")
 	builder.WriteString(rt.ClaimsPreferredUsername)
+	builder.WriteString(", claims_acr=")
+	builder.WriteString(rt.ClaimsAcr)
 	builder.WriteString(", connector_id=")
 	builder.WriteString(rt.ConnectorID)
 	if v := rt.ConnectorData; v != nil {
@@ -233,6 +253,8 @@ func (rt *RefreshToken) String() string {
 	builder.WriteString(rt.Token)
 	builder.WriteString(", obsolete_token=")
 	builder.WriteString(rt.ObsoleteToken)
+	builder.WriteString(", retired_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", rt.RetiredTokens))
 	builder.WriteString(", created_at=")
 	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", last_used=")
@@ -29,6 +29,8 @@ const (
 	FieldClaimsGroups = "claims_groups"
 	// FieldClaimsPreferredUsername holds the string denoting the claims_preferred_username field in the database.
 	FieldClaimsPreferredUsername = "claims_preferred_username"
+	// FieldClaimsAcr holds the string denoting the claims_acr field in the database.
+	FieldClaimsAcr = "claims_acr"
 	// FieldConnectorID holds the string denoting the connector_id field in the database.
 	FieldConnectorID = "connector_id"
 	// FieldConnectorData holds the string denoting the connector_data field in the database.
@@ -37,6 +39,8 @@ const (
 	FieldToken = "token"
 	// FieldObsoleteToken holds the string denoting the obsolete_token field in the database.
 	FieldObsoleteToken = "obsolete_token"
+	// FieldRetiredTokens holds the string denoting the retired_tokens field in the database.
+	FieldRetiredTokens = "retired_tokens"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldLastUsed holds the string denoting the last_used field in the database.
@@ -57,10 +61,12 @@ var Columns = []string{
 	FieldClaimsEmailVerified,
 	FieldClaimsGroups,
 	FieldClaimsPreferredUsername,
+	FieldClaimsAcr,
 	FieldConnectorID,
 	FieldConnectorData,
 	FieldToken,
 	FieldObsoleteToken,
+	FieldRetiredTokens,
 	FieldCreatedAt,
 	FieldLastUsed,
 }
@@ -88,6 +94,8 @@ var (
 	ClaimsEmailValidator func(string) error
 	// DefaultClaimsPreferredUsername holds the default value on creation for the "claims_preferred_username" field.
 	DefaultClaimsPreferredUsername string
+	// DefaultClaimsAcr holds the default value on creation for the "claims_acr" field.
+	DefaultClaimsAcr string
 	// ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
 	ConnectorIDValidator func(string) error
 	// DefaultToken holds the default value on creation for the "token" field.
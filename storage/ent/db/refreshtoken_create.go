@@ -82,6 +82,20 @@ func (rtc *RefreshTokenCreate) SetNillableClaimsPreferredUsername(s *string) *Re
 	return rtc
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (rtc *RefreshTokenCreate) SetClaimsAcr(s string) *RefreshTokenCreate {
+	rtc.mutation.SetClaimsAcr(s)
+	return rtc
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableClaimsAcr(s *string) *RefreshTokenCreate {
+	if s != nil {
+		rtc.SetClaimsAcr(*s)
+	}
+	return rtc
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (rtc *RefreshTokenCreate) SetConnectorID(s string) *RefreshTokenCreate {
 	rtc.mutation.SetConnectorID(s)
@@ -122,6 +136,12 @@ func (rtc *RefreshTokenCreate) SetNillableObsoleteToken(s *string) *RefreshToken
 	return rtc
 }
 
+// SetRetiredTokens sets the "retired_tokens" field.
+func (rtc *RefreshTokenCreate) SetRetiredTokens(s []string) *RefreshTokenCreate {
+	rtc.mutation.SetRetiredTokens(s)
+	return rtc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rtc *RefreshTokenCreate) SetCreatedAt(t time.Time) *RefreshTokenCreate {
 	rtc.mutation.SetCreatedAt(t)
@@ -231,6 +251,10 @@ func (rtc *RefreshTokenCreate) defaults() {
 		v := refreshtoken.DefaultClaimsPreferredUsername
 		rtc.mutation.SetClaimsPreferredUsername(v)
 	}
+	if _, ok := rtc.mutation.ClaimsAcr(); !ok {
+		v := refreshtoken.DefaultClaimsAcr
+		rtc.mutation.SetClaimsAcr(v)
+	}
 	if _, ok := rtc.mutation.Token(); !ok {
 		v := refreshtoken.DefaultToken
 		rtc.mutation.SetToken(v)
@@ -297,6 +321,9 @@ func (rtc *RefreshTokenCreate) check() error {
 	if _, ok := rtc.mutation.ClaimsPreferredUsername(); !ok {
 		return &ValidationError{Name: "claims_preferred_username", err: errors.New(`db: missing required field "RefreshToken.claims_preferred_username"`)}
 	}
+	if _, ok := rtc.mutation.ClaimsAcr(); !ok {
+		return &ValidationError{Name: "claims_acr", err: errors.New(`db: missing required field "RefreshToken.claims_acr"`)}
+	}
 	if _, ok := rtc.mutation.ConnectorID(); !ok {
 		return &ValidationError{Name: "connector_id", err: errors.New(`db: missing required field "RefreshToken.connector_id"`)}
 	}
@@ -430,6 +457,14 @@ func (rtc *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec
 		})
 		_node.ClaimsPreferredUsername = value
 	}
+	if value, ok := rtc.mutation.ClaimsAcr(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refreshtoken.FieldClaimsAcr,
+		})
+		_node.ClaimsAcr = value
+	}
 	if value, ok := rtc.mutation.ConnectorID(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -462,6 +497,14 @@ func (rtc *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec
 		})
 		_node.ObsoleteToken = value
 	}
+	if value, ok := rtc.mutation.RetiredTokens(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: refreshtoken.FieldRetiredTokens,
+		})
+		_node.RetiredTokens = value
+	}
 	if value, ok := rtc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
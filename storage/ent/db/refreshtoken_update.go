@@ -102,6 +102,20 @@ func (rtu *RefreshTokenUpdate) SetNillableClaimsPreferredUsername(s *string) *Re
 	return rtu
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (rtu *RefreshTokenUpdate) SetClaimsAcr(s string) *RefreshTokenUpdate {
+	rtu.mutation.SetClaimsAcr(s)
+	return rtu
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableClaimsAcr(s *string) *RefreshTokenUpdate {
+	if s != nil {
+		rtu.SetClaimsAcr(*s)
+	}
+	return rtu
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (rtu *RefreshTokenUpdate) SetConnectorID(s string) *RefreshTokenUpdate {
 	rtu.mutation.SetConnectorID(s)
@@ -148,6 +162,18 @@ func (rtu *RefreshTokenUpdate) SetNillableObsoleteToken(s *string) *RefreshToken
 	return rtu
 }
 
+// SetRetiredTokens sets the "retired_tokens" field.
+func (rtu *RefreshTokenUpdate) SetRetiredTokens(s []string) *RefreshTokenUpdate {
+	rtu.mutation.SetRetiredTokens(s)
+	return rtu
+}
+
+// ClearRetiredTokens clears the value of the "retired_tokens" field.
+func (rtu *RefreshTokenUpdate) ClearRetiredTokens() *RefreshTokenUpdate {
+	rtu.mutation.ClearRetiredTokens()
+	return rtu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rtu *RefreshTokenUpdate) SetCreatedAt(t time.Time) *RefreshTokenUpdate {
 	rtu.mutation.SetCreatedAt(t)
@@ -369,6 +395,13 @@ func (rtu *RefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: refreshtoken.FieldClaimsPreferredUsername,
 		})
 	}
+	if value, ok := rtu.mutation.ClaimsAcr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refreshtoken.FieldClaimsAcr,
+		})
+	}
 	if value, ok := rtu.mutation.ConnectorID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -403,6 +436,19 @@ func (rtu *RefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: refreshtoken.FieldObsoleteToken,
 		})
 	}
+	if value, ok := rtu.mutation.RetiredTokens(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: refreshtoken.FieldRetiredTokens,
+		})
+	}
+	if rtu.mutation.RetiredTokensCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: refreshtoken.FieldRetiredTokens,
+		})
+	}
 	if value, ok := rtu.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -510,6 +556,20 @@ func (rtuo *RefreshTokenUpdateOne) SetNillableClaimsPreferredUsername(s *string)
 	return rtuo
 }
 
+// SetClaimsAcr sets the "claims_acr" field.
+func (rtuo *RefreshTokenUpdateOne) SetClaimsAcr(s string) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetClaimsAcr(s)
+	return rtuo
+}
+
+// SetNillableClaimsAcr sets the "claims_acr" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableClaimsAcr(s *string) *RefreshTokenUpdateOne {
+	if s != nil {
+		rtuo.SetClaimsAcr(*s)
+	}
+	return rtuo
+}
+
 // SetConnectorID sets the "connector_id" field.
 func (rtuo *RefreshTokenUpdateOne) SetConnectorID(s string) *RefreshTokenUpdateOne {
 	rtuo.mutation.SetConnectorID(s)
@@ -556,6 +616,18 @@ func (rtuo *RefreshTokenUpdateOne) SetNillableObsoleteToken(s *string) *RefreshT
 	return rtuo
 }
 
+// SetRetiredTokens sets the "retired_tokens" field.
+func (rtuo *RefreshTokenUpdateOne) SetRetiredTokens(s []string) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetRetiredTokens(s)
+	return rtuo
+}
+
+// ClearRetiredTokens clears the value of the "retired_tokens" field.
+func (rtuo *RefreshTokenUpdateOne) ClearRetiredTokens() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearRetiredTokens()
+	return rtuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rtuo *RefreshTokenUpdateOne) SetCreatedAt(t time.Time) *RefreshTokenUpdateOne {
 	rtuo.mutation.SetCreatedAt(t)
@@ -801,6 +873,13 @@ func (rtuo *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshT
 			Column: refreshtoken.FieldClaimsPreferredUsername,
 		})
 	}
+	if value, ok := rtuo.mutation.ClaimsAcr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: refreshtoken.FieldClaimsAcr,
+		})
+	}
 	if value, ok := rtuo.mutation.ConnectorID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -835,6 +914,19 @@ func (rtuo *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshT
 			Column: refreshtoken.FieldObsoleteToken,
 		})
 	}
+	if value, ok := rtuo.mutation.RetiredTokens(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: refreshtoken.FieldRetiredTokens,
+		})
+	}
+	if rtuo.mutation.RetiredTokensCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: refreshtoken.FieldRetiredTokens,
+		})
+	}
 	if value, ok := rtuo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -7,14 +7,23 @@ import (
 
 	"github.com/dexidp/dex/storage/ent/db/authcode"
 	"github.com/dexidp/dex/storage/ent/db/authrequest"
+	"github.com/dexidp/dex/storage/ent/db/backchannelauthrequest"
 	"github.com/dexidp/dex/storage/ent/db/connector"
 	"github.com/dexidp/dex/storage/ent/db/devicerequest"
 	"github.com/dexidp/dex/storage/ent/db/devicetoken"
+	"github.com/dexidp/dex/storage/ent/db/emailverification"
 	"github.com/dexidp/dex/storage/ent/db/keys"
+	"github.com/dexidp/dex/storage/ent/db/loginattempts"
 	"github.com/dexidp/dex/storage/ent/db/oauth2client"
 	"github.com/dexidp/dex/storage/ent/db/offlinesession"
 	"github.com/dexidp/dex/storage/ent/db/password"
+	"github.com/dexidp/dex/storage/ent/db/passwordreset"
 	"github.com/dexidp/dex/storage/ent/db/refreshtoken"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
 	"github.com/dexidp/dex/storage/ent/schema"
 )
 
@@ -52,16 +61,20 @@ func init() {
 	authcodeDescClaimsPreferredUsername := authcodeFields[10].Descriptor()
 	// authcode.DefaultClaimsPreferredUsername holds the default value on creation for the claims_preferred_username field.
 	authcode.DefaultClaimsPreferredUsername = authcodeDescClaimsPreferredUsername.Default.(string)
+	// authcodeDescClaimsAcr is the schema descriptor for claims_acr field.
+	authcodeDescClaimsAcr := authcodeFields[11].Descriptor()
+	// authcode.DefaultClaimsAcr holds the default value on creation for the claims_acr field.
+	authcode.DefaultClaimsAcr = authcodeDescClaimsAcr.Default.(string)
 	// authcodeDescConnectorID is the schema descriptor for connector_id field.
-	authcodeDescConnectorID := authcodeFields[11].Descriptor()
+	authcodeDescConnectorID := authcodeFields[12].Descriptor()
 	// authcode.ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
 	authcode.ConnectorIDValidator = authcodeDescConnectorID.Validators[0].(func(string) error)
 	// authcodeDescCodeChallenge is the schema descriptor for code_challenge field.
-	authcodeDescCodeChallenge := authcodeFields[14].Descriptor()
+	authcodeDescCodeChallenge := authcodeFields[15].Descriptor()
 	// authcode.DefaultCodeChallenge holds the default value on creation for the code_challenge field.
 	authcode.DefaultCodeChallenge = authcodeDescCodeChallenge.Default.(string)
 	// authcodeDescCodeChallengeMethod is the schema descriptor for code_challenge_method field.
-	authcodeDescCodeChallengeMethod := authcodeFields[15].Descriptor()
+	authcodeDescCodeChallengeMethod := authcodeFields[16].Descriptor()
 	// authcode.DefaultCodeChallengeMethod holds the default value on creation for the code_challenge_method field.
 	authcode.DefaultCodeChallengeMethod = authcodeDescCodeChallengeMethod.Default.(string)
 	// authcodeDescID is the schema descriptor for id field.
@@ -74,18 +87,52 @@ func init() {
 	authrequestDescClaimsPreferredUsername := authrequestFields[14].Descriptor()
 	// authrequest.DefaultClaimsPreferredUsername holds the default value on creation for the claims_preferred_username field.
 	authrequest.DefaultClaimsPreferredUsername = authrequestDescClaimsPreferredUsername.Default.(string)
+	// authrequestDescClaimsAcr is the schema descriptor for claims_acr field.
+	authrequestDescClaimsAcr := authrequestFields[15].Descriptor()
+	// authrequest.DefaultClaimsAcr holds the default value on creation for the claims_acr field.
+	authrequest.DefaultClaimsAcr = authrequestDescClaimsAcr.Default.(string)
 	// authrequestDescCodeChallenge is the schema descriptor for code_challenge field.
-	authrequestDescCodeChallenge := authrequestFields[18].Descriptor()
+	authrequestDescCodeChallenge := authrequestFields[19].Descriptor()
 	// authrequest.DefaultCodeChallenge holds the default value on creation for the code_challenge field.
 	authrequest.DefaultCodeChallenge = authrequestDescCodeChallenge.Default.(string)
 	// authrequestDescCodeChallengeMethod is the schema descriptor for code_challenge_method field.
-	authrequestDescCodeChallengeMethod := authrequestFields[19].Descriptor()
+	authrequestDescCodeChallengeMethod := authrequestFields[20].Descriptor()
 	// authrequest.DefaultCodeChallengeMethod holds the default value on creation for the code_challenge_method field.
 	authrequest.DefaultCodeChallengeMethod = authrequestDescCodeChallengeMethod.Default.(string)
+	// authrequestDescResponseMode is the schema descriptor for response_mode field.
+	authrequestDescResponseMode := authrequestFields[21].Descriptor()
+	// authrequest.DefaultResponseMode holds the default value on creation for the response_mode field.
+	authrequest.DefaultResponseMode = authrequestDescResponseMode.Default.(string)
+	// authrequestDescMfaValidated is the schema descriptor for mfa_validated field.
+	authrequestDescMfaValidated := authrequestFields[24].Descriptor()
+	// authrequest.DefaultMfaValidated holds the default value on creation for the mfa_validated field.
+	authrequest.DefaultMfaValidated = authrequestDescMfaValidated.Default.(bool)
+	// authrequestDescWebauthnChallenge is the schema descriptor for webauthn_challenge field.
+	authrequestDescWebauthnChallenge := authrequestFields[25].Descriptor()
+	// authrequest.DefaultWebauthnChallenge holds the default value on creation for the webauthn_challenge field.
+	authrequest.DefaultWebauthnChallenge = authrequestDescWebauthnChallenge.Default.(string)
 	// authrequestDescID is the schema descriptor for id field.
 	authrequestDescID := authrequestFields[0].Descriptor()
 	// authrequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	authrequest.IDValidator = authrequestDescID.Validators[0].(func(string) error)
+	backchannelauthrequestFields := schema.BackchannelAuthRequest{}.Fields()
+	_ = backchannelauthrequestFields
+	// backchannelauthrequestDescClientID is the schema descriptor for client_id field.
+	backchannelauthrequestDescClientID := backchannelauthrequestFields[1].Descriptor()
+	// backchannelauthrequest.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	backchannelauthrequest.ClientIDValidator = backchannelauthrequestDescClientID.Validators[0].(func(string) error)
+	// backchannelauthrequestDescBindingMessage is the schema descriptor for binding_message field.
+	backchannelauthrequestDescBindingMessage := backchannelauthrequestFields[4].Descriptor()
+	// backchannelauthrequest.DefaultBindingMessage holds the default value on creation for the binding_message field.
+	backchannelauthrequest.DefaultBindingMessage = backchannelauthrequestDescBindingMessage.Default.(string)
+	// backchannelauthrequestDescClientNotificationToken is the schema descriptor for client_notification_token field.
+	backchannelauthrequestDescClientNotificationToken := backchannelauthrequestFields[5].Descriptor()
+	// backchannelauthrequest.DefaultClientNotificationToken holds the default value on creation for the client_notification_token field.
+	backchannelauthrequest.DefaultClientNotificationToken = backchannelauthrequestDescClientNotificationToken.Default.(string)
+	// backchannelauthrequestDescID is the schema descriptor for id field.
+	backchannelauthrequestDescID := backchannelauthrequestFields[0].Descriptor()
+	// backchannelauthrequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	backchannelauthrequest.IDValidator = backchannelauthrequestDescID.Validators[0].(func(string) error)
 	connectorFields := schema.Connector{}.Fields()
 	_ = connectorFields
 	// connectorDescType is the schema descriptor for type field.
@@ -142,12 +189,28 @@ func init() {
 	devicetokenDescStatus := devicetokenFields[1].Descriptor()
 	// devicetoken.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	devicetoken.StatusValidator = devicetokenDescStatus.Validators[0].(func(string) error)
+	emailverificationFields := schema.EmailVerification{}.Fields()
+	_ = emailverificationFields
+	// emailverificationDescEmail is the schema descriptor for email field.
+	emailverificationDescEmail := emailverificationFields[1].Descriptor()
+	// emailverification.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailverification.EmailValidator = emailverificationDescEmail.Validators[0].(func(string) error)
+	// emailverificationDescID is the schema descriptor for id field.
+	emailverificationDescID := emailverificationFields[0].Descriptor()
+	// emailverification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	emailverification.IDValidator = emailverificationDescID.Validators[0].(func(string) error)
 	keysFields := schema.Keys{}.Fields()
 	_ = keysFields
 	// keysDescID is the schema descriptor for id field.
 	keysDescID := keysFields[0].Descriptor()
 	// keys.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	keys.IDValidator = keysDescID.Validators[0].(func(string) error)
+	loginattemptsFields := schema.LoginAttempts{}.Fields()
+	_ = loginattemptsFields
+	// loginattemptsDescID is the schema descriptor for id field.
+	loginattemptsDescID := loginattemptsFields[0].Descriptor()
+	// loginattempts.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	loginattempts.IDValidator = loginattemptsDescID.Validators[0].(func(string) error)
 	oauth2clientFields := schema.OAuth2Client{}.Fields()
 	_ = oauth2clientFields
 	// oauth2clientDescSecret is the schema descriptor for secret field.
@@ -162,6 +225,42 @@ func init() {
 	oauth2clientDescLogoURL := oauth2clientFields[6].Descriptor()
 	// oauth2client.LogoURLValidator is a validator for the "logo_url" field. It is called by the builders before save.
 	oauth2client.LogoURLValidator = oauth2clientDescLogoURL.Validators[0].(func(string) error)
+	// oauth2clientDescBackchannelNotificationEndpoint is the schema descriptor for backchannel_notification_endpoint field.
+	oauth2clientDescBackchannelNotificationEndpoint := oauth2clientFields[7].Descriptor()
+	// oauth2client.DefaultBackchannelNotificationEndpoint holds the default value on creation for the backchannel_notification_endpoint field.
+	oauth2client.DefaultBackchannelNotificationEndpoint = oauth2clientDescBackchannelNotificationEndpoint.Default.(string)
+	// oauth2clientDescIDTokenEncryptedResponseAlg is the schema descriptor for id_token_encrypted_response_alg field.
+	oauth2clientDescIDTokenEncryptedResponseAlg := oauth2clientFields[8].Descriptor()
+	// oauth2client.DefaultIDTokenEncryptedResponseAlg holds the default value on creation for the id_token_encrypted_response_alg field.
+	oauth2client.DefaultIDTokenEncryptedResponseAlg = oauth2clientDescIDTokenEncryptedResponseAlg.Default.(string)
+	// oauth2clientDescIDTokenEncryptedResponseEnc is the schema descriptor for id_token_encrypted_response_enc field.
+	oauth2clientDescIDTokenEncryptedResponseEnc := oauth2clientFields[9].Descriptor()
+	// oauth2client.DefaultIDTokenEncryptedResponseEnc holds the default value on creation for the id_token_encrypted_response_enc field.
+	oauth2client.DefaultIDTokenEncryptedResponseEnc = oauth2clientDescIDTokenEncryptedResponseEnc.Default.(string)
+	// oauth2clientDescUserinfoSignedResponseAlg is the schema descriptor for userinfo_signed_response_alg field.
+	oauth2clientDescUserinfoSignedResponseAlg := oauth2clientFields[10].Descriptor()
+	// oauth2client.DefaultUserinfoSignedResponseAlg holds the default value on creation for the userinfo_signed_response_alg field.
+	oauth2client.DefaultUserinfoSignedResponseAlg = oauth2clientDescUserinfoSignedResponseAlg.Default.(string)
+	// oauth2clientDescUserinfoEncryptedResponseAlg is the schema descriptor for userinfo_encrypted_response_alg field.
+	oauth2clientDescUserinfoEncryptedResponseAlg := oauth2clientFields[11].Descriptor()
+	// oauth2client.DefaultUserinfoEncryptedResponseAlg holds the default value on creation for the userinfo_encrypted_response_alg field.
+	oauth2client.DefaultUserinfoEncryptedResponseAlg = oauth2clientDescUserinfoEncryptedResponseAlg.Default.(string)
+	// oauth2clientDescUserinfoEncryptedResponseEnc is the schema descriptor for userinfo_encrypted_response_enc field.
+	oauth2clientDescUserinfoEncryptedResponseEnc := oauth2clientFields[12].Descriptor()
+	// oauth2client.DefaultUserinfoEncryptedResponseEnc holds the default value on creation for the userinfo_encrypted_response_enc field.
+	oauth2client.DefaultUserinfoEncryptedResponseEnc = oauth2clientDescUserinfoEncryptedResponseEnc.Default.(string)
+	// oauth2clientDescSubjectType is the schema descriptor for subject_type field.
+	oauth2clientDescSubjectType := oauth2clientFields[14].Descriptor()
+	// oauth2client.DefaultSubjectType holds the default value on creation for the subject_type field.
+	oauth2client.DefaultSubjectType = oauth2clientDescSubjectType.Default.(string)
+	// oauth2clientDescSectorIdentifier is the schema descriptor for sector_identifier field.
+	oauth2clientDescSectorIdentifier := oauth2clientFields[15].Descriptor()
+	// oauth2client.DefaultSectorIdentifier holds the default value on creation for the sector_identifier field.
+	oauth2client.DefaultSectorIdentifier = oauth2clientDescSectorIdentifier.Default.(string)
+	// oauth2clientDescBackchannelLogoutURI is the schema descriptor for backchannel_logout_uri field.
+	oauth2clientDescBackchannelLogoutURI := oauth2clientFields[16].Descriptor()
+	// oauth2client.DefaultBackchannelLogoutURI holds the default value on creation for the backchannel_logout_uri field.
+	oauth2client.DefaultBackchannelLogoutURI = oauth2clientDescBackchannelLogoutURI.Default.(string)
 	// oauth2clientDescID is the schema descriptor for id field.
 	oauth2clientDescID := oauth2clientFields[0].Descriptor()
 	// oauth2client.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -201,13 +300,35 @@ func init() {
 	// password.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	password.EmailValidator = passwordDescEmail.Validators[0].(func(string) error)
 	// passwordDescUsername is the schema descriptor for username field.
-	passwordDescUsername := passwordFields[2].Descriptor()
+	passwordDescUsername := passwordFields[3].Descriptor()
 	// password.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	password.UsernameValidator = passwordDescUsername.Validators[0].(func(string) error)
 	// passwordDescUserID is the schema descriptor for user_id field.
-	passwordDescUserID := passwordFields[3].Descriptor()
+	passwordDescUserID := passwordFields[4].Descriptor()
 	// password.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	password.UserIDValidator = passwordDescUserID.Validators[0].(func(string) error)
+	// passwordDescPendingVerification is the schema descriptor for pending_verification field.
+	passwordDescPendingVerification := passwordFields[5].Descriptor()
+	// password.DefaultPendingVerification holds the default value on creation for the pending_verification field.
+	password.DefaultPendingVerification = passwordDescPendingVerification.Default.(bool)
+	// passwordDescPendingApproval is the schema descriptor for pending_approval field.
+	passwordDescPendingApproval := passwordFields[6].Descriptor()
+	// password.DefaultPendingApproval holds the default value on creation for the pending_approval field.
+	password.DefaultPendingApproval = passwordDescPendingApproval.Default.(bool)
+	// passwordDescDisplayName is the schema descriptor for display_name field.
+	passwordDescDisplayName := passwordFields[8].Descriptor()
+	// password.DefaultDisplayName holds the default value on creation for the display_name field.
+	password.DefaultDisplayName = passwordDescDisplayName.Default.(string)
+	passwordresetFields := schema.PasswordReset{}.Fields()
+	_ = passwordresetFields
+	// passwordresetDescEmail is the schema descriptor for email field.
+	passwordresetDescEmail := passwordresetFields[1].Descriptor()
+	// passwordreset.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	passwordreset.EmailValidator = passwordresetDescEmail.Validators[0].(func(string) error)
+	// passwordresetDescID is the schema descriptor for id field.
+	passwordresetDescID := passwordresetFields[0].Descriptor()
+	// passwordreset.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	passwordreset.IDValidator = passwordresetDescID.Validators[0].(func(string) error)
 	refreshtokenFields := schema.RefreshToken{}.Fields()
 	_ = refreshtokenFields
 	// refreshtokenDescClientID is the schema descriptor for client_id field.
@@ -234,28 +355,90 @@ func init() {
 	refreshtokenDescClaimsPreferredUsername := refreshtokenFields[9].Descriptor()
 	// refreshtoken.DefaultClaimsPreferredUsername holds the default value on creation for the claims_preferred_username field.
 	refreshtoken.DefaultClaimsPreferredUsername = refreshtokenDescClaimsPreferredUsername.Default.(string)
+	// refreshtokenDescClaimsAcr is the schema descriptor for claims_acr field.
+	refreshtokenDescClaimsAcr := refreshtokenFields[10].Descriptor()
+	// refreshtoken.DefaultClaimsAcr holds the default value on creation for the claims_acr field.
+	refreshtoken.DefaultClaimsAcr = refreshtokenDescClaimsAcr.Default.(string)
 	// refreshtokenDescConnectorID is the schema descriptor for connector_id field.
-	refreshtokenDescConnectorID := refreshtokenFields[10].Descriptor()
+	refreshtokenDescConnectorID := refreshtokenFields[11].Descriptor()
 	// refreshtoken.ConnectorIDValidator is a validator for the "connector_id" field. It is called by the builders before save.
 	refreshtoken.ConnectorIDValidator = refreshtokenDescConnectorID.Validators[0].(func(string) error)
 	// refreshtokenDescToken is the schema descriptor for token field.
-	refreshtokenDescToken := refreshtokenFields[12].Descriptor()
+	refreshtokenDescToken := refreshtokenFields[13].Descriptor()
 	// refreshtoken.DefaultToken holds the default value on creation for the token field.
 	refreshtoken.DefaultToken = refreshtokenDescToken.Default.(string)
 	// refreshtokenDescObsoleteToken is the schema descriptor for obsolete_token field.
-	refreshtokenDescObsoleteToken := refreshtokenFields[13].Descriptor()
+	refreshtokenDescObsoleteToken := refreshtokenFields[14].Descriptor()
 	// refreshtoken.DefaultObsoleteToken holds the default value on creation for the obsolete_token field.
 	refreshtoken.DefaultObsoleteToken = refreshtokenDescObsoleteToken.Default.(string)
 	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
-	refreshtokenDescCreatedAt := refreshtokenFields[14].Descriptor()
+	refreshtokenDescCreatedAt := refreshtokenFields[16].Descriptor()
 	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
 	// refreshtokenDescLastUsed is the schema descriptor for last_used field.
-	refreshtokenDescLastUsed := refreshtokenFields[15].Descriptor()
+	refreshtokenDescLastUsed := refreshtokenFields[17].Descriptor()
 	// refreshtoken.DefaultLastUsed holds the default value on creation for the last_used field.
 	refreshtoken.DefaultLastUsed = refreshtokenDescLastUsed.Default.(func() time.Time)
 	// refreshtokenDescID is the schema descriptor for id field.
 	refreshtokenDescID := refreshtokenFields[0].Descriptor()
 	// refreshtoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	refreshtoken.IDValidator = refreshtokenDescID.Validators[0].(func(string) error)
+	samlassertionFields := schema.SAMLAssertion{}.Fields()
+	_ = samlassertionFields
+	// samlassertionDescID is the schema descriptor for id field.
+	samlassertionDescID := samlassertionFields[0].Descriptor()
+	// samlassertion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	samlassertion.IDValidator = samlassertionDescID.Validators[0].(func(string) error)
+	samlserviceproviderFields := schema.SAMLServiceProvider{}.Fields()
+	_ = samlserviceproviderFields
+	// samlserviceproviderDescName is the schema descriptor for name field.
+	samlserviceproviderDescName := samlserviceproviderFields[1].Descriptor()
+	// samlserviceprovider.DefaultName holds the default value on creation for the name field.
+	samlserviceprovider.DefaultName = samlserviceproviderDescName.Default.(string)
+	// samlserviceproviderDescNameIDFormat is the schema descriptor for name_id_format field.
+	samlserviceproviderDescNameIDFormat := samlserviceproviderFields[3].Descriptor()
+	// samlserviceprovider.DefaultNameIDFormat holds the default value on creation for the name_id_format field.
+	samlserviceprovider.DefaultNameIDFormat = samlserviceproviderDescNameIDFormat.Default.(string)
+	// samlserviceproviderDescID is the schema descriptor for id field.
+	samlserviceproviderDescID := samlserviceproviderFields[0].Descriptor()
+	// samlserviceprovider.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	samlserviceprovider.IDValidator = samlserviceproviderDescID.Validators[0].(func(string) error)
+	totpenrollmentFields := schema.TOTPEnrollment{}.Fields()
+	_ = totpenrollmentFields
+	// totpenrollmentDescUserID is the schema descriptor for user_id field.
+	totpenrollmentDescUserID := totpenrollmentFields[1].Descriptor()
+	// totpenrollment.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	totpenrollment.UserIDValidator = totpenrollmentDescUserID.Validators[0].(func(string) error)
+	// totpenrollmentDescConnID is the schema descriptor for conn_id field.
+	totpenrollmentDescConnID := totpenrollmentFields[2].Descriptor()
+	// totpenrollment.ConnIDValidator is a validator for the "conn_id" field. It is called by the builders before save.
+	totpenrollment.ConnIDValidator = totpenrollmentDescConnID.Validators[0].(func(string) error)
+	// totpenrollmentDescID is the schema descriptor for id field.
+	totpenrollmentDescID := totpenrollmentFields[0].Descriptor()
+	// totpenrollment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	totpenrollment.IDValidator = totpenrollmentDescID.Validators[0].(func(string) error)
+	userconsentFields := schema.UserConsent{}.Fields()
+	_ = userconsentFields
+	// userconsentDescUserID is the schema descriptor for user_id field.
+	userconsentDescUserID := userconsentFields[1].Descriptor()
+	// userconsent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	userconsent.UserIDValidator = userconsentDescUserID.Validators[0].(func(string) error)
+	// userconsentDescConnID is the schema descriptor for conn_id field.
+	userconsentDescConnID := userconsentFields[2].Descriptor()
+	// userconsent.ConnIDValidator is a validator for the "conn_id" field. It is called by the builders before save.
+	userconsent.ConnIDValidator = userconsentDescConnID.Validators[0].(func(string) error)
+	// userconsentDescID is the schema descriptor for id field.
+	userconsentDescID := userconsentFields[0].Descriptor()
+	// userconsent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userconsent.IDValidator = userconsentDescID.Validators[0].(func(string) error)
+	webauthncredentialFields := schema.WebAuthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescEmail is the schema descriptor for email field.
+	webauthncredentialDescEmail := webauthncredentialFields[1].Descriptor()
+	// webauthncredential.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	webauthncredential.EmailValidator = webauthncredentialDescEmail.Validators[0].(func(string) error)
+	// webauthncredentialDescID is the schema descriptor for id field.
+	webauthncredentialDescID := webauthncredentialFields[0].Descriptor()
+	// webauthncredential.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webauthncredential.IDValidator = webauthncredentialDescID.Validators[0].(func(string) error)
 }
@@ -0,0 +1,100 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+)
+
+// SAMLAssertion is the model entity for the SAMLAssertion schema.
+type SAMLAssertion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Expiry holds the value of the "expiry" field.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SAMLAssertion) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case samlassertion.FieldID:
+			values[i] = new(sql.NullString)
+		case samlassertion.FieldExpiry:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SAMLAssertion", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SAMLAssertion fields.
+func (sa *SAMLAssertion) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case samlassertion.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sa.ID = value.String
+			}
+		case samlassertion.FieldExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry", values[i])
+			} else if value.Valid {
+				sa.Expiry = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this SAMLAssertion.
+// Note that you need to call SAMLAssertion.Unwrap() before calling this method if this SAMLAssertion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SAMLAssertion) Update() *SAMLAssertionUpdateOne {
+	return (&SAMLAssertionClient{config: sa.config}).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SAMLAssertion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SAMLAssertion) Unwrap() *SAMLAssertion {
+	tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("db: SAMLAssertion is not a transactional entity")
+	}
+	sa.config.driver = tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SAMLAssertion) String() string {
+	var builder strings.Builder
+	builder.WriteString("SAMLAssertion(")
+	builder.WriteString(fmt.Sprintf("id=%v", sa.ID))
+	builder.WriteString(", expiry=")
+	builder.WriteString(sa.Expiry.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SAMLAssertions is a parsable slice of SAMLAssertion.
+type SAMLAssertions []*SAMLAssertion
+
+func (sa SAMLAssertions) config(cfg config) {
+	for _i := range sa {
+		sa[_i].config = cfg
+	}
+}
@@ -0,0 +1,35 @@
+// Code generated by entc, DO NOT EDIT.
+
+package samlassertion
+
+const (
+	// Label holds the string label denoting the samlassertion type in the database.
+	Label = "saml_assertion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldExpiry holds the string denoting the expiry field in the database.
+	FieldExpiry = "expiry"
+	// Table holds the table name of the samlassertion in the database.
+	Table = "saml_assertions"
+)
+
+// Columns holds all SQL columns for samlassertion fields.
+var Columns = []string{
+	FieldID,
+	FieldExpiry,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
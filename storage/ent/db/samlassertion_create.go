@@ -0,0 +1,237 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+)
+
+// SAMLAssertionCreate is the builder for creating a SAMLAssertion entity.
+type SAMLAssertionCreate struct {
+	config
+	mutation *SAMLAssertionMutation
+	hooks    []Hook
+}
+
+// SetExpiry sets the "expiry" field.
+func (sac *SAMLAssertionCreate) SetExpiry(t time.Time) *SAMLAssertionCreate {
+	sac.mutation.SetExpiry(t)
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *SAMLAssertionCreate) SetID(s string) *SAMLAssertionCreate {
+	sac.mutation.SetID(s)
+	return sac
+}
+
+// Mutation returns the SAMLAssertionMutation object of the builder.
+func (sac *SAMLAssertionCreate) Mutation() *SAMLAssertionMutation {
+	return sac.mutation
+}
+
+// Save creates the SAMLAssertion in the database.
+func (sac *SAMLAssertionCreate) Save(ctx context.Context) (*SAMLAssertion, error) {
+	var (
+		err  error
+		node *SAMLAssertion
+	)
+	if len(sac.hooks) == 0 {
+		if err = sac.check(); err != nil {
+			return nil, err
+		}
+		node, err = sac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SAMLAssertionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sac.check(); err != nil {
+				return nil, err
+			}
+			sac.mutation = mutation
+			if node, err = sac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sac.hooks) - 1; i >= 0; i-- {
+			if sac.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = sac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SAMLAssertionCreate) SaveX(ctx context.Context) *SAMLAssertion {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SAMLAssertionCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SAMLAssertionCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SAMLAssertionCreate) check() error {
+	if _, ok := sac.mutation.Expiry(); !ok {
+		return &ValidationError{Name: "expiry", err: errors.New(`db: missing required field "SAMLAssertion.expiry"`)}
+	}
+	if v, ok := sac.mutation.ID(); ok {
+		if err := samlassertion.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "SAMLAssertion.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sac *SAMLAssertionCreate) sqlSave(ctx context.Context) (*SAMLAssertion, error) {
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SAMLAssertion.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (sac *SAMLAssertionCreate) createSpec() (*SAMLAssertion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SAMLAssertion{config: sac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: samlassertion.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlassertion.FieldID,
+			},
+		}
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sac.mutation.Expiry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: samlassertion.FieldExpiry,
+		})
+		_node.Expiry = value
+	}
+	return _node, _spec
+}
+
+// SAMLAssertionCreateBulk is the builder for creating many SAMLAssertion entities in bulk.
+type SAMLAssertionCreateBulk struct {
+	config
+	builders []*SAMLAssertionCreate
+}
+
+// Save creates the SAMLAssertion entities in the database.
+func (sacb *SAMLAssertionCreateBulk) Save(ctx context.Context) ([]*SAMLAssertion, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SAMLAssertion, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SAMLAssertionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SAMLAssertionCreateBulk) SaveX(ctx context.Context) []*SAMLAssertion {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SAMLAssertionCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SAMLAssertionCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+)
+
+// SAMLAssertionDelete is the builder for deleting a SAMLAssertion entity.
+type SAMLAssertionDelete struct {
+	config
+	hooks    []Hook
+	mutation *SAMLAssertionMutation
+}
+
+// Where appends a list predicates to the SAMLAssertionDelete builder.
+func (sad *SAMLAssertionDelete) Where(ps ...predicate.SAMLAssertion) *SAMLAssertionDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *SAMLAssertionDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sad.hooks) == 0 {
+		affected, err = sad.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SAMLAssertionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sad.mutation = mutation
+			affected, err = sad.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sad.hooks) - 1; i >= 0; i-- {
+			if sad.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = sad.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sad.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *SAMLAssertionDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *SAMLAssertionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: samlassertion.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlassertion.FieldID,
+			},
+		},
+	}
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+}
+
+// SAMLAssertionDeleteOne is the builder for deleting a single SAMLAssertion entity.
+type SAMLAssertionDeleteOne struct {
+	sad *SAMLAssertionDelete
+}
+
+// Exec executes the deletion query.
+func (sado *SAMLAssertionDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{samlassertion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *SAMLAssertionDeleteOne) ExecX(ctx context.Context) {
+	sado.sad.ExecX(ctx)
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+)
+
+// SAMLAssertionQuery is the builder for querying SAMLAssertion entities.
+type SAMLAssertionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SAMLAssertion
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SAMLAssertionQuery builder.
+func (saq *SAMLAssertionQuery) Where(ps ...predicate.SAMLAssertion) *SAMLAssertionQuery {
+	saq.predicates = append(saq.predicates, ps...)
+	return saq
+}
+
+// Limit adds a limit step to the query.
+func (saq *SAMLAssertionQuery) Limit(limit int) *SAMLAssertionQuery {
+	saq.limit = &limit
+	return saq
+}
+
+// Offset adds an offset step to the query.
+func (saq *SAMLAssertionQuery) Offset(offset int) *SAMLAssertionQuery {
+	saq.offset = &offset
+	return saq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (saq *SAMLAssertionQuery) Unique(unique bool) *SAMLAssertionQuery {
+	saq.unique = &unique
+	return saq
+}
+
+// Order adds an order step to the query.
+func (saq *SAMLAssertionQuery) Order(o ...OrderFunc) *SAMLAssertionQuery {
+	saq.order = append(saq.order, o...)
+	return saq
+}
+
+// First returns the first SAMLAssertion entity from the query.
+// Returns a *NotFoundError when no SAMLAssertion was found.
+func (saq *SAMLAssertionQuery) First(ctx context.Context) (*SAMLAssertion, error) {
+	nodes, err := saq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{samlassertion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (saq *SAMLAssertionQuery) FirstX(ctx context.Context) *SAMLAssertion {
+	node, err := saq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SAMLAssertion ID from the query.
+// Returns a *NotFoundError when no SAMLAssertion ID was found.
+func (saq *SAMLAssertionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = saq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{samlassertion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (saq *SAMLAssertionQuery) FirstIDX(ctx context.Context) string {
+	id, err := saq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SAMLAssertion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SAMLAssertion entity is found.
+// Returns a *NotFoundError when no SAMLAssertion entities are found.
+func (saq *SAMLAssertionQuery) Only(ctx context.Context) (*SAMLAssertion, error) {
+	nodes, err := saq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{samlassertion.Label}
+	default:
+		return nil, &NotSingularError{samlassertion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (saq *SAMLAssertionQuery) OnlyX(ctx context.Context) *SAMLAssertion {
+	node, err := saq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SAMLAssertion ID in the query.
+// Returns a *NotSingularError when more than one SAMLAssertion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (saq *SAMLAssertionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = saq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = &NotSingularError{samlassertion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (saq *SAMLAssertionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := saq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SAMLAssertions.
+func (saq *SAMLAssertionQuery) All(ctx context.Context) ([]*SAMLAssertion, error) {
+	if err := saq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return saq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (saq *SAMLAssertionQuery) AllX(ctx context.Context) []*SAMLAssertion {
+	nodes, err := saq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SAMLAssertion IDs.
+func (saq *SAMLAssertionQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := saq.Select(samlassertion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (saq *SAMLAssertionQuery) IDsX(ctx context.Context) []string {
+	ids, err := saq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (saq *SAMLAssertionQuery) Count(ctx context.Context) (int, error) {
+	if err := saq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return saq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (saq *SAMLAssertionQuery) CountX(ctx context.Context) int {
+	count, err := saq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (saq *SAMLAssertionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := saq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return saq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (saq *SAMLAssertionQuery) ExistX(ctx context.Context) bool {
+	exist, err := saq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SAMLAssertionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (saq *SAMLAssertionQuery) Clone() *SAMLAssertionQuery {
+	if saq == nil {
+		return nil
+	}
+	return &SAMLAssertionQuery{
+		config:     saq.config,
+		limit:      saq.limit,
+		offset:     saq.offset,
+		order:      append([]OrderFunc{}, saq.order...),
+		predicates: append([]predicate.SAMLAssertion{}, saq.predicates...),
+		// clone intermediate query.
+		sql:    saq.sql.Clone(),
+		path:   saq.path,
+		unique: saq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Expiry time.Time `json:"expiry,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SAMLAssertion.Query().
+//		GroupBy(samlassertion.FieldExpiry).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+//
+func (saq *SAMLAssertionQuery) GroupBy(field string, fields ...string) *SAMLAssertionGroupBy {
+	group := &SAMLAssertionGroupBy{config: saq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return saq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Expiry time.Time `json:"expiry,omitempty"`
+//	}
+//
+//	client.SAMLAssertion.Query().
+//		Select(samlassertion.FieldExpiry).
+//		Scan(ctx, &v)
+//
+func (saq *SAMLAssertionQuery) Select(fields ...string) *SAMLAssertionSelect {
+	saq.fields = append(saq.fields, fields...)
+	return &SAMLAssertionSelect{SAMLAssertionQuery: saq}
+}
+
+func (saq *SAMLAssertionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range saq.fields {
+		if !samlassertion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if saq.path != nil {
+		prev, err := saq.path(ctx)
+		if err != nil {
+			return err
+		}
+		saq.sql = prev
+	}
+	return nil
+}
+
+func (saq *SAMLAssertionQuery) sqlAll(ctx context.Context) ([]*SAMLAssertion, error) {
+	var (
+		nodes = []*SAMLAssertion{}
+		_spec = saq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &SAMLAssertion{config: saq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, saq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (saq *SAMLAssertionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := saq.querySpec()
+	_spec.Node.Columns = saq.fields
+	if len(saq.fields) > 0 {
+		_spec.Unique = saq.unique != nil && *saq.unique
+	}
+	return sqlgraph.CountNodes(ctx, saq.driver, _spec)
+}
+
+func (saq *SAMLAssertionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := saq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (saq *SAMLAssertionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   samlassertion.Table,
+			Columns: samlassertion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlassertion.FieldID,
+			},
+		},
+		From:   saq.sql,
+		Unique: true,
+	}
+	if unique := saq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := saq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlassertion.FieldID)
+		for i := range fields {
+			if fields[i] != samlassertion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := saq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := saq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := saq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := saq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (saq *SAMLAssertionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(saq.driver.Dialect())
+	t1 := builder.Table(samlassertion.Table)
+	columns := saq.fields
+	if len(columns) == 0 {
+		columns = samlassertion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if saq.sql != nil {
+		selector = saq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if saq.unique != nil && *saq.unique {
+		selector.Distinct()
+	}
+	for _, p := range saq.predicates {
+		p(selector)
+	}
+	for _, p := range saq.order {
+		p(selector)
+	}
+	if offset := saq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := saq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SAMLAssertionGroupBy is the group-by builder for SAMLAssertion entities.
+type SAMLAssertionGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sagb *SAMLAssertionGroupBy) Aggregate(fns ...AggregateFunc) *SAMLAssertionGroupBy {
+	sagb.fns = append(sagb.fns, fns...)
+	return sagb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (sagb *SAMLAssertionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := sagb.path(ctx)
+	if err != nil {
+		return err
+	}
+	sagb.sql = query
+	return sagb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := sagb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sagb *SAMLAssertionGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(sagb.fields) > 1 {
+		return nil, errors.New("db: SAMLAssertionGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := sagb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) StringsX(ctx context.Context) []string {
+	v, err := sagb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sagb *SAMLAssertionGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sagb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = fmt.Errorf("db: SAMLAssertionGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) StringX(ctx context.Context) string {
+	v, err := sagb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sagb *SAMLAssertionGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(sagb.fields) > 1 {
+		return nil, errors.New("db: SAMLAssertionGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := sagb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) IntsX(ctx context.Context) []int {
+	v, err := sagb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sagb *SAMLAssertionGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sagb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = fmt.Errorf("db: SAMLAssertionGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) IntX(ctx context.Context) int {
+	v, err := sagb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sagb *SAMLAssertionGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sagb.fields) > 1 {
+		return nil, errors.New("db: SAMLAssertionGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := sagb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := sagb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sagb *SAMLAssertionGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sagb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = fmt.Errorf("db: SAMLAssertionGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := sagb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sagb *SAMLAssertionGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(sagb.fields) > 1 {
+		return nil, errors.New("db: SAMLAssertionGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := sagb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := sagb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sagb *SAMLAssertionGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sagb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = fmt.Errorf("db: SAMLAssertionGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sagb *SAMLAssertionGroupBy) BoolX(ctx context.Context) bool {
+	v, err := sagb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sagb *SAMLAssertionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range sagb.fields {
+		if !samlassertion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := sagb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sagb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sagb *SAMLAssertionGroupBy) sqlQuery() *sql.Selector {
+	selector := sagb.sql.Select()
+	aggregation := make([]string, 0, len(sagb.fns))
+	for _, fn := range sagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(sagb.fields)+len(sagb.fns))
+		for _, f := range sagb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(sagb.fields...)...)
+}
+
+// SAMLAssertionSelect is the builder for selecting fields of SAMLAssertion entities.
+type SAMLAssertionSelect struct {
+	*SAMLAssertionQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sas *SAMLAssertionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := sas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sas.sql = sas.SAMLAssertionQuery.sqlQuery(ctx)
+	return sas.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := sas.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (sas *SAMLAssertionSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(sas.fields) > 1 {
+		return nil, errors.New("db: SAMLAssertionSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := sas.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) StringsX(ctx context.Context) []string {
+	v, err := sas.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (sas *SAMLAssertionSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sas.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = fmt.Errorf("db: SAMLAssertionSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) StringX(ctx context.Context) string {
+	v, err := sas.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (sas *SAMLAssertionSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(sas.fields) > 1 {
+		return nil, errors.New("db: SAMLAssertionSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := sas.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) IntsX(ctx context.Context) []int {
+	v, err := sas.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (sas *SAMLAssertionSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sas.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = fmt.Errorf("db: SAMLAssertionSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) IntX(ctx context.Context) int {
+	v, err := sas.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (sas *SAMLAssertionSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sas.fields) > 1 {
+		return nil, errors.New("db: SAMLAssertionSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := sas.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := sas.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (sas *SAMLAssertionSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sas.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = fmt.Errorf("db: SAMLAssertionSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) Float64X(ctx context.Context) float64 {
+	v, err := sas.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (sas *SAMLAssertionSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(sas.fields) > 1 {
+		return nil, errors.New("db: SAMLAssertionSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := sas.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) BoolsX(ctx context.Context) []bool {
+	v, err := sas.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (sas *SAMLAssertionSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sas.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlassertion.Label}
+	default:
+		err = fmt.Errorf("db: SAMLAssertionSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sas *SAMLAssertionSelect) BoolX(ctx context.Context) bool {
+	v, err := sas.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sas *SAMLAssertionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sas.sql.Query()
+	if err := sas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,266 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/samlassertion"
+)
+
+// SAMLAssertionUpdate is the builder for updating SAMLAssertion entities.
+type SAMLAssertionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SAMLAssertionMutation
+}
+
+// Where appends a list predicates to the SAMLAssertionUpdate builder.
+func (sau *SAMLAssertionUpdate) Where(ps ...predicate.SAMLAssertion) *SAMLAssertionUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetExpiry sets the "expiry" field.
+func (sau *SAMLAssertionUpdate) SetExpiry(t time.Time) *SAMLAssertionUpdate {
+	sau.mutation.SetExpiry(t)
+	return sau
+}
+
+// Mutation returns the SAMLAssertionMutation object of the builder.
+func (sau *SAMLAssertionUpdate) Mutation() *SAMLAssertionMutation {
+	return sau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SAMLAssertionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sau.hooks) == 0 {
+		affected, err = sau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SAMLAssertionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sau.mutation = mutation
+			affected, err = sau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sau.hooks) - 1; i >= 0; i-- {
+			if sau.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = sau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SAMLAssertionUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SAMLAssertionUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SAMLAssertionUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sau *SAMLAssertionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   samlassertion.Table,
+			Columns: samlassertion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlassertion.FieldID,
+			},
+		},
+	}
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.Expiry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: samlassertion.FieldExpiry,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlassertion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SAMLAssertionUpdateOne is the builder for updating a single SAMLAssertion entity.
+type SAMLAssertionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SAMLAssertionMutation
+}
+
+// SetExpiry sets the "expiry" field.
+func (sauo *SAMLAssertionUpdateOne) SetExpiry(t time.Time) *SAMLAssertionUpdateOne {
+	sauo.mutation.SetExpiry(t)
+	return sauo
+}
+
+// Mutation returns the SAMLAssertionMutation object of the builder.
+func (sauo *SAMLAssertionUpdateOne) Mutation() *SAMLAssertionMutation {
+	return sauo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SAMLAssertionUpdateOne) Select(field string, fields ...string) *SAMLAssertionUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SAMLAssertion entity.
+func (sauo *SAMLAssertionUpdateOne) Save(ctx context.Context) (*SAMLAssertion, error) {
+	var (
+		err  error
+		node *SAMLAssertion
+	)
+	if len(sauo.hooks) == 0 {
+		node, err = sauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SAMLAssertionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sauo.mutation = mutation
+			node, err = sauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sauo.hooks) - 1; i >= 0; i-- {
+			if sauo.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = sauo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sauo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SAMLAssertionUpdateOne) SaveX(ctx context.Context) *SAMLAssertion {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SAMLAssertionUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SAMLAssertionUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sauo *SAMLAssertionUpdateOne) sqlSave(ctx context.Context) (_node *SAMLAssertion, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   samlassertion.Table,
+			Columns: samlassertion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlassertion.FieldID,
+			},
+		},
+	}
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SAMLAssertion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlassertion.FieldID)
+		for _, f := range fields {
+			if !samlassertion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != samlassertion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.Expiry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: samlassertion.FieldExpiry,
+		})
+	}
+	_node = &SAMLAssertion{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlassertion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
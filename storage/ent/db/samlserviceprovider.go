@@ -0,0 +1,134 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+)
+
+// SAMLServiceProvider is the model entity for the SAMLServiceProvider schema.
+type SAMLServiceProvider struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// AcsUrls holds the value of the "acs_urls" field.
+	AcsUrls []string `json:"acs_urls,omitempty"`
+	// NameIDFormat holds the value of the "name_id_format" field.
+	NameIDFormat string `json:"name_id_format,omitempty"`
+	// AttributeNames holds the value of the "attribute_names" field.
+	AttributeNames map[string]string `json:"attribute_names,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SAMLServiceProvider) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case samlserviceprovider.FieldAcsUrls, samlserviceprovider.FieldAttributeNames:
+			values[i] = new([]byte)
+		case samlserviceprovider.FieldID, samlserviceprovider.FieldName, samlserviceprovider.FieldNameIDFormat:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SAMLServiceProvider", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SAMLServiceProvider fields.
+func (ssp *SAMLServiceProvider) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case samlserviceprovider.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ssp.ID = value.String
+			}
+		case samlserviceprovider.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ssp.Name = value.String
+			}
+		case samlserviceprovider.FieldAcsUrls:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field acs_urls", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ssp.AcsUrls); err != nil {
+					return fmt.Errorf("unmarshal field acs_urls: %w", err)
+				}
+			}
+		case samlserviceprovider.FieldNameIDFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_id_format", values[i])
+			} else if value.Valid {
+				ssp.NameIDFormat = value.String
+			}
+		case samlserviceprovider.FieldAttributeNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ssp.AttributeNames); err != nil {
+					return fmt.Errorf("unmarshal field attribute_names: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this SAMLServiceProvider.
+// Note that you need to call SAMLServiceProvider.Unwrap() before calling this method if this SAMLServiceProvider
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ssp *SAMLServiceProvider) Update() *SAMLServiceProviderUpdateOne {
+	return (&SAMLServiceProviderClient{config: ssp.config}).UpdateOne(ssp)
+}
+
+// Unwrap unwraps the SAMLServiceProvider entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ssp *SAMLServiceProvider) Unwrap() *SAMLServiceProvider {
+	tx, ok := ssp.config.driver.(*txDriver)
+	if !ok {
+		panic("db: SAMLServiceProvider is not a transactional entity")
+	}
+	ssp.config.driver = tx.drv
+	return ssp
+}
+
+// String implements the fmt.Stringer.
+func (ssp *SAMLServiceProvider) String() string {
+	var builder strings.Builder
+	builder.WriteString("SAMLServiceProvider(")
+	builder.WriteString(fmt.Sprintf("id=%v", ssp.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(ssp.Name)
+	builder.WriteString(", acs_urls=")
+	builder.WriteString(fmt.Sprintf("%v", ssp.AcsUrls))
+	builder.WriteString(", name_id_format=")
+	builder.WriteString(ssp.NameIDFormat)
+	builder.WriteString(", attribute_names=")
+	builder.WriteString(fmt.Sprintf("%v", ssp.AttributeNames))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SAMLServiceProviders is a parsable slice of SAMLServiceProvider.
+type SAMLServiceProviders []*SAMLServiceProvider
+
+func (ssp SAMLServiceProviders) config(cfg config) {
+	for _i := range ssp {
+		ssp[_i].config = cfg
+	}
+}
@@ -0,0 +1,48 @@
+// Code generated by entc, DO NOT EDIT.
+
+package samlserviceprovider
+
+const (
+	// Label holds the string label denoting the samlserviceprovider type in the database.
+	Label = "saml_service_provider"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAcsUrls holds the string denoting the acs_urls field in the database.
+	FieldAcsUrls = "acs_urls"
+	// FieldNameIDFormat holds the string denoting the name_id_format field in the database.
+	FieldNameIDFormat = "name_id_format"
+	// FieldAttributeNames holds the string denoting the attribute_names field in the database.
+	FieldAttributeNames = "attribute_names"
+	// Table holds the table name of the samlserviceprovider in the database.
+	Table = "saml_service_providers"
+)
+
+// Columns holds all SQL columns for samlserviceprovider fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldAcsUrls,
+	FieldNameIDFormat,
+	FieldAttributeNames,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultNameIDFormat holds the default value on creation for the "name_id_format" field.
+	DefaultNameIDFormat string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
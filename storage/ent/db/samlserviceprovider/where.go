@@ -0,0 +1,387 @@
+// Code generated by entc, DO NOT EDIT.
+
+package samlserviceprovider
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameIDFormat applies equality check predicate on the "name_id_format" field. It's identical to NameIDFormatEQ.
+func NameIDFormat(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SAMLServiceProvider {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SAMLServiceProvider {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// AcsUrlsIsNil applies the IsNil predicate on the "acs_urls" field.
+func AcsUrlsIsNil() predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAcsUrls)))
+	})
+}
+
+// AcsUrlsNotNil applies the NotNil predicate on the "acs_urls" field.
+func AcsUrlsNotNil() predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAcsUrls)))
+	})
+}
+
+// NameIDFormatEQ applies the EQ predicate on the "name_id_format" field.
+func NameIDFormatEQ(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatNEQ applies the NEQ predicate on the "name_id_format" field.
+func NameIDFormatNEQ(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatIn applies the In predicate on the "name_id_format" field.
+func NameIDFormatIn(vs ...string) predicate.SAMLServiceProvider {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNameIDFormat), v...))
+	})
+}
+
+// NameIDFormatNotIn applies the NotIn predicate on the "name_id_format" field.
+func NameIDFormatNotIn(vs ...string) predicate.SAMLServiceProvider {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNameIDFormat), v...))
+	})
+}
+
+// NameIDFormatGT applies the GT predicate on the "name_id_format" field.
+func NameIDFormatGT(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatGTE applies the GTE predicate on the "name_id_format" field.
+func NameIDFormatGTE(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatLT applies the LT predicate on the "name_id_format" field.
+func NameIDFormatLT(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatLTE applies the LTE predicate on the "name_id_format" field.
+func NameIDFormatLTE(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatContains applies the Contains predicate on the "name_id_format" field.
+func NameIDFormatContains(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatHasPrefix applies the HasPrefix predicate on the "name_id_format" field.
+func NameIDFormatHasPrefix(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatHasSuffix applies the HasSuffix predicate on the "name_id_format" field.
+func NameIDFormatHasSuffix(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatEqualFold applies the EqualFold predicate on the "name_id_format" field.
+func NameIDFormatEqualFold(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// NameIDFormatContainsFold applies the ContainsFold predicate on the "name_id_format" field.
+func NameIDFormatContainsFold(v string) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNameIDFormat), v))
+	})
+}
+
+// AttributeNamesIsNil applies the IsNil predicate on the "attribute_names" field.
+func AttributeNamesIsNil() predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAttributeNames)))
+	})
+}
+
+// AttributeNamesNotNil applies the NotNil predicate on the "attribute_names" field.
+func AttributeNamesNotNil() predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAttributeNames)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SAMLServiceProvider) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SAMLServiceProvider) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SAMLServiceProvider) predicate.SAMLServiceProvider {
+	return predicate.SAMLServiceProvider(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,311 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+)
+
+// SAMLServiceProviderCreate is the builder for creating a SAMLServiceProvider entity.
+type SAMLServiceProviderCreate struct {
+	config
+	mutation *SAMLServiceProviderMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sspc *SAMLServiceProviderCreate) SetName(s string) *SAMLServiceProviderCreate {
+	sspc.mutation.SetName(s)
+	return sspc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sspc *SAMLServiceProviderCreate) SetNillableName(s *string) *SAMLServiceProviderCreate {
+	if s != nil {
+		sspc.SetName(*s)
+	}
+	return sspc
+}
+
+// SetAcsUrls sets the "acs_urls" field.
+func (sspc *SAMLServiceProviderCreate) SetAcsUrls(s []string) *SAMLServiceProviderCreate {
+	sspc.mutation.SetAcsUrls(s)
+	return sspc
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (sspc *SAMLServiceProviderCreate) SetNameIDFormat(s string) *SAMLServiceProviderCreate {
+	sspc.mutation.SetNameIDFormat(s)
+	return sspc
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (sspc *SAMLServiceProviderCreate) SetNillableNameIDFormat(s *string) *SAMLServiceProviderCreate {
+	if s != nil {
+		sspc.SetNameIDFormat(*s)
+	}
+	return sspc
+}
+
+// SetAttributeNames sets the "attribute_names" field.
+func (sspc *SAMLServiceProviderCreate) SetAttributeNames(m map[string]string) *SAMLServiceProviderCreate {
+	sspc.mutation.SetAttributeNames(m)
+	return sspc
+}
+
+// SetID sets the "id" field.
+func (sspc *SAMLServiceProviderCreate) SetID(s string) *SAMLServiceProviderCreate {
+	sspc.mutation.SetID(s)
+	return sspc
+}
+
+// Mutation returns the SAMLServiceProviderMutation object of the builder.
+func (sspc *SAMLServiceProviderCreate) Mutation() *SAMLServiceProviderMutation {
+	return sspc.mutation
+}
+
+// Save creates the SAMLServiceProvider in the database.
+func (sspc *SAMLServiceProviderCreate) Save(ctx context.Context) (*SAMLServiceProvider, error) {
+	var (
+		err  error
+		node *SAMLServiceProvider
+	)
+	sspc.defaults()
+	if len(sspc.hooks) == 0 {
+		if err = sspc.check(); err != nil {
+			return nil, err
+		}
+		node, err = sspc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SAMLServiceProviderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sspc.check(); err != nil {
+				return nil, err
+			}
+			sspc.mutation = mutation
+			if node, err = sspc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sspc.hooks) - 1; i >= 0; i-- {
+			if sspc.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = sspc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sspc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sspc *SAMLServiceProviderCreate) SaveX(ctx context.Context) *SAMLServiceProvider {
+	v, err := sspc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sspc *SAMLServiceProviderCreate) Exec(ctx context.Context) error {
+	_, err := sspc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspc *SAMLServiceProviderCreate) ExecX(ctx context.Context) {
+	if err := sspc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sspc *SAMLServiceProviderCreate) defaults() {
+	if _, ok := sspc.mutation.Name(); !ok {
+		v := samlserviceprovider.DefaultName
+		sspc.mutation.SetName(v)
+	}
+	if _, ok := sspc.mutation.NameIDFormat(); !ok {
+		v := samlserviceprovider.DefaultNameIDFormat
+		sspc.mutation.SetNameIDFormat(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sspc *SAMLServiceProviderCreate) check() error {
+	if _, ok := sspc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`db: missing required field "SAMLServiceProvider.name"`)}
+	}
+	if _, ok := sspc.mutation.NameIDFormat(); !ok {
+		return &ValidationError{Name: "name_id_format", err: errors.New(`db: missing required field "SAMLServiceProvider.name_id_format"`)}
+	}
+	if v, ok := sspc.mutation.ID(); ok {
+		if err := samlserviceprovider.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "SAMLServiceProvider.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sspc *SAMLServiceProviderCreate) sqlSave(ctx context.Context) (*SAMLServiceProvider, error) {
+	_node, _spec := sspc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sspc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SAMLServiceProvider.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (sspc *SAMLServiceProviderCreate) createSpec() (*SAMLServiceProvider, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SAMLServiceProvider{config: sspc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: samlserviceprovider.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlserviceprovider.FieldID,
+			},
+		}
+	)
+	if id, ok := sspc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sspc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: samlserviceprovider.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := sspc.mutation.AcsUrls(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: samlserviceprovider.FieldAcsUrls,
+		})
+		_node.AcsUrls = value
+	}
+	if value, ok := sspc.mutation.NameIDFormat(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: samlserviceprovider.FieldNameIDFormat,
+		})
+		_node.NameIDFormat = value
+	}
+	if value, ok := sspc.mutation.AttributeNames(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: samlserviceprovider.FieldAttributeNames,
+		})
+		_node.AttributeNames = value
+	}
+	return _node, _spec
+}
+
+// SAMLServiceProviderCreateBulk is the builder for creating many SAMLServiceProvider entities in bulk.
+type SAMLServiceProviderCreateBulk struct {
+	config
+	builders []*SAMLServiceProviderCreate
+}
+
+// Save creates the SAMLServiceProvider entities in the database.
+func (sspcb *SAMLServiceProviderCreateBulk) Save(ctx context.Context) ([]*SAMLServiceProvider, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sspcb.builders))
+	nodes := make([]*SAMLServiceProvider, len(sspcb.builders))
+	mutators := make([]Mutator, len(sspcb.builders))
+	for i := range sspcb.builders {
+		func(i int, root context.Context) {
+			builder := sspcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SAMLServiceProviderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sspcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sspcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sspcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sspcb *SAMLServiceProviderCreateBulk) SaveX(ctx context.Context) []*SAMLServiceProvider {
+	v, err := sspcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sspcb *SAMLServiceProviderCreateBulk) Exec(ctx context.Context) error {
+	_, err := sspcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspcb *SAMLServiceProviderCreateBulk) ExecX(ctx context.Context) {
+	if err := sspcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
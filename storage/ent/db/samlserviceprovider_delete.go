@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+)
+
+// SAMLServiceProviderDelete is the builder for deleting a SAMLServiceProvider entity.
+type SAMLServiceProviderDelete struct {
+	config
+	hooks    []Hook
+	mutation *SAMLServiceProviderMutation
+}
+
+// Where appends a list predicates to the SAMLServiceProviderDelete builder.
+func (sspd *SAMLServiceProviderDelete) Where(ps ...predicate.SAMLServiceProvider) *SAMLServiceProviderDelete {
+	sspd.mutation.Where(ps...)
+	return sspd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sspd *SAMLServiceProviderDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sspd.hooks) == 0 {
+		affected, err = sspd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SAMLServiceProviderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sspd.mutation = mutation
+			affected, err = sspd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sspd.hooks) - 1; i >= 0; i-- {
+			if sspd.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = sspd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sspd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspd *SAMLServiceProviderDelete) ExecX(ctx context.Context) int {
+	n, err := sspd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sspd *SAMLServiceProviderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: samlserviceprovider.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlserviceprovider.FieldID,
+			},
+		},
+	}
+	if ps := sspd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, sspd.driver, _spec)
+}
+
+// SAMLServiceProviderDeleteOne is the builder for deleting a single SAMLServiceProvider entity.
+type SAMLServiceProviderDeleteOne struct {
+	sspd *SAMLServiceProviderDelete
+}
+
+// Exec executes the deletion query.
+func (sspdo *SAMLServiceProviderDeleteOne) Exec(ctx context.Context) error {
+	n, err := sspdo.sspd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{samlserviceprovider.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspdo *SAMLServiceProviderDeleteOne) ExecX(ctx context.Context) {
+	sspdo.sspd.ExecX(ctx)
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+)
+
+// SAMLServiceProviderQuery is the builder for querying SAMLServiceProvider entities.
+type SAMLServiceProviderQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SAMLServiceProvider
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SAMLServiceProviderQuery builder.
+func (sspq *SAMLServiceProviderQuery) Where(ps ...predicate.SAMLServiceProvider) *SAMLServiceProviderQuery {
+	sspq.predicates = append(sspq.predicates, ps...)
+	return sspq
+}
+
+// Limit adds a limit step to the query.
+func (sspq *SAMLServiceProviderQuery) Limit(limit int) *SAMLServiceProviderQuery {
+	sspq.limit = &limit
+	return sspq
+}
+
+// Offset adds an offset step to the query.
+func (sspq *SAMLServiceProviderQuery) Offset(offset int) *SAMLServiceProviderQuery {
+	sspq.offset = &offset
+	return sspq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sspq *SAMLServiceProviderQuery) Unique(unique bool) *SAMLServiceProviderQuery {
+	sspq.unique = &unique
+	return sspq
+}
+
+// Order adds an order step to the query.
+func (sspq *SAMLServiceProviderQuery) Order(o ...OrderFunc) *SAMLServiceProviderQuery {
+	sspq.order = append(sspq.order, o...)
+	return sspq
+}
+
+// First returns the first SAMLServiceProvider entity from the query.
+// Returns a *NotFoundError when no SAMLServiceProvider was found.
+func (sspq *SAMLServiceProviderQuery) First(ctx context.Context) (*SAMLServiceProvider, error) {
+	nodes, err := sspq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{samlserviceprovider.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sspq *SAMLServiceProviderQuery) FirstX(ctx context.Context) *SAMLServiceProvider {
+	node, err := sspq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SAMLServiceProvider ID from the query.
+// Returns a *NotFoundError when no SAMLServiceProvider ID was found.
+func (sspq *SAMLServiceProviderQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sspq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{samlserviceprovider.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sspq *SAMLServiceProviderQuery) FirstIDX(ctx context.Context) string {
+	id, err := sspq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SAMLServiceProvider entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SAMLServiceProvider entity is found.
+// Returns a *NotFoundError when no SAMLServiceProvider entities are found.
+func (sspq *SAMLServiceProviderQuery) Only(ctx context.Context) (*SAMLServiceProvider, error) {
+	nodes, err := sspq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{samlserviceprovider.Label}
+	default:
+		return nil, &NotSingularError{samlserviceprovider.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sspq *SAMLServiceProviderQuery) OnlyX(ctx context.Context) *SAMLServiceProvider {
+	node, err := sspq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SAMLServiceProvider ID in the query.
+// Returns a *NotSingularError when more than one SAMLServiceProvider ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sspq *SAMLServiceProviderQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = sspq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = &NotSingularError{samlserviceprovider.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sspq *SAMLServiceProviderQuery) OnlyIDX(ctx context.Context) string {
+	id, err := sspq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SAMLServiceProviders.
+func (sspq *SAMLServiceProviderQuery) All(ctx context.Context) ([]*SAMLServiceProvider, error) {
+	if err := sspq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return sspq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sspq *SAMLServiceProviderQuery) AllX(ctx context.Context) []*SAMLServiceProvider {
+	nodes, err := sspq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SAMLServiceProvider IDs.
+func (sspq *SAMLServiceProviderQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := sspq.Select(samlserviceprovider.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sspq *SAMLServiceProviderQuery) IDsX(ctx context.Context) []string {
+	ids, err := sspq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sspq *SAMLServiceProviderQuery) Count(ctx context.Context) (int, error) {
+	if err := sspq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return sspq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sspq *SAMLServiceProviderQuery) CountX(ctx context.Context) int {
+	count, err := sspq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sspq *SAMLServiceProviderQuery) Exist(ctx context.Context) (bool, error) {
+	if err := sspq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return sspq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sspq *SAMLServiceProviderQuery) ExistX(ctx context.Context) bool {
+	exist, err := sspq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SAMLServiceProviderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sspq *SAMLServiceProviderQuery) Clone() *SAMLServiceProviderQuery {
+	if sspq == nil {
+		return nil
+	}
+	return &SAMLServiceProviderQuery{
+		config:     sspq.config,
+		limit:      sspq.limit,
+		offset:     sspq.offset,
+		order:      append([]OrderFunc{}, sspq.order...),
+		predicates: append([]predicate.SAMLServiceProvider{}, sspq.predicates...),
+		// clone intermediate query.
+		sql:    sspq.sql.Clone(),
+		path:   sspq.path,
+		unique: sspq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SAMLServiceProvider.Query().
+//		GroupBy(samlserviceprovider.FieldName).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+//
+func (sspq *SAMLServiceProviderQuery) GroupBy(field string, fields ...string) *SAMLServiceProviderGroupBy {
+	group := &SAMLServiceProviderGroupBy{config: sspq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := sspq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return sspq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.SAMLServiceProvider.Query().
+//		Select(samlserviceprovider.FieldName).
+//		Scan(ctx, &v)
+//
+func (sspq *SAMLServiceProviderQuery) Select(fields ...string) *SAMLServiceProviderSelect {
+	sspq.fields = append(sspq.fields, fields...)
+	return &SAMLServiceProviderSelect{SAMLServiceProviderQuery: sspq}
+}
+
+func (sspq *SAMLServiceProviderQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range sspq.fields {
+		if !samlserviceprovider.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if sspq.path != nil {
+		prev, err := sspq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sspq.sql = prev
+	}
+	return nil
+}
+
+func (sspq *SAMLServiceProviderQuery) sqlAll(ctx context.Context) ([]*SAMLServiceProvider, error) {
+	var (
+		nodes = []*SAMLServiceProvider{}
+		_spec = sspq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &SAMLServiceProvider{config: sspq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sspq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (sspq *SAMLServiceProviderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sspq.querySpec()
+	_spec.Node.Columns = sspq.fields
+	if len(sspq.fields) > 0 {
+		_spec.Unique = sspq.unique != nil && *sspq.unique
+	}
+	return sqlgraph.CountNodes(ctx, sspq.driver, _spec)
+}
+
+func (sspq *SAMLServiceProviderQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := sspq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (sspq *SAMLServiceProviderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   samlserviceprovider.Table,
+			Columns: samlserviceprovider.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlserviceprovider.FieldID,
+			},
+		},
+		From:   sspq.sql,
+		Unique: true,
+	}
+	if unique := sspq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := sspq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlserviceprovider.FieldID)
+		for i := range fields {
+			if fields[i] != samlserviceprovider.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sspq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sspq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sspq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sspq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sspq *SAMLServiceProviderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sspq.driver.Dialect())
+	t1 := builder.Table(samlserviceprovider.Table)
+	columns := sspq.fields
+	if len(columns) == 0 {
+		columns = samlserviceprovider.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sspq.sql != nil {
+		selector = sspq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sspq.unique != nil && *sspq.unique {
+		selector.Distinct()
+	}
+	for _, p := range sspq.predicates {
+		p(selector)
+	}
+	for _, p := range sspq.order {
+		p(selector)
+	}
+	if offset := sspq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sspq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SAMLServiceProviderGroupBy is the group-by builder for SAMLServiceProvider entities.
+type SAMLServiceProviderGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sspgb *SAMLServiceProviderGroupBy) Aggregate(fns ...AggregateFunc) *SAMLServiceProviderGroupBy {
+	sspgb.fns = append(sspgb.fns, fns...)
+	return sspgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (sspgb *SAMLServiceProviderGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := sspgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	sspgb.sql = query
+	return sspgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := sspgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sspgb *SAMLServiceProviderGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(sspgb.fields) > 1 {
+		return nil, errors.New("db: SAMLServiceProviderGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := sspgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) StringsX(ctx context.Context) []string {
+	v, err := sspgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sspgb *SAMLServiceProviderGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = sspgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = fmt.Errorf("db: SAMLServiceProviderGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) StringX(ctx context.Context) string {
+	v, err := sspgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sspgb *SAMLServiceProviderGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(sspgb.fields) > 1 {
+		return nil, errors.New("db: SAMLServiceProviderGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := sspgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) IntsX(ctx context.Context) []int {
+	v, err := sspgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sspgb *SAMLServiceProviderGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = sspgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = fmt.Errorf("db: SAMLServiceProviderGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) IntX(ctx context.Context) int {
+	v, err := sspgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sspgb *SAMLServiceProviderGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(sspgb.fields) > 1 {
+		return nil, errors.New("db: SAMLServiceProviderGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := sspgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := sspgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sspgb *SAMLServiceProviderGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = sspgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = fmt.Errorf("db: SAMLServiceProviderGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := sspgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (sspgb *SAMLServiceProviderGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(sspgb.fields) > 1 {
+		return nil, errors.New("db: SAMLServiceProviderGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := sspgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := sspgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (sspgb *SAMLServiceProviderGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = sspgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = fmt.Errorf("db: SAMLServiceProviderGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (sspgb *SAMLServiceProviderGroupBy) BoolX(ctx context.Context) bool {
+	v, err := sspgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sspgb *SAMLServiceProviderGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range sspgb.fields {
+		if !samlserviceprovider.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := sspgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sspgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sspgb *SAMLServiceProviderGroupBy) sqlQuery() *sql.Selector {
+	selector := sspgb.sql.Select()
+	aggregation := make([]string, 0, len(sspgb.fns))
+	for _, fn := range sspgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(sspgb.fields)+len(sspgb.fns))
+		for _, f := range sspgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(sspgb.fields...)...)
+}
+
+// SAMLServiceProviderSelect is the builder for selecting fields of SAMLServiceProvider entities.
+type SAMLServiceProviderSelect struct {
+	*SAMLServiceProviderQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssps *SAMLServiceProviderSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ssps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ssps.sql = ssps.SAMLServiceProviderQuery.sqlQuery(ctx)
+	return ssps.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ssps.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ssps *SAMLServiceProviderSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ssps.fields) > 1 {
+		return nil, errors.New("db: SAMLServiceProviderSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ssps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) StringsX(ctx context.Context) []string {
+	v, err := ssps.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ssps *SAMLServiceProviderSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ssps.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = fmt.Errorf("db: SAMLServiceProviderSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) StringX(ctx context.Context) string {
+	v, err := ssps.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ssps *SAMLServiceProviderSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ssps.fields) > 1 {
+		return nil, errors.New("db: SAMLServiceProviderSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ssps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) IntsX(ctx context.Context) []int {
+	v, err := ssps.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ssps *SAMLServiceProviderSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ssps.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = fmt.Errorf("db: SAMLServiceProviderSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) IntX(ctx context.Context) int {
+	v, err := ssps.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ssps *SAMLServiceProviderSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ssps.fields) > 1 {
+		return nil, errors.New("db: SAMLServiceProviderSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ssps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ssps.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ssps *SAMLServiceProviderSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ssps.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = fmt.Errorf("db: SAMLServiceProviderSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) Float64X(ctx context.Context) float64 {
+	v, err := ssps.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ssps *SAMLServiceProviderSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ssps.fields) > 1 {
+		return nil, errors.New("db: SAMLServiceProviderSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ssps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ssps.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ssps *SAMLServiceProviderSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ssps.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{samlserviceprovider.Label}
+	default:
+		err = fmt.Errorf("db: SAMLServiceProviderSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ssps *SAMLServiceProviderSelect) BoolX(ctx context.Context) bool {
+	v, err := ssps.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ssps *SAMLServiceProviderSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ssps.sql.Query()
+	if err := ssps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,423 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/samlserviceprovider"
+)
+
+// SAMLServiceProviderUpdate is the builder for updating SAMLServiceProvider entities.
+type SAMLServiceProviderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SAMLServiceProviderMutation
+}
+
+// Where appends a list predicates to the SAMLServiceProviderUpdate builder.
+func (sspu *SAMLServiceProviderUpdate) Where(ps ...predicate.SAMLServiceProvider) *SAMLServiceProviderUpdate {
+	sspu.mutation.Where(ps...)
+	return sspu
+}
+
+// SetName sets the "name" field.
+func (sspu *SAMLServiceProviderUpdate) SetName(s string) *SAMLServiceProviderUpdate {
+	sspu.mutation.SetName(s)
+	return sspu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sspu *SAMLServiceProviderUpdate) SetNillableName(s *string) *SAMLServiceProviderUpdate {
+	if s != nil {
+		sspu.SetName(*s)
+	}
+	return sspu
+}
+
+// SetAcsUrls sets the "acs_urls" field.
+func (sspu *SAMLServiceProviderUpdate) SetAcsUrls(s []string) *SAMLServiceProviderUpdate {
+	sspu.mutation.SetAcsUrls(s)
+	return sspu
+}
+
+// ClearAcsUrls clears the value of the "acs_urls" field.
+func (sspu *SAMLServiceProviderUpdate) ClearAcsUrls() *SAMLServiceProviderUpdate {
+	sspu.mutation.ClearAcsUrls()
+	return sspu
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (sspu *SAMLServiceProviderUpdate) SetNameIDFormat(s string) *SAMLServiceProviderUpdate {
+	sspu.mutation.SetNameIDFormat(s)
+	return sspu
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (sspu *SAMLServiceProviderUpdate) SetNillableNameIDFormat(s *string) *SAMLServiceProviderUpdate {
+	if s != nil {
+		sspu.SetNameIDFormat(*s)
+	}
+	return sspu
+}
+
+// SetAttributeNames sets the "attribute_names" field.
+func (sspu *SAMLServiceProviderUpdate) SetAttributeNames(m map[string]string) *SAMLServiceProviderUpdate {
+	sspu.mutation.SetAttributeNames(m)
+	return sspu
+}
+
+// ClearAttributeNames clears the value of the "attribute_names" field.
+func (sspu *SAMLServiceProviderUpdate) ClearAttributeNames() *SAMLServiceProviderUpdate {
+	sspu.mutation.ClearAttributeNames()
+	return sspu
+}
+
+// Mutation returns the SAMLServiceProviderMutation object of the builder.
+func (sspu *SAMLServiceProviderUpdate) Mutation() *SAMLServiceProviderMutation {
+	return sspu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sspu *SAMLServiceProviderUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sspu.hooks) == 0 {
+		affected, err = sspu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SAMLServiceProviderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sspu.mutation = mutation
+			affected, err = sspu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sspu.hooks) - 1; i >= 0; i-- {
+			if sspu.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = sspu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sspu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sspu *SAMLServiceProviderUpdate) SaveX(ctx context.Context) int {
+	affected, err := sspu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sspu *SAMLServiceProviderUpdate) Exec(ctx context.Context) error {
+	_, err := sspu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspu *SAMLServiceProviderUpdate) ExecX(ctx context.Context) {
+	if err := sspu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sspu *SAMLServiceProviderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   samlserviceprovider.Table,
+			Columns: samlserviceprovider.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlserviceprovider.FieldID,
+			},
+		},
+	}
+	if ps := sspu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sspu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: samlserviceprovider.FieldName,
+		})
+	}
+	if value, ok := sspu.mutation.AcsUrls(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: samlserviceprovider.FieldAcsUrls,
+		})
+	}
+	if sspu.mutation.AcsUrlsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: samlserviceprovider.FieldAcsUrls,
+		})
+	}
+	if value, ok := sspu.mutation.NameIDFormat(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: samlserviceprovider.FieldNameIDFormat,
+		})
+	}
+	if value, ok := sspu.mutation.AttributeNames(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: samlserviceprovider.FieldAttributeNames,
+		})
+	}
+	if sspu.mutation.AttributeNamesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: samlserviceprovider.FieldAttributeNames,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sspu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlserviceprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SAMLServiceProviderUpdateOne is the builder for updating a single SAMLServiceProvider entity.
+type SAMLServiceProviderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SAMLServiceProviderMutation
+}
+
+// SetName sets the "name" field.
+func (sspuo *SAMLServiceProviderUpdateOne) SetName(s string) *SAMLServiceProviderUpdateOne {
+	sspuo.mutation.SetName(s)
+	return sspuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sspuo *SAMLServiceProviderUpdateOne) SetNillableName(s *string) *SAMLServiceProviderUpdateOne {
+	if s != nil {
+		sspuo.SetName(*s)
+	}
+	return sspuo
+}
+
+// SetAcsUrls sets the "acs_urls" field.
+func (sspuo *SAMLServiceProviderUpdateOne) SetAcsUrls(s []string) *SAMLServiceProviderUpdateOne {
+	sspuo.mutation.SetAcsUrls(s)
+	return sspuo
+}
+
+// ClearAcsUrls clears the value of the "acs_urls" field.
+func (sspuo *SAMLServiceProviderUpdateOne) ClearAcsUrls() *SAMLServiceProviderUpdateOne {
+	sspuo.mutation.ClearAcsUrls()
+	return sspuo
+}
+
+// SetNameIDFormat sets the "name_id_format" field.
+func (sspuo *SAMLServiceProviderUpdateOne) SetNameIDFormat(s string) *SAMLServiceProviderUpdateOne {
+	sspuo.mutation.SetNameIDFormat(s)
+	return sspuo
+}
+
+// SetNillableNameIDFormat sets the "name_id_format" field if the given value is not nil.
+func (sspuo *SAMLServiceProviderUpdateOne) SetNillableNameIDFormat(s *string) *SAMLServiceProviderUpdateOne {
+	if s != nil {
+		sspuo.SetNameIDFormat(*s)
+	}
+	return sspuo
+}
+
+// SetAttributeNames sets the "attribute_names" field.
+func (sspuo *SAMLServiceProviderUpdateOne) SetAttributeNames(m map[string]string) *SAMLServiceProviderUpdateOne {
+	sspuo.mutation.SetAttributeNames(m)
+	return sspuo
+}
+
+// ClearAttributeNames clears the value of the "attribute_names" field.
+func (sspuo *SAMLServiceProviderUpdateOne) ClearAttributeNames() *SAMLServiceProviderUpdateOne {
+	sspuo.mutation.ClearAttributeNames()
+	return sspuo
+}
+
+// Mutation returns the SAMLServiceProviderMutation object of the builder.
+func (sspuo *SAMLServiceProviderUpdateOne) Mutation() *SAMLServiceProviderMutation {
+	return sspuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sspuo *SAMLServiceProviderUpdateOne) Select(field string, fields ...string) *SAMLServiceProviderUpdateOne {
+	sspuo.fields = append([]string{field}, fields...)
+	return sspuo
+}
+
+// Save executes the query and returns the updated SAMLServiceProvider entity.
+func (sspuo *SAMLServiceProviderUpdateOne) Save(ctx context.Context) (*SAMLServiceProvider, error) {
+	var (
+		err  error
+		node *SAMLServiceProvider
+	)
+	if len(sspuo.hooks) == 0 {
+		node, err = sspuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SAMLServiceProviderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sspuo.mutation = mutation
+			node, err = sspuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sspuo.hooks) - 1; i >= 0; i-- {
+			if sspuo.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = sspuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sspuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sspuo *SAMLServiceProviderUpdateOne) SaveX(ctx context.Context) *SAMLServiceProvider {
+	node, err := sspuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sspuo *SAMLServiceProviderUpdateOne) Exec(ctx context.Context) error {
+	_, err := sspuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspuo *SAMLServiceProviderUpdateOne) ExecX(ctx context.Context) {
+	if err := sspuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sspuo *SAMLServiceProviderUpdateOne) sqlSave(ctx context.Context) (_node *SAMLServiceProvider, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   samlserviceprovider.Table,
+			Columns: samlserviceprovider.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: samlserviceprovider.FieldID,
+			},
+		},
+	}
+	id, ok := sspuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SAMLServiceProvider.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sspuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlserviceprovider.FieldID)
+		for _, f := range fields {
+			if !samlserviceprovider.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != samlserviceprovider.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sspuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sspuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: samlserviceprovider.FieldName,
+		})
+	}
+	if value, ok := sspuo.mutation.AcsUrls(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: samlserviceprovider.FieldAcsUrls,
+		})
+	}
+	if sspuo.mutation.AcsUrlsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: samlserviceprovider.FieldAcsUrls,
+		})
+	}
+	if value, ok := sspuo.mutation.NameIDFormat(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: samlserviceprovider.FieldNameIDFormat,
+		})
+	}
+	if value, ok := sspuo.mutation.AttributeNames(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: samlserviceprovider.FieldAttributeNames,
+		})
+	}
+	if sspuo.mutation.AttributeNamesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: samlserviceprovider.FieldAttributeNames,
+		})
+	}
+	_node = &SAMLServiceProvider{config: sspuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sspuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlserviceprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,169 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+)
+
+// TOTPEnrollment is the model entity for the TOTPEnrollment schema.
+type TOTPEnrollment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// ConnID holds the value of the "conn_id" field.
+	ConnID string `json:"conn_id,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret []byte `json:"secret,omitempty"`
+	// Confirmed holds the value of the "confirmed" field.
+	Confirmed bool `json:"confirmed,omitempty"`
+	// RecoveryCodes holds the value of the "recovery_codes" field.
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+	// LastUsedStep holds the value of the "last_used_step" field.
+	LastUsedStep int64 `json:"last_used_step,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TOTPEnrollment) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case totpenrollment.FieldSecret, totpenrollment.FieldRecoveryCodes:
+			values[i] = new([]byte)
+		case totpenrollment.FieldConfirmed:
+			values[i] = new(sql.NullBool)
+		case totpenrollment.FieldLastUsedStep:
+			values[i] = new(sql.NullInt64)
+		case totpenrollment.FieldID, totpenrollment.FieldUserID, totpenrollment.FieldConnID:
+			values[i] = new(sql.NullString)
+		case totpenrollment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type TOTPEnrollment", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TOTPEnrollment fields.
+func (te *TOTPEnrollment) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case totpenrollment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				te.ID = value.String
+			}
+		case totpenrollment.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				te.UserID = value.String
+			}
+		case totpenrollment.FieldConnID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conn_id", values[i])
+			} else if value.Valid {
+				te.ConnID = value.String
+			}
+		case totpenrollment.FieldSecret:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value != nil {
+				te.Secret = *value
+			}
+		case totpenrollment.FieldConfirmed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed", values[i])
+			} else if value.Valid {
+				te.Confirmed = value.Bool
+			}
+		case totpenrollment.FieldRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &te.RecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field recovery_codes: %w", err)
+				}
+			}
+		case totpenrollment.FieldLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_step", values[i])
+			} else if value.Valid {
+				te.LastUsedStep = value.Int64
+			}
+		case totpenrollment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				te.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this TOTPEnrollment.
+// Note that you need to call TOTPEnrollment.Unwrap() before calling this method if this TOTPEnrollment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (te *TOTPEnrollment) Update() *TOTPEnrollmentUpdateOne {
+	return (&TOTPEnrollmentClient{config: te.config}).UpdateOne(te)
+}
+
+// Unwrap unwraps the TOTPEnrollment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (te *TOTPEnrollment) Unwrap() *TOTPEnrollment {
+	tx, ok := te.config.driver.(*txDriver)
+	if !ok {
+		panic("db: TOTPEnrollment is not a transactional entity")
+	}
+	te.config.driver = tx.drv
+	return te
+}
+
+// String implements the fmt.Stringer.
+func (te *TOTPEnrollment) String() string {
+	var builder strings.Builder
+	builder.WriteString("TOTPEnrollment(")
+	builder.WriteString(fmt.Sprintf("id=%v", te.ID))
+	builder.WriteString(", user_id=")
+	builder.WriteString(te.UserID)
+	builder.WriteString(", conn_id=")
+	builder.WriteString(te.ConnID)
+	builder.WriteString(", secret=")
+	builder.WriteString(fmt.Sprintf("%v", te.Secret))
+	builder.WriteString(", confirmed=")
+	builder.WriteString(fmt.Sprintf("%v", te.Confirmed))
+	builder.WriteString(", recovery_codes=")
+	builder.WriteString(fmt.Sprintf("%v", te.RecoveryCodes))
+	builder.WriteString(", last_used_step=")
+	builder.WriteString(fmt.Sprintf("%v", te.LastUsedStep))
+	builder.WriteString(", created_at=")
+	builder.WriteString(te.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TOTPEnrollments is a parsable slice of TOTPEnrollment.
+type TOTPEnrollments []*TOTPEnrollment
+
+func (te TOTPEnrollments) config(cfg config) {
+	for _i := range te {
+		te[_i].config = cfg
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by entc, DO NOT EDIT.
+
+package totpenrollment
+
+const (
+	// Label holds the string label denoting the totpenrollment type in the database.
+	Label = "totp_enrollment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldConnID holds the string denoting the conn_id field in the database.
+	FieldConnID = "conn_id"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// FieldConfirmed holds the string denoting the confirmed field in the database.
+	FieldConfirmed = "confirmed"
+	// FieldRecoveryCodes holds the string denoting the recovery_codes field in the database.
+	FieldRecoveryCodes = "recovery_codes"
+	// FieldLastUsedStep holds the string denoting the last_used_step field in the database.
+	FieldLastUsedStep = "last_used_step"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the totpenrollment in the database.
+	Table = "totp_enrollments"
+)
+
+// Columns holds all SQL columns for totpenrollment fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldConnID,
+	FieldSecret,
+	FieldConfirmed,
+	FieldRecoveryCodes,
+	FieldLastUsedStep,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// ConnIDValidator is a validator for the "conn_id" field. It is called by the builders before save.
+	ConnIDValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
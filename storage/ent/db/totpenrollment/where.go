@@ -0,0 +1,645 @@
+// Code generated by entc, DO NOT EDIT.
+
+package totpenrollment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// ConnID applies equality check predicate on the "conn_id" field. It's identical to ConnIDEQ.
+func ConnID(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConnID), v))
+	})
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v []byte) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecret), v))
+	})
+}
+
+// Confirmed applies equality check predicate on the "confirmed" field. It's identical to ConfirmedEQ.
+func Confirmed(v bool) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConfirmed), v))
+	})
+}
+
+// LastUsedStep applies equality check predicate on the "last_used_step" field. It's identical to LastUsedStepEQ.
+func LastUsedStep(v int64) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedStep), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUserID), v))
+	})
+}
+
+// ConnIDEQ applies the EQ predicate on the "conn_id" field.
+func ConnIDEQ(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDNEQ applies the NEQ predicate on the "conn_id" field.
+func ConnIDNEQ(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDIn applies the In predicate on the "conn_id" field.
+func ConnIDIn(vs ...string) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConnID), v...))
+	})
+}
+
+// ConnIDNotIn applies the NotIn predicate on the "conn_id" field.
+func ConnIDNotIn(vs ...string) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConnID), v...))
+	})
+}
+
+// ConnIDGT applies the GT predicate on the "conn_id" field.
+func ConnIDGT(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDGTE applies the GTE predicate on the "conn_id" field.
+func ConnIDGTE(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDLT applies the LT predicate on the "conn_id" field.
+func ConnIDLT(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDLTE applies the LTE predicate on the "conn_id" field.
+func ConnIDLTE(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDContains applies the Contains predicate on the "conn_id" field.
+func ConnIDContains(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDHasPrefix applies the HasPrefix predicate on the "conn_id" field.
+func ConnIDHasPrefix(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDHasSuffix applies the HasSuffix predicate on the "conn_id" field.
+func ConnIDHasSuffix(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDEqualFold applies the EqualFold predicate on the "conn_id" field.
+func ConnIDEqualFold(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDContainsFold applies the ContainsFold predicate on the "conn_id" field.
+func ConnIDContainsFold(v string) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldConnID), v))
+	})
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v []byte) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSecret), v))
+	})
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v []byte) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSecret), v))
+	})
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...[]byte) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSecret), v...))
+	})
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...[]byte) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSecret), v...))
+	})
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v []byte) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSecret), v))
+	})
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v []byte) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSecret), v))
+	})
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v []byte) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSecret), v))
+	})
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v []byte) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSecret), v))
+	})
+}
+
+// ConfirmedEQ applies the EQ predicate on the "confirmed" field.
+func ConfirmedEQ(v bool) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConfirmed), v))
+	})
+}
+
+// ConfirmedNEQ applies the NEQ predicate on the "confirmed" field.
+func ConfirmedNEQ(v bool) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConfirmed), v))
+	})
+}
+
+// RecoveryCodesIsNil applies the IsNil predicate on the "recovery_codes" field.
+func RecoveryCodesIsNil() predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRecoveryCodes)))
+	})
+}
+
+// RecoveryCodesNotNil applies the NotNil predicate on the "recovery_codes" field.
+func RecoveryCodesNotNil() predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRecoveryCodes)))
+	})
+}
+
+// LastUsedStepEQ applies the EQ predicate on the "last_used_step" field.
+func LastUsedStepEQ(v int64) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedStep), v))
+	})
+}
+
+// LastUsedStepNEQ applies the NEQ predicate on the "last_used_step" field.
+func LastUsedStepNEQ(v int64) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastUsedStep), v))
+	})
+}
+
+// LastUsedStepIn applies the In predicate on the "last_used_step" field.
+func LastUsedStepIn(vs ...int64) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastUsedStep), v...))
+	})
+}
+
+// LastUsedStepNotIn applies the NotIn predicate on the "last_used_step" field.
+func LastUsedStepNotIn(vs ...int64) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastUsedStep), v...))
+	})
+}
+
+// LastUsedStepGT applies the GT predicate on the "last_used_step" field.
+func LastUsedStepGT(v int64) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastUsedStep), v))
+	})
+}
+
+// LastUsedStepGTE applies the GTE predicate on the "last_used_step" field.
+func LastUsedStepGTE(v int64) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastUsedStep), v))
+	})
+}
+
+// LastUsedStepLT applies the LT predicate on the "last_used_step" field.
+func LastUsedStepLT(v int64) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastUsedStep), v))
+	})
+}
+
+// LastUsedStepLTE applies the LTE predicate on the "last_used_step" field.
+func LastUsedStepLTE(v int64) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastUsedStep), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TOTPEnrollment {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TOTPEnrollment) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TOTPEnrollment) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TOTPEnrollment) predicate.TOTPEnrollment {
+	return predicate.TOTPEnrollment(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
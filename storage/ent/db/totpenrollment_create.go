@@ -0,0 +1,346 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+)
+
+// TOTPEnrollmentCreate is the builder for creating a TOTPEnrollment entity.
+type TOTPEnrollmentCreate struct {
+	config
+	mutation *TOTPEnrollmentMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (tec *TOTPEnrollmentCreate) SetUserID(s string) *TOTPEnrollmentCreate {
+	tec.mutation.SetUserID(s)
+	return tec
+}
+
+// SetConnID sets the "conn_id" field.
+func (tec *TOTPEnrollmentCreate) SetConnID(s string) *TOTPEnrollmentCreate {
+	tec.mutation.SetConnID(s)
+	return tec
+}
+
+// SetSecret sets the "secret" field.
+func (tec *TOTPEnrollmentCreate) SetSecret(b []byte) *TOTPEnrollmentCreate {
+	tec.mutation.SetSecret(b)
+	return tec
+}
+
+// SetConfirmed sets the "confirmed" field.
+func (tec *TOTPEnrollmentCreate) SetConfirmed(b bool) *TOTPEnrollmentCreate {
+	tec.mutation.SetConfirmed(b)
+	return tec
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (tec *TOTPEnrollmentCreate) SetRecoveryCodes(s []string) *TOTPEnrollmentCreate {
+	tec.mutation.SetRecoveryCodes(s)
+	return tec
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (tec *TOTPEnrollmentCreate) SetLastUsedStep(i int64) *TOTPEnrollmentCreate {
+	tec.mutation.SetLastUsedStep(i)
+	return tec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tec *TOTPEnrollmentCreate) SetCreatedAt(t time.Time) *TOTPEnrollmentCreate {
+	tec.mutation.SetCreatedAt(t)
+	return tec
+}
+
+// SetID sets the "id" field.
+func (tec *TOTPEnrollmentCreate) SetID(s string) *TOTPEnrollmentCreate {
+	tec.mutation.SetID(s)
+	return tec
+}
+
+// Mutation returns the TOTPEnrollmentMutation object of the builder.
+func (tec *TOTPEnrollmentCreate) Mutation() *TOTPEnrollmentMutation {
+	return tec.mutation
+}
+
+// Save creates the TOTPEnrollment in the database.
+func (tec *TOTPEnrollmentCreate) Save(ctx context.Context) (*TOTPEnrollment, error) {
+	var (
+		err  error
+		node *TOTPEnrollment
+	)
+	if len(tec.hooks) == 0 {
+		if err = tec.check(); err != nil {
+			return nil, err
+		}
+		node, err = tec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TOTPEnrollmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tec.check(); err != nil {
+				return nil, err
+			}
+			tec.mutation = mutation
+			if node, err = tec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tec.hooks) - 1; i >= 0; i-- {
+			if tec.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = tec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tec *TOTPEnrollmentCreate) SaveX(ctx context.Context) *TOTPEnrollment {
+	v, err := tec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tec *TOTPEnrollmentCreate) Exec(ctx context.Context) error {
+	_, err := tec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tec *TOTPEnrollmentCreate) ExecX(ctx context.Context) {
+	if err := tec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tec *TOTPEnrollmentCreate) check() error {
+	if _, ok := tec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`db: missing required field "TOTPEnrollment.user_id"`)}
+	}
+	if v, ok := tec.mutation.UserID(); ok {
+		if err := totpenrollment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`db: validator failed for field "TOTPEnrollment.user_id": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.ConnID(); !ok {
+		return &ValidationError{Name: "conn_id", err: errors.New(`db: missing required field "TOTPEnrollment.conn_id"`)}
+	}
+	if v, ok := tec.mutation.ConnID(); ok {
+		if err := totpenrollment.ConnIDValidator(v); err != nil {
+			return &ValidationError{Name: "conn_id", err: fmt.Errorf(`db: validator failed for field "TOTPEnrollment.conn_id": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`db: missing required field "TOTPEnrollment.secret"`)}
+	}
+	if _, ok := tec.mutation.Confirmed(); !ok {
+		return &ValidationError{Name: "confirmed", err: errors.New(`db: missing required field "TOTPEnrollment.confirmed"`)}
+	}
+	if _, ok := tec.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`db: missing required field "TOTPEnrollment.last_used_step"`)}
+	}
+	if _, ok := tec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "TOTPEnrollment.created_at"`)}
+	}
+	if v, ok := tec.mutation.ID(); ok {
+		if err := totpenrollment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "TOTPEnrollment.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tec *TOTPEnrollmentCreate) sqlSave(ctx context.Context) (*TOTPEnrollment, error) {
+	_node, _spec := tec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TOTPEnrollment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (tec *TOTPEnrollmentCreate) createSpec() (*TOTPEnrollment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TOTPEnrollment{config: tec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: totpenrollment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: totpenrollment.FieldID,
+			},
+		}
+	)
+	if id, ok := tec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tec.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: totpenrollment.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := tec.mutation.ConnID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: totpenrollment.FieldConnID,
+		})
+		_node.ConnID = value
+	}
+	if value, ok := tec.mutation.Secret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: totpenrollment.FieldSecret,
+		})
+		_node.Secret = value
+	}
+	if value, ok := tec.mutation.Confirmed(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: totpenrollment.FieldConfirmed,
+		})
+		_node.Confirmed = value
+	}
+	if value, ok := tec.mutation.RecoveryCodes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: totpenrollment.FieldRecoveryCodes,
+		})
+		_node.RecoveryCodes = value
+	}
+	if value, ok := tec.mutation.LastUsedStep(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: totpenrollment.FieldLastUsedStep,
+		})
+		_node.LastUsedStep = value
+	}
+	if value, ok := tec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: totpenrollment.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TOTPEnrollmentCreateBulk is the builder for creating many TOTPEnrollment entities in bulk.
+type TOTPEnrollmentCreateBulk struct {
+	config
+	builders []*TOTPEnrollmentCreate
+}
+
+// Save creates the TOTPEnrollment entities in the database.
+func (tecb *TOTPEnrollmentCreateBulk) Save(ctx context.Context) ([]*TOTPEnrollment, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tecb.builders))
+	nodes := make([]*TOTPEnrollment, len(tecb.builders))
+	mutators := make([]Mutator, len(tecb.builders))
+	for i := range tecb.builders {
+		func(i int, root context.Context) {
+			builder := tecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TOTPEnrollmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tecb *TOTPEnrollmentCreateBulk) SaveX(ctx context.Context) []*TOTPEnrollment {
+	v, err := tecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tecb *TOTPEnrollmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := tecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tecb *TOTPEnrollmentCreateBulk) ExecX(ctx context.Context) {
+	if err := tecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
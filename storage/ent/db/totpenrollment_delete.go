@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+)
+
+// TOTPEnrollmentDelete is the builder for deleting a TOTPEnrollment entity.
+type TOTPEnrollmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *TOTPEnrollmentMutation
+}
+
+// Where appends a list predicates to the TOTPEnrollmentDelete builder.
+func (ted *TOTPEnrollmentDelete) Where(ps ...predicate.TOTPEnrollment) *TOTPEnrollmentDelete {
+	ted.mutation.Where(ps...)
+	return ted
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ted *TOTPEnrollmentDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ted.hooks) == 0 {
+		affected, err = ted.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TOTPEnrollmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ted.mutation = mutation
+			affected, err = ted.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ted.hooks) - 1; i >= 0; i-- {
+			if ted.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = ted.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ted.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ted *TOTPEnrollmentDelete) ExecX(ctx context.Context) int {
+	n, err := ted.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ted *TOTPEnrollmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: totpenrollment.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: totpenrollment.FieldID,
+			},
+		},
+	}
+	if ps := ted.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, ted.driver, _spec)
+}
+
+// TOTPEnrollmentDeleteOne is the builder for deleting a single TOTPEnrollment entity.
+type TOTPEnrollmentDeleteOne struct {
+	ted *TOTPEnrollmentDelete
+}
+
+// Exec executes the deletion query.
+func (tedo *TOTPEnrollmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := tedo.ted.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{totpenrollment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tedo *TOTPEnrollmentDeleteOne) ExecX(ctx context.Context) {
+	tedo.ted.ExecX(ctx)
+}
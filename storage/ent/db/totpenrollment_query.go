@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+)
+
+// TOTPEnrollmentQuery is the builder for querying TOTPEnrollment entities.
+type TOTPEnrollmentQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.TOTPEnrollment
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TOTPEnrollmentQuery builder.
+func (teq *TOTPEnrollmentQuery) Where(ps ...predicate.TOTPEnrollment) *TOTPEnrollmentQuery {
+	teq.predicates = append(teq.predicates, ps...)
+	return teq
+}
+
+// Limit adds a limit step to the query.
+func (teq *TOTPEnrollmentQuery) Limit(limit int) *TOTPEnrollmentQuery {
+	teq.limit = &limit
+	return teq
+}
+
+// Offset adds an offset step to the query.
+func (teq *TOTPEnrollmentQuery) Offset(offset int) *TOTPEnrollmentQuery {
+	teq.offset = &offset
+	return teq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (teq *TOTPEnrollmentQuery) Unique(unique bool) *TOTPEnrollmentQuery {
+	teq.unique = &unique
+	return teq
+}
+
+// Order adds an order step to the query.
+func (teq *TOTPEnrollmentQuery) Order(o ...OrderFunc) *TOTPEnrollmentQuery {
+	teq.order = append(teq.order, o...)
+	return teq
+}
+
+// First returns the first TOTPEnrollment entity from the query.
+// Returns a *NotFoundError when no TOTPEnrollment was found.
+func (teq *TOTPEnrollmentQuery) First(ctx context.Context) (*TOTPEnrollment, error) {
+	nodes, err := teq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{totpenrollment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (teq *TOTPEnrollmentQuery) FirstX(ctx context.Context) *TOTPEnrollment {
+	node, err := teq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TOTPEnrollment ID from the query.
+// Returns a *NotFoundError when no TOTPEnrollment ID was found.
+func (teq *TOTPEnrollmentQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = teq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{totpenrollment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (teq *TOTPEnrollmentQuery) FirstIDX(ctx context.Context) string {
+	id, err := teq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TOTPEnrollment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TOTPEnrollment entity is found.
+// Returns a *NotFoundError when no TOTPEnrollment entities are found.
+func (teq *TOTPEnrollmentQuery) Only(ctx context.Context) (*TOTPEnrollment, error) {
+	nodes, err := teq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{totpenrollment.Label}
+	default:
+		return nil, &NotSingularError{totpenrollment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (teq *TOTPEnrollmentQuery) OnlyX(ctx context.Context) *TOTPEnrollment {
+	node, err := teq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TOTPEnrollment ID in the query.
+// Returns a *NotSingularError when more than one TOTPEnrollment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (teq *TOTPEnrollmentQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = teq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = &NotSingularError{totpenrollment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (teq *TOTPEnrollmentQuery) OnlyIDX(ctx context.Context) string {
+	id, err := teq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TOTPEnrollments.
+func (teq *TOTPEnrollmentQuery) All(ctx context.Context) ([]*TOTPEnrollment, error) {
+	if err := teq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return teq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (teq *TOTPEnrollmentQuery) AllX(ctx context.Context) []*TOTPEnrollment {
+	nodes, err := teq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TOTPEnrollment IDs.
+func (teq *TOTPEnrollmentQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := teq.Select(totpenrollment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (teq *TOTPEnrollmentQuery) IDsX(ctx context.Context) []string {
+	ids, err := teq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (teq *TOTPEnrollmentQuery) Count(ctx context.Context) (int, error) {
+	if err := teq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return teq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (teq *TOTPEnrollmentQuery) CountX(ctx context.Context) int {
+	count, err := teq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (teq *TOTPEnrollmentQuery) Exist(ctx context.Context) (bool, error) {
+	if err := teq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return teq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (teq *TOTPEnrollmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := teq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TOTPEnrollmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (teq *TOTPEnrollmentQuery) Clone() *TOTPEnrollmentQuery {
+	if teq == nil {
+		return nil
+	}
+	return &TOTPEnrollmentQuery{
+		config:     teq.config,
+		limit:      teq.limit,
+		offset:     teq.offset,
+		order:      append([]OrderFunc{}, teq.order...),
+		predicates: append([]predicate.TOTPEnrollment{}, teq.predicates...),
+		// clone intermediate query.
+		sql:    teq.sql.Clone(),
+		path:   teq.path,
+		unique: teq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TOTPEnrollment.Query().
+//		GroupBy(totpenrollment.FieldUserID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+//
+func (teq *TOTPEnrollmentQuery) GroupBy(field string, fields ...string) *TOTPEnrollmentGroupBy {
+	group := &TOTPEnrollmentGroupBy{config: teq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := teq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return teq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.TOTPEnrollment.Query().
+//		Select(totpenrollment.FieldUserID).
+//		Scan(ctx, &v)
+//
+func (teq *TOTPEnrollmentQuery) Select(fields ...string) *TOTPEnrollmentSelect {
+	teq.fields = append(teq.fields, fields...)
+	return &TOTPEnrollmentSelect{TOTPEnrollmentQuery: teq}
+}
+
+func (teq *TOTPEnrollmentQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range teq.fields {
+		if !totpenrollment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if teq.path != nil {
+		prev, err := teq.path(ctx)
+		if err != nil {
+			return err
+		}
+		teq.sql = prev
+	}
+	return nil
+}
+
+func (teq *TOTPEnrollmentQuery) sqlAll(ctx context.Context) ([]*TOTPEnrollment, error) {
+	var (
+		nodes = []*TOTPEnrollment{}
+		_spec = teq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &TOTPEnrollment{config: teq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, teq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (teq *TOTPEnrollmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := teq.querySpec()
+	_spec.Node.Columns = teq.fields
+	if len(teq.fields) > 0 {
+		_spec.Unique = teq.unique != nil && *teq.unique
+	}
+	return sqlgraph.CountNodes(ctx, teq.driver, _spec)
+}
+
+func (teq *TOTPEnrollmentQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := teq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (teq *TOTPEnrollmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   totpenrollment.Table,
+			Columns: totpenrollment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: totpenrollment.FieldID,
+			},
+		},
+		From:   teq.sql,
+		Unique: true,
+	}
+	if unique := teq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := teq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, totpenrollment.FieldID)
+		for i := range fields {
+			if fields[i] != totpenrollment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := teq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := teq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := teq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := teq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (teq *TOTPEnrollmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(teq.driver.Dialect())
+	t1 := builder.Table(totpenrollment.Table)
+	columns := teq.fields
+	if len(columns) == 0 {
+		columns = totpenrollment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if teq.sql != nil {
+		selector = teq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if teq.unique != nil && *teq.unique {
+		selector.Distinct()
+	}
+	for _, p := range teq.predicates {
+		p(selector)
+	}
+	for _, p := range teq.order {
+		p(selector)
+	}
+	if offset := teq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := teq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TOTPEnrollmentGroupBy is the group-by builder for TOTPEnrollment entities.
+type TOTPEnrollmentGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tegb *TOTPEnrollmentGroupBy) Aggregate(fns ...AggregateFunc) *TOTPEnrollmentGroupBy {
+	tegb.fns = append(tegb.fns, fns...)
+	return tegb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (tegb *TOTPEnrollmentGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := tegb.path(ctx)
+	if err != nil {
+		return err
+	}
+	tegb.sql = query
+	return tegb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := tegb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TOTPEnrollmentGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(tegb.fields) > 1 {
+		return nil, errors.New("db: TOTPEnrollmentGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := tegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) StringsX(ctx context.Context) []string {
+	v, err := tegb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TOTPEnrollmentGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tegb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = fmt.Errorf("db: TOTPEnrollmentGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) StringX(ctx context.Context) string {
+	v, err := tegb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TOTPEnrollmentGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(tegb.fields) > 1 {
+		return nil, errors.New("db: TOTPEnrollmentGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := tegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) IntsX(ctx context.Context) []int {
+	v, err := tegb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TOTPEnrollmentGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tegb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = fmt.Errorf("db: TOTPEnrollmentGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) IntX(ctx context.Context) int {
+	v, err := tegb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TOTPEnrollmentGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tegb.fields) > 1 {
+		return nil, errors.New("db: TOTPEnrollmentGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := tegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := tegb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TOTPEnrollmentGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tegb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = fmt.Errorf("db: TOTPEnrollmentGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := tegb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TOTPEnrollmentGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(tegb.fields) > 1 {
+		return nil, errors.New("db: TOTPEnrollmentGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := tegb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := tegb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tegb *TOTPEnrollmentGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tegb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = fmt.Errorf("db: TOTPEnrollmentGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tegb *TOTPEnrollmentGroupBy) BoolX(ctx context.Context) bool {
+	v, err := tegb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tegb *TOTPEnrollmentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range tegb.fields {
+		if !totpenrollment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := tegb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tegb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (tegb *TOTPEnrollmentGroupBy) sqlQuery() *sql.Selector {
+	selector := tegb.sql.Select()
+	aggregation := make([]string, 0, len(tegb.fns))
+	for _, fn := range tegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(tegb.fields)+len(tegb.fns))
+		for _, f := range tegb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(tegb.fields...)...)
+}
+
+// TOTPEnrollmentSelect is the builder for selecting fields of TOTPEnrollment entities.
+type TOTPEnrollmentSelect struct {
+	*TOTPEnrollmentQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tes *TOTPEnrollmentSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := tes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	tes.sql = tes.TOTPEnrollmentQuery.sqlQuery(ctx)
+	return tes.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := tes.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (tes *TOTPEnrollmentSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(tes.fields) > 1 {
+		return nil, errors.New("db: TOTPEnrollmentSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := tes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) StringsX(ctx context.Context) []string {
+	v, err := tes.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (tes *TOTPEnrollmentSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tes.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = fmt.Errorf("db: TOTPEnrollmentSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) StringX(ctx context.Context) string {
+	v, err := tes.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (tes *TOTPEnrollmentSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(tes.fields) > 1 {
+		return nil, errors.New("db: TOTPEnrollmentSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := tes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) IntsX(ctx context.Context) []int {
+	v, err := tes.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (tes *TOTPEnrollmentSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tes.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = fmt.Errorf("db: TOTPEnrollmentSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) IntX(ctx context.Context) int {
+	v, err := tes.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (tes *TOTPEnrollmentSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tes.fields) > 1 {
+		return nil, errors.New("db: TOTPEnrollmentSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := tes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := tes.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (tes *TOTPEnrollmentSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tes.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = fmt.Errorf("db: TOTPEnrollmentSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) Float64X(ctx context.Context) float64 {
+	v, err := tes.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (tes *TOTPEnrollmentSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(tes.fields) > 1 {
+		return nil, errors.New("db: TOTPEnrollmentSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := tes.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) BoolsX(ctx context.Context) []bool {
+	v, err := tes.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (tes *TOTPEnrollmentSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tes.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{totpenrollment.Label}
+	default:
+		err = fmt.Errorf("db: TOTPEnrollmentSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tes *TOTPEnrollmentSelect) BoolX(ctx context.Context) bool {
+	v, err := tes.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tes *TOTPEnrollmentSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := tes.sql.Query()
+	if err := tes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,516 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/totpenrollment"
+)
+
+// TOTPEnrollmentUpdate is the builder for updating TOTPEnrollment entities.
+type TOTPEnrollmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TOTPEnrollmentMutation
+}
+
+// Where appends a list predicates to the TOTPEnrollmentUpdate builder.
+func (teu *TOTPEnrollmentUpdate) Where(ps ...predicate.TOTPEnrollment) *TOTPEnrollmentUpdate {
+	teu.mutation.Where(ps...)
+	return teu
+}
+
+// SetUserID sets the "user_id" field.
+func (teu *TOTPEnrollmentUpdate) SetUserID(s string) *TOTPEnrollmentUpdate {
+	teu.mutation.SetUserID(s)
+	return teu
+}
+
+// SetConnID sets the "conn_id" field.
+func (teu *TOTPEnrollmentUpdate) SetConnID(s string) *TOTPEnrollmentUpdate {
+	teu.mutation.SetConnID(s)
+	return teu
+}
+
+// SetSecret sets the "secret" field.
+func (teu *TOTPEnrollmentUpdate) SetSecret(b []byte) *TOTPEnrollmentUpdate {
+	teu.mutation.SetSecret(b)
+	return teu
+}
+
+// SetConfirmed sets the "confirmed" field.
+func (teu *TOTPEnrollmentUpdate) SetConfirmed(b bool) *TOTPEnrollmentUpdate {
+	teu.mutation.SetConfirmed(b)
+	return teu
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (teu *TOTPEnrollmentUpdate) SetRecoveryCodes(s []string) *TOTPEnrollmentUpdate {
+	teu.mutation.SetRecoveryCodes(s)
+	return teu
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (teu *TOTPEnrollmentUpdate) ClearRecoveryCodes() *TOTPEnrollmentUpdate {
+	teu.mutation.ClearRecoveryCodes()
+	return teu
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (teu *TOTPEnrollmentUpdate) SetLastUsedStep(i int64) *TOTPEnrollmentUpdate {
+	teu.mutation.ResetLastUsedStep()
+	teu.mutation.SetLastUsedStep(i)
+	return teu
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (teu *TOTPEnrollmentUpdate) AddLastUsedStep(i int64) *TOTPEnrollmentUpdate {
+	teu.mutation.AddLastUsedStep(i)
+	return teu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (teu *TOTPEnrollmentUpdate) SetCreatedAt(t time.Time) *TOTPEnrollmentUpdate {
+	teu.mutation.SetCreatedAt(t)
+	return teu
+}
+
+// Mutation returns the TOTPEnrollmentMutation object of the builder.
+func (teu *TOTPEnrollmentUpdate) Mutation() *TOTPEnrollmentMutation {
+	return teu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (teu *TOTPEnrollmentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(teu.hooks) == 0 {
+		if err = teu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = teu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TOTPEnrollmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = teu.check(); err != nil {
+				return 0, err
+			}
+			teu.mutation = mutation
+			affected, err = teu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(teu.hooks) - 1; i >= 0; i-- {
+			if teu.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = teu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, teu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teu *TOTPEnrollmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := teu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (teu *TOTPEnrollmentUpdate) Exec(ctx context.Context) error {
+	_, err := teu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teu *TOTPEnrollmentUpdate) ExecX(ctx context.Context) {
+	if err := teu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teu *TOTPEnrollmentUpdate) check() error {
+	if v, ok := teu.mutation.UserID(); ok {
+		if err := totpenrollment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`db: validator failed for field "TOTPEnrollment.user_id": %w`, err)}
+		}
+	}
+	if v, ok := teu.mutation.ConnID(); ok {
+		if err := totpenrollment.ConnIDValidator(v); err != nil {
+			return &ValidationError{Name: "conn_id", err: fmt.Errorf(`db: validator failed for field "TOTPEnrollment.conn_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (teu *TOTPEnrollmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   totpenrollment.Table,
+			Columns: totpenrollment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: totpenrollment.FieldID,
+			},
+		},
+	}
+	if ps := teu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: totpenrollment.FieldUserID,
+		})
+	}
+	if value, ok := teu.mutation.ConnID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: totpenrollment.FieldConnID,
+		})
+	}
+	if value, ok := teu.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: totpenrollment.FieldSecret,
+		})
+	}
+	if value, ok := teu.mutation.Confirmed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: totpenrollment.FieldConfirmed,
+		})
+	}
+	if value, ok := teu.mutation.RecoveryCodes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: totpenrollment.FieldRecoveryCodes,
+		})
+	}
+	if teu.mutation.RecoveryCodesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: totpenrollment.FieldRecoveryCodes,
+		})
+	}
+	if value, ok := teu.mutation.LastUsedStep(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: totpenrollment.FieldLastUsedStep,
+		})
+	}
+	if value, ok := teu.mutation.AddedLastUsedStep(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: totpenrollment.FieldLastUsedStep,
+		})
+	}
+	if value, ok := teu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: totpenrollment.FieldCreatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, teu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{totpenrollment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TOTPEnrollmentUpdateOne is the builder for updating a single TOTPEnrollment entity.
+type TOTPEnrollmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TOTPEnrollmentMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (teuo *TOTPEnrollmentUpdateOne) SetUserID(s string) *TOTPEnrollmentUpdateOne {
+	teuo.mutation.SetUserID(s)
+	return teuo
+}
+
+// SetConnID sets the "conn_id" field.
+func (teuo *TOTPEnrollmentUpdateOne) SetConnID(s string) *TOTPEnrollmentUpdateOne {
+	teuo.mutation.SetConnID(s)
+	return teuo
+}
+
+// SetSecret sets the "secret" field.
+func (teuo *TOTPEnrollmentUpdateOne) SetSecret(b []byte) *TOTPEnrollmentUpdateOne {
+	teuo.mutation.SetSecret(b)
+	return teuo
+}
+
+// SetConfirmed sets the "confirmed" field.
+func (teuo *TOTPEnrollmentUpdateOne) SetConfirmed(b bool) *TOTPEnrollmentUpdateOne {
+	teuo.mutation.SetConfirmed(b)
+	return teuo
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (teuo *TOTPEnrollmentUpdateOne) SetRecoveryCodes(s []string) *TOTPEnrollmentUpdateOne {
+	teuo.mutation.SetRecoveryCodes(s)
+	return teuo
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (teuo *TOTPEnrollmentUpdateOne) ClearRecoveryCodes() *TOTPEnrollmentUpdateOne {
+	teuo.mutation.ClearRecoveryCodes()
+	return teuo
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (teuo *TOTPEnrollmentUpdateOne) SetLastUsedStep(i int64) *TOTPEnrollmentUpdateOne {
+	teuo.mutation.ResetLastUsedStep()
+	teuo.mutation.SetLastUsedStep(i)
+	return teuo
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (teuo *TOTPEnrollmentUpdateOne) AddLastUsedStep(i int64) *TOTPEnrollmentUpdateOne {
+	teuo.mutation.AddLastUsedStep(i)
+	return teuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (teuo *TOTPEnrollmentUpdateOne) SetCreatedAt(t time.Time) *TOTPEnrollmentUpdateOne {
+	teuo.mutation.SetCreatedAt(t)
+	return teuo
+}
+
+// Mutation returns the TOTPEnrollmentMutation object of the builder.
+func (teuo *TOTPEnrollmentUpdateOne) Mutation() *TOTPEnrollmentMutation {
+	return teuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (teuo *TOTPEnrollmentUpdateOne) Select(field string, fields ...string) *TOTPEnrollmentUpdateOne {
+	teuo.fields = append([]string{field}, fields...)
+	return teuo
+}
+
+// Save executes the query and returns the updated TOTPEnrollment entity.
+func (teuo *TOTPEnrollmentUpdateOne) Save(ctx context.Context) (*TOTPEnrollment, error) {
+	var (
+		err  error
+		node *TOTPEnrollment
+	)
+	if len(teuo.hooks) == 0 {
+		if err = teuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = teuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TOTPEnrollmentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = teuo.check(); err != nil {
+				return nil, err
+			}
+			teuo.mutation = mutation
+			node, err = teuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(teuo.hooks) - 1; i >= 0; i-- {
+			if teuo.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = teuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, teuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teuo *TOTPEnrollmentUpdateOne) SaveX(ctx context.Context) *TOTPEnrollment {
+	node, err := teuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (teuo *TOTPEnrollmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := teuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teuo *TOTPEnrollmentUpdateOne) ExecX(ctx context.Context) {
+	if err := teuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teuo *TOTPEnrollmentUpdateOne) check() error {
+	if v, ok := teuo.mutation.UserID(); ok {
+		if err := totpenrollment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`db: validator failed for field "TOTPEnrollment.user_id": %w`, err)}
+		}
+	}
+	if v, ok := teuo.mutation.ConnID(); ok {
+		if err := totpenrollment.ConnIDValidator(v); err != nil {
+			return &ValidationError{Name: "conn_id", err: fmt.Errorf(`db: validator failed for field "TOTPEnrollment.conn_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (teuo *TOTPEnrollmentUpdateOne) sqlSave(ctx context.Context) (_node *TOTPEnrollment, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   totpenrollment.Table,
+			Columns: totpenrollment.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: totpenrollment.FieldID,
+			},
+		},
+	}
+	id, ok := teuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "TOTPEnrollment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := teuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, totpenrollment.FieldID)
+		for _, f := range fields {
+			if !totpenrollment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != totpenrollment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := teuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: totpenrollment.FieldUserID,
+		})
+	}
+	if value, ok := teuo.mutation.ConnID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: totpenrollment.FieldConnID,
+		})
+	}
+	if value, ok := teuo.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: totpenrollment.FieldSecret,
+		})
+	}
+	if value, ok := teuo.mutation.Confirmed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: totpenrollment.FieldConfirmed,
+		})
+	}
+	if value, ok := teuo.mutation.RecoveryCodes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: totpenrollment.FieldRecoveryCodes,
+		})
+	}
+	if teuo.mutation.RecoveryCodesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: totpenrollment.FieldRecoveryCodes,
+		})
+	}
+	if value, ok := teuo.mutation.LastUsedStep(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: totpenrollment.FieldLastUsedStep,
+		})
+	}
+	if value, ok := teuo.mutation.AddedLastUsedStep(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: totpenrollment.FieldLastUsedStep,
+		})
+	}
+	if value, ok := teuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: totpenrollment.FieldCreatedAt,
+		})
+	}
+	_node = &TOTPEnrollment{config: teuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, teuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{totpenrollment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -16,22 +16,40 @@ type Tx struct {
 	AuthCode *AuthCodeClient
 	// AuthRequest is the client for interacting with the AuthRequest builders.
 	AuthRequest *AuthRequestClient
+	// BackchannelAuthRequest is the client for interacting with the BackchannelAuthRequest builders.
+	BackchannelAuthRequest *BackchannelAuthRequestClient
 	// Connector is the client for interacting with the Connector builders.
 	Connector *ConnectorClient
 	// DeviceRequest is the client for interacting with the DeviceRequest builders.
 	DeviceRequest *DeviceRequestClient
 	// DeviceToken is the client for interacting with the DeviceToken builders.
 	DeviceToken *DeviceTokenClient
+	// EmailVerification is the client for interacting with the EmailVerification builders.
+	EmailVerification *EmailVerificationClient
 	// Keys is the client for interacting with the Keys builders.
 	Keys *KeysClient
+	// LoginAttempts is the client for interacting with the LoginAttempts builders.
+	LoginAttempts *LoginAttemptsClient
 	// OAuth2Client is the client for interacting with the OAuth2Client builders.
 	OAuth2Client *OAuth2ClientClient
 	// OfflineSession is the client for interacting with the OfflineSession builders.
 	OfflineSession *OfflineSessionClient
 	// Password is the client for interacting with the Password builders.
 	Password *PasswordClient
+	// PasswordReset is the client for interacting with the PasswordReset builders.
+	PasswordReset *PasswordResetClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// SAMLAssertion is the client for interacting with the SAMLAssertion builders.
+	SAMLAssertion *SAMLAssertionClient
+	// SAMLServiceProvider is the client for interacting with the SAMLServiceProvider builders.
+	SAMLServiceProvider *SAMLServiceProviderClient
+	// TOTPEnrollment is the client for interacting with the TOTPEnrollment builders.
+	TOTPEnrollment *TOTPEnrollmentClient
+	// UserConsent is the client for interacting with the UserConsent builders.
+	UserConsent *UserConsentClient
+	// WebAuthnCredential is the client for interacting with the WebAuthnCredential builders.
+	WebAuthnCredential *WebAuthnCredentialClient
 
 	// lazily loaded.
 	client     *Client
@@ -169,14 +187,23 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.AuthCode = NewAuthCodeClient(tx.config)
 	tx.AuthRequest = NewAuthRequestClient(tx.config)
+	tx.BackchannelAuthRequest = NewBackchannelAuthRequestClient(tx.config)
 	tx.Connector = NewConnectorClient(tx.config)
 	tx.DeviceRequest = NewDeviceRequestClient(tx.config)
 	tx.DeviceToken = NewDeviceTokenClient(tx.config)
+	tx.EmailVerification = NewEmailVerificationClient(tx.config)
 	tx.Keys = NewKeysClient(tx.config)
+	tx.LoginAttempts = NewLoginAttemptsClient(tx.config)
 	tx.OAuth2Client = NewOAuth2ClientClient(tx.config)
 	tx.OfflineSession = NewOfflineSessionClient(tx.config)
 	tx.Password = NewPasswordClient(tx.config)
+	tx.PasswordReset = NewPasswordResetClient(tx.config)
 	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.SAMLAssertion = NewSAMLAssertionClient(tx.config)
+	tx.SAMLServiceProvider = NewSAMLServiceProviderClient(tx.config)
+	tx.TOTPEnrollment = NewTOTPEnrollmentClient(tx.config)
+	tx.UserConsent = NewUserConsentClient(tx.config)
+	tx.WebAuthnCredential = NewWebAuthnCredentialClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,119 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+)
+
+// UserConsent is the model entity for the UserConsent schema.
+type UserConsent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// ConnID holds the value of the "conn_id" field.
+	ConnID string `json:"conn_id,omitempty"`
+	// Clients holds the value of the "clients" field.
+	Clients []byte `json:"clients,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserConsent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userconsent.FieldClients:
+			values[i] = new([]byte)
+		case userconsent.FieldID, userconsent.FieldUserID, userconsent.FieldConnID:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type UserConsent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserConsent fields.
+func (uc *UserConsent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userconsent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				uc.ID = value.String
+			}
+		case userconsent.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				uc.UserID = value.String
+			}
+		case userconsent.FieldConnID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conn_id", values[i])
+			} else if value.Valid {
+				uc.ConnID = value.String
+			}
+		case userconsent.FieldClients:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field clients", values[i])
+			} else if value != nil {
+				uc.Clients = *value
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this UserConsent.
+// Note that you need to call UserConsent.Unwrap() before calling this method if this UserConsent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uc *UserConsent) Update() *UserConsentUpdateOne {
+	return (&UserConsentClient{config: uc.config}).UpdateOne(uc)
+}
+
+// Unwrap unwraps the UserConsent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uc *UserConsent) Unwrap() *UserConsent {
+	tx, ok := uc.config.driver.(*txDriver)
+	if !ok {
+		panic("db: UserConsent is not a transactional entity")
+	}
+	uc.config.driver = tx.drv
+	return uc
+}
+
+// String implements the fmt.Stringer.
+func (uc *UserConsent) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserConsent(")
+	builder.WriteString(fmt.Sprintf("id=%v", uc.ID))
+	builder.WriteString(", user_id=")
+	builder.WriteString(uc.UserID)
+	builder.WriteString(", conn_id=")
+	builder.WriteString(uc.ConnID)
+	builder.WriteString(", clients=")
+	builder.WriteString(fmt.Sprintf("%v", uc.Clients))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserConsents is a parsable slice of UserConsent.
+type UserConsents []*UserConsent
+
+func (uc UserConsents) config(cfg config) {
+	for _i := range uc {
+		uc[_i].config = cfg
+	}
+}
@@ -0,0 +1,45 @@
+// Code generated by entc, DO NOT EDIT.
+
+package userconsent
+
+const (
+	// Label holds the string label denoting the userconsent type in the database.
+	Label = "user_consent"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldConnID holds the string denoting the conn_id field in the database.
+	FieldConnID = "conn_id"
+	// FieldClients holds the string denoting the clients field in the database.
+	FieldClients = "clients"
+	// Table holds the table name of the userconsent in the database.
+	Table = "user_consents"
+)
+
+// Columns holds all SQL columns for userconsent fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldConnID,
+	FieldClients,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// ConnIDValidator is a validator for the "conn_id" field. It is called by the builders before save.
+	ConnIDValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
@@ -0,0 +1,442 @@
+// Code generated by entc, DO NOT EDIT.
+
+package userconsent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// ConnID applies equality check predicate on the "conn_id" field. It's identical to ConnIDEQ.
+func ConnID(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConnID), v))
+	})
+}
+
+// Clients applies equality check predicate on the "clients" field. It's identical to ClientsEQ.
+func Clients(v []byte) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClients), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.UserConsent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserConsent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.UserConsent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserConsent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUserID), v))
+	})
+}
+
+// ConnIDEQ applies the EQ predicate on the "conn_id" field.
+func ConnIDEQ(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDNEQ applies the NEQ predicate on the "conn_id" field.
+func ConnIDNEQ(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDIn applies the In predicate on the "conn_id" field.
+func ConnIDIn(vs ...string) predicate.UserConsent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserConsent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldConnID), v...))
+	})
+}
+
+// ConnIDNotIn applies the NotIn predicate on the "conn_id" field.
+func ConnIDNotIn(vs ...string) predicate.UserConsent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserConsent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldConnID), v...))
+	})
+}
+
+// ConnIDGT applies the GT predicate on the "conn_id" field.
+func ConnIDGT(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDGTE applies the GTE predicate on the "conn_id" field.
+func ConnIDGTE(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDLT applies the LT predicate on the "conn_id" field.
+func ConnIDLT(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDLTE applies the LTE predicate on the "conn_id" field.
+func ConnIDLTE(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDContains applies the Contains predicate on the "conn_id" field.
+func ConnIDContains(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDHasPrefix applies the HasPrefix predicate on the "conn_id" field.
+func ConnIDHasPrefix(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDHasSuffix applies the HasSuffix predicate on the "conn_id" field.
+func ConnIDHasSuffix(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDEqualFold applies the EqualFold predicate on the "conn_id" field.
+func ConnIDEqualFold(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldConnID), v))
+	})
+}
+
+// ConnIDContainsFold applies the ContainsFold predicate on the "conn_id" field.
+func ConnIDContainsFold(v string) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldConnID), v))
+	})
+}
+
+// ClientsEQ applies the EQ predicate on the "clients" field.
+func ClientsEQ(v []byte) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClients), v))
+	})
+}
+
+// ClientsNEQ applies the NEQ predicate on the "clients" field.
+func ClientsNEQ(v []byte) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClients), v))
+	})
+}
+
+// ClientsIn applies the In predicate on the "clients" field.
+func ClientsIn(vs ...[]byte) predicate.UserConsent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserConsent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClients), v...))
+	})
+}
+
+// ClientsNotIn applies the NotIn predicate on the "clients" field.
+func ClientsNotIn(vs ...[]byte) predicate.UserConsent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserConsent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClients), v...))
+	})
+}
+
+// ClientsGT applies the GT predicate on the "clients" field.
+func ClientsGT(v []byte) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClients), v))
+	})
+}
+
+// ClientsGTE applies the GTE predicate on the "clients" field.
+func ClientsGTE(v []byte) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClients), v))
+	})
+}
+
+// ClientsLT applies the LT predicate on the "clients" field.
+func ClientsLT(v []byte) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClients), v))
+	})
+}
+
+// ClientsLTE applies the LTE predicate on the "clients" field.
+func ClientsLTE(v []byte) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClients), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserConsent) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserConsent) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserConsent) predicate.UserConsent {
+	return predicate.UserConsent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,280 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+)
+
+// UserConsentCreate is the builder for creating a UserConsent entity.
+type UserConsentCreate struct {
+	config
+	mutation *UserConsentMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ucc *UserConsentCreate) SetUserID(s string) *UserConsentCreate {
+	ucc.mutation.SetUserID(s)
+	return ucc
+}
+
+// SetConnID sets the "conn_id" field.
+func (ucc *UserConsentCreate) SetConnID(s string) *UserConsentCreate {
+	ucc.mutation.SetConnID(s)
+	return ucc
+}
+
+// SetClients sets the "clients" field.
+func (ucc *UserConsentCreate) SetClients(b []byte) *UserConsentCreate {
+	ucc.mutation.SetClients(b)
+	return ucc
+}
+
+// SetID sets the "id" field.
+func (ucc *UserConsentCreate) SetID(s string) *UserConsentCreate {
+	ucc.mutation.SetID(s)
+	return ucc
+}
+
+// Mutation returns the UserConsentMutation object of the builder.
+func (ucc *UserConsentCreate) Mutation() *UserConsentMutation {
+	return ucc.mutation
+}
+
+// Save creates the UserConsent in the database.
+func (ucc *UserConsentCreate) Save(ctx context.Context) (*UserConsent, error) {
+	var (
+		err  error
+		node *UserConsent
+	)
+	if len(ucc.hooks) == 0 {
+		if err = ucc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ucc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserConsentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ucc.check(); err != nil {
+				return nil, err
+			}
+			ucc.mutation = mutation
+			if node, err = ucc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ucc.hooks) - 1; i >= 0; i-- {
+			if ucc.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = ucc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ucc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ucc *UserConsentCreate) SaveX(ctx context.Context) *UserConsent {
+	v, err := ucc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucc *UserConsentCreate) Exec(ctx context.Context) error {
+	_, err := ucc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucc *UserConsentCreate) ExecX(ctx context.Context) {
+	if err := ucc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucc *UserConsentCreate) check() error {
+	if _, ok := ucc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`db: missing required field "UserConsent.user_id"`)}
+	}
+	if v, ok := ucc.mutation.UserID(); ok {
+		if err := userconsent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`db: validator failed for field "UserConsent.user_id": %w`, err)}
+		}
+	}
+	if _, ok := ucc.mutation.ConnID(); !ok {
+		return &ValidationError{Name: "conn_id", err: errors.New(`db: missing required field "UserConsent.conn_id"`)}
+	}
+	if v, ok := ucc.mutation.ConnID(); ok {
+		if err := userconsent.ConnIDValidator(v); err != nil {
+			return &ValidationError{Name: "conn_id", err: fmt.Errorf(`db: validator failed for field "UserConsent.conn_id": %w`, err)}
+		}
+	}
+	if _, ok := ucc.mutation.Clients(); !ok {
+		return &ValidationError{Name: "clients", err: errors.New(`db: missing required field "UserConsent.clients"`)}
+	}
+	if v, ok := ucc.mutation.ID(); ok {
+		if err := userconsent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "UserConsent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ucc *UserConsentCreate) sqlSave(ctx context.Context) (*UserConsent, error) {
+	_node, _spec := ucc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ucc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected UserConsent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (ucc *UserConsentCreate) createSpec() (*UserConsent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserConsent{config: ucc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: userconsent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: userconsent.FieldID,
+			},
+		}
+	)
+	if id, ok := ucc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ucc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userconsent.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := ucc.mutation.ConnID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userconsent.FieldConnID,
+		})
+		_node.ConnID = value
+	}
+	if value, ok := ucc.mutation.Clients(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: userconsent.FieldClients,
+		})
+		_node.Clients = value
+	}
+	return _node, _spec
+}
+
+// UserConsentCreateBulk is the builder for creating many UserConsent entities in bulk.
+type UserConsentCreateBulk struct {
+	config
+	builders []*UserConsentCreate
+}
+
+// Save creates the UserConsent entities in the database.
+func (uccb *UserConsentCreateBulk) Save(ctx context.Context) ([]*UserConsent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(uccb.builders))
+	nodes := make([]*UserConsent, len(uccb.builders))
+	mutators := make([]Mutator, len(uccb.builders))
+	for i := range uccb.builders {
+		func(i int, root context.Context) {
+			builder := uccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserConsentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uccb *UserConsentCreateBulk) SaveX(ctx context.Context) []*UserConsent {
+	v, err := uccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uccb *UserConsentCreateBulk) Exec(ctx context.Context) error {
+	_, err := uccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uccb *UserConsentCreateBulk) ExecX(ctx context.Context) {
+	if err := uccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
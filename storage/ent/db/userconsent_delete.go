@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+)
+
+// UserConsentDelete is the builder for deleting a UserConsent entity.
+type UserConsentDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserConsentMutation
+}
+
+// Where appends a list predicates to the UserConsentDelete builder.
+func (ucd *UserConsentDelete) Where(ps ...predicate.UserConsent) *UserConsentDelete {
+	ucd.mutation.Where(ps...)
+	return ucd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ucd *UserConsentDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ucd.hooks) == 0 {
+		affected, err = ucd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserConsentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ucd.mutation = mutation
+			affected, err = ucd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ucd.hooks) - 1; i >= 0; i-- {
+			if ucd.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = ucd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ucd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucd *UserConsentDelete) ExecX(ctx context.Context) int {
+	n, err := ucd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ucd *UserConsentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: userconsent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: userconsent.FieldID,
+			},
+		},
+	}
+	if ps := ucd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, ucd.driver, _spec)
+}
+
+// UserConsentDeleteOne is the builder for deleting a single UserConsent entity.
+type UserConsentDeleteOne struct {
+	ucd *UserConsentDelete
+}
+
+// Exec executes the deletion query.
+func (ucdo *UserConsentDeleteOne) Exec(ctx context.Context) error {
+	n, err := ucdo.ucd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{userconsent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucdo *UserConsentDeleteOne) ExecX(ctx context.Context) {
+	ucdo.ucd.ExecX(ctx)
+}
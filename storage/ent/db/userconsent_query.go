@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+)
+
+// UserConsentQuery is the builder for querying UserConsent entities.
+type UserConsentQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.UserConsent
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserConsentQuery builder.
+func (ucq *UserConsentQuery) Where(ps ...predicate.UserConsent) *UserConsentQuery {
+	ucq.predicates = append(ucq.predicates, ps...)
+	return ucq
+}
+
+// Limit adds a limit step to the query.
+func (ucq *UserConsentQuery) Limit(limit int) *UserConsentQuery {
+	ucq.limit = &limit
+	return ucq
+}
+
+// Offset adds an offset step to the query.
+func (ucq *UserConsentQuery) Offset(offset int) *UserConsentQuery {
+	ucq.offset = &offset
+	return ucq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ucq *UserConsentQuery) Unique(unique bool) *UserConsentQuery {
+	ucq.unique = &unique
+	return ucq
+}
+
+// Order adds an order step to the query.
+func (ucq *UserConsentQuery) Order(o ...OrderFunc) *UserConsentQuery {
+	ucq.order = append(ucq.order, o...)
+	return ucq
+}
+
+// First returns the first UserConsent entity from the query.
+// Returns a *NotFoundError when no UserConsent was found.
+func (ucq *UserConsentQuery) First(ctx context.Context) (*UserConsent, error) {
+	nodes, err := ucq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{userconsent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ucq *UserConsentQuery) FirstX(ctx context.Context) *UserConsent {
+	node, err := ucq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserConsent ID from the query.
+// Returns a *NotFoundError when no UserConsent ID was found.
+func (ucq *UserConsentQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ucq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{userconsent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ucq *UserConsentQuery) FirstIDX(ctx context.Context) string {
+	id, err := ucq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserConsent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserConsent entity is found.
+// Returns a *NotFoundError when no UserConsent entities are found.
+func (ucq *UserConsentQuery) Only(ctx context.Context) (*UserConsent, error) {
+	nodes, err := ucq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{userconsent.Label}
+	default:
+		return nil, &NotSingularError{userconsent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ucq *UserConsentQuery) OnlyX(ctx context.Context) *UserConsent {
+	node, err := ucq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserConsent ID in the query.
+// Returns a *NotSingularError when more than one UserConsent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ucq *UserConsentQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ucq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = &NotSingularError{userconsent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ucq *UserConsentQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ucq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserConsents.
+func (ucq *UserConsentQuery) All(ctx context.Context) ([]*UserConsent, error) {
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ucq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ucq *UserConsentQuery) AllX(ctx context.Context) []*UserConsent {
+	nodes, err := ucq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserConsent IDs.
+func (ucq *UserConsentQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := ucq.Select(userconsent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ucq *UserConsentQuery) IDsX(ctx context.Context) []string {
+	ids, err := ucq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ucq *UserConsentQuery) Count(ctx context.Context) (int, error) {
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ucq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ucq *UserConsentQuery) CountX(ctx context.Context) int {
+	count, err := ucq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ucq *UserConsentQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ucq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ucq *UserConsentQuery) ExistX(ctx context.Context) bool {
+	exist, err := ucq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserConsentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ucq *UserConsentQuery) Clone() *UserConsentQuery {
+	if ucq == nil {
+		return nil
+	}
+	return &UserConsentQuery{
+		config:     ucq.config,
+		limit:      ucq.limit,
+		offset:     ucq.offset,
+		order:      append([]OrderFunc{}, ucq.order...),
+		predicates: append([]predicate.UserConsent{}, ucq.predicates...),
+		// clone intermediate query.
+		sql:    ucq.sql.Clone(),
+		path:   ucq.path,
+		unique: ucq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserConsent.Query().
+//		GroupBy(userconsent.FieldUserID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+//
+func (ucq *UserConsentQuery) GroupBy(field string, fields ...string) *UserConsentGroupBy {
+	group := &UserConsentGroupBy{config: ucq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ucq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ucq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.UserConsent.Query().
+//		Select(userconsent.FieldUserID).
+//		Scan(ctx, &v)
+//
+func (ucq *UserConsentQuery) Select(fields ...string) *UserConsentSelect {
+	ucq.fields = append(ucq.fields, fields...)
+	return &UserConsentSelect{UserConsentQuery: ucq}
+}
+
+func (ucq *UserConsentQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ucq.fields {
+		if !userconsent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if ucq.path != nil {
+		prev, err := ucq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ucq.sql = prev
+	}
+	return nil
+}
+
+func (ucq *UserConsentQuery) sqlAll(ctx context.Context) ([]*UserConsent, error) {
+	var (
+		nodes = []*UserConsent{}
+		_spec = ucq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &UserConsent{config: ucq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ucq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ucq *UserConsentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ucq.querySpec()
+	_spec.Node.Columns = ucq.fields
+	if len(ucq.fields) > 0 {
+		_spec.Unique = ucq.unique != nil && *ucq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ucq.driver, _spec)
+}
+
+func (ucq *UserConsentQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ucq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ucq *UserConsentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userconsent.Table,
+			Columns: userconsent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: userconsent.FieldID,
+			},
+		},
+		From:   ucq.sql,
+		Unique: true,
+	}
+	if unique := ucq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ucq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userconsent.FieldID)
+		for i := range fields {
+			if fields[i] != userconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ucq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ucq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ucq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ucq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ucq *UserConsentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ucq.driver.Dialect())
+	t1 := builder.Table(userconsent.Table)
+	columns := ucq.fields
+	if len(columns) == 0 {
+		columns = userconsent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ucq.sql != nil {
+		selector = ucq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ucq.unique != nil && *ucq.unique {
+		selector.Distinct()
+	}
+	for _, p := range ucq.predicates {
+		p(selector)
+	}
+	for _, p := range ucq.order {
+		p(selector)
+	}
+	if offset := ucq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ucq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserConsentGroupBy is the group-by builder for UserConsent entities.
+type UserConsentGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ucgb *UserConsentGroupBy) Aggregate(fns ...AggregateFunc) *UserConsentGroupBy {
+	ucgb.fns = append(ucgb.fns, fns...)
+	return ucgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ucgb *UserConsentGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ucgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ucgb.sql = query
+	return ucgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ucgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ucgb *UserConsentGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ucgb.fields) > 1 {
+		return nil, errors.New("db: UserConsentGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ucgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ucgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ucgb *UserConsentGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ucgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = fmt.Errorf("db: UserConsentGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) StringX(ctx context.Context) string {
+	v, err := ucgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ucgb *UserConsentGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ucgb.fields) > 1 {
+		return nil, errors.New("db: UserConsentGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ucgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ucgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ucgb *UserConsentGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ucgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = fmt.Errorf("db: UserConsentGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) IntX(ctx context.Context) int {
+	v, err := ucgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ucgb *UserConsentGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ucgb.fields) > 1 {
+		return nil, errors.New("db: UserConsentGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ucgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ucgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ucgb *UserConsentGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ucgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = fmt.Errorf("db: UserConsentGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ucgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ucgb *UserConsentGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ucgb.fields) > 1 {
+		return nil, errors.New("db: UserConsentGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ucgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ucgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ucgb *UserConsentGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ucgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = fmt.Errorf("db: UserConsentGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ucgb *UserConsentGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ucgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ucgb *UserConsentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ucgb.fields {
+		if !userconsent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ucgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ucgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ucgb *UserConsentGroupBy) sqlQuery() *sql.Selector {
+	selector := ucgb.sql.Select()
+	aggregation := make([]string, 0, len(ucgb.fns))
+	for _, fn := range ucgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ucgb.fields)+len(ucgb.fns))
+		for _, f := range ucgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ucgb.fields...)...)
+}
+
+// UserConsentSelect is the builder for selecting fields of UserConsent entities.
+type UserConsentSelect struct {
+	*UserConsentQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ucs *UserConsentSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ucs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ucs.sql = ucs.UserConsentQuery.sqlQuery(ctx)
+	return ucs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ucs *UserConsentSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ucs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ucs *UserConsentSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ucs.fields) > 1 {
+		return nil, errors.New("db: UserConsentSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ucs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ucs *UserConsentSelect) StringsX(ctx context.Context) []string {
+	v, err := ucs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ucs *UserConsentSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ucs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = fmt.Errorf("db: UserConsentSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ucs *UserConsentSelect) StringX(ctx context.Context) string {
+	v, err := ucs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ucs *UserConsentSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ucs.fields) > 1 {
+		return nil, errors.New("db: UserConsentSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ucs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ucs *UserConsentSelect) IntsX(ctx context.Context) []int {
+	v, err := ucs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ucs *UserConsentSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ucs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = fmt.Errorf("db: UserConsentSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ucs *UserConsentSelect) IntX(ctx context.Context) int {
+	v, err := ucs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ucs *UserConsentSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ucs.fields) > 1 {
+		return nil, errors.New("db: UserConsentSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ucs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ucs *UserConsentSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ucs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ucs *UserConsentSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ucs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = fmt.Errorf("db: UserConsentSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ucs *UserConsentSelect) Float64X(ctx context.Context) float64 {
+	v, err := ucs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ucs *UserConsentSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ucs.fields) > 1 {
+		return nil, errors.New("db: UserConsentSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ucs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ucs *UserConsentSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ucs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ucs *UserConsentSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ucs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{userconsent.Label}
+	default:
+		err = fmt.Errorf("db: UserConsentSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ucs *UserConsentSelect) BoolX(ctx context.Context) bool {
+	v, err := ucs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ucs *UserConsentSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ucs.sql.Query()
+	if err := ucs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,359 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/userconsent"
+)
+
+// UserConsentUpdate is the builder for updating UserConsent entities.
+type UserConsentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserConsentMutation
+}
+
+// Where appends a list predicates to the UserConsentUpdate builder.
+func (ucu *UserConsentUpdate) Where(ps ...predicate.UserConsent) *UserConsentUpdate {
+	ucu.mutation.Where(ps...)
+	return ucu
+}
+
+// SetUserID sets the "user_id" field.
+func (ucu *UserConsentUpdate) SetUserID(s string) *UserConsentUpdate {
+	ucu.mutation.SetUserID(s)
+	return ucu
+}
+
+// SetConnID sets the "conn_id" field.
+func (ucu *UserConsentUpdate) SetConnID(s string) *UserConsentUpdate {
+	ucu.mutation.SetConnID(s)
+	return ucu
+}
+
+// SetClients sets the "clients" field.
+func (ucu *UserConsentUpdate) SetClients(b []byte) *UserConsentUpdate {
+	ucu.mutation.SetClients(b)
+	return ucu
+}
+
+// Mutation returns the UserConsentMutation object of the builder.
+func (ucu *UserConsentUpdate) Mutation() *UserConsentMutation {
+	return ucu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ucu *UserConsentUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ucu.hooks) == 0 {
+		if err = ucu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ucu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserConsentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ucu.check(); err != nil {
+				return 0, err
+			}
+			ucu.mutation = mutation
+			affected, err = ucu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ucu.hooks) - 1; i >= 0; i-- {
+			if ucu.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = ucu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ucu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucu *UserConsentUpdate) SaveX(ctx context.Context) int {
+	affected, err := ucu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ucu *UserConsentUpdate) Exec(ctx context.Context) error {
+	_, err := ucu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucu *UserConsentUpdate) ExecX(ctx context.Context) {
+	if err := ucu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucu *UserConsentUpdate) check() error {
+	if v, ok := ucu.mutation.UserID(); ok {
+		if err := userconsent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`db: validator failed for field "UserConsent.user_id": %w`, err)}
+		}
+	}
+	if v, ok := ucu.mutation.ConnID(); ok {
+		if err := userconsent.ConnIDValidator(v); err != nil {
+			return &ValidationError{Name: "conn_id", err: fmt.Errorf(`db: validator failed for field "UserConsent.conn_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ucu *UserConsentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userconsent.Table,
+			Columns: userconsent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: userconsent.FieldID,
+			},
+		},
+	}
+	if ps := ucu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ucu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userconsent.FieldUserID,
+		})
+	}
+	if value, ok := ucu.mutation.ConnID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userconsent.FieldConnID,
+		})
+	}
+	if value, ok := ucu.mutation.Clients(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: userconsent.FieldClients,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ucu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UserConsentUpdateOne is the builder for updating a single UserConsent entity.
+type UserConsentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserConsentMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (ucuo *UserConsentUpdateOne) SetUserID(s string) *UserConsentUpdateOne {
+	ucuo.mutation.SetUserID(s)
+	return ucuo
+}
+
+// SetConnID sets the "conn_id" field.
+func (ucuo *UserConsentUpdateOne) SetConnID(s string) *UserConsentUpdateOne {
+	ucuo.mutation.SetConnID(s)
+	return ucuo
+}
+
+// SetClients sets the "clients" field.
+func (ucuo *UserConsentUpdateOne) SetClients(b []byte) *UserConsentUpdateOne {
+	ucuo.mutation.SetClients(b)
+	return ucuo
+}
+
+// Mutation returns the UserConsentMutation object of the builder.
+func (ucuo *UserConsentUpdateOne) Mutation() *UserConsentMutation {
+	return ucuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ucuo *UserConsentUpdateOne) Select(field string, fields ...string) *UserConsentUpdateOne {
+	ucuo.fields = append([]string{field}, fields...)
+	return ucuo
+}
+
+// Save executes the query and returns the updated UserConsent entity.
+func (ucuo *UserConsentUpdateOne) Save(ctx context.Context) (*UserConsent, error) {
+	var (
+		err  error
+		node *UserConsent
+	)
+	if len(ucuo.hooks) == 0 {
+		if err = ucuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ucuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserConsentMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ucuo.check(); err != nil {
+				return nil, err
+			}
+			ucuo.mutation = mutation
+			node, err = ucuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ucuo.hooks) - 1; i >= 0; i-- {
+			if ucuo.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = ucuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ucuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucuo *UserConsentUpdateOne) SaveX(ctx context.Context) *UserConsent {
+	node, err := ucuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ucuo *UserConsentUpdateOne) Exec(ctx context.Context) error {
+	_, err := ucuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucuo *UserConsentUpdateOne) ExecX(ctx context.Context) {
+	if err := ucuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucuo *UserConsentUpdateOne) check() error {
+	if v, ok := ucuo.mutation.UserID(); ok {
+		if err := userconsent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`db: validator failed for field "UserConsent.user_id": %w`, err)}
+		}
+	}
+	if v, ok := ucuo.mutation.ConnID(); ok {
+		if err := userconsent.ConnIDValidator(v); err != nil {
+			return &ValidationError{Name: "conn_id", err: fmt.Errorf(`db: validator failed for field "UserConsent.conn_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ucuo *UserConsentUpdateOne) sqlSave(ctx context.Context) (_node *UserConsent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userconsent.Table,
+			Columns: userconsent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: userconsent.FieldID,
+			},
+		},
+	}
+	id, ok := ucuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "UserConsent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ucuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userconsent.FieldID)
+		for _, f := range fields {
+			if !userconsent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != userconsent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ucuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ucuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userconsent.FieldUserID,
+		})
+	}
+	if value, ok := ucuo.mutation.ConnID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userconsent.FieldConnID,
+		})
+	}
+	if value, ok := ucuo.mutation.Clients(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: userconsent.FieldClients,
+		})
+	}
+	_node = &UserConsent{config: ucuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ucuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userconsent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,177 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
+)
+
+// WebAuthnCredential is the model entity for the WebAuthnCredential schema.
+type WebAuthnCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey []byte `json:"public_key,omitempty"`
+	// AttestationType holds the value of the "attestation_type" field.
+	AttestationType string `json:"attestation_type,omitempty"`
+	// Transports holds the value of the "transports" field.
+	Transports []string `json:"transports,omitempty"`
+	// Aaguid holds the value of the "aaguid" field.
+	Aaguid []byte `json:"aaguid,omitempty"`
+	// SignCount holds the value of the "sign_count" field.
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastUsed holds the value of the "last_used" field.
+	LastUsed time.Time `json:"last_used,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebAuthnCredential) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthncredential.FieldPublicKey, webauthncredential.FieldTransports, webauthncredential.FieldAaguid:
+			values[i] = new([]byte)
+		case webauthncredential.FieldSignCount:
+			values[i] = new(sql.NullInt64)
+		case webauthncredential.FieldID, webauthncredential.FieldEmail, webauthncredential.FieldAttestationType:
+			values[i] = new(sql.NullString)
+		case webauthncredential.FieldCreatedAt, webauthncredential.FieldLastUsed:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WebAuthnCredential", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebAuthnCredential fields.
+func (wac *WebAuthnCredential) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthncredential.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				wac.ID = value.String
+			}
+		case webauthncredential.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				wac.Email = value.String
+			}
+		case webauthncredential.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				wac.PublicKey = *value
+			}
+		case webauthncredential.FieldAttestationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_type", values[i])
+			} else if value.Valid {
+				wac.AttestationType = value.String
+			}
+		case webauthncredential.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wac.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case webauthncredential.FieldAaguid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value != nil {
+				wac.Aaguid = *value
+			}
+		case webauthncredential.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				wac.SignCount = uint32(value.Int64)
+			}
+		case webauthncredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wac.CreatedAt = value.Time
+			}
+		case webauthncredential.FieldLastUsed:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used", values[i])
+			} else if value.Valid {
+				wac.LastUsed = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WebAuthnCredential.
+// Note that you need to call WebAuthnCredential.Unwrap() before calling this method if this WebAuthnCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wac *WebAuthnCredential) Update() *WebAuthnCredentialUpdateOne {
+	return (&WebAuthnCredentialClient{config: wac.config}).UpdateOne(wac)
+}
+
+// Unwrap unwraps the WebAuthnCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wac *WebAuthnCredential) Unwrap() *WebAuthnCredential {
+	tx, ok := wac.config.driver.(*txDriver)
+	if !ok {
+		panic("db: WebAuthnCredential is not a transactional entity")
+	}
+	wac.config.driver = tx.drv
+	return wac
+}
+
+// String implements the fmt.Stringer.
+func (wac *WebAuthnCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebAuthnCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v", wac.ID))
+	builder.WriteString(", email=")
+	builder.WriteString(wac.Email)
+	builder.WriteString(", public_key=")
+	builder.WriteString(fmt.Sprintf("%v", wac.PublicKey))
+	builder.WriteString(", attestation_type=")
+	builder.WriteString(wac.AttestationType)
+	builder.WriteString(", transports=")
+	builder.WriteString(fmt.Sprintf("%v", wac.Transports))
+	builder.WriteString(", aaguid=")
+	builder.WriteString(fmt.Sprintf("%v", wac.Aaguid))
+	builder.WriteString(", sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", wac.SignCount))
+	builder.WriteString(", created_at=")
+	builder.WriteString(wac.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", last_used=")
+	builder.WriteString(wac.LastUsed.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebAuthnCredentials is a parsable slice of WebAuthnCredential.
+type WebAuthnCredentials []*WebAuthnCredential
+
+func (wac WebAuthnCredentials) config(cfg config) {
+	for _i := range wac {
+		wac[_i].config = cfg
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by entc, DO NOT EDIT.
+
+package webauthncredential
+
+const (
+	// Label holds the string label denoting the webauthncredential type in the database.
+	Label = "web_authn_credential"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldPublicKey holds the string denoting the public_key field in the database.
+	FieldPublicKey = "public_key"
+	// FieldAttestationType holds the string denoting the attestation_type field in the database.
+	FieldAttestationType = "attestation_type"
+	// FieldTransports holds the string denoting the transports field in the database.
+	FieldTransports = "transports"
+	// FieldAaguid holds the string denoting the aaguid field in the database.
+	FieldAaguid = "aaguid"
+	// FieldSignCount holds the string denoting the sign_count field in the database.
+	FieldSignCount = "sign_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastUsed holds the string denoting the last_used field in the database.
+	FieldLastUsed = "last_used"
+	// Table holds the table name of the webauthncredential in the database.
+	Table = "web_authn_credentials"
+)
+
+// Columns holds all SQL columns for webauthncredential fields.
+var Columns = []string{
+	FieldID,
+	FieldEmail,
+	FieldPublicKey,
+	FieldAttestationType,
+	FieldTransports,
+	FieldAaguid,
+	FieldSignCount,
+	FieldCreatedAt,
+	FieldLastUsed,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
@@ -0,0 +1,804 @@
+// Code generated by entc, DO NOT EDIT.
+
+package webauthncredential
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmail), v))
+	})
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPublicKey), v))
+	})
+}
+
+// AttestationType applies equality check predicate on the "attestation_type" field. It's identical to AttestationTypeEQ.
+func AttestationType(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttestationType), v))
+	})
+}
+
+// Aaguid applies equality check predicate on the "aaguid" field. It's identical to AaguidEQ.
+func Aaguid(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAaguid), v))
+	})
+}
+
+// SignCount applies equality check predicate on the "sign_count" field. It's identical to SignCountEQ.
+func SignCount(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSignCount), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// LastUsed applies equality check predicate on the "last_used" field. It's identical to LastUsedEQ.
+func LastUsed(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsed), v))
+	})
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmail), v))
+	})
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEmail), v))
+	})
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEmail), v...))
+	})
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEmail), v...))
+	})
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEmail), v))
+	})
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEmail), v))
+	})
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEmail), v))
+	})
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEmail), v))
+	})
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEmail), v))
+	})
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEmail), v))
+	})
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEmail), v))
+	})
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEmail), v))
+	})
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEmail), v))
+	})
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...[]byte) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPublicKey), v...))
+	})
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...[]byte) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPublicKey), v...))
+	})
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPublicKey), v))
+	})
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPublicKey), v))
+	})
+}
+
+// AttestationTypeEQ applies the EQ predicate on the "attestation_type" field.
+func AttestationTypeEQ(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeNEQ applies the NEQ predicate on the "attestation_type" field.
+func AttestationTypeNEQ(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeIn applies the In predicate on the "attestation_type" field.
+func AttestationTypeIn(vs ...string) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAttestationType), v...))
+	})
+}
+
+// AttestationTypeNotIn applies the NotIn predicate on the "attestation_type" field.
+func AttestationTypeNotIn(vs ...string) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAttestationType), v...))
+	})
+}
+
+// AttestationTypeGT applies the GT predicate on the "attestation_type" field.
+func AttestationTypeGT(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeGTE applies the GTE predicate on the "attestation_type" field.
+func AttestationTypeGTE(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeLT applies the LT predicate on the "attestation_type" field.
+func AttestationTypeLT(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeLTE applies the LTE predicate on the "attestation_type" field.
+func AttestationTypeLTE(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeContains applies the Contains predicate on the "attestation_type" field.
+func AttestationTypeContains(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeHasPrefix applies the HasPrefix predicate on the "attestation_type" field.
+func AttestationTypeHasPrefix(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeHasSuffix applies the HasSuffix predicate on the "attestation_type" field.
+func AttestationTypeHasSuffix(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeEqualFold applies the EqualFold predicate on the "attestation_type" field.
+func AttestationTypeEqualFold(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAttestationType), v))
+	})
+}
+
+// AttestationTypeContainsFold applies the ContainsFold predicate on the "attestation_type" field.
+func AttestationTypeContainsFold(v string) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAttestationType), v))
+	})
+}
+
+// TransportsIsNil applies the IsNil predicate on the "transports" field.
+func TransportsIsNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTransports)))
+	})
+}
+
+// TransportsNotNil applies the NotNil predicate on the "transports" field.
+func TransportsNotNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTransports)))
+	})
+}
+
+// AaguidEQ applies the EQ predicate on the "aaguid" field.
+func AaguidEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidNEQ applies the NEQ predicate on the "aaguid" field.
+func AaguidNEQ(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidIn applies the In predicate on the "aaguid" field.
+func AaguidIn(vs ...[]byte) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAaguid), v...))
+	})
+}
+
+// AaguidNotIn applies the NotIn predicate on the "aaguid" field.
+func AaguidNotIn(vs ...[]byte) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAaguid), v...))
+	})
+}
+
+// AaguidGT applies the GT predicate on the "aaguid" field.
+func AaguidGT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidGTE applies the GTE predicate on the "aaguid" field.
+func AaguidGTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidLT applies the LT predicate on the "aaguid" field.
+func AaguidLT(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidLTE applies the LTE predicate on the "aaguid" field.
+func AaguidLTE(v []byte) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAaguid), v))
+	})
+}
+
+// AaguidIsNil applies the IsNil predicate on the "aaguid" field.
+func AaguidIsNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAaguid)))
+	})
+}
+
+// AaguidNotNil applies the NotNil predicate on the "aaguid" field.
+func AaguidNotNil() predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAaguid)))
+	})
+}
+
+// SignCountEQ applies the EQ predicate on the "sign_count" field.
+func SignCountEQ(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountNEQ applies the NEQ predicate on the "sign_count" field.
+func SignCountNEQ(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountIn applies the In predicate on the "sign_count" field.
+func SignCountIn(vs ...uint32) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSignCount), v...))
+	})
+}
+
+// SignCountNotIn applies the NotIn predicate on the "sign_count" field.
+func SignCountNotIn(vs ...uint32) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSignCount), v...))
+	})
+}
+
+// SignCountGT applies the GT predicate on the "sign_count" field.
+func SignCountGT(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountGTE applies the GTE predicate on the "sign_count" field.
+func SignCountGTE(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountLT applies the LT predicate on the "sign_count" field.
+func SignCountLT(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSignCount), v))
+	})
+}
+
+// SignCountLTE applies the LTE predicate on the "sign_count" field.
+func SignCountLTE(v uint32) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSignCount), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// LastUsedEQ applies the EQ predicate on the "last_used" field.
+func LastUsedEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsed), v))
+	})
+}
+
+// LastUsedNEQ applies the NEQ predicate on the "last_used" field.
+func LastUsedNEQ(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastUsed), v))
+	})
+}
+
+// LastUsedIn applies the In predicate on the "last_used" field.
+func LastUsedIn(vs ...time.Time) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastUsed), v...))
+	})
+}
+
+// LastUsedNotIn applies the NotIn predicate on the "last_used" field.
+func LastUsedNotIn(vs ...time.Time) predicate.WebAuthnCredential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastUsed), v...))
+	})
+}
+
+// LastUsedGT applies the GT predicate on the "last_used" field.
+func LastUsedGT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastUsed), v))
+	})
+}
+
+// LastUsedGTE applies the GTE predicate on the "last_used" field.
+func LastUsedGTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastUsed), v))
+	})
+}
+
+// LastUsedLT applies the LT predicate on the "last_used" field.
+func LastUsedLT(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastUsed), v))
+	})
+}
+
+// LastUsedLTE applies the LTE predicate on the "last_used" field.
+func LastUsedLTE(v time.Time) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastUsed), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebAuthnCredential) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebAuthnCredential) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebAuthnCredential) predicate.WebAuthnCredential {
+	return predicate.WebAuthnCredential(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,355 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
+)
+
+// WebAuthnCredentialCreate is the builder for creating a WebAuthnCredential entity.
+type WebAuthnCredentialCreate struct {
+	config
+	mutation *WebAuthnCredentialMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (wacc *WebAuthnCredentialCreate) SetEmail(s string) *WebAuthnCredentialCreate {
+	wacc.mutation.SetEmail(s)
+	return wacc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (wacc *WebAuthnCredentialCreate) SetPublicKey(b []byte) *WebAuthnCredentialCreate {
+	wacc.mutation.SetPublicKey(b)
+	return wacc
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wacc *WebAuthnCredentialCreate) SetAttestationType(s string) *WebAuthnCredentialCreate {
+	wacc.mutation.SetAttestationType(s)
+	return wacc
+}
+
+// SetTransports sets the "transports" field.
+func (wacc *WebAuthnCredentialCreate) SetTransports(s []string) *WebAuthnCredentialCreate {
+	wacc.mutation.SetTransports(s)
+	return wacc
+}
+
+// SetAaguid sets the "aaguid" field.
+func (wacc *WebAuthnCredentialCreate) SetAaguid(b []byte) *WebAuthnCredentialCreate {
+	wacc.mutation.SetAaguid(b)
+	return wacc
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wacc *WebAuthnCredentialCreate) SetSignCount(u uint32) *WebAuthnCredentialCreate {
+	wacc.mutation.SetSignCount(u)
+	return wacc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wacc *WebAuthnCredentialCreate) SetCreatedAt(t time.Time) *WebAuthnCredentialCreate {
+	wacc.mutation.SetCreatedAt(t)
+	return wacc
+}
+
+// SetLastUsed sets the "last_used" field.
+func (wacc *WebAuthnCredentialCreate) SetLastUsed(t time.Time) *WebAuthnCredentialCreate {
+	wacc.mutation.SetLastUsed(t)
+	return wacc
+}
+
+// SetID sets the "id" field.
+func (wacc *WebAuthnCredentialCreate) SetID(s string) *WebAuthnCredentialCreate {
+	wacc.mutation.SetID(s)
+	return wacc
+}
+
+// Mutation returns the WebAuthnCredentialMutation object of the builder.
+func (wacc *WebAuthnCredentialCreate) Mutation() *WebAuthnCredentialMutation {
+	return wacc.mutation
+}
+
+// Save creates the WebAuthnCredential in the database.
+func (wacc *WebAuthnCredentialCreate) Save(ctx context.Context) (*WebAuthnCredential, error) {
+	var (
+		err  error
+		node *WebAuthnCredential
+	)
+	if len(wacc.hooks) == 0 {
+		if err = wacc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wacc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebAuthnCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wacc.check(); err != nil {
+				return nil, err
+			}
+			wacc.mutation = mutation
+			if node, err = wacc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wacc.hooks) - 1; i >= 0; i-- {
+			if wacc.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = wacc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wacc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wacc *WebAuthnCredentialCreate) SaveX(ctx context.Context) *WebAuthnCredential {
+	v, err := wacc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wacc *WebAuthnCredentialCreate) Exec(ctx context.Context) error {
+	_, err := wacc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacc *WebAuthnCredentialCreate) ExecX(ctx context.Context) {
+	if err := wacc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacc *WebAuthnCredentialCreate) check() error {
+	if _, ok := wacc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`db: missing required field "WebAuthnCredential.email"`)}
+	}
+	if v, ok := wacc.mutation.Email(); ok {
+		if err := webauthncredential.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`db: validator failed for field "WebAuthnCredential.email": %w`, err)}
+		}
+	}
+	if _, ok := wacc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`db: missing required field "WebAuthnCredential.public_key"`)}
+	}
+	if _, ok := wacc.mutation.AttestationType(); !ok {
+		return &ValidationError{Name: "attestation_type", err: errors.New(`db: missing required field "WebAuthnCredential.attestation_type"`)}
+	}
+	if _, ok := wacc.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`db: missing required field "WebAuthnCredential.sign_count"`)}
+	}
+	if _, ok := wacc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "WebAuthnCredential.created_at"`)}
+	}
+	if _, ok := wacc.mutation.LastUsed(); !ok {
+		return &ValidationError{Name: "last_used", err: errors.New(`db: missing required field "WebAuthnCredential.last_used"`)}
+	}
+	if v, ok := wacc.mutation.ID(); ok {
+		if err := webauthncredential.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`db: validator failed for field "WebAuthnCredential.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wacc *WebAuthnCredentialCreate) sqlSave(ctx context.Context) (*WebAuthnCredential, error) {
+	_node, _spec := wacc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wacc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebAuthnCredential.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (wacc *WebAuthnCredentialCreate) createSpec() (*WebAuthnCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebAuthnCredential{config: wacc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webauthncredential.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webauthncredential.FieldID,
+			},
+		}
+	)
+	if id, ok := wacc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wacc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldEmail,
+		})
+		_node.Email = value
+	}
+	if value, ok := wacc.mutation.PublicKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldPublicKey,
+		})
+		_node.PublicKey = value
+	}
+	if value, ok := wacc.mutation.AttestationType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldAttestationType,
+		})
+		_node.AttestationType = value
+	}
+	if value, ok := wacc.mutation.Transports(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webauthncredential.FieldTransports,
+		})
+		_node.Transports = value
+	}
+	if value, ok := wacc.mutation.Aaguid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldAaguid,
+		})
+		_node.Aaguid = value
+	}
+	if value, ok := wacc.mutation.SignCount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+		_node.SignCount = value
+	}
+	if value, ok := wacc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := wacc.mutation.LastUsed(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldLastUsed,
+		})
+		_node.LastUsed = value
+	}
+	return _node, _spec
+}
+
+// WebAuthnCredentialCreateBulk is the builder for creating many WebAuthnCredential entities in bulk.
+type WebAuthnCredentialCreateBulk struct {
+	config
+	builders []*WebAuthnCredentialCreate
+}
+
+// Save creates the WebAuthnCredential entities in the database.
+func (waccb *WebAuthnCredentialCreateBulk) Save(ctx context.Context) ([]*WebAuthnCredential, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(waccb.builders))
+	nodes := make([]*WebAuthnCredential, len(waccb.builders))
+	mutators := make([]Mutator, len(waccb.builders))
+	for i := range waccb.builders {
+		func(i int, root context.Context) {
+			builder := waccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebAuthnCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, waccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, waccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, waccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (waccb *WebAuthnCredentialCreateBulk) SaveX(ctx context.Context) []*WebAuthnCredential {
+	v, err := waccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (waccb *WebAuthnCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := waccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (waccb *WebAuthnCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := waccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
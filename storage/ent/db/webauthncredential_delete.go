@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
+)
+
+// WebAuthnCredentialDelete is the builder for deleting a WebAuthnCredential entity.
+type WebAuthnCredentialDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebAuthnCredentialMutation
+}
+
+// Where appends a list predicates to the WebAuthnCredentialDelete builder.
+func (wacd *WebAuthnCredentialDelete) Where(ps ...predicate.WebAuthnCredential) *WebAuthnCredentialDelete {
+	wacd.mutation.Where(ps...)
+	return wacd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wacd *WebAuthnCredentialDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wacd.hooks) == 0 {
+		affected, err = wacd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebAuthnCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wacd.mutation = mutation
+			affected, err = wacd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wacd.hooks) - 1; i >= 0; i-- {
+			if wacd.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = wacd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wacd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacd *WebAuthnCredentialDelete) ExecX(ctx context.Context) int {
+	n, err := wacd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wacd *WebAuthnCredentialDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: webauthncredential.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webauthncredential.FieldID,
+			},
+		},
+	}
+	if ps := wacd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, wacd.driver, _spec)
+}
+
+// WebAuthnCredentialDeleteOne is the builder for deleting a single WebAuthnCredential entity.
+type WebAuthnCredentialDeleteOne struct {
+	wacd *WebAuthnCredentialDelete
+}
+
+// Exec executes the deletion query.
+func (wacdo *WebAuthnCredentialDeleteOne) Exec(ctx context.Context) error {
+	n, err := wacdo.wacd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webauthncredential.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacdo *WebAuthnCredentialDeleteOne) ExecX(ctx context.Context) {
+	wacdo.wacd.ExecX(ctx)
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
+)
+
+// WebAuthnCredentialQuery is the builder for querying WebAuthnCredential entities.
+type WebAuthnCredentialQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WebAuthnCredential
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebAuthnCredentialQuery builder.
+func (wacq *WebAuthnCredentialQuery) Where(ps ...predicate.WebAuthnCredential) *WebAuthnCredentialQuery {
+	wacq.predicates = append(wacq.predicates, ps...)
+	return wacq
+}
+
+// Limit adds a limit step to the query.
+func (wacq *WebAuthnCredentialQuery) Limit(limit int) *WebAuthnCredentialQuery {
+	wacq.limit = &limit
+	return wacq
+}
+
+// Offset adds an offset step to the query.
+func (wacq *WebAuthnCredentialQuery) Offset(offset int) *WebAuthnCredentialQuery {
+	wacq.offset = &offset
+	return wacq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wacq *WebAuthnCredentialQuery) Unique(unique bool) *WebAuthnCredentialQuery {
+	wacq.unique = &unique
+	return wacq
+}
+
+// Order adds an order step to the query.
+func (wacq *WebAuthnCredentialQuery) Order(o ...OrderFunc) *WebAuthnCredentialQuery {
+	wacq.order = append(wacq.order, o...)
+	return wacq
+}
+
+// First returns the first WebAuthnCredential entity from the query.
+// Returns a *NotFoundError when no WebAuthnCredential was found.
+func (wacq *WebAuthnCredentialQuery) First(ctx context.Context) (*WebAuthnCredential, error) {
+	nodes, err := wacq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webauthncredential.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wacq *WebAuthnCredentialQuery) FirstX(ctx context.Context) *WebAuthnCredential {
+	node, err := wacq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebAuthnCredential ID from the query.
+// Returns a *NotFoundError when no WebAuthnCredential ID was found.
+func (wacq *WebAuthnCredentialQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = wacq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webauthncredential.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wacq *WebAuthnCredentialQuery) FirstIDX(ctx context.Context) string {
+	id, err := wacq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebAuthnCredential entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebAuthnCredential entity is found.
+// Returns a *NotFoundError when no WebAuthnCredential entities are found.
+func (wacq *WebAuthnCredentialQuery) Only(ctx context.Context) (*WebAuthnCredential, error) {
+	nodes, err := wacq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webauthncredential.Label}
+	default:
+		return nil, &NotSingularError{webauthncredential.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wacq *WebAuthnCredentialQuery) OnlyX(ctx context.Context) *WebAuthnCredential {
+	node, err := wacq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebAuthnCredential ID in the query.
+// Returns a *NotSingularError when more than one WebAuthnCredential ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wacq *WebAuthnCredentialQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = wacq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = &NotSingularError{webauthncredential.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wacq *WebAuthnCredentialQuery) OnlyIDX(ctx context.Context) string {
+	id, err := wacq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebAuthnCredentials.
+func (wacq *WebAuthnCredentialQuery) All(ctx context.Context) ([]*WebAuthnCredential, error) {
+	if err := wacq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wacq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wacq *WebAuthnCredentialQuery) AllX(ctx context.Context) []*WebAuthnCredential {
+	nodes, err := wacq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebAuthnCredential IDs.
+func (wacq *WebAuthnCredentialQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := wacq.Select(webauthncredential.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wacq *WebAuthnCredentialQuery) IDsX(ctx context.Context) []string {
+	ids, err := wacq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wacq *WebAuthnCredentialQuery) Count(ctx context.Context) (int, error) {
+	if err := wacq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wacq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wacq *WebAuthnCredentialQuery) CountX(ctx context.Context) int {
+	count, err := wacq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wacq *WebAuthnCredentialQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wacq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wacq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wacq *WebAuthnCredentialQuery) ExistX(ctx context.Context) bool {
+	exist, err := wacq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebAuthnCredentialQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wacq *WebAuthnCredentialQuery) Clone() *WebAuthnCredentialQuery {
+	if wacq == nil {
+		return nil
+	}
+	return &WebAuthnCredentialQuery{
+		config:     wacq.config,
+		limit:      wacq.limit,
+		offset:     wacq.offset,
+		order:      append([]OrderFunc{}, wacq.order...),
+		predicates: append([]predicate.WebAuthnCredential{}, wacq.predicates...),
+		// clone intermediate query.
+		sql:    wacq.sql.Clone(),
+		path:   wacq.path,
+		unique: wacq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebAuthnCredential.Query().
+//		GroupBy(webauthncredential.FieldEmail).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+//
+func (wacq *WebAuthnCredentialQuery) GroupBy(field string, fields ...string) *WebAuthnCredentialGroupBy {
+	group := &WebAuthnCredentialGroupBy{config: wacq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wacq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wacq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Email string `json:"email,omitempty"`
+//	}
+//
+//	client.WebAuthnCredential.Query().
+//		Select(webauthncredential.FieldEmail).
+//		Scan(ctx, &v)
+//
+func (wacq *WebAuthnCredentialQuery) Select(fields ...string) *WebAuthnCredentialSelect {
+	wacq.fields = append(wacq.fields, fields...)
+	return &WebAuthnCredentialSelect{WebAuthnCredentialQuery: wacq}
+}
+
+func (wacq *WebAuthnCredentialQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wacq.fields {
+		if !webauthncredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if wacq.path != nil {
+		prev, err := wacq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wacq.sql = prev
+	}
+	return nil
+}
+
+func (wacq *WebAuthnCredentialQuery) sqlAll(ctx context.Context) ([]*WebAuthnCredential, error) {
+	var (
+		nodes = []*WebAuthnCredential{}
+		_spec = wacq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &WebAuthnCredential{config: wacq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("db: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wacq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wacq *WebAuthnCredentialQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wacq.querySpec()
+	_spec.Node.Columns = wacq.fields
+	if len(wacq.fields) > 0 {
+		_spec.Unique = wacq.unique != nil && *wacq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wacq.driver, _spec)
+}
+
+func (wacq *WebAuthnCredentialQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wacq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("db: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wacq *WebAuthnCredentialQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webauthncredential.Table,
+			Columns: webauthncredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webauthncredential.FieldID,
+			},
+		},
+		From:   wacq.sql,
+		Unique: true,
+	}
+	if unique := wacq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wacq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthncredential.FieldID)
+		for i := range fields {
+			if fields[i] != webauthncredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wacq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wacq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wacq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wacq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wacq *WebAuthnCredentialQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wacq.driver.Dialect())
+	t1 := builder.Table(webauthncredential.Table)
+	columns := wacq.fields
+	if len(columns) == 0 {
+		columns = webauthncredential.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wacq.sql != nil {
+		selector = wacq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wacq.unique != nil && *wacq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wacq.predicates {
+		p(selector)
+	}
+	for _, p := range wacq.order {
+		p(selector)
+	}
+	if offset := wacq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wacq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebAuthnCredentialGroupBy is the group-by builder for WebAuthnCredential entities.
+type WebAuthnCredentialGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wacgb *WebAuthnCredentialGroupBy) Aggregate(fns ...AggregateFunc) *WebAuthnCredentialGroupBy {
+	wacgb.fns = append(wacgb.fns, fns...)
+	return wacgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wacgb *WebAuthnCredentialGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wacgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wacgb.sql = query
+	return wacgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wacgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wacgb *WebAuthnCredentialGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wacgb.fields) > 1 {
+		return nil, errors.New("db: WebAuthnCredentialGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wacgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wacgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wacgb *WebAuthnCredentialGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wacgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = fmt.Errorf("db: WebAuthnCredentialGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) StringX(ctx context.Context) string {
+	v, err := wacgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wacgb *WebAuthnCredentialGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wacgb.fields) > 1 {
+		return nil, errors.New("db: WebAuthnCredentialGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wacgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wacgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wacgb *WebAuthnCredentialGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wacgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = fmt.Errorf("db: WebAuthnCredentialGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) IntX(ctx context.Context) int {
+	v, err := wacgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wacgb *WebAuthnCredentialGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wacgb.fields) > 1 {
+		return nil, errors.New("db: WebAuthnCredentialGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wacgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wacgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wacgb *WebAuthnCredentialGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wacgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = fmt.Errorf("db: WebAuthnCredentialGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := wacgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (wacgb *WebAuthnCredentialGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wacgb.fields) > 1 {
+		return nil, errors.New("db: WebAuthnCredentialGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wacgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wacgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (wacgb *WebAuthnCredentialGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wacgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = fmt.Errorf("db: WebAuthnCredentialGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wacgb *WebAuthnCredentialGroupBy) BoolX(ctx context.Context) bool {
+	v, err := wacgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wacgb *WebAuthnCredentialGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wacgb.fields {
+		if !webauthncredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wacgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wacgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wacgb *WebAuthnCredentialGroupBy) sqlQuery() *sql.Selector {
+	selector := wacgb.sql.Select()
+	aggregation := make([]string, 0, len(wacgb.fns))
+	for _, fn := range wacgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wacgb.fields)+len(wacgb.fns))
+		for _, f := range wacgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wacgb.fields...)...)
+}
+
+// WebAuthnCredentialSelect is the builder for selecting fields of WebAuthnCredential entities.
+type WebAuthnCredentialSelect struct {
+	*WebAuthnCredentialQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wacs *WebAuthnCredentialSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wacs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wacs.sql = wacs.WebAuthnCredentialQuery.sqlQuery(ctx)
+	return wacs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := wacs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (wacs *WebAuthnCredentialSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(wacs.fields) > 1 {
+		return nil, errors.New("db: WebAuthnCredentialSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := wacs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) StringsX(ctx context.Context) []string {
+	v, err := wacs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (wacs *WebAuthnCredentialSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = wacs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = fmt.Errorf("db: WebAuthnCredentialSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) StringX(ctx context.Context) string {
+	v, err := wacs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (wacs *WebAuthnCredentialSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(wacs.fields) > 1 {
+		return nil, errors.New("db: WebAuthnCredentialSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := wacs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) IntsX(ctx context.Context) []int {
+	v, err := wacs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (wacs *WebAuthnCredentialSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = wacs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = fmt.Errorf("db: WebAuthnCredentialSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) IntX(ctx context.Context) int {
+	v, err := wacs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (wacs *WebAuthnCredentialSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wacs.fields) > 1 {
+		return nil, errors.New("db: WebAuthnCredentialSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := wacs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := wacs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (wacs *WebAuthnCredentialSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = wacs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = fmt.Errorf("db: WebAuthnCredentialSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) Float64X(ctx context.Context) float64 {
+	v, err := wacs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (wacs *WebAuthnCredentialSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(wacs.fields) > 1 {
+		return nil, errors.New("db: WebAuthnCredentialSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := wacs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) BoolsX(ctx context.Context) []bool {
+	v, err := wacs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (wacs *WebAuthnCredentialSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = wacs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{webauthncredential.Label}
+	default:
+		err = fmt.Errorf("db: WebAuthnCredentialSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (wacs *WebAuthnCredentialSelect) BoolX(ctx context.Context) bool {
+	v, err := wacs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wacs *WebAuthnCredentialSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wacs.sql.Query()
+	if err := wacs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
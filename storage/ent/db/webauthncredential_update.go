@@ -0,0 +1,556 @@
+// Code generated by entc, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dexidp/dex/storage/ent/db/predicate"
+	"github.com/dexidp/dex/storage/ent/db/webauthncredential"
+)
+
+// WebAuthnCredentialUpdate is the builder for updating WebAuthnCredential entities.
+type WebAuthnCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebAuthnCredentialMutation
+}
+
+// Where appends a list predicates to the WebAuthnCredentialUpdate builder.
+func (wacu *WebAuthnCredentialUpdate) Where(ps ...predicate.WebAuthnCredential) *WebAuthnCredentialUpdate {
+	wacu.mutation.Where(ps...)
+	return wacu
+}
+
+// SetEmail sets the "email" field.
+func (wacu *WebAuthnCredentialUpdate) SetEmail(s string) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetEmail(s)
+	return wacu
+}
+
+// SetPublicKey sets the "public_key" field.
+func (wacu *WebAuthnCredentialUpdate) SetPublicKey(b []byte) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetPublicKey(b)
+	return wacu
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wacu *WebAuthnCredentialUpdate) SetAttestationType(s string) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetAttestationType(s)
+	return wacu
+}
+
+// SetTransports sets the "transports" field.
+func (wacu *WebAuthnCredentialUpdate) SetTransports(s []string) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetTransports(s)
+	return wacu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wacu *WebAuthnCredentialUpdate) ClearTransports() *WebAuthnCredentialUpdate {
+	wacu.mutation.ClearTransports()
+	return wacu
+}
+
+// SetAaguid sets the "aaguid" field.
+func (wacu *WebAuthnCredentialUpdate) SetAaguid(b []byte) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetAaguid(b)
+	return wacu
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (wacu *WebAuthnCredentialUpdate) ClearAaguid() *WebAuthnCredentialUpdate {
+	wacu.mutation.ClearAaguid()
+	return wacu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wacu *WebAuthnCredentialUpdate) SetSignCount(u uint32) *WebAuthnCredentialUpdate {
+	wacu.mutation.ResetSignCount()
+	wacu.mutation.SetSignCount(u)
+	return wacu
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (wacu *WebAuthnCredentialUpdate) AddSignCount(u int32) *WebAuthnCredentialUpdate {
+	wacu.mutation.AddSignCount(u)
+	return wacu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wacu *WebAuthnCredentialUpdate) SetCreatedAt(t time.Time) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetCreatedAt(t)
+	return wacu
+}
+
+// SetLastUsed sets the "last_used" field.
+func (wacu *WebAuthnCredentialUpdate) SetLastUsed(t time.Time) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetLastUsed(t)
+	return wacu
+}
+
+// Mutation returns the WebAuthnCredentialMutation object of the builder.
+func (wacu *WebAuthnCredentialUpdate) Mutation() *WebAuthnCredentialMutation {
+	return wacu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wacu *WebAuthnCredentialUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wacu.hooks) == 0 {
+		if err = wacu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wacu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebAuthnCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wacu.check(); err != nil {
+				return 0, err
+			}
+			wacu.mutation = mutation
+			affected, err = wacu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wacu.hooks) - 1; i >= 0; i-- {
+			if wacu.hooks[i] == nil {
+				return 0, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = wacu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wacu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wacu *WebAuthnCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := wacu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wacu *WebAuthnCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := wacu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacu *WebAuthnCredentialUpdate) ExecX(ctx context.Context) {
+	if err := wacu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacu *WebAuthnCredentialUpdate) check() error {
+	if v, ok := wacu.mutation.Email(); ok {
+		if err := webauthncredential.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`db: validator failed for field "WebAuthnCredential.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wacu *WebAuthnCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webauthncredential.Table,
+			Columns: webauthncredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webauthncredential.FieldID,
+			},
+		},
+	}
+	if ps := wacu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wacu.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldEmail,
+		})
+	}
+	if value, ok := wacu.mutation.PublicKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldPublicKey,
+		})
+	}
+	if value, ok := wacu.mutation.AttestationType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldAttestationType,
+		})
+	}
+	if value, ok := wacu.mutation.Transports(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webauthncredential.FieldTransports,
+		})
+	}
+	if wacu.mutation.TransportsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: webauthncredential.FieldTransports,
+		})
+	}
+	if value, ok := wacu.mutation.Aaguid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldAaguid,
+		})
+	}
+	if wacu.mutation.AaguidCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: webauthncredential.FieldAaguid,
+		})
+	}
+	if value, ok := wacu.mutation.SignCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+	}
+	if value, ok := wacu.mutation.AddedSignCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+	}
+	if value, ok := wacu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldCreatedAt,
+		})
+	}
+	if value, ok := wacu.mutation.LastUsed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldLastUsed,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wacu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebAuthnCredentialUpdateOne is the builder for updating a single WebAuthnCredential entity.
+type WebAuthnCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebAuthnCredentialMutation
+}
+
+// SetEmail sets the "email" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetEmail(s string) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetEmail(s)
+	return wacuo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetPublicKey(b []byte) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetPublicKey(b)
+	return wacuo
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetAttestationType(s string) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetAttestationType(s)
+	return wacuo
+}
+
+// SetTransports sets the "transports" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetTransports(s []string) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetTransports(s)
+	return wacuo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wacuo *WebAuthnCredentialUpdateOne) ClearTransports() *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.ClearTransports()
+	return wacuo
+}
+
+// SetAaguid sets the "aaguid" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetAaguid(b []byte) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetAaguid(b)
+	return wacuo
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (wacuo *WebAuthnCredentialUpdateOne) ClearAaguid() *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.ClearAaguid()
+	return wacuo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetSignCount(u uint32) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.ResetSignCount()
+	wacuo.mutation.SetSignCount(u)
+	return wacuo
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (wacuo *WebAuthnCredentialUpdateOne) AddSignCount(u int32) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.AddSignCount(u)
+	return wacuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetCreatedAt(t time.Time) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetCreatedAt(t)
+	return wacuo
+}
+
+// SetLastUsed sets the "last_used" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetLastUsed(t time.Time) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetLastUsed(t)
+	return wacuo
+}
+
+// Mutation returns the WebAuthnCredentialMutation object of the builder.
+func (wacuo *WebAuthnCredentialUpdateOne) Mutation() *WebAuthnCredentialMutation {
+	return wacuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wacuo *WebAuthnCredentialUpdateOne) Select(field string, fields ...string) *WebAuthnCredentialUpdateOne {
+	wacuo.fields = append([]string{field}, fields...)
+	return wacuo
+}
+
+// Save executes the query and returns the updated WebAuthnCredential entity.
+func (wacuo *WebAuthnCredentialUpdateOne) Save(ctx context.Context) (*WebAuthnCredential, error) {
+	var (
+		err  error
+		node *WebAuthnCredential
+	)
+	if len(wacuo.hooks) == 0 {
+		if err = wacuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wacuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebAuthnCredentialMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wacuo.check(); err != nil {
+				return nil, err
+			}
+			wacuo.mutation = mutation
+			node, err = wacuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wacuo.hooks) - 1; i >= 0; i-- {
+			if wacuo.hooks[i] == nil {
+				return nil, fmt.Errorf("db: uninitialized hook (forgotten import db/runtime?)")
+			}
+			mut = wacuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wacuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wacuo *WebAuthnCredentialUpdateOne) SaveX(ctx context.Context) *WebAuthnCredential {
+	node, err := wacuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wacuo *WebAuthnCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := wacuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacuo *WebAuthnCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := wacuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacuo *WebAuthnCredentialUpdateOne) check() error {
+	if v, ok := wacuo.mutation.Email(); ok {
+		if err := webauthncredential.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`db: validator failed for field "WebAuthnCredential.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wacuo *WebAuthnCredentialUpdateOne) sqlSave(ctx context.Context) (_node *WebAuthnCredential, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webauthncredential.Table,
+			Columns: webauthncredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webauthncredential.FieldID,
+			},
+		},
+	}
+	id, ok := wacuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "WebAuthnCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wacuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthncredential.FieldID)
+		for _, f := range fields {
+			if !webauthncredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != webauthncredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wacuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wacuo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldEmail,
+		})
+	}
+	if value, ok := wacuo.mutation.PublicKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldPublicKey,
+		})
+	}
+	if value, ok := wacuo.mutation.AttestationType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webauthncredential.FieldAttestationType,
+		})
+	}
+	if value, ok := wacuo.mutation.Transports(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webauthncredential.FieldTransports,
+		})
+	}
+	if wacuo.mutation.TransportsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: webauthncredential.FieldTransports,
+		})
+	}
+	if value, ok := wacuo.mutation.Aaguid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: webauthncredential.FieldAaguid,
+		})
+	}
+	if wacuo.mutation.AaguidCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: webauthncredential.FieldAaguid,
+		})
+	}
+	if value, ok := wacuo.mutation.SignCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+	}
+	if value, ok := wacuo.mutation.AddedSignCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: webauthncredential.FieldSignCount,
+		})
+	}
+	if value, ok := wacuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldCreatedAt,
+		})
+	}
+	if value, ok := wacuo.mutation.LastUsed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webauthncredential.FieldLastUsed,
+		})
+	}
+	_node = &WebAuthnCredential{config: wacuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wacuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -23,7 +23,8 @@ create table auth_code
     expiry                    timestamp not null,
     claims_preferred_username text default '' not null,
     code_challenge            text default '' not null,
-    code_challenge_method     text default '' not null
+    code_challenge_method     text default '' not null,
+    claims_acr                text default '' not null
 );
 */
 
@@ -66,6 +67,9 @@ func (AuthCode) Fields() []ent.Field {
 		field.Text("claims_preferred_username").
 			SchemaType(textSchema).
 			Default(""),
+		field.Text("claims_acr").
+			SchemaType(textSchema).
+			Default(""),
 
 		field.Text("connector_id").
 			SchemaType(textSchema).
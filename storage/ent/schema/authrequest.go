@@ -27,7 +27,14 @@ create table auth_request
     expiry                    timestamp not null,
     claims_preferred_username text default '' not null,
     code_challenge            text default '' not null,
-    code_challenge_method     text default '' not null
+    code_challenge_method     text default '' not null,
+    response_mode             text default '' not null,
+    claims_acr                text default '' not null,
+    acr_values                blob,
+    connector_chain           blob,
+    mfa_validated             integer default false not null,
+    webauthn_challenge        text    default ''    not null,
+    callback_data             blob
 );
 */
 
@@ -71,6 +78,9 @@ func (AuthRequest) Fields() []ent.Field {
 		field.Text("claims_preferred_username").
 			SchemaType(textSchema).
 			Default(""),
+		field.Text("claims_acr").
+			SchemaType(textSchema).
+			Default(""),
 
 		field.Text("connector_id").
 			SchemaType(textSchema),
@@ -86,6 +96,20 @@ func (AuthRequest) Fields() []ent.Field {
 		field.Text("code_challenge_method").
 			SchemaType(textSchema).
 			Default(""),
+		field.Text("response_mode").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("acr_values", []string{}).
+			Optional(),
+		field.JSON("connector_chain", []string{}).
+			Optional(),
+		field.Bool("mfa_validated").
+			Default(false),
+		field.Text("webauthn_challenge").
+			SchemaType(textSchema).
+			Default(""),
+		field.Bytes("callback_data").
+			Optional(),
 	}
 }
 
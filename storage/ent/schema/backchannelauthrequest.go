@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table backchannel_auth_request
+(
+    id                        text      not null  primary key,
+    client_id                 text      not null,
+    scopes                    blob      not null,
+    login_hint                text      not null,
+    binding_message           text      not null,
+    client_notification_token text      not null,
+    expiry                    timestamp not null
+);
+*/
+
+// BackchannelAuthRequest holds the schema definition for the BackchannelAuthRequest entity.
+type BackchannelAuthRequest struct {
+	ent.Schema
+}
+
+// Fields of the BackchannelAuthRequest.
+func (BackchannelAuthRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("client_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.JSON("scopes", []string{}).
+			Optional(),
+		field.Text("login_hint").
+			SchemaType(textSchema),
+		field.Text("binding_message").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("client_notification_token").
+			SchemaType(textSchema).
+			Default(""),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the BackchannelAuthRequest.
+func (BackchannelAuthRequest) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"gopkg.in/square/go-jose.v2"
 )
 
 /* Original SQL table:
@@ -14,7 +15,17 @@ create table client
     trusted_peers blob    not null,
     public        integer not null,
     name          text    not null,
-    logo_url      text    not null
+    logo_url      text    not null,
+    backchannel_notification_endpoint text default '' not null,
+    id_token_encrypted_response_alg   text default '' not null,
+    id_token_encrypted_response_enc   text default '' not null,
+    userinfo_signed_response_alg      text default '' not null,
+    userinfo_encrypted_response_alg   text default '' not null,
+    userinfo_encrypted_response_enc   text default '' not null,
+    jwks                              blob,
+    subject_type                      text default '' not null,
+    sector_identifier                 text default '' not null,
+    backchannel_logout_uri            text default '' not null
 );
 */
 
@@ -45,6 +56,35 @@ func (OAuth2Client) Fields() []ent.Field {
 		field.Text("logo_url").
 			SchemaType(textSchema).
 			NotEmpty(),
+		field.Text("backchannel_notification_endpoint").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("id_token_encrypted_response_alg").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("id_token_encrypted_response_enc").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("userinfo_signed_response_alg").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("userinfo_encrypted_response_alg").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("userinfo_encrypted_response_enc").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("jwks", &jose.JSONWebKeySet{}).
+			Optional(),
+		field.Text("subject_type").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("sector_identifier").
+			SchemaType(textSchema).
+			Default(""),
+		field.Text("backchannel_logout_uri").
+			SchemaType(textSchema).
+			Default(""),
 	}
 }
 
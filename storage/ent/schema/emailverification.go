@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table email_verification
+(
+    id     text      not null primary key,
+    email  text      not null,
+    expiry timestamp not null
+);
+*/
+
+// EmailVerification holds the schema definition for the EmailVerification entity.
+type EmailVerification struct {
+	ent.Schema
+}
+
+// Fields of the EmailVerification.
+func (EmailVerification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("email").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the EmailVerification.
+func (EmailVerification) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table login_attempts
+(
+    id           text      not null primary key,
+    failures     integer   not null,
+    last_failure timestamp not null,
+    locked_until timestamp not null,
+    expiry       timestamp not null
+);
+*/
+
+// LoginAttempts holds the schema definition for the LoginAttempts entity.
+type LoginAttempts struct {
+	ent.Schema
+}
+
+// Fields of the LoginAttempts.
+func (LoginAttempts) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Int("failures"),
+		field.Time("last_failure").
+			SchemaType(timeSchema),
+		field.Time("locked_until").
+			SchemaType(timeSchema),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the LoginAttempts.
+func (LoginAttempts) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
@@ -8,10 +8,16 @@ import (
 /* Original SQL table:
 create table password
 (
-    email    text not null  primary key,
-    hash     blob not null,
-    username text not null,
-    user_id  text not null
+    email                text    not null  primary key,
+    hash                 blob    not null,
+    username             text    not null,
+    user_id              text    not null,
+    pending_verification integer not null default 0,
+    pending_approval     integer not null default 0,
+    user_groups          blob,
+    display_name         text    not null default '',
+    attributes           blob,
+    previous_hashes      blob
 );
 */
 
@@ -29,12 +35,25 @@ func (Password) Fields() []ent.Field {
 			NotEmpty().
 			Unique(),
 		field.Bytes("hash"),
+		field.JSON("previous_hashes", [][]byte{}).
+			Optional(),
 		field.Text("username").
 			SchemaType(textSchema).
 			NotEmpty(),
 		field.Text("user_id").
 			SchemaType(textSchema).
 			NotEmpty(),
+		field.Bool("pending_verification").
+			Default(false),
+		field.Bool("pending_approval").
+			Default(false),
+		field.JSON("groups", []string{}).
+			Optional(),
+		field.Text("display_name").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("attributes", map[string]string{}).
+			Optional(),
 	}
 }
 
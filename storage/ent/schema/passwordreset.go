@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table password_reset
+(
+    id     text      not null primary key,
+    email  text      not null,
+    expiry timestamp not null
+);
+*/
+
+// PasswordReset holds the schema definition for the PasswordReset entity.
+type PasswordReset struct {
+	ent.Schema
+}
+
+// Fields of the PasswordReset.
+func (PasswordReset) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("email").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the PasswordReset.
+func (PasswordReset) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
@@ -25,7 +25,8 @@ create table refresh_token
     created_at                timestamp default '0001-01-01 00:00:00 UTC' not null,
     last_used                 timestamp default '0001-01-01 00:00:00 UTC' not null,
     claims_preferred_username text      default '' not null,
-    obsolete_token            text      default ''
+    obsolete_token            text      default '',
+    claims_acr                text      default '' not null
 );
 */
 
@@ -65,6 +66,9 @@ func (RefreshToken) Fields() []ent.Field {
 		field.Text("claims_preferred_username").
 			SchemaType(textSchema).
 			Default(""),
+		field.Text("claims_acr").
+			SchemaType(textSchema).
+			Default(""),
 
 		field.Text("connector_id").
 			SchemaType(textSchema).
@@ -79,6 +83,8 @@ func (RefreshToken) Fields() []ent.Field {
 		field.Text("obsolete_token").
 			SchemaType(textSchema).
 			Default(""),
+		field.JSON("retired_tokens", []string{}).
+			Optional(),
 
 		field.Time("created_at").
 			SchemaType(timeSchema).
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table saml_assertion
+(
+    id     text      not null primary key,
+    expiry timestamp not null
+);
+*/
+
+// SAMLAssertion holds the schema definition for the SAMLAssertion entity.
+type SAMLAssertion struct {
+	ent.Schema
+}
+
+// Fields of the SAMLAssertion.
+func (SAMLAssertion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Time("expiry").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the SAMLAssertion.
+func (SAMLAssertion) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
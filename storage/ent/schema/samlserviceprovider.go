@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table saml_service_provider
+(
+    id              text not null primary key,
+    name            text not null,
+    acs_urls        blob not null,
+    name_id_format  text not null,
+    attribute_names blob not null
+);
+*/
+
+// SAMLServiceProvider holds the schema definition for the SAMLServiceProvider entity.
+type SAMLServiceProvider struct {
+	ent.Schema
+}
+
+// Fields of the SAMLServiceProvider.
+func (SAMLServiceProvider) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("name").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("acs_urls", []string{}).
+			Optional(),
+		field.Text("name_id_format").
+			SchemaType(textSchema).
+			Default(""),
+		field.JSON("attribute_names", map[string]string{}).
+			Optional(),
+	}
+}
+
+// Edges of the SAMLServiceProvider.
+func (SAMLServiceProvider) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table totp_enrollment
+(
+    user_id        text      not null,
+    conn_id        text      not null,
+    secret         blob      not null,
+    confirmed      integer   not null,
+    recovery_codes blob      not null,
+    last_used_step integer   not null,
+    created_at     timestamp not null,
+    primary key (user_id, conn_id)
+);
+*/
+
+// TOTPEnrollment holds the schema definition for the TOTPEnrollment entity.
+type TOTPEnrollment struct {
+	ent.Schema
+}
+
+// Fields of the TOTPEnrollment.
+func (TOTPEnrollment) Fields() []ent.Field {
+	return []ent.Field{
+		// Using id field here because it's impossible to create multi-key primary yet
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("user_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Text("conn_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Bytes("secret"),
+		field.Bool("confirmed"),
+		field.JSON("recovery_codes", []string{}).
+			Optional(),
+		field.Int64("last_used_step"),
+		field.Time("created_at").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the TOTPEnrollment.
+func (TOTPEnrollment) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table user_consent
+(
+    user_id text not null,
+    conn_id text not null,
+    clients blob not null,
+    primary key (user_id, conn_id)
+);
+*/
+
+// UserConsent holds the schema definition for the UserConsent entity.
+type UserConsent struct {
+	ent.Schema
+}
+
+// Fields of the UserConsent.
+func (UserConsent) Fields() []ent.Field {
+	return []ent.Field{
+		// Using id field here because it's impossible to create multi-key primary yet
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("user_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Text("conn_id").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Bytes("clients"),
+	}
+}
+
+// Edges of the UserConsent.
+func (UserConsent) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
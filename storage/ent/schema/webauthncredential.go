@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+/* Original SQL table:
+create table webauthn_credential
+(
+    id               text      not null primary key,
+    email            text      not null,
+    public_key       blob      not null,
+    attestation_type text      not null,
+    transports       blob      not null,
+    aaguid           blob      not null,
+    sign_count       integer   not null,
+    created_at       timestamp not null,
+    last_used        timestamp not null
+);
+*/
+
+// WebAuthnCredential holds the schema definition for the WebAuthnCredential entity.
+type WebAuthnCredential struct {
+	ent.Schema
+}
+
+// Fields of the WebAuthnCredential.
+func (WebAuthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("id").
+			SchemaType(textSchema).
+			NotEmpty().
+			Unique(),
+		field.Text("email").
+			SchemaType(textSchema).
+			NotEmpty(),
+		field.Bytes("public_key"),
+		field.Text("attestation_type").
+			SchemaType(textSchema),
+		field.JSON("transports", []string{}).
+			Optional(),
+		field.Bytes("aaguid").
+			Optional(),
+		field.Uint32("sign_count"),
+		field.Time("created_at").
+			SchemaType(timeSchema),
+		field.Time("last_used").
+			SchemaType(timeSchema),
+	}
+}
+
+// Edges of the WebAuthnCredential.
+func (WebAuthnCredential) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
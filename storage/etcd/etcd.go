@@ -14,16 +14,25 @@ import (
 )
 
 const (
-	clientPrefix         = "client/"
-	authCodePrefix       = "auth_code/"
-	refreshTokenPrefix   = "refresh_token/"
-	authRequestPrefix    = "auth_req/"
-	passwordPrefix       = "password/"
-	offlineSessionPrefix = "offline_session/"
-	connectorPrefix      = "connector/"
-	keysName             = "openid-connect-keys"
-	deviceRequestPrefix  = "device_req/"
-	deviceTokenPrefix    = "device_token/"
+	clientPrefix            = "client/"
+	authCodePrefix          = "auth_code/"
+	refreshTokenPrefix      = "refresh_token/"
+	authRequestPrefix       = "auth_req/"
+	passwordPrefix          = "password/"
+	offlineSessionPrefix    = "offline_session/"
+	userConsentPrefix       = "user_consent/"
+	totpPrefix              = "totp/"
+	webAuthnPrefix          = "webauthn_credential/"
+	loginAttemptsPrefix     = "login_attempts/"
+	passwordResetPrefix     = "password_reset/"
+	emailVerificationPrefix = "email_verification/"
+	samlAssertionPrefix     = "saml_assertion/"
+	samlSPPrefix            = "saml_service_provider/"
+	connectorPrefix         = "connector/"
+	keysName                = "openid-connect-keys"
+	deviceRequestPrefix     = "device_req/"
+	deviceTokenPrefix       = "device_token/"
+	backchannelReqPrefix    = "backchannel_req/"
 
 	// defaultStorageTimeout will be applied to all storage's operations.
 	defaultStorageTimeout = 5 * time.Second
@@ -104,6 +113,81 @@ func (c *conn) GarbageCollect(now time.Time) (result storage.GCResult, err error
 			result.DeviceTokens++
 		}
 	}
+
+	backchannelReqs, err := c.listBackchannelAuthRequests(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, backchannelReq := range backchannelReqs {
+		if now.After(backchannelReq.Expiry) {
+			if err := c.deleteKey(ctx, keyID(backchannelReqPrefix, backchannelReq.ID)); err != nil {
+				c.logger.Errorf("failed to delete backchannel auth request %v", err)
+				delErr = fmt.Errorf("failed to delete backchannel auth request: %v", err)
+			}
+			result.BackchannelAuthRequests++
+		}
+	}
+
+	loginAttempts, err := c.listLoginAttempts(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, attempts := range loginAttempts {
+		if now.After(attempts.Expiry) {
+			if err := c.deleteKey(ctx, keyID(loginAttemptsPrefix, attempts.ID)); err != nil {
+				c.logger.Errorf("failed to delete login attempts %v", err)
+				delErr = fmt.Errorf("failed to delete login attempts: %v", err)
+			}
+			result.LoginAttempts++
+		}
+	}
+
+	passwordResets, err := c.listPasswordResets(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, reset := range passwordResets {
+		if now.After(reset.Expiry) {
+			if err := c.deleteKey(ctx, keyID(passwordResetPrefix, reset.ID)); err != nil {
+				c.logger.Errorf("failed to delete password reset %v", err)
+				delErr = fmt.Errorf("failed to delete password reset: %v", err)
+			}
+			result.PasswordResets++
+		}
+	}
+
+	emailVerifications, err := c.listEmailVerifications(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, v := range emailVerifications {
+		if now.After(v.Expiry) {
+			if err := c.deleteKey(ctx, keyID(emailVerificationPrefix, v.ID)); err != nil {
+				c.logger.Errorf("failed to delete email verification %v", err)
+				delErr = fmt.Errorf("failed to delete email verification: %v", err)
+			}
+			result.EmailVerifications++
+		}
+	}
+
+	samlAssertions, err := c.listSAMLAssertions(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, a := range samlAssertions {
+		if now.After(a.Expiry) {
+			if err := c.deleteKey(ctx, keyID(samlAssertionPrefix, a.ID)); err != nil {
+				c.logger.Errorf("failed to delete saml assertion %v", err)
+				delErr = fmt.Errorf("failed to delete saml assertion: %v", err)
+			}
+			result.SAMLAssertions++
+		}
+	}
 	return result, delErr
 }
 
@@ -375,6 +459,391 @@ func (c *conn) DeleteOfflineSessions(userID string, connID string) error {
 	return c.deleteKey(ctx, keySession(userID, connID))
 }
 
+func (c *conn) CreateUserConsent(uc storage.UserConsent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyConsent(uc.UserID, uc.ConnID), fromStorageUserConsent(uc))
+}
+
+func (c *conn) UpdateUserConsent(userID string, connID string, updater func(uc storage.UserConsent) (storage.UserConsent, error)) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyConsent(userID, connID), func(currentValue []byte) ([]byte, error) {
+		var current UserConsent
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(toStorageUserConsent(current))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(fromStorageUserConsent(updated))
+	})
+}
+
+func (c *conn) GetUserConsent(userID string, connID string) (uc storage.UserConsent, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var consent UserConsent
+	if err = c.getKey(ctx, keyConsent(userID, connID), &consent); err != nil {
+		return
+	}
+	return toStorageUserConsent(consent), nil
+}
+
+func (c *conn) DeleteUserConsent(userID string, connID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyConsent(userID, connID))
+}
+
+func (c *conn) CreateTOTPEnrollment(t storage.TOTPEnrollment) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyTOTP(t.UserID, t.ConnID), fromStorageTOTPEnrollment(t))
+}
+
+func (c *conn) UpdateTOTPEnrollment(userID string, connID string, updater func(t storage.TOTPEnrollment) (storage.TOTPEnrollment, error)) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyTOTP(userID, connID), func(currentValue []byte) ([]byte, error) {
+		var current TOTPEnrollment
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(toStorageTOTPEnrollment(current))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(fromStorageTOTPEnrollment(updated))
+	})
+}
+
+func (c *conn) GetTOTPEnrollment(userID string, connID string) (t storage.TOTPEnrollment, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var enrollment TOTPEnrollment
+	if err = c.getKey(ctx, keyTOTP(userID, connID), &enrollment); err != nil {
+		return
+	}
+	return toStorageTOTPEnrollment(enrollment), nil
+}
+
+func (c *conn) DeleteTOTPEnrollment(userID string, connID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyTOTP(userID, connID))
+}
+
+func (c *conn) CreateWebAuthnCredential(cred storage.WebAuthnCredential) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyID(webAuthnPrefix, cred.ID), fromStorageWebAuthnCredential(cred))
+}
+
+func (c *conn) GetWebAuthnCredential(id string) (storage.WebAuthnCredential, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var cred WebAuthnCredential
+	if err := c.getKey(ctx, keyID(webAuthnPrefix, id), &cred); err != nil {
+		return storage.WebAuthnCredential{}, err
+	}
+	return toStorageWebAuthnCredential(cred), nil
+}
+
+func (c *conn) ListWebAuthnCredentials(email string) (creds []storage.WebAuthnCredential, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	res, err := c.db.Get(ctx, webAuthnPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return creds, err
+	}
+	for _, v := range res.Kvs {
+		var cred WebAuthnCredential
+		if err = json.Unmarshal(v.Value, &cred); err != nil {
+			return creds, err
+		}
+		if strings.EqualFold(cred.Email, email) {
+			creds = append(creds, toStorageWebAuthnCredential(cred))
+		}
+	}
+	return creds, nil
+}
+
+func (c *conn) UpdateWebAuthnCredential(id string, updater func(c storage.WebAuthnCredential) (storage.WebAuthnCredential, error)) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyID(webAuthnPrefix, id), func(currentValue []byte) ([]byte, error) {
+		var current WebAuthnCredential
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(toStorageWebAuthnCredential(current))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(fromStorageWebAuthnCredential(updated))
+	})
+}
+
+func (c *conn) DeleteWebAuthnCredential(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(webAuthnPrefix, id))
+}
+
+func (c *conn) CreateLoginAttempts(a storage.LoginAttempts) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyID(loginAttemptsPrefix, a.ID), fromStorageLoginAttempts(a))
+}
+
+func (c *conn) GetLoginAttempts(id string) (storage.LoginAttempts, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var a LoginAttempts
+	if err := c.getKey(ctx, keyID(loginAttemptsPrefix, id), &a); err != nil {
+		return storage.LoginAttempts{}, err
+	}
+	return toStorageLoginAttempts(a), nil
+}
+
+func (c *conn) listLoginAttempts(ctx context.Context) (attempts []LoginAttempts, err error) {
+	res, err := c.db.Get(ctx, loginAttemptsPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return attempts, err
+	}
+	for _, v := range res.Kvs {
+		var a LoginAttempts
+		if err = json.Unmarshal(v.Value, &a); err != nil {
+			return attempts, err
+		}
+		attempts = append(attempts, a)
+	}
+	return attempts, nil
+}
+
+func (c *conn) ListLoginAttempts() ([]storage.LoginAttempts, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	attempts, err := c.listLoginAttempts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]storage.LoginAttempts, len(attempts))
+	for i, a := range attempts {
+		result[i] = toStorageLoginAttempts(a)
+	}
+	return result, nil
+}
+
+func (c *conn) UpdateLoginAttempts(id string, updater func(a storage.LoginAttempts) (storage.LoginAttempts, error)) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyID(loginAttemptsPrefix, id), func(currentValue []byte) ([]byte, error) {
+		var current LoginAttempts
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(toStorageLoginAttempts(current))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(fromStorageLoginAttempts(updated))
+	})
+}
+
+func (c *conn) DeleteLoginAttempts(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(loginAttemptsPrefix, id))
+}
+
+func (c *conn) CreatePasswordReset(r storage.PasswordReset) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyID(passwordResetPrefix, r.ID), fromStoragePasswordReset(r))
+}
+
+func (c *conn) GetPasswordReset(id string) (storage.PasswordReset, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var r PasswordReset
+	if err := c.getKey(ctx, keyID(passwordResetPrefix, id), &r); err != nil {
+		return storage.PasswordReset{}, err
+	}
+	return toStoragePasswordReset(r), nil
+}
+
+func (c *conn) ListPasswordResets(email string) (resets []storage.PasswordReset, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	all, err := c.listPasswordResets(ctx)
+	if err != nil {
+		return resets, err
+	}
+	for _, r := range all {
+		if strings.EqualFold(r.Email, email) {
+			resets = append(resets, toStoragePasswordReset(r))
+		}
+	}
+	return resets, nil
+}
+
+func (c *conn) listPasswordResets(ctx context.Context) (resets []PasswordReset, err error) {
+	res, err := c.db.Get(ctx, passwordResetPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return resets, err
+	}
+	for _, v := range res.Kvs {
+		var r PasswordReset
+		if err = json.Unmarshal(v.Value, &r); err != nil {
+			return resets, err
+		}
+		resets = append(resets, r)
+	}
+	return resets, nil
+}
+
+func (c *conn) DeletePasswordReset(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(passwordResetPrefix, id))
+}
+
+func (c *conn) CreateEmailVerification(v storage.EmailVerification) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyID(emailVerificationPrefix, v.ID), fromStorageEmailVerification(v))
+}
+
+func (c *conn) GetEmailVerification(id string) (storage.EmailVerification, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var v EmailVerification
+	if err := c.getKey(ctx, keyID(emailVerificationPrefix, id), &v); err != nil {
+		return storage.EmailVerification{}, err
+	}
+	return toStorageEmailVerification(v), nil
+}
+
+func (c *conn) listEmailVerifications(ctx context.Context) (verifications []EmailVerification, err error) {
+	res, err := c.db.Get(ctx, emailVerificationPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return verifications, err
+	}
+	for _, kv := range res.Kvs {
+		var v EmailVerification
+		if err = json.Unmarshal(kv.Value, &v); err != nil {
+			return verifications, err
+		}
+		verifications = append(verifications, v)
+	}
+	return verifications, nil
+}
+
+func (c *conn) DeleteEmailVerification(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(emailVerificationPrefix, id))
+}
+
+func (c *conn) CreateSAMLAssertion(a storage.SAMLAssertion) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyID(samlAssertionPrefix, a.ID), fromStorageSAMLAssertion(a))
+}
+
+func (c *conn) GetSAMLAssertion(id string) (storage.SAMLAssertion, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var a SAMLAssertion
+	if err := c.getKey(ctx, keyID(samlAssertionPrefix, id), &a); err != nil {
+		return storage.SAMLAssertion{}, err
+	}
+	return toStorageSAMLAssertion(a), nil
+}
+
+func (c *conn) listSAMLAssertions(ctx context.Context) (assertions []SAMLAssertion, err error) {
+	res, err := c.db.Get(ctx, samlAssertionPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return assertions, err
+	}
+	for _, kv := range res.Kvs {
+		var a SAMLAssertion
+		if err = json.Unmarshal(kv.Value, &a); err != nil {
+			return assertions, err
+		}
+		assertions = append(assertions, a)
+	}
+	return assertions, nil
+}
+
+func (c *conn) CreateSAMLServiceProvider(sp storage.SAMLServiceProvider) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyID(samlSPPrefix, sp.ID), fromStorageSAMLServiceProvider(sp))
+}
+
+func (c *conn) GetSAMLServiceProvider(id string) (storage.SAMLServiceProvider, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var sp SAMLServiceProvider
+	if err := c.getKey(ctx, keyID(samlSPPrefix, id), &sp); err != nil {
+		return storage.SAMLServiceProvider{}, err
+	}
+	return toStorageSAMLServiceProvider(sp), nil
+}
+
+func (c *conn) UpdateSAMLServiceProvider(id string, updater func(sp storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error)) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnUpdate(ctx, keyID(samlSPPrefix, id), func(currentValue []byte) ([]byte, error) {
+		var current SAMLServiceProvider
+		if len(currentValue) > 0 {
+			if err := json.Unmarshal(currentValue, &current); err != nil {
+				return nil, err
+			}
+		}
+		updated, err := updater(toStorageSAMLServiceProvider(current))
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(fromStorageSAMLServiceProvider(updated))
+	})
+}
+
+func (c *conn) DeleteSAMLServiceProvider(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.deleteKey(ctx, keyID(samlSPPrefix, id))
+}
+
+func (c *conn) ListSAMLServiceProviders() (sps []storage.SAMLServiceProvider, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	res, err := c.db.Get(ctx, samlSPPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return sps, err
+	}
+	for _, kv := range res.Kvs {
+		var sp SAMLServiceProvider
+		if err = json.Unmarshal(kv.Value, &sp); err != nil {
+			return sps, err
+		}
+		sps = append(sps, toStorageSAMLServiceProvider(sp))
+	}
+	return sps, nil
+}
+
 func (c *conn) CreateConnector(connector storage.Connector) error {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
 	defer cancel()
@@ -568,6 +1037,14 @@ func keySession(userID, connID string) string {
 	return offlineSessionPrefix + strings.ToLower(userID+"|"+connID)
 }
 
+func keyConsent(userID, connID string) string {
+	return userConsentPrefix + strings.ToLower(userID+"|"+connID)
+}
+
+func keyTOTP(userID, connID string) string {
+	return totpPrefix + strings.ToLower(userID+"|"+connID)
+}
+
 func (c *conn) CreateDeviceRequest(d storage.DeviceRequest) error {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
 	defer cancel()
@@ -641,3 +1118,34 @@ func (c *conn) UpdateDeviceToken(deviceCode string, updater func(old storage.Dev
 		return json.Marshal(fromStorageDeviceToken(updated))
 	})
 }
+
+func (c *conn) CreateBackchannelAuthRequest(b storage.BackchannelAuthRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	return c.txnCreate(ctx, keyID(backchannelReqPrefix, b.ID), fromStorageBackchannelAuthRequest(b))
+}
+
+func (c *conn) GetBackchannelAuthRequest(id string) (b storage.BackchannelAuthRequest, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStorageTimeout)
+	defer cancel()
+	var req BackchannelAuthRequest
+	if err = c.getKey(ctx, keyID(backchannelReqPrefix, id), &req); err != nil {
+		return
+	}
+	return toStorageBackchannelAuthRequest(req), nil
+}
+
+func (c *conn) listBackchannelAuthRequests(ctx context.Context) (requests []BackchannelAuthRequest, err error) {
+	res, err := c.db.Get(ctx, backchannelReqPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return requests, err
+	}
+	for _, v := range res.Kvs {
+		var r BackchannelAuthRequest
+		if err = json.Unmarshal(v.Value, &r); err != nil {
+			return requests, err
+		}
+		requests = append(requests, r)
+	}
+	return requests, nil
+}
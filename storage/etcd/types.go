@@ -70,6 +70,8 @@ type AuthRequest struct {
 	RedirectURI   string   `json:"redirect_uri"`
 	Nonce         string   `json:"nonce"`
 	State         string   `json:"state"`
+	ResponseMode  string   `json:"response_mode,omitempty"`
+	ACRValues     []string `json:"acr_values,omitempty"`
 
 	ForceApprovalPrompt bool `json:"force_approval_prompt"`
 
@@ -79,8 +81,13 @@ type AuthRequest struct {
 
 	Claims Claims `json:"claims"`
 
-	ConnectorID   string `json:"connector_id"`
-	ConnectorData []byte `json:"connector_data"`
+	ConnectorID    string   `json:"connector_id"`
+	ConnectorData  []byte   `json:"connector_data"`
+	ConnectorChain []string `json:"connector_chain,omitempty"`
+	MFAValidated   bool     `json:"mfa_validated,omitempty"`
+
+	WebAuthnChallenge string `json:"webauthn_challenge,omitempty"`
+	CallbackData      []byte `json:"callback_data,omitempty"`
 
 	CodeChallenge       string `json:"code_challenge,omitempty"`
 	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
@@ -95,6 +102,12 @@ func fromStorageAuthRequest(a storage.AuthRequest) AuthRequest {
 		RedirectURI:         a.RedirectURI,
 		Nonce:               a.Nonce,
 		State:               a.State,
+		ResponseMode:        a.ResponseMode,
+		ACRValues:           a.ACRValues,
+		ConnectorChain:      a.ConnectorChain,
+		MFAValidated:        a.MFAValidated,
+		WebAuthnChallenge:   a.WebAuthnChallenge,
+		CallbackData:        a.CallbackData,
 		ForceApprovalPrompt: a.ForceApprovalPrompt,
 		Expiry:              a.Expiry,
 		LoggedIn:            a.LoggedIn,
@@ -115,6 +128,12 @@ func toStorageAuthRequest(a AuthRequest) storage.AuthRequest {
 		RedirectURI:         a.RedirectURI,
 		Nonce:               a.Nonce,
 		State:               a.State,
+		ResponseMode:        a.ResponseMode,
+		ACRValues:           a.ACRValues,
+		ConnectorChain:      a.ConnectorChain,
+		MFAValidated:        a.MFAValidated,
+		WebAuthnChallenge:   a.WebAuthnChallenge,
+		CallbackData:        a.CallbackData,
 		ForceApprovalPrompt: a.ForceApprovalPrompt,
 		LoggedIn:            a.LoggedIn,
 		ConnectorID:         a.ConnectorID,
@@ -132,8 +151,9 @@ func toStorageAuthRequest(a AuthRequest) storage.AuthRequest {
 type RefreshToken struct {
 	ID string `json:"id"`
 
-	Token         string `json:"token"`
-	ObsoleteToken string `json:"obsolete_token"`
+	Token         string   `json:"token"`
+	ObsoleteToken string   `json:"obsolete_token"`
+	RetiredTokens []string `json:"retired_tokens,omitempty"`
 
 	CreatedAt time.Time `json:"created_at"`
 	LastUsed  time.Time `json:"last_used"`
@@ -154,6 +174,7 @@ func toStorageRefreshToken(r RefreshToken) storage.RefreshToken {
 		ID:            r.ID,
 		Token:         r.Token,
 		ObsoleteToken: r.ObsoleteToken,
+		RetiredTokens: r.RetiredTokens,
 		CreatedAt:     r.CreatedAt,
 		LastUsed:      r.LastUsed,
 		ClientID:      r.ClientID,
@@ -170,6 +191,7 @@ func fromStorageRefreshToken(r storage.RefreshToken) RefreshToken {
 		ID:            r.ID,
 		Token:         r.Token,
 		ObsoleteToken: r.ObsoleteToken,
+		RetiredTokens: r.RetiredTokens,
 		CreatedAt:     r.CreatedAt,
 		LastUsed:      r.LastUsed,
 		ClientID:      r.ClientID,
@@ -189,6 +211,7 @@ type Claims struct {
 	Email             string   `json:"email"`
 	EmailVerified     bool     `json:"emailVerified"`
 	Groups            []string `json:"groups,omitempty"`
+	ACR               string   `json:"acr,omitempty"`
 }
 
 func fromStorageClaims(i storage.Claims) Claims {
@@ -199,6 +222,7 @@ func fromStorageClaims(i storage.Claims) Claims {
 		Email:             i.Email,
 		EmailVerified:     i.EmailVerified,
 		Groups:            i.Groups,
+		ACR:               i.ACR,
 	}
 }
 
@@ -210,6 +234,7 @@ func toStorageClaims(i Claims) storage.Claims {
 		Email:             i.Email,
 		EmailVerified:     i.EmailVerified,
 		Groups:            i.Groups,
+		ACR:               i.ACR,
 	}
 }
 
@@ -252,7 +277,233 @@ func toStorageOfflineSessions(o OfflineSessions) storage.OfflineSessions {
 	return s
 }
 
-// DeviceRequest is a mirrored struct from storage with JSON struct tags
+// UserConsent is a mirrored struct from storage with JSON struct tags
+type UserConsent struct {
+	UserID  string                            `json:"user_id,omitempty"`
+	ConnID  string                            `json:"conn_id,omitempty"`
+	Clients map[string]*storage.ClientConsent `json:"clients,omitempty"`
+}
+
+func fromStorageUserConsent(uc storage.UserConsent) UserConsent {
+	return UserConsent{
+		UserID:  uc.UserID,
+		ConnID:  uc.ConnID,
+		Clients: uc.Clients,
+	}
+}
+
+func toStorageUserConsent(uc UserConsent) storage.UserConsent {
+	s := storage.UserConsent{
+		UserID:  uc.UserID,
+		ConnID:  uc.ConnID,
+		Clients: uc.Clients,
+	}
+	if s.Clients == nil {
+		s.Clients = make(map[string]*storage.ClientConsent)
+	}
+	return s
+}
+
+// TOTPEnrollment is a mirrored struct from storage with JSON struct tags
+type TOTPEnrollment struct {
+	UserID        string    `json:"user_id,omitempty"`
+	ConnID        string    `json:"conn_id,omitempty"`
+	Secret        []byte    `json:"secret,omitempty"`
+	Confirmed     bool      `json:"confirmed,omitempty"`
+	RecoveryCodes []string  `json:"recovery_codes,omitempty"`
+	LastUsedStep  int64     `json:"last_used_step,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func fromStorageTOTPEnrollment(t storage.TOTPEnrollment) TOTPEnrollment {
+	return TOTPEnrollment{
+		UserID:        t.UserID,
+		ConnID:        t.ConnID,
+		Secret:        t.Secret,
+		Confirmed:     t.Confirmed,
+		RecoveryCodes: t.RecoveryCodes,
+		LastUsedStep:  t.LastUsedStep,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+func toStorageTOTPEnrollment(t TOTPEnrollment) storage.TOTPEnrollment {
+	return storage.TOTPEnrollment{
+		UserID:        t.UserID,
+		ConnID:        t.ConnID,
+		Secret:        t.Secret,
+		Confirmed:     t.Confirmed,
+		RecoveryCodes: t.RecoveryCodes,
+		LastUsedStep:  t.LastUsedStep,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+// WebAuthnCredential is a mirrored struct from storage with JSON struct tags
+type WebAuthnCredential struct {
+	ID              string    `json:"id"`
+	Email           string    `json:"email"`
+	PublicKey       []byte    `json:"public_key"`
+	AttestationType string    `json:"attestation_type,omitempty"`
+	Transports      []string  `json:"transports,omitempty"`
+	AAGUID          []byte    `json:"aaguid,omitempty"`
+	SignCount       uint32    `json:"sign_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	LastUsed        time.Time `json:"last_used"`
+}
+
+func fromStorageWebAuthnCredential(c storage.WebAuthnCredential) WebAuthnCredential {
+	return WebAuthnCredential{
+		ID:              c.ID,
+		Email:           c.Email,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      c.Transports,
+		AAGUID:          c.AAGUID,
+		SignCount:       c.SignCount,
+		CreatedAt:       c.CreatedAt,
+		LastUsed:        c.LastUsed,
+	}
+}
+
+func toStorageWebAuthnCredential(c WebAuthnCredential) storage.WebAuthnCredential {
+	return storage.WebAuthnCredential{
+		ID:              c.ID,
+		Email:           c.Email,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      c.Transports,
+		AAGUID:          c.AAGUID,
+		SignCount:       c.SignCount,
+		CreatedAt:       c.CreatedAt,
+		LastUsed:        c.LastUsed,
+	}
+}
+
+// LoginAttempts is a mirrored struct from storage with JSON struct tags
+type LoginAttempts struct {
+	ID          string    `json:"id"`
+	Failures    int       `json:"failures"`
+	LastFailure time.Time `json:"last_failure"`
+	LockedUntil time.Time `json:"locked_until"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+func fromStorageLoginAttempts(a storage.LoginAttempts) LoginAttempts {
+	return LoginAttempts{
+		ID:          a.ID,
+		Failures:    a.Failures,
+		LastFailure: a.LastFailure,
+		LockedUntil: a.LockedUntil,
+		Expiry:      a.Expiry,
+	}
+}
+
+func toStorageLoginAttempts(a LoginAttempts) storage.LoginAttempts {
+	return storage.LoginAttempts{
+		ID:          a.ID,
+		Failures:    a.Failures,
+		LastFailure: a.LastFailure,
+		LockedUntil: a.LockedUntil,
+		Expiry:      a.Expiry,
+	}
+}
+
+// PasswordReset is a mirrored struct from storage with JSON struct tags
+type PasswordReset struct {
+	ID     string    `json:"id"`
+	Email  string    `json:"email"`
+	Expiry time.Time `json:"expiry"`
+}
+
+func fromStoragePasswordReset(r storage.PasswordReset) PasswordReset {
+	return PasswordReset{
+		ID:     r.ID,
+		Email:  r.Email,
+		Expiry: r.Expiry,
+	}
+}
+
+func toStoragePasswordReset(r PasswordReset) storage.PasswordReset {
+	return storage.PasswordReset{
+		ID:     r.ID,
+		Email:  r.Email,
+		Expiry: r.Expiry,
+	}
+}
+
+// EmailVerification is a mirrored struct from storage with JSON struct tags
+type EmailVerification struct {
+	ID     string    `json:"id"`
+	Email  string    `json:"email"`
+	Expiry time.Time `json:"expiry"`
+}
+
+func fromStorageEmailVerification(v storage.EmailVerification) EmailVerification {
+	return EmailVerification{
+		ID:     v.ID,
+		Email:  v.Email,
+		Expiry: v.Expiry,
+	}
+}
+
+func toStorageEmailVerification(v EmailVerification) storage.EmailVerification {
+	return storage.EmailVerification{
+		ID:     v.ID,
+		Email:  v.Email,
+		Expiry: v.Expiry,
+	}
+}
+
+// SAMLAssertion is a mirrored struct from storage with JSON struct tags
+type SAMLAssertion struct {
+	ID     string    `json:"id"`
+	Expiry time.Time `json:"expiry"`
+}
+
+func fromStorageSAMLAssertion(a storage.SAMLAssertion) SAMLAssertion {
+	return SAMLAssertion{
+		ID:     a.ID,
+		Expiry: a.Expiry,
+	}
+}
+
+func toStorageSAMLAssertion(a SAMLAssertion) storage.SAMLAssertion {
+	return storage.SAMLAssertion{
+		ID:     a.ID,
+		Expiry: a.Expiry,
+	}
+}
+
+// SAMLServiceProvider is a mirrored struct from storage with JSON struct tags
+type SAMLServiceProvider struct {
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	ACSURLs        []string          `json:"acs_urls"`
+	NameIDFormat   string            `json:"name_id_format"`
+	AttributeNames map[string]string `json:"attribute_names,omitempty"`
+}
+
+func fromStorageSAMLServiceProvider(sp storage.SAMLServiceProvider) SAMLServiceProvider {
+	return SAMLServiceProvider{
+		ID:             sp.ID,
+		Name:           sp.Name,
+		ACSURLs:        sp.ACSURLs,
+		NameIDFormat:   sp.NameIDFormat,
+		AttributeNames: sp.AttributeNames,
+	}
+}
+
+func toStorageSAMLServiceProvider(sp SAMLServiceProvider) storage.SAMLServiceProvider {
+	return storage.SAMLServiceProvider{
+		ID:             sp.ID,
+		Name:           sp.Name,
+		ACSURLs:        sp.ACSURLs,
+		NameIDFormat:   sp.NameIDFormat,
+		AttributeNames: sp.AttributeNames,
+	}
+}
+
 type DeviceRequest struct {
 	UserCode     string    `json:"user_code"`
 	DeviceCode   string    `json:"device_code"`
@@ -304,3 +555,38 @@ func toStorageDeviceToken(t DeviceToken) storage.DeviceToken {
 		PollIntervalSeconds: t.PollIntervalSeconds,
 	}
 }
+
+// BackchannelAuthRequest is a mirrored struct from storage with JSON struct tags
+type BackchannelAuthRequest struct {
+	ID                      string    `json:"id"`
+	ClientID                string    `json:"client_id"`
+	Scopes                  []string  `json:"scopes"`
+	LoginHint               string    `json:"login_hint"`
+	BindingMessage          string    `json:"binding_message,omitempty"`
+	ClientNotificationToken string    `json:"client_notification_token,omitempty"`
+	Expiry                  time.Time `json:"expiry"`
+}
+
+func fromStorageBackchannelAuthRequest(b storage.BackchannelAuthRequest) BackchannelAuthRequest {
+	return BackchannelAuthRequest{
+		ID:                      b.ID,
+		ClientID:                b.ClientID,
+		Scopes:                  b.Scopes,
+		LoginHint:               b.LoginHint,
+		BindingMessage:          b.BindingMessage,
+		ClientNotificationToken: b.ClientNotificationToken,
+		Expiry:                  b.Expiry,
+	}
+}
+
+func toStorageBackchannelAuthRequest(b BackchannelAuthRequest) storage.BackchannelAuthRequest {
+	return storage.BackchannelAuthRequest{
+		ID:                      b.ID,
+		ClientID:                b.ClientID,
+		Scopes:                  b.Scopes,
+		LoginHint:               b.LoginHint,
+		BindingMessage:          b.BindingMessage,
+		ClientNotificationToken: b.ClientNotificationToken,
+		Expiry:                  b.Expiry,
+	}
+}
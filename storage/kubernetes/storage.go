@@ -15,29 +15,47 @@ import (
 )
 
 const (
-	kindAuthCode        = "AuthCode"
-	kindAuthRequest     = "AuthRequest"
-	kindClient          = "OAuth2Client"
-	kindRefreshToken    = "RefreshToken"
-	kindKeys            = "SigningKey"
-	kindPassword        = "Password"
-	kindOfflineSessions = "OfflineSessions"
-	kindConnector       = "Connector"
-	kindDeviceRequest   = "DeviceRequest"
-	kindDeviceToken     = "DeviceToken"
+	kindAuthCode          = "AuthCode"
+	kindAuthRequest       = "AuthRequest"
+	kindClient            = "OAuth2Client"
+	kindRefreshToken      = "RefreshToken"
+	kindKeys              = "SigningKey"
+	kindPassword          = "Password"
+	kindOfflineSessions   = "OfflineSessions"
+	kindConnector         = "Connector"
+	kindDeviceRequest     = "DeviceRequest"
+	kindDeviceToken       = "DeviceToken"
+	kindUserConsent       = "UserConsent"
+	kindTOTPEnrollment    = "TOTPEnrollment"
+	kindBackchannelReq    = "BackchannelAuthRequest"
+	kindWebAuthnCred      = "WebAuthnCredential"
+	kindLoginAttempts     = "LoginAttempts"
+	kindPasswordReset     = "PasswordReset"
+	kindEmailVerification = "EmailVerification"
+	kindSAMLAssertion     = "SAMLAssertion"
+	kindSAMLSP            = "SAMLServiceProvider"
 )
 
 const (
-	resourceAuthCode        = "authcodes"
-	resourceAuthRequest     = "authrequests"
-	resourceClient          = "oauth2clients"
-	resourceRefreshToken    = "refreshtokens"
-	resourceKeys            = "signingkeies" // Kubernetes attempts to pluralize.
-	resourcePassword        = "passwords"
-	resourceOfflineSessions = "offlinesessionses" // Again attempts to pluralize.
-	resourceConnector       = "connectors"
-	resourceDeviceRequest   = "devicerequests"
-	resourceDeviceToken     = "devicetokens"
+	resourceAuthCode          = "authcodes"
+	resourceAuthRequest       = "authrequests"
+	resourceClient            = "oauth2clients"
+	resourceRefreshToken      = "refreshtokens"
+	resourceKeys              = "signingkeies" // Kubernetes attempts to pluralize.
+	resourcePassword          = "passwords"
+	resourceOfflineSessions   = "offlinesessionses" // Again attempts to pluralize.
+	resourceConnector         = "connectors"
+	resourceDeviceRequest     = "devicerequests"
+	resourceDeviceToken       = "devicetokens"
+	resourceUserConsent       = "userconsents"
+	resourceTOTPEnrollment    = "totpenrollments"
+	resourceBackchannelReq    = "backchannelauthrequests"
+	resourceWebAuthnCred      = "webauthncredentials"
+	resourceLoginAttempts     = "loginattemptses" // Again attempts to pluralize.
+	resourcePasswordReset     = "passwordresets"
+	resourceEmailVerification = "emailverifications"
+	resourceSAMLAssertion     = "samlassertions"
+	resourceSAMLSP            = "samlserviceproviders"
 )
 
 // Config values for the Kubernetes storage type.
@@ -252,6 +270,14 @@ func (cli *client) CreateOfflineSessions(o storage.OfflineSessions) error {
 	return cli.post(resourceOfflineSessions, cli.fromStorageOfflineSessions(o))
 }
 
+func (cli *client) CreateUserConsent(uc storage.UserConsent) error {
+	return cli.post(resourceUserConsent, cli.fromStorageUserConsent(uc))
+}
+
+func (cli *client) CreateTOTPEnrollment(t storage.TOTPEnrollment) error {
+	return cli.post(resourceTOTPEnrollment, cli.fromStorageTOTPEnrollment(t))
+}
+
 func (cli *client) CreateConnector(c storage.Connector) error {
 	return cli.post(resourceConnector, cli.fromStorageConnector(c))
 }
@@ -354,6 +380,333 @@ func (cli *client) getOfflineSessions(userID string, connID string) (o OfflineSe
 	return o, nil
 }
 
+func (cli *client) GetUserConsent(userID string, connID string) (storage.UserConsent, error) {
+	uc, err := cli.getUserConsent(userID, connID)
+	if err != nil {
+		return storage.UserConsent{}, err
+	}
+	return toStorageUserConsent(uc), nil
+}
+
+func (cli *client) getUserConsent(userID string, connID string) (uc UserConsent, err error) {
+	name := cli.offlineTokenName(userID, connID)
+	if err = cli.get(resourceUserConsent, name, &uc); err != nil {
+		return UserConsent{}, err
+	}
+	if userID != uc.UserID || connID != uc.ConnID {
+		return UserConsent{}, fmt.Errorf("get user consent: wrong consent retrieved")
+	}
+	return uc, nil
+}
+
+func (cli *client) CreateWebAuthnCredential(c storage.WebAuthnCredential) error {
+	return cli.post(resourceWebAuthnCred, cli.fromStorageWebAuthnCredential(c))
+}
+
+func (cli *client) GetWebAuthnCredential(id string) (storage.WebAuthnCredential, error) {
+	c, err := cli.getWebAuthnCredential(id)
+	if err != nil {
+		return storage.WebAuthnCredential{}, err
+	}
+	return toStorageWebAuthnCredential(c), nil
+}
+
+func (cli *client) getWebAuthnCredential(id string) (WebAuthnCredential, error) {
+	var c WebAuthnCredential
+	name := cli.idToName(id)
+	if err := cli.get(resourceWebAuthnCred, name, &c); err != nil {
+		return WebAuthnCredential{}, err
+	}
+	if c.ID != id {
+		return WebAuthnCredential{}, fmt.Errorf("get webauthn credential: ID %q mapped to credential with ID %q", id, c.ID)
+	}
+	return c, nil
+}
+
+func (cli *client) ListWebAuthnCredentials(email string) (creds []storage.WebAuthnCredential, err error) {
+	var credList WebAuthnCredentialList
+	if err = cli.list(resourceWebAuthnCred, &credList); err != nil {
+		return creds, fmt.Errorf("failed to list webauthn credentials: %v", err)
+	}
+
+	email = strings.ToLower(email)
+	for _, c := range credList.Credentials {
+		if c.Email == email {
+			creds = append(creds, toStorageWebAuthnCredential(c))
+		}
+	}
+	return creds, nil
+}
+
+func (cli *client) UpdateWebAuthnCredential(id string, updater func(old storage.WebAuthnCredential) (storage.WebAuthnCredential, error)) error {
+	return retryOnConflict(context.TODO(), func() error {
+		c, err := cli.getWebAuthnCredential(id)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updater(toStorageWebAuthnCredential(c))
+		if err != nil {
+			return err
+		}
+		updated.ID = c.ID
+
+		newCred := cli.fromStorageWebAuthnCredential(updated)
+		newCred.ObjectMeta = c.ObjectMeta
+		return cli.put(resourceWebAuthnCred, c.ObjectMeta.Name, newCred)
+	})
+}
+
+func (cli *client) DeleteWebAuthnCredential(id string) error {
+	// Check for hash collision.
+	c, err := cli.getWebAuthnCredential(id)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourceWebAuthnCred, c.ObjectMeta.Name)
+}
+
+func (cli *client) CreateLoginAttempts(a storage.LoginAttempts) error {
+	return cli.post(resourceLoginAttempts, cli.fromStorageLoginAttempts(a))
+}
+
+func (cli *client) GetLoginAttempts(id string) (storage.LoginAttempts, error) {
+	a, err := cli.getLoginAttempts(id)
+	if err != nil {
+		return storage.LoginAttempts{}, err
+	}
+	return toStorageLoginAttempts(a), nil
+}
+
+func (cli *client) getLoginAttempts(id string) (LoginAttempts, error) {
+	var a LoginAttempts
+	name := cli.idToName(id)
+	if err := cli.get(resourceLoginAttempts, name, &a); err != nil {
+		return LoginAttempts{}, err
+	}
+	if a.ID != id {
+		return LoginAttempts{}, fmt.Errorf("get login attempts: ID %q mapped to login attempts with ID %q", id, a.ID)
+	}
+	return a, nil
+}
+
+func (cli *client) ListLoginAttempts() (attempts []storage.LoginAttempts, err error) {
+	var attemptsList LoginAttemptsList
+	if err = cli.list(resourceLoginAttempts, &attemptsList); err != nil {
+		return attempts, fmt.Errorf("failed to list login attempts: %v", err)
+	}
+
+	for _, a := range attemptsList.LoginAttempts {
+		attempts = append(attempts, toStorageLoginAttempts(a))
+	}
+	return attempts, nil
+}
+
+func (cli *client) UpdateLoginAttempts(id string, updater func(old storage.LoginAttempts) (storage.LoginAttempts, error)) error {
+	return retryOnConflict(context.TODO(), func() error {
+		a, err := cli.getLoginAttempts(id)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updater(toStorageLoginAttempts(a))
+		if err != nil {
+			return err
+		}
+		updated.ID = a.ID
+
+		newAttempts := cli.fromStorageLoginAttempts(updated)
+		newAttempts.ObjectMeta = a.ObjectMeta
+		return cli.put(resourceLoginAttempts, a.ObjectMeta.Name, newAttempts)
+	})
+}
+
+func (cli *client) DeleteLoginAttempts(id string) error {
+	// Check for hash collision.
+	a, err := cli.getLoginAttempts(id)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourceLoginAttempts, a.ObjectMeta.Name)
+}
+
+func (cli *client) CreatePasswordReset(r storage.PasswordReset) error {
+	return cli.post(resourcePasswordReset, cli.fromStoragePasswordReset(r))
+}
+
+func (cli *client) GetPasswordReset(id string) (storage.PasswordReset, error) {
+	r, err := cli.getPasswordReset(id)
+	if err != nil {
+		return storage.PasswordReset{}, err
+	}
+	return toStoragePasswordReset(r), nil
+}
+
+func (cli *client) getPasswordReset(id string) (PasswordReset, error) {
+	var r PasswordReset
+	name := cli.idToName(id)
+	if err := cli.get(resourcePasswordReset, name, &r); err != nil {
+		return PasswordReset{}, err
+	}
+	if r.ID != id {
+		return PasswordReset{}, fmt.Errorf("get password reset: ID %q mapped to password reset with ID %q", id, r.ID)
+	}
+	return r, nil
+}
+
+func (cli *client) ListPasswordResets(email string) (resets []storage.PasswordReset, err error) {
+	var resetList PasswordResetList
+	if err = cli.list(resourcePasswordReset, &resetList); err != nil {
+		return resets, fmt.Errorf("failed to list password resets: %v", err)
+	}
+
+	for _, r := range resetList.PasswordResets {
+		if strings.EqualFold(r.Email, email) {
+			resets = append(resets, toStoragePasswordReset(r))
+		}
+	}
+	return resets, nil
+}
+
+func (cli *client) DeletePasswordReset(id string) error {
+	// Check for hash collision.
+	r, err := cli.getPasswordReset(id)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourcePasswordReset, r.ObjectMeta.Name)
+}
+
+func (cli *client) CreateEmailVerification(v storage.EmailVerification) error {
+	return cli.post(resourceEmailVerification, cli.fromStorageEmailVerification(v))
+}
+
+func (cli *client) GetEmailVerification(id string) (storage.EmailVerification, error) {
+	v, err := cli.getEmailVerification(id)
+	if err != nil {
+		return storage.EmailVerification{}, err
+	}
+	return toStorageEmailVerification(v), nil
+}
+
+func (cli *client) getEmailVerification(id string) (EmailVerification, error) {
+	var v EmailVerification
+	name := cli.idToName(id)
+	if err := cli.get(resourceEmailVerification, name, &v); err != nil {
+		return EmailVerification{}, err
+	}
+	if v.ID != id {
+		return EmailVerification{}, fmt.Errorf("get email verification: ID %q mapped to email verification with ID %q", id, v.ID)
+	}
+	return v, nil
+}
+
+func (cli *client) DeleteEmailVerification(id string) error {
+	// Check for hash collision.
+	v, err := cli.getEmailVerification(id)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourceEmailVerification, v.ObjectMeta.Name)
+}
+
+func (cli *client) CreateSAMLAssertion(a storage.SAMLAssertion) error {
+	return cli.post(resourceSAMLAssertion, cli.fromStorageSAMLAssertion(a))
+}
+
+func (cli *client) GetSAMLAssertion(id string) (storage.SAMLAssertion, error) {
+	var a SAMLAssertion
+	if err := cli.get(resourceSAMLAssertion, cli.idToName(id), &a); err != nil {
+		return storage.SAMLAssertion{}, err
+	}
+	if a.ID != id {
+		return storage.SAMLAssertion{}, fmt.Errorf("get saml assertion: ID %q mapped to saml assertion with ID %q", id, a.ID)
+	}
+	return toStorageSAMLAssertion(a), nil
+}
+
+func (cli *client) CreateSAMLServiceProvider(sp storage.SAMLServiceProvider) error {
+	return cli.post(resourceSAMLSP, cli.fromStorageSAMLServiceProvider(sp))
+}
+
+func (cli *client) GetSAMLServiceProvider(id string) (storage.SAMLServiceProvider, error) {
+	sp, err := cli.getSAMLServiceProvider(id)
+	if err != nil {
+		return storage.SAMLServiceProvider{}, err
+	}
+	return toStorageSAMLServiceProvider(sp), nil
+}
+
+func (cli *client) getSAMLServiceProvider(id string) (SAMLServiceProvider, error) {
+	var sp SAMLServiceProvider
+	if err := cli.get(resourceSAMLSP, cli.idToName(id), &sp); err != nil {
+		return SAMLServiceProvider{}, err
+	}
+	if sp.ID != id {
+		return SAMLServiceProvider{}, fmt.Errorf("get saml service provider: ID %q mapped to saml service provider with ID %q", id, sp.ID)
+	}
+	return sp, nil
+}
+
+func (cli *client) ListSAMLServiceProviders() (sps []storage.SAMLServiceProvider, err error) {
+	var spList SAMLServiceProviderList
+	if err = cli.list(resourceSAMLSP, &spList); err != nil {
+		return sps, fmt.Errorf("failed to list saml service providers: %v", err)
+	}
+
+	for _, sp := range spList.SAMLServiceProviders {
+		sps = append(sps, toStorageSAMLServiceProvider(sp))
+	}
+	return sps, nil
+}
+
+func (cli *client) UpdateSAMLServiceProvider(id string, updater func(old storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error)) error {
+	return retryOnConflict(context.TODO(), func() error {
+		sp, err := cli.getSAMLServiceProvider(id)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updater(toStorageSAMLServiceProvider(sp))
+		if err != nil {
+			return err
+		}
+		updated.ID = sp.ID
+
+		newSP := cli.fromStorageSAMLServiceProvider(updated)
+		newSP.ObjectMeta = sp.ObjectMeta
+		return cli.put(resourceSAMLSP, sp.ObjectMeta.Name, newSP)
+	})
+}
+
+func (cli *client) DeleteSAMLServiceProvider(id string) error {
+	// Check for hash collision.
+	sp, err := cli.getSAMLServiceProvider(id)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourceSAMLSP, sp.ObjectMeta.Name)
+}
+
+func (cli *client) GetTOTPEnrollment(userID string, connID string) (storage.TOTPEnrollment, error) {
+	t, err := cli.getTOTPEnrollment(userID, connID)
+	if err != nil {
+		return storage.TOTPEnrollment{}, err
+	}
+	return toStorageTOTPEnrollment(t), nil
+}
+
+func (cli *client) getTOTPEnrollment(userID string, connID string) (t TOTPEnrollment, err error) {
+	name := cli.offlineTokenName(userID, connID)
+	if err = cli.get(resourceTOTPEnrollment, name, &t); err != nil {
+		return TOTPEnrollment{}, err
+	}
+	if userID != t.UserID || connID != t.ConnID {
+		return TOTPEnrollment{}, fmt.Errorf("get totp enrollment: wrong enrollment retrieved")
+	}
+	return t, nil
+}
+
 func (cli *client) GetConnector(id string) (storage.Connector, error) {
 	var c Connector
 	if err := cli.get(resourceConnector, id, &c); err != nil {
@@ -442,6 +795,24 @@ func (cli *client) DeleteOfflineSessions(userID string, connID string) error {
 	return cli.delete(resourceOfflineSessions, o.ObjectMeta.Name)
 }
 
+func (cli *client) DeleteUserConsent(userID string, connID string) error {
+	// Check for hash collision.
+	uc, err := cli.getUserConsent(userID, connID)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourceUserConsent, uc.ObjectMeta.Name)
+}
+
+func (cli *client) DeleteTOTPEnrollment(userID string, connID string) error {
+	// Check for hash collision.
+	t, err := cli.getTOTPEnrollment(userID, connID)
+	if err != nil {
+		return err
+	}
+	return cli.delete(resourceTOTPEnrollment, t.ObjectMeta.Name)
+}
+
 func (cli *client) DeleteConnector(id string) error {
 	return cli.delete(resourceConnector, id)
 }
@@ -516,6 +887,42 @@ func (cli *client) UpdateOfflineSessions(userID string, connID string, updater f
 	})
 }
 
+func (cli *client) UpdateUserConsent(userID string, connID string, updater func(old storage.UserConsent) (storage.UserConsent, error)) error {
+	return retryOnConflict(context.TODO(), func() error {
+		uc, err := cli.getUserConsent(userID, connID)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updater(toStorageUserConsent(uc))
+		if err != nil {
+			return err
+		}
+
+		newUserConsent := cli.fromStorageUserConsent(updated)
+		newUserConsent.ObjectMeta = uc.ObjectMeta
+		return cli.put(resourceUserConsent, uc.ObjectMeta.Name, newUserConsent)
+	})
+}
+
+func (cli *client) UpdateTOTPEnrollment(userID string, connID string, updater func(old storage.TOTPEnrollment) (storage.TOTPEnrollment, error)) error {
+	return retryOnConflict(context.TODO(), func() error {
+		t, err := cli.getTOTPEnrollment(userID, connID)
+		if err != nil {
+			return err
+		}
+
+		updated, err := updater(toStorageTOTPEnrollment(t))
+		if err != nil {
+			return err
+		}
+
+		newEnrollment := cli.fromStorageTOTPEnrollment(updated)
+		newEnrollment.ObjectMeta = t.ObjectMeta
+		return cli.put(resourceTOTPEnrollment, t.ObjectMeta.Name, newEnrollment)
+	})
+}
+
 func (cli *client) UpdateKeys(updater func(old storage.Keys) (storage.Keys, error)) error {
 	firstUpdate := false
 	var keys Keys
@@ -662,6 +1069,81 @@ func (cli *client) GarbageCollect(now time.Time) (result storage.GCResult, err e
 		}
 	}
 
+	var backchannelReqs BackchannelAuthRequestList
+	if err := cli.list(resourceBackchannelReq, &backchannelReqs); err != nil {
+		return result, fmt.Errorf("failed to list backchannel auth requests: %v", err)
+	}
+
+	for _, backchannelReq := range backchannelReqs.BackchannelAuthRequests {
+		if now.After(backchannelReq.Expiry) {
+			if err := cli.delete(resourceBackchannelReq, backchannelReq.ObjectMeta.Name); err != nil {
+				cli.logger.Errorf("failed to delete backchannel auth request: %v", err)
+				delErr = fmt.Errorf("failed to delete backchannel auth request: %v", err)
+			}
+			result.BackchannelAuthRequests++
+		}
+	}
+
+	var loginAttempts LoginAttemptsList
+	if err := cli.list(resourceLoginAttempts, &loginAttempts); err != nil {
+		return result, fmt.Errorf("failed to list login attempts: %v", err)
+	}
+
+	for _, attempts := range loginAttempts.LoginAttempts {
+		if now.After(attempts.Expiry) {
+			if err := cli.delete(resourceLoginAttempts, attempts.ObjectMeta.Name); err != nil {
+				cli.logger.Errorf("failed to delete login attempts: %v", err)
+				delErr = fmt.Errorf("failed to delete login attempts: %v", err)
+			}
+			result.LoginAttempts++
+		}
+	}
+
+	var passwordResets PasswordResetList
+	if err := cli.list(resourcePasswordReset, &passwordResets); err != nil {
+		return result, fmt.Errorf("failed to list password resets: %v", err)
+	}
+
+	for _, reset := range passwordResets.PasswordResets {
+		if now.After(reset.Expiry) {
+			if err := cli.delete(resourcePasswordReset, reset.ObjectMeta.Name); err != nil {
+				cli.logger.Errorf("failed to delete password reset: %v", err)
+				delErr = fmt.Errorf("failed to delete password reset: %v", err)
+			}
+			result.PasswordResets++
+		}
+	}
+
+	var emailVerifications EmailVerificationList
+	if err := cli.list(resourceEmailVerification, &emailVerifications); err != nil {
+		return result, fmt.Errorf("failed to list email verifications: %v", err)
+	}
+
+	for _, v := range emailVerifications.EmailVerifications {
+		if now.After(v.Expiry) {
+			if err := cli.delete(resourceEmailVerification, v.ObjectMeta.Name); err != nil {
+				cli.logger.Errorf("failed to delete email verification: %v", err)
+				delErr = fmt.Errorf("failed to delete email verification: %v", err)
+			}
+			result.EmailVerifications++
+		}
+	}
+
+	var samlAssertions SAMLAssertionList
+	if err := cli.list(resourceSAMLAssertion, &samlAssertions); err != nil {
+		return result, fmt.Errorf("failed to list saml assertions: %v", err)
+	}
+
+	for _, a := range samlAssertions.SAMLAssertions {
+		if now.After(a.Expiry) {
+			if err := cli.delete(resourceSAMLAssertion, a.ObjectMeta.Name); err != nil {
+				cli.logger.Errorf("failed to delete saml assertion: %v", err)
+				delErr = fmt.Errorf("failed to delete saml assertion: %v", err)
+			}
+			result.SAMLAssertions++
+		}
+	}
+
 	if delErr != nil {
 		return result, delErr
 	}
@@ -754,3 +1236,15 @@ func retryOnConflict(ctx context.Context, action func() error) error {
 		}
 	}
 }
+
+func (cli *client) CreateBackchannelAuthRequest(b storage.BackchannelAuthRequest) error {
+	return cli.post(resourceBackchannelReq, cli.fromStorageBackchannelAuthRequest(b))
+}
+
+func (cli *client) GetBackchannelAuthRequest(id string) (storage.BackchannelAuthRequest, error) {
+	var req BackchannelAuthRequest
+	if err := cli.get(resourceBackchannelReq, id, &req); err != nil {
+		return storage.BackchannelAuthRequest{}, err
+	}
+	return toStorageBackchannelAuthRequest(req), nil
+}
@@ -226,6 +226,159 @@ func customResourceDefinitions(apiVersion string) []k8sapi.CustomResourceDefinit
 				},
 			},
 		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "userconsents.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "userconsents",
+					Singular: "userconsent",
+					Kind:     "UserConsent",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "backchannelauthrequests.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "backchannelauthrequests",
+					Singular: "backchannelauthrequest",
+					Kind:     "BackchannelAuthRequest",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "totpenrollments.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "totpenrollments",
+					Singular: "totpenrollment",
+					Kind:     "TOTPEnrollment",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "webauthncredentials.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "webauthncredentials",
+					Singular: "webauthncredential",
+					Kind:     "WebAuthnCredential",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "loginattemptses.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "loginattemptses",
+					Singular: "loginattempts",
+					Kind:     "LoginAttempts",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "passwordresets.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "passwordresets",
+					Singular: "passwordreset",
+					Kind:     "PasswordReset",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "emailverifications.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "emailverifications",
+					Singular: "emailverification",
+					Kind:     "EmailVerification",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "samlassertions.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "samlassertions",
+					Singular: "samlassertion",
+					Kind:     "SAMLAssertion",
+				},
+			},
+		},
+		{
+			ObjectMeta: k8sapi.ObjectMeta{
+				Name: "samlserviceproviders.dex.coreos.com",
+			},
+			TypeMeta: crdMeta,
+			Spec: k8sapi.CustomResourceDefinitionSpec{
+				Group:    apiGroup,
+				Version:  version,
+				Versions: versions,
+				Scope:    scope,
+				Names: k8sapi.CustomResourceDefinitionNames{
+					Plural:   "samlserviceproviders",
+					Singular: "samlserviceprovider",
+					Kind:     "SAMLServiceProvider",
+				},
+			},
+		},
 	}
 }
 
@@ -251,6 +404,20 @@ type Client struct {
 
 	Name    string `json:"name,omitempty"`
 	LogoURL string `json:"logoURL,omitempty"`
+
+	BackchannelNotificationEndpoint string `json:"backchannelNotificationEndpoint,omitempty"`
+
+	IDTokenEncryptedResponseAlg  string              `json:"idTokenEncryptedResponseAlg,omitempty"`
+	IDTokenEncryptedResponseEnc  string              `json:"idTokenEncryptedResponseEnc,omitempty"`
+	UserInfoSignedResponseAlg    string              `json:"userInfoSignedResponseAlg,omitempty"`
+	UserInfoEncryptedResponseAlg string              `json:"userInfoEncryptedResponseAlg,omitempty"`
+	UserInfoEncryptedResponseEnc string              `json:"userInfoEncryptedResponseEnc,omitempty"`
+	JWKS                         *jose.JSONWebKeySet `json:"jwks,omitempty"`
+
+	SubjectType      string `json:"subjectType,omitempty"`
+	SectorIdentifier string `json:"sectorIdentifier,omitempty"`
+
+	BackchannelLogoutURI string `json:"backchannelLogoutURI,omitempty"`
 }
 
 // ClientList is a list of Clients.
@@ -277,6 +444,20 @@ func (cli *client) fromStorageClient(c storage.Client) Client {
 		Public:       c.Public,
 		Name:         c.Name,
 		LogoURL:      c.LogoURL,
+
+		BackchannelNotificationEndpoint: c.BackchannelNotificationEndpoint,
+
+		IDTokenEncryptedResponseAlg:  c.IDTokenEncryptedResponseAlg,
+		IDTokenEncryptedResponseEnc:  c.IDTokenEncryptedResponseEnc,
+		UserInfoSignedResponseAlg:    c.UserInfoSignedResponseAlg,
+		UserInfoEncryptedResponseAlg: c.UserInfoEncryptedResponseAlg,
+		UserInfoEncryptedResponseEnc: c.UserInfoEncryptedResponseEnc,
+		JWKS:                         c.JWKS,
+
+		SubjectType:      c.SubjectType,
+		SectorIdentifier: c.SectorIdentifier,
+
+		BackchannelLogoutURI: c.BackchannelLogoutURI,
 	}
 }
 
@@ -289,6 +470,20 @@ func toStorageClient(c Client) storage.Client {
 		Public:       c.Public,
 		Name:         c.Name,
 		LogoURL:      c.LogoURL,
+
+		BackchannelNotificationEndpoint: c.BackchannelNotificationEndpoint,
+
+		IDTokenEncryptedResponseAlg:  c.IDTokenEncryptedResponseAlg,
+		IDTokenEncryptedResponseEnc:  c.IDTokenEncryptedResponseEnc,
+		UserInfoSignedResponseAlg:    c.UserInfoSignedResponseAlg,
+		UserInfoEncryptedResponseAlg: c.UserInfoEncryptedResponseAlg,
+		UserInfoEncryptedResponseEnc: c.UserInfoEncryptedResponseEnc,
+		JWKS:                         c.JWKS,
+
+		SubjectType:      c.SubjectType,
+		SectorIdentifier: c.SectorIdentifier,
+
+		BackchannelLogoutURI: c.BackchannelLogoutURI,
 	}
 }
 
@@ -300,6 +495,7 @@ type Claims struct {
 	Email             string   `json:"email"`
 	EmailVerified     bool     `json:"emailVerified"`
 	Groups            []string `json:"groups,omitempty"`
+	ACR               string   `json:"acr,omitempty"`
 }
 
 func fromStorageClaims(i storage.Claims) Claims {
@@ -310,6 +506,7 @@ func fromStorageClaims(i storage.Claims) Claims {
 		Email:             i.Email,
 		EmailVerified:     i.EmailVerified,
 		Groups:            i.Groups,
+		ACR:               i.ACR,
 	}
 }
 
@@ -321,6 +518,7 @@ func toStorageClaims(i Claims) storage.Claims {
 		Email:             i.Email,
 		EmailVerified:     i.EmailVerified,
 		Groups:            i.Groups,
+		ACR:               i.ACR,
 	}
 }
 
@@ -338,6 +536,9 @@ type AuthRequest struct {
 	Nonce string `json:"nonce,omitempty"`
 	State string `json:"state,omitempty"`
 
+	ResponseMode string   `json:"responseMode,omitempty"`
+	ACRValues    []string `json:"acrValues,omitempty"`
+
 	// The client has indicated that the end user must be shown an approval prompt
 	// on all requests. The server cannot cache their initial action for subsequent
 	// attempts.
@@ -349,8 +550,13 @@ type AuthRequest struct {
 	// with a backend.
 	Claims Claims `json:"claims,omitempty"`
 	// The connector used to login the user. Set when the user authenticates.
-	ConnectorID   string `json:"connectorID,omitempty"`
-	ConnectorData []byte `json:"connectorData,omitempty"`
+	ConnectorID    string   `json:"connectorID,omitempty"`
+	ConnectorData  []byte   `json:"connectorData,omitempty"`
+	ConnectorChain []string `json:"connectorChain,omitempty"`
+	MFAValidated   bool     `json:"mfaValidated,omitempty"`
+
+	WebAuthnChallenge string `json:"webAuthnChallenge,omitempty"`
+	CallbackData      []byte `json:"callbackData,omitempty"`
 
 	Expiry time.Time `json:"expiry"`
 
@@ -374,6 +580,12 @@ func toStorageAuthRequest(req AuthRequest) storage.AuthRequest {
 		RedirectURI:         req.RedirectURI,
 		Nonce:               req.Nonce,
 		State:               req.State,
+		ResponseMode:        req.ResponseMode,
+		ACRValues:           req.ACRValues,
+		ConnectorChain:      req.ConnectorChain,
+		MFAValidated:        req.MFAValidated,
+		WebAuthnChallenge:   req.WebAuthnChallenge,
+		CallbackData:        req.CallbackData,
 		ForceApprovalPrompt: req.ForceApprovalPrompt,
 		LoggedIn:            req.LoggedIn,
 		ConnectorID:         req.ConnectorID,
@@ -404,6 +616,12 @@ func (cli *client) fromStorageAuthRequest(a storage.AuthRequest) AuthRequest {
 		RedirectURI:         a.RedirectURI,
 		Nonce:               a.Nonce,
 		State:               a.State,
+		ResponseMode:        a.ResponseMode,
+		ACRValues:           a.ACRValues,
+		ConnectorChain:      a.ConnectorChain,
+		MFAValidated:        a.MFAValidated,
+		WebAuthnChallenge:   a.WebAuthnChallenge,
+		CallbackData:        a.CallbackData,
 		LoggedIn:            a.LoggedIn,
 		ForceApprovalPrompt: a.ForceApprovalPrompt,
 		ConnectorID:         a.ConnectorID,
@@ -430,6 +648,15 @@ type Password struct {
 	Hash     []byte `json:"hash,omitempty"`
 	Username string `json:"username,omitempty"`
 	UserID   string `json:"userID,omitempty"`
+
+	PreviousHashes [][]byte `json:"previousHashes,omitempty"`
+
+	Groups      []string          `json:"groups,omitempty"`
+	DisplayName string            `json:"displayName,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+
+	PendingVerification bool `json:"pendingVerification,omitempty"`
+	PendingApproval     bool `json:"pendingApproval,omitempty"`
 }
 
 // PasswordList is a list of Passwords.
@@ -450,19 +677,31 @@ func (cli *client) fromStoragePassword(p storage.Password) Password {
 			Name:      cli.idToName(email),
 			Namespace: cli.namespace,
 		},
-		Email:    email,
-		Hash:     p.Hash,
-		Username: p.Username,
-		UserID:   p.UserID,
+		Email:               email,
+		Hash:                p.Hash,
+		PreviousHashes:      p.PreviousHashes,
+		Username:            p.Username,
+		UserID:              p.UserID,
+		Groups:              p.Groups,
+		DisplayName:         p.DisplayName,
+		Attributes:          p.Attributes,
+		PendingVerification: p.PendingVerification,
+		PendingApproval:     p.PendingApproval,
 	}
 }
 
 func toStoragePassword(p Password) storage.Password {
 	return storage.Password{
-		Email:    p.Email,
-		Hash:     p.Hash,
-		Username: p.Username,
-		UserID:   p.UserID,
+		Email:               p.Email,
+		Hash:                p.Hash,
+		PreviousHashes:      p.PreviousHashes,
+		Username:            p.Username,
+		UserID:              p.UserID,
+		Groups:              p.Groups,
+		DisplayName:         p.DisplayName,
+		Attributes:          p.Attributes,
+		PendingVerification: p.PendingVerification,
+		PendingApproval:     p.PendingApproval,
 	}
 }
 
@@ -550,8 +789,9 @@ type RefreshToken struct {
 	ClientID string   `json:"clientID"`
 	Scopes   []string `json:"scopes,omitempty"`
 
-	Token         string `json:"token,omitempty"`
-	ObsoleteToken string `json:"obsoleteToken,omitempty"`
+	Token         string   `json:"token,omitempty"`
+	ObsoleteToken string   `json:"obsoleteToken,omitempty"`
+	RetiredTokens []string `json:"retiredTokens,omitempty"`
 
 	Nonce string `json:"nonce,omitempty"`
 
@@ -572,6 +812,7 @@ func toStorageRefreshToken(r RefreshToken) storage.RefreshToken {
 		ID:            r.ObjectMeta.Name,
 		Token:         r.Token,
 		ObsoleteToken: r.ObsoleteToken,
+		RetiredTokens: r.RetiredTokens,
 		CreatedAt:     r.CreatedAt,
 		LastUsed:      r.LastUsed,
 		ClientID:      r.ClientID,
@@ -595,6 +836,7 @@ func (cli *client) fromStorageRefreshToken(r storage.RefreshToken) RefreshToken
 		},
 		Token:         r.Token,
 		ObsoleteToken: r.ObsoleteToken,
+		RetiredTokens: r.RetiredTokens,
 		CreatedAt:     r.CreatedAt,
 		LastUsed:      r.LastUsed,
 		ClientID:      r.ClientID,
@@ -694,6 +936,374 @@ func toStorageOfflineSessions(o OfflineSessions) storage.OfflineSessions {
 	return s
 }
 
+// UserConsent is a mirrored struct from storage with JSON struct tags and Kubernetes
+// type metadata.
+type UserConsent struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	UserID  string                            `json:"userID,omitempty"`
+	ConnID  string                            `json:"connID,omitempty"`
+	Clients map[string]*storage.ClientConsent `json:"clients,omitempty"`
+}
+
+func (cli *client) fromStorageUserConsent(uc storage.UserConsent) UserConsent {
+	return UserConsent{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindUserConsent,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.offlineTokenName(uc.UserID, uc.ConnID),
+			Namespace: cli.namespace,
+		},
+		UserID:  uc.UserID,
+		ConnID:  uc.ConnID,
+		Clients: uc.Clients,
+	}
+}
+
+func toStorageUserConsent(uc UserConsent) storage.UserConsent {
+	s := storage.UserConsent{
+		UserID:  uc.UserID,
+		ConnID:  uc.ConnID,
+		Clients: uc.Clients,
+	}
+	if s.Clients == nil {
+		s.Clients = make(map[string]*storage.ClientConsent)
+	}
+	return s
+}
+
+// TOTPEnrollment is a mirrored struct from storage with JSON struct tags and Kubernetes
+// type metadata.
+type TOTPEnrollment struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	UserID        string    `json:"userID,omitempty"`
+	ConnID        string    `json:"connID,omitempty"`
+	Secret        []byte    `json:"secret,omitempty"`
+	Confirmed     bool      `json:"confirmed,omitempty"`
+	RecoveryCodes []string  `json:"recoveryCodes,omitempty"`
+	LastUsedStep  int64     `json:"lastUsedStep,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+func (cli *client) fromStorageTOTPEnrollment(t storage.TOTPEnrollment) TOTPEnrollment {
+	return TOTPEnrollment{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindTOTPEnrollment,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.offlineTokenName(t.UserID, t.ConnID),
+			Namespace: cli.namespace,
+		},
+		UserID:        t.UserID,
+		ConnID:        t.ConnID,
+		Secret:        t.Secret,
+		Confirmed:     t.Confirmed,
+		RecoveryCodes: t.RecoveryCodes,
+		LastUsedStep:  t.LastUsedStep,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+func toStorageTOTPEnrollment(t TOTPEnrollment) storage.TOTPEnrollment {
+	return storage.TOTPEnrollment{
+		UserID:        t.UserID,
+		ConnID:        t.ConnID,
+		Secret:        t.Secret,
+		Confirmed:     t.Confirmed,
+		RecoveryCodes: t.RecoveryCodes,
+		LastUsedStep:  t.LastUsedStep,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+// WebAuthnCredential is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type WebAuthnCredential struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ID              string    `json:"id,omitempty"`
+	Email           string    `json:"email,omitempty"`
+	PublicKey       []byte    `json:"publicKey,omitempty"`
+	AttestationType string    `json:"attestationType,omitempty"`
+	Transports      []string  `json:"transports,omitempty"`
+	AAGUID          []byte    `json:"aaguid,omitempty"`
+	SignCount       uint32    `json:"signCount,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	LastUsed        time.Time `json:"lastUsed"`
+}
+
+// WebAuthnCredentialList is a list of WebAuthnCredentials.
+type WebAuthnCredentialList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	Credentials     []WebAuthnCredential `json:"items"`
+}
+
+func (cli *client) fromStorageWebAuthnCredential(c storage.WebAuthnCredential) WebAuthnCredential {
+	return WebAuthnCredential{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindWebAuthnCred,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.idToName(c.ID),
+			Namespace: cli.namespace,
+		},
+		ID:              c.ID,
+		Email:           strings.ToLower(c.Email),
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      c.Transports,
+		AAGUID:          c.AAGUID,
+		SignCount:       c.SignCount,
+		CreatedAt:       c.CreatedAt,
+		LastUsed:        c.LastUsed,
+	}
+}
+
+func toStorageWebAuthnCredential(c WebAuthnCredential) storage.WebAuthnCredential {
+	return storage.WebAuthnCredential{
+		ID:              c.ID,
+		Email:           c.Email,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transports:      c.Transports,
+		AAGUID:          c.AAGUID,
+		SignCount:       c.SignCount,
+		CreatedAt:       c.CreatedAt,
+		LastUsed:        c.LastUsed,
+	}
+}
+
+// LoginAttempts is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type LoginAttempts struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ID          string    `json:"id,omitempty"`
+	Failures    int       `json:"failures,omitempty"`
+	LastFailure time.Time `json:"lastFailure"`
+	LockedUntil time.Time `json:"lockedUntil"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// LoginAttemptsList is a list of LoginAttempts.
+type LoginAttemptsList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	LoginAttempts   []LoginAttempts `json:"items"`
+}
+
+func (cli *client) fromStorageLoginAttempts(a storage.LoginAttempts) LoginAttempts {
+	return LoginAttempts{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindLoginAttempts,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.idToName(a.ID),
+			Namespace: cli.namespace,
+		},
+		ID:          a.ID,
+		Failures:    a.Failures,
+		LastFailure: a.LastFailure,
+		LockedUntil: a.LockedUntil,
+		Expiry:      a.Expiry,
+	}
+}
+
+func toStorageLoginAttempts(a LoginAttempts) storage.LoginAttempts {
+	return storage.LoginAttempts{
+		ID:          a.ID,
+		Failures:    a.Failures,
+		LastFailure: a.LastFailure,
+		LockedUntil: a.LockedUntil,
+		Expiry:      a.Expiry,
+	}
+}
+
+// PasswordReset is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type PasswordReset struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ID     string    `json:"id,omitempty"`
+	Email  string    `json:"email,omitempty"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// PasswordResetList is a list of PasswordResets.
+type PasswordResetList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	PasswordResets  []PasswordReset `json:"items"`
+}
+
+func (cli *client) fromStoragePasswordReset(r storage.PasswordReset) PasswordReset {
+	return PasswordReset{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindPasswordReset,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.idToName(r.ID),
+			Namespace: cli.namespace,
+		},
+		ID:     r.ID,
+		Email:  r.Email,
+		Expiry: r.Expiry,
+	}
+}
+
+func toStoragePasswordReset(r PasswordReset) storage.PasswordReset {
+	return storage.PasswordReset{
+		ID:     r.ID,
+		Email:  r.Email,
+		Expiry: r.Expiry,
+	}
+}
+
+// EmailVerification is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type EmailVerification struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ID     string    `json:"id,omitempty"`
+	Email  string    `json:"email,omitempty"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// EmailVerificationList is a list of EmailVerifications.
+type EmailVerificationList struct {
+	k8sapi.TypeMeta    `json:",inline"`
+	k8sapi.ListMeta    `json:"metadata,omitempty"`
+	EmailVerifications []EmailVerification `json:"items"`
+}
+
+func (cli *client) fromStorageEmailVerification(v storage.EmailVerification) EmailVerification {
+	return EmailVerification{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindEmailVerification,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.idToName(v.ID),
+			Namespace: cli.namespace,
+		},
+		ID:     v.ID,
+		Email:  v.Email,
+		Expiry: v.Expiry,
+	}
+}
+
+func toStorageEmailVerification(v EmailVerification) storage.EmailVerification {
+	return storage.EmailVerification{
+		ID:     v.ID,
+		Email:  v.Email,
+		Expiry: v.Expiry,
+	}
+}
+
+// SAMLAssertion is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type SAMLAssertion struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ID     string    `json:"id,omitempty"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// SAMLAssertionList is a list of SAMLAssertions.
+type SAMLAssertionList struct {
+	k8sapi.TypeMeta `json:",inline"`
+	k8sapi.ListMeta `json:"metadata,omitempty"`
+	SAMLAssertions  []SAMLAssertion `json:"items"`
+}
+
+func (cli *client) fromStorageSAMLAssertion(a storage.SAMLAssertion) SAMLAssertion {
+	return SAMLAssertion{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindSAMLAssertion,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.idToName(a.ID),
+			Namespace: cli.namespace,
+		},
+		ID:     a.ID,
+		Expiry: a.Expiry,
+	}
+}
+
+func toStorageSAMLAssertion(a SAMLAssertion) storage.SAMLAssertion {
+	return storage.SAMLAssertion{
+		ID:     a.ID,
+		Expiry: a.Expiry,
+	}
+}
+
+// SAMLServiceProvider is a mirrored struct from storage with JSON struct tags
+// and Kubernetes type metadata.
+type SAMLServiceProvider struct {
+	// Name is a hash of the ID.
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	// ID is immutable, since it's a primary key and should not be changed.
+	ID string `json:"id,omitempty"`
+
+	Name           string            `json:"name,omitempty"`
+	ACSURLs        []string          `json:"acsURLs,omitempty"`
+	NameIDFormat   string            `json:"nameIDFormat,omitempty"`
+	AttributeNames map[string]string `json:"attributeNames,omitempty"`
+}
+
+// SAMLServiceProviderList is a list of SAMLServiceProviders.
+type SAMLServiceProviderList struct {
+	k8sapi.TypeMeta      `json:",inline"`
+	k8sapi.ListMeta      `json:"metadata,omitempty"`
+	SAMLServiceProviders []SAMLServiceProvider `json:"items"`
+}
+
+func (cli *client) fromStorageSAMLServiceProvider(sp storage.SAMLServiceProvider) SAMLServiceProvider {
+	return SAMLServiceProvider{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindSAMLSP,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      cli.idToName(sp.ID),
+			Namespace: cli.namespace,
+		},
+		ID:             sp.ID,
+		Name:           sp.Name,
+		ACSURLs:        sp.ACSURLs,
+		NameIDFormat:   sp.NameIDFormat,
+		AttributeNames: sp.AttributeNames,
+	}
+}
+
+func toStorageSAMLServiceProvider(sp SAMLServiceProvider) storage.SAMLServiceProvider {
+	return storage.SAMLServiceProvider{
+		ID:             sp.ID,
+		Name:           sp.Name,
+		ACSURLs:        sp.ACSURLs,
+		NameIDFormat:   sp.NameIDFormat,
+		AttributeNames: sp.AttributeNames,
+	}
+}
+
 // Connector is a mirrored struct from storage with JSON struct tags and Kubernetes
 // type metadata.
 type Connector struct {
@@ -840,3 +1450,55 @@ func toStorageDeviceToken(t DeviceToken) storage.DeviceToken {
 		PollIntervalSeconds: t.PollIntervalSeconds,
 	}
 }
+
+// BackchannelAuthRequest is a mirrored struct from storage with JSON struct tags and
+// Kubernetes type metadata.
+type BackchannelAuthRequest struct {
+	k8sapi.TypeMeta   `json:",inline"`
+	k8sapi.ObjectMeta `json:"metadata,omitempty"`
+
+	ClientID                string    `json:"clientID,omitempty"`
+	Scopes                  []string  `json:"scopes,omitempty"`
+	LoginHint               string    `json:"loginHint,omitempty"`
+	BindingMessage          string    `json:"bindingMessage,omitempty"`
+	ClientNotificationToken string    `json:"clientNotificationToken,omitempty"`
+	Expiry                  time.Time `json:"expiry"`
+}
+
+// BackchannelAuthRequestList is a list of BackchannelAuthRequests.
+type BackchannelAuthRequestList struct {
+	k8sapi.TypeMeta         `json:",inline"`
+	k8sapi.ListMeta         `json:"metadata,omitempty"`
+	BackchannelAuthRequests []BackchannelAuthRequest `json:"items"`
+}
+
+func (cli *client) fromStorageBackchannelAuthRequest(b storage.BackchannelAuthRequest) BackchannelAuthRequest {
+	return BackchannelAuthRequest{
+		TypeMeta: k8sapi.TypeMeta{
+			Kind:       kindBackchannelReq,
+			APIVersion: cli.apiVersion,
+		},
+		ObjectMeta: k8sapi.ObjectMeta{
+			Name:      b.ID,
+			Namespace: cli.namespace,
+		},
+		ClientID:                b.ClientID,
+		Scopes:                  b.Scopes,
+		LoginHint:               b.LoginHint,
+		BindingMessage:          b.BindingMessage,
+		ClientNotificationToken: b.ClientNotificationToken,
+		Expiry:                  b.Expiry,
+	}
+}
+
+func toStorageBackchannelAuthRequest(req BackchannelAuthRequest) storage.BackchannelAuthRequest {
+	return storage.BackchannelAuthRequest{
+		ID:                      req.ObjectMeta.Name,
+		ClientID:                req.ClientID,
+		Scopes:                  req.Scopes,
+		LoginHint:               req.LoginHint,
+		BindingMessage:          req.BindingMessage,
+		ClientNotificationToken: req.ClientNotificationToken,
+		Expiry:                  req.Expiry,
+	}
+}
@@ -13,16 +13,25 @@ import (
 // New returns an in memory storage.
 func New(logger log.Logger) storage.Storage {
 	return &memStorage{
-		clients:         make(map[string]storage.Client),
-		authCodes:       make(map[string]storage.AuthCode),
-		refreshTokens:   make(map[string]storage.RefreshToken),
-		authReqs:        make(map[string]storage.AuthRequest),
-		passwords:       make(map[string]storage.Password),
-		offlineSessions: make(map[offlineSessionID]storage.OfflineSessions),
-		connectors:      make(map[string]storage.Connector),
-		deviceRequests:  make(map[string]storage.DeviceRequest),
-		deviceTokens:    make(map[string]storage.DeviceToken),
-		logger:          logger,
+		clients:            make(map[string]storage.Client),
+		authCodes:          make(map[string]storage.AuthCode),
+		refreshTokens:      make(map[string]storage.RefreshToken),
+		authReqs:           make(map[string]storage.AuthRequest),
+		passwords:          make(map[string]storage.Password),
+		offlineSessions:    make(map[offlineSessionID]storage.OfflineSessions),
+		userConsents:       make(map[offlineSessionID]storage.UserConsent),
+		totpEnrollments:    make(map[offlineSessionID]storage.TOTPEnrollment),
+		webAuthnCreds:      make(map[string]storage.WebAuthnCredential),
+		loginAttempts:      make(map[string]storage.LoginAttempts),
+		passwordResets:     make(map[string]storage.PasswordReset),
+		emailVerifications: make(map[string]storage.EmailVerification),
+		samlAssertions:     make(map[string]storage.SAMLAssertion),
+		samlSPs:            make(map[string]storage.SAMLServiceProvider),
+		connectors:         make(map[string]storage.Connector),
+		deviceRequests:     make(map[string]storage.DeviceRequest),
+		deviceTokens:       make(map[string]storage.DeviceToken),
+		backchannelReqs:    make(map[string]storage.BackchannelAuthRequest),
+		logger:             logger,
 	}
 }
 
@@ -40,15 +49,24 @@ func (c *Config) Open(logger log.Logger) (storage.Storage, error) {
 type memStorage struct {
 	mu sync.Mutex
 
-	clients         map[string]storage.Client
-	authCodes       map[string]storage.AuthCode
-	refreshTokens   map[string]storage.RefreshToken
-	authReqs        map[string]storage.AuthRequest
-	passwords       map[string]storage.Password
-	offlineSessions map[offlineSessionID]storage.OfflineSessions
-	connectors      map[string]storage.Connector
-	deviceRequests  map[string]storage.DeviceRequest
-	deviceTokens    map[string]storage.DeviceToken
+	clients            map[string]storage.Client
+	authCodes          map[string]storage.AuthCode
+	refreshTokens      map[string]storage.RefreshToken
+	authReqs           map[string]storage.AuthRequest
+	passwords          map[string]storage.Password
+	offlineSessions    map[offlineSessionID]storage.OfflineSessions
+	userConsents       map[offlineSessionID]storage.UserConsent
+	totpEnrollments    map[offlineSessionID]storage.TOTPEnrollment
+	webAuthnCreds      map[string]storage.WebAuthnCredential
+	loginAttempts      map[string]storage.LoginAttempts
+	passwordResets     map[string]storage.PasswordReset
+	emailVerifications map[string]storage.EmailVerification
+	samlAssertions     map[string]storage.SAMLAssertion
+	samlSPs            map[string]storage.SAMLServiceProvider
+	connectors         map[string]storage.Connector
+	deviceRequests     map[string]storage.DeviceRequest
+	deviceTokens       map[string]storage.DeviceToken
+	backchannelReqs    map[string]storage.BackchannelAuthRequest
 
 	keys storage.Keys
 
@@ -94,6 +112,36 @@ func (s *memStorage) GarbageCollect(now time.Time) (result storage.GCResult, err
 				result.DeviceTokens++
 			}
 		}
+		for id, a := range s.backchannelReqs {
+			if now.After(a.Expiry) {
+				delete(s.backchannelReqs, id)
+				result.BackchannelAuthRequests++
+			}
+		}
+		for id, a := range s.loginAttempts {
+			if now.After(a.Expiry) {
+				delete(s.loginAttempts, id)
+				result.LoginAttempts++
+			}
+		}
+		for id, r := range s.passwordResets {
+			if now.After(r.Expiry) {
+				delete(s.passwordResets, id)
+				result.PasswordResets++
+			}
+		}
+		for id, v := range s.emailVerifications {
+			if now.After(v.Expiry) {
+				delete(s.emailVerifications, id)
+				result.EmailVerifications++
+			}
+		}
+		for id, a := range s.samlAssertions {
+			if now.After(a.Expiry) {
+				delete(s.samlAssertions, id)
+				result.SAMLAssertions++
+			}
+		}
 	})
 	return result, nil
 }
@@ -169,6 +217,102 @@ func (s *memStorage) CreateOfflineSessions(o storage.OfflineSessions) (err error
 	return
 }
 
+func (s *memStorage) CreateUserConsent(c storage.UserConsent) (err error) {
+	id := offlineSessionID{
+		userID: c.UserID,
+		connID: c.ConnID,
+	}
+	s.tx(func() {
+		if _, ok := s.userConsents[id]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.userConsents[id] = copyUserConsent(c)
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateTOTPEnrollment(t storage.TOTPEnrollment) (err error) {
+	id := offlineSessionID{
+		userID: t.UserID,
+		connID: t.ConnID,
+	}
+	s.tx(func() {
+		if _, ok := s.totpEnrollments[id]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.totpEnrollments[id] = t
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateWebAuthnCredential(c storage.WebAuthnCredential) (err error) {
+	s.tx(func() {
+		if _, ok := s.webAuthnCreds[c.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.webAuthnCreds[c.ID] = c
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateLoginAttempts(a storage.LoginAttempts) (err error) {
+	s.tx(func() {
+		if _, ok := s.loginAttempts[a.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.loginAttempts[a.ID] = a
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreatePasswordReset(r storage.PasswordReset) (err error) {
+	s.tx(func() {
+		if _, ok := s.passwordResets[r.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.passwordResets[r.ID] = r
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateEmailVerification(v storage.EmailVerification) (err error) {
+	s.tx(func() {
+		if _, ok := s.emailVerifications[v.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.emailVerifications[v.ID] = v
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateSAMLAssertion(a storage.SAMLAssertion) (err error) {
+	s.tx(func() {
+		if _, ok := s.samlAssertions[a.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.samlAssertions[a.ID] = a
+		}
+	})
+	return
+}
+
+func (s *memStorage) CreateSAMLServiceProvider(sp storage.SAMLServiceProvider) (err error) {
+	s.tx(func() {
+		if _, ok := s.samlSPs[sp.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.samlSPs[sp.ID] = sp
+		}
+	})
+	return
+}
+
 func (s *memStorage) CreateConnector(connector storage.Connector) (err error) {
 	s.tx(func() {
 		if _, ok := s.connectors[connector.ID]; ok {
@@ -254,6 +398,117 @@ func (s *memStorage) GetOfflineSessions(userID string, connID string) (o storage
 	return
 }
 
+func (s *memStorage) GetUserConsent(userID string, connID string) (c storage.UserConsent, err error) {
+	id := offlineSessionID{
+		userID: userID,
+		connID: connID,
+	}
+	s.tx(func() {
+		var ok bool
+		if c, ok = s.userConsents[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		c = copyUserConsent(c)
+	})
+	return
+}
+
+// copyUserConsent copies the client consents of c, which are pointers, so callers
+// can't change the stored consent outside of a transaction.
+func copyUserConsent(c storage.UserConsent) storage.UserConsent {
+	if c.Clients == nil {
+		return c
+	}
+	clients := make(map[string]*storage.ClientConsent, len(c.Clients))
+	for id, cc := range c.Clients {
+		if cc == nil {
+			clients[id] = nil
+			continue
+		}
+		ccCopy := *cc
+		ccCopy.Scopes = append([]string(nil), cc.Scopes...)
+		clients[id] = &ccCopy
+	}
+	c.Clients = clients
+	return c
+}
+
+func (s *memStorage) GetTOTPEnrollment(userID string, connID string) (t storage.TOTPEnrollment, err error) {
+	id := offlineSessionID{
+		userID: userID,
+		connID: connID,
+	}
+	s.tx(func() {
+		var ok bool
+		if t, ok = s.totpEnrollments[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetWebAuthnCredential(id string) (c storage.WebAuthnCredential, err error) {
+	s.tx(func() {
+		var ok bool
+		if c, ok = s.webAuthnCreds[id]; !ok {
+			err = storage.ErrNotFound
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetLoginAttempts(id string) (a storage.LoginAttempts, err error) {
+	s.tx(func() {
+		var ok bool
+		if a, ok = s.loginAttempts[id]; !ok {
+			err = storage.ErrNotFound
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetPasswordReset(id string) (r storage.PasswordReset, err error) {
+	s.tx(func() {
+		var ok bool
+		if r, ok = s.passwordResets[id]; !ok {
+			err = storage.ErrNotFound
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetEmailVerification(id string) (v storage.EmailVerification, err error) {
+	s.tx(func() {
+		var ok bool
+		if v, ok = s.emailVerifications[id]; !ok {
+			err = storage.ErrNotFound
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetSAMLAssertion(id string) (a storage.SAMLAssertion, err error) {
+	s.tx(func() {
+		var ok bool
+		if a, ok = s.samlAssertions[id]; !ok {
+			err = storage.ErrNotFound
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetSAMLServiceProvider(id string) (sp storage.SAMLServiceProvider, err error) {
+	s.tx(func() {
+		var ok bool
+		if sp, ok = s.samlSPs[id]; !ok {
+			err = storage.ErrNotFound
+		}
+	})
+	return
+}
+
 func (s *memStorage) GetConnector(id string) (connector storage.Connector, err error) {
 	s.tx(func() {
 		var ok bool
@@ -291,6 +546,48 @@ func (s *memStorage) ListPasswords() (passwords []storage.Password, err error) {
 	return
 }
 
+func (s *memStorage) ListPasswordResets(email string) (resets []storage.PasswordReset, err error) {
+	email = strings.ToLower(email)
+	s.tx(func() {
+		for _, r := range s.passwordResets {
+			if strings.ToLower(r.Email) == email {
+				resets = append(resets, r)
+			}
+		}
+	})
+	return
+}
+
+func (s *memStorage) ListWebAuthnCredentials(email string) (creds []storage.WebAuthnCredential, err error) {
+	email = strings.ToLower(email)
+	s.tx(func() {
+		for _, c := range s.webAuthnCreds {
+			if strings.ToLower(c.Email) == email {
+				creds = append(creds, c)
+			}
+		}
+	})
+	return
+}
+
+func (s *memStorage) ListLoginAttempts() (attempts []storage.LoginAttempts, err error) {
+	s.tx(func() {
+		for _, a := range s.loginAttempts {
+			attempts = append(attempts, a)
+		}
+	})
+	return
+}
+
+func (s *memStorage) ListSAMLServiceProviders() (sps []storage.SAMLServiceProvider, err error) {
+	s.tx(func() {
+		for _, sp := range s.samlSPs {
+			sps = append(sps, sp)
+		}
+	})
+	return
+}
+
 func (s *memStorage) ListConnectors() (conns []storage.Connector, err error) {
 	s.tx(func() {
 		for _, c := range s.connectors {
@@ -371,6 +668,91 @@ func (s *memStorage) DeleteOfflineSessions(userID string, connID string) (err er
 	return
 }
 
+func (s *memStorage) DeleteUserConsent(userID string, connID string) (err error) {
+	id := offlineSessionID{
+		userID: userID,
+		connID: connID,
+	}
+	s.tx(func() {
+		if _, ok := s.userConsents[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.userConsents, id)
+	})
+	return
+}
+
+func (s *memStorage) DeleteTOTPEnrollment(userID string, connID string) (err error) {
+	id := offlineSessionID{
+		userID: userID,
+		connID: connID,
+	}
+	s.tx(func() {
+		if _, ok := s.totpEnrollments[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.totpEnrollments, id)
+	})
+	return
+}
+
+func (s *memStorage) DeleteWebAuthnCredential(id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.webAuthnCreds[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.webAuthnCreds, id)
+	})
+	return
+}
+
+func (s *memStorage) DeleteLoginAttempts(id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.loginAttempts[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.loginAttempts, id)
+	})
+	return
+}
+
+func (s *memStorage) DeletePasswordReset(id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.passwordResets[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.passwordResets, id)
+	})
+	return
+}
+
+func (s *memStorage) DeleteEmailVerification(id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.emailVerifications[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.emailVerifications, id)
+	})
+	return
+}
+
+func (s *memStorage) DeleteSAMLServiceProvider(id string) (err error) {
+	s.tx(func() {
+		if _, ok := s.samlSPs[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		delete(s.samlSPs, id)
+	})
+	return
+}
+
 func (s *memStorage) DeleteConnector(id string) (err error) {
 	s.tx(func() {
 		if _, ok := s.connectors[id]; !ok {
@@ -467,6 +849,84 @@ func (s *memStorage) UpdateOfflineSessions(userID string, connID string, updater
 	return
 }
 
+func (s *memStorage) UpdateUserConsent(userID string, connID string, updater func(c storage.UserConsent) (storage.UserConsent, error)) (err error) {
+	id := offlineSessionID{
+		userID: userID,
+		connID: connID,
+	}
+	s.tx(func() {
+		r, ok := s.userConsents[id]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if r, err = updater(copyUserConsent(r)); err == nil {
+			s.userConsents[id] = copyUserConsent(r)
+		}
+	})
+	return
+}
+
+func (s *memStorage) UpdateTOTPEnrollment(userID string, connID string, updater func(t storage.TOTPEnrollment) (storage.TOTPEnrollment, error)) (err error) {
+	id := offlineSessionID{
+		userID: userID,
+		connID: connID,
+	}
+	s.tx(func() {
+		r, ok := s.totpEnrollments[id]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if r, err = updater(r); err == nil {
+			s.totpEnrollments[id] = r
+		}
+	})
+	return
+}
+
+func (s *memStorage) UpdateWebAuthnCredential(id string, updater func(c storage.WebAuthnCredential) (storage.WebAuthnCredential, error)) (err error) {
+	s.tx(func() {
+		r, ok := s.webAuthnCreds[id]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if r, err = updater(r); err == nil {
+			s.webAuthnCreds[id] = r
+		}
+	})
+	return
+}
+
+func (s *memStorage) UpdateLoginAttempts(id string, updater func(a storage.LoginAttempts) (storage.LoginAttempts, error)) (err error) {
+	s.tx(func() {
+		r, ok := s.loginAttempts[id]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if r, err = updater(r); err == nil {
+			s.loginAttempts[id] = r
+		}
+	})
+	return
+}
+
+func (s *memStorage) UpdateSAMLServiceProvider(id string, updater func(sp storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error)) (err error) {
+	s.tx(func() {
+		sp, ok := s.samlSPs[id]
+		if !ok {
+			err = storage.ErrNotFound
+			return
+		}
+		if sp, err = updater(sp); err == nil {
+			s.samlSPs[id] = sp
+		}
+	})
+	return
+}
+
 func (s *memStorage) UpdateConnector(id string, updater func(c storage.Connector) (storage.Connector, error)) (err error) {
 	s.tx(func() {
 		r, ok := s.connectors[id]
@@ -538,3 +998,25 @@ func (s *memStorage) UpdateDeviceToken(deviceCode string, updater func(p storage
 	})
 	return
 }
+
+func (s *memStorage) CreateBackchannelAuthRequest(b storage.BackchannelAuthRequest) (err error) {
+	s.tx(func() {
+		if _, ok := s.backchannelReqs[b.ID]; ok {
+			err = storage.ErrAlreadyExists
+		} else {
+			s.backchannelReqs[b.ID] = b
+		}
+	})
+	return
+}
+
+func (s *memStorage) GetBackchannelAuthRequest(id string) (req storage.BackchannelAuthRequest, err error) {
+	s.tx(func() {
+		var ok bool
+		if req, ok = s.backchannelReqs[id]; !ok {
+			err = storage.ErrNotFound
+			return
+		}
+	})
+	return
+}
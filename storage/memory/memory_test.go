@@ -1,6 +1,7 @@
 package memory
 
 import (
+	"errors"
 	"os"
 	"testing"
 
@@ -22,3 +23,40 @@ func TestStorage(t *testing.T) {
 	}
 	conformance.RunTests(t, newStorage)
 }
+
+func TestUserConsentIsCopied(t *testing.T) {
+	s := New(logrus.New())
+	consent := storage.UserConsent{
+		UserID: "user",
+		ConnID: "conn",
+		Clients: map[string]*storage.ClientConsent{
+			"client": {ClientID: "client", Scopes: []string{"openid"}},
+		},
+	}
+	if err := s.CreateUserConsent(consent); err != nil {
+		t.Fatal(err)
+	}
+	consent.Clients["client"].Scopes[0] = "changed"
+
+	got, err := s.GetUserConsent("user", "conn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Clients["client"].Scopes = append(got.Clients["client"].Scopes, "email")
+
+	err = s.UpdateUserConsent("user", "conn", func(old storage.UserConsent) (storage.UserConsent, error) {
+		old.Clients["client"].Scopes = nil
+		return old, errors.New("abort")
+	})
+	if err == nil {
+		t.Fatal("expected the update to fail")
+	}
+
+	got, err = s.GetUserConsent("user", "conn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scopes := got.Clients["client"].Scopes; len(scopes) != 1 || scopes[0] != "openid" {
+		t.Errorf("stored consent was modified outside of the storage: %v", scopes)
+	}
+}
@@ -119,6 +119,46 @@ func (c *conn) GarbageCollect(now time.Time) (storage.GCResult, error) {
 		result.DeviceTokens = n
 	}
 
+	r, err = c.Exec(`delete from backchannel_auth_request where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc backchannel_auth_request: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.BackchannelAuthRequests = n
+	}
+
+	r, err = c.Exec(`delete from login_attempts where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc login_attempts: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.LoginAttempts = n
+	}
+
+	r, err = c.Exec(`delete from password_reset where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc password_reset: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.PasswordResets = n
+	}
+
+	r, err = c.Exec(`delete from email_verification where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc email_verification: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.EmailVerifications = n
+	}
+
+	r, err = c.Exec(`delete from saml_assertion where expiry < $1`, now)
+	if err != nil {
+		return result, fmt.Errorf("gc saml_assertion: %v", err)
+	}
+	if n, err := r.RowsAffected(); err == nil {
+		result.SAMLAssertions = n
+	}
+
 	return result, err
 }
 
@@ -131,10 +171,14 @@ func (c *conn) CreateAuthRequest(a storage.AuthRequest) error {
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
 			expiry,
-			code_challenge, code_challenge_method
+			code_challenge, code_challenge_method,
+			response_mode,
+			claims_acr, acr_values, connector_chain,
+			mfa_validated, webauthn_challenge, callback_data
 		)
 		values (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21,
+			$22, $23, $24, $25, $26, $27
 		);
 	`,
 		a.ID, a.ClientID, encoder(a.ResponseTypes), encoder(a.Scopes), a.RedirectURI, a.Nonce, a.State,
@@ -144,6 +188,9 @@ func (c *conn) CreateAuthRequest(a storage.AuthRequest) error {
 		a.ConnectorID, a.ConnectorData,
 		a.Expiry,
 		a.PKCE.CodeChallenge, a.PKCE.CodeChallengeMethod,
+		a.ResponseMode,
+		a.Claims.ACR, encoder(a.ACRValues), encoder(a.ConnectorChain),
+		a.MFAValidated, a.WebAuthnChallenge, a.CallbackData,
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -175,8 +222,11 @@ func (c *conn) UpdateAuthRequest(id string, updater func(a storage.AuthRequest)
 				claims_groups = $14,
 				connector_id = $15, connector_data = $16,
 				expiry = $17,
-				code_challenge = $18, code_challenge_method = $19
-			where id = $20;
+				code_challenge = $18, code_challenge_method = $19,
+				response_mode = $20,
+				claims_acr = $21, acr_values = $22, connector_chain = $23,
+				mfa_validated = $24, webauthn_challenge = $25, callback_data = $26
+			where id = $27;
 		`,
 			a.ClientID, encoder(a.ResponseTypes), encoder(a.Scopes), a.RedirectURI, a.Nonce, a.State,
 			a.ForceApprovalPrompt, a.LoggedIn,
@@ -186,6 +236,9 @@ func (c *conn) UpdateAuthRequest(id string, updater func(a storage.AuthRequest)
 			a.ConnectorID, a.ConnectorData,
 			a.Expiry,
 			a.PKCE.CodeChallenge, a.PKCE.CodeChallengeMethod,
+			a.ResponseMode,
+			a.Claims.ACR, encoder(a.ACRValues), encoder(a.ConnectorChain),
+			a.MFAValidated, a.WebAuthnChallenge, a.CallbackData,
 			r.ID,
 		)
 		if err != nil {
@@ -200,6 +253,8 @@ func (c *conn) GetAuthRequest(id string) (storage.AuthRequest, error) {
 }
 
 func getAuthRequest(q querier, id string) (a storage.AuthRequest, err error) {
+	// Auth requests created before these columns were added have them set to NULL.
+	var acrValues, connectorChain []byte
 	err = q.QueryRow(`
 		select
 			id, client_id, response_types, scopes, redirect_uri, nonce, state,
@@ -207,7 +262,10 @@ func getAuthRequest(q querier, id string) (a storage.AuthRequest, err error) {
 			claims_user_id, claims_username, claims_preferred_username,
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data, expiry,
-			code_challenge, code_challenge_method
+			code_challenge, code_challenge_method,
+			response_mode,
+			claims_acr, acr_values, connector_chain,
+			mfa_validated, webauthn_challenge, callback_data
 		from auth_request where id = $1;
 	`, id).Scan(
 		&a.ID, &a.ClientID, decoder(&a.ResponseTypes), decoder(&a.Scopes), &a.RedirectURI, &a.Nonce, &a.State,
@@ -217,6 +275,9 @@ func getAuthRequest(q querier, id string) (a storage.AuthRequest, err error) {
 		decoder(&a.Claims.Groups),
 		&a.ConnectorID, &a.ConnectorData, &a.Expiry,
 		&a.PKCE.CodeChallenge, &a.PKCE.CodeChallengeMethod,
+		&a.ResponseMode,
+		&a.Claims.ACR, &acrValues, &connectorChain,
+		&a.MFAValidated, &a.WebAuthnChallenge, &a.CallbackData,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -224,6 +285,16 @@ func getAuthRequest(q querier, id string) (a storage.AuthRequest, err error) {
 		}
 		return a, fmt.Errorf("select auth request: %v", err)
 	}
+	if len(acrValues) > 0 {
+		if err := json.Unmarshal(acrValues, &a.ACRValues); err != nil {
+			return a, fmt.Errorf("unmarshal auth request acr values: %v", err)
+		}
+	}
+	if len(connectorChain) > 0 {
+		if err := json.Unmarshal(connectorChain, &a.ConnectorChain); err != nil {
+			return a, fmt.Errorf("unmarshal auth request connector chain: %v", err)
+		}
+	}
 	return a, nil
 }
 
@@ -235,14 +306,16 @@ func (c *conn) CreateAuthCode(a storage.AuthCode) error {
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
 			expiry,
-			code_challenge, code_challenge_method
+			code_challenge, code_challenge_method,
+			claims_acr
 		)
-		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17);
 	`,
 		a.ID, a.ClientID, encoder(a.Scopes), a.Nonce, a.RedirectURI, a.Claims.UserID,
 		a.Claims.Username, a.Claims.PreferredUsername, a.Claims.Email, a.Claims.EmailVerified,
 		encoder(a.Claims.Groups), a.ConnectorID, a.ConnectorData, a.Expiry,
 		a.PKCE.CodeChallenge, a.PKCE.CodeChallengeMethod,
+		a.Claims.ACR,
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -261,13 +334,15 @@ func (c *conn) GetAuthCode(id string) (a storage.AuthCode, err error) {
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
 			expiry,
-			code_challenge, code_challenge_method
+			code_challenge, code_challenge_method,
+			claims_acr
 		from auth_code where id = $1;
 	`, id).Scan(
 		&a.ID, &a.ClientID, decoder(&a.Scopes), &a.Nonce, &a.RedirectURI, &a.Claims.UserID,
 		&a.Claims.Username, &a.Claims.PreferredUsername, &a.Claims.Email, &a.Claims.EmailVerified,
 		decoder(&a.Claims.Groups), &a.ConnectorID, &a.ConnectorData, &a.Expiry,
 		&a.PKCE.CodeChallenge, &a.PKCE.CodeChallengeMethod,
+		&a.Claims.ACR,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -285,9 +360,10 @@ func (c *conn) CreateRefresh(r storage.RefreshToken) error {
 			claims_user_id, claims_username, claims_preferred_username,
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
-			token, obsolete_token, created_at, last_used
+			token, obsolete_token, created_at, last_used,
+			claims_acr, retired_tokens
 		)
-		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18);
 	`,
 		r.ID, r.ClientID, encoder(r.Scopes), r.Nonce,
 		r.Claims.UserID, r.Claims.Username, r.Claims.PreferredUsername,
@@ -295,6 +371,7 @@ func (c *conn) CreateRefresh(r storage.RefreshToken) error {
 		encoder(r.Claims.Groups),
 		r.ConnectorID, r.ConnectorData,
 		r.Token, r.ObsoleteToken, r.CreatedAt, r.LastUsed,
+		r.Claims.ACR, encoder(r.RetiredTokens),
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -331,16 +408,19 @@ func (c *conn) UpdateRefreshToken(id string, updater func(old storage.RefreshTok
 				token = $12,
                 obsolete_token = $13,
 				created_at = $14,
-				last_used = $15
+				last_used = $15,
+				claims_acr = $16,
+				retired_tokens = $17
 			where
-				id = $16
+				id = $18
 		`,
 			r.ClientID, encoder(r.Scopes), r.Nonce,
 			r.Claims.UserID, r.Claims.Username, r.Claims.PreferredUsername,
 			r.Claims.Email, r.Claims.EmailVerified,
 			encoder(r.Claims.Groups),
 			r.ConnectorID, r.ConnectorData,
-			r.Token, r.ObsoleteToken, r.CreatedAt, r.LastUsed, id,
+			r.Token, r.ObsoleteToken, r.CreatedAt, r.LastUsed,
+			r.Claims.ACR, encoder(r.RetiredTokens), id,
 		)
 		if err != nil {
 			return fmt.Errorf("update refresh token: %v", err)
@@ -361,7 +441,8 @@ func getRefresh(q querier, id string) (storage.RefreshToken, error) {
 			claims_email, claims_email_verified,
 			claims_groups,
 			connector_id, connector_data,
-			token, obsolete_token, created_at, last_used
+			token, obsolete_token, created_at, last_used,
+			claims_acr, retired_tokens
 		from refresh_token where id = $1;
 	`, id))
 }
@@ -373,7 +454,8 @@ func (c *conn) ListRefreshTokens() ([]storage.RefreshToken, error) {
 			claims_user_id, claims_username, claims_preferred_username,
 			claims_email, claims_email_verified, claims_groups,
 			connector_id, connector_data,
-			token, obsolete_token, created_at, last_used
+			token, obsolete_token, created_at, last_used,
+			claims_acr, retired_tokens
 		from refresh_token;
 	`)
 	if err != nil {
@@ -396,6 +478,9 @@ func (c *conn) ListRefreshTokens() ([]storage.RefreshToken, error) {
 }
 
 func scanRefresh(s scanner) (r storage.RefreshToken, err error) {
+	// Refresh tokens created before the retired_tokens column was added have it
+	// set to NULL.
+	var retiredTokens []byte
 	err = s.Scan(
 		&r.ID, &r.ClientID, decoder(&r.Scopes), &r.Nonce,
 		&r.Claims.UserID, &r.Claims.Username, &r.Claims.PreferredUsername,
@@ -403,6 +488,7 @@ func scanRefresh(s scanner) (r storage.RefreshToken, err error) {
 		decoder(&r.Claims.Groups),
 		&r.ConnectorID, &r.ConnectorData,
 		&r.Token, &r.ObsoleteToken, &r.CreatedAt, &r.LastUsed,
+		&r.Claims.ACR, &retiredTokens,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -410,6 +496,11 @@ func scanRefresh(s scanner) (r storage.RefreshToken, err error) {
 		}
 		return r, fmt.Errorf("scan refresh_token: %v", err)
 	}
+	if len(retiredTokens) > 0 {
+		if err := json.Unmarshal(retiredTokens, &r.RetiredTokens); err != nil {
+			return r, fmt.Errorf("unmarshal refresh_token retired tokens: %v", err)
+		}
+	}
 	return r, nil
 }
 
@@ -508,9 +599,24 @@ func (c *conn) UpdateClient(id string, updater func(old storage.Client) (storage
 				trusted_peers = $3,
 				public = $4,
 				name = $5,
-				logo_url = $6
-			where id = $7;
-		`, nc.Secret, encoder(nc.RedirectURIs), encoder(nc.TrustedPeers), nc.Public, nc.Name, nc.LogoURL, id,
+				logo_url = $6,
+				backchannel_notification_endpoint = $7,
+				id_token_encrypted_response_alg = $8,
+				id_token_encrypted_response_enc = $9,
+				userinfo_signed_response_alg = $10,
+				userinfo_encrypted_response_alg = $11,
+				userinfo_encrypted_response_enc = $12,
+				jwks = $13,
+				subject_type = $14,
+				sector_identifier = $15,
+				backchannel_logout_uri = $16
+			where id = $17;
+		`, nc.Secret, encoder(nc.RedirectURIs), encoder(nc.TrustedPeers), nc.Public, nc.Name, nc.LogoURL,
+			nc.BackchannelNotificationEndpoint,
+			nc.IDTokenEncryptedResponseAlg, nc.IDTokenEncryptedResponseEnc,
+			nc.UserInfoSignedResponseAlg, nc.UserInfoEncryptedResponseAlg,
+			nc.UserInfoEncryptedResponseEnc, encoder(nc.JWKS),
+			nc.SubjectType, nc.SectorIdentifier, nc.BackchannelLogoutURI, id,
 		)
 		if err != nil {
 			return fmt.Errorf("update client: %v", err)
@@ -522,12 +628,22 @@ func (c *conn) UpdateClient(id string, updater func(old storage.Client) (storage
 func (c *conn) CreateClient(cli storage.Client) error {
 	_, err := c.Exec(`
 		insert into client (
-			id, secret, redirect_uris, trusted_peers, public, name, logo_url
+			id, secret, redirect_uris, trusted_peers, public, name, logo_url,
+			backchannel_notification_endpoint,
+			id_token_encrypted_response_alg, id_token_encrypted_response_enc,
+			userinfo_signed_response_alg, userinfo_encrypted_response_alg,
+			userinfo_encrypted_response_enc, jwks,
+			subject_type, sector_identifier, backchannel_logout_uri
 		)
-		values ($1, $2, $3, $4, $5, $6, $7);
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17);
 	`,
 		cli.ID, cli.Secret, encoder(cli.RedirectURIs), encoder(cli.TrustedPeers),
 		cli.Public, cli.Name, cli.LogoURL,
+		cli.BackchannelNotificationEndpoint,
+		cli.IDTokenEncryptedResponseAlg, cli.IDTokenEncryptedResponseEnc,
+		cli.UserInfoSignedResponseAlg, cli.UserInfoEncryptedResponseAlg,
+		cli.UserInfoEncryptedResponseEnc, encoder(cli.JWKS),
+		cli.SubjectType, cli.SectorIdentifier, cli.BackchannelLogoutURI,
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -541,7 +657,12 @@ func (c *conn) CreateClient(cli storage.Client) error {
 func getClient(q querier, id string) (storage.Client, error) {
 	return scanClient(q.QueryRow(`
 		select
-			id, secret, redirect_uris, trusted_peers, public, name, logo_url
+			id, secret, redirect_uris, trusted_peers, public, name, logo_url,
+			backchannel_notification_endpoint,
+			id_token_encrypted_response_alg, id_token_encrypted_response_enc,
+			userinfo_signed_response_alg, userinfo_encrypted_response_alg,
+			userinfo_encrypted_response_enc, jwks,
+			subject_type, sector_identifier, backchannel_logout_uri
 	    from client where id = $1;
 	`, id))
 }
@@ -553,7 +674,12 @@ func (c *conn) GetClient(id string) (storage.Client, error) {
 func (c *conn) ListClients() ([]storage.Client, error) {
 	rows, err := c.Query(`
 		select
-			id, secret, redirect_uris, trusted_peers, public, name, logo_url
+			id, secret, redirect_uris, trusted_peers, public, name, logo_url,
+			backchannel_notification_endpoint,
+			id_token_encrypted_response_alg, id_token_encrypted_response_enc,
+			userinfo_signed_response_alg, userinfo_encrypted_response_alg,
+			userinfo_encrypted_response_enc, jwks,
+			subject_type, sector_identifier, backchannel_logout_uri
 		from client;
 	`)
 	if err != nil {
@@ -576,9 +702,16 @@ func (c *conn) ListClients() ([]storage.Client, error) {
 }
 
 func scanClient(s scanner) (cli storage.Client, err error) {
+	// Clients created before the jwks column was added have it set to NULL.
+	var jwks []byte
 	err = s.Scan(
 		&cli.ID, &cli.Secret, decoder(&cli.RedirectURIs), decoder(&cli.TrustedPeers),
 		&cli.Public, &cli.Name, &cli.LogoURL,
+		&cli.BackchannelNotificationEndpoint,
+		&cli.IDTokenEncryptedResponseAlg, &cli.IDTokenEncryptedResponseEnc,
+		&cli.UserInfoSignedResponseAlg, &cli.UserInfoEncryptedResponseAlg,
+		&cli.UserInfoEncryptedResponseEnc, &jwks,
+		&cli.SubjectType, &cli.SectorIdentifier, &cli.BackchannelLogoutURI,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -586,6 +719,11 @@ func scanClient(s scanner) (cli storage.Client, err error) {
 		}
 		return cli, fmt.Errorf("get client: %v", err)
 	}
+	if len(jwks) > 0 {
+		if err := json.Unmarshal(jwks, &cli.JWKS); err != nil {
+			return cli, fmt.Errorf("unmarshal client jwks: %v", err)
+		}
+	}
 	return cli, nil
 }
 
@@ -593,13 +731,15 @@ func (c *conn) CreatePassword(p storage.Password) error {
 	p.Email = strings.ToLower(p.Email)
 	_, err := c.Exec(`
 		insert into password (
-			email, hash, username, user_id
+			email, hash, username, user_id, pending_verification, pending_approval,
+			user_groups, display_name, attributes, previous_hashes
 		)
 		values (
-			$1, $2, $3, $4
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 		);
 	`,
-		p.Email, p.Hash, p.Username, p.UserID,
+		p.Email, p.Hash, p.Username, p.UserID, p.PendingVerification, p.PendingApproval,
+		encoder(p.Groups), p.DisplayName, encoder(p.Attributes), encoder(p.PreviousHashes),
 	)
 	if err != nil {
 		if c.alreadyExistsCheck(err) {
@@ -624,10 +764,16 @@ func (c *conn) UpdatePassword(email string, updater func(p storage.Password) (st
 		_, err = tx.Exec(`
 			update password
 			set
-				hash = $1, username = $2, user_id = $3
-			where email = $4;
+				hash = $1, username = $2, user_id = $3,
+				pending_verification = $4, pending_approval = $5,
+				user_groups = $6, display_name = $7, attributes = $8,
+				previous_hashes = $9
+			where email = $10;
 		`,
-			np.Hash, np.Username, np.UserID, p.Email,
+			np.Hash, np.Username, np.UserID,
+			np.PendingVerification, np.PendingApproval,
+			encoder(np.Groups), np.DisplayName, encoder(np.Attributes),
+			encoder(np.PreviousHashes), p.Email,
 		)
 		if err != nil {
 			return fmt.Errorf("update password: %v", err)
@@ -643,7 +789,8 @@ func (c *conn) GetPassword(email string) (storage.Password, error) {
 func getPassword(q querier, email string) (p storage.Password, err error) {
 	return scanPassword(q.QueryRow(`
 		select
-			email, hash, username, user_id
+			email, hash, username, user_id, pending_verification, pending_approval,
+			user_groups, display_name, attributes, previous_hashes
 		from password where email = $1;
 	`, strings.ToLower(email)))
 }
@@ -651,7 +798,8 @@ func getPassword(q querier, email string) (p storage.Password, err error) {
 func (c *conn) ListPasswords() ([]storage.Password, error) {
 	rows, err := c.Query(`
 		select
-			email, hash, username, user_id
+			email, hash, username, user_id, pending_verification, pending_approval,
+			user_groups, display_name, attributes, previous_hashes
 		from password;
 	`)
 	if err != nil {
@@ -674,8 +822,12 @@ func (c *conn) ListPasswords() ([]storage.Password, error) {
 }
 
 func scanPassword(s scanner) (p storage.Password, err error) {
+	// Passwords created before the groups, attributes and previous_hashes columns
+	// were added have them set to NULL.
+	var groups, attributes, previousHashes []byte
 	err = s.Scan(
-		&p.Email, &p.Hash, &p.Username, &p.UserID,
+		&p.Email, &p.Hash, &p.Username, &p.UserID, &p.PendingVerification, &p.PendingApproval,
+		&groups, &p.DisplayName, &attributes, &previousHashes,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -683,6 +835,21 @@ func scanPassword(s scanner) (p storage.Password, err error) {
 		}
 		return p, fmt.Errorf("select password: %v", err)
 	}
+	if len(groups) > 0 {
+		if err := json.Unmarshal(groups, &p.Groups); err != nil {
+			return p, fmt.Errorf("unmarshal password groups: %v", err)
+		}
+	}
+	if len(attributes) > 0 {
+		if err := json.Unmarshal(attributes, &p.Attributes); err != nil {
+			return p, fmt.Errorf("unmarshal password attributes: %v", err)
+		}
+	}
+	if len(previousHashes) > 0 {
+		if err := json.Unmarshal(previousHashes, &p.PreviousHashes); err != nil {
+			return p, fmt.Errorf("unmarshal password previous hashes: %v", err)
+		}
+	}
 	return p, nil
 }
 
@@ -759,6 +926,581 @@ func scanOfflineSessions(s scanner) (o storage.OfflineSessions, err error) {
 	return o, nil
 }
 
+func (c *conn) CreateUserConsent(uc storage.UserConsent) error {
+	_, err := c.Exec(`
+		insert into user_consent (
+			user_id, conn_id, clients
+		)
+		values (
+			$1, $2, $3
+		);
+	`,
+		uc.UserID, uc.ConnID, encoder(uc.Clients),
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert user consent: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) UpdateUserConsent(userID string, connID string, updater func(uc storage.UserConsent) (storage.UserConsent, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		uc, err := getUserConsent(tx, userID, connID)
+		if err != nil {
+			return err
+		}
+
+		newConsent, err := updater(uc)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update user_consent
+			set
+				clients = $1
+			where user_id = $2 AND conn_id = $3;
+		`,
+			encoder(newConsent.Clients), uc.UserID, uc.ConnID,
+		)
+		if err != nil {
+			return fmt.Errorf("update user consent: %v", err)
+		}
+		return nil
+	})
+}
+
+func (c *conn) GetUserConsent(userID string, connID string) (storage.UserConsent, error) {
+	return getUserConsent(c, userID, connID)
+}
+
+func getUserConsent(q querier, userID string, connID string) (storage.UserConsent, error) {
+	return scanUserConsent(q.QueryRow(`
+		select
+			user_id, conn_id, clients
+		from user_consent
+		where user_id = $1 AND conn_id = $2;
+		`, userID, connID))
+}
+
+func scanUserConsent(s scanner) (uc storage.UserConsent, err error) {
+	err = s.Scan(
+		&uc.UserID, &uc.ConnID, decoder(&uc.Clients),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uc, storage.ErrNotFound
+		}
+		return uc, fmt.Errorf("select user consent: %v", err)
+	}
+	return uc, nil
+}
+
+func (c *conn) CreateTOTPEnrollment(t storage.TOTPEnrollment) error {
+	_, err := c.Exec(`
+		insert into totp_enrollment (
+			user_id, conn_id, secret, confirmed, recovery_codes, last_used_step, created_at
+		)
+		values (
+			$1, $2, $3, $4, $5, $6, $7
+		);
+	`,
+		t.UserID, t.ConnID, t.Secret, t.Confirmed, encoder(t.RecoveryCodes), t.LastUsedStep, t.CreatedAt,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert totp enrollment: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) UpdateTOTPEnrollment(userID string, connID string, updater func(t storage.TOTPEnrollment) (storage.TOTPEnrollment, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		t, err := getTOTPEnrollment(tx, userID, connID)
+		if err != nil {
+			return err
+		}
+
+		newEnrollment, err := updater(t)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update totp_enrollment
+			set
+				secret = $1, confirmed = $2, recovery_codes = $3, last_used_step = $4, created_at = $5
+			where user_id = $6 AND conn_id = $7;
+		`,
+			newEnrollment.Secret, newEnrollment.Confirmed, encoder(newEnrollment.RecoveryCodes),
+			newEnrollment.LastUsedStep, newEnrollment.CreatedAt,
+			t.UserID, t.ConnID,
+		)
+		if err != nil {
+			return fmt.Errorf("update totp enrollment: %v", err)
+		}
+		return nil
+	})
+}
+
+func (c *conn) GetTOTPEnrollment(userID string, connID string) (storage.TOTPEnrollment, error) {
+	return getTOTPEnrollment(c, userID, connID)
+}
+
+func getTOTPEnrollment(q querier, userID string, connID string) (storage.TOTPEnrollment, error) {
+	return scanTOTPEnrollment(q.QueryRow(`
+		select
+			user_id, conn_id, secret, confirmed, recovery_codes, last_used_step, created_at
+		from totp_enrollment
+		where user_id = $1 AND conn_id = $2;
+		`, userID, connID))
+}
+
+func scanTOTPEnrollment(s scanner) (t storage.TOTPEnrollment, err error) {
+	err = s.Scan(
+		&t.UserID, &t.ConnID, &t.Secret, &t.Confirmed, decoder(&t.RecoveryCodes), &t.LastUsedStep, &t.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return t, storage.ErrNotFound
+		}
+		return t, fmt.Errorf("select totp enrollment: %v", err)
+	}
+	return t, nil
+}
+
+func (c *conn) CreateWebAuthnCredential(cred storage.WebAuthnCredential) error {
+	_, err := c.Exec(`
+		insert into webauthn_credential (
+			id, email, public_key, attestation_type, transports, aaguid, sign_count,
+			created_at, last_used
+		)
+		values (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		);
+	`,
+		cred.ID, strings.ToLower(cred.Email), cred.PublicKey, cred.AttestationType, encoder(cred.Transports),
+		cred.AAGUID, int64(cred.SignCount), cred.CreatedAt, cred.LastUsed,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert webauthn credential: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) UpdateWebAuthnCredential(id string, updater func(c storage.WebAuthnCredential) (storage.WebAuthnCredential, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		cred, err := getWebAuthnCredential(tx, id)
+		if err != nil {
+			return err
+		}
+
+		nc, err := updater(cred)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update webauthn_credential
+			set
+				email = $1, public_key = $2, attestation_type = $3, transports = $4, aaguid = $5,
+				sign_count = $6, created_at = $7, last_used = $8
+			where id = $9;
+		`,
+			strings.ToLower(nc.Email), nc.PublicKey, nc.AttestationType, encoder(nc.Transports), nc.AAGUID,
+			int64(nc.SignCount), nc.CreatedAt, nc.LastUsed,
+			cred.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("update webauthn credential: %v", err)
+		}
+		return nil
+	})
+}
+
+func (c *conn) GetWebAuthnCredential(id string) (storage.WebAuthnCredential, error) {
+	return getWebAuthnCredential(c, id)
+}
+
+func getWebAuthnCredential(q querier, id string) (storage.WebAuthnCredential, error) {
+	return scanWebAuthnCredential(q.QueryRow(`
+		select
+			id, email, public_key, attestation_type, transports, aaguid, sign_count,
+			created_at, last_used
+		from webauthn_credential where id = $1;
+	`, id))
+}
+
+func (c *conn) ListWebAuthnCredentials(email string) ([]storage.WebAuthnCredential, error) {
+	rows, err := c.Query(`
+		select
+			id, email, public_key, attestation_type, transports, aaguid, sign_count,
+			created_at, last_used
+		from webauthn_credential where email = $1;
+	`, strings.ToLower(email))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var creds []storage.WebAuthnCredential
+	for rows.Next() {
+		cred, err := scanWebAuthnCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, cred)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
+
+func scanWebAuthnCredential(s scanner) (cred storage.WebAuthnCredential, err error) {
+	var signCount int64
+	err = s.Scan(
+		&cred.ID, &cred.Email, &cred.PublicKey, &cred.AttestationType, decoder(&cred.Transports), &cred.AAGUID,
+		&signCount, &cred.CreatedAt, &cred.LastUsed,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return cred, storage.ErrNotFound
+		}
+		return cred, fmt.Errorf("select webauthn credential: %v", err)
+	}
+	cred.SignCount = uint32(signCount)
+	return cred, nil
+}
+
+func (c *conn) CreateLoginAttempts(a storage.LoginAttempts) error {
+	_, err := c.Exec(`
+		insert into login_attempts (
+			id, failures, last_failure, locked_until, expiry
+		)
+		values (
+			$1, $2, $3, $4, $5
+		);
+	`,
+		a.ID, a.Failures, a.LastFailure, a.LockedUntil, a.Expiry,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert login attempts: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) UpdateLoginAttempts(id string, updater func(a storage.LoginAttempts) (storage.LoginAttempts, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		a, err := getLoginAttempts(tx, id)
+		if err != nil {
+			return err
+		}
+
+		na, err := updater(a)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update login_attempts
+			set
+				failures = $1, last_failure = $2, locked_until = $3, expiry = $4
+			where id = $5;
+		`,
+			na.Failures, na.LastFailure, na.LockedUntil, na.Expiry,
+			a.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("update login attempts: %v", err)
+		}
+		return nil
+	})
+}
+
+func (c *conn) GetLoginAttempts(id string) (storage.LoginAttempts, error) {
+	return getLoginAttempts(c, id)
+}
+
+func getLoginAttempts(q querier, id string) (storage.LoginAttempts, error) {
+	return scanLoginAttempts(q.QueryRow(`
+		select
+			id, failures, last_failure, locked_until, expiry
+		from login_attempts where id = $1;
+	`, id))
+}
+
+func (c *conn) ListLoginAttempts() ([]storage.LoginAttempts, error) {
+	rows, err := c.Query(`
+		select
+			id, failures, last_failure, locked_until, expiry
+		from login_attempts;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []storage.LoginAttempts
+	for rows.Next() {
+		a, err := scanLoginAttempts(rows)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
+
+func (c *conn) CreatePasswordReset(r storage.PasswordReset) error {
+	_, err := c.Exec(`
+		insert into password_reset (
+			id, email, expiry
+		)
+		values (
+			$1, $2, $3
+		);
+	`,
+		r.ID, strings.ToLower(r.Email), r.Expiry,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert password reset: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetPasswordReset(id string) (r storage.PasswordReset, err error) {
+	err = c.QueryRow(`
+		select
+			id, email, expiry
+		from password_reset where id = $1;
+	`, id).Scan(&r.ID, &r.Email, &r.Expiry)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return r, storage.ErrNotFound
+		}
+		return r, fmt.Errorf("select password reset: %v", err)
+	}
+	return r, nil
+}
+
+func (c *conn) ListPasswordResets(email string) ([]storage.PasswordReset, error) {
+	rows, err := c.Query(`
+		select
+			id, email, expiry
+		from password_reset where email = $1;
+	`, strings.ToLower(email))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resets []storage.PasswordReset
+	for rows.Next() {
+		var r storage.PasswordReset
+		if err := rows.Scan(&r.ID, &r.Email, &r.Expiry); err != nil {
+			return nil, fmt.Errorf("scan password reset: %v", err)
+		}
+		resets = append(resets, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return resets, nil
+}
+
+func (c *conn) CreateEmailVerification(v storage.EmailVerification) error {
+	_, err := c.Exec(`
+		insert into email_verification (
+			id, email, expiry
+		)
+		values (
+			$1, $2, $3
+		);
+	`,
+		v.ID, strings.ToLower(v.Email), v.Expiry,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert email verification: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetEmailVerification(id string) (v storage.EmailVerification, err error) {
+	err = c.QueryRow(`
+		select
+			id, email, expiry
+		from email_verification where id = $1;
+	`, id).Scan(&v.ID, &v.Email, &v.Expiry)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return v, storage.ErrNotFound
+		}
+		return v, fmt.Errorf("select email verification: %v", err)
+	}
+	return v, nil
+}
+
+func (c *conn) CreateSAMLAssertion(a storage.SAMLAssertion) error {
+	_, err := c.Exec(`
+		insert into saml_assertion (
+			id, expiry
+		)
+		values (
+			$1, $2
+		);
+	`,
+		a.ID, a.Expiry,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert saml assertion: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetSAMLAssertion(id string) (a storage.SAMLAssertion, err error) {
+	err = c.QueryRow(`
+		select
+			id, expiry
+		from saml_assertion where id = $1;
+	`, id).Scan(&a.ID, &a.Expiry)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return a, storage.ErrNotFound
+		}
+		return a, fmt.Errorf("select saml assertion: %v", err)
+	}
+	return a, nil
+}
+
+func (c *conn) CreateSAMLServiceProvider(sp storage.SAMLServiceProvider) error {
+	_, err := c.Exec(`
+		insert into saml_service_provider (
+			id, name, acs_urls, name_id_format, attribute_names
+		)
+		values (
+			$1, $2, $3, $4, $5
+		);
+	`,
+		sp.ID, sp.Name, encoder(sp.ACSURLs), sp.NameIDFormat, encoder(sp.AttributeNames),
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert saml service provider: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) UpdateSAMLServiceProvider(id string, updater func(sp storage.SAMLServiceProvider) (storage.SAMLServiceProvider, error)) error {
+	return c.ExecTx(func(tx *trans) error {
+		sp, err := getSAMLServiceProvider(tx, id)
+		if err != nil {
+			return err
+		}
+		nsp, err := updater(sp)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			update saml_service_provider
+			set
+				name = $1,
+				acs_urls = $2,
+				name_id_format = $3,
+				attribute_names = $4
+			where id = $5;
+		`, nsp.Name, encoder(nsp.ACSURLs), nsp.NameIDFormat, encoder(nsp.AttributeNames), id)
+		if err != nil {
+			return fmt.Errorf("update saml service provider: %v", err)
+		}
+		return nil
+	})
+}
+
+func (c *conn) GetSAMLServiceProvider(id string) (storage.SAMLServiceProvider, error) {
+	return getSAMLServiceProvider(c, id)
+}
+
+func getSAMLServiceProvider(q querier, id string) (storage.SAMLServiceProvider, error) {
+	return scanSAMLServiceProvider(q.QueryRow(`
+		select
+			id, name, acs_urls, name_id_format, attribute_names
+		from saml_service_provider where id = $1;
+	`, id))
+}
+
+func (c *conn) ListSAMLServiceProviders() ([]storage.SAMLServiceProvider, error) {
+	rows, err := c.Query(`
+		select
+			id, name, acs_urls, name_id_format, attribute_names
+		from saml_service_provider;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sps []storage.SAMLServiceProvider
+	for rows.Next() {
+		sp, err := scanSAMLServiceProvider(rows)
+		if err != nil {
+			return nil, err
+		}
+		sps = append(sps, sp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sps, nil
+}
+
+func scanSAMLServiceProvider(s scanner) (sp storage.SAMLServiceProvider, err error) {
+	err = s.Scan(
+		&sp.ID, &sp.Name, decoder(&sp.ACSURLs), &sp.NameIDFormat, decoder(&sp.AttributeNames),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return sp, storage.ErrNotFound
+		}
+		return sp, fmt.Errorf("select saml service provider: %v", err)
+	}
+	return sp, nil
+}
+
+func scanLoginAttempts(s scanner) (a storage.LoginAttempts, err error) {
+	err = s.Scan(
+		&a.ID, &a.Failures, &a.LastFailure, &a.LockedUntil, &a.Expiry,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return a, storage.ErrNotFound
+		}
+		return a, fmt.Errorf("select login attempts: %v", err)
+	}
+	return a, nil
+}
+
 func (c *conn) CreateConnector(connector storage.Connector) error {
 	_, err := c.Exec(`
 		insert into connector (
@@ -867,6 +1609,17 @@ func (c *conn) DeletePassword(email string) error {
 	return c.delete("password", "email", strings.ToLower(email))
 }
 func (c *conn) DeleteConnector(id string) error { return c.delete("connector", "id", id) }
+func (c *conn) DeleteWebAuthnCredential(id string) error {
+	return c.delete("webauthn_credential", "id", id)
+}
+func (c *conn) DeleteLoginAttempts(id string) error { return c.delete("login_attempts", "id", id) }
+func (c *conn) DeleteSAMLServiceProvider(id string) error {
+	return c.delete("saml_service_provider", "id", id)
+}
+func (c *conn) DeletePasswordReset(id string) error { return c.delete("password_reset", "id", id) }
+func (c *conn) DeleteEmailVerification(id string) error {
+	return c.delete("email_verification", "id", id)
+}
 
 func (c *conn) DeleteOfflineSessions(userID string, connID string) error {
 	result, err := c.Exec(`delete from offline_session where user_id = $1 AND conn_id = $2`, userID, connID)
@@ -886,6 +1639,38 @@ func (c *conn) DeleteOfflineSessions(userID string, connID string) error {
 	return nil
 }
 
+func (c *conn) DeleteUserConsent(userID string, connID string) error {
+	result, err := c.Exec(`delete from user_consent where user_id = $1 AND conn_id = $2`, userID, connID)
+	if err != nil {
+		return fmt.Errorf("delete user_consent: user_id = %s, conn_id = %s", userID, connID)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %v", err)
+	}
+	if n < 1 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (c *conn) DeleteTOTPEnrollment(userID string, connID string) error {
+	result, err := c.Exec(`delete from totp_enrollment where user_id = $1 AND conn_id = $2`, userID, connID)
+	if err != nil {
+		return fmt.Errorf("delete totp_enrollment: user_id = %s, conn_id = %s", userID, connID)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %v", err)
+	}
+	if n < 1 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
 // Do NOT call directly. Does not escape table.
 func (c *conn) delete(table, field, id string) error {
 	result, err := c.Exec(`delete from `+table+` where `+field+` = $1`, id)
@@ -1014,3 +1799,40 @@ func (c *conn) UpdateDeviceToken(deviceCode string, updater func(old storage.Dev
 		return nil
 	})
 }
+
+func (c *conn) CreateBackchannelAuthRequest(b storage.BackchannelAuthRequest) error {
+	_, err := c.Exec(`
+		insert into backchannel_auth_request (
+			id, client_id, scopes, login_hint, binding_message, client_notification_token, expiry
+		)
+		values (
+			$1, $2, $3, $4, $5, $6, $7
+		);`,
+		b.ID, b.ClientID, encoder(b.Scopes), b.LoginHint, b.BindingMessage, b.ClientNotificationToken, b.Expiry,
+	)
+	if err != nil {
+		if c.alreadyExistsCheck(err) {
+			return storage.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert backchannel auth request: %v", err)
+	}
+	return nil
+}
+
+func (c *conn) GetBackchannelAuthRequest(id string) (b storage.BackchannelAuthRequest, err error) {
+	err = c.QueryRow(`
+		select
+			client_id, scopes, login_hint, binding_message, client_notification_token, expiry
+		from backchannel_auth_request where id = $1;
+	`, id).Scan(
+		&b.ClientID, decoder(&b.Scopes), &b.LoginHint, &b.BindingMessage, &b.ClientNotificationToken, &b.Expiry,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return b, storage.ErrNotFound
+		}
+		return b, fmt.Errorf("select backchannel auth request: %v", err)
+	}
+	b.ID = id
+	return b, nil
+}
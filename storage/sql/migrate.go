@@ -281,4 +281,227 @@ var migrations = []migration{
 				add column obsolete_token text default '';`,
 		},
 	},
+	{
+		stmts: []string{
+			`
+			create table user_consent (
+				user_id text not null,
+				conn_id text not null,
+				clients bytea not null, -- JSON object of client consents
+				PRIMARY KEY (user_id, conn_id)
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column response_mode text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column backchannel_notification_endpoint text not null default '';`,
+			`
+			create table backchannel_auth_request (
+				id text not null primary key,
+				client_id text not null,
+				scopes bytea not null, -- JSON array of strings
+				login_hint text not null,
+				binding_message text not null,
+				client_notification_token text not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column id_token_encrypted_response_alg text not null default '';`,
+			`
+			alter table client
+				add column id_token_encrypted_response_enc text not null default '';`,
+			`
+			alter table client
+				add column userinfo_signed_response_alg text not null default '';`,
+			`
+			alter table client
+				add column userinfo_encrypted_response_alg text not null default '';`,
+			`
+			alter table client
+				add column userinfo_encrypted_response_enc text not null default '';`,
+			`
+			alter table client
+				add column jwks bytea;`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column subject_type text not null default '';`,
+			`
+			alter table client
+				add column sector_identifier text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table client
+				add column backchannel_logout_uri text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column claims_acr text not null default '';`,
+			`
+			alter table auth_request
+				add column acr_values bytea;`,
+			`
+			alter table auth_request
+				add column connector_chain bytea;`,
+			`
+			alter table auth_code
+				add column claims_acr text not null default '';`,
+			`
+			alter table refresh_token
+				add column claims_acr text not null default '';`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column mfa_validated boolean not null default false;`,
+			`
+			create table totp_enrollment (
+				user_id text not null,
+				conn_id text not null,
+				secret bytea not null,
+				confirmed boolean not null,
+				recovery_codes bytea not null, -- JSON array of strings
+				last_used_step bigint not null,
+				created_at timestamptz not null,
+				PRIMARY KEY (user_id, conn_id)
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column webauthn_challenge text not null default '';`,
+			`
+			create table webauthn_credential (
+				id text not null primary key,
+				email text not null,
+				public_key bytea not null,
+				attestation_type text not null,
+				transports bytea not null, -- JSON array of strings
+				aaguid bytea not null,
+				sign_count bigint not null,
+				created_at timestamptz not null,
+				last_used timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			create table login_attempts (
+				id text not null primary key,
+				failures integer not null,
+				last_failure timestamptz not null,
+				locked_until timestamptz not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			create table password_reset (
+				id text not null primary key,
+				email text not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table password
+				add column pending_verification boolean not null default false;`,
+			`
+			alter table password
+				add column pending_approval boolean not null default false;`,
+			`
+			create table email_verification (
+				id text not null primary key,
+				email text not null,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table password
+				add column user_groups bytea;`,
+			`
+			alter table password
+				add column display_name text not null default '';`,
+			`
+			alter table password
+				add column attributes bytea;`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table password
+				add column previous_hashes bytea;`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			create table saml_assertion (
+				id text not null primary key,
+				expiry timestamptz not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			create table saml_service_provider (
+				id text not null primary key,
+				name text not null,
+				acs_urls bytea not null,
+				name_id_format text not null,
+				attribute_names bytea not null
+			);`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table auth_request
+				add column callback_data bytea;`,
+		},
+	},
+	{
+		stmts: []string{
+			`
+			alter table refresh_token
+				add column retired_tokens bytea;`,
+		},
+	},
 }
@@ -53,6 +53,12 @@ type GCResult struct {
 	AuthCodes      int64
 	DeviceRequests int64
 	DeviceTokens   int64
+
+	BackchannelAuthRequests int64
+	LoginAttempts           int64
+	PasswordResets          int64
+	EmailVerifications      int64
+	SAMLAssertions          int64
 }
 
 // IsEmpty returns whether the garbage collection result is empty or not.
@@ -60,7 +66,12 @@ func (g *GCResult) IsEmpty() bool {
 	return g.AuthRequests == 0 &&
 		g.AuthCodes == 0 &&
 		g.DeviceRequests == 0 &&
-		g.DeviceTokens == 0
+		g.DeviceTokens == 0 &&
+		g.BackchannelAuthRequests == 0 &&
+		g.LoginAttempts == 0 &&
+		g.PasswordResets == 0 &&
+		g.EmailVerifications == 0 &&
+		g.SAMLAssertions == 0
 }
 
 // Storage is the storage interface used by the server. Implementations are
@@ -76,9 +87,19 @@ type Storage interface {
 	CreateRefresh(r RefreshToken) error
 	CreatePassword(p Password) error
 	CreateOfflineSessions(s OfflineSessions) error
+	CreateUserConsent(c UserConsent) error
+	CreateTOTPEnrollment(t TOTPEnrollment) error
+	CreateWebAuthnCredential(c WebAuthnCredential) error
+	CreateLoginAttempts(a LoginAttempts) error
+	CreatePasswordReset(r PasswordReset) error
+	CreateEmailVerification(v EmailVerification) error
+	// CreateSAMLAssertion returns ErrAlreadyExists if the assertion was used before.
+	CreateSAMLAssertion(a SAMLAssertion) error
+	CreateSAMLServiceProvider(sp SAMLServiceProvider) error
 	CreateConnector(c Connector) error
 	CreateDeviceRequest(d DeviceRequest) error
 	CreateDeviceToken(d DeviceToken) error
+	CreateBackchannelAuthRequest(b BackchannelAuthRequest) error
 
 	// TODO(ericchiang): return (T, bool, error) so we can indicate not found
 	// requests that way instead of using ErrNotFound.
@@ -89,14 +110,29 @@ type Storage interface {
 	GetRefresh(id string) (RefreshToken, error)
 	GetPassword(email string) (Password, error)
 	GetOfflineSessions(userID string, connID string) (OfflineSessions, error)
+	GetUserConsent(userID string, connID string) (UserConsent, error)
+	GetTOTPEnrollment(userID string, connID string) (TOTPEnrollment, error)
+	GetWebAuthnCredential(id string) (WebAuthnCredential, error)
+	GetLoginAttempts(id string) (LoginAttempts, error)
+	GetPasswordReset(id string) (PasswordReset, error)
+	GetEmailVerification(id string) (EmailVerification, error)
+	GetSAMLAssertion(id string) (SAMLAssertion, error)
+	GetSAMLServiceProvider(id string) (SAMLServiceProvider, error)
 	GetConnector(id string) (Connector, error)
 	GetDeviceRequest(userCode string) (DeviceRequest, error)
 	GetDeviceToken(deviceCode string) (DeviceToken, error)
+	GetBackchannelAuthRequest(id string) (BackchannelAuthRequest, error)
 
 	ListClients() ([]Client, error)
 	ListRefreshTokens() ([]RefreshToken, error)
 	ListPasswords() ([]Password, error)
 	ListConnectors() ([]Connector, error)
+	// ListWebAuthnCredentials returns the credentials of the local user with the email.
+	ListWebAuthnCredentials(email string) ([]WebAuthnCredential, error)
+	// ListPasswordResets returns the password resets sent to the email.
+	ListPasswordResets(email string) ([]PasswordReset, error)
+	ListLoginAttempts() ([]LoginAttempts, error)
+	ListSAMLServiceProviders() ([]SAMLServiceProvider, error)
 
 	// Delete methods MUST be atomic.
 	DeleteAuthRequest(id string) error
@@ -105,6 +141,13 @@ type Storage interface {
 	DeleteRefresh(id string) error
 	DeletePassword(email string) error
 	DeleteOfflineSessions(userID string, connID string) error
+	DeleteUserConsent(userID string, connID string) error
+	DeleteTOTPEnrollment(userID string, connID string) error
+	DeleteWebAuthnCredential(id string) error
+	DeleteLoginAttempts(id string) error
+	DeletePasswordReset(id string) error
+	DeleteEmailVerification(id string) error
+	DeleteSAMLServiceProvider(id string) error
 	DeleteConnector(id string) error
 
 	// Update methods take a function for updating an object then performs that update within
@@ -127,19 +170,25 @@ type Storage interface {
 	UpdateRefreshToken(id string, updater func(r RefreshToken) (RefreshToken, error)) error
 	UpdatePassword(email string, updater func(p Password) (Password, error)) error
 	UpdateOfflineSessions(userID string, connID string, updater func(s OfflineSessions) (OfflineSessions, error)) error
+	UpdateUserConsent(userID string, connID string, updater func(c UserConsent) (UserConsent, error)) error
+	UpdateTOTPEnrollment(userID string, connID string, updater func(t TOTPEnrollment) (TOTPEnrollment, error)) error
+	UpdateWebAuthnCredential(id string, updater func(c WebAuthnCredential) (WebAuthnCredential, error)) error
+	UpdateLoginAttempts(id string, updater func(a LoginAttempts) (LoginAttempts, error)) error
+	UpdateSAMLServiceProvider(id string, updater func(sp SAMLServiceProvider) (SAMLServiceProvider, error)) error
 	UpdateConnector(id string, updater func(c Connector) (Connector, error)) error
 	UpdateDeviceToken(deviceCode string, updater func(t DeviceToken) (DeviceToken, error)) error
 
-	// GarbageCollect deletes all expired AuthCodes,
-	// AuthRequests, DeviceRequests, and DeviceTokens.
+	// GarbageCollect deletes all expired AuthCodes, AuthRequests,
+	// DeviceRequests, DeviceTokens, BackchannelAuthRequests, LoginAttempts,
+	// PasswordResets, EmailVerifications, and SAMLAssertions.
 	GarbageCollect(now time.Time) (GCResult, error)
 }
 
 // Client represents an OAuth2 client.
 //
 // For further reading see:
-//   * Trusted peers: https://developers.google.com/identity/protocols/CrossClientAuth
-//   * Public clients: https://developers.google.com/api-client-library/python/auth/installed-app
+//   - Trusted peers: https://developers.google.com/identity/protocols/CrossClientAuth
+//   - Public clients: https://developers.google.com/api-client-library/python/auth/installed-app
 type Client struct {
 	// Client ID and secret used to identify the client.
 	ID        string `json:"id" yaml:"id"`
@@ -164,6 +213,37 @@ type Client struct {
 	// Name and LogoURL used when displaying this client to the end user.
 	Name    string `json:"name" yaml:"name"`
 	LogoURL string `json:"logoURL" yaml:"logoURL"`
+
+	// BackchannelNotificationEndpoint is where the server notifies the client that a
+	// backchannel authentication request completed. Only clients that set it can use
+	// the "ping" token delivery mode.
+	BackchannelNotificationEndpoint string `json:"backchannelNotificationEndpoint" yaml:"backchannelNotificationEndpoint"`
+
+	// If set, ID tokens issued to this client are encrypted with these JWE algorithms
+	// to a key from JWKS. The content encryption defaults to A128CBC-HS256.
+	IDTokenEncryptedResponseAlg string `json:"idTokenEncryptedResponseAlg" yaml:"idTokenEncryptedResponseAlg"`
+	IDTokenEncryptedResponseEnc string `json:"idTokenEncryptedResponseEnc" yaml:"idTokenEncryptedResponseEnc"`
+
+	// If set, the userinfo endpoint answers this client with a signed JWT instead of plain
+	// JSON, which is additionally encrypted if the encryption algorithms are set.
+	UserInfoSignedResponseAlg    string `json:"userInfoSignedResponseAlg" yaml:"userInfoSignedResponseAlg"`
+	UserInfoEncryptedResponseAlg string `json:"userInfoEncryptedResponseAlg" yaml:"userInfoEncryptedResponseAlg"`
+	UserInfoEncryptedResponseEnc string `json:"userInfoEncryptedResponseEnc" yaml:"userInfoEncryptedResponseEnc"`
+
+	// JWKS holds the public keys of the client which responses are encrypted to.
+	JWKS *jose.JSONWebKeySet `json:"jwks,omitempty" yaml:"jwks,omitempty"`
+
+	// SubjectType is either "public" (the default) or "pairwise". Pairwise clients get a
+	// "sub" claim which differs between sectors, so clients can't correlate users.
+	// For the same reason they don't get the "federated_claims" claim.
+	SubjectType string `json:"subjectType" yaml:"subjectType"`
+	// SectorIdentifier groups pairwise clients which see the same subjects. Defaults to
+	// the host of the client's redirect URIs.
+	SectorIdentifier string `json:"sectorIdentifier" yaml:"sectorIdentifier"`
+
+	// BackchannelLogoutURI receives OpenID Connect back-channel logout tokens when the
+	// server ends a user's session with the client.
+	BackchannelLogoutURI string `json:"backchannelLogoutURI" yaml:"backchannelLogoutURI"`
 }
 
 // Claims represents the ID Token claims supported by the server.
@@ -175,6 +255,9 @@ type Claims struct {
 	EmailVerified     bool
 
 	Groups []string
+
+	// ACR is the authentication context class the user logged in with, if any.
+	ACR string
 }
 
 // PKCE is a container for the data needed to perform Proof Key for Code Exchange (RFC 7636) auth flow
@@ -200,6 +283,14 @@ type AuthRequest struct {
 	Nonce         string
 	State         string
 
+	// ResponseMode is the "response_mode" requested by the client. If empty the
+	// default mode of the response types is used.
+	ResponseMode string
+
+	// ACRValues are the authentication context classes requested by the client
+	// through "acr_values", in order of preference.
+	ACRValues []string
+
 	// The client has indicated that the end user must be shown an approval prompt
 	// on all requests. The server cannot cache their initial action for subsequent
 	// attempts.
@@ -221,6 +312,22 @@ type AuthRequest struct {
 	ConnectorID   string
 	ConnectorData []byte
 
+	// ConnectorChain lists the connectors the user has logged in with so far when
+	// the requested ACR takes a chain of connectors. The identity and connector
+	// above are those of the first connector in the chain.
+	ConnectorChain []string
+
+	// MFAValidated is set once the user completed the second factor required
+	// after logging in with the connector.
+	MFAValidated bool
+
+	// WebAuthnChallenge is the challenge of the WebAuthn ceremony in progress.
+	WebAuthnChallenge string
+
+	// CallbackData is what the connector keeps between sending the user to the
+	// upstream provider and handling the callback, for example a PKCE verifier.
+	CallbackData []byte
+
 	// PKCE CodeChallenge and CodeChallengeMethod
 	PKCE PKCE
 }
@@ -274,6 +381,10 @@ type RefreshToken struct {
 	Token         string
 	ObsoleteToken string
 
+	// Hashes of the tokens rotated out before ObsoleteToken, most recent last.
+	// Presenting one of them again means the token family leaked.
+	RetiredTokens []string
+
 	CreatedAt time.Time
 	LastUsed  time.Time
 
@@ -322,6 +433,171 @@ type OfflineSessions struct {
 	ConnectorData []byte
 }
 
+// UserConsent holds the scopes an end user has agreed to share with each client.
+// It is used to skip the approval screen when a client asks for scopes the user
+// already granted.
+type UserConsent struct {
+	// UserID of an end user who has logged in to the server.
+	UserID string
+
+	// The ID of the connector used to login the user.
+	ConnID string
+
+	// Clients is a hash table of consent decisions indexed by client ID.
+	Clients map[string]*ClientConsent
+}
+
+// TOTPEnrollment is the TOTP second factor a user set up for the identity returned by
+// a connector.
+type TOTPEnrollment struct {
+	// UserID of an end user who has logged in to the server.
+	UserID string
+
+	// The ID of the connector used to login the user.
+	ConnID string
+
+	// Secret shared with the user's authenticator, encrypted by the server.
+	Secret []byte
+
+	// Confirmed is set once the user entered a valid code after scanning the secret.
+	// Unconfirmed enrollments are replaced when the user starts over.
+	Confirmed bool
+
+	// RecoveryCodes holds hashes of the single use codes which haven't been used yet.
+	RecoveryCodes []string
+
+	// LastUsedStep is the time step of the last accepted code. Codes of this step or
+	// earlier ones are rejected so a code can't be replayed.
+	LastUsedStep int64
+
+	CreatedAt time.Time
+}
+
+// ClientConsent is the set of scopes a user granted to a single client.
+type ClientConsent struct {
+	ClientID string
+
+	// Scopes approved by the user. Later requests for a subset of these scopes
+	// don't require another approval.
+	Scopes []string
+
+	CreatedAt   time.Time
+	LastUpdated time.Time
+}
+
+// Covers reports whether every scope in scopes has been granted.
+func (c *ClientConsent) Covers(scopes []string) bool {
+	granted := make(map[string]bool, len(c.Scopes))
+	for _, scope := range c.Scopes {
+		granted[scope] = true
+	}
+	for _, scope := range scopes {
+		if !granted[scope] {
+			return false
+		}
+	}
+	return true
+}
+
+// WebAuthnCredential is a WebAuthn credential, such as a passkey, a local user
+// registered to log in with.
+type WebAuthnCredential struct {
+	// ID of the credential chosen by the authenticator, base64url encoded.
+	ID string
+
+	// Email of the local user the credential belongs to.
+	Email string
+
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey []byte
+
+	AttestationType string
+	Transports      []string
+
+	// AAGUID identifies the model of the authenticator.
+	AAGUID []byte
+
+	// SignCount is the signature counter last reported by the authenticator. A counter
+	// which doesn't increase hints at a cloned authenticator.
+	SignCount uint32
+
+	CreatedAt time.Time
+	LastUsed  time.Time
+}
+
+// LoginAttempts counts the failed password logins for a username or from a client
+// address, so every instance of the server applies the same lockout.
+type LoginAttempts struct {
+	// ID of what the logins were attempted for, set by the server.
+	ID string
+
+	// Failures since the last successful login, or since the failures expired.
+	Failures    int
+	LastFailure time.Time
+
+	// No further logins are attempted until LockedUntil.
+	LockedUntil time.Time
+
+	// The failures are forgotten after Expiry.
+	Expiry time.Time
+}
+
+// PasswordReset is a single use token emailed to a user of the password database
+// who forgot their password.
+type PasswordReset struct {
+	// The token sent in the reset link.
+	ID string
+
+	// Email of the password to reset.
+	Email string
+
+	Expiry time.Time
+}
+
+// EmailVerification is a single use token emailed to a user who signed up for the
+// password database, to confirm they own the email address.
+type EmailVerification struct {
+	// The token sent in the verification link.
+	ID string
+
+	// Email of the password to verify.
+	Email string
+
+	Expiry time.Time
+}
+
+// SAMLAssertion is the ID of a SAML assertion which logged a user in without a
+// request of the server, remembered until it expires so it can't be replayed.
+type SAMLAssertion struct {
+	// ID of the connector and of the assertion, set by the server.
+	ID string
+
+	Expiry time.Time
+}
+
+// SAMLServiceProvider is an application which logs users in with the server as
+// its SAML 2.0 identity provider.
+type SAMLServiceProvider struct {
+	// Entity ID of the service provider, the Issuer of its AuthnRequests.
+	ID string
+
+	// Name used when displaying the service provider to the end user.
+	Name string
+
+	// URLs of the service provider's AssertionConsumerServices with the HTTP-POST
+	// binding. Responses are only sent to these URLs, the first is the default.
+	ACSURLs []string
+
+	// Format of the NameID of the subject, either persistent, the "sub" claim, or
+	// emailAddress. Defaults to persistent. Users whose email isn't verified can't
+	// log in to service providers using emailAddress, and their email is never sent.
+	NameIDFormat string
+
+	// Names of the attributes claims are sent as, by claim: "email", "name",
+	// "preferred_username" and "groups". Other claims keep their claim name.
+	AttributeNames map[string]string
+}
+
 // Password is an email to password mapping managed by the storage.
 type Password struct {
 	// Email and identifying name of the password. Emails are assumed to be valid and
@@ -333,17 +609,42 @@ type Password struct {
 	// (cough cough, kubernetes), must map this value appropriately.
 	Email string `json:"email"`
 
-	// Bcrypt encoded hash of the password. This package enforces a min cost value of 10
+	// Bcrypt or argon2id encoded hash of the password. This package enforces a min
+	// bcrypt cost value of 10
 	Hash []byte `json:"hash"`
 
 	// Bcrypt encoded hash of the password set in environment variable of this name.
 	HashFromEnv string `json:"hashFromEnv"`
 
+	// Hashes of the passwords the user had before, most recent first. Only kept
+	// while a password policy forbids reusing them.
+	PreviousHashes [][]byte `json:"previousHashes"`
+
 	// Optional username to display. NOT used during login.
 	Username string `json:"username"`
 
 	// Randomly generated user ID. This is NOT the primary ID of the Password object.
 	UserID string `json:"userID"`
+
+	// Groups the user is a member of, returned when clients request the "groups" scope.
+	Groups []string `json:"groups"`
+
+	// Optional full name of the user, used for the "name" claim. The username is
+	// used as the preferred username if it's set.
+	DisplayName string `json:"displayName"`
+
+	// Optional custom attributes of the user, such as a department or an employee
+	// number. They're managed through the API, and returned in the identity of
+	// the password connector on login and refresh. They aren't added to tokens.
+	Attributes map[string]string `json:"attributes"`
+
+	// Set for users who signed up themselves until they follow the link sent to
+	// their email address. Their email isn't reported as verified until then.
+	PendingVerification bool `json:"pendingVerification"`
+
+	// Set for users who signed up themselves while sign ups need the approval of
+	// an admin. They can't log in until an admin approves them.
+	PendingApproval bool `json:"pendingApproval"`
 }
 
 // Connector is an object that contains the metadata about connectors used to login to Dex.
@@ -428,3 +729,26 @@ type DeviceToken struct {
 	LastRequestTime     time.Time
 	PollIntervalSeconds int
 }
+
+// BackchannelAuthRequest represents a client initiated backchannel authentication (CIBA)
+// request. It holds the state of the request until the user authenticates through the
+// link they were notified with or the expiry time passes.
+//
+// The token polling state for the request is kept in a DeviceToken whose DeviceCode is
+// the ID of the request.
+type BackchannelAuthRequest struct {
+	// The auth_req_id handed to the client.
+	ID string
+	// The client ID the request is for.
+	ClientID string
+	// The scopes the client requests.
+	Scopes []string
+	// Identifies the user who is asked to authenticate.
+	LoginHint string
+	// Optional message displayed to the user on both the consumption and authentication devices.
+	BindingMessage string
+	// Bearer token the client expects when it is pinged. Empty for the poll mode.
+	ClientNotificationToken string
+	// The expire time
+	Expiry time.Time
+}
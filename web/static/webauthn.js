@@ -0,0 +1,126 @@
+// Runs the WebAuthn ceremony of the page: fetches the options from the server, hands
+// them to the browser and posts the browser's response back.
+(function () {
+  "use strict";
+
+  var root = document.getElementById("webauthn");
+  var errorBox = document.getElementById("webauthn-error");
+  var button = document.getElementById("webauthn-start");
+
+  function decode(value) {
+    var s = value.replace(/-/g, "+").replace(/_/g, "/");
+    while (s.length % 4) {
+      s += "=";
+    }
+    var bin = atob(s);
+    var bytes = new Uint8Array(bin.length);
+    for (var i = 0; i < bin.length; i++) {
+      bytes[i] = bin.charCodeAt(i);
+    }
+    return bytes.buffer;
+  }
+
+  function encode(buffer) {
+    if (!buffer) {
+      return undefined;
+    }
+    var bytes = new Uint8Array(buffer);
+    var bin = "";
+    for (var i = 0; i < bytes.length; i++) {
+      bin += String.fromCharCode(bytes[i]);
+    }
+    return btoa(bin).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+  }
+
+  function decodeDescriptors(list) {
+    return (list || []).map(function (c) {
+      return Object.assign({}, c, { id: decode(c.id) });
+    });
+  }
+
+  function showError(message) {
+    errorBox.textContent = message;
+    errorBox.hidden = false;
+    button.disabled = false;
+  }
+
+  function post(url, body) {
+    return fetch(url, {
+      method: "POST",
+      credentials: "same-origin",
+      headers: { "Content-Type": "application/json" },
+      body: body,
+    }).then(function (resp) {
+      return resp.json();
+    });
+  }
+
+  function login(options) {
+    var publicKey = options.publicKey;
+    publicKey.challenge = decode(publicKey.challenge);
+    publicKey.allowCredentials = decodeDescriptors(publicKey.allowCredentials);
+    return navigator.credentials.get({ publicKey: publicKey }).then(function (cred) {
+      return {
+        id: cred.id,
+        rawId: encode(cred.rawId),
+        type: cred.type,
+        response: {
+          authenticatorData: encode(cred.response.authenticatorData),
+          clientDataJSON: encode(cred.response.clientDataJSON),
+          signature: encode(cred.response.signature),
+          userHandle: encode(cred.response.userHandle),
+        },
+      };
+    });
+  }
+
+  function register(options) {
+    var publicKey = options.publicKey;
+    publicKey.challenge = decode(publicKey.challenge);
+    publicKey.user.id = decode(publicKey.user.id);
+    publicKey.excludeCredentials = decodeDescriptors(publicKey.excludeCredentials);
+    return navigator.credentials.create({ publicKey: publicKey }).then(function (cred) {
+      var transports = cred.response.getTransports ? cred.response.getTransports() : [];
+      return {
+        id: cred.id,
+        rawId: encode(cred.rawId),
+        type: cred.type,
+        transports: transports,
+        response: {
+          attestationObject: encode(cred.response.attestationObject),
+          clientDataJSON: encode(cred.response.clientDataJSON),
+        },
+      };
+    });
+  }
+
+  button.addEventListener("click", function () {
+    if (!window.PublicKeyCredential) {
+      showError("Your browser doesn't support passkeys.");
+      return;
+    }
+    button.disabled = true;
+    errorBox.hidden = true;
+
+    var ceremony = root.dataset.ceremony === "register" ? register : login;
+    post(root.dataset.optionsUrl, "")
+      .then(function (options) {
+        if (options.error) {
+          throw new Error(options.error);
+        }
+        return ceremony(options);
+      })
+      .then(function (cred) {
+        return post(root.dataset.postUrl, JSON.stringify(cred));
+      })
+      .then(function (result) {
+        if (result.error) {
+          throw new Error(result.error);
+        }
+        window.location = result.redirect;
+      })
+      .catch(function (err) {
+        showError(err.message || "Passkey ceremony failed.");
+      });
+  });
+})();